@@ -21,6 +21,7 @@ import (
 	"github.com/pachyderm/pachyderm/src/client/pfs"
 	"github.com/pachyderm/pachyderm/src/client/pkg/config"
 	"github.com/pachyderm/pachyderm/src/client/pkg/grpcutil"
+	"github.com/pachyderm/pachyderm/src/client/pkg/tracing"
 	"github.com/pachyderm/pachyderm/src/client/pps"
 )
 
@@ -84,6 +85,10 @@ type APIClient struct {
 
 	// The context used in requests, can be set with WithCtx
 	ctx context.Context
+
+	// The ID of the transaction that PFS requests are recorded in, can be set
+	// with WithTransaction
+	transactionID string
 }
 
 // GetAddress returns the pachd host:post with which 'c' is communicating. If
@@ -238,10 +243,15 @@ func EtcdDialOptions() []grpc.DialOption {
 // - TLS is disabled
 // - Dial is synchronous: the call doesn't return until the connection has been
 //                        established and it's safe to send RPCs
+// - RPCs are traced (see src/client/pkg/tracing)
 //
 // This is primarily useful for Pachd and Worker clients
 func PachDialOptions() []grpc.DialOption {
-	return append(EtcdDialOptions(), grpc.WithInsecure())
+	return append(EtcdDialOptions(),
+		grpc.WithInsecure(),
+		grpc.WithUnaryInterceptor(tracing.UnaryClientInterceptor),
+		grpc.WithStreamInterceptor(tracing.StreamClientInterceptor),
+	)
 }
 
 func (c *APIClient) connect() error {
@@ -278,6 +288,14 @@ func (c *APIClient) AddMetadata(ctx context.Context) context.Context {
 	if c.authenticationToken != "" {
 		clientData[auth.ContextTokenKey] = c.authenticationToken
 	}
+	if c.transactionID != "" {
+		clientData[pfs.TransactionKey] = c.transactionID
+	}
+	// Propagate the current span (if any), so that the server's spans join
+	// the caller's trace
+	if sc, ok := tracing.SpanContextFromContext(ctx); ok {
+		clientData[tracing.MetadataKey] = sc.String()
+	}
 	// metadata API downcases all the key names
 	if c.metricsUserID != "" {
 		clientData["userid"] = c.metricsUserID
@@ -319,6 +337,19 @@ func (c *APIClient) WithCtx(ctx context.Context) *APIClient {
 	return &result
 }
 
+// WithTransaction returns a new APIClient whose StartCommit, FinishCommit,
+// PutFile, DeleteFile and SetBranch requests are recorded in 'txn' instead of
+// being applied. They're applied atomically by FinishTransaction. A nil 'txn'
+// returns a client that doesn't use a transaction.
+func (c *APIClient) WithTransaction(txn *pfs.Transaction) *APIClient {
+	result := *c // copy c
+	result.transactionID = ""
+	if txn != nil {
+		result.transactionID = txn.ID
+	}
+	return &result
+}
+
 // SetAuthToken sets the authentication token that will be used for all
 // API calls for this client.
 func (c *APIClient) SetAuthToken(token string) {
@@ -3,6 +3,7 @@ package client
 import (
 	"bytes"
 	"context"
+	"fmt"
 	"io"
 	"path/filepath"
 
@@ -104,6 +105,35 @@ func (c APIClient) DeleteRepo(repoName string, force bool) error {
 	return err
 }
 
+// ForkRepo creates the repo forkName with a copy of each finished commit and
+// each branch of repoName. The copies share the original commits' trees, so
+// no data is copied, but they have new commit IDs.
+func (c APIClient) ForkRepo(repoName string, forkName string, description string) error {
+	_, err := c.PfsAPIClient.ForkRepo(
+		c.Ctx(),
+		&pfs.ForkRepoRequest{
+			Repo:        NewRepo(repoName),
+			Fork:        NewRepo(forkName),
+			Description: description,
+		},
+	)
+	return grpcutil.ScrubGRPC(err)
+}
+
+// RenameRepo renames a repo, and updates everything that refers to it,
+// including the inputs of pipelines. Repos with open commits, and the output
+// repos of pipelines, can't be renamed.
+func (c APIClient) RenameRepo(repoName string, newName string) error {
+	_, err := c.PfsAPIClient.RenameRepo(
+		c.Ctx(),
+		&pfs.RenameRepoRequest{
+			Repo:    NewRepo(repoName),
+			NewRepo: NewRepo(newName),
+		},
+	)
+	return grpcutil.ScrubGRPC(err)
+}
+
 // StartCommit begins the process of committing data to a Repo. Once started
 // you can write to the Commit with PutFile and when all the data has been
 // written you must finish the Commit with FinishCommit. NOTE, data is not
@@ -283,6 +313,160 @@ func (c APIClient) DeleteBranch(repoName string, branch string) error {
 	return grpcutil.ScrubGRPC(err)
 }
 
+// ListBranchHistory returns the moves of a branch's head, newest first.
+// number limits the moves returned; 0 means all of them.
+func (c APIClient) ListBranchHistory(repoName string, branch string, number uint64) ([]*pfs.BranchMove, error) {
+	history, err := c.PfsAPIClient.ListBranchHistory(
+		c.Ctx(),
+		&pfs.ListBranchHistoryRequest{
+			Repo:   NewRepo(repoName),
+			Branch: branch,
+			Number: number,
+		},
+	)
+	if err != nil {
+		return nil, grpcutil.ScrubGRPC(err)
+	}
+	return history.Moves, nil
+}
+
+// RestoreBranch moves a branch back to the head it had n moves ago (see
+// ListBranchHistory). The restore is itself recorded as a move.
+func (c APIClient) RestoreBranch(repoName string, branch string, n int) error {
+	return c.SetBranch(repoName, fmt.Sprintf("%s@{-%d}", branch, n), branch)
+}
+
+// SetBranchProtection creates or replaces the protection rule for the branch
+// named in protection. Only repo owners may change branch protection.
+func (c APIClient) SetBranchProtection(repoName string, protection *pfs.BranchProtection) error {
+	_, err := c.PfsAPIClient.SetBranchProtection(
+		c.Ctx(),
+		&pfs.SetBranchProtectionRequest{
+			Repo:       NewRepo(repoName),
+			Protection: protection,
+		},
+	)
+	return grpcutil.ScrubGRPC(err)
+}
+
+// DeleteBranchProtection removes the protection rule for a branch, after
+// which anyone with WRITER access may move it again.
+func (c APIClient) DeleteBranchProtection(repoName string, branch string) error {
+	_, err := c.PfsAPIClient.DeleteBranchProtection(
+		c.Ctx(),
+		&pfs.DeleteBranchProtectionRequest{
+			Repo:   NewRepo(repoName),
+			Branch: branch,
+		},
+	)
+	return grpcutil.ScrubGRPC(err)
+}
+
+// SetRetentionPolicy sets the policy deciding which of a repo's commits are
+// deleted automatically. A nil policy removes the repo's retention policy.
+func (c APIClient) SetRetentionPolicy(repoName string, policy *pfs.RetentionPolicy) error {
+	_, err := c.PfsAPIClient.SetRetentionPolicy(
+		c.Ctx(),
+		&pfs.SetRetentionPolicyRequest{
+			Repo:   NewRepo(repoName),
+			Policy: policy,
+		},
+	)
+	return grpcutil.ScrubGRPC(err)
+}
+
+// ApplyRetentionPolicy deletes the commits that a repo's retention policy
+// doesn't keep, without waiting for pachd to do so, and returns them.
+// If dryRun is true, the commits are returned but not deleted.
+func (c APIClient) ApplyRetentionPolicy(repoName string, dryRun bool) ([]*pfs.Commit, error) {
+	response, err := c.PfsAPIClient.ApplyRetentionPolicy(
+		c.Ctx(),
+		&pfs.ApplyRetentionPolicyRequest{
+			Repo:   NewRepo(repoName),
+			DryRun: dryRun,
+		},
+	)
+	if err != nil {
+		return nil, grpcutil.ScrubGRPC(err)
+	}
+	return response.Deleted, nil
+}
+
+// SetRepoQuota sets the limits on the data stored in a repo. A nil quota
+// removes the repo's quota. Only cluster admins may set quotas.
+func (c APIClient) SetRepoQuota(repoName string, quota *pfs.Quota) error {
+	_, err := c.PfsAPIClient.SetQuota(
+		c.Ctx(),
+		&pfs.SetQuotaRequest{
+			Repo:  NewRepo(repoName),
+			Quota: quota,
+		},
+	)
+	return grpcutil.ScrubGRPC(err)
+}
+
+// SetPrincipalQuota sets the limits on the data stored in all the repos
+// created by a principal. A nil quota removes the principal's quota. Only
+// cluster admins may set quotas.
+func (c APIClient) SetPrincipalQuota(principal string, quota *pfs.Quota) error {
+	_, err := c.PfsAPIClient.SetQuota(
+		c.Ctx(),
+		&pfs.SetQuotaRequest{
+			Principal: principal,
+			Quota:     quota,
+		},
+	)
+	return grpcutil.ScrubGRPC(err)
+}
+
+// QuotaUsage returns the storage used by each repo and by each principal.
+func (c APIClient) QuotaUsage() (*pfs.QuotaUsageResponse, error) {
+	response, err := c.PfsAPIClient.QuotaUsage(
+		c.Ctx(),
+		&pfs.QuotaUsageRequest{},
+	)
+	if err != nil {
+		return nil, grpcutil.ScrubGRPC(err)
+	}
+	return response, nil
+}
+
+// StartTransaction starts a transaction. Use WithTransaction to get a client
+// whose requests are recorded in it, and FinishTransaction to apply them.
+func (c APIClient) StartTransaction() (*pfs.Transaction, error) {
+	txn, err := c.PfsAPIClient.StartTransaction(
+		c.Ctx(),
+		&pfs.StartTransactionRequest{},
+	)
+	if err != nil {
+		return nil, grpcutil.ScrubGRPC(err)
+	}
+	return txn, nil
+}
+
+// FinishTransaction atomically applies all of the requests recorded in a
+// transaction: other clients see either all of them or none of them.
+func (c APIClient) FinishTransaction(txn *pfs.Transaction) error {
+	_, err := c.PfsAPIClient.FinishTransaction(
+		c.Ctx(),
+		&pfs.FinishTransactionRequest{
+			Transaction: txn,
+		},
+	)
+	return grpcutil.ScrubGRPC(err)
+}
+
+// DeleteTransaction discards a transaction without applying its requests.
+func (c APIClient) DeleteTransaction(txn *pfs.Transaction) error {
+	_, err := c.PfsAPIClient.DeleteTransaction(
+		c.Ctx(),
+		&pfs.DeleteTransactionRequest{
+			Transaction: txn,
+		},
+	)
+	return grpcutil.ScrubGRPC(err)
+}
+
 // DeleteCommit deletes a commit.
 // Note it is currently not implemented.
 func (c APIClient) DeleteCommit(repoName string, commitID string) error {
@@ -690,6 +874,30 @@ func (c APIClient) getFile(repoName string, commitID string, path string, offset
 	)
 }
 
+// GetArchive writes an archive, in 'format', of the files and directories
+// in a Commit that match 'path' to 'writer'. 'path' may be a glob pattern, and
+// matching directories are archived with everything under them.
+func (c APIClient) GetArchive(repoName string, commitID string, path string, format pfs.ArchiveFormat, writer io.Writer) error {
+	if c.streamSemaphore != nil {
+		c.streamSemaphore <- struct{}{}
+		defer func() { <-c.streamSemaphore }()
+	}
+	apiGetArchiveClient, err := c.PfsAPIClient.GetArchive(
+		c.Ctx(),
+		&pfs.GetArchiveRequest{
+			File:   NewFile(repoName, commitID, path),
+			Format: format,
+		},
+	)
+	if err != nil {
+		return grpcutil.ScrubGRPC(err)
+	}
+	if err := grpcutil.WriteFromStreamingBytesClient(apiGetArchiveClient, writer); err != nil {
+		return grpcutil.ScrubGRPC(err)
+	}
+	return nil
+}
+
 // InspectFile returns info about a specific file.
 func (c APIClient) InspectFile(repoName string, commitID string, path string) (*pfs.FileInfo, error) {
 	return c.inspectFile(repoName, commitID, path)
@@ -759,6 +967,39 @@ func (c APIClient) GlobFile(repoName string, commitID string, pattern string) ([
 	return result, nil
 }
 
+// GrepFile calls 'f' with each line matching 'regex' in the files in a commit
+// that match the glob 'pattern'. The matches in each file are returned
+// together and in order, but files may be returned in any order. A
+// 'maxMatches' or 'maxBytes' of 0 means no limit. Once 'maxMatches' lines have
+// matched the search stops, while scanning more than 'maxBytes' bytes is an
+// error.
+func (c APIClient) GrepFile(repoName string, commitID string, pattern string, regex string, maxMatches int64, maxBytes int64, f func(*pfs.GrepFileResult) error) error {
+	grepClient, err := c.PfsAPIClient.GrepFile(
+		c.Ctx(),
+		&pfs.GrepFileRequest{
+			Commit:     NewCommit(repoName, commitID),
+			Pattern:    pattern,
+			Regex:      regex,
+			MaxMatches: maxMatches,
+			MaxBytes:   maxBytes,
+		},
+	)
+	if err != nil {
+		return grpcutil.ScrubGRPC(err)
+	}
+	for {
+		result, err := grepClient.Recv()
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return grpcutil.ScrubGRPC(err)
+		}
+		if err := f(result); err != nil {
+			return err
+		}
+	}
+}
+
 // DiffFile returns the difference between 2 paths, old path may be omitted in
 // which case the parent of the new path will be used. DiffFile return 2 values
 // (unless it returns an error) the first value is files present under new
@@ -6,6 +6,16 @@ import (
 	"encoding/hex"
 	"fmt"
 	"hash"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+const (
+	// TransactionKey is the key of the transaction ID in the metadata of
+	// requests made in a transaction
+	TransactionKey = "pach-transaction"
 )
 
 var (
@@ -13,6 +23,22 @@ var (
 	ChunkSize = int64(16 * 1024 * 1024) // 16 MB
 )
 
+// quotaExceededErrorMsg prefixes the messages of errors returned by PFS when
+// a write would exceed a storage quota. If edited, ErrQuotaExceeded in
+// src/server/pfs needs to be updated too.
+const quotaExceededErrorMsg = "quota exceeded"
+
+// IsQuotaExceededError returns true if 'err' was returned because a write
+// would have exceeded a repo's or a principal's storage quota. It works both
+// on raw GRPC errors (which have the code RESOURCE_EXHAUSTED) and on errors
+// that have been scrubbed by the client library.
+func IsQuotaExceededError(err error) bool {
+	if err == nil {
+		return false
+	}
+	return grpc.Code(err) == codes.ResourceExhausted || strings.HasPrefix(err.Error(), quotaExceededErrorMsg)
+}
+
 // FullID prints repoName/CommitID
 func (c *Commit) FullID() string {
 	return fmt.Sprintf("%s/%s", c.Repo.Name, c.ID)
@@ -34,3 +60,35 @@ func GetBlock(hash hash.Hash) *Block {
 		Hash: base64.URLEncoding.EncodeToString(hash.Sum(nil)),
 	}
 }
+
+// archiveFormats are the names of the archive formats, as given to
+// ParseArchiveFormat. They're also the formats' file extensions.
+var archiveFormats = map[string]ArchiveFormat{
+	"tar":    ArchiveFormat_TAR,
+	"tar.gz": ArchiveFormat_TAR_GZ,
+	"tgz":    ArchiveFormat_TAR_GZ,
+	"zip":    ArchiveFormat_ZIP,
+}
+
+// ParseArchiveFormat parses the name of an archive format: "tar", "tar.gz"
+// (or "tgz"), or "zip".
+func ParseArchiveFormat(s string) (ArchiveFormat, error) {
+	format, ok := archiveFormats[strings.ToLower(s)]
+	if !ok {
+		return 0, fmt.Errorf("unknown archive format %q, expected \"tar\", \"tar.gz\" or \"zip\"", s)
+	}
+	return format, nil
+}
+
+// Extension returns the file extension of archives in 'f', without a leading
+// dot.
+func (f ArchiveFormat) Extension() string {
+	switch f {
+	case ArchiveFormat_TAR_GZ:
+		return "tar.gz"
+	case ArchiveFormat_ZIP:
+		return "zip"
+	default:
+		return "tar"
+	}
+}
@@ -11,11 +11,16 @@
 		Repo
 		BranchInfo
 		BranchInfos
+		BranchMove
+		BranchHistory
 		File
 		Block
 		Object
 		Tag
 		RepoInfo
+		BranchProtection
+		RetentionPolicy
+		Quota
 		RepoAuthInfo
 		Commit
 		CommitInfo
@@ -28,6 +33,8 @@
 		ListRepoRequest
 		ListRepoResponse
 		DeleteRepoRequest
+		ForkRepoRequest
+		RenameRepoRequest
 		StartCommitRequest
 		BuildCommitRequest
 		FinishCommitRequest
@@ -37,18 +44,41 @@
 		ListBranchRequest
 		SetBranchRequest
 		DeleteBranchRequest
+		ListBranchHistoryRequest
+		SetBranchProtectionRequest
+		DeleteBranchProtectionRequest
+		SetRetentionPolicyRequest
+		ApplyRetentionPolicyRequest
+		ApplyRetentionPolicyResponse
+		SetQuotaRequest
+		CheckQuotaRequest
+		QuotaUsageRequest
+		Usage
+		RepoUsage
+		PrincipalUsage
+		QuotaUsageResponse
 		DeleteCommitRequest
 		FlushCommitRequest
 		SubscribeCommitRequest
 		GetFileRequest
+		GetArchiveRequest
 		OverwriteIndex
 		PutFileRequest
 		PutFileRecord
 		PutFileRecords
+		Transaction
+		TransactionInfo
+		TransactionRequest
+		TransactionPutFile
+		StartTransactionRequest
+		FinishTransactionRequest
+		DeleteTransactionRequest
 		CopyFileRequest
 		InspectFileRequest
 		ListFileRequest
 		GlobFileRequest
+		GrepFileRequest
+		GrepFileResult
 		FileInfos
 		DiffFileRequest
 		DiffFileResponse
@@ -76,6 +106,7 @@ import math "math"
 import google_protobuf "github.com/gogo/protobuf/types"
 import google_protobuf1 "github.com/gogo/protobuf/types"
 import google_protobuf2 "github.com/gogo/protobuf/types"
+import google_protobuf3 "github.com/gogo/protobuf/types"
 import _ "github.com/gogo/protobuf/gogoproto"
 import auth "github.com/pachyderm/pachyderm/src/client/auth"
 
@@ -121,6 +152,31 @@ func (x FileType) String() string {
 }
 func (FileType) EnumDescriptor() ([]byte, []int) { return fileDescriptorPfs, []int{0} }
 
+// ArchiveFormat is the format of the archives that GetArchive returns.
+type ArchiveFormat int32
+
+const (
+	ArchiveFormat_TAR    ArchiveFormat = 0
+	ArchiveFormat_TAR_GZ ArchiveFormat = 1
+	ArchiveFormat_ZIP    ArchiveFormat = 2
+)
+
+var ArchiveFormat_name = map[int32]string{
+	0: "TAR",
+	1: "TAR_GZ",
+	2: "ZIP",
+}
+var ArchiveFormat_value = map[string]int32{
+	"TAR":    0,
+	"TAR_GZ": 1,
+	"ZIP":    2,
+}
+
+func (x ArchiveFormat) String() string {
+	return proto.EnumName(ArchiveFormat_name, int32(x))
+}
+func (ArchiveFormat) EnumDescriptor() ([]byte, []int) { return fileDescriptorPfs, []int{1} }
+
 type Delimiter int32
 
 const (
@@ -143,7 +199,7 @@ var Delimiter_value = map[string]int32{
 func (x Delimiter) String() string {
 	return proto.EnumName(Delimiter_name, int32(x))
 }
-func (Delimiter) EnumDescriptor() ([]byte, []int) { return fileDescriptorPfs, []int{1} }
+func (Delimiter) EnumDescriptor() ([]byte, []int) { return fileDescriptorPfs, []int{2} }
 
 type Repo struct {
 	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
@@ -201,6 +257,87 @@ func (m *BranchInfos) GetBranchInfo() []*BranchInfo {
 	return nil
 }
 
+// BranchMove records one change to the head of a branch. Every request that
+// moves a branch (StartCommit and BuildCommit on the branch, SetBranch,
+// DeleteBranch, DeleteCommit of the head, and FinishTransaction) appends one
+// to the branch's history.
+type BranchMove struct {
+	Branch string `protobuf:"bytes,1,opt,name=branch,proto3" json:"branch,omitempty"`
+	// The head before the move. Unset if the move created the branch.
+	OldHead *Commit `protobuf:"bytes,2,opt,name=old_head,json=oldHead" json:"old_head,omitempty"`
+	// The head after the move. Unset if the move deleted the branch.
+	NewHead *Commit                     `protobuf:"bytes,3,opt,name=new_head,json=newHead" json:"new_head,omitempty"`
+	Time    *google_protobuf2.Timestamp `protobuf:"bytes,4,opt,name=time" json:"time,omitempty"`
+	// The principal who moved the branch, if auth was active at the time.
+	Principal string `protobuf:"bytes,5,opt,name=principal,proto3" json:"principal,omitempty"`
+	// The request that moved the branch, e.g. "SetBranch"
+	Operation string `protobuf:"bytes,6,opt,name=operation,proto3" json:"operation,omitempty"`
+}
+
+func (m *BranchMove) Reset()                    { *m = BranchMove{} }
+func (m *BranchMove) String() string            { return proto.CompactTextString(m) }
+func (*BranchMove) ProtoMessage()               {}
+func (*BranchMove) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{3} }
+
+func (m *BranchMove) GetBranch() string {
+	if m != nil {
+		return m.Branch
+	}
+	return ""
+}
+
+func (m *BranchMove) GetOldHead() *Commit {
+	if m != nil {
+		return m.OldHead
+	}
+	return nil
+}
+
+func (m *BranchMove) GetNewHead() *Commit {
+	if m != nil {
+		return m.NewHead
+	}
+	return nil
+}
+
+func (m *BranchMove) GetTime() *google_protobuf2.Timestamp {
+	if m != nil {
+		return m.Time
+	}
+	return nil
+}
+
+func (m *BranchMove) GetPrincipal() string {
+	if m != nil {
+		return m.Principal
+	}
+	return ""
+}
+
+func (m *BranchMove) GetOperation() string {
+	if m != nil {
+		return m.Operation
+	}
+	return ""
+}
+
+// BranchHistory is the moves of a branch, newest first.
+type BranchHistory struct {
+	Moves []*BranchMove `protobuf:"bytes,1,rep,name=moves" json:"moves,omitempty"`
+}
+
+func (m *BranchHistory) Reset()                    { *m = BranchHistory{} }
+func (m *BranchHistory) String() string            { return proto.CompactTextString(m) }
+func (*BranchHistory) ProtoMessage()               {}
+func (*BranchHistory) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{4} }
+
+func (m *BranchHistory) GetMoves() []*BranchMove {
+	if m != nil {
+		return m.Moves
+	}
+	return nil
+}
+
 type File struct {
 	Commit *Commit `protobuf:"bytes,1,opt,name=commit" json:"commit,omitempty"`
 	Path   string  `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
@@ -209,7 +346,7 @@ type File struct {
 func (m *File) Reset()                    { *m = File{} }
 func (m *File) String() string            { return proto.CompactTextString(m) }
 func (*File) ProtoMessage()               {}
-func (*File) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{3} }
+func (*File) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{5} }
 
 func (m *File) GetCommit() *Commit {
 	if m != nil {
@@ -232,7 +369,7 @@ type Block struct {
 func (m *Block) Reset()                    { *m = Block{} }
 func (m *Block) String() string            { return proto.CompactTextString(m) }
 func (*Block) ProtoMessage()               {}
-func (*Block) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{4} }
+func (*Block) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{6} }
 
 func (m *Block) GetHash() string {
 	if m != nil {
@@ -248,7 +385,7 @@ type Object struct {
 func (m *Object) Reset()                    { *m = Object{} }
 func (m *Object) String() string            { return proto.CompactTextString(m) }
 func (*Object) ProtoMessage()               {}
-func (*Object) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{5} }
+func (*Object) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{7} }
 
 func (m *Object) GetHash() string {
 	if m != nil {
@@ -264,7 +401,7 @@ type Tag struct {
 func (m *Tag) Reset()                    { *m = Tag{} }
 func (m *Tag) String() string            { return proto.CompactTextString(m) }
 func (*Tag) ProtoMessage()               {}
-func (*Tag) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{6} }
+func (*Tag) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{8} }
 
 func (m *Tag) GetName() string {
 	if m != nil {
@@ -276,7 +413,7 @@ func (m *Tag) GetName() string {
 // RepoInfo is the main data structure representing a Repo in etcd
 type RepoInfo struct {
 	Repo        *Repo                       `protobuf:"bytes,1,opt,name=repo" json:"repo,omitempty"`
-	Created     *google_protobuf1.Timestamp `protobuf:"bytes,2,opt,name=created" json:"created,omitempty"`
+	Created     *google_protobuf2.Timestamp `protobuf:"bytes,2,opt,name=created" json:"created,omitempty"`
 	SizeBytes   uint64                      `protobuf:"varint,3,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
 	Provenance  []*Repo                     `protobuf:"bytes,4,rep,name=provenance" json:"provenance,omitempty"`
 	Description string                      `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
@@ -284,12 +421,23 @@ type RepoInfo struct {
 	// not stored in etcd. To set a user's auth scope for a repo, use the
 	// Pachyderm Auth API (in src/client/auth/auth.proto)
 	AuthInfo *RepoAuthInfo `protobuf:"bytes,6,opt,name=auth_info,json=authInfo" json:"auth_info,omitempty"`
+	// The rules restricting how branches in this repo may be moved. Set with
+	// SetBranchProtection and removed with DeleteBranchProtection.
+	BranchProtections []*BranchProtection `protobuf:"bytes,7,rep,name=branch_protections,json=branchProtections" json:"branch_protections,omitempty"`
+	// The policy deciding which of this repo's commits pachd deletes
+	// automatically. Set with SetRetentionPolicy.
+	RetentionPolicy *RetentionPolicy `protobuf:"bytes,8,opt,name=retention_policy,json=retentionPolicy" json:"retention_policy,omitempty"`
+	// The limits on the data stored in this repo. Set with SetQuota.
+	Quota *Quota `protobuf:"bytes,9,opt,name=quota" json:"quota,omitempty"`
+	// The principal who created this repo, if auth was active at the time.
+	// Their quota (if any) applies to this repo.
+	CreatedBy string `protobuf:"bytes,10,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
 }
 
 func (m *RepoInfo) Reset()                    { *m = RepoInfo{} }
 func (m *RepoInfo) String() string            { return proto.CompactTextString(m) }
 func (*RepoInfo) ProtoMessage()               {}
-func (*RepoInfo) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{7} }
+func (*RepoInfo) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{9} }
 
 func (m *RepoInfo) GetRepo() *Repo {
 	if m != nil {
@@ -298,7 +446,7 @@ func (m *RepoInfo) GetRepo() *Repo {
 	return nil
 }
 
-func (m *RepoInfo) GetCreated() *google_protobuf1.Timestamp {
+func (m *RepoInfo) GetCreated() *google_protobuf2.Timestamp {
 	if m != nil {
 		return m.Created
 	}
@@ -333,6 +481,162 @@ func (m *RepoInfo) GetAuthInfo() *RepoAuthInfo {
 	return nil
 }
 
+func (m *RepoInfo) GetBranchProtections() []*BranchProtection {
+	if m != nil {
+		return m.BranchProtections
+	}
+	return nil
+}
+
+func (m *RepoInfo) GetRetentionPolicy() *RetentionPolicy {
+	if m != nil {
+		return m.RetentionPolicy
+	}
+	return nil
+}
+
+func (m *RepoInfo) GetQuota() *Quota {
+	if m != nil {
+		return m.Quota
+	}
+	return nil
+}
+
+func (m *RepoInfo) GetCreatedBy() string {
+	if m != nil {
+		return m.CreatedBy
+	}
+	return ""
+}
+
+// BranchProtection restricts who may move a branch and which commits the
+// branch may be moved to. It's enforced by SetBranch, and by StartCommit and
+// FinishCommit for commits made directly on the branch.
+type BranchProtection struct {
+	Branch string `protobuf:"bytes,1,opt,name=branch,proto3" json:"branch,omitempty"`
+	// If set, only these principals (and cluster admins) may move the branch.
+	AllowedPrincipals []string `protobuf:"bytes,2,rep,name=allowed_principals,json=allowedPrincipals" json:"allowed_principals,omitempty"`
+	// If true, the branch may only be moved to descendants of its current head.
+	FastForwardOnly bool `protobuf:"varint,3,opt,name=fast_forward_only,json=fastForwardOnly,proto3" json:"fast_forward_only,omitempty"`
+	// If set, the branch may only be moved to a commit once the named pipeline
+	// has produced a finished output commit with that commit in its provenance.
+	RequiredPipeline string `protobuf:"bytes,4,opt,name=required_pipeline,json=requiredPipeline,proto3" json:"required_pipeline,omitempty"`
+}
+
+func (m *BranchProtection) Reset()                    { *m = BranchProtection{} }
+func (m *BranchProtection) String() string            { return proto.CompactTextString(m) }
+func (*BranchProtection) ProtoMessage()               {}
+func (*BranchProtection) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{10} }
+
+func (m *BranchProtection) GetBranch() string {
+	if m != nil {
+		return m.Branch
+	}
+	return ""
+}
+
+func (m *BranchProtection) GetAllowedPrincipals() []string {
+	if m != nil {
+		return m.AllowedPrincipals
+	}
+	return nil
+}
+
+func (m *BranchProtection) GetFastForwardOnly() bool {
+	if m != nil {
+		return m.FastForwardOnly
+	}
+	return false
+}
+
+func (m *BranchProtection) GetRequiredPipeline() string {
+	if m != nil {
+		return m.RequiredPipeline
+	}
+	return ""
+}
+
+// RetentionPolicy decides which finished commits in a repo are kept. A commit
+// is kept if any of the set fields keeps it; all other commits are deleted
+// by pachd, unless they're the head of a branch, are open, or are in the
+// provenance of a downstream commit. Because each commit holds the complete
+// state of the repo, deleting a commit effectively squashes it into its
+// children.
+type RetentionPolicy struct {
+	// Keep the 'keep_last' most recent commits.
+	KeepLast uint64 `protobuf:"varint,1,opt,name=keep_last,json=keepLast,proto3" json:"keep_last,omitempty"`
+	// Keep commits started less than 'keep_newer_than' ago (i.e. commits
+	// expire once they're this old).
+	KeepNewerThan *google_protobuf.Duration `protobuf:"bytes,2,opt,name=keep_newer_than,json=keepNewerThan" json:"keep_newer_than,omitempty"`
+	// Keep the most recent commit of each of the last 'keep_daily' days that
+	// have commits.
+	KeepDaily uint64 `protobuf:"varint,3,opt,name=keep_daily,json=keepDaily,proto3" json:"keep_daily,omitempty"`
+	// Keep the most recent commit of each of the last 'keep_weekly' weeks that
+	// have commits.
+	KeepWeekly uint64 `protobuf:"varint,4,opt,name=keep_weekly,json=keepWeekly,proto3" json:"keep_weekly,omitempty"`
+}
+
+func (m *RetentionPolicy) Reset()                    { *m = RetentionPolicy{} }
+func (m *RetentionPolicy) String() string            { return proto.CompactTextString(m) }
+func (*RetentionPolicy) ProtoMessage()               {}
+func (*RetentionPolicy) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{11} }
+
+func (m *RetentionPolicy) GetKeepLast() uint64 {
+	if m != nil {
+		return m.KeepLast
+	}
+	return 0
+}
+
+func (m *RetentionPolicy) GetKeepNewerThan() *google_protobuf.Duration {
+	if m != nil {
+		return m.KeepNewerThan
+	}
+	return nil
+}
+
+func (m *RetentionPolicy) GetKeepDaily() uint64 {
+	if m != nil {
+		return m.KeepDaily
+	}
+	return 0
+}
+
+func (m *RetentionPolicy) GetKeepWeekly() uint64 {
+	if m != nil {
+		return m.KeepWeekly
+	}
+	return 0
+}
+
+// Quota limits the data stored in a repo, or in all of the repos created by a
+// principal. A zero field means that there's no limit.
+type Quota struct {
+	// The maximum logical size (see RepoInfo.size_bytes) in bytes
+	MaxBytes uint64 `protobuf:"varint,1,opt,name=max_bytes,json=maxBytes,proto3" json:"max_bytes,omitempty"`
+	// The maximum number of commits
+	MaxCommits uint64 `protobuf:"varint,2,opt,name=max_commits,json=maxCommits,proto3" json:"max_commits,omitempty"`
+}
+
+func (m *Quota) Reset()                    { *m = Quota{} }
+func (m *Quota) String() string            { return proto.CompactTextString(m) }
+func (*Quota) ProtoMessage()               {}
+func (*Quota) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{12} }
+
+func (m *Quota) GetMaxBytes() uint64 {
+	if m != nil {
+		return m.MaxBytes
+	}
+	return 0
+}
+
+func (m *Quota) GetMaxCommits() uint64 {
+	if m != nil {
+		return m.MaxCommits
+	}
+	return 0
+}
+
 // RepoAuthInfo includes the caller's access scope for a repo, and is returned
 // by ListRepo and InspectRepo but not persisted in etcd. It's used by the
 // Pachyderm dashboard to render repo access appropriately. To set a user's auth
@@ -346,7 +650,7 @@ type RepoAuthInfo struct {
 func (m *RepoAuthInfo) Reset()                    { *m = RepoAuthInfo{} }
 func (m *RepoAuthInfo) String() string            { return proto.CompactTextString(m) }
 func (*RepoAuthInfo) ProtoMessage()               {}
-func (*RepoAuthInfo) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{8} }
+func (*RepoAuthInfo) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{13} }
 
 func (m *RepoAuthInfo) GetAccessLevel() auth.Scope {
 	if m != nil {
@@ -366,7 +670,7 @@ type Commit struct {
 func (m *Commit) Reset()                    { *m = Commit{} }
 func (m *Commit) String() string            { return proto.CompactTextString(m) }
 func (*Commit) ProtoMessage()               {}
-func (*Commit) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{9} }
+func (*Commit) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{14} }
 
 func (m *Commit) GetRepo() *Repo {
 	if m != nil {
@@ -386,8 +690,8 @@ func (m *Commit) GetID() string {
 type CommitInfo struct {
 	Commit       *Commit                     `protobuf:"bytes,1,opt,name=commit" json:"commit,omitempty"`
 	ParentCommit *Commit                     `protobuf:"bytes,2,opt,name=parent_commit,json=parentCommit" json:"parent_commit,omitempty"`
-	Started      *google_protobuf1.Timestamp `protobuf:"bytes,3,opt,name=started" json:"started,omitempty"`
-	Finished     *google_protobuf1.Timestamp `protobuf:"bytes,4,opt,name=finished" json:"finished,omitempty"`
+	Started      *google_protobuf2.Timestamp `protobuf:"bytes,3,opt,name=started" json:"started,omitempty"`
+	Finished     *google_protobuf2.Timestamp `protobuf:"bytes,4,opt,name=finished" json:"finished,omitempty"`
 	SizeBytes    uint64                      `protobuf:"varint,5,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
 	Provenance   []*Commit                   `protobuf:"bytes,6,rep,name=provenance" json:"provenance,omitempty"`
 	// this is the block that stores the serialized form of a tree that
@@ -398,7 +702,7 @@ type CommitInfo struct {
 func (m *CommitInfo) Reset()                    { *m = CommitInfo{} }
 func (m *CommitInfo) String() string            { return proto.CompactTextString(m) }
 func (*CommitInfo) ProtoMessage()               {}
-func (*CommitInfo) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{10} }
+func (*CommitInfo) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{15} }
 
 func (m *CommitInfo) GetCommit() *Commit {
 	if m != nil {
@@ -414,14 +718,14 @@ func (m *CommitInfo) GetParentCommit() *Commit {
 	return nil
 }
 
-func (m *CommitInfo) GetStarted() *google_protobuf1.Timestamp {
+func (m *CommitInfo) GetStarted() *google_protobuf2.Timestamp {
 	if m != nil {
 		return m.Started
 	}
 	return nil
 }
 
-func (m *CommitInfo) GetFinished() *google_protobuf1.Timestamp {
+func (m *CommitInfo) GetFinished() *google_protobuf2.Timestamp {
 	if m != nil {
 		return m.Finished
 	}
@@ -463,7 +767,7 @@ type FileInfo struct {
 func (m *FileInfo) Reset()                    { *m = FileInfo{} }
 func (m *FileInfo) String() string            { return proto.CompactTextString(m) }
 func (*FileInfo) ProtoMessage()               {}
-func (*FileInfo) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{11} }
+func (*FileInfo) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{16} }
 
 func (m *FileInfo) GetFile() *File {
 	if m != nil {
@@ -515,7 +819,7 @@ type ByteRange struct {
 func (m *ByteRange) Reset()                    { *m = ByteRange{} }
 func (m *ByteRange) String() string            { return proto.CompactTextString(m) }
 func (*ByteRange) ProtoMessage()               {}
-func (*ByteRange) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{12} }
+func (*ByteRange) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{17} }
 
 func (m *ByteRange) GetLower() uint64 {
 	if m != nil {
@@ -539,7 +843,7 @@ type BlockRef struct {
 func (m *BlockRef) Reset()                    { *m = BlockRef{} }
 func (m *BlockRef) String() string            { return proto.CompactTextString(m) }
 func (*BlockRef) ProtoMessage()               {}
-func (*BlockRef) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{13} }
+func (*BlockRef) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{18} }
 
 func (m *BlockRef) GetBlock() *Block {
 	if m != nil {
@@ -563,7 +867,7 @@ type ObjectInfo struct {
 func (m *ObjectInfo) Reset()                    { *m = ObjectInfo{} }
 func (m *ObjectInfo) String() string            { return proto.CompactTextString(m) }
 func (*ObjectInfo) ProtoMessage()               {}
-func (*ObjectInfo) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{14} }
+func (*ObjectInfo) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{19} }
 
 func (m *ObjectInfo) GetObject() *Object {
 	if m != nil {
@@ -589,7 +893,7 @@ type CreateRepoRequest struct {
 func (m *CreateRepoRequest) Reset()                    { *m = CreateRepoRequest{} }
 func (m *CreateRepoRequest) String() string            { return proto.CompactTextString(m) }
 func (*CreateRepoRequest) ProtoMessage()               {}
-func (*CreateRepoRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{15} }
+func (*CreateRepoRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{20} }
 
 func (m *CreateRepoRequest) GetRepo() *Repo {
 	if m != nil {
@@ -626,7 +930,7 @@ type InspectRepoRequest struct {
 func (m *InspectRepoRequest) Reset()                    { *m = InspectRepoRequest{} }
 func (m *InspectRepoRequest) String() string            { return proto.CompactTextString(m) }
 func (*InspectRepoRequest) ProtoMessage()               {}
-func (*InspectRepoRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{16} }
+func (*InspectRepoRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{21} }
 
 func (m *InspectRepoRequest) GetRepo() *Repo {
 	if m != nil {
@@ -642,7 +946,7 @@ type ListRepoRequest struct {
 func (m *ListRepoRequest) Reset()                    { *m = ListRepoRequest{} }
 func (m *ListRepoRequest) String() string            { return proto.CompactTextString(m) }
 func (*ListRepoRequest) ProtoMessage()               {}
-func (*ListRepoRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{17} }
+func (*ListRepoRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{22} }
 
 func (m *ListRepoRequest) GetProvenance() []*Repo {
 	if m != nil {
@@ -658,7 +962,7 @@ type ListRepoResponse struct {
 func (m *ListRepoResponse) Reset()                    { *m = ListRepoResponse{} }
 func (m *ListRepoResponse) String() string            { return proto.CompactTextString(m) }
 func (*ListRepoResponse) ProtoMessage()               {}
-func (*ListRepoResponse) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{18} }
+func (*ListRepoResponse) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{23} }
 
 func (m *ListRepoResponse) GetRepoInfo() []*RepoInfo {
 	if m != nil {
@@ -676,7 +980,7 @@ type DeleteRepoRequest struct {
 func (m *DeleteRepoRequest) Reset()                    { *m = DeleteRepoRequest{} }
 func (m *DeleteRepoRequest) String() string            { return proto.CompactTextString(m) }
 func (*DeleteRepoRequest) ProtoMessage()               {}
-func (*DeleteRepoRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{19} }
+func (*DeleteRepoRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{24} }
 
 func (m *DeleteRepoRequest) GetRepo() *Repo {
 	if m != nil {
@@ -699,6 +1003,64 @@ func (m *DeleteRepoRequest) GetAll() bool {
 	return false
 }
 
+type ForkRepoRequest struct {
+	// The repo to fork
+	Repo *Repo `protobuf:"bytes,1,opt,name=repo" json:"repo,omitempty"`
+	// The repo to create. It must not exist.
+	Fork        *Repo  `protobuf:"bytes,2,opt,name=fork" json:"fork,omitempty"`
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+}
+
+func (m *ForkRepoRequest) Reset()                    { *m = ForkRepoRequest{} }
+func (m *ForkRepoRequest) String() string            { return proto.CompactTextString(m) }
+func (*ForkRepoRequest) ProtoMessage()               {}
+func (*ForkRepoRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{25} }
+
+func (m *ForkRepoRequest) GetRepo() *Repo {
+	if m != nil {
+		return m.Repo
+	}
+	return nil
+}
+
+func (m *ForkRepoRequest) GetFork() *Repo {
+	if m != nil {
+		return m.Fork
+	}
+	return nil
+}
+
+func (m *ForkRepoRequest) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+type RenameRepoRequest struct {
+	Repo    *Repo `protobuf:"bytes,1,opt,name=repo" json:"repo,omitempty"`
+	NewRepo *Repo `protobuf:"bytes,2,opt,name=new_repo,json=newRepo" json:"new_repo,omitempty"`
+}
+
+func (m *RenameRepoRequest) Reset()                    { *m = RenameRepoRequest{} }
+func (m *RenameRepoRequest) String() string            { return proto.CompactTextString(m) }
+func (*RenameRepoRequest) ProtoMessage()               {}
+func (*RenameRepoRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{26} }
+
+func (m *RenameRepoRequest) GetRepo() *Repo {
+	if m != nil {
+		return m.Repo
+	}
+	return nil
+}
+
+func (m *RenameRepoRequest) GetNewRepo() *Repo {
+	if m != nil {
+		return m.NewRepo
+	}
+	return nil
+}
+
 type StartCommitRequest struct {
 	// Parent.ID may be empty in which case the commit that Branch points to will be used as the parent.
 	// If branch is empty, or if branch does not exist, the commit will have no parent.
@@ -710,7 +1072,7 @@ type StartCommitRequest struct {
 func (m *StartCommitRequest) Reset()                    { *m = StartCommitRequest{} }
 func (m *StartCommitRequest) String() string            { return proto.CompactTextString(m) }
 func (*StartCommitRequest) ProtoMessage()               {}
-func (*StartCommitRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{20} }
+func (*StartCommitRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{27} }
 
 func (m *StartCommitRequest) GetParent() *Commit {
 	if m != nil {
@@ -743,7 +1105,7 @@ type BuildCommitRequest struct {
 func (m *BuildCommitRequest) Reset()                    { *m = BuildCommitRequest{} }
 func (m *BuildCommitRequest) String() string            { return proto.CompactTextString(m) }
 func (*BuildCommitRequest) ProtoMessage()               {}
-func (*BuildCommitRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{21} }
+func (*BuildCommitRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{28} }
 
 func (m *BuildCommitRequest) GetParent() *Commit {
 	if m != nil {
@@ -780,7 +1142,7 @@ type FinishCommitRequest struct {
 func (m *FinishCommitRequest) Reset()                    { *m = FinishCommitRequest{} }
 func (m *FinishCommitRequest) String() string            { return proto.CompactTextString(m) }
 func (*FinishCommitRequest) ProtoMessage()               {}
-func (*FinishCommitRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{22} }
+func (*FinishCommitRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{29} }
 
 func (m *FinishCommitRequest) GetCommit() *Commit {
 	if m != nil {
@@ -796,7 +1158,7 @@ type InspectCommitRequest struct {
 func (m *InspectCommitRequest) Reset()                    { *m = InspectCommitRequest{} }
 func (m *InspectCommitRequest) String() string            { return proto.CompactTextString(m) }
 func (*InspectCommitRequest) ProtoMessage()               {}
-func (*InspectCommitRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{23} }
+func (*InspectCommitRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{30} }
 
 func (m *InspectCommitRequest) GetCommit() *Commit {
 	if m != nil {
@@ -815,7 +1177,7 @@ type ListCommitRequest struct {
 func (m *ListCommitRequest) Reset()                    { *m = ListCommitRequest{} }
 func (m *ListCommitRequest) String() string            { return proto.CompactTextString(m) }
 func (*ListCommitRequest) ProtoMessage()               {}
-func (*ListCommitRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{24} }
+func (*ListCommitRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{31} }
 
 func (m *ListCommitRequest) GetRepo() *Repo {
 	if m != nil {
@@ -852,7 +1214,7 @@ type CommitInfos struct {
 func (m *CommitInfos) Reset()                    { *m = CommitInfos{} }
 func (m *CommitInfos) String() string            { return proto.CompactTextString(m) }
 func (*CommitInfos) ProtoMessage()               {}
-func (*CommitInfos) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{25} }
+func (*CommitInfos) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{32} }
 
 func (m *CommitInfos) GetCommitInfo() []*CommitInfo {
 	if m != nil {
@@ -868,7 +1230,7 @@ type ListBranchRequest struct {
 func (m *ListBranchRequest) Reset()                    { *m = ListBranchRequest{} }
 func (m *ListBranchRequest) String() string            { return proto.CompactTextString(m) }
 func (*ListBranchRequest) ProtoMessage()               {}
-func (*ListBranchRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{26} }
+func (*ListBranchRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{33} }
 
 func (m *ListBranchRequest) GetRepo() *Repo {
 	if m != nil {
@@ -885,7 +1247,7 @@ type SetBranchRequest struct {
 func (m *SetBranchRequest) Reset()                    { *m = SetBranchRequest{} }
 func (m *SetBranchRequest) String() string            { return proto.CompactTextString(m) }
 func (*SetBranchRequest) ProtoMessage()               {}
-func (*SetBranchRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{27} }
+func (*SetBranchRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{34} }
 
 func (m *SetBranchRequest) GetCommit() *Commit {
 	if m != nil {
@@ -909,7 +1271,7 @@ type DeleteBranchRequest struct {
 func (m *DeleteBranchRequest) Reset()                    { *m = DeleteBranchRequest{} }
 func (m *DeleteBranchRequest) String() string            { return proto.CompactTextString(m) }
 func (*DeleteBranchRequest) ProtoMessage()               {}
-func (*DeleteBranchRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{28} }
+func (*DeleteBranchRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{35} }
 
 func (m *DeleteBranchRequest) GetRepo() *Repo {
 	if m != nil {
@@ -925,2032 +1287,1935 @@ func (m *DeleteBranchRequest) GetBranch() string {
 	return ""
 }
 
-type DeleteCommitRequest struct {
-	Commit *Commit `protobuf:"bytes,1,opt,name=commit" json:"commit,omitempty"`
+type ListBranchHistoryRequest struct {
+	Repo   *Repo  `protobuf:"bytes,1,opt,name=repo" json:"repo,omitempty"`
+	Branch string `protobuf:"bytes,2,opt,name=branch,proto3" json:"branch,omitempty"`
+	// The number of moves to return, newest first. 0 means all of them.
+	Number uint64 `protobuf:"varint,3,opt,name=number,proto3" json:"number,omitempty"`
 }
 
-func (m *DeleteCommitRequest) Reset()                    { *m = DeleteCommitRequest{} }
-func (m *DeleteCommitRequest) String() string            { return proto.CompactTextString(m) }
-func (*DeleteCommitRequest) ProtoMessage()               {}
-func (*DeleteCommitRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{29} }
+func (m *ListBranchHistoryRequest) Reset()                    { *m = ListBranchHistoryRequest{} }
+func (m *ListBranchHistoryRequest) String() string            { return proto.CompactTextString(m) }
+func (*ListBranchHistoryRequest) ProtoMessage()               {}
+func (*ListBranchHistoryRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{36} }
 
-func (m *DeleteCommitRequest) GetCommit() *Commit {
+func (m *ListBranchHistoryRequest) GetRepo() *Repo {
 	if m != nil {
-		return m.Commit
+		return m.Repo
 	}
 	return nil
 }
 
-type FlushCommitRequest struct {
-	Commits []*Commit `protobuf:"bytes,1,rep,name=commits" json:"commits,omitempty"`
-	ToRepos []*Repo   `protobuf:"bytes,2,rep,name=to_repos,json=toRepos" json:"to_repos,omitempty"`
+func (m *ListBranchHistoryRequest) GetBranch() string {
+	if m != nil {
+		return m.Branch
+	}
+	return ""
 }
 
-func (m *FlushCommitRequest) Reset()                    { *m = FlushCommitRequest{} }
-func (m *FlushCommitRequest) String() string            { return proto.CompactTextString(m) }
-func (*FlushCommitRequest) ProtoMessage()               {}
-func (*FlushCommitRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{30} }
+func (m *ListBranchHistoryRequest) GetNumber() uint64 {
+	if m != nil {
+		return m.Number
+	}
+	return 0
+}
 
-func (m *FlushCommitRequest) GetCommits() []*Commit {
+type SetBranchProtectionRequest struct {
+	Repo       *Repo             `protobuf:"bytes,1,opt,name=repo" json:"repo,omitempty"`
+	Protection *BranchProtection `protobuf:"bytes,2,opt,name=protection" json:"protection,omitempty"`
+}
+
+func (m *SetBranchProtectionRequest) Reset()                    { *m = SetBranchProtectionRequest{} }
+func (m *SetBranchProtectionRequest) String() string            { return proto.CompactTextString(m) }
+func (*SetBranchProtectionRequest) ProtoMessage()               {}
+func (*SetBranchProtectionRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{37} }
+
+func (m *SetBranchProtectionRequest) GetRepo() *Repo {
 	if m != nil {
-		return m.Commits
+		return m.Repo
 	}
 	return nil
 }
 
-func (m *FlushCommitRequest) GetToRepos() []*Repo {
+func (m *SetBranchProtectionRequest) GetProtection() *BranchProtection {
 	if m != nil {
-		return m.ToRepos
+		return m.Protection
 	}
 	return nil
 }
 
-type SubscribeCommitRequest struct {
+type DeleteBranchProtectionRequest struct {
 	Repo   *Repo  `protobuf:"bytes,1,opt,name=repo" json:"repo,omitempty"`
 	Branch string `protobuf:"bytes,2,opt,name=branch,proto3" json:"branch,omitempty"`
-	// only commits created since this commit are returned
-	From *Commit `protobuf:"bytes,3,opt,name=from" json:"from,omitempty"`
 }
 
-func (m *SubscribeCommitRequest) Reset()                    { *m = SubscribeCommitRequest{} }
-func (m *SubscribeCommitRequest) String() string            { return proto.CompactTextString(m) }
-func (*SubscribeCommitRequest) ProtoMessage()               {}
-func (*SubscribeCommitRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{31} }
+func (m *DeleteBranchProtectionRequest) Reset()         { *m = DeleteBranchProtectionRequest{} }
+func (m *DeleteBranchProtectionRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteBranchProtectionRequest) ProtoMessage()    {}
+func (*DeleteBranchProtectionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptorPfs, []int{38}
+}
 
-func (m *SubscribeCommitRequest) GetRepo() *Repo {
+func (m *DeleteBranchProtectionRequest) GetRepo() *Repo {
 	if m != nil {
 		return m.Repo
 	}
 	return nil
 }
 
-func (m *SubscribeCommitRequest) GetBranch() string {
+func (m *DeleteBranchProtectionRequest) GetBranch() string {
 	if m != nil {
 		return m.Branch
 	}
 	return ""
 }
 
-func (m *SubscribeCommitRequest) GetFrom() *Commit {
+type SetRetentionPolicyRequest struct {
+	Repo *Repo `protobuf:"bytes,1,opt,name=repo" json:"repo,omitempty"`
+	// If unset, the repo's retention policy is removed
+	Policy *RetentionPolicy `protobuf:"bytes,2,opt,name=policy" json:"policy,omitempty"`
+}
+
+func (m *SetRetentionPolicyRequest) Reset()                    { *m = SetRetentionPolicyRequest{} }
+func (m *SetRetentionPolicyRequest) String() string            { return proto.CompactTextString(m) }
+func (*SetRetentionPolicyRequest) ProtoMessage()               {}
+func (*SetRetentionPolicyRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{39} }
+
+func (m *SetRetentionPolicyRequest) GetRepo() *Repo {
 	if m != nil {
-		return m.From
+		return m.Repo
 	}
 	return nil
 }
 
-type GetFileRequest struct {
-	File        *File `protobuf:"bytes,1,opt,name=file" json:"file,omitempty"`
-	OffsetBytes int64 `protobuf:"varint,2,opt,name=offset_bytes,json=offsetBytes,proto3" json:"offset_bytes,omitempty"`
-	SizeBytes   int64 `protobuf:"varint,3,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+func (m *SetRetentionPolicyRequest) GetPolicy() *RetentionPolicy {
+	if m != nil {
+		return m.Policy
+	}
+	return nil
 }
 
-func (m *GetFileRequest) Reset()                    { *m = GetFileRequest{} }
-func (m *GetFileRequest) String() string            { return proto.CompactTextString(m) }
-func (*GetFileRequest) ProtoMessage()               {}
-func (*GetFileRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{32} }
+type ApplyRetentionPolicyRequest struct {
+	Repo *Repo `protobuf:"bytes,1,opt,name=repo" json:"repo,omitempty"`
+	// If true, report the commits that would be deleted without deleting them
+	DryRun bool `protobuf:"varint,2,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+}
 
-func (m *GetFileRequest) GetFile() *File {
+func (m *ApplyRetentionPolicyRequest) Reset()                    { *m = ApplyRetentionPolicyRequest{} }
+func (m *ApplyRetentionPolicyRequest) String() string            { return proto.CompactTextString(m) }
+func (*ApplyRetentionPolicyRequest) ProtoMessage()               {}
+func (*ApplyRetentionPolicyRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{40} }
+
+func (m *ApplyRetentionPolicyRequest) GetRepo() *Repo {
 	if m != nil {
-		return m.File
+		return m.Repo
 	}
 	return nil
 }
 
-func (m *GetFileRequest) GetOffsetBytes() int64 {
+func (m *ApplyRetentionPolicyRequest) GetDryRun() bool {
 	if m != nil {
-		return m.OffsetBytes
+		return m.DryRun
 	}
-	return 0
+	return false
 }
 
-func (m *GetFileRequest) GetSizeBytes() int64 {
+type ApplyRetentionPolicyResponse struct {
+	Deleted []*Commit `protobuf:"bytes,1,rep,name=deleted" json:"deleted,omitempty"`
+}
+
+func (m *ApplyRetentionPolicyResponse) Reset()         { *m = ApplyRetentionPolicyResponse{} }
+func (m *ApplyRetentionPolicyResponse) String() string { return proto.CompactTextString(m) }
+func (*ApplyRetentionPolicyResponse) ProtoMessage()    {}
+func (*ApplyRetentionPolicyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptorPfs, []int{41}
+}
+
+func (m *ApplyRetentionPolicyResponse) GetDeleted() []*Commit {
 	if m != nil {
-		return m.SizeBytes
+		return m.Deleted
 	}
-	return 0
+	return nil
 }
 
-// An OverwriteIndex specifies the index of objects from which new writes
-// are applied to.  Existing objects starting from the index are deleted.
-// We want a separate message for ObjectIndex because we want to be able to
-// distinguish between a zero index and a non-existent index.
-type OverwriteIndex struct {
-	Index int64 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+// SetQuotaRequest sets the quota of either a repo or a principal.
+type SetQuotaRequest struct {
+	Repo      *Repo  `protobuf:"bytes,1,opt,name=repo" json:"repo,omitempty"`
+	Principal string `protobuf:"bytes,2,opt,name=principal,proto3" json:"principal,omitempty"`
+	// If unset, the repo's or principal's quota is removed
+	Quota *Quota `protobuf:"bytes,3,opt,name=quota" json:"quota,omitempty"`
 }
 
-func (m *OverwriteIndex) Reset()                    { *m = OverwriteIndex{} }
-func (m *OverwriteIndex) String() string            { return proto.CompactTextString(m) }
-func (*OverwriteIndex) ProtoMessage()               {}
-func (*OverwriteIndex) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{33} }
+func (m *SetQuotaRequest) Reset()                    { *m = SetQuotaRequest{} }
+func (m *SetQuotaRequest) String() string            { return proto.CompactTextString(m) }
+func (*SetQuotaRequest) ProtoMessage()               {}
+func (*SetQuotaRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{42} }
 
-func (m *OverwriteIndex) GetIndex() int64 {
+func (m *SetQuotaRequest) GetRepo() *Repo {
 	if m != nil {
-		return m.Index
+		return m.Repo
 	}
-	return 0
+	return nil
 }
 
-type PutFileRequest struct {
-	File  *File  `protobuf:"bytes,1,opt,name=file" json:"file,omitempty"`
-	Value []byte `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
-	Url   string `protobuf:"bytes,5,opt,name=url,proto3" json:"url,omitempty"`
-	// applies only to URLs that can be recursively walked, for example s3:// URLs
-	Recursive bool `protobuf:"varint,6,opt,name=recursive,proto3" json:"recursive,omitempty"`
-	// Delimiter causes data to be broken up into separate files with File.Path
-	// as a prefix.
-	Delimiter Delimiter `protobuf:"varint,7,opt,name=delimiter,proto3,enum=pfs.Delimiter" json:"delimiter,omitempty"`
-	// TargetFileDatums specifies the target number of datums in each written
-	// file it may be lower if data does not split evenly, but will never be
-	// higher, unless the value is 0.
-	TargetFileDatums int64 `protobuf:"varint,8,opt,name=target_file_datums,json=targetFileDatums,proto3" json:"target_file_datums,omitempty"`
-	// TargetFileBytes specifies the target number of bytes in each written
-	// file, files may have more or fewer bytes than the target.
-	TargetFileBytes int64 `protobuf:"varint,9,opt,name=target_file_bytes,json=targetFileBytes,proto3" json:"target_file_bytes,omitempty"`
-	// overwrite_index is the object index where the write starts from.  All
-	// existing objects starting from the index are deleted.
-	OverwriteIndex *OverwriteIndex `protobuf:"bytes,10,opt,name=overwrite_index,json=overwriteIndex" json:"overwrite_index,omitempty"`
+func (m *SetQuotaRequest) GetPrincipal() string {
+	if m != nil {
+		return m.Principal
+	}
+	return ""
 }
 
-func (m *PutFileRequest) Reset()                    { *m = PutFileRequest{} }
-func (m *PutFileRequest) String() string            { return proto.CompactTextString(m) }
-func (*PutFileRequest) ProtoMessage()               {}
-func (*PutFileRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{34} }
-
-func (m *PutFileRequest) GetFile() *File {
+func (m *SetQuotaRequest) GetQuota() *Quota {
 	if m != nil {
-		return m.File
+		return m.Quota
 	}
 	return nil
 }
 
-func (m *PutFileRequest) GetValue() []byte {
+type CheckQuotaRequest struct {
+	Repo *Repo `protobuf:"bytes,1,opt,name=repo" json:"repo,omitempty"`
+	// The bytes and commits that the caller is about to add to 'repo'
+	AdditionalBytes   uint64 `protobuf:"varint,2,opt,name=additional_bytes,json=additionalBytes,proto3" json:"additional_bytes,omitempty"`
+	AdditionalCommits uint64 `protobuf:"varint,3,opt,name=additional_commits,json=additionalCommits,proto3" json:"additional_commits,omitempty"`
+}
+
+func (m *CheckQuotaRequest) Reset()                    { *m = CheckQuotaRequest{} }
+func (m *CheckQuotaRequest) String() string            { return proto.CompactTextString(m) }
+func (*CheckQuotaRequest) ProtoMessage()               {}
+func (*CheckQuotaRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{43} }
+
+func (m *CheckQuotaRequest) GetRepo() *Repo {
 	if m != nil {
-		return m.Value
+		return m.Repo
 	}
 	return nil
 }
 
-func (m *PutFileRequest) GetUrl() string {
+func (m *CheckQuotaRequest) GetAdditionalBytes() uint64 {
 	if m != nil {
-		return m.Url
+		return m.AdditionalBytes
 	}
-	return ""
+	return 0
 }
 
-func (m *PutFileRequest) GetRecursive() bool {
+func (m *CheckQuotaRequest) GetAdditionalCommits() uint64 {
 	if m != nil {
-		return m.Recursive
+		return m.AdditionalCommits
 	}
-	return false
+	return 0
 }
 
-func (m *PutFileRequest) GetDelimiter() Delimiter {
+type QuotaUsageRequest struct {
+}
+
+func (m *QuotaUsageRequest) Reset()                    { *m = QuotaUsageRequest{} }
+func (m *QuotaUsageRequest) String() string            { return proto.CompactTextString(m) }
+func (*QuotaUsageRequest) ProtoMessage()               {}
+func (*QuotaUsageRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{44} }
+
+// Usage is the storage used by a repo or a principal. Logical bytes count
+// each file in each commit once, as RepoInfo.size_bytes does, while
+// deduplicated bytes count each distinct file content once, however many
+// commits or repos it appears in.
+type Usage struct {
+	LogicalBytes      uint64 `protobuf:"varint,1,opt,name=logical_bytes,json=logicalBytes,proto3" json:"logical_bytes,omitempty"`
+	DeduplicatedBytes uint64 `protobuf:"varint,2,opt,name=deduplicated_bytes,json=deduplicatedBytes,proto3" json:"deduplicated_bytes,omitempty"`
+	Commits           uint64 `protobuf:"varint,3,opt,name=commits,proto3" json:"commits,omitempty"`
+	Quota             *Quota `protobuf:"bytes,4,opt,name=quota" json:"quota,omitempty"`
+}
+
+func (m *Usage) Reset()                    { *m = Usage{} }
+func (m *Usage) String() string            { return proto.CompactTextString(m) }
+func (*Usage) ProtoMessage()               {}
+func (*Usage) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{45} }
+
+func (m *Usage) GetLogicalBytes() uint64 {
 	if m != nil {
-		return m.Delimiter
+		return m.LogicalBytes
 	}
-	return Delimiter_NONE
+	return 0
 }
 
-func (m *PutFileRequest) GetTargetFileDatums() int64 {
+func (m *Usage) GetDeduplicatedBytes() uint64 {
 	if m != nil {
-		return m.TargetFileDatums
+		return m.DeduplicatedBytes
 	}
 	return 0
 }
 
-func (m *PutFileRequest) GetTargetFileBytes() int64 {
+func (m *Usage) GetCommits() uint64 {
 	if m != nil {
-		return m.TargetFileBytes
+		return m.Commits
 	}
 	return 0
 }
 
-func (m *PutFileRequest) GetOverwriteIndex() *OverwriteIndex {
+func (m *Usage) GetQuota() *Quota {
 	if m != nil {
-		return m.OverwriteIndex
+		return m.Quota
 	}
 	return nil
 }
 
-// PutFileRecord is used to record PutFile requests in etcd temporarily.
-type PutFileRecord struct {
-	SizeBytes      int64           `protobuf:"varint,1,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
-	ObjectHash     string          `protobuf:"bytes,2,opt,name=object_hash,json=objectHash,proto3" json:"object_hash,omitempty"`
-	OverwriteIndex *OverwriteIndex `protobuf:"bytes,3,opt,name=overwrite_index,json=overwriteIndex" json:"overwrite_index,omitempty"`
+type RepoUsage struct {
+	Repo      *Repo  `protobuf:"bytes,1,opt,name=repo" json:"repo,omitempty"`
+	CreatedBy string `protobuf:"bytes,2,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	Usage     *Usage `protobuf:"bytes,3,opt,name=usage" json:"usage,omitempty"`
 }
 
-func (m *PutFileRecord) Reset()                    { *m = PutFileRecord{} }
-func (m *PutFileRecord) String() string            { return proto.CompactTextString(m) }
-func (*PutFileRecord) ProtoMessage()               {}
-func (*PutFileRecord) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{35} }
+func (m *RepoUsage) Reset()                    { *m = RepoUsage{} }
+func (m *RepoUsage) String() string            { return proto.CompactTextString(m) }
+func (*RepoUsage) ProtoMessage()               {}
+func (*RepoUsage) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{46} }
 
-func (m *PutFileRecord) GetSizeBytes() int64 {
+func (m *RepoUsage) GetRepo() *Repo {
 	if m != nil {
-		return m.SizeBytes
+		return m.Repo
 	}
-	return 0
+	return nil
 }
 
-func (m *PutFileRecord) GetObjectHash() string {
+func (m *RepoUsage) GetCreatedBy() string {
 	if m != nil {
-		return m.ObjectHash
+		return m.CreatedBy
 	}
 	return ""
 }
 
-func (m *PutFileRecord) GetOverwriteIndex() *OverwriteIndex {
+func (m *RepoUsage) GetUsage() *Usage {
 	if m != nil {
-		return m.OverwriteIndex
+		return m.Usage
 	}
 	return nil
 }
 
-type PutFileRecords struct {
-	Split   bool             `protobuf:"varint,1,opt,name=split,proto3" json:"split,omitempty"`
-	Records []*PutFileRecord `protobuf:"bytes,2,rep,name=records" json:"records,omitempty"`
+type PrincipalUsage struct {
+	Principal string `protobuf:"bytes,1,opt,name=principal,proto3" json:"principal,omitempty"`
+	Usage     *Usage `protobuf:"bytes,2,opt,name=usage" json:"usage,omitempty"`
 }
 
-func (m *PutFileRecords) Reset()                    { *m = PutFileRecords{} }
-func (m *PutFileRecords) String() string            { return proto.CompactTextString(m) }
-func (*PutFileRecords) ProtoMessage()               {}
-func (*PutFileRecords) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{36} }
+func (m *PrincipalUsage) Reset()                    { *m = PrincipalUsage{} }
+func (m *PrincipalUsage) String() string            { return proto.CompactTextString(m) }
+func (*PrincipalUsage) ProtoMessage()               {}
+func (*PrincipalUsage) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{47} }
 
-func (m *PutFileRecords) GetSplit() bool {
+func (m *PrincipalUsage) GetPrincipal() string {
 	if m != nil {
-		return m.Split
+		return m.Principal
 	}
-	return false
+	return ""
 }
 
-func (m *PutFileRecords) GetRecords() []*PutFileRecord {
+func (m *PrincipalUsage) GetUsage() *Usage {
 	if m != nil {
-		return m.Records
+		return m.Usage
 	}
 	return nil
 }
 
-type CopyFileRequest struct {
-	Src       *File `protobuf:"bytes,1,opt,name=src" json:"src,omitempty"`
-	Dst       *File `protobuf:"bytes,2,opt,name=dst" json:"dst,omitempty"`
-	Overwrite bool  `protobuf:"varint,3,opt,name=overwrite,proto3" json:"overwrite,omitempty"`
+type QuotaUsageResponse struct {
+	Repos      []*RepoUsage      `protobuf:"bytes,1,rep,name=repos" json:"repos,omitempty"`
+	Principals []*PrincipalUsage `protobuf:"bytes,2,rep,name=principals" json:"principals,omitempty"`
 }
 
-func (m *CopyFileRequest) Reset()                    { *m = CopyFileRequest{} }
-func (m *CopyFileRequest) String() string            { return proto.CompactTextString(m) }
-func (*CopyFileRequest) ProtoMessage()               {}
-func (*CopyFileRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{37} }
+func (m *QuotaUsageResponse) Reset()                    { *m = QuotaUsageResponse{} }
+func (m *QuotaUsageResponse) String() string            { return proto.CompactTextString(m) }
+func (*QuotaUsageResponse) ProtoMessage()               {}
+func (*QuotaUsageResponse) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{48} }
 
-func (m *CopyFileRequest) GetSrc() *File {
+func (m *QuotaUsageResponse) GetRepos() []*RepoUsage {
 	if m != nil {
-		return m.Src
+		return m.Repos
 	}
 	return nil
 }
 
-func (m *CopyFileRequest) GetDst() *File {
+func (m *QuotaUsageResponse) GetPrincipals() []*PrincipalUsage {
 	if m != nil {
-		return m.Dst
+		return m.Principals
 	}
 	return nil
 }
 
-func (m *CopyFileRequest) GetOverwrite() bool {
-	if m != nil {
-		return m.Overwrite
-	}
-	return false
-}
-
-type InspectFileRequest struct {
-	File *File `protobuf:"bytes,1,opt,name=file" json:"file,omitempty"`
+type DeleteCommitRequest struct {
+	Commit *Commit `protobuf:"bytes,1,opt,name=commit" json:"commit,omitempty"`
 }
 
-func (m *InspectFileRequest) Reset()                    { *m = InspectFileRequest{} }
-func (m *InspectFileRequest) String() string            { return proto.CompactTextString(m) }
-func (*InspectFileRequest) ProtoMessage()               {}
-func (*InspectFileRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{38} }
+func (m *DeleteCommitRequest) Reset()                    { *m = DeleteCommitRequest{} }
+func (m *DeleteCommitRequest) String() string            { return proto.CompactTextString(m) }
+func (*DeleteCommitRequest) ProtoMessage()               {}
+func (*DeleteCommitRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{49} }
 
-func (m *InspectFileRequest) GetFile() *File {
+func (m *DeleteCommitRequest) GetCommit() *Commit {
 	if m != nil {
-		return m.File
+		return m.Commit
 	}
 	return nil
 }
 
-type ListFileRequest struct {
-	// File is the parent directory of the files we want to list. This fixes the
-	// repo, the commit/branch, and path prefix of files we're interested it
-	File *File `protobuf:"bytes,1,opt,name=file" json:"file,omitempty"`
-	// Full indicates whether the result should include file contents, which may
-	// be large (i.e. the list of children for directories, and the list of object
-	// references for regular files)
-	Full bool `protobuf:"varint,2,opt,name=full,proto3" json:"full,omitempty"`
+type FlushCommitRequest struct {
+	Commits []*Commit `protobuf:"bytes,1,rep,name=commits" json:"commits,omitempty"`
+	ToRepos []*Repo   `protobuf:"bytes,2,rep,name=to_repos,json=toRepos" json:"to_repos,omitempty"`
 }
 
-func (m *ListFileRequest) Reset()                    { *m = ListFileRequest{} }
-func (m *ListFileRequest) String() string            { return proto.CompactTextString(m) }
-func (*ListFileRequest) ProtoMessage()               {}
-func (*ListFileRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{39} }
+func (m *FlushCommitRequest) Reset()                    { *m = FlushCommitRequest{} }
+func (m *FlushCommitRequest) String() string            { return proto.CompactTextString(m) }
+func (*FlushCommitRequest) ProtoMessage()               {}
+func (*FlushCommitRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{50} }
 
-func (m *ListFileRequest) GetFile() *File {
+func (m *FlushCommitRequest) GetCommits() []*Commit {
 	if m != nil {
-		return m.File
+		return m.Commits
 	}
 	return nil
 }
 
-func (m *ListFileRequest) GetFull() bool {
+func (m *FlushCommitRequest) GetToRepos() []*Repo {
 	if m != nil {
-		return m.Full
+		return m.ToRepos
 	}
-	return false
+	return nil
 }
 
-type GlobFileRequest struct {
-	Commit  *Commit `protobuf:"bytes,1,opt,name=commit" json:"commit,omitempty"`
-	Pattern string  `protobuf:"bytes,2,opt,name=pattern,proto3" json:"pattern,omitempty"`
+type SubscribeCommitRequest struct {
+	Repo   *Repo  `protobuf:"bytes,1,opt,name=repo" json:"repo,omitempty"`
+	Branch string `protobuf:"bytes,2,opt,name=branch,proto3" json:"branch,omitempty"`
+	// only commits created since this commit are returned
+	From *Commit `protobuf:"bytes,3,opt,name=from" json:"from,omitempty"`
 }
 
-func (m *GlobFileRequest) Reset()                    { *m = GlobFileRequest{} }
-func (m *GlobFileRequest) String() string            { return proto.CompactTextString(m) }
-func (*GlobFileRequest) ProtoMessage()               {}
-func (*GlobFileRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{40} }
+func (m *SubscribeCommitRequest) Reset()                    { *m = SubscribeCommitRequest{} }
+func (m *SubscribeCommitRequest) String() string            { return proto.CompactTextString(m) }
+func (*SubscribeCommitRequest) ProtoMessage()               {}
+func (*SubscribeCommitRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{51} }
 
-func (m *GlobFileRequest) GetCommit() *Commit {
+func (m *SubscribeCommitRequest) GetRepo() *Repo {
 	if m != nil {
-		return m.Commit
+		return m.Repo
 	}
 	return nil
 }
 
-func (m *GlobFileRequest) GetPattern() string {
+func (m *SubscribeCommitRequest) GetBranch() string {
 	if m != nil {
-		return m.Pattern
+		return m.Branch
 	}
 	return ""
 }
 
-// FileInfos is the result of both ListFile and GlobFile
-type FileInfos struct {
-	FileInfo []*FileInfo `protobuf:"bytes,1,rep,name=file_info,json=fileInfo" json:"file_info,omitempty"`
-}
-
-func (m *FileInfos) Reset()                    { *m = FileInfos{} }
-func (m *FileInfos) String() string            { return proto.CompactTextString(m) }
-func (*FileInfos) ProtoMessage()               {}
-func (*FileInfos) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{41} }
-
-func (m *FileInfos) GetFileInfo() []*FileInfo {
+func (m *SubscribeCommitRequest) GetFrom() *Commit {
 	if m != nil {
-		return m.FileInfo
+		return m.From
 	}
 	return nil
 }
 
-type DiffFileRequest struct {
-	NewFile *File `protobuf:"bytes,1,opt,name=new_file,json=newFile" json:"new_file,omitempty"`
-	// OldFile may be left nil in which case the same path in the parent of
-	// NewFile's commit will be used.
-	OldFile *File `protobuf:"bytes,2,opt,name=old_file,json=oldFile" json:"old_file,omitempty"`
-	Shallow bool  `protobuf:"varint,3,opt,name=shallow,proto3" json:"shallow,omitempty"`
+type GetFileRequest struct {
+	File        *File `protobuf:"bytes,1,opt,name=file" json:"file,omitempty"`
+	OffsetBytes int64 `protobuf:"varint,2,opt,name=offset_bytes,json=offsetBytes,proto3" json:"offset_bytes,omitempty"`
+	SizeBytes   int64 `protobuf:"varint,3,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
 }
 
-func (m *DiffFileRequest) Reset()                    { *m = DiffFileRequest{} }
-func (m *DiffFileRequest) String() string            { return proto.CompactTextString(m) }
-func (*DiffFileRequest) ProtoMessage()               {}
-func (*DiffFileRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{42} }
+func (m *GetFileRequest) Reset()                    { *m = GetFileRequest{} }
+func (m *GetFileRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetFileRequest) ProtoMessage()               {}
+func (*GetFileRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{52} }
 
-func (m *DiffFileRequest) GetNewFile() *File {
+func (m *GetFileRequest) GetFile() *File {
 	if m != nil {
-		return m.NewFile
+		return m.File
 	}
 	return nil
 }
 
-func (m *DiffFileRequest) GetOldFile() *File {
+func (m *GetFileRequest) GetOffsetBytes() int64 {
 	if m != nil {
-		return m.OldFile
+		return m.OffsetBytes
 	}
-	return nil
+	return 0
 }
 
-func (m *DiffFileRequest) GetShallow() bool {
+func (m *GetFileRequest) GetSizeBytes() int64 {
 	if m != nil {
-		return m.Shallow
+		return m.SizeBytes
 	}
-	return false
+	return 0
 }
 
-type DiffFileResponse struct {
-	NewFiles []*FileInfo `protobuf:"bytes,1,rep,name=new_files,json=newFiles" json:"new_files,omitempty"`
-	OldFiles []*FileInfo `protobuf:"bytes,2,rep,name=old_files,json=oldFiles" json:"old_files,omitempty"`
+type GetArchiveRequest struct {
+	// The commit to archive files from, and the path of the file or directory
+	// to archive, which may be a glob pattern. Matching directories are
+	// archived with everything under them.
+	File   *File         `protobuf:"bytes,1,opt,name=file" json:"file,omitempty"`
+	Format ArchiveFormat `protobuf:"varint,2,opt,name=format,proto3,enum=pfs.ArchiveFormat" json:"format,omitempty"`
 }
 
-func (m *DiffFileResponse) Reset()                    { *m = DiffFileResponse{} }
-func (m *DiffFileResponse) String() string            { return proto.CompactTextString(m) }
-func (*DiffFileResponse) ProtoMessage()               {}
-func (*DiffFileResponse) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{43} }
+func (m *GetArchiveRequest) Reset()                    { *m = GetArchiveRequest{} }
+func (m *GetArchiveRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetArchiveRequest) ProtoMessage()               {}
+func (*GetArchiveRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{53} }
 
-func (m *DiffFileResponse) GetNewFiles() []*FileInfo {
+func (m *GetArchiveRequest) GetFile() *File {
 	if m != nil {
-		return m.NewFiles
+		return m.File
 	}
 	return nil
 }
 
-func (m *DiffFileResponse) GetOldFiles() []*FileInfo {
+func (m *GetArchiveRequest) GetFormat() ArchiveFormat {
 	if m != nil {
-		return m.OldFiles
+		return m.Format
 	}
-	return nil
+	return ArchiveFormat_TAR
 }
 
-type DeleteFileRequest struct {
-	File *File `protobuf:"bytes,1,opt,name=file" json:"file,omitempty"`
+// An OverwriteIndex specifies the index of objects from which new writes
+// are applied to.  Existing objects starting from the index are deleted.
+// We want a separate message for ObjectIndex because we want to be able to
+// distinguish between a zero index and a non-existent index.
+type OverwriteIndex struct {
+	Index int64 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
 }
 
-func (m *DeleteFileRequest) Reset()                    { *m = DeleteFileRequest{} }
-func (m *DeleteFileRequest) String() string            { return proto.CompactTextString(m) }
-func (*DeleteFileRequest) ProtoMessage()               {}
-func (*DeleteFileRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{44} }
+func (m *OverwriteIndex) Reset()                    { *m = OverwriteIndex{} }
+func (m *OverwriteIndex) String() string            { return proto.CompactTextString(m) }
+func (*OverwriteIndex) ProtoMessage()               {}
+func (*OverwriteIndex) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{54} }
 
-func (m *DeleteFileRequest) GetFile() *File {
+func (m *OverwriteIndex) GetIndex() int64 {
 	if m != nil {
-		return m.File
+		return m.Index
 	}
-	return nil
-}
-
-type PutObjectRequest struct {
-	Value []byte `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
-	Tags  []*Tag `protobuf:"bytes,2,rep,name=tags" json:"tags,omitempty"`
+	return 0
 }
 
-func (m *PutObjectRequest) Reset()                    { *m = PutObjectRequest{} }
-func (m *PutObjectRequest) String() string            { return proto.CompactTextString(m) }
-func (*PutObjectRequest) ProtoMessage()               {}
-func (*PutObjectRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{45} }
-
-func (m *PutObjectRequest) GetValue() []byte {
-	if m != nil {
-		return m.Value
-	}
-	return nil
+type PutFileRequest struct {
+	File  *File  `protobuf:"bytes,1,opt,name=file" json:"file,omitempty"`
+	Value []byte `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	Url   string `protobuf:"bytes,5,opt,name=url,proto3" json:"url,omitempty"`
+	// applies only to URLs that can be recursively walked, for example s3:// URLs
+	Recursive bool `protobuf:"varint,6,opt,name=recursive,proto3" json:"recursive,omitempty"`
+	// Delimiter causes data to be broken up into separate files with File.Path
+	// as a prefix.
+	Delimiter Delimiter `protobuf:"varint,7,opt,name=delimiter,proto3,enum=pfs.Delimiter" json:"delimiter,omitempty"`
+	// TargetFileDatums specifies the target number of datums in each written
+	// file it may be lower if data does not split evenly, but will never be
+	// higher, unless the value is 0.
+	TargetFileDatums int64 `protobuf:"varint,8,opt,name=target_file_datums,json=targetFileDatums,proto3" json:"target_file_datums,omitempty"`
+	// TargetFileBytes specifies the target number of bytes in each written
+	// file, files may have more or fewer bytes than the target.
+	TargetFileBytes int64 `protobuf:"varint,9,opt,name=target_file_bytes,json=targetFileBytes,proto3" json:"target_file_bytes,omitempty"`
+	// overwrite_index is the object index where the write starts from.  All
+	// existing objects starting from the index are deleted.
+	OverwriteIndex *OverwriteIndex `protobuf:"bytes,10,opt,name=overwrite_index,json=overwriteIndex" json:"overwrite_index,omitempty"`
 }
 
-func (m *PutObjectRequest) GetTags() []*Tag {
+func (m *PutFileRequest) Reset()                    { *m = PutFileRequest{} }
+func (m *PutFileRequest) String() string            { return proto.CompactTextString(m) }
+func (*PutFileRequest) ProtoMessage()               {}
+func (*PutFileRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{55} }
+
+func (m *PutFileRequest) GetFile() *File {
 	if m != nil {
-		return m.Tags
+		return m.File
 	}
 	return nil
 }
 
-type GetObjectsRequest struct {
-	Objects     []*Object `protobuf:"bytes,1,rep,name=objects" json:"objects,omitempty"`
-	OffsetBytes uint64    `protobuf:"varint,2,opt,name=offset_bytes,json=offsetBytes,proto3" json:"offset_bytes,omitempty"`
-	// The number of bytes we intend to read.
-	SizeBytes uint64 `protobuf:"varint,3,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
-	// The total amount of bytes in these objects.  It's OK if it's not
-	// entirely accurate or if it's unknown (in which case it'd be set to 0).
-	// It's used primarily as a hint for cache eviction.
-	TotalSize uint64 `protobuf:"varint,4,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
+func (m *PutFileRequest) GetValue() []byte {
+	if m != nil {
+		return m.Value
+	}
+	return nil
 }
 
-func (m *GetObjectsRequest) Reset()                    { *m = GetObjectsRequest{} }
-func (m *GetObjectsRequest) String() string            { return proto.CompactTextString(m) }
-func (*GetObjectsRequest) ProtoMessage()               {}
-func (*GetObjectsRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{46} }
+func (m *PutFileRequest) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
 
-func (m *GetObjectsRequest) GetObjects() []*Object {
+func (m *PutFileRequest) GetRecursive() bool {
 	if m != nil {
-		return m.Objects
+		return m.Recursive
 	}
-	return nil
+	return false
 }
 
-func (m *GetObjectsRequest) GetOffsetBytes() uint64 {
+func (m *PutFileRequest) GetDelimiter() Delimiter {
 	if m != nil {
-		return m.OffsetBytes
+		return m.Delimiter
 	}
-	return 0
+	return Delimiter_NONE
 }
 
-func (m *GetObjectsRequest) GetSizeBytes() uint64 {
+func (m *PutFileRequest) GetTargetFileDatums() int64 {
 	if m != nil {
-		return m.SizeBytes
+		return m.TargetFileDatums
 	}
 	return 0
 }
 
-func (m *GetObjectsRequest) GetTotalSize() uint64 {
+func (m *PutFileRequest) GetTargetFileBytes() int64 {
 	if m != nil {
-		return m.TotalSize
+		return m.TargetFileBytes
 	}
 	return 0
 }
 
-type TagObjectRequest struct {
-	Object *Object `protobuf:"bytes,1,opt,name=object" json:"object,omitempty"`
-	Tags   []*Tag  `protobuf:"bytes,2,rep,name=tags" json:"tags,omitempty"`
+func (m *PutFileRequest) GetOverwriteIndex() *OverwriteIndex {
+	if m != nil {
+		return m.OverwriteIndex
+	}
+	return nil
 }
 
-func (m *TagObjectRequest) Reset()                    { *m = TagObjectRequest{} }
-func (m *TagObjectRequest) String() string            { return proto.CompactTextString(m) }
-func (*TagObjectRequest) ProtoMessage()               {}
-func (*TagObjectRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{47} }
+// PutFileRecord is used to record PutFile requests in etcd temporarily.
+type PutFileRecord struct {
+	SizeBytes      int64           `protobuf:"varint,1,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	ObjectHash     string          `protobuf:"bytes,2,opt,name=object_hash,json=objectHash,proto3" json:"object_hash,omitempty"`
+	OverwriteIndex *OverwriteIndex `protobuf:"bytes,3,opt,name=overwrite_index,json=overwriteIndex" json:"overwrite_index,omitempty"`
+}
 
-func (m *TagObjectRequest) GetObject() *Object {
+func (m *PutFileRecord) Reset()                    { *m = PutFileRecord{} }
+func (m *PutFileRecord) String() string            { return proto.CompactTextString(m) }
+func (*PutFileRecord) ProtoMessage()               {}
+func (*PutFileRecord) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{56} }
+
+func (m *PutFileRecord) GetSizeBytes() int64 {
 	if m != nil {
-		return m.Object
+		return m.SizeBytes
 	}
-	return nil
+	return 0
 }
 
-func (m *TagObjectRequest) GetTags() []*Tag {
+func (m *PutFileRecord) GetObjectHash() string {
 	if m != nil {
-		return m.Tags
+		return m.ObjectHash
 	}
-	return nil
+	return ""
 }
 
-type ListObjectsRequest struct {
+func (m *PutFileRecord) GetOverwriteIndex() *OverwriteIndex {
+	if m != nil {
+		return m.OverwriteIndex
+	}
+	return nil
 }
 
-func (m *ListObjectsRequest) Reset()                    { *m = ListObjectsRequest{} }
-func (m *ListObjectsRequest) String() string            { return proto.CompactTextString(m) }
-func (*ListObjectsRequest) ProtoMessage()               {}
-func (*ListObjectsRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{48} }
-
-type ListTagsRequest struct {
-	Prefix        string `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`
-	IncludeObject bool   `protobuf:"varint,2,opt,name=includeObject,proto3" json:"includeObject,omitempty"`
+type PutFileRecords struct {
+	Split   bool             `protobuf:"varint,1,opt,name=split,proto3" json:"split,omitempty"`
+	Records []*PutFileRecord `protobuf:"bytes,2,rep,name=records" json:"records,omitempty"`
 }
 
-func (m *ListTagsRequest) Reset()                    { *m = ListTagsRequest{} }
-func (m *ListTagsRequest) String() string            { return proto.CompactTextString(m) }
-func (*ListTagsRequest) ProtoMessage()               {}
-func (*ListTagsRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{49} }
+func (m *PutFileRecords) Reset()                    { *m = PutFileRecords{} }
+func (m *PutFileRecords) String() string            { return proto.CompactTextString(m) }
+func (*PutFileRecords) ProtoMessage()               {}
+func (*PutFileRecords) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{57} }
 
-func (m *ListTagsRequest) GetPrefix() string {
+func (m *PutFileRecords) GetSplit() bool {
 	if m != nil {
-		return m.Prefix
+		return m.Split
 	}
-	return ""
+	return false
 }
 
-func (m *ListTagsRequest) GetIncludeObject() bool {
+func (m *PutFileRecords) GetRecords() []*PutFileRecord {
 	if m != nil {
-		return m.IncludeObject
+		return m.Records
 	}
-	return false
+	return nil
 }
 
-type ListTagsResponse struct {
-	Tag    string  `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
-	Object *Object `protobuf:"bytes,2,opt,name=object" json:"object,omitempty"`
+// Transaction is a reference to a transaction. Requests made with a
+// transaction's ID in their metadata (see APIClient.WithTransaction) are
+// recorded in the transaction rather than applied, and FinishTransaction
+// applies all of them atomically.
+type Transaction struct {
+	ID string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (m *ListTagsResponse) Reset()                    { *m = ListTagsResponse{} }
-func (m *ListTagsResponse) String() string            { return proto.CompactTextString(m) }
-func (*ListTagsResponse) ProtoMessage()               {}
-func (*ListTagsResponse) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{50} }
+func (m *Transaction) Reset()                    { *m = Transaction{} }
+func (m *Transaction) String() string            { return proto.CompactTextString(m) }
+func (*Transaction) ProtoMessage()               {}
+func (*Transaction) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{58} }
 
-func (m *ListTagsResponse) GetTag() string {
+func (m *Transaction) GetID() string {
 	if m != nil {
-		return m.Tag
+		return m.ID
 	}
 	return ""
 }
 
-func (m *ListTagsResponse) GetObject() *Object {
+// TransactionInfo is the data structure representing a transaction in etcd
+type TransactionInfo struct {
+	Transaction *Transaction                `protobuf:"bytes,1,opt,name=transaction" json:"transaction,omitempty"`
+	Started     *google_protobuf2.Timestamp `protobuf:"bytes,2,opt,name=started" json:"started,omitempty"`
+	// The requests recorded in the transaction, in the order they were made
+	Requests []*TransactionRequest `protobuf:"bytes,3,rep,name=requests" json:"requests,omitempty"`
+}
+
+func (m *TransactionInfo) Reset()                    { *m = TransactionInfo{} }
+func (m *TransactionInfo) String() string            { return proto.CompactTextString(m) }
+func (*TransactionInfo) ProtoMessage()               {}
+func (*TransactionInfo) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{59} }
+
+func (m *TransactionInfo) GetTransaction() *Transaction {
 	if m != nil {
-		return m.Object
+		return m.Transaction
 	}
 	return nil
 }
 
-type DeleteObjectsRequest struct {
-	Objects []*Object `protobuf:"bytes,1,rep,name=objects" json:"objects,omitempty"`
+func (m *TransactionInfo) GetStarted() *google_protobuf2.Timestamp {
+	if m != nil {
+		return m.Started
+	}
+	return nil
 }
 
-func (m *DeleteObjectsRequest) Reset()                    { *m = DeleteObjectsRequest{} }
-func (m *DeleteObjectsRequest) String() string            { return proto.CompactTextString(m) }
-func (*DeleteObjectsRequest) ProtoMessage()               {}
-func (*DeleteObjectsRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{51} }
-
-func (m *DeleteObjectsRequest) GetObjects() []*Object {
+func (m *TransactionInfo) GetRequests() []*TransactionRequest {
 	if m != nil {
-		return m.Objects
+		return m.Requests
 	}
 	return nil
 }
 
-type DeleteObjectsResponse struct {
+// TransactionRequest is a request recorded in a transaction. Exactly one of
+// start_commit, finish_commit, put_file and set_branch is set.
+type TransactionRequest struct {
+	StartCommit *StartCommitRequest `protobuf:"bytes,1,opt,name=start_commit,json=startCommit" json:"start_commit,omitempty"`
+	// The commit that start_commit will create
+	Commit       *Commit              `protobuf:"bytes,2,opt,name=commit" json:"commit,omitempty"`
+	FinishCommit *FinishCommitRequest `protobuf:"bytes,3,opt,name=finish_commit,json=finishCommit" json:"finish_commit,omitempty"`
+	PutFile      *TransactionPutFile  `protobuf:"bytes,4,opt,name=put_file,json=putFile" json:"put_file,omitempty"`
+	SetBranch    *SetBranchRequest    `protobuf:"bytes,5,opt,name=set_branch,json=setBranch" json:"set_branch,omitempty"`
 }
 
-func (m *DeleteObjectsResponse) Reset()                    { *m = DeleteObjectsResponse{} }
-func (m *DeleteObjectsResponse) String() string            { return proto.CompactTextString(m) }
-func (*DeleteObjectsResponse) ProtoMessage()               {}
-func (*DeleteObjectsResponse) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{52} }
+func (m *TransactionRequest) Reset()                    { *m = TransactionRequest{} }
+func (m *TransactionRequest) String() string            { return proto.CompactTextString(m) }
+func (*TransactionRequest) ProtoMessage()               {}
+func (*TransactionRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{60} }
 
-type DeleteTagsRequest struct {
-	Tags []string `protobuf:"bytes,1,rep,name=tags" json:"tags,omitempty"`
+func (m *TransactionRequest) GetStartCommit() *StartCommitRequest {
+	if m != nil {
+		return m.StartCommit
+	}
+	return nil
 }
 
-func (m *DeleteTagsRequest) Reset()                    { *m = DeleteTagsRequest{} }
-func (m *DeleteTagsRequest) String() string            { return proto.CompactTextString(m) }
-func (*DeleteTagsRequest) ProtoMessage()               {}
-func (*DeleteTagsRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{53} }
+func (m *TransactionRequest) GetCommit() *Commit {
+	if m != nil {
+		return m.Commit
+	}
+	return nil
+}
 
-func (m *DeleteTagsRequest) GetTags() []string {
+func (m *TransactionRequest) GetFinishCommit() *FinishCommitRequest {
 	if m != nil {
-		return m.Tags
+		return m.FinishCommit
 	}
 	return nil
 }
 
-type DeleteTagsResponse struct {
+func (m *TransactionRequest) GetPutFile() *TransactionPutFile {
+	if m != nil {
+		return m.PutFile
+	}
+	return nil
 }
 
-func (m *DeleteTagsResponse) Reset()                    { *m = DeleteTagsResponse{} }
-func (m *DeleteTagsResponse) String() string            { return proto.CompactTextString(m) }
-func (*DeleteTagsResponse) ProtoMessage()               {}
-func (*DeleteTagsResponse) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{54} }
+func (m *TransactionRequest) GetSetBranch() *SetBranchRequest {
+	if m != nil {
+		return m.SetBranch
+	}
+	return nil
+}
 
-type CheckObjectRequest struct {
-	Object *Object `protobuf:"bytes,1,opt,name=object" json:"object,omitempty"`
+// TransactionPutFile is a PutFile or DeleteFile request recorded in a
+// transaction. The file's data has already been uploaded to object storage.
+type TransactionPutFile struct {
+	File *File `protobuf:"bytes,1,opt,name=file" json:"file,omitempty"`
+	// Unset if the file is being deleted
+	Records *PutFileRecords `protobuf:"bytes,2,opt,name=records" json:"records,omitempty"`
 }
 
-func (m *CheckObjectRequest) Reset()                    { *m = CheckObjectRequest{} }
-func (m *CheckObjectRequest) String() string            { return proto.CompactTextString(m) }
-func (*CheckObjectRequest) ProtoMessage()               {}
-func (*CheckObjectRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{55} }
+func (m *TransactionPutFile) Reset()                    { *m = TransactionPutFile{} }
+func (m *TransactionPutFile) String() string            { return proto.CompactTextString(m) }
+func (*TransactionPutFile) ProtoMessage()               {}
+func (*TransactionPutFile) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{61} }
 
-func (m *CheckObjectRequest) GetObject() *Object {
+func (m *TransactionPutFile) GetFile() *File {
 	if m != nil {
-		return m.Object
+		return m.File
 	}
 	return nil
 }
 
-type CheckObjectResponse struct {
-	Exists bool `protobuf:"varint,1,opt,name=exists,proto3" json:"exists,omitempty"`
+func (m *TransactionPutFile) GetRecords() *PutFileRecords {
+	if m != nil {
+		return m.Records
+	}
+	return nil
 }
 
-func (m *CheckObjectResponse) Reset()                    { *m = CheckObjectResponse{} }
-func (m *CheckObjectResponse) String() string            { return proto.CompactTextString(m) }
-func (*CheckObjectResponse) ProtoMessage()               {}
-func (*CheckObjectResponse) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{56} }
+type StartTransactionRequest struct {
+}
 
-func (m *CheckObjectResponse) GetExists() bool {
+func (m *StartTransactionRequest) Reset()                    { *m = StartTransactionRequest{} }
+func (m *StartTransactionRequest) String() string            { return proto.CompactTextString(m) }
+func (*StartTransactionRequest) ProtoMessage()               {}
+func (*StartTransactionRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{62} }
+
+type FinishTransactionRequest struct {
+	Transaction *Transaction `protobuf:"bytes,1,opt,name=transaction" json:"transaction,omitempty"`
+}
+
+func (m *FinishTransactionRequest) Reset()                    { *m = FinishTransactionRequest{} }
+func (m *FinishTransactionRequest) String() string            { return proto.CompactTextString(m) }
+func (*FinishTransactionRequest) ProtoMessage()               {}
+func (*FinishTransactionRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{63} }
+
+func (m *FinishTransactionRequest) GetTransaction() *Transaction {
 	if m != nil {
-		return m.Exists
+		return m.Transaction
 	}
-	return false
+	return nil
 }
 
-type Objects struct {
-	Objects []*Object `protobuf:"bytes,1,rep,name=objects" json:"objects,omitempty"`
+type DeleteTransactionRequest struct {
+	Transaction *Transaction `protobuf:"bytes,1,opt,name=transaction" json:"transaction,omitempty"`
 }
 
-func (m *Objects) Reset()                    { *m = Objects{} }
-func (m *Objects) String() string            { return proto.CompactTextString(m) }
-func (*Objects) ProtoMessage()               {}
-func (*Objects) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{57} }
+func (m *DeleteTransactionRequest) Reset()                    { *m = DeleteTransactionRequest{} }
+func (m *DeleteTransactionRequest) String() string            { return proto.CompactTextString(m) }
+func (*DeleteTransactionRequest) ProtoMessage()               {}
+func (*DeleteTransactionRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{64} }
 
-func (m *Objects) GetObjects() []*Object {
+func (m *DeleteTransactionRequest) GetTransaction() *Transaction {
 	if m != nil {
-		return m.Objects
+		return m.Transaction
 	}
 	return nil
 }
 
-type ObjectIndex struct {
-	Objects map[string]*BlockRef `protobuf:"bytes,1,rep,name=objects" json:"objects,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value"`
-	Tags    map[string]*Object   `protobuf:"bytes,2,rep,name=tags" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value"`
+type CopyFileRequest struct {
+	Src       *File `protobuf:"bytes,1,opt,name=src" json:"src,omitempty"`
+	Dst       *File `protobuf:"bytes,2,opt,name=dst" json:"dst,omitempty"`
+	Overwrite bool  `protobuf:"varint,3,opt,name=overwrite,proto3" json:"overwrite,omitempty"`
 }
 
-func (m *ObjectIndex) Reset()                    { *m = ObjectIndex{} }
-func (m *ObjectIndex) String() string            { return proto.CompactTextString(m) }
-func (*ObjectIndex) ProtoMessage()               {}
-func (*ObjectIndex) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{58} }
+func (m *CopyFileRequest) Reset()                    { *m = CopyFileRequest{} }
+func (m *CopyFileRequest) String() string            { return proto.CompactTextString(m) }
+func (*CopyFileRequest) ProtoMessage()               {}
+func (*CopyFileRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{65} }
 
-func (m *ObjectIndex) GetObjects() map[string]*BlockRef {
+func (m *CopyFileRequest) GetSrc() *File {
 	if m != nil {
-		return m.Objects
+		return m.Src
 	}
 	return nil
 }
 
-func (m *ObjectIndex) GetTags() map[string]*Object {
+func (m *CopyFileRequest) GetDst() *File {
 	if m != nil {
-		return m.Tags
+		return m.Dst
 	}
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*Repo)(nil), "pfs.Repo")
-	proto.RegisterType((*BranchInfo)(nil), "pfs.BranchInfo")
-	proto.RegisterType((*BranchInfos)(nil), "pfs.BranchInfos")
-	proto.RegisterType((*File)(nil), "pfs.File")
-	proto.RegisterType((*Block)(nil), "pfs.Block")
-	proto.RegisterType((*Object)(nil), "pfs.Object")
-	proto.RegisterType((*Tag)(nil), "pfs.Tag")
-	proto.RegisterType((*RepoInfo)(nil), "pfs.RepoInfo")
-	proto.RegisterType((*RepoAuthInfo)(nil), "pfs.RepoAuthInfo")
-	proto.RegisterType((*Commit)(nil), "pfs.Commit")
-	proto.RegisterType((*CommitInfo)(nil), "pfs.CommitInfo")
-	proto.RegisterType((*FileInfo)(nil), "pfs.FileInfo")
-	proto.RegisterType((*ByteRange)(nil), "pfs.ByteRange")
-	proto.RegisterType((*BlockRef)(nil), "pfs.BlockRef")
-	proto.RegisterType((*ObjectInfo)(nil), "pfs.ObjectInfo")
-	proto.RegisterType((*CreateRepoRequest)(nil), "pfs.CreateRepoRequest")
-	proto.RegisterType((*InspectRepoRequest)(nil), "pfs.InspectRepoRequest")
-	proto.RegisterType((*ListRepoRequest)(nil), "pfs.ListRepoRequest")
-	proto.RegisterType((*ListRepoResponse)(nil), "pfs.ListRepoResponse")
-	proto.RegisterType((*DeleteRepoRequest)(nil), "pfs.DeleteRepoRequest")
-	proto.RegisterType((*StartCommitRequest)(nil), "pfs.StartCommitRequest")
-	proto.RegisterType((*BuildCommitRequest)(nil), "pfs.BuildCommitRequest")
-	proto.RegisterType((*FinishCommitRequest)(nil), "pfs.FinishCommitRequest")
-	proto.RegisterType((*InspectCommitRequest)(nil), "pfs.InspectCommitRequest")
-	proto.RegisterType((*ListCommitRequest)(nil), "pfs.ListCommitRequest")
-	proto.RegisterType((*CommitInfos)(nil), "pfs.CommitInfos")
-	proto.RegisterType((*ListBranchRequest)(nil), "pfs.ListBranchRequest")
-	proto.RegisterType((*SetBranchRequest)(nil), "pfs.SetBranchRequest")
-	proto.RegisterType((*DeleteBranchRequest)(nil), "pfs.DeleteBranchRequest")
-	proto.RegisterType((*DeleteCommitRequest)(nil), "pfs.DeleteCommitRequest")
-	proto.RegisterType((*FlushCommitRequest)(nil), "pfs.FlushCommitRequest")
-	proto.RegisterType((*SubscribeCommitRequest)(nil), "pfs.SubscribeCommitRequest")
-	proto.RegisterType((*GetFileRequest)(nil), "pfs.GetFileRequest")
-	proto.RegisterType((*OverwriteIndex)(nil), "pfs.OverwriteIndex")
-	proto.RegisterType((*PutFileRequest)(nil), "pfs.PutFileRequest")
-	proto.RegisterType((*PutFileRecord)(nil), "pfs.PutFileRecord")
-	proto.RegisterType((*PutFileRecords)(nil), "pfs.PutFileRecords")
-	proto.RegisterType((*CopyFileRequest)(nil), "pfs.CopyFileRequest")
-	proto.RegisterType((*InspectFileRequest)(nil), "pfs.InspectFileRequest")
-	proto.RegisterType((*ListFileRequest)(nil), "pfs.ListFileRequest")
-	proto.RegisterType((*GlobFileRequest)(nil), "pfs.GlobFileRequest")
-	proto.RegisterType((*FileInfos)(nil), "pfs.FileInfos")
-	proto.RegisterType((*DiffFileRequest)(nil), "pfs.DiffFileRequest")
-	proto.RegisterType((*DiffFileResponse)(nil), "pfs.DiffFileResponse")
-	proto.RegisterType((*DeleteFileRequest)(nil), "pfs.DeleteFileRequest")
-	proto.RegisterType((*PutObjectRequest)(nil), "pfs.PutObjectRequest")
-	proto.RegisterType((*GetObjectsRequest)(nil), "pfs.GetObjectsRequest")
-	proto.RegisterType((*TagObjectRequest)(nil), "pfs.TagObjectRequest")
-	proto.RegisterType((*ListObjectsRequest)(nil), "pfs.ListObjectsRequest")
-	proto.RegisterType((*ListTagsRequest)(nil), "pfs.ListTagsRequest")
-	proto.RegisterType((*ListTagsResponse)(nil), "pfs.ListTagsResponse")
-	proto.RegisterType((*DeleteObjectsRequest)(nil), "pfs.DeleteObjectsRequest")
-	proto.RegisterType((*DeleteObjectsResponse)(nil), "pfs.DeleteObjectsResponse")
-	proto.RegisterType((*DeleteTagsRequest)(nil), "pfs.DeleteTagsRequest")
-	proto.RegisterType((*DeleteTagsResponse)(nil), "pfs.DeleteTagsResponse")
-	proto.RegisterType((*CheckObjectRequest)(nil), "pfs.CheckObjectRequest")
-	proto.RegisterType((*CheckObjectResponse)(nil), "pfs.CheckObjectResponse")
-	proto.RegisterType((*Objects)(nil), "pfs.Objects")
-	proto.RegisterType((*ObjectIndex)(nil), "pfs.ObjectIndex")
-	proto.RegisterEnum("pfs.FileType", FileType_name, FileType_value)
-	proto.RegisterEnum("pfs.Delimiter", Delimiter_name, Delimiter_value)
+func (m *CopyFileRequest) GetOverwrite() bool {
+	if m != nil {
+		return m.Overwrite
+	}
+	return false
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+type InspectFileRequest struct {
+	File *File `protobuf:"bytes,1,opt,name=file" json:"file,omitempty"`
+}
 
-// Client API for API service
+func (m *InspectFileRequest) Reset()                    { *m = InspectFileRequest{} }
+func (m *InspectFileRequest) String() string            { return proto.CompactTextString(m) }
+func (*InspectFileRequest) ProtoMessage()               {}
+func (*InspectFileRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{66} }
 
-type APIClient interface {
-	// Repo rpcs
-	// CreateRepo creates a new repo.
-	// An error is returned if the repo already exists.
-	CreateRepo(ctx context.Context, in *CreateRepoRequest, opts ...grpc.CallOption) (*google_protobuf.Empty, error)
-	// InspectRepo returns info about a repo.
-	InspectRepo(ctx context.Context, in *InspectRepoRequest, opts ...grpc.CallOption) (*RepoInfo, error)
-	// ListRepo returns info about all repos.
-	ListRepo(ctx context.Context, in *ListRepoRequest, opts ...grpc.CallOption) (*ListRepoResponse, error)
-	// DeleteRepo deletes a repo.
-	DeleteRepo(ctx context.Context, in *DeleteRepoRequest, opts ...grpc.CallOption) (*google_protobuf.Empty, error)
-	// Commit rpcs
-	// StartCommit creates a new write commit from a parent commit.
-	StartCommit(ctx context.Context, in *StartCommitRequest, opts ...grpc.CallOption) (*Commit, error)
-	// FinishCommit turns a write commit into a read commit.
-	FinishCommit(ctx context.Context, in *FinishCommitRequest, opts ...grpc.CallOption) (*google_protobuf.Empty, error)
-	// InspectCommit returns the info about a commit.
-	InspectCommit(ctx context.Context, in *InspectCommitRequest, opts ...grpc.CallOption) (*CommitInfo, error)
-	// ListCommit returns info about all commits.
-	ListCommit(ctx context.Context, in *ListCommitRequest, opts ...grpc.CallOption) (*CommitInfos, error)
-	// DeleteCommit deletes a commit.
-	DeleteCommit(ctx context.Context, in *DeleteCommitRequest, opts ...grpc.CallOption) (*google_protobuf.Empty, error)
-	// FlushCommit waits for downstream commits to finish
-	FlushCommit(ctx context.Context, in *FlushCommitRequest, opts ...grpc.CallOption) (API_FlushCommitClient, error)
-	// SubscribeCommit subscribes for new commits on a given branch
-	SubscribeCommit(ctx context.Context, in *SubscribeCommitRequest, opts ...grpc.CallOption) (API_SubscribeCommitClient, error)
-	// BuildCommit builds a commit that's backed by the given tree
-	BuildCommit(ctx context.Context, in *BuildCommitRequest, opts ...grpc.CallOption) (*Commit, error)
-	// ListBranch returns info about the heads of branches.
-	ListBranch(ctx context.Context, in *ListBranchRequest, opts ...grpc.CallOption) (*BranchInfos, error)
-	// SetBranch assigns a commit and its ancestors to a branch.
-	SetBranch(ctx context.Context, in *SetBranchRequest, opts ...grpc.CallOption) (*google_protobuf.Empty, error)
-	// DeleteBranch deletes a branch; note that the commits still exist.
-	DeleteBranch(ctx context.Context, in *DeleteBranchRequest, opts ...grpc.CallOption) (*google_protobuf.Empty, error)
-	// File rpcs
-	// PutFile writes the specified file to pfs.
-	PutFile(ctx context.Context, opts ...grpc.CallOption) (API_PutFileClient, error)
-	// CopyFile copies the contents of one file to another.
-	CopyFile(ctx context.Context, in *CopyFileRequest, opts ...grpc.CallOption) (*google_protobuf.Empty, error)
-	// GetFile returns a byte stream of the contents of the file.
-	GetFile(ctx context.Context, in *GetFileRequest, opts ...grpc.CallOption) (API_GetFileClient, error)
-	// InspectFile returns info about a file.
-	InspectFile(ctx context.Context, in *InspectFileRequest, opts ...grpc.CallOption) (*FileInfo, error)
-	// ListFile returns info about all files.
-	ListFile(ctx context.Context, in *ListFileRequest, opts ...grpc.CallOption) (*FileInfos, error)
-	// ListFileStream is a streaming version of ListFile
-	// TODO(msteffen): When the dash has been updated to use ListFileStream,
-	// replace ListFile with this RPC (https://github.com/pachyderm/dash/issues/201)
-	ListFileStream(ctx context.Context, in *ListFileRequest, opts ...grpc.CallOption) (API_ListFileStreamClient, error)
-	// GlobFile returns info about all files.
-	GlobFile(ctx context.Context, in *GlobFileRequest, opts ...grpc.CallOption) (*FileInfos, error)
-	// GlobFileStream is a streaming version of GlobFile
-	// TODO(msteffen): When the dash has been updated to use GlobFileStream,
-	// replace GlobFile with this RPC (https://github.com/pachyderm/dash/issues/201)
-	GlobFileStream(ctx context.Context, in *GlobFileRequest, opts ...grpc.CallOption) (API_GlobFileStreamClient, error)
-	// DiffFile returns the differences between 2 paths at 2 commits.
-	DiffFile(ctx context.Context, in *DiffFileRequest, opts ...grpc.CallOption) (*DiffFileResponse, error)
-	// DeleteFile deletes a file.
-	DeleteFile(ctx context.Context, in *DeleteFileRequest, opts ...grpc.CallOption) (*google_protobuf.Empty, error)
-	// DeleteAll deletes everything
-	DeleteAll(ctx context.Context, in *google_protobuf.Empty, opts ...grpc.CallOption) (*google_protobuf.Empty, error)
+func (m *InspectFileRequest) GetFile() *File {
+	if m != nil {
+		return m.File
+	}
+	return nil
 }
 
-type aPIClient struct {
-	cc *grpc.ClientConn
+type ListFileRequest struct {
+	// File is the parent directory of the files we want to list. This fixes the
+	// repo, the commit/branch, and path prefix of files we're interested it
+	File *File `protobuf:"bytes,1,opt,name=file" json:"file,omitempty"`
+	// Full indicates whether the result should include file contents, which may
+	// be large (i.e. the list of children for directories, and the list of object
+	// references for regular files)
+	Full bool `protobuf:"varint,2,opt,name=full,proto3" json:"full,omitempty"`
 }
 
-func NewAPIClient(cc *grpc.ClientConn) APIClient {
-	return &aPIClient{cc}
-}
+func (m *ListFileRequest) Reset()                    { *m = ListFileRequest{} }
+func (m *ListFileRequest) String() string            { return proto.CompactTextString(m) }
+func (*ListFileRequest) ProtoMessage()               {}
+func (*ListFileRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{67} }
 
-func (c *aPIClient) CreateRepo(ctx context.Context, in *CreateRepoRequest, opts ...grpc.CallOption) (*google_protobuf.Empty, error) {
-	out := new(google_protobuf.Empty)
-	err := grpc.Invoke(ctx, "/pfs.API/CreateRepo", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+func (m *ListFileRequest) GetFile() *File {
+	if m != nil {
+		return m.File
 	}
-	return out, nil
+	return nil
 }
 
-func (c *aPIClient) InspectRepo(ctx context.Context, in *InspectRepoRequest, opts ...grpc.CallOption) (*RepoInfo, error) {
-	out := new(RepoInfo)
-	err := grpc.Invoke(ctx, "/pfs.API/InspectRepo", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+func (m *ListFileRequest) GetFull() bool {
+	if m != nil {
+		return m.Full
 	}
-	return out, nil
+	return false
 }
 
-func (c *aPIClient) ListRepo(ctx context.Context, in *ListRepoRequest, opts ...grpc.CallOption) (*ListRepoResponse, error) {
-	out := new(ListRepoResponse)
-	err := grpc.Invoke(ctx, "/pfs.API/ListRepo", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type GlobFileRequest struct {
+	Commit  *Commit `protobuf:"bytes,1,opt,name=commit" json:"commit,omitempty"`
+	Pattern string  `protobuf:"bytes,2,opt,name=pattern,proto3" json:"pattern,omitempty"`
 }
 
-func (c *aPIClient) DeleteRepo(ctx context.Context, in *DeleteRepoRequest, opts ...grpc.CallOption) (*google_protobuf.Empty, error) {
-	out := new(google_protobuf.Empty)
-	err := grpc.Invoke(ctx, "/pfs.API/DeleteRepo", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+func (m *GlobFileRequest) Reset()                    { *m = GlobFileRequest{} }
+func (m *GlobFileRequest) String() string            { return proto.CompactTextString(m) }
+func (*GlobFileRequest) ProtoMessage()               {}
+func (*GlobFileRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{68} }
+
+func (m *GlobFileRequest) GetCommit() *Commit {
+	if m != nil {
+		return m.Commit
 	}
-	return out, nil
+	return nil
 }
 
-func (c *aPIClient) StartCommit(ctx context.Context, in *StartCommitRequest, opts ...grpc.CallOption) (*Commit, error) {
-	out := new(Commit)
-	err := grpc.Invoke(ctx, "/pfs.API/StartCommit", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+func (m *GlobFileRequest) GetPattern() string {
+	if m != nil {
+		return m.Pattern
 	}
-	return out, nil
+	return ""
 }
 
-func (c *aPIClient) FinishCommit(ctx context.Context, in *FinishCommitRequest, opts ...grpc.CallOption) (*google_protobuf.Empty, error) {
-	out := new(google_protobuf.Empty)
-	err := grpc.Invoke(ctx, "/pfs.API/FinishCommit", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+type GrepFileRequest struct {
+	Commit *Commit `protobuf:"bytes,1,opt,name=commit" json:"commit,omitempty"`
+	// pattern is a glob, as in GlobFileRequest, selecting the files to search
+	Pattern string `protobuf:"bytes,2,opt,name=pattern,proto3" json:"pattern,omitempty"`
+	// regex is matched against each line of the selected files (RE2 syntax)
+	Regex string `protobuf:"bytes,3,opt,name=regex,proto3" json:"regex,omitempty"`
+	// max_matches stops the search after this many matching lines (0 means no
+	// limit)
+	MaxMatches int64 `protobuf:"varint,4,opt,name=max_matches,json=maxMatches,proto3" json:"max_matches,omitempty"`
+	// max_bytes fails the search once more than this many bytes have been
+	// scanned (0 means no limit)
+	MaxBytes int64 `protobuf:"varint,5,opt,name=max_bytes,json=maxBytes,proto3" json:"max_bytes,omitempty"`
+}
+
+func (m *GrepFileRequest) Reset()                    { *m = GrepFileRequest{} }
+func (m *GrepFileRequest) String() string            { return proto.CompactTextString(m) }
+func (*GrepFileRequest) ProtoMessage()               {}
+func (*GrepFileRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{69} }
+
+func (m *GrepFileRequest) GetCommit() *Commit {
+	if m != nil {
+		return m.Commit
 	}
-	return out, nil
+	return nil
 }
 
-func (c *aPIClient) InspectCommit(ctx context.Context, in *InspectCommitRequest, opts ...grpc.CallOption) (*CommitInfo, error) {
-	out := new(CommitInfo)
-	err := grpc.Invoke(ctx, "/pfs.API/InspectCommit", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+func (m *GrepFileRequest) GetPattern() string {
+	if m != nil {
+		return m.Pattern
 	}
-	return out, nil
+	return ""
 }
 
-func (c *aPIClient) ListCommit(ctx context.Context, in *ListCommitRequest, opts ...grpc.CallOption) (*CommitInfos, error) {
-	out := new(CommitInfos)
-	err := grpc.Invoke(ctx, "/pfs.API/ListCommit", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+func (m *GrepFileRequest) GetRegex() string {
+	if m != nil {
+		return m.Regex
 	}
-	return out, nil
+	return ""
 }
 
-func (c *aPIClient) DeleteCommit(ctx context.Context, in *DeleteCommitRequest, opts ...grpc.CallOption) (*google_protobuf.Empty, error) {
-	out := new(google_protobuf.Empty)
-	err := grpc.Invoke(ctx, "/pfs.API/DeleteCommit", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+func (m *GrepFileRequest) GetMaxMatches() int64 {
+	if m != nil {
+		return m.MaxMatches
 	}
-	return out, nil
+	return 0
 }
 
-func (c *aPIClient) FlushCommit(ctx context.Context, in *FlushCommitRequest, opts ...grpc.CallOption) (API_FlushCommitClient, error) {
-	stream, err := grpc.NewClientStream(ctx, &_API_serviceDesc.Streams[0], c.cc, "/pfs.API/FlushCommit", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &aPIFlushCommitClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+func (m *GrepFileRequest) GetMaxBytes() int64 {
+	if m != nil {
+		return m.MaxBytes
 	}
-	return x, nil
+	return 0
 }
 
-type API_FlushCommitClient interface {
-	Recv() (*CommitInfo, error)
-	grpc.ClientStream
+// GrepFileResult is a line matched by GrepFile
+type GrepFileResult struct {
+	File *File `protobuf:"bytes,1,opt,name=file" json:"file,omitempty"`
+	// line_number is the 1-based number of the line in the file
+	LineNumber int64  `protobuf:"varint,2,opt,name=line_number,json=lineNumber,proto3" json:"line_number,omitempty"`
+	Line       string `protobuf:"bytes,3,opt,name=line,proto3" json:"line,omitempty"`
 }
 
-type aPIFlushCommitClient struct {
-	grpc.ClientStream
-}
+func (m *GrepFileResult) Reset()                    { *m = GrepFileResult{} }
+func (m *GrepFileResult) String() string            { return proto.CompactTextString(m) }
+func (*GrepFileResult) ProtoMessage()               {}
+func (*GrepFileResult) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{70} }
 
-func (x *aPIFlushCommitClient) Recv() (*CommitInfo, error) {
-	m := new(CommitInfo)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+func (m *GrepFileResult) GetFile() *File {
+	if m != nil {
+		return m.File
 	}
-	return m, nil
+	return nil
 }
 
-func (c *aPIClient) SubscribeCommit(ctx context.Context, in *SubscribeCommitRequest, opts ...grpc.CallOption) (API_SubscribeCommitClient, error) {
-	stream, err := grpc.NewClientStream(ctx, &_API_serviceDesc.Streams[1], c.cc, "/pfs.API/SubscribeCommit", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &aPISubscribeCommitClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+func (m *GrepFileResult) GetLineNumber() int64 {
+	if m != nil {
+		return m.LineNumber
 	}
-	return x, nil
+	return 0
 }
 
-type API_SubscribeCommitClient interface {
-	Recv() (*CommitInfo, error)
-	grpc.ClientStream
+func (m *GrepFileResult) GetLine() string {
+	if m != nil {
+		return m.Line
+	}
+	return ""
 }
 
-type aPISubscribeCommitClient struct {
-	grpc.ClientStream
+// FileInfos is the result of both ListFile and GlobFile
+type FileInfos struct {
+	FileInfo []*FileInfo `protobuf:"bytes,1,rep,name=file_info,json=fileInfo" json:"file_info,omitempty"`
 }
 
-func (x *aPISubscribeCommitClient) Recv() (*CommitInfo, error) {
-	m := new(CommitInfo)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+func (m *FileInfos) Reset()                    { *m = FileInfos{} }
+func (m *FileInfos) String() string            { return proto.CompactTextString(m) }
+func (*FileInfos) ProtoMessage()               {}
+func (*FileInfos) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{71} }
+
+func (m *FileInfos) GetFileInfo() []*FileInfo {
+	if m != nil {
+		return m.FileInfo
 	}
-	return m, nil
+	return nil
 }
 
-func (c *aPIClient) BuildCommit(ctx context.Context, in *BuildCommitRequest, opts ...grpc.CallOption) (*Commit, error) {
-	out := new(Commit)
-	err := grpc.Invoke(ctx, "/pfs.API/BuildCommit", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type DiffFileRequest struct {
+	NewFile *File `protobuf:"bytes,1,opt,name=new_file,json=newFile" json:"new_file,omitempty"`
+	// OldFile may be left nil in which case the same path in the parent of
+	// NewFile's commit will be used.
+	OldFile *File `protobuf:"bytes,2,opt,name=old_file,json=oldFile" json:"old_file,omitempty"`
+	Shallow bool  `protobuf:"varint,3,opt,name=shallow,proto3" json:"shallow,omitempty"`
 }
 
-func (c *aPIClient) ListBranch(ctx context.Context, in *ListBranchRequest, opts ...grpc.CallOption) (*BranchInfos, error) {
-	out := new(BranchInfos)
-	err := grpc.Invoke(ctx, "/pfs.API/ListBranch", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+func (m *DiffFileRequest) Reset()                    { *m = DiffFileRequest{} }
+func (m *DiffFileRequest) String() string            { return proto.CompactTextString(m) }
+func (*DiffFileRequest) ProtoMessage()               {}
+func (*DiffFileRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{72} }
+
+func (m *DiffFileRequest) GetNewFile() *File {
+	if m != nil {
+		return m.NewFile
 	}
-	return out, nil
+	return nil
 }
 
-func (c *aPIClient) SetBranch(ctx context.Context, in *SetBranchRequest, opts ...grpc.CallOption) (*google_protobuf.Empty, error) {
-	out := new(google_protobuf.Empty)
-	err := grpc.Invoke(ctx, "/pfs.API/SetBranch", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+func (m *DiffFileRequest) GetOldFile() *File {
+	if m != nil {
+		return m.OldFile
 	}
-	return out, nil
+	return nil
 }
 
-func (c *aPIClient) DeleteBranch(ctx context.Context, in *DeleteBranchRequest, opts ...grpc.CallOption) (*google_protobuf.Empty, error) {
-	out := new(google_protobuf.Empty)
-	err := grpc.Invoke(ctx, "/pfs.API/DeleteBranch", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+func (m *DiffFileRequest) GetShallow() bool {
+	if m != nil {
+		return m.Shallow
 	}
-	return out, nil
+	return false
 }
 
-func (c *aPIClient) PutFile(ctx context.Context, opts ...grpc.CallOption) (API_PutFileClient, error) {
-	stream, err := grpc.NewClientStream(ctx, &_API_serviceDesc.Streams[2], c.cc, "/pfs.API/PutFile", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &aPIPutFileClient{stream}
-	return x, nil
+type DiffFileResponse struct {
+	NewFiles []*FileInfo `protobuf:"bytes,1,rep,name=new_files,json=newFiles" json:"new_files,omitempty"`
+	OldFiles []*FileInfo `protobuf:"bytes,2,rep,name=old_files,json=oldFiles" json:"old_files,omitempty"`
 }
 
-type API_PutFileClient interface {
-	Send(*PutFileRequest) error
-	CloseAndRecv() (*google_protobuf.Empty, error)
-	grpc.ClientStream
+func (m *DiffFileResponse) Reset()                    { *m = DiffFileResponse{} }
+func (m *DiffFileResponse) String() string            { return proto.CompactTextString(m) }
+func (*DiffFileResponse) ProtoMessage()               {}
+func (*DiffFileResponse) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{73} }
+
+func (m *DiffFileResponse) GetNewFiles() []*FileInfo {
+	if m != nil {
+		return m.NewFiles
+	}
+	return nil
 }
 
-type aPIPutFileClient struct {
-	grpc.ClientStream
+func (m *DiffFileResponse) GetOldFiles() []*FileInfo {
+	if m != nil {
+		return m.OldFiles
+	}
+	return nil
 }
 
-func (x *aPIPutFileClient) Send(m *PutFileRequest) error {
-	return x.ClientStream.SendMsg(m)
+type DeleteFileRequest struct {
+	File *File `protobuf:"bytes,1,opt,name=file" json:"file,omitempty"`
 }
 
-func (x *aPIPutFileClient) CloseAndRecv() (*google_protobuf.Empty, error) {
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
-	}
-	m := new(google_protobuf.Empty)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+func (m *DeleteFileRequest) Reset()                    { *m = DeleteFileRequest{} }
+func (m *DeleteFileRequest) String() string            { return proto.CompactTextString(m) }
+func (*DeleteFileRequest) ProtoMessage()               {}
+func (*DeleteFileRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{74} }
+
+func (m *DeleteFileRequest) GetFile() *File {
+	if m != nil {
+		return m.File
 	}
-	return m, nil
+	return nil
 }
 
-func (c *aPIClient) CopyFile(ctx context.Context, in *CopyFileRequest, opts ...grpc.CallOption) (*google_protobuf.Empty, error) {
-	out := new(google_protobuf.Empty)
-	err := grpc.Invoke(ctx, "/pfs.API/CopyFile", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type PutObjectRequest struct {
+	Value []byte `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	Tags  []*Tag `protobuf:"bytes,2,rep,name=tags" json:"tags,omitempty"`
 }
 
-func (c *aPIClient) GetFile(ctx context.Context, in *GetFileRequest, opts ...grpc.CallOption) (API_GetFileClient, error) {
-	stream, err := grpc.NewClientStream(ctx, &_API_serviceDesc.Streams[3], c.cc, "/pfs.API/GetFile", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &aPIGetFileClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+func (m *PutObjectRequest) Reset()                    { *m = PutObjectRequest{} }
+func (m *PutObjectRequest) String() string            { return proto.CompactTextString(m) }
+func (*PutObjectRequest) ProtoMessage()               {}
+func (*PutObjectRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{75} }
+
+func (m *PutObjectRequest) GetValue() []byte {
+	if m != nil {
+		return m.Value
 	}
-	return x, nil
+	return nil
 }
 
-type API_GetFileClient interface {
-	Recv() (*google_protobuf2.BytesValue, error)
-	grpc.ClientStream
+func (m *PutObjectRequest) GetTags() []*Tag {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
 }
 
-type aPIGetFileClient struct {
-	grpc.ClientStream
+type GetObjectsRequest struct {
+	Objects     []*Object `protobuf:"bytes,1,rep,name=objects" json:"objects,omitempty"`
+	OffsetBytes uint64    `protobuf:"varint,2,opt,name=offset_bytes,json=offsetBytes,proto3" json:"offset_bytes,omitempty"`
+	// The number of bytes we intend to read.
+	SizeBytes uint64 `protobuf:"varint,3,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	// The total amount of bytes in these objects.  It's OK if it's not
+	// entirely accurate or if it's unknown (in which case it'd be set to 0).
+	// It's used primarily as a hint for cache eviction.
+	TotalSize uint64 `protobuf:"varint,4,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
 }
 
-func (x *aPIGetFileClient) Recv() (*google_protobuf2.BytesValue, error) {
-	m := new(google_protobuf2.BytesValue)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+func (m *GetObjectsRequest) Reset()                    { *m = GetObjectsRequest{} }
+func (m *GetObjectsRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetObjectsRequest) ProtoMessage()               {}
+func (*GetObjectsRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{76} }
+
+func (m *GetObjectsRequest) GetObjects() []*Object {
+	if m != nil {
+		return m.Objects
 	}
-	return m, nil
+	return nil
 }
 
-func (c *aPIClient) InspectFile(ctx context.Context, in *InspectFileRequest, opts ...grpc.CallOption) (*FileInfo, error) {
-	out := new(FileInfo)
-	err := grpc.Invoke(ctx, "/pfs.API/InspectFile", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+func (m *GetObjectsRequest) GetOffsetBytes() uint64 {
+	if m != nil {
+		return m.OffsetBytes
 	}
-	return out, nil
+	return 0
 }
 
-func (c *aPIClient) ListFile(ctx context.Context, in *ListFileRequest, opts ...grpc.CallOption) (*FileInfos, error) {
-	out := new(FileInfos)
-	err := grpc.Invoke(ctx, "/pfs.API/ListFile", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+func (m *GetObjectsRequest) GetSizeBytes() uint64 {
+	if m != nil {
+		return m.SizeBytes
 	}
-	return out, nil
+	return 0
 }
 
-func (c *aPIClient) ListFileStream(ctx context.Context, in *ListFileRequest, opts ...grpc.CallOption) (API_ListFileStreamClient, error) {
-	stream, err := grpc.NewClientStream(ctx, &_API_serviceDesc.Streams[4], c.cc, "/pfs.API/ListFileStream", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &aPIListFileStreamClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+func (m *GetObjectsRequest) GetTotalSize() uint64 {
+	if m != nil {
+		return m.TotalSize
 	}
-	return x, nil
+	return 0
 }
 
-type API_ListFileStreamClient interface {
-	Recv() (*FileInfo, error)
-	grpc.ClientStream
+type TagObjectRequest struct {
+	Object *Object `protobuf:"bytes,1,opt,name=object" json:"object,omitempty"`
+	Tags   []*Tag  `protobuf:"bytes,2,rep,name=tags" json:"tags,omitempty"`
 }
 
-type aPIListFileStreamClient struct {
-	grpc.ClientStream
-}
+func (m *TagObjectRequest) Reset()                    { *m = TagObjectRequest{} }
+func (m *TagObjectRequest) String() string            { return proto.CompactTextString(m) }
+func (*TagObjectRequest) ProtoMessage()               {}
+func (*TagObjectRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{77} }
 
-func (x *aPIListFileStreamClient) Recv() (*FileInfo, error) {
-	m := new(FileInfo)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+func (m *TagObjectRequest) GetObject() *Object {
+	if m != nil {
+		return m.Object
 	}
-	return m, nil
+	return nil
 }
 
-func (c *aPIClient) GlobFile(ctx context.Context, in *GlobFileRequest, opts ...grpc.CallOption) (*FileInfos, error) {
-	out := new(FileInfos)
-	err := grpc.Invoke(ctx, "/pfs.API/GlobFile", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+func (m *TagObjectRequest) GetTags() []*Tag {
+	if m != nil {
+		return m.Tags
 	}
-	return out, nil
+	return nil
 }
 
-func (c *aPIClient) GlobFileStream(ctx context.Context, in *GlobFileRequest, opts ...grpc.CallOption) (API_GlobFileStreamClient, error) {
-	stream, err := grpc.NewClientStream(ctx, &_API_serviceDesc.Streams[5], c.cc, "/pfs.API/GlobFileStream", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &aPIGlobFileStreamClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
-	}
-	return x, nil
+type ListObjectsRequest struct {
 }
 
-type API_GlobFileStreamClient interface {
-	Recv() (*FileInfo, error)
-	grpc.ClientStream
-}
+func (m *ListObjectsRequest) Reset()                    { *m = ListObjectsRequest{} }
+func (m *ListObjectsRequest) String() string            { return proto.CompactTextString(m) }
+func (*ListObjectsRequest) ProtoMessage()               {}
+func (*ListObjectsRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{78} }
 
-type aPIGlobFileStreamClient struct {
-	grpc.ClientStream
+type ListTagsRequest struct {
+	Prefix        string `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	IncludeObject bool   `protobuf:"varint,2,opt,name=includeObject,proto3" json:"includeObject,omitempty"`
 }
 
-func (x *aPIGlobFileStreamClient) Recv() (*FileInfo, error) {
-	m := new(FileInfo)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+func (m *ListTagsRequest) Reset()                    { *m = ListTagsRequest{} }
+func (m *ListTagsRequest) String() string            { return proto.CompactTextString(m) }
+func (*ListTagsRequest) ProtoMessage()               {}
+func (*ListTagsRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{79} }
+
+func (m *ListTagsRequest) GetPrefix() string {
+	if m != nil {
+		return m.Prefix
 	}
-	return m, nil
+	return ""
 }
 
-func (c *aPIClient) DiffFile(ctx context.Context, in *DiffFileRequest, opts ...grpc.CallOption) (*DiffFileResponse, error) {
-	out := new(DiffFileResponse)
-	err := grpc.Invoke(ctx, "/pfs.API/DiffFile", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+func (m *ListTagsRequest) GetIncludeObject() bool {
+	if m != nil {
+		return m.IncludeObject
 	}
-	return out, nil
+	return false
 }
 
-func (c *aPIClient) DeleteFile(ctx context.Context, in *DeleteFileRequest, opts ...grpc.CallOption) (*google_protobuf.Empty, error) {
-	out := new(google_protobuf.Empty)
-	err := grpc.Invoke(ctx, "/pfs.API/DeleteFile", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+type ListTagsResponse struct {
+	Tag    string  `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	Object *Object `protobuf:"bytes,2,opt,name=object" json:"object,omitempty"`
+}
+
+func (m *ListTagsResponse) Reset()                    { *m = ListTagsResponse{} }
+func (m *ListTagsResponse) String() string            { return proto.CompactTextString(m) }
+func (*ListTagsResponse) ProtoMessage()               {}
+func (*ListTagsResponse) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{80} }
+
+func (m *ListTagsResponse) GetTag() string {
+	if m != nil {
+		return m.Tag
 	}
-	return out, nil
+	return ""
 }
 
-func (c *aPIClient) DeleteAll(ctx context.Context, in *google_protobuf.Empty, opts ...grpc.CallOption) (*google_protobuf.Empty, error) {
-	out := new(google_protobuf.Empty)
-	err := grpc.Invoke(ctx, "/pfs.API/DeleteAll", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+func (m *ListTagsResponse) GetObject() *Object {
+	if m != nil {
+		return m.Object
 	}
-	return out, nil
+	return nil
 }
 
-// Server API for API service
+type DeleteObjectsRequest struct {
+	Objects []*Object `protobuf:"bytes,1,rep,name=objects" json:"objects,omitempty"`
+}
 
-type APIServer interface {
-	// Repo rpcs
-	// CreateRepo creates a new repo.
-	// An error is returned if the repo already exists.
-	CreateRepo(context.Context, *CreateRepoRequest) (*google_protobuf.Empty, error)
-	// InspectRepo returns info about a repo.
-	InspectRepo(context.Context, *InspectRepoRequest) (*RepoInfo, error)
-	// ListRepo returns info about all repos.
-	ListRepo(context.Context, *ListRepoRequest) (*ListRepoResponse, error)
-	// DeleteRepo deletes a repo.
-	DeleteRepo(context.Context, *DeleteRepoRequest) (*google_protobuf.Empty, error)
-	// Commit rpcs
-	// StartCommit creates a new write commit from a parent commit.
-	StartCommit(context.Context, *StartCommitRequest) (*Commit, error)
-	// FinishCommit turns a write commit into a read commit.
-	FinishCommit(context.Context, *FinishCommitRequest) (*google_protobuf.Empty, error)
-	// InspectCommit returns the info about a commit.
-	InspectCommit(context.Context, *InspectCommitRequest) (*CommitInfo, error)
-	// ListCommit returns info about all commits.
-	ListCommit(context.Context, *ListCommitRequest) (*CommitInfos, error)
-	// DeleteCommit deletes a commit.
-	DeleteCommit(context.Context, *DeleteCommitRequest) (*google_protobuf.Empty, error)
-	// FlushCommit waits for downstream commits to finish
-	FlushCommit(*FlushCommitRequest, API_FlushCommitServer) error
-	// SubscribeCommit subscribes for new commits on a given branch
-	SubscribeCommit(*SubscribeCommitRequest, API_SubscribeCommitServer) error
-	// BuildCommit builds a commit that's backed by the given tree
-	BuildCommit(context.Context, *BuildCommitRequest) (*Commit, error)
-	// ListBranch returns info about the heads of branches.
-	ListBranch(context.Context, *ListBranchRequest) (*BranchInfos, error)
-	// SetBranch assigns a commit and its ancestors to a branch.
-	SetBranch(context.Context, *SetBranchRequest) (*google_protobuf.Empty, error)
-	// DeleteBranch deletes a branch; note that the commits still exist.
-	DeleteBranch(context.Context, *DeleteBranchRequest) (*google_protobuf.Empty, error)
-	// File rpcs
-	// PutFile writes the specified file to pfs.
-	PutFile(API_PutFileServer) error
-	// CopyFile copies the contents of one file to another.
-	CopyFile(context.Context, *CopyFileRequest) (*google_protobuf.Empty, error)
-	// GetFile returns a byte stream of the contents of the file.
-	GetFile(*GetFileRequest, API_GetFileServer) error
-	// InspectFile returns info about a file.
-	InspectFile(context.Context, *InspectFileRequest) (*FileInfo, error)
-	// ListFile returns info about all files.
-	ListFile(context.Context, *ListFileRequest) (*FileInfos, error)
-	// ListFileStream is a streaming version of ListFile
-	// TODO(msteffen): When the dash has been updated to use ListFileStream,
-	// replace ListFile with this RPC (https://github.com/pachyderm/dash/issues/201)
-	ListFileStream(*ListFileRequest, API_ListFileStreamServer) error
-	// GlobFile returns info about all files.
-	GlobFile(context.Context, *GlobFileRequest) (*FileInfos, error)
-	// GlobFileStream is a streaming version of GlobFile
-	// TODO(msteffen): When the dash has been updated to use GlobFileStream,
-	// replace GlobFile with this RPC (https://github.com/pachyderm/dash/issues/201)
-	GlobFileStream(*GlobFileRequest, API_GlobFileStreamServer) error
-	// DiffFile returns the differences between 2 paths at 2 commits.
-	DiffFile(context.Context, *DiffFileRequest) (*DiffFileResponse, error)
-	// DeleteFile deletes a file.
-	DeleteFile(context.Context, *DeleteFileRequest) (*google_protobuf.Empty, error)
-	// DeleteAll deletes everything
-	DeleteAll(context.Context, *google_protobuf.Empty) (*google_protobuf.Empty, error)
+func (m *DeleteObjectsRequest) Reset()                    { *m = DeleteObjectsRequest{} }
+func (m *DeleteObjectsRequest) String() string            { return proto.CompactTextString(m) }
+func (*DeleteObjectsRequest) ProtoMessage()               {}
+func (*DeleteObjectsRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{81} }
+
+func (m *DeleteObjectsRequest) GetObjects() []*Object {
+	if m != nil {
+		return m.Objects
+	}
+	return nil
 }
 
-func RegisterAPIServer(s *grpc.Server, srv APIServer) {
-	s.RegisterService(&_API_serviceDesc, srv)
+type DeleteObjectsResponse struct {
 }
 
-func _API_CreateRepo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateRepoRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(APIServer).CreateRepo(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pfs.API/CreateRepo",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).CreateRepo(ctx, req.(*CreateRepoRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *DeleteObjectsResponse) Reset()                    { *m = DeleteObjectsResponse{} }
+func (m *DeleteObjectsResponse) String() string            { return proto.CompactTextString(m) }
+func (*DeleteObjectsResponse) ProtoMessage()               {}
+func (*DeleteObjectsResponse) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{82} }
+
+type DeleteTagsRequest struct {
+	Tags []string `protobuf:"bytes,1,rep,name=tags" json:"tags,omitempty"`
 }
 
-func _API_InspectRepo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(InspectRepoRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(APIServer).InspectRepo(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pfs.API/InspectRepo",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).InspectRepo(ctx, req.(*InspectRepoRequest))
+func (m *DeleteTagsRequest) Reset()                    { *m = DeleteTagsRequest{} }
+func (m *DeleteTagsRequest) String() string            { return proto.CompactTextString(m) }
+func (*DeleteTagsRequest) ProtoMessage()               {}
+func (*DeleteTagsRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{83} }
+
+func (m *DeleteTagsRequest) GetTags() []string {
+	if m != nil {
+		return m.Tags
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _API_ListRepo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListRepoRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(APIServer).ListRepo(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pfs.API/ListRepo",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).ListRepo(ctx, req.(*ListRepoRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type DeleteTagsResponse struct {
 }
 
-func _API_DeleteRepo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteRepoRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(APIServer).DeleteRepo(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pfs.API/DeleteRepo",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).DeleteRepo(ctx, req.(*DeleteRepoRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *DeleteTagsResponse) Reset()                    { *m = DeleteTagsResponse{} }
+func (m *DeleteTagsResponse) String() string            { return proto.CompactTextString(m) }
+func (*DeleteTagsResponse) ProtoMessage()               {}
+func (*DeleteTagsResponse) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{84} }
+
+type CheckObjectRequest struct {
+	Object *Object `protobuf:"bytes,1,opt,name=object" json:"object,omitempty"`
 }
 
-func _API_StartCommit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(StartCommitRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(APIServer).StartCommit(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pfs.API/StartCommit",
+func (m *CheckObjectRequest) Reset()                    { *m = CheckObjectRequest{} }
+func (m *CheckObjectRequest) String() string            { return proto.CompactTextString(m) }
+func (*CheckObjectRequest) ProtoMessage()               {}
+func (*CheckObjectRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{85} }
+
+func (m *CheckObjectRequest) GetObject() *Object {
+	if m != nil {
+		return m.Object
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).StartCommit(ctx, req.(*StartCommitRequest))
+	return nil
+}
+
+type CheckObjectResponse struct {
+	Exists bool `protobuf:"varint,1,opt,name=exists,proto3" json:"exists,omitempty"`
+}
+
+func (m *CheckObjectResponse) Reset()                    { *m = CheckObjectResponse{} }
+func (m *CheckObjectResponse) String() string            { return proto.CompactTextString(m) }
+func (*CheckObjectResponse) ProtoMessage()               {}
+func (*CheckObjectResponse) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{86} }
+
+func (m *CheckObjectResponse) GetExists() bool {
+	if m != nil {
+		return m.Exists
 	}
-	return interceptor(ctx, in, info, handler)
+	return false
 }
 
-func _API_FinishCommit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(FinishCommitRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(APIServer).FinishCommit(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pfs.API/FinishCommit",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).FinishCommit(ctx, req.(*FinishCommitRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type Objects struct {
+	Objects []*Object `protobuf:"bytes,1,rep,name=objects" json:"objects,omitempty"`
 }
 
-func _API_InspectCommit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(InspectCommitRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(APIServer).InspectCommit(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pfs.API/InspectCommit",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).InspectCommit(ctx, req.(*InspectCommitRequest))
+func (m *Objects) Reset()                    { *m = Objects{} }
+func (m *Objects) String() string            { return proto.CompactTextString(m) }
+func (*Objects) ProtoMessage()               {}
+func (*Objects) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{87} }
+
+func (m *Objects) GetObjects() []*Object {
+	if m != nil {
+		return m.Objects
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _API_ListCommit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListCommitRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(APIServer).ListCommit(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pfs.API/ListCommit",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).ListCommit(ctx, req.(*ListCommitRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type ObjectIndex struct {
+	Objects map[string]*BlockRef `protobuf:"bytes,1,rep,name=objects" json:"objects,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value"`
+	Tags    map[string]*Object   `protobuf:"bytes,2,rep,name=tags" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value"`
 }
 
-func _API_DeleteCommit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteCommitRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(APIServer).DeleteCommit(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pfs.API/DeleteCommit",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).DeleteCommit(ctx, req.(*DeleteCommitRequest))
+func (m *ObjectIndex) Reset()                    { *m = ObjectIndex{} }
+func (m *ObjectIndex) String() string            { return proto.CompactTextString(m) }
+func (*ObjectIndex) ProtoMessage()               {}
+func (*ObjectIndex) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{88} }
+
+func (m *ObjectIndex) GetObjects() map[string]*BlockRef {
+	if m != nil {
+		return m.Objects
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _API_FlushCommit_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(FlushCommitRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func (m *ObjectIndex) GetTags() map[string]*Object {
+	if m != nil {
+		return m.Tags
 	}
-	return srv.(APIServer).FlushCommit(m, &aPIFlushCommitServer{stream})
+	return nil
 }
 
-type API_FlushCommitServer interface {
-	Send(*CommitInfo) error
-	grpc.ServerStream
+func init() {
+	proto.RegisterType((*Repo)(nil), "pfs.Repo")
+	proto.RegisterType((*BranchInfo)(nil), "pfs.BranchInfo")
+	proto.RegisterType((*BranchInfos)(nil), "pfs.BranchInfos")
+	proto.RegisterType((*BranchMove)(nil), "pfs.BranchMove")
+	proto.RegisterType((*BranchHistory)(nil), "pfs.BranchHistory")
+	proto.RegisterType((*File)(nil), "pfs.File")
+	proto.RegisterType((*Block)(nil), "pfs.Block")
+	proto.RegisterType((*Object)(nil), "pfs.Object")
+	proto.RegisterType((*Tag)(nil), "pfs.Tag")
+	proto.RegisterType((*RepoInfo)(nil), "pfs.RepoInfo")
+	proto.RegisterType((*BranchProtection)(nil), "pfs.BranchProtection")
+	proto.RegisterType((*RetentionPolicy)(nil), "pfs.RetentionPolicy")
+	proto.RegisterType((*Quota)(nil), "pfs.Quota")
+	proto.RegisterType((*RepoAuthInfo)(nil), "pfs.RepoAuthInfo")
+	proto.RegisterType((*Commit)(nil), "pfs.Commit")
+	proto.RegisterType((*CommitInfo)(nil), "pfs.CommitInfo")
+	proto.RegisterType((*FileInfo)(nil), "pfs.FileInfo")
+	proto.RegisterType((*ByteRange)(nil), "pfs.ByteRange")
+	proto.RegisterType((*BlockRef)(nil), "pfs.BlockRef")
+	proto.RegisterType((*ObjectInfo)(nil), "pfs.ObjectInfo")
+	proto.RegisterType((*CreateRepoRequest)(nil), "pfs.CreateRepoRequest")
+	proto.RegisterType((*InspectRepoRequest)(nil), "pfs.InspectRepoRequest")
+	proto.RegisterType((*ListRepoRequest)(nil), "pfs.ListRepoRequest")
+	proto.RegisterType((*ListRepoResponse)(nil), "pfs.ListRepoResponse")
+	proto.RegisterType((*DeleteRepoRequest)(nil), "pfs.DeleteRepoRequest")
+	proto.RegisterType((*ForkRepoRequest)(nil), "pfs.ForkRepoRequest")
+	proto.RegisterType((*RenameRepoRequest)(nil), "pfs.RenameRepoRequest")
+	proto.RegisterType((*StartCommitRequest)(nil), "pfs.StartCommitRequest")
+	proto.RegisterType((*BuildCommitRequest)(nil), "pfs.BuildCommitRequest")
+	proto.RegisterType((*FinishCommitRequest)(nil), "pfs.FinishCommitRequest")
+	proto.RegisterType((*InspectCommitRequest)(nil), "pfs.InspectCommitRequest")
+	proto.RegisterType((*ListCommitRequest)(nil), "pfs.ListCommitRequest")
+	proto.RegisterType((*CommitInfos)(nil), "pfs.CommitInfos")
+	proto.RegisterType((*ListBranchRequest)(nil), "pfs.ListBranchRequest")
+	proto.RegisterType((*SetBranchRequest)(nil), "pfs.SetBranchRequest")
+	proto.RegisterType((*DeleteBranchRequest)(nil), "pfs.DeleteBranchRequest")
+	proto.RegisterType((*ListBranchHistoryRequest)(nil), "pfs.ListBranchHistoryRequest")
+	proto.RegisterType((*SetBranchProtectionRequest)(nil), "pfs.SetBranchProtectionRequest")
+	proto.RegisterType((*DeleteBranchProtectionRequest)(nil), "pfs.DeleteBranchProtectionRequest")
+	proto.RegisterType((*SetRetentionPolicyRequest)(nil), "pfs.SetRetentionPolicyRequest")
+	proto.RegisterType((*ApplyRetentionPolicyRequest)(nil), "pfs.ApplyRetentionPolicyRequest")
+	proto.RegisterType((*ApplyRetentionPolicyResponse)(nil), "pfs.ApplyRetentionPolicyResponse")
+	proto.RegisterType((*SetQuotaRequest)(nil), "pfs.SetQuotaRequest")
+	proto.RegisterType((*CheckQuotaRequest)(nil), "pfs.CheckQuotaRequest")
+	proto.RegisterType((*QuotaUsageRequest)(nil), "pfs.QuotaUsageRequest")
+	proto.RegisterType((*Usage)(nil), "pfs.Usage")
+	proto.RegisterType((*RepoUsage)(nil), "pfs.RepoUsage")
+	proto.RegisterType((*PrincipalUsage)(nil), "pfs.PrincipalUsage")
+	proto.RegisterType((*QuotaUsageResponse)(nil), "pfs.QuotaUsageResponse")
+	proto.RegisterType((*DeleteCommitRequest)(nil), "pfs.DeleteCommitRequest")
+	proto.RegisterType((*FlushCommitRequest)(nil), "pfs.FlushCommitRequest")
+	proto.RegisterType((*SubscribeCommitRequest)(nil), "pfs.SubscribeCommitRequest")
+	proto.RegisterType((*GetFileRequest)(nil), "pfs.GetFileRequest")
+	proto.RegisterType((*GetArchiveRequest)(nil), "pfs.GetArchiveRequest")
+	proto.RegisterType((*OverwriteIndex)(nil), "pfs.OverwriteIndex")
+	proto.RegisterType((*PutFileRequest)(nil), "pfs.PutFileRequest")
+	proto.RegisterType((*PutFileRecord)(nil), "pfs.PutFileRecord")
+	proto.RegisterType((*PutFileRecords)(nil), "pfs.PutFileRecords")
+	proto.RegisterType((*Transaction)(nil), "pfs.Transaction")
+	proto.RegisterType((*TransactionInfo)(nil), "pfs.TransactionInfo")
+	proto.RegisterType((*TransactionRequest)(nil), "pfs.TransactionRequest")
+	proto.RegisterType((*TransactionPutFile)(nil), "pfs.TransactionPutFile")
+	proto.RegisterType((*StartTransactionRequest)(nil), "pfs.StartTransactionRequest")
+	proto.RegisterType((*FinishTransactionRequest)(nil), "pfs.FinishTransactionRequest")
+	proto.RegisterType((*DeleteTransactionRequest)(nil), "pfs.DeleteTransactionRequest")
+	proto.RegisterType((*CopyFileRequest)(nil), "pfs.CopyFileRequest")
+	proto.RegisterType((*InspectFileRequest)(nil), "pfs.InspectFileRequest")
+	proto.RegisterType((*ListFileRequest)(nil), "pfs.ListFileRequest")
+	proto.RegisterType((*GlobFileRequest)(nil), "pfs.GlobFileRequest")
+	proto.RegisterType((*GrepFileRequest)(nil), "pfs.GrepFileRequest")
+	proto.RegisterType((*GrepFileResult)(nil), "pfs.GrepFileResult")
+	proto.RegisterType((*FileInfos)(nil), "pfs.FileInfos")
+	proto.RegisterType((*DiffFileRequest)(nil), "pfs.DiffFileRequest")
+	proto.RegisterType((*DiffFileResponse)(nil), "pfs.DiffFileResponse")
+	proto.RegisterType((*DeleteFileRequest)(nil), "pfs.DeleteFileRequest")
+	proto.RegisterType((*PutObjectRequest)(nil), "pfs.PutObjectRequest")
+	proto.RegisterType((*GetObjectsRequest)(nil), "pfs.GetObjectsRequest")
+	proto.RegisterType((*TagObjectRequest)(nil), "pfs.TagObjectRequest")
+	proto.RegisterType((*ListObjectsRequest)(nil), "pfs.ListObjectsRequest")
+	proto.RegisterType((*ListTagsRequest)(nil), "pfs.ListTagsRequest")
+	proto.RegisterType((*ListTagsResponse)(nil), "pfs.ListTagsResponse")
+	proto.RegisterType((*DeleteObjectsRequest)(nil), "pfs.DeleteObjectsRequest")
+	proto.RegisterType((*DeleteObjectsResponse)(nil), "pfs.DeleteObjectsResponse")
+	proto.RegisterType((*DeleteTagsRequest)(nil), "pfs.DeleteTagsRequest")
+	proto.RegisterType((*DeleteTagsResponse)(nil), "pfs.DeleteTagsResponse")
+	proto.RegisterType((*CheckObjectRequest)(nil), "pfs.CheckObjectRequest")
+	proto.RegisterType((*CheckObjectResponse)(nil), "pfs.CheckObjectResponse")
+	proto.RegisterType((*Objects)(nil), "pfs.Objects")
+	proto.RegisterType((*ObjectIndex)(nil), "pfs.ObjectIndex")
+	proto.RegisterEnum("pfs.FileType", FileType_name, FileType_value)
+	proto.RegisterEnum("pfs.ArchiveFormat", ArchiveFormat_name, ArchiveFormat_value)
+	proto.RegisterEnum("pfs.Delimiter", Delimiter_name, Delimiter_value)
 }
 
-type aPIFlushCommitServer struct {
-	grpc.ServerStream
-}
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
 
-func (x *aPIFlushCommitServer) Send(m *CommitInfo) error {
-	return x.ServerStream.SendMsg(m)
-}
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
 
-func _API_SubscribeCommit_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(SubscribeCommitRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
-	}
-	return srv.(APIServer).SubscribeCommit(m, &aPISubscribeCommitServer{stream})
-}
+// Client API for API service
 
-type API_SubscribeCommitServer interface {
-	Send(*CommitInfo) error
-	grpc.ServerStream
+type APIClient interface {
+	// Repo rpcs
+	// CreateRepo creates a new repo.
+	// An error is returned if the repo already exists.
+	CreateRepo(ctx context.Context, in *CreateRepoRequest, opts ...grpc.CallOption) (*google_protobuf1.Empty, error)
+	// InspectRepo returns info about a repo.
+	InspectRepo(ctx context.Context, in *InspectRepoRequest, opts ...grpc.CallOption) (*RepoInfo, error)
+	// ListRepo returns info about all repos.
+	ListRepo(ctx context.Context, in *ListRepoRequest, opts ...grpc.CallOption) (*ListRepoResponse, error)
+	// DeleteRepo deletes a repo.
+	DeleteRepo(ctx context.Context, in *DeleteRepoRequest, opts ...grpc.CallOption) (*google_protobuf1.Empty, error)
+	// ForkRepo creates a new repo with a copy of each of the finished commits
+	// and branches of an existing repo. The copies share the trees of the
+	// original commits, so no data is copied. Open commits aren't copied.
+	ForkRepo(ctx context.Context, in *ForkRepoRequest, opts ...grpc.CallOption) (*google_protobuf1.Empty, error)
+	// RenameRepo renames a repo, and updates its commits, its branches, its
+	// ACL, the provenance of downstream repos and commits, and the inputs of
+	// pipelines, in one etcd transaction. Repos with open commits, and the
+	// output repos of pipelines, can't be renamed.
+	RenameRepo(ctx context.Context, in *RenameRepoRequest, opts ...grpc.CallOption) (*google_protobuf1.Empty, error)
+	// Commit rpcs
+	// StartCommit creates a new write commit from a parent commit.
+	StartCommit(ctx context.Context, in *StartCommitRequest, opts ...grpc.CallOption) (*Commit, error)
+	// FinishCommit turns a write commit into a read commit.
+	FinishCommit(ctx context.Context, in *FinishCommitRequest, opts ...grpc.CallOption) (*google_protobuf1.Empty, error)
+	// InspectCommit returns the info about a commit.
+	InspectCommit(ctx context.Context, in *InspectCommitRequest, opts ...grpc.CallOption) (*CommitInfo, error)
+	// ListCommit returns info about all commits.
+	ListCommit(ctx context.Context, in *ListCommitRequest, opts ...grpc.CallOption) (*CommitInfos, error)
+	// DeleteCommit deletes a commit.
+	DeleteCommit(ctx context.Context, in *DeleteCommitRequest, opts ...grpc.CallOption) (*google_protobuf1.Empty, error)
+	// FlushCommit waits for downstream commits to finish
+	FlushCommit(ctx context.Context, in *FlushCommitRequest, opts ...grpc.CallOption) (API_FlushCommitClient, error)
+	// SubscribeCommit subscribes for new commits on a given branch
+	SubscribeCommit(ctx context.Context, in *SubscribeCommitRequest, opts ...grpc.CallOption) (API_SubscribeCommitClient, error)
+	// BuildCommit builds a commit that's backed by the given tree
+	BuildCommit(ctx context.Context, in *BuildCommitRequest, opts ...grpc.CallOption) (*Commit, error)
+	// ListBranch returns info about the heads of branches.
+	ListBranch(ctx context.Context, in *ListBranchRequest, opts ...grpc.CallOption) (*BranchInfos, error)
+	// SetBranch assigns a commit and its ancestors to a branch.
+	SetBranch(ctx context.Context, in *SetBranchRequest, opts ...grpc.CallOption) (*google_protobuf1.Empty, error)
+	// DeleteBranch deletes a branch; note that the commits still exist.
+	DeleteBranch(ctx context.Context, in *DeleteBranchRequest, opts ...grpc.CallOption) (*google_protobuf1.Empty, error)
+	// ListBranchHistory returns the moves of a branch's head, newest first.
+	// The history of a deleted branch is kept until its repo is deleted.
+	ListBranchHistory(ctx context.Context, in *ListBranchHistoryRequest, opts ...grpc.CallOption) (*BranchHistory, error)
+	// SetBranchProtection creates or replaces the protection rule for a branch.
+	SetBranchProtection(ctx context.Context, in *SetBranchProtectionRequest, opts ...grpc.CallOption) (*google_protobuf1.Empty, error)
+	// DeleteBranchProtection removes the protection rule for a branch.
+	DeleteBranchProtection(ctx context.Context, in *DeleteBranchProtectionRequest, opts ...grpc.CallOption) (*google_protobuf1.Empty, error)
+	// SetRetentionPolicy sets or removes the retention policy of a repo
+	SetRetentionPolicy(ctx context.Context, in *SetRetentionPolicyRequest, opts ...grpc.CallOption) (*google_protobuf1.Empty, error)
+	// ApplyRetentionPolicy deletes the commits that a repo's retention policy
+	// doesn't keep, and returns them. pachd also does this periodically.
+	ApplyRetentionPolicy(ctx context.Context, in *ApplyRetentionPolicyRequest, opts ...grpc.CallOption) (*ApplyRetentionPolicyResponse, error)
+	// SetQuota sets or removes the quota of a repo or a principal. Only cluster
+	// admins may set quotas.
+	SetQuota(ctx context.Context, in *SetQuotaRequest, opts ...grpc.CallOption) (*google_protobuf1.Empty, error)
+	// CheckQuota returns a RESOURCE_EXHAUSTED error if adding data to a repo
+	// would exceed its quota or its creator's quota.
+	CheckQuota(ctx context.Context, in *CheckQuotaRequest, opts ...grpc.CallOption) (*google_protobuf1.Empty, error)
+	// QuotaUsage returns the storage used by each repo and each principal.
+	QuotaUsage(ctx context.Context, in *QuotaUsageRequest, opts ...grpc.CallOption) (*QuotaUsageResponse, error)
+	// Transaction rpcs
+	// StartTransaction starts a transaction, which records StartCommit,
+	// FinishCommit, PutFile, DeleteFile and SetBranch requests made with its ID
+	// instead of applying them.
+	StartTransaction(ctx context.Context, in *StartTransactionRequest, opts ...grpc.CallOption) (*Transaction, error)
+	// FinishTransaction applies all of the requests recorded in a transaction
+	// atomically, and deletes the transaction.
+	FinishTransaction(ctx context.Context, in *FinishTransactionRequest, opts ...grpc.CallOption) (*google_protobuf1.Empty, error)
+	// DeleteTransaction deletes a transaction without applying its requests.
+	DeleteTransaction(ctx context.Context, in *DeleteTransactionRequest, opts ...grpc.CallOption) (*google_protobuf1.Empty, error)
+	// File rpcs
+	// PutFile writes the specified file to pfs.
+	PutFile(ctx context.Context, opts ...grpc.CallOption) (API_PutFileClient, error)
+	// CopyFile copies the contents of one file to another.
+	CopyFile(ctx context.Context, in *CopyFileRequest, opts ...grpc.CallOption) (*google_protobuf1.Empty, error)
+	// GetFile returns a byte stream of the contents of the file.
+	GetFile(ctx context.Context, in *GetFileRequest, opts ...grpc.CallOption) (API_GetFileClient, error)
+	// GetArchive returns a byte stream of an archive of the files and
+	// directories that match a path or glob pattern.
+	GetArchive(ctx context.Context, in *GetArchiveRequest, opts ...grpc.CallOption) (API_GetArchiveClient, error)
+	// InspectFile returns info about a file.
+	InspectFile(ctx context.Context, in *InspectFileRequest, opts ...grpc.CallOption) (*FileInfo, error)
+	// ListFile returns info about all files.
+	ListFile(ctx context.Context, in *ListFileRequest, opts ...grpc.CallOption) (*FileInfos, error)
+	// ListFileStream is a streaming version of ListFile
+	// TODO(msteffen): When the dash has been updated to use ListFileStream,
+	// replace ListFile with this RPC (https://github.com/pachyderm/dash/issues/201)
+	ListFileStream(ctx context.Context, in *ListFileRequest, opts ...grpc.CallOption) (API_ListFileStreamClient, error)
+	// GlobFile returns info about all files.
+	GlobFile(ctx context.Context, in *GlobFileRequest, opts ...grpc.CallOption) (*FileInfos, error)
+	// GlobFileStream is a streaming version of GlobFile
+	// TODO(msteffen): When the dash has been updated to use GlobFileStream,
+	// replace GlobFile with this RPC (https://github.com/pachyderm/dash/issues/201)
+	GlobFileStream(ctx context.Context, in *GlobFileRequest, opts ...grpc.CallOption) (API_GlobFileStreamClient, error)
+	// GrepFile returns the lines matching a regex in the files matching a glob
+	// pattern.
+	GrepFile(ctx context.Context, in *GrepFileRequest, opts ...grpc.CallOption) (API_GrepFileClient, error)
+	// DiffFile returns the differences between 2 paths at 2 commits.
+	DiffFile(ctx context.Context, in *DiffFileRequest, opts ...grpc.CallOption) (*DiffFileResponse, error)
+	// DeleteFile deletes a file.
+	DeleteFile(ctx context.Context, in *DeleteFileRequest, opts ...grpc.CallOption) (*google_protobuf1.Empty, error)
+	// DeleteAll deletes everything
+	DeleteAll(ctx context.Context, in *google_protobuf1.Empty, opts ...grpc.CallOption) (*google_protobuf1.Empty, error)
 }
 
-type aPISubscribeCommitServer struct {
-	grpc.ServerStream
+type aPIClient struct {
+	cc *grpc.ClientConn
 }
 
-func (x *aPISubscribeCommitServer) Send(m *CommitInfo) error {
-	return x.ServerStream.SendMsg(m)
+func NewAPIClient(cc *grpc.ClientConn) APIClient {
+	return &aPIClient{cc}
 }
 
-func _API_BuildCommit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BuildCommitRequest)
-	if err := dec(in); err != nil {
+func (c *aPIClient) CreateRepo(ctx context.Context, in *CreateRepoRequest, opts ...grpc.CallOption) (*google_protobuf1.Empty, error) {
+	out := new(google_protobuf1.Empty)
+	err := grpc.Invoke(ctx, "/pfs.API/CreateRepo", in, out, c.cc, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(APIServer).BuildCommit(ctx, in)
+	return out, nil
+}
+
+func (c *aPIClient) InspectRepo(ctx context.Context, in *InspectRepoRequest, opts ...grpc.CallOption) (*RepoInfo, error) {
+	out := new(RepoInfo)
+	err := grpc.Invoke(ctx, "/pfs.API/InspectRepo", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pfs.API/BuildCommit",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).BuildCommit(ctx, req.(*BuildCommitRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _API_ListBranch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListBranchRequest)
-	if err := dec(in); err != nil {
+func (c *aPIClient) ListRepo(ctx context.Context, in *ListRepoRequest, opts ...grpc.CallOption) (*ListRepoResponse, error) {
+	out := new(ListRepoResponse)
+	err := grpc.Invoke(ctx, "/pfs.API/ListRepo", in, out, c.cc, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(APIServer).ListBranch(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pfs.API/ListBranch",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).ListBranch(ctx, req.(*ListBranchRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _API_SetBranch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetBranchRequest)
-	if err := dec(in); err != nil {
+func (c *aPIClient) DeleteRepo(ctx context.Context, in *DeleteRepoRequest, opts ...grpc.CallOption) (*google_protobuf1.Empty, error) {
+	out := new(google_protobuf1.Empty)
+	err := grpc.Invoke(ctx, "/pfs.API/DeleteRepo", in, out, c.cc, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(APIServer).SetBranch(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pfs.API/SetBranch",
+	return out, nil
+}
+
+func (c *aPIClient) ForkRepo(ctx context.Context, in *ForkRepoRequest, opts ...grpc.CallOption) (*google_protobuf1.Empty, error) {
+	out := new(google_protobuf1.Empty)
+	err := grpc.Invoke(ctx, "/pfs.API/ForkRepo", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).SetBranch(ctx, req.(*SetBranchRequest))
+	return out, nil
+}
+
+func (c *aPIClient) RenameRepo(ctx context.Context, in *RenameRepoRequest, opts ...grpc.CallOption) (*google_protobuf1.Empty, error) {
+	out := new(google_protobuf1.Empty)
+	err := grpc.Invoke(ctx, "/pfs.API/RenameRepo", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _API_DeleteBranch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteBranchRequest)
-	if err := dec(in); err != nil {
+func (c *aPIClient) StartCommit(ctx context.Context, in *StartCommitRequest, opts ...grpc.CallOption) (*Commit, error) {
+	out := new(Commit)
+	err := grpc.Invoke(ctx, "/pfs.API/StartCommit", in, out, c.cc, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(APIServer).DeleteBranch(ctx, in)
+	return out, nil
+}
+
+func (c *aPIClient) FinishCommit(ctx context.Context, in *FinishCommitRequest, opts ...grpc.CallOption) (*google_protobuf1.Empty, error) {
+	out := new(google_protobuf1.Empty)
+	err := grpc.Invoke(ctx, "/pfs.API/FinishCommit", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pfs.API/DeleteBranch",
+	return out, nil
+}
+
+func (c *aPIClient) InspectCommit(ctx context.Context, in *InspectCommitRequest, opts ...grpc.CallOption) (*CommitInfo, error) {
+	out := new(CommitInfo)
+	err := grpc.Invoke(ctx, "/pfs.API/InspectCommit", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).DeleteBranch(ctx, req.(*DeleteBranchRequest))
+	return out, nil
+}
+
+func (c *aPIClient) ListCommit(ctx context.Context, in *ListCommitRequest, opts ...grpc.CallOption) (*CommitInfos, error) {
+	out := new(CommitInfos)
+	err := grpc.Invoke(ctx, "/pfs.API/ListCommit", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _API_PutFile_Handler(srv interface{}, stream grpc.ServerStream) error {
-	return srv.(APIServer).PutFile(&aPIPutFileServer{stream})
+func (c *aPIClient) DeleteCommit(ctx context.Context, in *DeleteCommitRequest, opts ...grpc.CallOption) (*google_protobuf1.Empty, error) {
+	out := new(google_protobuf1.Empty)
+	err := grpc.Invoke(ctx, "/pfs.API/DeleteCommit", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-type API_PutFileServer interface {
-	SendAndClose(*google_protobuf.Empty) error
-	Recv() (*PutFileRequest, error)
-	grpc.ServerStream
+func (c *aPIClient) FlushCommit(ctx context.Context, in *FlushCommitRequest, opts ...grpc.CallOption) (API_FlushCommitClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_API_serviceDesc.Streams[0], c.cc, "/pfs.API/FlushCommit", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &aPIFlushCommitClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
 
-type aPIPutFileServer struct {
-	grpc.ServerStream
+type API_FlushCommitClient interface {
+	Recv() (*CommitInfo, error)
+	grpc.ClientStream
 }
 
-func (x *aPIPutFileServer) SendAndClose(m *google_protobuf.Empty) error {
-	return x.ServerStream.SendMsg(m)
+type aPIFlushCommitClient struct {
+	grpc.ClientStream
 }
 
-func (x *aPIPutFileServer) Recv() (*PutFileRequest, error) {
-	m := new(PutFileRequest)
-	if err := x.ServerStream.RecvMsg(m); err != nil {
+func (x *aPIFlushCommitClient) Recv() (*CommitInfo, error) {
+	m := new(CommitInfo)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
 	return m, nil
 }
 
-func _API_CopyFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CopyFileRequest)
-	if err := dec(in); err != nil {
+func (c *aPIClient) SubscribeCommit(ctx context.Context, in *SubscribeCommitRequest, opts ...grpc.CallOption) (API_SubscribeCommitClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_API_serviceDesc.Streams[1], c.cc, "/pfs.API/SubscribeCommit", opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(APIServer).CopyFile(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pfs.API/CopyFile",
+	x := &aPISubscribeCommitClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).CopyFile(ctx, req.(*CopyFileRequest))
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return x, nil
 }
 
-func _API_GetFile_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(GetFileRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
-	}
-	return srv.(APIServer).GetFile(m, &aPIGetFileServer{stream})
+type API_SubscribeCommitClient interface {
+	Recv() (*CommitInfo, error)
+	grpc.ClientStream
 }
 
-type API_GetFileServer interface {
-	Send(*google_protobuf2.BytesValue) error
-	grpc.ServerStream
+type aPISubscribeCommitClient struct {
+	grpc.ClientStream
 }
 
-type aPIGetFileServer struct {
-	grpc.ServerStream
+func (x *aPISubscribeCommitClient) Recv() (*CommitInfo, error) {
+	m := new(CommitInfo)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
 }
 
-func (x *aPIGetFileServer) Send(m *google_protobuf2.BytesValue) error {
-	return x.ServerStream.SendMsg(m)
+func (c *aPIClient) BuildCommit(ctx context.Context, in *BuildCommitRequest, opts ...grpc.CallOption) (*Commit, error) {
+	out := new(Commit)
+	err := grpc.Invoke(ctx, "/pfs.API/BuildCommit", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func _API_InspectFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(InspectFileRequest)
-	if err := dec(in); err != nil {
+func (c *aPIClient) ListBranch(ctx context.Context, in *ListBranchRequest, opts ...grpc.CallOption) (*BranchInfos, error) {
+	out := new(BranchInfos)
+	err := grpc.Invoke(ctx, "/pfs.API/ListBranch", in, out, c.cc, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(APIServer).InspectFile(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pfs.API/InspectFile",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).InspectFile(ctx, req.(*InspectFileRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _API_ListFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListFileRequest)
-	if err := dec(in); err != nil {
+func (c *aPIClient) SetBranch(ctx context.Context, in *SetBranchRequest, opts ...grpc.CallOption) (*google_protobuf1.Empty, error) {
+	out := new(google_protobuf1.Empty)
+	err := grpc.Invoke(ctx, "/pfs.API/SetBranch", in, out, c.cc, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(APIServer).ListFile(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pfs.API/ListFile",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).ListFile(ctx, req.(*ListFileRequest))
+	return out, nil
+}
+
+func (c *aPIClient) DeleteBranch(ctx context.Context, in *DeleteBranchRequest, opts ...grpc.CallOption) (*google_protobuf1.Empty, error) {
+	out := new(google_protobuf1.Empty)
+	err := grpc.Invoke(ctx, "/pfs.API/DeleteBranch", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _API_ListFileStream_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(ListFileRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func (c *aPIClient) ListBranchHistory(ctx context.Context, in *ListBranchHistoryRequest, opts ...grpc.CallOption) (*BranchHistory, error) {
+	out := new(BranchHistory)
+	err := grpc.Invoke(ctx, "/pfs.API/ListBranchHistory", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return srv.(APIServer).ListFileStream(m, &aPIListFileStreamServer{stream})
+	return out, nil
 }
 
-type API_ListFileStreamServer interface {
-	Send(*FileInfo) error
-	grpc.ServerStream
+func (c *aPIClient) SetBranchProtection(ctx context.Context, in *SetBranchProtectionRequest, opts ...grpc.CallOption) (*google_protobuf1.Empty, error) {
+	out := new(google_protobuf1.Empty)
+	err := grpc.Invoke(ctx, "/pfs.API/SetBranchProtection", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-type aPIListFileStreamServer struct {
-	grpc.ServerStream
+func (c *aPIClient) DeleteBranchProtection(ctx context.Context, in *DeleteBranchProtectionRequest, opts ...grpc.CallOption) (*google_protobuf1.Empty, error) {
+	out := new(google_protobuf1.Empty)
+	err := grpc.Invoke(ctx, "/pfs.API/DeleteBranchProtection", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (x *aPIListFileStreamServer) Send(m *FileInfo) error {
-	return x.ServerStream.SendMsg(m)
+func (c *aPIClient) SetRetentionPolicy(ctx context.Context, in *SetRetentionPolicyRequest, opts ...grpc.CallOption) (*google_protobuf1.Empty, error) {
+	out := new(google_protobuf1.Empty)
+	err := grpc.Invoke(ctx, "/pfs.API/SetRetentionPolicy", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func _API_GlobFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GlobFileRequest)
-	if err := dec(in); err != nil {
+func (c *aPIClient) ApplyRetentionPolicy(ctx context.Context, in *ApplyRetentionPolicyRequest, opts ...grpc.CallOption) (*ApplyRetentionPolicyResponse, error) {
+	out := new(ApplyRetentionPolicyResponse)
+	err := grpc.Invoke(ctx, "/pfs.API/ApplyRetentionPolicy", in, out, c.cc, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(APIServer).GlobFile(ctx, in)
+	return out, nil
+}
+
+func (c *aPIClient) SetQuota(ctx context.Context, in *SetQuotaRequest, opts ...grpc.CallOption) (*google_protobuf1.Empty, error) {
+	out := new(google_protobuf1.Empty)
+	err := grpc.Invoke(ctx, "/pfs.API/SetQuota", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pfs.API/GlobFile",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).GlobFile(ctx, req.(*GlobFileRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _API_GlobFileStream_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(GlobFileRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func (c *aPIClient) CheckQuota(ctx context.Context, in *CheckQuotaRequest, opts ...grpc.CallOption) (*google_protobuf1.Empty, error) {
+	out := new(google_protobuf1.Empty)
+	err := grpc.Invoke(ctx, "/pfs.API/CheckQuota", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return srv.(APIServer).GlobFileStream(m, &aPIGlobFileStreamServer{stream})
-}
-
-type API_GlobFileStreamServer interface {
-	Send(*FileInfo) error
-	grpc.ServerStream
-}
-
-type aPIGlobFileStreamServer struct {
-	grpc.ServerStream
-}
-
-func (x *aPIGlobFileStreamServer) Send(m *FileInfo) error {
-	return x.ServerStream.SendMsg(m)
+	return out, nil
 }
 
-func _API_DiffFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DiffFileRequest)
-	if err := dec(in); err != nil {
+func (c *aPIClient) QuotaUsage(ctx context.Context, in *QuotaUsageRequest, opts ...grpc.CallOption) (*QuotaUsageResponse, error) {
+	out := new(QuotaUsageResponse)
+	err := grpc.Invoke(ctx, "/pfs.API/QuotaUsage", in, out, c.cc, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(APIServer).DiffFile(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pfs.API/DiffFile",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).DiffFile(ctx, req.(*DiffFileRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _API_DeleteFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteFileRequest)
-	if err := dec(in); err != nil {
+func (c *aPIClient) StartTransaction(ctx context.Context, in *StartTransactionRequest, opts ...grpc.CallOption) (*Transaction, error) {
+	out := new(Transaction)
+	err := grpc.Invoke(ctx, "/pfs.API/StartTransaction", in, out, c.cc, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(APIServer).DeleteFile(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pfs.API/DeleteFile",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).DeleteFile(ctx, req.(*DeleteFileRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _API_DeleteAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(google_protobuf.Empty)
-	if err := dec(in); err != nil {
+func (c *aPIClient) FinishTransaction(ctx context.Context, in *FinishTransactionRequest, opts ...grpc.CallOption) (*google_protobuf1.Empty, error) {
+	out := new(google_protobuf1.Empty)
+	err := grpc.Invoke(ctx, "/pfs.API/FinishTransaction", in, out, c.cc, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(APIServer).DeleteAll(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pfs.API/DeleteAll",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).DeleteAll(ctx, req.(*google_protobuf.Empty))
-	}
-	return interceptor(ctx, in, info, handler)
-}
-
-var _API_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "pfs.API",
-	HandlerType: (*APIServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "CreateRepo",
-			Handler:    _API_CreateRepo_Handler,
-		},
-		{
-			MethodName: "InspectRepo",
-			Handler:    _API_InspectRepo_Handler,
-		},
-		{
-			MethodName: "ListRepo",
-			Handler:    _API_ListRepo_Handler,
-		},
-		{
-			MethodName: "DeleteRepo",
-			Handler:    _API_DeleteRepo_Handler,
-		},
-		{
-			MethodName: "StartCommit",
-			Handler:    _API_StartCommit_Handler,
-		},
-		{
-			MethodName: "FinishCommit",
-			Handler:    _API_FinishCommit_Handler,
-		},
-		{
-			MethodName: "InspectCommit",
-			Handler:    _API_InspectCommit_Handler,
-		},
-		{
-			MethodName: "ListCommit",
-			Handler:    _API_ListCommit_Handler,
-		},
-		{
-			MethodName: "DeleteCommit",
-			Handler:    _API_DeleteCommit_Handler,
-		},
-		{
-			MethodName: "BuildCommit",
-			Handler:    _API_BuildCommit_Handler,
-		},
-		{
-			MethodName: "ListBranch",
-			Handler:    _API_ListBranch_Handler,
-		},
-		{
-			MethodName: "SetBranch",
-			Handler:    _API_SetBranch_Handler,
-		},
-		{
-			MethodName: "DeleteBranch",
-			Handler:    _API_DeleteBranch_Handler,
-		},
-		{
-			MethodName: "CopyFile",
-			Handler:    _API_CopyFile_Handler,
-		},
-		{
-			MethodName: "InspectFile",
-			Handler:    _API_InspectFile_Handler,
-		},
-		{
-			MethodName: "ListFile",
-			Handler:    _API_ListFile_Handler,
-		},
-		{
-			MethodName: "GlobFile",
-			Handler:    _API_GlobFile_Handler,
-		},
-		{
-			MethodName: "DiffFile",
-			Handler:    _API_DiffFile_Handler,
-		},
-		{
-			MethodName: "DeleteFile",
-			Handler:    _API_DeleteFile_Handler,
-		},
-		{
-			MethodName: "DeleteAll",
-			Handler:    _API_DeleteAll_Handler,
-		},
-	},
-	Streams: []grpc.StreamDesc{
-		{
-			StreamName:    "FlushCommit",
-			Handler:       _API_FlushCommit_Handler,
-			ServerStreams: true,
-		},
-		{
-			StreamName:    "SubscribeCommit",
-			Handler:       _API_SubscribeCommit_Handler,
-			ServerStreams: true,
-		},
-		{
-			StreamName:    "PutFile",
-			Handler:       _API_PutFile_Handler,
-			ClientStreams: true,
-		},
-		{
-			StreamName:    "GetFile",
-			Handler:       _API_GetFile_Handler,
-			ServerStreams: true,
-		},
-		{
-			StreamName:    "ListFileStream",
-			Handler:       _API_ListFileStream_Handler,
-			ServerStreams: true,
-		},
-		{
-			StreamName:    "GlobFileStream",
-			Handler:       _API_GlobFileStream_Handler,
-			ServerStreams: true,
-		},
-	},
-	Metadata: "client/pfs/pfs.proto",
-}
-
-// Client API for ObjectAPI service
-
-type ObjectAPIClient interface {
-	PutObject(ctx context.Context, opts ...grpc.CallOption) (ObjectAPI_PutObjectClient, error)
-	PutObjectSplit(ctx context.Context, opts ...grpc.CallOption) (ObjectAPI_PutObjectSplitClient, error)
-	GetObject(ctx context.Context, in *Object, opts ...grpc.CallOption) (ObjectAPI_GetObjectClient, error)
-	GetObjects(ctx context.Context, in *GetObjectsRequest, opts ...grpc.CallOption) (ObjectAPI_GetObjectsClient, error)
-	TagObject(ctx context.Context, in *TagObjectRequest, opts ...grpc.CallOption) (*google_protobuf.Empty, error)
-	InspectObject(ctx context.Context, in *Object, opts ...grpc.CallOption) (*ObjectInfo, error)
-	// CheckObject checks if an object exists in the blob store without
-	// actually reading the object.
-	CheckObject(ctx context.Context, in *CheckObjectRequest, opts ...grpc.CallOption) (*CheckObjectResponse, error)
-	ListObjects(ctx context.Context, in *ListObjectsRequest, opts ...grpc.CallOption) (ObjectAPI_ListObjectsClient, error)
-	DeleteObjects(ctx context.Context, in *DeleteObjectsRequest, opts ...grpc.CallOption) (*DeleteObjectsResponse, error)
-	GetTag(ctx context.Context, in *Tag, opts ...grpc.CallOption) (ObjectAPI_GetTagClient, error)
-	InspectTag(ctx context.Context, in *Tag, opts ...grpc.CallOption) (*ObjectInfo, error)
-	ListTags(ctx context.Context, in *ListTagsRequest, opts ...grpc.CallOption) (ObjectAPI_ListTagsClient, error)
-	DeleteTags(ctx context.Context, in *DeleteTagsRequest, opts ...grpc.CallOption) (*DeleteTagsResponse, error)
-	Compact(ctx context.Context, in *google_protobuf.Empty, opts ...grpc.CallOption) (*google_protobuf.Empty, error)
-}
-
-type objectAPIClient struct {
-	cc *grpc.ClientConn
+	return out, nil
 }
 
-func NewObjectAPIClient(cc *grpc.ClientConn) ObjectAPIClient {
-	return &objectAPIClient{cc}
+func (c *aPIClient) DeleteTransaction(ctx context.Context, in *DeleteTransactionRequest, opts ...grpc.CallOption) (*google_protobuf1.Empty, error) {
+	out := new(google_protobuf1.Empty)
+	err := grpc.Invoke(ctx, "/pfs.API/DeleteTransaction", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (c *objectAPIClient) PutObject(ctx context.Context, opts ...grpc.CallOption) (ObjectAPI_PutObjectClient, error) {
-	stream, err := grpc.NewClientStream(ctx, &_ObjectAPI_serviceDesc.Streams[0], c.cc, "/pfs.ObjectAPI/PutObject", opts...)
+func (c *aPIClient) PutFile(ctx context.Context, opts ...grpc.CallOption) (API_PutFileClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_API_serviceDesc.Streams[2], c.cc, "/pfs.API/PutFile", opts...)
 	if err != nil {
 		return nil, err
 	}
-	x := &objectAPIPutObjectClient{stream}
+	x := &aPIPutFileClient{stream}
 	return x, nil
 }
 
-type ObjectAPI_PutObjectClient interface {
-	Send(*PutObjectRequest) error
-	CloseAndRecv() (*Object, error)
+type API_PutFileClient interface {
+	Send(*PutFileRequest) error
+	CloseAndRecv() (*google_protobuf1.Empty, error)
 	grpc.ClientStream
 }
 
-type objectAPIPutObjectClient struct {
+type aPIPutFileClient struct {
 	grpc.ClientStream
 }
 
-func (x *objectAPIPutObjectClient) Send(m *PutObjectRequest) error {
+func (x *aPIPutFileClient) Send(m *PutFileRequest) error {
 	return x.ClientStream.SendMsg(m)
 }
 
-func (x *objectAPIPutObjectClient) CloseAndRecv() (*Object, error) {
+func (x *aPIPutFileClient) CloseAndRecv() (*google_protobuf1.Empty, error) {
 	if err := x.ClientStream.CloseSend(); err != nil {
 		return nil, err
 	}
-	m := new(Object)
+	m := new(google_protobuf1.Empty)
 	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
 	return m, nil
 }
 
-func (c *objectAPIClient) PutObjectSplit(ctx context.Context, opts ...grpc.CallOption) (ObjectAPI_PutObjectSplitClient, error) {
-	stream, err := grpc.NewClientStream(ctx, &_ObjectAPI_serviceDesc.Streams[1], c.cc, "/pfs.ObjectAPI/PutObjectSplit", opts...)
+func (c *aPIClient) CopyFile(ctx context.Context, in *CopyFileRequest, opts ...grpc.CallOption) (*google_protobuf1.Empty, error) {
+	out := new(google_protobuf1.Empty)
+	err := grpc.Invoke(ctx, "/pfs.API/CopyFile", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
-	x := &objectAPIPutObjectSplitClient{stream}
-	return x, nil
-}
-
-type ObjectAPI_PutObjectSplitClient interface {
-	Send(*PutObjectRequest) error
-	CloseAndRecv() (*Objects, error)
-	grpc.ClientStream
+	return out, nil
 }
 
-type objectAPIPutObjectSplitClient struct {
-	grpc.ClientStream
-}
-
-func (x *objectAPIPutObjectSplitClient) Send(m *PutObjectRequest) error {
-	return x.ClientStream.SendMsg(m)
-}
-
-func (x *objectAPIPutObjectSplitClient) CloseAndRecv() (*Objects, error) {
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
-	}
-	m := new(Objects)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
-	}
-	return m, nil
-}
-
-func (c *objectAPIClient) GetObject(ctx context.Context, in *Object, opts ...grpc.CallOption) (ObjectAPI_GetObjectClient, error) {
-	stream, err := grpc.NewClientStream(ctx, &_ObjectAPI_serviceDesc.Streams[2], c.cc, "/pfs.ObjectAPI/GetObject", opts...)
+func (c *aPIClient) GetFile(ctx context.Context, in *GetFileRequest, opts ...grpc.CallOption) (API_GetFileClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_API_serviceDesc.Streams[3], c.cc, "/pfs.API/GetFile", opts...)
 	if err != nil {
 		return nil, err
 	}
-	x := &objectAPIGetObjectClient{stream}
+	x := &aPIGetFileClient{stream}
 	if err := x.ClientStream.SendMsg(in); err != nil {
 		return nil, err
 	}
@@ -2960,29 +3225,29 @@ func (c *objectAPIClient) GetObject(ctx context.Context, in *Object, opts ...grp
 	return x, nil
 }
 
-type ObjectAPI_GetObjectClient interface {
-	Recv() (*google_protobuf2.BytesValue, error)
+type API_GetFileClient interface {
+	Recv() (*google_protobuf3.BytesValue, error)
 	grpc.ClientStream
 }
 
-type objectAPIGetObjectClient struct {
+type aPIGetFileClient struct {
 	grpc.ClientStream
 }
 
-func (x *objectAPIGetObjectClient) Recv() (*google_protobuf2.BytesValue, error) {
-	m := new(google_protobuf2.BytesValue)
+func (x *aPIGetFileClient) Recv() (*google_protobuf3.BytesValue, error) {
+	m := new(google_protobuf3.BytesValue)
 	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
 	return m, nil
 }
 
-func (c *objectAPIClient) GetObjects(ctx context.Context, in *GetObjectsRequest, opts ...grpc.CallOption) (ObjectAPI_GetObjectsClient, error) {
-	stream, err := grpc.NewClientStream(ctx, &_ObjectAPI_serviceDesc.Streams[3], c.cc, "/pfs.ObjectAPI/GetObjects", opts...)
+func (c *aPIClient) GetArchive(ctx context.Context, in *GetArchiveRequest, opts ...grpc.CallOption) (API_GetArchiveClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_API_serviceDesc.Streams[4], c.cc, "/pfs.API/GetArchive", opts...)
 	if err != nil {
 		return nil, err
 	}
-	x := &objectAPIGetObjectsClient{stream}
+	x := &aPIGetArchiveClient{stream}
 	if err := x.ClientStream.SendMsg(in); err != nil {
 		return nil, err
 	}
@@ -2992,56 +3257,47 @@ func (c *objectAPIClient) GetObjects(ctx context.Context, in *GetObjectsRequest,
 	return x, nil
 }
 
-type ObjectAPI_GetObjectsClient interface {
-	Recv() (*google_protobuf2.BytesValue, error)
+type API_GetArchiveClient interface {
+	Recv() (*google_protobuf3.BytesValue, error)
 	grpc.ClientStream
 }
 
-type objectAPIGetObjectsClient struct {
+type aPIGetArchiveClient struct {
 	grpc.ClientStream
 }
 
-func (x *objectAPIGetObjectsClient) Recv() (*google_protobuf2.BytesValue, error) {
-	m := new(google_protobuf2.BytesValue)
+func (x *aPIGetArchiveClient) Recv() (*google_protobuf3.BytesValue, error) {
+	m := new(google_protobuf3.BytesValue)
 	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
 	return m, nil
 }
 
-func (c *objectAPIClient) TagObject(ctx context.Context, in *TagObjectRequest, opts ...grpc.CallOption) (*google_protobuf.Empty, error) {
-	out := new(google_protobuf.Empty)
-	err := grpc.Invoke(ctx, "/pfs.ObjectAPI/TagObject", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-func (c *objectAPIClient) InspectObject(ctx context.Context, in *Object, opts ...grpc.CallOption) (*ObjectInfo, error) {
-	out := new(ObjectInfo)
-	err := grpc.Invoke(ctx, "/pfs.ObjectAPI/InspectObject", in, out, c.cc, opts...)
+func (c *aPIClient) InspectFile(ctx context.Context, in *InspectFileRequest, opts ...grpc.CallOption) (*FileInfo, error) {
+	out := new(FileInfo)
+	err := grpc.Invoke(ctx, "/pfs.API/InspectFile", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *objectAPIClient) CheckObject(ctx context.Context, in *CheckObjectRequest, opts ...grpc.CallOption) (*CheckObjectResponse, error) {
-	out := new(CheckObjectResponse)
-	err := grpc.Invoke(ctx, "/pfs.ObjectAPI/CheckObject", in, out, c.cc, opts...)
+func (c *aPIClient) ListFile(ctx context.Context, in *ListFileRequest, opts ...grpc.CallOption) (*FileInfos, error) {
+	out := new(FileInfos)
+	err := grpc.Invoke(ctx, "/pfs.API/ListFile", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *objectAPIClient) ListObjects(ctx context.Context, in *ListObjectsRequest, opts ...grpc.CallOption) (ObjectAPI_ListObjectsClient, error) {
-	stream, err := grpc.NewClientStream(ctx, &_ObjectAPI_serviceDesc.Streams[4], c.cc, "/pfs.ObjectAPI/ListObjects", opts...)
+func (c *aPIClient) ListFileStream(ctx context.Context, in *ListFileRequest, opts ...grpc.CallOption) (API_ListFileStreamClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_API_serviceDesc.Streams[5], c.cc, "/pfs.API/ListFileStream", opts...)
 	if err != nil {
 		return nil, err
 	}
-	x := &objectAPIListObjectsClient{stream}
+	x := &aPIListFileStreamClient{stream}
 	if err := x.ClientStream.SendMsg(in); err != nil {
 		return nil, err
 	}
@@ -3051,38 +3307,38 @@ func (c *objectAPIClient) ListObjects(ctx context.Context, in *ListObjectsReques
 	return x, nil
 }
 
-type ObjectAPI_ListObjectsClient interface {
-	Recv() (*Object, error)
+type API_ListFileStreamClient interface {
+	Recv() (*FileInfo, error)
 	grpc.ClientStream
 }
 
-type objectAPIListObjectsClient struct {
+type aPIListFileStreamClient struct {
 	grpc.ClientStream
 }
 
-func (x *objectAPIListObjectsClient) Recv() (*Object, error) {
-	m := new(Object)
+func (x *aPIListFileStreamClient) Recv() (*FileInfo, error) {
+	m := new(FileInfo)
 	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
 	return m, nil
 }
 
-func (c *objectAPIClient) DeleteObjects(ctx context.Context, in *DeleteObjectsRequest, opts ...grpc.CallOption) (*DeleteObjectsResponse, error) {
-	out := new(DeleteObjectsResponse)
-	err := grpc.Invoke(ctx, "/pfs.ObjectAPI/DeleteObjects", in, out, c.cc, opts...)
+func (c *aPIClient) GlobFile(ctx context.Context, in *GlobFileRequest, opts ...grpc.CallOption) (*FileInfos, error) {
+	out := new(FileInfos)
+	err := grpc.Invoke(ctx, "/pfs.API/GlobFile", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *objectAPIClient) GetTag(ctx context.Context, in *Tag, opts ...grpc.CallOption) (ObjectAPI_GetTagClient, error) {
-	stream, err := grpc.NewClientStream(ctx, &_ObjectAPI_serviceDesc.Streams[5], c.cc, "/pfs.ObjectAPI/GetTag", opts...)
+func (c *aPIClient) GlobFileStream(ctx context.Context, in *GlobFileRequest, opts ...grpc.CallOption) (API_GlobFileStreamClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_API_serviceDesc.Streams[6], c.cc, "/pfs.API/GlobFileStream", opts...)
 	if err != nil {
 		return nil, err
 	}
-	x := &objectAPIGetTagClient{stream}
+	x := &aPIGlobFileStreamClient{stream}
 	if err := x.ClientStream.SendMsg(in); err != nil {
 		return nil, err
 	}
@@ -3092,38 +3348,29 @@ func (c *objectAPIClient) GetTag(ctx context.Context, in *Tag, opts ...grpc.Call
 	return x, nil
 }
 
-type ObjectAPI_GetTagClient interface {
-	Recv() (*google_protobuf2.BytesValue, error)
+type API_GlobFileStreamClient interface {
+	Recv() (*FileInfo, error)
 	grpc.ClientStream
 }
 
-type objectAPIGetTagClient struct {
+type aPIGlobFileStreamClient struct {
 	grpc.ClientStream
 }
 
-func (x *objectAPIGetTagClient) Recv() (*google_protobuf2.BytesValue, error) {
-	m := new(google_protobuf2.BytesValue)
+func (x *aPIGlobFileStreamClient) Recv() (*FileInfo, error) {
+	m := new(FileInfo)
 	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
 	return m, nil
 }
 
-func (c *objectAPIClient) InspectTag(ctx context.Context, in *Tag, opts ...grpc.CallOption) (*ObjectInfo, error) {
-	out := new(ObjectInfo)
-	err := grpc.Invoke(ctx, "/pfs.ObjectAPI/InspectTag", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-func (c *objectAPIClient) ListTags(ctx context.Context, in *ListTagsRequest, opts ...grpc.CallOption) (ObjectAPI_ListTagsClient, error) {
-	stream, err := grpc.NewClientStream(ctx, &_ObjectAPI_serviceDesc.Streams[6], c.cc, "/pfs.ObjectAPI/ListTags", opts...)
+func (c *aPIClient) GrepFile(ctx context.Context, in *GrepFileRequest, opts ...grpc.CallOption) (API_GrepFileClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_API_serviceDesc.Streams[7], c.cc, "/pfs.API/GrepFile", opts...)
 	if err != nil {
 		return nil, err
 	}
-	x := &objectAPIListTagsClient{stream}
+	x := &aPIGrepFileClient{stream}
 	if err := x.ClientStream.SendMsg(in); err != nil {
 		return nil, err
 	}
@@ -3133,2298 +3380,1815 @@ func (c *objectAPIClient) ListTags(ctx context.Context, in *ListTagsRequest, opt
 	return x, nil
 }
 
-type ObjectAPI_ListTagsClient interface {
-	Recv() (*ListTagsResponse, error)
+type API_GrepFileClient interface {
+	Recv() (*GrepFileResult, error)
 	grpc.ClientStream
 }
 
-type objectAPIListTagsClient struct {
+type aPIGrepFileClient struct {
 	grpc.ClientStream
 }
 
-func (x *objectAPIListTagsClient) Recv() (*ListTagsResponse, error) {
-	m := new(ListTagsResponse)
+func (x *aPIGrepFileClient) Recv() (*GrepFileResult, error) {
+	m := new(GrepFileResult)
 	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
 	return m, nil
 }
 
-func (c *objectAPIClient) DeleteTags(ctx context.Context, in *DeleteTagsRequest, opts ...grpc.CallOption) (*DeleteTagsResponse, error) {
-	out := new(DeleteTagsResponse)
-	err := grpc.Invoke(ctx, "/pfs.ObjectAPI/DeleteTags", in, out, c.cc, opts...)
+func (c *aPIClient) DiffFile(ctx context.Context, in *DiffFileRequest, opts ...grpc.CallOption) (*DiffFileResponse, error) {
+	out := new(DiffFileResponse)
+	err := grpc.Invoke(ctx, "/pfs.API/DiffFile", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *objectAPIClient) Compact(ctx context.Context, in *google_protobuf.Empty, opts ...grpc.CallOption) (*google_protobuf.Empty, error) {
-	out := new(google_protobuf.Empty)
-	err := grpc.Invoke(ctx, "/pfs.ObjectAPI/Compact", in, out, c.cc, opts...)
+func (c *aPIClient) DeleteFile(ctx context.Context, in *DeleteFileRequest, opts ...grpc.CallOption) (*google_protobuf1.Empty, error) {
+	out := new(google_protobuf1.Empty)
+	err := grpc.Invoke(ctx, "/pfs.API/DeleteFile", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// Server API for ObjectAPI service
-
-type ObjectAPIServer interface {
-	PutObject(ObjectAPI_PutObjectServer) error
-	PutObjectSplit(ObjectAPI_PutObjectSplitServer) error
-	GetObject(*Object, ObjectAPI_GetObjectServer) error
-	GetObjects(*GetObjectsRequest, ObjectAPI_GetObjectsServer) error
-	TagObject(context.Context, *TagObjectRequest) (*google_protobuf.Empty, error)
-	InspectObject(context.Context, *Object) (*ObjectInfo, error)
-	// CheckObject checks if an object exists in the blob store without
-	// actually reading the object.
-	CheckObject(context.Context, *CheckObjectRequest) (*CheckObjectResponse, error)
-	ListObjects(*ListObjectsRequest, ObjectAPI_ListObjectsServer) error
-	DeleteObjects(context.Context, *DeleteObjectsRequest) (*DeleteObjectsResponse, error)
-	GetTag(*Tag, ObjectAPI_GetTagServer) error
-	InspectTag(context.Context, *Tag) (*ObjectInfo, error)
-	ListTags(*ListTagsRequest, ObjectAPI_ListTagsServer) error
-	DeleteTags(context.Context, *DeleteTagsRequest) (*DeleteTagsResponse, error)
-	Compact(context.Context, *google_protobuf.Empty) (*google_protobuf.Empty, error)
-}
-
-func RegisterObjectAPIServer(s *grpc.Server, srv ObjectAPIServer) {
-	s.RegisterService(&_ObjectAPI_serviceDesc, srv)
+func (c *aPIClient) DeleteAll(ctx context.Context, in *google_protobuf1.Empty, opts ...grpc.CallOption) (*google_protobuf1.Empty, error) {
+	out := new(google_protobuf1.Empty)
+	err := grpc.Invoke(ctx, "/pfs.API/DeleteAll", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func _ObjectAPI_PutObject_Handler(srv interface{}, stream grpc.ServerStream) error {
-	return srv.(ObjectAPIServer).PutObject(&objectAPIPutObjectServer{stream})
-}
+// Server API for API service
 
-type ObjectAPI_PutObjectServer interface {
-	SendAndClose(*Object) error
-	Recv() (*PutObjectRequest, error)
-	grpc.ServerStream
+type APIServer interface {
+	// Repo rpcs
+	// CreateRepo creates a new repo.
+	// An error is returned if the repo already exists.
+	CreateRepo(context.Context, *CreateRepoRequest) (*google_protobuf1.Empty, error)
+	// InspectRepo returns info about a repo.
+	InspectRepo(context.Context, *InspectRepoRequest) (*RepoInfo, error)
+	// ListRepo returns info about all repos.
+	ListRepo(context.Context, *ListRepoRequest) (*ListRepoResponse, error)
+	// DeleteRepo deletes a repo.
+	DeleteRepo(context.Context, *DeleteRepoRequest) (*google_protobuf1.Empty, error)
+	// ForkRepo creates a new repo with a copy of each of the finished commits
+	// and branches of an existing repo. The copies share the trees of the
+	// original commits, so no data is copied. Open commits aren't copied.
+	ForkRepo(context.Context, *ForkRepoRequest) (*google_protobuf1.Empty, error)
+	// RenameRepo renames a repo, and updates its commits, its branches, its
+	// ACL, the provenance of downstream repos and commits, and the inputs of
+	// pipelines, in one etcd transaction. Repos with open commits, and the
+	// output repos of pipelines, can't be renamed.
+	RenameRepo(context.Context, *RenameRepoRequest) (*google_protobuf1.Empty, error)
+	// Commit rpcs
+	// StartCommit creates a new write commit from a parent commit.
+	StartCommit(context.Context, *StartCommitRequest) (*Commit, error)
+	// FinishCommit turns a write commit into a read commit.
+	FinishCommit(context.Context, *FinishCommitRequest) (*google_protobuf1.Empty, error)
+	// InspectCommit returns the info about a commit.
+	InspectCommit(context.Context, *InspectCommitRequest) (*CommitInfo, error)
+	// ListCommit returns info about all commits.
+	ListCommit(context.Context, *ListCommitRequest) (*CommitInfos, error)
+	// DeleteCommit deletes a commit.
+	DeleteCommit(context.Context, *DeleteCommitRequest) (*google_protobuf1.Empty, error)
+	// FlushCommit waits for downstream commits to finish
+	FlushCommit(*FlushCommitRequest, API_FlushCommitServer) error
+	// SubscribeCommit subscribes for new commits on a given branch
+	SubscribeCommit(*SubscribeCommitRequest, API_SubscribeCommitServer) error
+	// BuildCommit builds a commit that's backed by the given tree
+	BuildCommit(context.Context, *BuildCommitRequest) (*Commit, error)
+	// ListBranch returns info about the heads of branches.
+	ListBranch(context.Context, *ListBranchRequest) (*BranchInfos, error)
+	// SetBranch assigns a commit and its ancestors to a branch.
+	SetBranch(context.Context, *SetBranchRequest) (*google_protobuf1.Empty, error)
+	// DeleteBranch deletes a branch; note that the commits still exist.
+	DeleteBranch(context.Context, *DeleteBranchRequest) (*google_protobuf1.Empty, error)
+	// ListBranchHistory returns the moves of a branch's head, newest first.
+	// The history of a deleted branch is kept until its repo is deleted.
+	ListBranchHistory(context.Context, *ListBranchHistoryRequest) (*BranchHistory, error)
+	// SetBranchProtection creates or replaces the protection rule for a branch.
+	SetBranchProtection(context.Context, *SetBranchProtectionRequest) (*google_protobuf1.Empty, error)
+	// DeleteBranchProtection removes the protection rule for a branch.
+	DeleteBranchProtection(context.Context, *DeleteBranchProtectionRequest) (*google_protobuf1.Empty, error)
+	// SetRetentionPolicy sets or removes the retention policy of a repo
+	SetRetentionPolicy(context.Context, *SetRetentionPolicyRequest) (*google_protobuf1.Empty, error)
+	// ApplyRetentionPolicy deletes the commits that a repo's retention policy
+	// doesn't keep, and returns them. pachd also does this periodically.
+	ApplyRetentionPolicy(context.Context, *ApplyRetentionPolicyRequest) (*ApplyRetentionPolicyResponse, error)
+	// SetQuota sets or removes the quota of a repo or a principal. Only cluster
+	// admins may set quotas.
+	SetQuota(context.Context, *SetQuotaRequest) (*google_protobuf1.Empty, error)
+	// CheckQuota returns a RESOURCE_EXHAUSTED error if adding data to a repo
+	// would exceed its quota or its creator's quota.
+	CheckQuota(context.Context, *CheckQuotaRequest) (*google_protobuf1.Empty, error)
+	// QuotaUsage returns the storage used by each repo and each principal.
+	QuotaUsage(context.Context, *QuotaUsageRequest) (*QuotaUsageResponse, error)
+	// Transaction rpcs
+	// StartTransaction starts a transaction, which records StartCommit,
+	// FinishCommit, PutFile, DeleteFile and SetBranch requests made with its ID
+	// instead of applying them.
+	StartTransaction(context.Context, *StartTransactionRequest) (*Transaction, error)
+	// FinishTransaction applies all of the requests recorded in a transaction
+	// atomically, and deletes the transaction.
+	FinishTransaction(context.Context, *FinishTransactionRequest) (*google_protobuf1.Empty, error)
+	// DeleteTransaction deletes a transaction without applying its requests.
+	DeleteTransaction(context.Context, *DeleteTransactionRequest) (*google_protobuf1.Empty, error)
+	// File rpcs
+	// PutFile writes the specified file to pfs.
+	PutFile(API_PutFileServer) error
+	// CopyFile copies the contents of one file to another.
+	CopyFile(context.Context, *CopyFileRequest) (*google_protobuf1.Empty, error)
+	// GetFile returns a byte stream of the contents of the file.
+	GetFile(*GetFileRequest, API_GetFileServer) error
+	// GetArchive returns a byte stream of an archive of the files and
+	// directories that match a path or glob pattern.
+	GetArchive(*GetArchiveRequest, API_GetArchiveServer) error
+	// InspectFile returns info about a file.
+	InspectFile(context.Context, *InspectFileRequest) (*FileInfo, error)
+	// ListFile returns info about all files.
+	ListFile(context.Context, *ListFileRequest) (*FileInfos, error)
+	// ListFileStream is a streaming version of ListFile
+	// TODO(msteffen): When the dash has been updated to use ListFileStream,
+	// replace ListFile with this RPC (https://github.com/pachyderm/dash/issues/201)
+	ListFileStream(*ListFileRequest, API_ListFileStreamServer) error
+	// GlobFile returns info about all files.
+	GlobFile(context.Context, *GlobFileRequest) (*FileInfos, error)
+	// GlobFileStream is a streaming version of GlobFile
+	// TODO(msteffen): When the dash has been updated to use GlobFileStream,
+	// replace GlobFile with this RPC (https://github.com/pachyderm/dash/issues/201)
+	GlobFileStream(*GlobFileRequest, API_GlobFileStreamServer) error
+	// GrepFile returns the lines matching a regex in the files matching a glob
+	// pattern.
+	GrepFile(*GrepFileRequest, API_GrepFileServer) error
+	// DiffFile returns the differences between 2 paths at 2 commits.
+	DiffFile(context.Context, *DiffFileRequest) (*DiffFileResponse, error)
+	// DeleteFile deletes a file.
+	DeleteFile(context.Context, *DeleteFileRequest) (*google_protobuf1.Empty, error)
+	// DeleteAll deletes everything
+	DeleteAll(context.Context, *google_protobuf1.Empty) (*google_protobuf1.Empty, error)
 }
 
-type objectAPIPutObjectServer struct {
-	grpc.ServerStream
+func RegisterAPIServer(s *grpc.Server, srv APIServer) {
+	s.RegisterService(&_API_serviceDesc, srv)
 }
 
-func (x *objectAPIPutObjectServer) SendAndClose(m *Object) error {
-	return x.ServerStream.SendMsg(m)
+func _API_CreateRepo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRepoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).CreateRepo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pfs.API/CreateRepo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).CreateRepo(ctx, req.(*CreateRepoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (x *objectAPIPutObjectServer) Recv() (*PutObjectRequest, error) {
-	m := new(PutObjectRequest)
-	if err := x.ServerStream.RecvMsg(m); err != nil {
+func _API_InspectRepo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InspectRepoRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return m, nil
+	if interceptor == nil {
+		return srv.(APIServer).InspectRepo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pfs.API/InspectRepo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).InspectRepo(ctx, req.(*InspectRepoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _ObjectAPI_PutObjectSplit_Handler(srv interface{}, stream grpc.ServerStream) error {
-	return srv.(ObjectAPIServer).PutObjectSplit(&objectAPIPutObjectSplitServer{stream})
+func _API_ListRepo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRepoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).ListRepo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pfs.API/ListRepo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).ListRepo(ctx, req.(*ListRepoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-type ObjectAPI_PutObjectSplitServer interface {
-	SendAndClose(*Objects) error
-	Recv() (*PutObjectRequest, error)
-	grpc.ServerStream
-}
-
-type objectAPIPutObjectSplitServer struct {
-	grpc.ServerStream
-}
-
-func (x *objectAPIPutObjectSplitServer) SendAndClose(m *Objects) error {
-	return x.ServerStream.SendMsg(m)
-}
-
-func (x *objectAPIPutObjectSplitServer) Recv() (*PutObjectRequest, error) {
-	m := new(PutObjectRequest)
-	if err := x.ServerStream.RecvMsg(m); err != nil {
+func _API_DeleteRepo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRepoRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return m, nil
-}
-
-func _ObjectAPI_GetObject_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(Object)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+	if interceptor == nil {
+		return srv.(APIServer).DeleteRepo(ctx, in)
 	}
-	return srv.(ObjectAPIServer).GetObject(m, &objectAPIGetObjectServer{stream})
-}
-
-type ObjectAPI_GetObjectServer interface {
-	Send(*google_protobuf2.BytesValue) error
-	grpc.ServerStream
-}
-
-type objectAPIGetObjectServer struct {
-	grpc.ServerStream
-}
-
-func (x *objectAPIGetObjectServer) Send(m *google_protobuf2.BytesValue) error {
-	return x.ServerStream.SendMsg(m)
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pfs.API/DeleteRepo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).DeleteRepo(ctx, req.(*DeleteRepoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _ObjectAPI_GetObjects_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(GetObjectsRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func _API_ForkRepo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ForkRepoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return srv.(ObjectAPIServer).GetObjects(m, &objectAPIGetObjectsServer{stream})
+	if interceptor == nil {
+		return srv.(APIServer).ForkRepo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pfs.API/ForkRepo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).ForkRepo(ctx, req.(*ForkRepoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-type ObjectAPI_GetObjectsServer interface {
-	Send(*google_protobuf2.BytesValue) error
-	grpc.ServerStream
+func _API_RenameRepo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenameRepoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).RenameRepo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pfs.API/RenameRepo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).RenameRepo(ctx, req.(*RenameRepoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-type objectAPIGetObjectsServer struct {
-	grpc.ServerStream
+func _API_StartCommit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartCommitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).StartCommit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pfs.API/StartCommit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).StartCommit(ctx, req.(*StartCommitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (x *objectAPIGetObjectsServer) Send(m *google_protobuf2.BytesValue) error {
-	return x.ServerStream.SendMsg(m)
+func _API_FinishCommit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FinishCommitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).FinishCommit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pfs.API/FinishCommit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).FinishCommit(ctx, req.(*FinishCommitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _ObjectAPI_TagObject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TagObjectRequest)
+func _API_InspectCommit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InspectCommitRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ObjectAPIServer).TagObject(ctx, in)
+		return srv.(APIServer).InspectCommit(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pfs.ObjectAPI/TagObject",
+		FullMethod: "/pfs.API/InspectCommit",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ObjectAPIServer).TagObject(ctx, req.(*TagObjectRequest))
+		return srv.(APIServer).InspectCommit(ctx, req.(*InspectCommitRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ObjectAPI_InspectObject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Object)
+func _API_ListCommit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCommitRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ObjectAPIServer).InspectObject(ctx, in)
+		return srv.(APIServer).ListCommit(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pfs.ObjectAPI/InspectObject",
+		FullMethod: "/pfs.API/ListCommit",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ObjectAPIServer).InspectObject(ctx, req.(*Object))
+		return srv.(APIServer).ListCommit(ctx, req.(*ListCommitRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ObjectAPI_CheckObject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CheckObjectRequest)
+func _API_DeleteCommit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCommitRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ObjectAPIServer).CheckObject(ctx, in)
+		return srv.(APIServer).DeleteCommit(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pfs.ObjectAPI/CheckObject",
+		FullMethod: "/pfs.API/DeleteCommit",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ObjectAPIServer).CheckObject(ctx, req.(*CheckObjectRequest))
+		return srv.(APIServer).DeleteCommit(ctx, req.(*DeleteCommitRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ObjectAPI_ListObjects_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(ListObjectsRequest)
+func _API_FlushCommit_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(FlushCommitRequest)
 	if err := stream.RecvMsg(m); err != nil {
 		return err
 	}
-	return srv.(ObjectAPIServer).ListObjects(m, &objectAPIListObjectsServer{stream})
+	return srv.(APIServer).FlushCommit(m, &aPIFlushCommitServer{stream})
 }
 
-type ObjectAPI_ListObjectsServer interface {
-	Send(*Object) error
+type API_FlushCommitServer interface {
+	Send(*CommitInfo) error
 	grpc.ServerStream
 }
 
-type objectAPIListObjectsServer struct {
+type aPIFlushCommitServer struct {
 	grpc.ServerStream
 }
 
-func (x *objectAPIListObjectsServer) Send(m *Object) error {
+func (x *aPIFlushCommitServer) Send(m *CommitInfo) error {
 	return x.ServerStream.SendMsg(m)
 }
 
-func _ObjectAPI_DeleteObjects_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteObjectsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ObjectAPIServer).DeleteObjects(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pfs.ObjectAPI/DeleteObjects",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ObjectAPIServer).DeleteObjects(ctx, req.(*DeleteObjectsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
-}
-
-func _ObjectAPI_GetTag_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(Tag)
+func _API_SubscribeCommit_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeCommitRequest)
 	if err := stream.RecvMsg(m); err != nil {
 		return err
 	}
-	return srv.(ObjectAPIServer).GetTag(m, &objectAPIGetTagServer{stream})
+	return srv.(APIServer).SubscribeCommit(m, &aPISubscribeCommitServer{stream})
 }
 
-type ObjectAPI_GetTagServer interface {
-	Send(*google_protobuf2.BytesValue) error
+type API_SubscribeCommitServer interface {
+	Send(*CommitInfo) error
 	grpc.ServerStream
 }
 
-type objectAPIGetTagServer struct {
+type aPISubscribeCommitServer struct {
 	grpc.ServerStream
 }
 
-func (x *objectAPIGetTagServer) Send(m *google_protobuf2.BytesValue) error {
+func (x *aPISubscribeCommitServer) Send(m *CommitInfo) error {
 	return x.ServerStream.SendMsg(m)
 }
 
-func _ObjectAPI_InspectTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Tag)
+func _API_BuildCommit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BuildCommitRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ObjectAPIServer).InspectTag(ctx, in)
+		return srv.(APIServer).BuildCommit(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pfs.ObjectAPI/InspectTag",
+		FullMethod: "/pfs.API/BuildCommit",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ObjectAPIServer).InspectTag(ctx, req.(*Tag))
+		return srv.(APIServer).BuildCommit(ctx, req.(*BuildCommitRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ObjectAPI_ListTags_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(ListTagsRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func _API_ListBranch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBranchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return srv.(ObjectAPIServer).ListTags(m, &objectAPIListTagsServer{stream})
-}
-
-type ObjectAPI_ListTagsServer interface {
-	Send(*ListTagsResponse) error
-	grpc.ServerStream
-}
-
-type objectAPIListTagsServer struct {
-	grpc.ServerStream
+	if interceptor == nil {
+		return srv.(APIServer).ListBranch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pfs.API/ListBranch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).ListBranch(ctx, req.(*ListBranchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (x *objectAPIListTagsServer) Send(m *ListTagsResponse) error {
-	return x.ServerStream.SendMsg(m)
+func _API_SetBranch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetBranchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).SetBranch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pfs.API/SetBranch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).SetBranch(ctx, req.(*SetBranchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _ObjectAPI_DeleteTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteTagsRequest)
+func _API_DeleteBranch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteBranchRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ObjectAPIServer).DeleteTags(ctx, in)
+		return srv.(APIServer).DeleteBranch(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pfs.ObjectAPI/DeleteTags",
+		FullMethod: "/pfs.API/DeleteBranch",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ObjectAPIServer).DeleteTags(ctx, req.(*DeleteTagsRequest))
+		return srv.(APIServer).DeleteBranch(ctx, req.(*DeleteBranchRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ObjectAPI_Compact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(google_protobuf.Empty)
+func _API_ListBranchHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBranchHistoryRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ObjectAPIServer).Compact(ctx, in)
+		return srv.(APIServer).ListBranchHistory(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pfs.ObjectAPI/Compact",
+		FullMethod: "/pfs.API/ListBranchHistory",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ObjectAPIServer).Compact(ctx, req.(*google_protobuf.Empty))
+		return srv.(APIServer).ListBranchHistory(ctx, req.(*ListBranchHistoryRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-var _ObjectAPI_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "pfs.ObjectAPI",
-	HandlerType: (*ObjectAPIServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "TagObject",
-			Handler:    _ObjectAPI_TagObject_Handler,
-		},
-		{
-			MethodName: "InspectObject",
-			Handler:    _ObjectAPI_InspectObject_Handler,
-		},
-		{
-			MethodName: "CheckObject",
-			Handler:    _ObjectAPI_CheckObject_Handler,
-		},
-		{
-			MethodName: "DeleteObjects",
-			Handler:    _ObjectAPI_DeleteObjects_Handler,
-		},
-		{
-			MethodName: "InspectTag",
-			Handler:    _ObjectAPI_InspectTag_Handler,
-		},
-		{
-			MethodName: "DeleteTags",
-			Handler:    _ObjectAPI_DeleteTags_Handler,
-		},
-		{
-			MethodName: "Compact",
-			Handler:    _ObjectAPI_Compact_Handler,
-		},
-	},
-	Streams: []grpc.StreamDesc{
-		{
-			StreamName:    "PutObject",
-			Handler:       _ObjectAPI_PutObject_Handler,
-			ClientStreams: true,
-		},
-		{
-			StreamName:    "PutObjectSplit",
-			Handler:       _ObjectAPI_PutObjectSplit_Handler,
-			ClientStreams: true,
-		},
-		{
-			StreamName:    "GetObject",
-			Handler:       _ObjectAPI_GetObject_Handler,
-			ServerStreams: true,
-		},
-		{
-			StreamName:    "GetObjects",
-			Handler:       _ObjectAPI_GetObjects_Handler,
-			ServerStreams: true,
-		},
-		{
-			StreamName:    "ListObjects",
-			Handler:       _ObjectAPI_ListObjects_Handler,
-			ServerStreams: true,
-		},
-		{
-			StreamName:    "GetTag",
-			Handler:       _ObjectAPI_GetTag_Handler,
-			ServerStreams: true,
-		},
-		{
-			StreamName:    "ListTags",
-			Handler:       _ObjectAPI_ListTags_Handler,
-			ServerStreams: true,
-		},
-	},
-	Metadata: "client/pfs/pfs.proto",
-}
-
-func (m *Repo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
+func _API_SetBranchProtection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetBranchProtectionRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(APIServer).SetBranchProtection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pfs.API/SetBranchProtection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).SetBranchProtection(ctx, req.(*SetBranchProtectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *Repo) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Name) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(len(m.Name)))
-		i += copy(dAtA[i:], m.Name)
+func _API_DeleteBranchProtection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteBranchProtectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return i, nil
+	if interceptor == nil {
+		return srv.(APIServer).DeleteBranchProtection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pfs.API/DeleteBranchProtection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).DeleteBranchProtection(ctx, req.(*DeleteBranchProtectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *BranchInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
+func _API_SetRetentionPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetRetentionPolicyRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(APIServer).SetRetentionPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pfs.API/SetRetentionPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).SetRetentionPolicy(ctx, req.(*SetRetentionPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *BranchInfo) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Name) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(len(m.Name)))
-		i += copy(dAtA[i:], m.Name)
+func _API_ApplyRetentionPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplyRetentionPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Head != nil {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.Head.Size()))
-		n1, err := m.Head.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n1
+	if interceptor == nil {
+		return srv.(APIServer).ApplyRetentionPolicy(ctx, in)
 	}
-	return i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pfs.API/ApplyRetentionPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).ApplyRetentionPolicy(ctx, req.(*ApplyRetentionPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *BranchInfos) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
+func _API_SetQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetQuotaRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(APIServer).SetQuota(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pfs.API/SetQuota",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).SetQuota(ctx, req.(*SetQuotaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *BranchInfos) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.BranchInfo) > 0 {
-		for _, msg := range m.BranchInfo {
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintPfs(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
+func _API_CheckQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckQuotaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return i, nil
+	if interceptor == nil {
+		return srv.(APIServer).CheckQuota(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pfs.API/CheckQuota",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).CheckQuota(ctx, req.(*CheckQuotaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *File) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
+func _API_QuotaUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuotaUsageRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(APIServer).QuotaUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pfs.API/QuotaUsage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).QuotaUsage(ctx, req.(*QuotaUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *File) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Commit != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.Commit.Size()))
-		n2, err := m.Commit.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n2
+func _API_StartTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Path) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(len(m.Path)))
-		i += copy(dAtA[i:], m.Path)
+	if interceptor == nil {
+		return srv.(APIServer).StartTransaction(ctx, in)
 	}
-	return i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pfs.API/StartTransaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).StartTransaction(ctx, req.(*StartTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *Block) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
+func _API_FinishTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FinishTransactionRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(APIServer).FinishTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pfs.API/FinishTransaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).FinishTransaction(ctx, req.(*FinishTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *Block) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Hash) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(len(m.Hash)))
-		i += copy(dAtA[i:], m.Hash)
+func _API_DeleteTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return i, nil
+	if interceptor == nil {
+		return srv.(APIServer).DeleteTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pfs.API/DeleteTransaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).DeleteTransaction(ctx, req.(*DeleteTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *Object) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func _API_PutFile_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(APIServer).PutFile(&aPIPutFileServer{stream})
 }
 
-func (m *Object) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Hash) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(len(m.Hash)))
-		i += copy(dAtA[i:], m.Hash)
-	}
-	return i, nil
+type API_PutFileServer interface {
+	SendAndClose(*google_protobuf1.Empty) error
+	Recv() (*PutFileRequest, error)
+	grpc.ServerStream
 }
 
-func (m *Tag) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type aPIPutFileServer struct {
+	grpc.ServerStream
 }
 
-func (m *Tag) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Name) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(len(m.Name)))
-		i += copy(dAtA[i:], m.Name)
-	}
-	return i, nil
+func (x *aPIPutFileServer) SendAndClose(m *google_protobuf1.Empty) error {
+	return x.ServerStream.SendMsg(m)
 }
 
-func (m *RepoInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
+func (x *aPIPutFileServer) Recv() (*PutFileRequest, error) {
+	m := new(PutFileRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return m, nil
 }
 
-func (m *RepoInfo) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Repo != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.Repo.Size()))
-		n3, err := m.Repo.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n3
-	}
-	if m.Created != nil {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.Created.Size()))
-		n4, err := m.Created.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n4
-	}
-	if m.SizeBytes != 0 {
-		dAtA[i] = 0x18
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.SizeBytes))
+func _API_CopyFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CopyFileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Provenance) > 0 {
-		for _, msg := range m.Provenance {
-			dAtA[i] = 0x22
-			i++
-			i = encodeVarintPfs(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
+	if interceptor == nil {
+		return srv.(APIServer).CopyFile(ctx, in)
 	}
-	if len(m.Description) > 0 {
-		dAtA[i] = 0x2a
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(len(m.Description)))
-		i += copy(dAtA[i:], m.Description)
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pfs.API/CopyFile",
 	}
-	if m.AuthInfo != nil {
-		dAtA[i] = 0x32
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.AuthInfo.Size()))
-		n5, err := m.AuthInfo.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n5
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).CopyFile(ctx, req.(*CopyFileRequest))
 	}
-	return i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *RepoAuthInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func _API_GetFile_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetFileRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	return dAtA[:n], nil
+	return srv.(APIServer).GetFile(m, &aPIGetFileServer{stream})
 }
 
-func (m *RepoAuthInfo) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.AccessLevel != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.AccessLevel))
+type API_GetFileServer interface {
+	Send(*google_protobuf3.BytesValue) error
+	grpc.ServerStream
+}
+
+type aPIGetFileServer struct {
+	grpc.ServerStream
+}
+
+func (x *aPIGetFileServer) Send(m *google_protobuf3.BytesValue) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _API_GetArchive_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetArchiveRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	return i, nil
+	return srv.(APIServer).GetArchive(m, &aPIGetArchiveServer{stream})
 }
 
-func (m *Commit) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
+type API_GetArchiveServer interface {
+	Send(*google_protobuf3.BytesValue) error
+	grpc.ServerStream
+}
+
+type aPIGetArchiveServer struct {
+	grpc.ServerStream
+}
+
+func (x *aPIGetArchiveServer) Send(m *google_protobuf3.BytesValue) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _API_InspectFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InspectFileRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(APIServer).InspectFile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pfs.API/InspectFile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).InspectFile(ctx, req.(*InspectFileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *Commit) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Repo != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.Repo.Size()))
-		n6, err := m.Repo.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n6
+func _API_ListFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.ID) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(len(m.ID)))
-		i += copy(dAtA[i:], m.ID)
+	if interceptor == nil {
+		return srv.(APIServer).ListFile(ctx, in)
 	}
-	return i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pfs.API/ListFile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).ListFile(ctx, req.(*ListFileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *CommitInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func _API_ListFileStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListFileRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	return dAtA[:n], nil
+	return srv.(APIServer).ListFileStream(m, &aPIListFileStreamServer{stream})
 }
 
-func (m *CommitInfo) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Commit != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.Commit.Size()))
-		n7, err := m.Commit.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n7
-	}
-	if m.ParentCommit != nil {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.ParentCommit.Size()))
-		n8, err := m.ParentCommit.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n8
-	}
-	if m.Started != nil {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.Started.Size()))
-		n9, err := m.Started.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n9
-	}
-	if m.Finished != nil {
-		dAtA[i] = 0x22
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.Finished.Size()))
-		n10, err := m.Finished.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n10
-	}
-	if m.SizeBytes != 0 {
-		dAtA[i] = 0x28
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.SizeBytes))
-	}
-	if len(m.Provenance) > 0 {
-		for _, msg := range m.Provenance {
-			dAtA[i] = 0x32
-			i++
-			i = encodeVarintPfs(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
-	}
-	if m.Tree != nil {
-		dAtA[i] = 0x3a
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.Tree.Size()))
-		n11, err := m.Tree.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n11
-	}
-	return i, nil
+type API_ListFileStreamServer interface {
+	Send(*FileInfo) error
+	grpc.ServerStream
 }
 
-func (m *FileInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type aPIListFileStreamServer struct {
+	grpc.ServerStream
 }
 
-func (m *FileInfo) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.File != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.File.Size()))
-		n12, err := m.File.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n12
-	}
-	if m.FileType != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.FileType))
-	}
-	if m.SizeBytes != 0 {
-		dAtA[i] = 0x18
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.SizeBytes))
+func (x *aPIListFileStreamServer) Send(m *FileInfo) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _API_GlobFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GlobFileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Children) > 0 {
-		for _, s := range m.Children {
-			dAtA[i] = 0x32
-			i++
-			l = len(s)
-			for l >= 1<<7 {
-				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
-				l >>= 7
-				i++
-			}
-			dAtA[i] = uint8(l)
-			i++
-			i += copy(dAtA[i:], s)
-		}
+	if interceptor == nil {
+		return srv.(APIServer).GlobFile(ctx, in)
 	}
-	if len(m.Hash) > 0 {
-		dAtA[i] = 0x3a
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(len(m.Hash)))
-		i += copy(dAtA[i:], m.Hash)
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pfs.API/GlobFile",
 	}
-	if len(m.Objects) > 0 {
-		for _, msg := range m.Objects {
-			dAtA[i] = 0x42
-			i++
-			i = encodeVarintPfs(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).GlobFile(ctx, req.(*GlobFileRequest))
 	}
-	return i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ByteRange) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func _API_GlobFileStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GlobFileRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	return dAtA[:n], nil
+	return srv.(APIServer).GlobFileStream(m, &aPIGlobFileStreamServer{stream})
 }
 
-func (m *ByteRange) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Lower != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.Lower))
-	}
-	if m.Upper != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.Upper))
-	}
-	return i, nil
+type API_GlobFileStreamServer interface {
+	Send(*FileInfo) error
+	grpc.ServerStream
 }
 
-func (m *BlockRef) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type aPIGlobFileStreamServer struct {
+	grpc.ServerStream
 }
 
-func (m *BlockRef) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Block != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.Block.Size()))
-		n13, err := m.Block.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n13
-	}
-	if m.Range != nil {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.Range.Size()))
-		n14, err := m.Range.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n14
-	}
-	return i, nil
+func (x *aPIGlobFileStreamServer) Send(m *FileInfo) error {
+	return x.ServerStream.SendMsg(m)
 }
 
-func (m *ObjectInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func _API_GrepFile_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GrepFileRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	return dAtA[:n], nil
+	return srv.(APIServer).GrepFile(m, &aPIGrepFileServer{stream})
 }
 
-func (m *ObjectInfo) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Object != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.Object.Size()))
-		n15, err := m.Object.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n15
-	}
-	if m.BlockRef != nil {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.BlockRef.Size()))
-		n16, err := m.BlockRef.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n16
-	}
-	return i, nil
+type API_GrepFileServer interface {
+	Send(*GrepFileResult) error
+	grpc.ServerStream
 }
 
-func (m *CreateRepoRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type aPIGrepFileServer struct {
+	grpc.ServerStream
 }
 
-func (m *CreateRepoRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Repo != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.Repo.Size()))
-		n17, err := m.Repo.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n17
-	}
-	if len(m.Provenance) > 0 {
-		for _, msg := range m.Provenance {
-			dAtA[i] = 0x12
-			i++
-			i = encodeVarintPfs(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
-	}
-	if len(m.Description) > 0 {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(len(m.Description)))
-		i += copy(dAtA[i:], m.Description)
-	}
-	if m.Update {
-		dAtA[i] = 0x20
-		i++
-		if m.Update {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
-	}
-	return i, nil
-}
-
-func (m *InspectRepoRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *InspectRepoRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Repo != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.Repo.Size()))
-		n18, err := m.Repo.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n18
-	}
-	return i, nil
+func (x *aPIGrepFileServer) Send(m *GrepFileResult) error {
+	return x.ServerStream.SendMsg(m)
 }
 
-func (m *ListRepoRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
+func _API_DiffFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiffFileRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ListRepoRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Provenance) > 0 {
-		for _, msg := range m.Provenance {
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintPfs(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
+	if interceptor == nil {
+		return srv.(APIServer).DiffFile(ctx, in)
 	}
-	return i, nil
-}
-
-func (m *ListRepoResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pfs.API/DiffFile",
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ListRepoResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.RepoInfo) > 0 {
-		for _, msg := range m.RepoInfo {
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintPfs(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).DiffFile(ctx, req.(*DiffFileRequest))
 	}
-	return i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *DeleteRepoRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
+func _API_DeleteFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteFileRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *DeleteRepoRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Repo != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.Repo.Size()))
-		n19, err := m.Repo.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n19
+	if interceptor == nil {
+		return srv.(APIServer).DeleteFile(ctx, in)
 	}
-	if m.Force {
-		dAtA[i] = 0x10
-		i++
-		if m.Force {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pfs.API/DeleteFile",
 	}
-	if m.All {
-		dAtA[i] = 0x18
-		i++
-		if m.All {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).DeleteFile(ctx, req.(*DeleteFileRequest))
 	}
-	return i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *StartCommitRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
+func _API_DeleteAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(google_protobuf1.Empty)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *StartCommitRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Parent != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.Parent.Size()))
-		n20, err := m.Parent.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n20
-	}
-	if len(m.Provenance) > 0 {
-		for _, msg := range m.Provenance {
-			dAtA[i] = 0x12
-			i++
-			i = encodeVarintPfs(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
+	if interceptor == nil {
+		return srv.(APIServer).DeleteAll(ctx, in)
 	}
-	if len(m.Branch) > 0 {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(len(m.Branch)))
-		i += copy(dAtA[i:], m.Branch)
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pfs.API/DeleteAll",
 	}
-	return i, nil
-}
-
-func (m *BuildCommitRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).DeleteAll(ctx, req.(*google_protobuf1.Empty))
 	}
-	return dAtA[:n], nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *BuildCommitRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Parent != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.Parent.Size()))
-		n21, err := m.Parent.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n21
-	}
-	if len(m.Provenance) > 0 {
-		for _, msg := range m.Provenance {
-			dAtA[i] = 0x12
-			i++
-			i = encodeVarintPfs(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
-	}
-	if m.Tree != nil {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.Tree.Size()))
-		n22, err := m.Tree.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n22
-	}
-	if len(m.Branch) > 0 {
-		dAtA[i] = 0x22
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(len(m.Branch)))
-		i += copy(dAtA[i:], m.Branch)
-	}
-	return i, nil
+var _API_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "pfs.API",
+	HandlerType: (*APIServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateRepo",
+			Handler:    _API_CreateRepo_Handler,
+		},
+		{
+			MethodName: "InspectRepo",
+			Handler:    _API_InspectRepo_Handler,
+		},
+		{
+			MethodName: "ListRepo",
+			Handler:    _API_ListRepo_Handler,
+		},
+		{
+			MethodName: "DeleteRepo",
+			Handler:    _API_DeleteRepo_Handler,
+		},
+		{
+			MethodName: "ForkRepo",
+			Handler:    _API_ForkRepo_Handler,
+		},
+		{
+			MethodName: "RenameRepo",
+			Handler:    _API_RenameRepo_Handler,
+		},
+		{
+			MethodName: "StartCommit",
+			Handler:    _API_StartCommit_Handler,
+		},
+		{
+			MethodName: "FinishCommit",
+			Handler:    _API_FinishCommit_Handler,
+		},
+		{
+			MethodName: "InspectCommit",
+			Handler:    _API_InspectCommit_Handler,
+		},
+		{
+			MethodName: "ListCommit",
+			Handler:    _API_ListCommit_Handler,
+		},
+		{
+			MethodName: "DeleteCommit",
+			Handler:    _API_DeleteCommit_Handler,
+		},
+		{
+			MethodName: "BuildCommit",
+			Handler:    _API_BuildCommit_Handler,
+		},
+		{
+			MethodName: "ListBranch",
+			Handler:    _API_ListBranch_Handler,
+		},
+		{
+			MethodName: "SetBranch",
+			Handler:    _API_SetBranch_Handler,
+		},
+		{
+			MethodName: "DeleteBranch",
+			Handler:    _API_DeleteBranch_Handler,
+		},
+		{
+			MethodName: "ListBranchHistory",
+			Handler:    _API_ListBranchHistory_Handler,
+		},
+		{
+			MethodName: "SetBranchProtection",
+			Handler:    _API_SetBranchProtection_Handler,
+		},
+		{
+			MethodName: "DeleteBranchProtection",
+			Handler:    _API_DeleteBranchProtection_Handler,
+		},
+		{
+			MethodName: "SetRetentionPolicy",
+			Handler:    _API_SetRetentionPolicy_Handler,
+		},
+		{
+			MethodName: "ApplyRetentionPolicy",
+			Handler:    _API_ApplyRetentionPolicy_Handler,
+		},
+		{
+			MethodName: "SetQuota",
+			Handler:    _API_SetQuota_Handler,
+		},
+		{
+			MethodName: "CheckQuota",
+			Handler:    _API_CheckQuota_Handler,
+		},
+		{
+			MethodName: "QuotaUsage",
+			Handler:    _API_QuotaUsage_Handler,
+		},
+		{
+			MethodName: "StartTransaction",
+			Handler:    _API_StartTransaction_Handler,
+		},
+		{
+			MethodName: "FinishTransaction",
+			Handler:    _API_FinishTransaction_Handler,
+		},
+		{
+			MethodName: "DeleteTransaction",
+			Handler:    _API_DeleteTransaction_Handler,
+		},
+		{
+			MethodName: "CopyFile",
+			Handler:    _API_CopyFile_Handler,
+		},
+		{
+			MethodName: "InspectFile",
+			Handler:    _API_InspectFile_Handler,
+		},
+		{
+			MethodName: "ListFile",
+			Handler:    _API_ListFile_Handler,
+		},
+		{
+			MethodName: "GlobFile",
+			Handler:    _API_GlobFile_Handler,
+		},
+		{
+			MethodName: "DiffFile",
+			Handler:    _API_DiffFile_Handler,
+		},
+		{
+			MethodName: "DeleteFile",
+			Handler:    _API_DeleteFile_Handler,
+		},
+		{
+			MethodName: "DeleteAll",
+			Handler:    _API_DeleteAll_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "FlushCommit",
+			Handler:       _API_FlushCommit_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "SubscribeCommit",
+			Handler:       _API_SubscribeCommit_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "PutFile",
+			Handler:       _API_PutFile_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "GetFile",
+			Handler:       _API_GetFile_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "GetArchive",
+			Handler:       _API_GetArchive_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ListFileStream",
+			Handler:       _API_ListFileStream_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "GlobFileStream",
+			Handler:       _API_GlobFileStream_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "GrepFile",
+			Handler:       _API_GrepFile_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "client/pfs/pfs.proto",
 }
 
-func (m *FinishCommitRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+// Client API for ObjectAPI service
+
+type ObjectAPIClient interface {
+	PutObject(ctx context.Context, opts ...grpc.CallOption) (ObjectAPI_PutObjectClient, error)
+	PutObjectSplit(ctx context.Context, opts ...grpc.CallOption) (ObjectAPI_PutObjectSplitClient, error)
+	GetObject(ctx context.Context, in *Object, opts ...grpc.CallOption) (ObjectAPI_GetObjectClient, error)
+	GetObjects(ctx context.Context, in *GetObjectsRequest, opts ...grpc.CallOption) (ObjectAPI_GetObjectsClient, error)
+	TagObject(ctx context.Context, in *TagObjectRequest, opts ...grpc.CallOption) (*google_protobuf1.Empty, error)
+	InspectObject(ctx context.Context, in *Object, opts ...grpc.CallOption) (*ObjectInfo, error)
+	// CheckObject checks if an object exists in the blob store without
+	// actually reading the object.
+	CheckObject(ctx context.Context, in *CheckObjectRequest, opts ...grpc.CallOption) (*CheckObjectResponse, error)
+	ListObjects(ctx context.Context, in *ListObjectsRequest, opts ...grpc.CallOption) (ObjectAPI_ListObjectsClient, error)
+	DeleteObjects(ctx context.Context, in *DeleteObjectsRequest, opts ...grpc.CallOption) (*DeleteObjectsResponse, error)
+	GetTag(ctx context.Context, in *Tag, opts ...grpc.CallOption) (ObjectAPI_GetTagClient, error)
+	InspectTag(ctx context.Context, in *Tag, opts ...grpc.CallOption) (*ObjectInfo, error)
+	ListTags(ctx context.Context, in *ListTagsRequest, opts ...grpc.CallOption) (ObjectAPI_ListTagsClient, error)
+	DeleteTags(ctx context.Context, in *DeleteTagsRequest, opts ...grpc.CallOption) (*DeleteTagsResponse, error)
+	Compact(ctx context.Context, in *google_protobuf1.Empty, opts ...grpc.CallOption) (*google_protobuf1.Empty, error)
+}
+
+type objectAPIClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewObjectAPIClient(cc *grpc.ClientConn) ObjectAPIClient {
+	return &objectAPIClient{cc}
+}
+
+func (c *objectAPIClient) PutObject(ctx context.Context, opts ...grpc.CallOption) (ObjectAPI_PutObjectClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_ObjectAPI_serviceDesc.Streams[0], c.cc, "/pfs.ObjectAPI/PutObject", opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	x := &objectAPIPutObjectClient{stream}
+	return x, nil
 }
 
-func (m *FinishCommitRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Commit != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.Commit.Size()))
-		n23, err := m.Commit.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n23
+type ObjectAPI_PutObjectClient interface {
+	Send(*PutObjectRequest) error
+	CloseAndRecv() (*Object, error)
+	grpc.ClientStream
+}
+
+type objectAPIPutObjectClient struct {
+	grpc.ClientStream
+}
+
+func (x *objectAPIPutObjectClient) Send(m *PutObjectRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *objectAPIPutObjectClient) CloseAndRecv() (*Object, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
 	}
-	return i, nil
+	m := new(Object)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
 }
 
-func (m *InspectCommitRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+func (c *objectAPIClient) PutObjectSplit(ctx context.Context, opts ...grpc.CallOption) (ObjectAPI_PutObjectSplitClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_ObjectAPI_serviceDesc.Streams[1], c.cc, "/pfs.ObjectAPI/PutObjectSplit", opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	x := &objectAPIPutObjectSplitClient{stream}
+	return x, nil
 }
 
-func (m *InspectCommitRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Commit != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.Commit.Size()))
-		n24, err := m.Commit.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n24
-	}
-	return i, nil
+type ObjectAPI_PutObjectSplitClient interface {
+	Send(*PutObjectRequest) error
+	CloseAndRecv() (*Objects, error)
+	grpc.ClientStream
 }
 
-func (m *ListCommitRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type objectAPIPutObjectSplitClient struct {
+	grpc.ClientStream
 }
 
-func (m *ListCommitRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Repo != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.Repo.Size()))
-		n25, err := m.Repo.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n25
-	}
-	if m.From != nil {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.From.Size()))
-		n26, err := m.From.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n26
-	}
-	if m.To != nil {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.To.Size()))
-		n27, err := m.To.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n27
+func (x *objectAPIPutObjectSplitClient) Send(m *PutObjectRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *objectAPIPutObjectSplitClient) CloseAndRecv() (*Objects, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
 	}
-	if m.Number != 0 {
-		dAtA[i] = 0x20
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.Number))
+	m := new(Objects)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
 	}
-	return i, nil
+	return m, nil
 }
 
-func (m *CommitInfos) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+func (c *objectAPIClient) GetObject(ctx context.Context, in *Object, opts ...grpc.CallOption) (ObjectAPI_GetObjectClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_ObjectAPI_serviceDesc.Streams[2], c.cc, "/pfs.ObjectAPI/GetObject", opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	x := &objectAPIGetObjectClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
 
-func (m *CommitInfos) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.CommitInfo) > 0 {
-		for _, msg := range m.CommitInfo {
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintPfs(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
-	}
-	return i, nil
+type ObjectAPI_GetObjectClient interface {
+	Recv() (*google_protobuf3.BytesValue, error)
+	grpc.ClientStream
 }
 
-func (m *ListBranchRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type objectAPIGetObjectClient struct {
+	grpc.ClientStream
 }
 
-func (m *ListBranchRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Repo != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.Repo.Size()))
-		n28, err := m.Repo.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n28
+func (x *objectAPIGetObjectClient) Recv() (*google_protobuf3.BytesValue, error) {
+	m := new(google_protobuf3.BytesValue)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
 	}
-	return i, nil
+	return m, nil
 }
 
-func (m *SetBranchRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+func (c *objectAPIClient) GetObjects(ctx context.Context, in *GetObjectsRequest, opts ...grpc.CallOption) (ObjectAPI_GetObjectsClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_ObjectAPI_serviceDesc.Streams[3], c.cc, "/pfs.ObjectAPI/GetObjects", opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *SetBranchRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Commit != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.Commit.Size()))
-		n29, err := m.Commit.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n29
+	x := &objectAPIGetObjectsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
 	}
-	if len(m.Branch) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(len(m.Branch)))
-		i += copy(dAtA[i:], m.Branch)
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
 	}
-	return i, nil
+	return x, nil
 }
 
-func (m *DeleteBranchRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type ObjectAPI_GetObjectsClient interface {
+	Recv() (*google_protobuf3.BytesValue, error)
+	grpc.ClientStream
 }
 
-func (m *DeleteBranchRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Repo != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.Repo.Size()))
-		n30, err := m.Repo.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n30
-	}
-	if len(m.Branch) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(len(m.Branch)))
-		i += copy(dAtA[i:], m.Branch)
+type objectAPIGetObjectsClient struct {
+	grpc.ClientStream
+}
+
+func (x *objectAPIGetObjectsClient) Recv() (*google_protobuf3.BytesValue, error) {
+	m := new(google_protobuf3.BytesValue)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
 	}
-	return i, nil
+	return m, nil
 }
 
-func (m *DeleteCommitRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+func (c *objectAPIClient) TagObject(ctx context.Context, in *TagObjectRequest, opts ...grpc.CallOption) (*google_protobuf1.Empty, error) {
+	out := new(google_protobuf1.Empty)
+	err := grpc.Invoke(ctx, "/pfs.ObjectAPI/TagObject", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *DeleteCommitRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Commit != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.Commit.Size()))
-		n31, err := m.Commit.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n31
+func (c *objectAPIClient) InspectObject(ctx context.Context, in *Object, opts ...grpc.CallOption) (*ObjectInfo, error) {
+	out := new(ObjectInfo)
+	err := grpc.Invoke(ctx, "/pfs.ObjectAPI/InspectObject", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return i, nil
+	return out, nil
 }
 
-func (m *FlushCommitRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+func (c *objectAPIClient) CheckObject(ctx context.Context, in *CheckObjectRequest, opts ...grpc.CallOption) (*CheckObjectResponse, error) {
+	out := new(CheckObjectResponse)
+	err := grpc.Invoke(ctx, "/pfs.ObjectAPI/CheckObject", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *FlushCommitRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Commits) > 0 {
-		for _, msg := range m.Commits {
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintPfs(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
+func (c *objectAPIClient) ListObjects(ctx context.Context, in *ListObjectsRequest, opts ...grpc.CallOption) (ObjectAPI_ListObjectsClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_ObjectAPI_serviceDesc.Streams[4], c.cc, "/pfs.ObjectAPI/ListObjects", opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.ToRepos) > 0 {
-		for _, msg := range m.ToRepos {
-			dAtA[i] = 0x12
-			i++
-			i = encodeVarintPfs(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
+	x := &objectAPIListObjectsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
 	}
-	return i, nil
-}
-
-func (m *SubscribeCommitRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
+	if err := x.ClientStream.CloseSend(); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return x, nil
 }
 
-func (m *SubscribeCommitRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Repo != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.Repo.Size()))
-		n32, err := m.Repo.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n32
-	}
-	if len(m.Branch) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(len(m.Branch)))
-		i += copy(dAtA[i:], m.Branch)
-	}
-	if m.From != nil {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.From.Size()))
-		n33, err := m.From.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n33
+type ObjectAPI_ListObjectsClient interface {
+	Recv() (*Object, error)
+	grpc.ClientStream
+}
+
+type objectAPIListObjectsClient struct {
+	grpc.ClientStream
+}
+
+func (x *objectAPIListObjectsClient) Recv() (*Object, error) {
+	m := new(Object)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
 	}
-	return i, nil
+	return m, nil
 }
 
-func (m *GetFileRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+func (c *objectAPIClient) DeleteObjects(ctx context.Context, in *DeleteObjectsRequest, opts ...grpc.CallOption) (*DeleteObjectsResponse, error) {
+	out := new(DeleteObjectsResponse)
+	err := grpc.Invoke(ctx, "/pfs.ObjectAPI/DeleteObjects", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *GetFileRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.File != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.File.Size()))
-		n34, err := m.File.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n34
+func (c *objectAPIClient) GetTag(ctx context.Context, in *Tag, opts ...grpc.CallOption) (ObjectAPI_GetTagClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_ObjectAPI_serviceDesc.Streams[5], c.cc, "/pfs.ObjectAPI/GetTag", opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.OffsetBytes != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.OffsetBytes))
+	x := &objectAPIGetTagClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
 	}
-	if m.SizeBytes != 0 {
-		dAtA[i] = 0x18
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.SizeBytes))
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
 	}
-	return i, nil
+	return x, nil
 }
 
-func (m *OverwriteIndex) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type ObjectAPI_GetTagClient interface {
+	Recv() (*google_protobuf3.BytesValue, error)
+	grpc.ClientStream
 }
 
-func (m *OverwriteIndex) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Index != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.Index))
+type objectAPIGetTagClient struct {
+	grpc.ClientStream
+}
+
+func (x *objectAPIGetTagClient) Recv() (*google_protobuf3.BytesValue, error) {
+	m := new(google_protobuf3.BytesValue)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
 	}
-	return i, nil
+	return m, nil
 }
 
-func (m *PutFileRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+func (c *objectAPIClient) InspectTag(ctx context.Context, in *Tag, opts ...grpc.CallOption) (*ObjectInfo, error) {
+	out := new(ObjectInfo)
+	err := grpc.Invoke(ctx, "/pfs.ObjectAPI/InspectTag", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *PutFileRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.File != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.File.Size()))
-		n35, err := m.File.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n35
-	}
-	if len(m.Value) > 0 {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(len(m.Value)))
-		i += copy(dAtA[i:], m.Value)
-	}
-	if len(m.Url) > 0 {
-		dAtA[i] = 0x2a
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(len(m.Url)))
-		i += copy(dAtA[i:], m.Url)
-	}
-	if m.Recursive {
-		dAtA[i] = 0x30
-		i++
-		if m.Recursive {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
+func (c *objectAPIClient) ListTags(ctx context.Context, in *ListTagsRequest, opts ...grpc.CallOption) (ObjectAPI_ListTagsClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_ObjectAPI_serviceDesc.Streams[6], c.cc, "/pfs.ObjectAPI/ListTags", opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.Delimiter != 0 {
-		dAtA[i] = 0x38
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.Delimiter))
+	x := &objectAPIListTagsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
 	}
-	if m.TargetFileDatums != 0 {
-		dAtA[i] = 0x40
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.TargetFileDatums))
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
 	}
-	if m.TargetFileBytes != 0 {
-		dAtA[i] = 0x48
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.TargetFileBytes))
+	return x, nil
+}
+
+type ObjectAPI_ListTagsClient interface {
+	Recv() (*ListTagsResponse, error)
+	grpc.ClientStream
+}
+
+type objectAPIListTagsClient struct {
+	grpc.ClientStream
+}
+
+func (x *objectAPIListTagsClient) Recv() (*ListTagsResponse, error) {
+	m := new(ListTagsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
 	}
-	if m.OverwriteIndex != nil {
-		dAtA[i] = 0x52
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.OverwriteIndex.Size()))
-		n36, err := m.OverwriteIndex.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n36
+	return m, nil
+}
+
+func (c *objectAPIClient) DeleteTags(ctx context.Context, in *DeleteTagsRequest, opts ...grpc.CallOption) (*DeleteTagsResponse, error) {
+	out := new(DeleteTagsResponse)
+	err := grpc.Invoke(ctx, "/pfs.ObjectAPI/DeleteTags", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return i, nil
+	return out, nil
 }
 
-func (m *PutFileRecord) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+func (c *objectAPIClient) Compact(ctx context.Context, in *google_protobuf1.Empty, opts ...grpc.CallOption) (*google_protobuf1.Empty, error) {
+	out := new(google_protobuf1.Empty)
+	err := grpc.Invoke(ctx, "/pfs.ObjectAPI/Compact", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *PutFileRecord) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.SizeBytes != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.SizeBytes))
-	}
-	if len(m.ObjectHash) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(len(m.ObjectHash)))
-		i += copy(dAtA[i:], m.ObjectHash)
-	}
-	if m.OverwriteIndex != nil {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.OverwriteIndex.Size()))
-		n37, err := m.OverwriteIndex.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n37
-	}
-	return i, nil
+// Server API for ObjectAPI service
+
+type ObjectAPIServer interface {
+	PutObject(ObjectAPI_PutObjectServer) error
+	PutObjectSplit(ObjectAPI_PutObjectSplitServer) error
+	GetObject(*Object, ObjectAPI_GetObjectServer) error
+	GetObjects(*GetObjectsRequest, ObjectAPI_GetObjectsServer) error
+	TagObject(context.Context, *TagObjectRequest) (*google_protobuf1.Empty, error)
+	InspectObject(context.Context, *Object) (*ObjectInfo, error)
+	// CheckObject checks if an object exists in the blob store without
+	// actually reading the object.
+	CheckObject(context.Context, *CheckObjectRequest) (*CheckObjectResponse, error)
+	ListObjects(*ListObjectsRequest, ObjectAPI_ListObjectsServer) error
+	DeleteObjects(context.Context, *DeleteObjectsRequest) (*DeleteObjectsResponse, error)
+	GetTag(*Tag, ObjectAPI_GetTagServer) error
+	InspectTag(context.Context, *Tag) (*ObjectInfo, error)
+	ListTags(*ListTagsRequest, ObjectAPI_ListTagsServer) error
+	DeleteTags(context.Context, *DeleteTagsRequest) (*DeleteTagsResponse, error)
+	Compact(context.Context, *google_protobuf1.Empty) (*google_protobuf1.Empty, error)
 }
 
-func (m *PutFileRecords) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func RegisterObjectAPIServer(s *grpc.Server, srv ObjectAPIServer) {
+	s.RegisterService(&_ObjectAPI_serviceDesc, srv)
 }
 
-func (m *PutFileRecords) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Split {
-		dAtA[i] = 0x8
-		i++
-		if m.Split {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
-	}
-	if len(m.Records) > 0 {
-		for _, msg := range m.Records {
-			dAtA[i] = 0x12
-			i++
-			i = encodeVarintPfs(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
-	}
-	return i, nil
+func _ObjectAPI_PutObject_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(ObjectAPIServer).PutObject(&objectAPIPutObjectServer{stream})
 }
 
-func (m *CopyFileRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type ObjectAPI_PutObjectServer interface {
+	SendAndClose(*Object) error
+	Recv() (*PutObjectRequest, error)
+	grpc.ServerStream
 }
 
-func (m *CopyFileRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Src != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.Src.Size()))
-		n38, err := m.Src.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n38
-	}
-	if m.Dst != nil {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.Dst.Size()))
-		n39, err := m.Dst.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n39
-	}
-	if m.Overwrite {
-		dAtA[i] = 0x18
-		i++
-		if m.Overwrite {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
-	}
-	return i, nil
+type objectAPIPutObjectServer struct {
+	grpc.ServerStream
 }
 
-func (m *InspectFileRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
+func (x *objectAPIPutObjectServer) SendAndClose(m *Object) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *objectAPIPutObjectServer) Recv() (*PutObjectRequest, error) {
+	m := new(PutObjectRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return m, nil
 }
 
-func (m *InspectFileRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.File != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.File.Size()))
-		n40, err := m.File.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n40
-	}
-	return i, nil
+func _ObjectAPI_PutObjectSplit_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(ObjectAPIServer).PutObjectSplit(&objectAPIPutObjectSplitServer{stream})
 }
 
-func (m *ListFileRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type ObjectAPI_PutObjectSplitServer interface {
+	SendAndClose(*Objects) error
+	Recv() (*PutObjectRequest, error)
+	grpc.ServerStream
 }
 
-func (m *ListFileRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.File != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.File.Size()))
-		n41, err := m.File.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n41
-	}
-	if m.Full {
-		dAtA[i] = 0x10
-		i++
-		if m.Full {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
-	}
-	return i, nil
+type objectAPIPutObjectSplitServer struct {
+	grpc.ServerStream
 }
 
-func (m *GlobFileRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
+func (x *objectAPIPutObjectSplitServer) SendAndClose(m *Objects) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *objectAPIPutObjectSplitServer) Recv() (*PutObjectRequest, error) {
+	m := new(PutObjectRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return m, nil
 }
 
-func (m *GlobFileRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Commit != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.Commit.Size()))
-		n42, err := m.Commit.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n42
-	}
-	if len(m.Pattern) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(len(m.Pattern)))
-		i += copy(dAtA[i:], m.Pattern)
+func _ObjectAPI_GetObject_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(Object)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	return i, nil
+	return srv.(ObjectAPIServer).GetObject(m, &objectAPIGetObjectServer{stream})
 }
 
-func (m *FileInfos) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type ObjectAPI_GetObjectServer interface {
+	Send(*google_protobuf3.BytesValue) error
+	grpc.ServerStream
 }
 
-func (m *FileInfos) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.FileInfo) > 0 {
-		for _, msg := range m.FileInfo {
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintPfs(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
-	}
-	return i, nil
+type objectAPIGetObjectServer struct {
+	grpc.ServerStream
 }
 
-func (m *DiffFileRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (x *objectAPIGetObjectServer) Send(m *google_protobuf3.BytesValue) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ObjectAPI_GetObjects_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetObjectsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	return dAtA[:n], nil
+	return srv.(ObjectAPIServer).GetObjects(m, &objectAPIGetObjectsServer{stream})
 }
 
-func (m *DiffFileRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.NewFile != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.NewFile.Size()))
-		n43, err := m.NewFile.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n43
+type ObjectAPI_GetObjectsServer interface {
+	Send(*google_protobuf3.BytesValue) error
+	grpc.ServerStream
+}
+
+type objectAPIGetObjectsServer struct {
+	grpc.ServerStream
+}
+
+func (x *objectAPIGetObjectsServer) Send(m *google_protobuf3.BytesValue) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ObjectAPI_TagObject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TagObjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.OldFile != nil {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.OldFile.Size()))
-		n44, err := m.OldFile.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n44
+	if interceptor == nil {
+		return srv.(ObjectAPIServer).TagObject(ctx, in)
 	}
-	if m.Shallow {
-		dAtA[i] = 0x18
-		i++
-		if m.Shallow {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pfs.ObjectAPI/TagObject",
 	}
-	return i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ObjectAPIServer).TagObject(ctx, req.(*TagObjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *DiffFileResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
+func _ObjectAPI_InspectObject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Object)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *DiffFileResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.NewFiles) > 0 {
-		for _, msg := range m.NewFiles {
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintPfs(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
+	if interceptor == nil {
+		return srv.(ObjectAPIServer).InspectObject(ctx, in)
 	}
-	if len(m.OldFiles) > 0 {
-		for _, msg := range m.OldFiles {
-			dAtA[i] = 0x12
-			i++
-			i = encodeVarintPfs(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pfs.ObjectAPI/InspectObject",
 	}
-	return i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ObjectAPIServer).InspectObject(ctx, req.(*Object))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *DeleteFileRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
+func _ObjectAPI_CheckObject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckObjectRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(ObjectAPIServer).CheckObject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pfs.ObjectAPI/CheckObject",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ObjectAPIServer).CheckObject(ctx, req.(*CheckObjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *DeleteFileRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.File != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.File.Size()))
-		n45, err := m.File.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n45
+func _ObjectAPI_ListObjects_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListObjectsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	return i, nil
+	return srv.(ObjectAPIServer).ListObjects(m, &objectAPIListObjectsServer{stream})
 }
 
-func (m *PutObjectRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type ObjectAPI_ListObjectsServer interface {
+	Send(*Object) error
+	grpc.ServerStream
 }
 
-func (m *PutObjectRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Value) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(len(m.Value)))
-		i += copy(dAtA[i:], m.Value)
-	}
-	if len(m.Tags) > 0 {
-		for _, msg := range m.Tags {
-			dAtA[i] = 0x12
-			i++
-			i = encodeVarintPfs(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
-	}
-	return i, nil
+type objectAPIListObjectsServer struct {
+	grpc.ServerStream
 }
 
-func (m *GetObjectsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (x *objectAPIListObjectsServer) Send(m *Object) error {
+	return x.ServerStream.SendMsg(m)
 }
 
-func (m *GetObjectsRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Objects) > 0 {
-		for _, msg := range m.Objects {
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintPfs(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
+func _ObjectAPI_DeleteObjects_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteObjectsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.OffsetBytes != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.OffsetBytes))
+	if interceptor == nil {
+		return srv.(ObjectAPIServer).DeleteObjects(ctx, in)
 	}
-	if m.SizeBytes != 0 {
-		dAtA[i] = 0x18
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.SizeBytes))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pfs.ObjectAPI/DeleteObjects",
 	}
-	if m.TotalSize != 0 {
-		dAtA[i] = 0x20
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.TotalSize))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ObjectAPIServer).DeleteObjects(ctx, req.(*DeleteObjectsRequest))
 	}
-	return i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *TagObjectRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
+func _ObjectAPI_GetTag_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(Tag)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ObjectAPIServer).GetTag(m, &objectAPIGetTagServer{stream})
+}
+
+type ObjectAPI_GetTagServer interface {
+	Send(*google_protobuf3.BytesValue) error
+	grpc.ServerStream
+}
+
+type objectAPIGetTagServer struct {
+	grpc.ServerStream
+}
+
+func (x *objectAPIGetTagServer) Send(m *google_protobuf3.BytesValue) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ObjectAPI_InspectTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Tag)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(ObjectAPIServer).InspectTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pfs.ObjectAPI/InspectTag",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ObjectAPIServer).InspectTag(ctx, req.(*Tag))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *TagObjectRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Object != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.Object.Size()))
-		n46, err := m.Object.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n46
+func _ObjectAPI_ListTags_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListTagsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	if len(m.Tags) > 0 {
-		for _, msg := range m.Tags {
-			dAtA[i] = 0x12
-			i++
-			i = encodeVarintPfs(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
+	return srv.(ObjectAPIServer).ListTags(m, &objectAPIListTagsServer{stream})
+}
+
+type ObjectAPI_ListTagsServer interface {
+	Send(*ListTagsResponse) error
+	grpc.ServerStream
+}
+
+type objectAPIListTagsServer struct {
+	grpc.ServerStream
+}
+
+func (x *objectAPIListTagsServer) Send(m *ListTagsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ObjectAPI_DeleteTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return i, nil
+	if interceptor == nil {
+		return srv.(ObjectAPIServer).DeleteTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pfs.ObjectAPI/DeleteTags",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ObjectAPIServer).DeleteTags(ctx, req.(*DeleteTagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ListObjectsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
+func _ObjectAPI_Compact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(google_protobuf1.Empty)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(ObjectAPIServer).Compact(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pfs.ObjectAPI/Compact",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ObjectAPIServer).Compact(ctx, req.(*google_protobuf1.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ListObjectsRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	return i, nil
+var _ObjectAPI_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "pfs.ObjectAPI",
+	HandlerType: (*ObjectAPIServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "TagObject",
+			Handler:    _ObjectAPI_TagObject_Handler,
+		},
+		{
+			MethodName: "InspectObject",
+			Handler:    _ObjectAPI_InspectObject_Handler,
+		},
+		{
+			MethodName: "CheckObject",
+			Handler:    _ObjectAPI_CheckObject_Handler,
+		},
+		{
+			MethodName: "DeleteObjects",
+			Handler:    _ObjectAPI_DeleteObjects_Handler,
+		},
+		{
+			MethodName: "InspectTag",
+			Handler:    _ObjectAPI_InspectTag_Handler,
+		},
+		{
+			MethodName: "DeleteTags",
+			Handler:    _ObjectAPI_DeleteTags_Handler,
+		},
+		{
+			MethodName: "Compact",
+			Handler:    _ObjectAPI_Compact_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "PutObject",
+			Handler:       _ObjectAPI_PutObject_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "PutObjectSplit",
+			Handler:       _ObjectAPI_PutObjectSplit_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "GetObject",
+			Handler:       _ObjectAPI_GetObject_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "GetObjects",
+			Handler:       _ObjectAPI_GetObjects_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ListObjects",
+			Handler:       _ObjectAPI_ListObjects_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "GetTag",
+			Handler:       _ObjectAPI_GetTag_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ListTags",
+			Handler:       _ObjectAPI_ListTags_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "client/pfs/pfs.proto",
 }
 
-func (m *ListTagsRequest) Marshal() (dAtA []byte, err error) {
+func (m *Repo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -5434,31 +5198,21 @@ func (m *ListTagsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ListTagsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *Repo) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.Prefix) > 0 {
+	if len(m.Name) > 0 {
 		dAtA[i] = 0xa
 		i++
-		i = encodeVarintPfs(dAtA, i, uint64(len(m.Prefix)))
-		i += copy(dAtA[i:], m.Prefix)
-	}
-	if m.IncludeObject {
-		dAtA[i] = 0x10
-		i++
-		if m.IncludeObject {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
 	}
 	return i, nil
 }
 
-func (m *ListTagsResponse) Marshal() (dAtA []byte, err error) {
+func (m *BranchInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -5468,31 +5222,31 @@ func (m *ListTagsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ListTagsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *BranchInfo) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.Tag) > 0 {
+	if len(m.Name) > 0 {
 		dAtA[i] = 0xa
 		i++
-		i = encodeVarintPfs(dAtA, i, uint64(len(m.Tag)))
-		i += copy(dAtA[i:], m.Tag)
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
 	}
-	if m.Object != nil {
+	if m.Head != nil {
 		dAtA[i] = 0x12
 		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.Object.Size()))
-		n47, err := m.Object.MarshalTo(dAtA[i:])
+		i = encodeVarintPfs(dAtA, i, uint64(m.Head.Size()))
+		n1, err := m.Head.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n47
+		i += n1
 	}
 	return i, nil
 }
 
-func (m *DeleteObjectsRequest) Marshal() (dAtA []byte, err error) {
+func (m *BranchInfos) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -5502,13 +5256,13 @@ func (m *DeleteObjectsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DeleteObjectsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *BranchInfos) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.Objects) > 0 {
-		for _, msg := range m.Objects {
+	if len(m.BranchInfo) > 0 {
+		for _, msg := range m.BranchInfo {
 			dAtA[i] = 0xa
 			i++
 			i = encodeVarintPfs(dAtA, i, uint64(msg.Size()))
@@ -5522,7 +5276,7 @@ func (m *DeleteObjectsRequest) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *DeleteObjectsResponse) Marshal() (dAtA []byte, err error) {
+func (m *BranchMove) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -5532,48 +5286,93 @@ func (m *DeleteObjectsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DeleteObjectsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *BranchMove) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	return i, nil
-}
-
-func (m *DeleteTagsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+	if len(m.Branch) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Branch)))
+		i += copy(dAtA[i:], m.Branch)
 	}
-	return dAtA[:n], nil
+	if m.OldHead != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.OldHead.Size()))
+		n2, err := m.OldHead.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n2
+	}
+	if m.NewHead != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.NewHead.Size()))
+		n3, err := m.NewHead.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n3
+	}
+	if m.Time != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Time.Size()))
+		n4, err := m.Time.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n4
+	}
+	if len(m.Principal) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Principal)))
+		i += copy(dAtA[i:], m.Principal)
+	}
+	if len(m.Operation) > 0 {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Operation)))
+		i += copy(dAtA[i:], m.Operation)
+	}
+	return i, nil
 }
 
-func (m *DeleteTagsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *BranchHistory) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BranchHistory) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.Tags) > 0 {
-		for _, s := range m.Tags {
+	if len(m.Moves) > 0 {
+		for _, msg := range m.Moves {
 			dAtA[i] = 0xa
 			i++
-			l = len(s)
-			for l >= 1<<7 {
-				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
-				l >>= 7
-				i++
+			i = encodeVarintPfs(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
 			}
-			dAtA[i] = uint8(l)
-			i++
-			i += copy(dAtA[i:], s)
+			i += n
 		}
 	}
 	return i, nil
 }
 
-func (m *DeleteTagsResponse) Marshal() (dAtA []byte, err error) {
+func (m *File) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -5583,15 +5382,31 @@ func (m *DeleteTagsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DeleteTagsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *File) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
+	if m.Commit != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Commit.Size()))
+		n5, err := m.Commit.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n5
+	}
+	if len(m.Path) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Path)))
+		i += copy(dAtA[i:], m.Path)
+	}
 	return i, nil
 }
 
-func (m *CheckObjectRequest) Marshal() (dAtA []byte, err error) {
+func (m *Block) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -5601,25 +5416,21 @@ func (m *CheckObjectRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *CheckObjectRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *Block) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Object != nil {
+	if len(m.Hash) > 0 {
 		dAtA[i] = 0xa
 		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.Object.Size()))
-		n48, err := m.Object.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n48
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Hash)))
+		i += copy(dAtA[i:], m.Hash)
 	}
 	return i, nil
 }
 
-func (m *CheckObjectResponse) Marshal() (dAtA []byte, err error) {
+func (m *Object) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -5629,25 +5440,45 @@ func (m *CheckObjectResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *CheckObjectResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *Object) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Exists {
-		dAtA[i] = 0x8
+	if len(m.Hash) > 0 {
+		dAtA[i] = 0xa
 		i++
-		if m.Exists {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Hash)))
+		i += copy(dAtA[i:], m.Hash)
+	}
+	return i, nil
+}
+
+func (m *Tag) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Tag) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		dAtA[i] = 0xa
 		i++
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
 	}
 	return i, nil
 }
 
-func (m *Objects) Marshal() (dAtA []byte, err error) {
+func (m *RepoInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -5657,14 +5488,67 @@ func (m *Objects) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Objects) MarshalTo(dAtA []byte) (int, error) {
+func (m *RepoInfo) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.Objects) > 0 {
-		for _, msg := range m.Objects {
-			dAtA[i] = 0xa
+	if m.Repo != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Repo.Size()))
+		n6, err := m.Repo.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n6
+	}
+	if m.Created != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Created.Size()))
+		n7, err := m.Created.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n7
+	}
+	if m.SizeBytes != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.SizeBytes))
+	}
+	if len(m.Provenance) > 0 {
+		for _, msg := range m.Provenance {
+			dAtA[i] = 0x22
+			i++
+			i = encodeVarintPfs(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.Description) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Description)))
+		i += copy(dAtA[i:], m.Description)
+	}
+	if m.AuthInfo != nil {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.AuthInfo.Size()))
+		n8, err := m.AuthInfo.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n8
+	}
+	if len(m.BranchProtections) > 0 {
+		for _, msg := range m.BranchProtections {
+			dAtA[i] = 0x3a
 			i++
 			i = encodeVarintPfs(dAtA, i, uint64(msg.Size()))
 			n, err := msg.MarshalTo(dAtA[i:])
@@ -5674,10 +5558,36 @@ func (m *Objects) MarshalTo(dAtA []byte) (int, error) {
 			i += n
 		}
 	}
+	if m.RetentionPolicy != nil {
+		dAtA[i] = 0x42
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.RetentionPolicy.Size()))
+		n9, err := m.RetentionPolicy.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n9
+	}
+	if m.Quota != nil {
+		dAtA[i] = 0x4a
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Quota.Size()))
+		n10, err := m.Quota.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n10
+	}
+	if len(m.CreatedBy) > 0 {
+		dAtA[i] = 0x52
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.CreatedBy)))
+		i += copy(dAtA[i:], m.CreatedBy)
+	}
 	return i, nil
 }
 
-func (m *ObjectIndex) Marshal() (dAtA []byte, err error) {
+func (m *BranchProtection) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -5687,993 +5597,7856 @@ func (m *ObjectIndex) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ObjectIndex) MarshalTo(dAtA []byte) (int, error) {
+func (m *BranchProtection) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.Objects) > 0 {
-		for k, _ := range m.Objects {
-			dAtA[i] = 0xa
-			i++
-			v := m.Objects[k]
-			msgSize := 0
-			if v != nil {
-				msgSize = v.Size()
-				msgSize += 1 + sovPfs(uint64(msgSize))
-			}
-			mapSize := 1 + len(k) + sovPfs(uint64(len(k))) + msgSize
-			i = encodeVarintPfs(dAtA, i, uint64(mapSize))
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintPfs(dAtA, i, uint64(len(k)))
-			i += copy(dAtA[i:], k)
-			if v != nil {
-				dAtA[i] = 0x12
-				i++
-				i = encodeVarintPfs(dAtA, i, uint64(v.Size()))
-				n49, err := v.MarshalTo(dAtA[i:])
-				if err != nil {
-					return 0, err
-				}
-				i += n49
-			}
-		}
+	if len(m.Branch) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Branch)))
+		i += copy(dAtA[i:], m.Branch)
 	}
-	if len(m.Tags) > 0 {
-		for k, _ := range m.Tags {
+	if len(m.AllowedPrincipals) > 0 {
+		for _, s := range m.AllowedPrincipals {
 			dAtA[i] = 0x12
 			i++
-			v := m.Tags[k]
-			msgSize := 0
-			if v != nil {
-				msgSize = v.Size()
-				msgSize += 1 + sovPfs(uint64(msgSize))
-			}
-			mapSize := 1 + len(k) + sovPfs(uint64(len(k))) + msgSize
-			i = encodeVarintPfs(dAtA, i, uint64(mapSize))
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintPfs(dAtA, i, uint64(len(k)))
-			i += copy(dAtA[i:], k)
-			if v != nil {
-				dAtA[i] = 0x12
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
 				i++
-				i = encodeVarintPfs(dAtA, i, uint64(v.Size()))
-				n50, err := v.MarshalTo(dAtA[i:])
-				if err != nil {
-					return 0, err
-				}
-				i += n50
 			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
 		}
 	}
-	return i, nil
-}
-
-func encodeFixed64Pfs(dAtA []byte, offset int, v uint64) int {
-	dAtA[offset] = uint8(v)
-	dAtA[offset+1] = uint8(v >> 8)
-	dAtA[offset+2] = uint8(v >> 16)
-	dAtA[offset+3] = uint8(v >> 24)
-	dAtA[offset+4] = uint8(v >> 32)
-	dAtA[offset+5] = uint8(v >> 40)
-	dAtA[offset+6] = uint8(v >> 48)
-	dAtA[offset+7] = uint8(v >> 56)
-	return offset + 8
-}
-func encodeFixed32Pfs(dAtA []byte, offset int, v uint32) int {
-	dAtA[offset] = uint8(v)
-	dAtA[offset+1] = uint8(v >> 8)
-	dAtA[offset+2] = uint8(v >> 16)
-	dAtA[offset+3] = uint8(v >> 24)
-	return offset + 4
-}
-func encodeVarintPfs(dAtA []byte, offset int, v uint64) int {
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if m.FastForwardOnly {
+		dAtA[i] = 0x18
+		i++
+		if m.FastForwardOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
 	}
-	dAtA[offset] = uint8(v)
-	return offset + 1
+	if len(m.RequiredPipeline) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.RequiredPipeline)))
+		i += copy(dAtA[i:], m.RequiredPipeline)
+	}
+	return i, nil
 }
-func (m *Repo) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovPfs(uint64(l))
+
+func (m *RetentionPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *BranchInfo) Size() (n int) {
+func (m *RetentionPolicy) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovPfs(uint64(l))
+	if m.KeepLast != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.KeepLast))
 	}
-	if m.Head != nil {
-		l = m.Head.Size()
-		n += 1 + l + sovPfs(uint64(l))
+	if m.KeepNewerThan != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.KeepNewerThan.Size()))
+		n11, err := m.KeepNewerThan.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n11
 	}
-	return n
+	if m.KeepDaily != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.KeepDaily))
+	}
+	if m.KeepWeekly != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.KeepWeekly))
+	}
+	return i, nil
 }
 
-func (m *BranchInfos) Size() (n int) {
-	var l int
-	_ = l
-	if len(m.BranchInfo) > 0 {
-		for _, e := range m.BranchInfo {
-			l = e.Size()
-			n += 1 + l + sovPfs(uint64(l))
-		}
+func (m *Quota) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *File) Size() (n int) {
+func (m *Quota) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Commit != nil {
-		l = m.Commit.Size()
-		n += 1 + l + sovPfs(uint64(l))
+	if m.MaxBytes != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.MaxBytes))
 	}
-	l = len(m.Path)
-	if l > 0 {
-		n += 1 + l + sovPfs(uint64(l))
+	if m.MaxCommits != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.MaxCommits))
 	}
-	return n
+	return i, nil
 }
 
-func (m *Block) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Hash)
-	if l > 0 {
-		n += 1 + l + sovPfs(uint64(l))
+func (m *RepoAuthInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *Object) Size() (n int) {
+func (m *RepoAuthInfo) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Hash)
-	if l > 0 {
-		n += 1 + l + sovPfs(uint64(l))
+	if m.AccessLevel != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.AccessLevel))
 	}
-	return n
+	return i, nil
 }
 
-func (m *Tag) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovPfs(uint64(l))
+func (m *Commit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *RepoInfo) Size() (n int) {
+func (m *Commit) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
 	if m.Repo != nil {
-		l = m.Repo.Size()
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	if m.Created != nil {
-		l = m.Created.Size()
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	if m.SizeBytes != 0 {
-		n += 1 + sovPfs(uint64(m.SizeBytes))
-	}
-	if len(m.Provenance) > 0 {
-		for _, e := range m.Provenance {
-			l = e.Size()
-			n += 1 + l + sovPfs(uint64(l))
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Repo.Size()))
+		n12, err := m.Repo.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
 		}
+		i += n12
 	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	if m.AuthInfo != nil {
-		l = m.AuthInfo.Size()
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	return n
-}
-
-func (m *RepoAuthInfo) Size() (n int) {
-	var l int
-	_ = l
-	if m.AccessLevel != 0 {
-		n += 1 + sovPfs(uint64(m.AccessLevel))
+	if len(m.ID) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.ID)))
+		i += copy(dAtA[i:], m.ID)
 	}
-	return n
+	return i, nil
 }
 
-func (m *Commit) Size() (n int) {
-	var l int
-	_ = l
-	if m.Repo != nil {
-		l = m.Repo.Size()
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	l = len(m.ID)
-	if l > 0 {
-		n += 1 + l + sovPfs(uint64(l))
+func (m *CommitInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *CommitInfo) Size() (n int) {
+func (m *CommitInfo) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
 	if m.Commit != nil {
-		l = m.Commit.Size()
-		n += 1 + l + sovPfs(uint64(l))
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Commit.Size()))
+		n13, err := m.Commit.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n13
 	}
 	if m.ParentCommit != nil {
-		l = m.ParentCommit.Size()
-		n += 1 + l + sovPfs(uint64(l))
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.ParentCommit.Size()))
+		n14, err := m.ParentCommit.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n14
 	}
 	if m.Started != nil {
-		l = m.Started.Size()
-		n += 1 + l + sovPfs(uint64(l))
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Started.Size()))
+		n15, err := m.Started.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n15
 	}
 	if m.Finished != nil {
-		l = m.Finished.Size()
-		n += 1 + l + sovPfs(uint64(l))
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Finished.Size()))
+		n16, err := m.Finished.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n16
 	}
 	if m.SizeBytes != 0 {
-		n += 1 + sovPfs(uint64(m.SizeBytes))
-	}
-	if len(m.Provenance) > 0 {
-		for _, e := range m.Provenance {
-			l = e.Size()
-			n += 1 + l + sovPfs(uint64(l))
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.SizeBytes))
+	}
+	if len(m.Provenance) > 0 {
+		for _, msg := range m.Provenance {
+			dAtA[i] = 0x32
+			i++
+			i = encodeVarintPfs(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
 		}
 	}
 	if m.Tree != nil {
-		l = m.Tree.Size()
-		n += 1 + l + sovPfs(uint64(l))
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Tree.Size()))
+		n17, err := m.Tree.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n17
 	}
-	return n
+	return i, nil
 }
 
-func (m *FileInfo) Size() (n int) {
+func (m *FileInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FileInfo) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
 	if m.File != nil {
-		l = m.File.Size()
-		n += 1 + l + sovPfs(uint64(l))
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.File.Size()))
+		n18, err := m.File.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n18
 	}
 	if m.FileType != 0 {
-		n += 1 + sovPfs(uint64(m.FileType))
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.FileType))
 	}
 	if m.SizeBytes != 0 {
-		n += 1 + sovPfs(uint64(m.SizeBytes))
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.SizeBytes))
 	}
 	if len(m.Children) > 0 {
 		for _, s := range m.Children {
+			dAtA[i] = 0x32
+			i++
 			l = len(s)
-			n += 1 + l + sovPfs(uint64(l))
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
 		}
 	}
-	l = len(m.Hash)
-	if l > 0 {
-		n += 1 + l + sovPfs(uint64(l))
+	if len(m.Hash) > 0 {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Hash)))
+		i += copy(dAtA[i:], m.Hash)
 	}
 	if len(m.Objects) > 0 {
-		for _, e := range m.Objects {
-			l = e.Size()
-			n += 1 + l + sovPfs(uint64(l))
+		for _, msg := range m.Objects {
+			dAtA[i] = 0x42
+			i++
+			i = encodeVarintPfs(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
 		}
 	}
-	return n
+	return i, nil
 }
 
-func (m *ByteRange) Size() (n int) {
+func (m *ByteRange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ByteRange) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
 	if m.Lower != 0 {
-		n += 1 + sovPfs(uint64(m.Lower))
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Lower))
 	}
 	if m.Upper != 0 {
-		n += 1 + sovPfs(uint64(m.Upper))
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Upper))
 	}
-	return n
+	return i, nil
 }
 
-func (m *BlockRef) Size() (n int) {
+func (m *BlockRef) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockRef) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
 	if m.Block != nil {
-		l = m.Block.Size()
-		n += 1 + l + sovPfs(uint64(l))
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Block.Size()))
+		n19, err := m.Block.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n19
 	}
 	if m.Range != nil {
-		l = m.Range.Size()
-		n += 1 + l + sovPfs(uint64(l))
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Range.Size()))
+		n20, err := m.Range.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n20
 	}
-	return n
+	return i, nil
 }
 
-func (m *ObjectInfo) Size() (n int) {
+func (m *ObjectInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ObjectInfo) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
 	if m.Object != nil {
-		l = m.Object.Size()
-		n += 1 + l + sovPfs(uint64(l))
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Object.Size()))
+		n21, err := m.Object.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n21
 	}
 	if m.BlockRef != nil {
-		l = m.BlockRef.Size()
-		n += 1 + l + sovPfs(uint64(l))
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.BlockRef.Size()))
+		n22, err := m.BlockRef.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n22
 	}
-	return n
+	return i, nil
 }
 
-func (m *CreateRepoRequest) Size() (n int) {
+func (m *CreateRepoRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreateRepoRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
 	if m.Repo != nil {
-		l = m.Repo.Size()
-		n += 1 + l + sovPfs(uint64(l))
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Repo.Size()))
+		n23, err := m.Repo.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n23
 	}
 	if len(m.Provenance) > 0 {
-		for _, e := range m.Provenance {
-			l = e.Size()
-			n += 1 + l + sovPfs(uint64(l))
+		for _, msg := range m.Provenance {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintPfs(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
 		}
 	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovPfs(uint64(l))
+	if len(m.Description) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Description)))
+		i += copy(dAtA[i:], m.Description)
 	}
 	if m.Update {
-		n += 2
+		dAtA[i] = 0x20
+		i++
+		if m.Update {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
 	}
-	return n
+	return i, nil
 }
 
-func (m *InspectRepoRequest) Size() (n int) {
-	var l int
-	_ = l
-	if m.Repo != nil {
-		l = m.Repo.Size()
-		n += 1 + l + sovPfs(uint64(l))
+func (m *InspectRepoRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ListRepoRequest) Size() (n int) {
+func (m *InspectRepoRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if len(m.Provenance) > 0 {
-		for _, e := range m.Provenance {
-			l = e.Size()
-			n += 1 + l + sovPfs(uint64(l))
+	if m.Repo != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Repo.Size()))
+		n24, err := m.Repo.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
 		}
+		i += n24
 	}
-	return n
+	return i, nil
 }
 
-func (m *ListRepoResponse) Size() (n int) {
-	var l int
-	_ = l
-	if len(m.RepoInfo) > 0 {
-		for _, e := range m.RepoInfo {
-			l = e.Size()
-			n += 1 + l + sovPfs(uint64(l))
-		}
+func (m *ListRepoRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *DeleteRepoRequest) Size() (n int) {
+func (m *ListRepoRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Repo != nil {
-		l = m.Repo.Size()
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	if m.Force {
-		n += 2
+	if len(m.Provenance) > 0 {
+		for _, msg := range m.Provenance {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintPfs(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
 	}
-	if m.All {
-		n += 2
+	return i, nil
+}
+
+func (m *ListRepoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *StartCommitRequest) Size() (n int) {
+func (m *ListRepoResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Parent != nil {
-		l = m.Parent.Size()
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	if len(m.Provenance) > 0 {
-		for _, e := range m.Provenance {
-			l = e.Size()
-			n += 1 + l + sovPfs(uint64(l))
+	if len(m.RepoInfo) > 0 {
+		for _, msg := range m.RepoInfo {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintPfs(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
 		}
 	}
-	l = len(m.Branch)
-	if l > 0 {
-		n += 1 + l + sovPfs(uint64(l))
+	return i, nil
+}
+
+func (m *DeleteRepoRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *BuildCommitRequest) Size() (n int) {
+func (m *DeleteRepoRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Parent != nil {
-		l = m.Parent.Size()
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	if len(m.Provenance) > 0 {
-		for _, e := range m.Provenance {
-			l = e.Size()
-			n += 1 + l + sovPfs(uint64(l))
+	if m.Repo != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Repo.Size()))
+		n25, err := m.Repo.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
 		}
+		i += n25
 	}
-	if m.Tree != nil {
-		l = m.Tree.Size()
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	l = len(m.Branch)
-	if l > 0 {
-		n += 1 + l + sovPfs(uint64(l))
+	if m.Force {
+		dAtA[i] = 0x10
+		i++
+		if m.Force {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
 	}
-	return n
-}
-
-func (m *FinishCommitRequest) Size() (n int) {
-	var l int
-	_ = l
-	if m.Commit != nil {
-		l = m.Commit.Size()
-		n += 1 + l + sovPfs(uint64(l))
+	if m.All {
+		dAtA[i] = 0x18
+		i++
+		if m.All {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
 	}
-	return n
+	return i, nil
 }
 
-func (m *InspectCommitRequest) Size() (n int) {
-	var l int
-	_ = l
-	if m.Commit != nil {
-		l = m.Commit.Size()
-		n += 1 + l + sovPfs(uint64(l))
+func (m *ForkRepoRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ListCommitRequest) Size() (n int) {
+func (m *ForkRepoRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
 	if m.Repo != nil {
-		l = m.Repo.Size()
-		n += 1 + l + sovPfs(uint64(l))
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Repo.Size()))
+		n26, err := m.Repo.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n26
 	}
-	if m.From != nil {
-		l = m.From.Size()
-		n += 1 + l + sovPfs(uint64(l))
+	if m.Fork != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Fork.Size()))
+		n27, err := m.Fork.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n27
 	}
-	if m.To != nil {
-		l = m.To.Size()
-		n += 1 + l + sovPfs(uint64(l))
+	if len(m.Description) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Description)))
+		i += copy(dAtA[i:], m.Description)
 	}
-	if m.Number != 0 {
-		n += 1 + sovPfs(uint64(m.Number))
+	return i, nil
+}
+
+func (m *RenameRepoRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *CommitInfos) Size() (n int) {
+func (m *RenameRepoRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if len(m.CommitInfo) > 0 {
-		for _, e := range m.CommitInfo {
-			l = e.Size()
-			n += 1 + l + sovPfs(uint64(l))
+	if m.Repo != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Repo.Size()))
+		n28, err := m.Repo.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n28
+	}
+	if m.NewRepo != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.NewRepo.Size()))
+		n29, err := m.NewRepo.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
 		}
+		i += n29
 	}
-	return n
+	return i, nil
 }
 
-func (m *ListBranchRequest) Size() (n int) {
-	var l int
-	_ = l
-	if m.Repo != nil {
-		l = m.Repo.Size()
-		n += 1 + l + sovPfs(uint64(l))
+func (m *StartCommitRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *SetBranchRequest) Size() (n int) {
+func (m *StartCommitRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Commit != nil {
-		l = m.Commit.Size()
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	l = len(m.Branch)
-	if l > 0 {
-		n += 1 + l + sovPfs(uint64(l))
+	if m.Parent != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Parent.Size()))
+		n30, err := m.Parent.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n30
 	}
-	return n
-}
-
-func (m *DeleteBranchRequest) Size() (n int) {
-	var l int
-	_ = l
-	if m.Repo != nil {
-		l = m.Repo.Size()
-		n += 1 + l + sovPfs(uint64(l))
+	if len(m.Provenance) > 0 {
+		for _, msg := range m.Provenance {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintPfs(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
 	}
-	l = len(m.Branch)
-	if l > 0 {
-		n += 1 + l + sovPfs(uint64(l))
+	if len(m.Branch) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Branch)))
+		i += copy(dAtA[i:], m.Branch)
 	}
-	return n
+	return i, nil
 }
 
-func (m *DeleteCommitRequest) Size() (n int) {
-	var l int
-	_ = l
-	if m.Commit != nil {
-		l = m.Commit.Size()
-		n += 1 + l + sovPfs(uint64(l))
+func (m *BuildCommitRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *FlushCommitRequest) Size() (n int) {
+func (m *BuildCommitRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if len(m.Commits) > 0 {
-		for _, e := range m.Commits {
-			l = e.Size()
-			n += 1 + l + sovPfs(uint64(l))
+	if m.Parent != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Parent.Size()))
+		n31, err := m.Parent.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
 		}
+		i += n31
 	}
-	if len(m.ToRepos) > 0 {
-		for _, e := range m.ToRepos {
-			l = e.Size()
-			n += 1 + l + sovPfs(uint64(l))
+	if len(m.Provenance) > 0 {
+		for _, msg := range m.Provenance {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintPfs(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
 		}
 	}
-	return n
-}
-
-func (m *SubscribeCommitRequest) Size() (n int) {
-	var l int
-	_ = l
-	if m.Repo != nil {
-		l = m.Repo.Size()
-		n += 1 + l + sovPfs(uint64(l))
+	if m.Tree != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Tree.Size()))
+		n32, err := m.Tree.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n32
 	}
-	l = len(m.Branch)
-	if l > 0 {
-		n += 1 + l + sovPfs(uint64(l))
+	if len(m.Branch) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Branch)))
+		i += copy(dAtA[i:], m.Branch)
 	}
-	if m.From != nil {
-		l = m.From.Size()
-		n += 1 + l + sovPfs(uint64(l))
+	return i, nil
+}
+
+func (m *FinishCommitRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *GetFileRequest) Size() (n int) {
+func (m *FinishCommitRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.File != nil {
-		l = m.File.Size()
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	if m.OffsetBytes != 0 {
-		n += 1 + sovPfs(uint64(m.OffsetBytes))
+	if m.Commit != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Commit.Size()))
+		n33, err := m.Commit.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n33
 	}
-	if m.SizeBytes != 0 {
-		n += 1 + sovPfs(uint64(m.SizeBytes))
+	return i, nil
+}
+
+func (m *InspectCommitRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *OverwriteIndex) Size() (n int) {
+func (m *InspectCommitRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Index != 0 {
-		n += 1 + sovPfs(uint64(m.Index))
+	if m.Commit != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Commit.Size()))
+		n34, err := m.Commit.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n34
 	}
-	return n
+	return i, nil
 }
 
-func (m *PutFileRequest) Size() (n int) {
+func (m *ListCommitRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListCommitRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.File != nil {
-		l = m.File.Size()
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	l = len(m.Url)
-	if l > 0 {
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	if m.Recursive {
-		n += 2
+	if m.Repo != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Repo.Size()))
+		n35, err := m.Repo.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n35
 	}
-	if m.Delimiter != 0 {
-		n += 1 + sovPfs(uint64(m.Delimiter))
+	if m.From != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.From.Size()))
+		n36, err := m.From.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n36
 	}
-	if m.TargetFileDatums != 0 {
-		n += 1 + sovPfs(uint64(m.TargetFileDatums))
+	if m.To != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.To.Size()))
+		n37, err := m.To.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n37
 	}
-	if m.TargetFileBytes != 0 {
-		n += 1 + sovPfs(uint64(m.TargetFileBytes))
+	if m.Number != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Number))
 	}
-	if m.OverwriteIndex != nil {
-		l = m.OverwriteIndex.Size()
-		n += 1 + l + sovPfs(uint64(l))
+	return i, nil
+}
+
+func (m *CommitInfos) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *PutFileRecord) Size() (n int) {
+func (m *CommitInfos) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.SizeBytes != 0 {
-		n += 1 + sovPfs(uint64(m.SizeBytes))
-	}
-	l = len(m.ObjectHash)
-	if l > 0 {
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	if m.OverwriteIndex != nil {
-		l = m.OverwriteIndex.Size()
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	return n
-}
-
-func (m *PutFileRecords) Size() (n int) {
-	var l int
-	_ = l
-	if m.Split {
-		n += 2
-	}
-	if len(m.Records) > 0 {
-		for _, e := range m.Records {
-			l = e.Size()
-			n += 1 + l + sovPfs(uint64(l))
+	if len(m.CommitInfo) > 0 {
+		for _, msg := range m.CommitInfo {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintPfs(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
 		}
 	}
-	return n
+	return i, nil
 }
 
-func (m *CopyFileRequest) Size() (n int) {
-	var l int
-	_ = l
-	if m.Src != nil {
-		l = m.Src.Size()
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	if m.Dst != nil {
-		l = m.Dst.Size()
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	if m.Overwrite {
-		n += 2
+func (m *ListBranchRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *InspectFileRequest) Size() (n int) {
+func (m *ListBranchRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.File != nil {
-		l = m.File.Size()
-		n += 1 + l + sovPfs(uint64(l))
+	if m.Repo != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Repo.Size()))
+		n38, err := m.Repo.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n38
 	}
-	return n
+	return i, nil
 }
 
-func (m *ListFileRequest) Size() (n int) {
-	var l int
-	_ = l
-	if m.File != nil {
-		l = m.File.Size()
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	if m.Full {
-		n += 2
+func (m *SetBranchRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *GlobFileRequest) Size() (n int) {
+func (m *SetBranchRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
 	if m.Commit != nil {
-		l = m.Commit.Size()
-		n += 1 + l + sovPfs(uint64(l))
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Commit.Size()))
+		n39, err := m.Commit.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n39
 	}
-	l = len(m.Pattern)
-	if l > 0 {
-		n += 1 + l + sovPfs(uint64(l))
+	if len(m.Branch) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Branch)))
+		i += copy(dAtA[i:], m.Branch)
 	}
-	return n
+	return i, nil
 }
 
-func (m *FileInfos) Size() (n int) {
-	var l int
-	_ = l
-	if len(m.FileInfo) > 0 {
-		for _, e := range m.FileInfo {
-			l = e.Size()
-			n += 1 + l + sovPfs(uint64(l))
-		}
+func (m *DeleteBranchRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *DiffFileRequest) Size() (n int) {
+func (m *DeleteBranchRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.NewFile != nil {
-		l = m.NewFile.Size()
-		n += 1 + l + sovPfs(uint64(l))
+	if m.Repo != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Repo.Size()))
+		n40, err := m.Repo.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n40
 	}
-	if m.OldFile != nil {
-		l = m.OldFile.Size()
-		n += 1 + l + sovPfs(uint64(l))
+	if len(m.Branch) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Branch)))
+		i += copy(dAtA[i:], m.Branch)
 	}
-	if m.Shallow {
-		n += 2
+	return i, nil
+}
+
+func (m *ListBranchHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *DiffFileResponse) Size() (n int) {
+func (m *ListBranchHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if len(m.NewFiles) > 0 {
-		for _, e := range m.NewFiles {
-			l = e.Size()
-			n += 1 + l + sovPfs(uint64(l))
+	if m.Repo != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Repo.Size()))
+		n41, err := m.Repo.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
 		}
+		i += n41
 	}
-	if len(m.OldFiles) > 0 {
-		for _, e := range m.OldFiles {
-			l = e.Size()
-			n += 1 + l + sovPfs(uint64(l))
-		}
+	if len(m.Branch) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Branch)))
+		i += copy(dAtA[i:], m.Branch)
 	}
-	return n
+	if m.Number != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Number))
+	}
+	return i, nil
 }
 
-func (m *DeleteFileRequest) Size() (n int) {
-	var l int
-	_ = l
-	if m.File != nil {
-		l = m.File.Size()
-		n += 1 + l + sovPfs(uint64(l))
+func (m *SetBranchProtectionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *PutObjectRequest) Size() (n int) {
+func (m *SetBranchProtectionRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovPfs(uint64(l))
+	if m.Repo != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Repo.Size()))
+		n42, err := m.Repo.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n42
 	}
-	if len(m.Tags) > 0 {
-		for _, e := range m.Tags {
-			l = e.Size()
-			n += 1 + l + sovPfs(uint64(l))
+	if m.Protection != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Protection.Size()))
+		n43, err := m.Protection.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
 		}
+		i += n43
 	}
-	return n
+	return i, nil
 }
 
-func (m *GetObjectsRequest) Size() (n int) {
-	var l int
-	_ = l
-	if len(m.Objects) > 0 {
-		for _, e := range m.Objects {
-			l = e.Size()
-			n += 1 + l + sovPfs(uint64(l))
-		}
-	}
-	if m.OffsetBytes != 0 {
-		n += 1 + sovPfs(uint64(m.OffsetBytes))
-	}
-	if m.SizeBytes != 0 {
-		n += 1 + sovPfs(uint64(m.SizeBytes))
-	}
-	if m.TotalSize != 0 {
-		n += 1 + sovPfs(uint64(m.TotalSize))
+func (m *DeleteBranchProtectionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *TagObjectRequest) Size() (n int) {
+func (m *DeleteBranchProtectionRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Object != nil {
-		l = m.Object.Size()
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	if len(m.Tags) > 0 {
-		for _, e := range m.Tags {
-			l = e.Size()
-			n += 1 + l + sovPfs(uint64(l))
+	if m.Repo != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Repo.Size()))
+		n44, err := m.Repo.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
 		}
+		i += n44
 	}
-	return n
+	if len(m.Branch) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Branch)))
+		i += copy(dAtA[i:], m.Branch)
+	}
+	return i, nil
 }
 
-func (m *ListObjectsRequest) Size() (n int) {
-	var l int
-	_ = l
-	return n
+func (m *SetRetentionPolicyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
 
-func (m *ListTagsRequest) Size() (n int) {
+func (m *SetRetentionPolicyRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Prefix)
-	if l > 0 {
-		n += 1 + l + sovPfs(uint64(l))
+	if m.Repo != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Repo.Size()))
+		n45, err := m.Repo.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n45
 	}
-	if m.IncludeObject {
-		n += 2
+	if m.Policy != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Policy.Size()))
+		n46, err := m.Policy.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n46
 	}
-	return n
+	return i, nil
 }
 
-func (m *ListTagsResponse) Size() (n int) {
+func (m *ApplyRetentionPolicyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplyRetentionPolicyRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Tag)
-	if l > 0 {
-		n += 1 + l + sovPfs(uint64(l))
+	if m.Repo != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Repo.Size()))
+		n47, err := m.Repo.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n47
 	}
-	if m.Object != nil {
-		l = m.Object.Size()
-		n += 1 + l + sovPfs(uint64(l))
+	if m.DryRun {
+		dAtA[i] = 0x10
+		i++
+		if m.DryRun {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
 	}
-	return n
+	return i, nil
 }
 
-func (m *DeleteObjectsRequest) Size() (n int) {
+func (m *ApplyRetentionPolicyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplyRetentionPolicyResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if len(m.Objects) > 0 {
-		for _, e := range m.Objects {
-			l = e.Size()
-			n += 1 + l + sovPfs(uint64(l))
+	if len(m.Deleted) > 0 {
+		for _, msg := range m.Deleted {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintPfs(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
 		}
 	}
-	return n
+	return i, nil
 }
 
-func (m *DeleteObjectsResponse) Size() (n int) {
-	var l int
-	_ = l
-	return n
+func (m *SetQuotaRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
 
-func (m *DeleteTagsRequest) Size() (n int) {
+func (m *SetQuotaRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if len(m.Tags) > 0 {
-		for _, s := range m.Tags {
-			l = len(s)
-			n += 1 + l + sovPfs(uint64(l))
+	if m.Repo != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Repo.Size()))
+		n48, err := m.Repo.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
 		}
+		i += n48
 	}
-	return n
+	if len(m.Principal) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Principal)))
+		i += copy(dAtA[i:], m.Principal)
+	}
+	if m.Quota != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Quota.Size()))
+		n49, err := m.Quota.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n49
+	}
+	return i, nil
 }
 
-func (m *DeleteTagsResponse) Size() (n int) {
-	var l int
-	_ = l
-	return n
+func (m *CheckQuotaRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
 
-func (m *CheckObjectRequest) Size() (n int) {
+func (m *CheckQuotaRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Object != nil {
-		l = m.Object.Size()
-		n += 1 + l + sovPfs(uint64(l))
+	if m.Repo != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Repo.Size()))
+		n50, err := m.Repo.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n50
 	}
-	return n
+	if m.AdditionalBytes != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.AdditionalBytes))
+	}
+	if m.AdditionalCommits != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.AdditionalCommits))
+	}
+	return i, nil
 }
 
-func (m *CheckObjectResponse) Size() (n int) {
+func (m *QuotaUsageRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuotaUsageRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Exists {
-		n += 2
+	return i, nil
+}
+
+func (m *Usage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *Objects) Size() (n int) {
+func (m *Usage) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if len(m.Objects) > 0 {
-		for _, e := range m.Objects {
-			l = e.Size()
-			n += 1 + l + sovPfs(uint64(l))
+	if m.LogicalBytes != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.LogicalBytes))
+	}
+	if m.DeduplicatedBytes != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.DeduplicatedBytes))
+	}
+	if m.Commits != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Commits))
+	}
+	if m.Quota != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Quota.Size()))
+		n51, err := m.Quota.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
 		}
+		i += n51
 	}
-	return n
+	return i, nil
 }
 
-func (m *ObjectIndex) Size() (n int) {
+func (m *RepoUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RepoUsage) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if len(m.Objects) > 0 {
-		for k, v := range m.Objects {
-			_ = k
-			_ = v
-			l = 0
-			if v != nil {
-				l = v.Size()
-				l += 1 + sovPfs(uint64(l))
+	if m.Repo != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Repo.Size()))
+		n52, err := m.Repo.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n52
+	}
+	if len(m.CreatedBy) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.CreatedBy)))
+		i += copy(dAtA[i:], m.CreatedBy)
+	}
+	if m.Usage != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Usage.Size()))
+		n53, err := m.Usage.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n53
+	}
+	return i, nil
+}
+
+func (m *PrincipalUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PrincipalUsage) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Principal) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Principal)))
+		i += copy(dAtA[i:], m.Principal)
+	}
+	if m.Usage != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Usage.Size()))
+		n54, err := m.Usage.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n54
+	}
+	return i, nil
+}
+
+func (m *QuotaUsageResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuotaUsageResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Repos) > 0 {
+		for _, msg := range m.Repos {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintPfs(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.Principals) > 0 {
+		for _, msg := range m.Principals {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintPfs(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *DeleteCommitRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteCommitRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Commit != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Commit.Size()))
+		n55, err := m.Commit.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n55
+	}
+	return i, nil
+}
+
+func (m *FlushCommitRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FlushCommitRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Commits) > 0 {
+		for _, msg := range m.Commits {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintPfs(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.ToRepos) > 0 {
+		for _, msg := range m.ToRepos {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintPfs(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *SubscribeCommitRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SubscribeCommitRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Repo != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Repo.Size()))
+		n56, err := m.Repo.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n56
+	}
+	if len(m.Branch) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Branch)))
+		i += copy(dAtA[i:], m.Branch)
+	}
+	if m.From != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.From.Size()))
+		n57, err := m.From.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n57
+	}
+	return i, nil
+}
+
+func (m *GetFileRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetFileRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.File != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.File.Size()))
+		n58, err := m.File.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n58
+	}
+	if m.OffsetBytes != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.OffsetBytes))
+	}
+	if m.SizeBytes != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.SizeBytes))
+	}
+	return i, nil
+}
+
+func (m *GetArchiveRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetArchiveRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.File != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.File.Size()))
+		n59, err := m.File.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n59
+	}
+	if m.Format != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Format))
+	}
+	return i, nil
+}
+
+func (m *OverwriteIndex) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OverwriteIndex) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Index != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Index))
+	}
+	return i, nil
+}
+
+func (m *PutFileRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PutFileRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.File != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.File.Size()))
+		n60, err := m.File.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n60
+	}
+	if len(m.Value) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Value)))
+		i += copy(dAtA[i:], m.Value)
+	}
+	if len(m.Url) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Url)))
+		i += copy(dAtA[i:], m.Url)
+	}
+	if m.Recursive {
+		dAtA[i] = 0x30
+		i++
+		if m.Recursive {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.Delimiter != 0 {
+		dAtA[i] = 0x38
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Delimiter))
+	}
+	if m.TargetFileDatums != 0 {
+		dAtA[i] = 0x40
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.TargetFileDatums))
+	}
+	if m.TargetFileBytes != 0 {
+		dAtA[i] = 0x48
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.TargetFileBytes))
+	}
+	if m.OverwriteIndex != nil {
+		dAtA[i] = 0x52
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.OverwriteIndex.Size()))
+		n61, err := m.OverwriteIndex.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n61
+	}
+	return i, nil
+}
+
+func (m *PutFileRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PutFileRecord) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.SizeBytes != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.SizeBytes))
+	}
+	if len(m.ObjectHash) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.ObjectHash)))
+		i += copy(dAtA[i:], m.ObjectHash)
+	}
+	if m.OverwriteIndex != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.OverwriteIndex.Size()))
+		n62, err := m.OverwriteIndex.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n62
+	}
+	return i, nil
+}
+
+func (m *PutFileRecords) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PutFileRecords) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Split {
+		dAtA[i] = 0x8
+		i++
+		if m.Split {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if len(m.Records) > 0 {
+		for _, msg := range m.Records {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintPfs(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *Transaction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Transaction) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.ID) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.ID)))
+		i += copy(dAtA[i:], m.ID)
+	}
+	return i, nil
+}
+
+func (m *TransactionInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TransactionInfo) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Transaction != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Transaction.Size()))
+		n63, err := m.Transaction.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n63
+	}
+	if m.Started != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Started.Size()))
+		n64, err := m.Started.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n64
+	}
+	if len(m.Requests) > 0 {
+		for _, msg := range m.Requests {
+			dAtA[i] = 0x1a
+			i++
+			i = encodeVarintPfs(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *TransactionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TransactionRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.StartCommit != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.StartCommit.Size()))
+		n65, err := m.StartCommit.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n65
+	}
+	if m.Commit != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Commit.Size()))
+		n66, err := m.Commit.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n66
+	}
+	if m.FinishCommit != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.FinishCommit.Size()))
+		n67, err := m.FinishCommit.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n67
+	}
+	if m.PutFile != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.PutFile.Size()))
+		n68, err := m.PutFile.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n68
+	}
+	if m.SetBranch != nil {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.SetBranch.Size()))
+		n69, err := m.SetBranch.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n69
+	}
+	return i, nil
+}
+
+func (m *TransactionPutFile) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TransactionPutFile) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.File != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.File.Size()))
+		n70, err := m.File.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n70
+	}
+	if m.Records != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Records.Size()))
+		n71, err := m.Records.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n71
+	}
+	return i, nil
+}
+
+func (m *StartTransactionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StartTransactionRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
+func (m *FinishTransactionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FinishTransactionRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Transaction != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Transaction.Size()))
+		n72, err := m.Transaction.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n72
+	}
+	return i, nil
+}
+
+func (m *DeleteTransactionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteTransactionRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Transaction != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Transaction.Size()))
+		n73, err := m.Transaction.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n73
+	}
+	return i, nil
+}
+
+func (m *CopyFileRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CopyFileRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Src != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Src.Size()))
+		n74, err := m.Src.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n74
+	}
+	if m.Dst != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Dst.Size()))
+		n75, err := m.Dst.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n75
+	}
+	if m.Overwrite {
+		dAtA[i] = 0x18
+		i++
+		if m.Overwrite {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *InspectFileRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InspectFileRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.File != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.File.Size()))
+		n76, err := m.File.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n76
+	}
+	return i, nil
+}
+
+func (m *ListFileRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListFileRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.File != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.File.Size()))
+		n77, err := m.File.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n77
+	}
+	if m.Full {
+		dAtA[i] = 0x10
+		i++
+		if m.Full {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *GlobFileRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GlobFileRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Commit != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Commit.Size()))
+		n78, err := m.Commit.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n78
+	}
+	if len(m.Pattern) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Pattern)))
+		i += copy(dAtA[i:], m.Pattern)
+	}
+	return i, nil
+}
+
+func (m *GrepFileRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GrepFileRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Commit != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Commit.Size()))
+		n79, err := m.Commit.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n79
+	}
+	if len(m.Pattern) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Pattern)))
+		i += copy(dAtA[i:], m.Pattern)
+	}
+	if len(m.Regex) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Regex)))
+		i += copy(dAtA[i:], m.Regex)
+	}
+	if m.MaxMatches != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.MaxMatches))
+	}
+	if m.MaxBytes != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.MaxBytes))
+	}
+	return i, nil
+}
+
+func (m *GrepFileResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GrepFileResult) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.File != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.File.Size()))
+		n80, err := m.File.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n80
+	}
+	if m.LineNumber != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.LineNumber))
+	}
+	if len(m.Line) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Line)))
+		i += copy(dAtA[i:], m.Line)
+	}
+	return i, nil
+}
+
+func (m *FileInfos) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FileInfos) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.FileInfo) > 0 {
+		for _, msg := range m.FileInfo {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintPfs(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *DiffFileRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DiffFileRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.NewFile != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.NewFile.Size()))
+		n81, err := m.NewFile.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n81
+	}
+	if m.OldFile != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.OldFile.Size()))
+		n82, err := m.OldFile.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n82
+	}
+	if m.Shallow {
+		dAtA[i] = 0x18
+		i++
+		if m.Shallow {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *DiffFileResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DiffFileResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewFiles) > 0 {
+		for _, msg := range m.NewFiles {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintPfs(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.OldFiles) > 0 {
+		for _, msg := range m.OldFiles {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintPfs(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *DeleteFileRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteFileRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.File != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.File.Size()))
+		n83, err := m.File.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n83
+	}
+	return i, nil
+}
+
+func (m *PutObjectRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PutObjectRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Value) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Value)))
+		i += copy(dAtA[i:], m.Value)
+	}
+	if len(m.Tags) > 0 {
+		for _, msg := range m.Tags {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintPfs(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *GetObjectsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetObjectsRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Objects) > 0 {
+		for _, msg := range m.Objects {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintPfs(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.OffsetBytes != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.OffsetBytes))
+	}
+	if m.SizeBytes != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.SizeBytes))
+	}
+	if m.TotalSize != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.TotalSize))
+	}
+	return i, nil
+}
+
+func (m *TagObjectRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TagObjectRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Object != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Object.Size()))
+		n84, err := m.Object.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n84
+	}
+	if len(m.Tags) > 0 {
+		for _, msg := range m.Tags {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintPfs(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *ListObjectsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListObjectsRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
+func (m *ListTagsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListTagsRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Prefix) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Prefix)))
+		i += copy(dAtA[i:], m.Prefix)
+	}
+	if m.IncludeObject {
+		dAtA[i] = 0x10
+		i++
+		if m.IncludeObject {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *ListTagsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListTagsResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Tag) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Tag)))
+		i += copy(dAtA[i:], m.Tag)
+	}
+	if m.Object != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Object.Size()))
+		n85, err := m.Object.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n85
+	}
+	return i, nil
+}
+
+func (m *DeleteObjectsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteObjectsRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Objects) > 0 {
+		for _, msg := range m.Objects {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintPfs(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *DeleteObjectsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteObjectsResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
+func (m *DeleteTagsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteTagsRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Tags) > 0 {
+		for _, s := range m.Tags {
+			dAtA[i] = 0xa
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	return i, nil
+}
+
+func (m *DeleteTagsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteTagsResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
+func (m *CheckObjectRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CheckObjectRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Object != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Object.Size()))
+		n86, err := m.Object.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n86
+	}
+	return i, nil
+}
+
+func (m *CheckObjectResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CheckObjectResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Exists {
+		dAtA[i] = 0x8
+		i++
+		if m.Exists {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *Objects) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Objects) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Objects) > 0 {
+		for _, msg := range m.Objects {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintPfs(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *ObjectIndex) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ObjectIndex) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Objects) > 0 {
+		for k, _ := range m.Objects {
+			dAtA[i] = 0xa
+			i++
+			v := m.Objects[k]
+			msgSize := 0
+			if v != nil {
+				msgSize = v.Size()
+				msgSize += 1 + sovPfs(uint64(msgSize))
+			}
+			mapSize := 1 + len(k) + sovPfs(uint64(len(k))) + msgSize
+			i = encodeVarintPfs(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintPfs(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			if v != nil {
+				dAtA[i] = 0x12
+				i++
+				i = encodeVarintPfs(dAtA, i, uint64(v.Size()))
+				n87, err := v.MarshalTo(dAtA[i:])
+				if err != nil {
+					return 0, err
+				}
+				i += n87
+			}
+		}
+	}
+	if len(m.Tags) > 0 {
+		for k, _ := range m.Tags {
+			dAtA[i] = 0x12
+			i++
+			v := m.Tags[k]
+			msgSize := 0
+			if v != nil {
+				msgSize = v.Size()
+				msgSize += 1 + sovPfs(uint64(msgSize))
+			}
+			mapSize := 1 + len(k) + sovPfs(uint64(len(k))) + msgSize
+			i = encodeVarintPfs(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintPfs(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			if v != nil {
+				dAtA[i] = 0x12
+				i++
+				i = encodeVarintPfs(dAtA, i, uint64(v.Size()))
+				n88, err := v.MarshalTo(dAtA[i:])
+				if err != nil {
+					return 0, err
+				}
+				i += n88
+			}
+		}
+	}
+	return i, nil
+}
+
+func encodeFixed64Pfs(dAtA []byte, offset int, v uint64) int {
+	dAtA[offset] = uint8(v)
+	dAtA[offset+1] = uint8(v >> 8)
+	dAtA[offset+2] = uint8(v >> 16)
+	dAtA[offset+3] = uint8(v >> 24)
+	dAtA[offset+4] = uint8(v >> 32)
+	dAtA[offset+5] = uint8(v >> 40)
+	dAtA[offset+6] = uint8(v >> 48)
+	dAtA[offset+7] = uint8(v >> 56)
+	return offset + 8
+}
+func encodeFixed32Pfs(dAtA []byte, offset int, v uint32) int {
+	dAtA[offset] = uint8(v)
+	dAtA[offset+1] = uint8(v >> 8)
+	dAtA[offset+2] = uint8(v >> 16)
+	dAtA[offset+3] = uint8(v >> 24)
+	return offset + 4
+}
+func encodeVarintPfs(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func (m *Repo) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	return n
+}
+
+func (m *BranchInfo) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Head != nil {
+		l = m.Head.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	return n
+}
+
+func (m *BranchInfos) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.BranchInfo) > 0 {
+		for _, e := range m.BranchInfo {
+			l = e.Size()
+			n += 1 + l + sovPfs(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BranchMove) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Branch)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.OldHead != nil {
+		l = m.OldHead.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.NewHead != nil {
+		l = m.NewHead.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Time != nil {
+		l = m.Time.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	l = len(m.Principal)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	l = len(m.Operation)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	return n
+}
+
+func (m *BranchHistory) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Moves) > 0 {
+		for _, e := range m.Moves {
+			l = e.Size()
+			n += 1 + l + sovPfs(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *File) Size() (n int) {
+	var l int
+	_ = l
+	if m.Commit != nil {
+		l = m.Commit.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	return n
+}
+
+func (m *Block) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	return n
+}
+
+func (m *Object) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	return n
+}
+
+func (m *Tag) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	return n
+}
+
+func (m *RepoInfo) Size() (n int) {
+	var l int
+	_ = l
+	if m.Repo != nil {
+		l = m.Repo.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Created != nil {
+		l = m.Created.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.SizeBytes != 0 {
+		n += 1 + sovPfs(uint64(m.SizeBytes))
+	}
+	if len(m.Provenance) > 0 {
+		for _, e := range m.Provenance {
+			l = e.Size()
+			n += 1 + l + sovPfs(uint64(l))
+		}
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.AuthInfo != nil {
+		l = m.AuthInfo.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if len(m.BranchProtections) > 0 {
+		for _, e := range m.BranchProtections {
+			l = e.Size()
+			n += 1 + l + sovPfs(uint64(l))
+		}
+	}
+	if m.RetentionPolicy != nil {
+		l = m.RetentionPolicy.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Quota != nil {
+		l = m.Quota.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	l = len(m.CreatedBy)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	return n
+}
+
+func (m *BranchProtection) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Branch)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if len(m.AllowedPrincipals) > 0 {
+		for _, s := range m.AllowedPrincipals {
+			l = len(s)
+			n += 1 + l + sovPfs(uint64(l))
+		}
+	}
+	if m.FastForwardOnly {
+		n += 2
+	}
+	l = len(m.RequiredPipeline)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	return n
+}
+
+func (m *RetentionPolicy) Size() (n int) {
+	var l int
+	_ = l
+	if m.KeepLast != 0 {
+		n += 1 + sovPfs(uint64(m.KeepLast))
+	}
+	if m.KeepNewerThan != nil {
+		l = m.KeepNewerThan.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.KeepDaily != 0 {
+		n += 1 + sovPfs(uint64(m.KeepDaily))
+	}
+	if m.KeepWeekly != 0 {
+		n += 1 + sovPfs(uint64(m.KeepWeekly))
+	}
+	return n
+}
+
+func (m *Quota) Size() (n int) {
+	var l int
+	_ = l
+	if m.MaxBytes != 0 {
+		n += 1 + sovPfs(uint64(m.MaxBytes))
+	}
+	if m.MaxCommits != 0 {
+		n += 1 + sovPfs(uint64(m.MaxCommits))
+	}
+	return n
+}
+
+func (m *RepoAuthInfo) Size() (n int) {
+	var l int
+	_ = l
+	if m.AccessLevel != 0 {
+		n += 1 + sovPfs(uint64(m.AccessLevel))
+	}
+	return n
+}
+
+func (m *Commit) Size() (n int) {
+	var l int
+	_ = l
+	if m.Repo != nil {
+		l = m.Repo.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	return n
+}
+
+func (m *CommitInfo) Size() (n int) {
+	var l int
+	_ = l
+	if m.Commit != nil {
+		l = m.Commit.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.ParentCommit != nil {
+		l = m.ParentCommit.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Started != nil {
+		l = m.Started.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Finished != nil {
+		l = m.Finished.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.SizeBytes != 0 {
+		n += 1 + sovPfs(uint64(m.SizeBytes))
+	}
+	if len(m.Provenance) > 0 {
+		for _, e := range m.Provenance {
+			l = e.Size()
+			n += 1 + l + sovPfs(uint64(l))
+		}
+	}
+	if m.Tree != nil {
+		l = m.Tree.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	return n
+}
+
+func (m *FileInfo) Size() (n int) {
+	var l int
+	_ = l
+	if m.File != nil {
+		l = m.File.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.FileType != 0 {
+		n += 1 + sovPfs(uint64(m.FileType))
+	}
+	if m.SizeBytes != 0 {
+		n += 1 + sovPfs(uint64(m.SizeBytes))
+	}
+	if len(m.Children) > 0 {
+		for _, s := range m.Children {
+			l = len(s)
+			n += 1 + l + sovPfs(uint64(l))
+		}
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if len(m.Objects) > 0 {
+		for _, e := range m.Objects {
+			l = e.Size()
+			n += 1 + l + sovPfs(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ByteRange) Size() (n int) {
+	var l int
+	_ = l
+	if m.Lower != 0 {
+		n += 1 + sovPfs(uint64(m.Lower))
+	}
+	if m.Upper != 0 {
+		n += 1 + sovPfs(uint64(m.Upper))
+	}
+	return n
+}
+
+func (m *BlockRef) Size() (n int) {
+	var l int
+	_ = l
+	if m.Block != nil {
+		l = m.Block.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Range != nil {
+		l = m.Range.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	return n
+}
+
+func (m *ObjectInfo) Size() (n int) {
+	var l int
+	_ = l
+	if m.Object != nil {
+		l = m.Object.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.BlockRef != nil {
+		l = m.BlockRef.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	return n
+}
+
+func (m *CreateRepoRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Repo != nil {
+		l = m.Repo.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if len(m.Provenance) > 0 {
+		for _, e := range m.Provenance {
+			l = e.Size()
+			n += 1 + l + sovPfs(uint64(l))
+		}
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Update {
+		n += 2
+	}
+	return n
+}
+
+func (m *InspectRepoRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Repo != nil {
+		l = m.Repo.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	return n
+}
+
+func (m *ListRepoRequest) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Provenance) > 0 {
+		for _, e := range m.Provenance {
+			l = e.Size()
+			n += 1 + l + sovPfs(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ListRepoResponse) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.RepoInfo) > 0 {
+		for _, e := range m.RepoInfo {
+			l = e.Size()
+			n += 1 + l + sovPfs(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DeleteRepoRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Repo != nil {
+		l = m.Repo.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Force {
+		n += 2
+	}
+	if m.All {
+		n += 2
+	}
+	return n
+}
+
+func (m *ForkRepoRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Repo != nil {
+		l = m.Repo.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Fork != nil {
+		l = m.Fork.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	return n
+}
+
+func (m *RenameRepoRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Repo != nil {
+		l = m.Repo.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.NewRepo != nil {
+		l = m.NewRepo.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	return n
+}
+
+func (m *StartCommitRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Parent != nil {
+		l = m.Parent.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if len(m.Provenance) > 0 {
+		for _, e := range m.Provenance {
+			l = e.Size()
+			n += 1 + l + sovPfs(uint64(l))
+		}
+	}
+	l = len(m.Branch)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	return n
+}
+
+func (m *BuildCommitRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Parent != nil {
+		l = m.Parent.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if len(m.Provenance) > 0 {
+		for _, e := range m.Provenance {
+			l = e.Size()
+			n += 1 + l + sovPfs(uint64(l))
+		}
+	}
+	if m.Tree != nil {
+		l = m.Tree.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	l = len(m.Branch)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	return n
+}
+
+func (m *FinishCommitRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Commit != nil {
+		l = m.Commit.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	return n
+}
+
+func (m *InspectCommitRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Commit != nil {
+		l = m.Commit.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	return n
+}
+
+func (m *ListCommitRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Repo != nil {
+		l = m.Repo.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.From != nil {
+		l = m.From.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.To != nil {
+		l = m.To.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Number != 0 {
+		n += 1 + sovPfs(uint64(m.Number))
+	}
+	return n
+}
+
+func (m *CommitInfos) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.CommitInfo) > 0 {
+		for _, e := range m.CommitInfo {
+			l = e.Size()
+			n += 1 + l + sovPfs(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ListBranchRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Repo != nil {
+		l = m.Repo.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	return n
+}
+
+func (m *SetBranchRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Commit != nil {
+		l = m.Commit.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	l = len(m.Branch)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	return n
+}
+
+func (m *DeleteBranchRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Repo != nil {
+		l = m.Repo.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	l = len(m.Branch)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	return n
+}
+
+func (m *ListBranchHistoryRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Repo != nil {
+		l = m.Repo.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	l = len(m.Branch)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Number != 0 {
+		n += 1 + sovPfs(uint64(m.Number))
+	}
+	return n
+}
+
+func (m *SetBranchProtectionRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Repo != nil {
+		l = m.Repo.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Protection != nil {
+		l = m.Protection.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	return n
+}
+
+func (m *DeleteBranchProtectionRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Repo != nil {
+		l = m.Repo.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	l = len(m.Branch)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	return n
+}
+
+func (m *SetRetentionPolicyRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Repo != nil {
+		l = m.Repo.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Policy != nil {
+		l = m.Policy.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	return n
+}
+
+func (m *ApplyRetentionPolicyRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Repo != nil {
+		l = m.Repo.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.DryRun {
+		n += 2
+	}
+	return n
+}
+
+func (m *ApplyRetentionPolicyResponse) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Deleted) > 0 {
+		for _, e := range m.Deleted {
+			l = e.Size()
+			n += 1 + l + sovPfs(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SetQuotaRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Repo != nil {
+		l = m.Repo.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	l = len(m.Principal)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Quota != nil {
+		l = m.Quota.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	return n
+}
+
+func (m *CheckQuotaRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Repo != nil {
+		l = m.Repo.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.AdditionalBytes != 0 {
+		n += 1 + sovPfs(uint64(m.AdditionalBytes))
+	}
+	if m.AdditionalCommits != 0 {
+		n += 1 + sovPfs(uint64(m.AdditionalCommits))
+	}
+	return n
+}
+
+func (m *QuotaUsageRequest) Size() (n int) {
+	var l int
+	_ = l
+	return n
+}
+
+func (m *Usage) Size() (n int) {
+	var l int
+	_ = l
+	if m.LogicalBytes != 0 {
+		n += 1 + sovPfs(uint64(m.LogicalBytes))
+	}
+	if m.DeduplicatedBytes != 0 {
+		n += 1 + sovPfs(uint64(m.DeduplicatedBytes))
+	}
+	if m.Commits != 0 {
+		n += 1 + sovPfs(uint64(m.Commits))
+	}
+	if m.Quota != nil {
+		l = m.Quota.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	return n
+}
+
+func (m *RepoUsage) Size() (n int) {
+	var l int
+	_ = l
+	if m.Repo != nil {
+		l = m.Repo.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	l = len(m.CreatedBy)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Usage != nil {
+		l = m.Usage.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	return n
+}
+
+func (m *PrincipalUsage) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Principal)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Usage != nil {
+		l = m.Usage.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	return n
+}
+
+func (m *QuotaUsageResponse) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Repos) > 0 {
+		for _, e := range m.Repos {
+			l = e.Size()
+			n += 1 + l + sovPfs(uint64(l))
+		}
+	}
+	if len(m.Principals) > 0 {
+		for _, e := range m.Principals {
+			l = e.Size()
+			n += 1 + l + sovPfs(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DeleteCommitRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Commit != nil {
+		l = m.Commit.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	return n
+}
+
+func (m *FlushCommitRequest) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Commits) > 0 {
+		for _, e := range m.Commits {
+			l = e.Size()
+			n += 1 + l + sovPfs(uint64(l))
+		}
+	}
+	if len(m.ToRepos) > 0 {
+		for _, e := range m.ToRepos {
+			l = e.Size()
+			n += 1 + l + sovPfs(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SubscribeCommitRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Repo != nil {
+		l = m.Repo.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	l = len(m.Branch)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.From != nil {
+		l = m.From.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	return n
+}
+
+func (m *GetFileRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.File != nil {
+		l = m.File.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.OffsetBytes != 0 {
+		n += 1 + sovPfs(uint64(m.OffsetBytes))
+	}
+	if m.SizeBytes != 0 {
+		n += 1 + sovPfs(uint64(m.SizeBytes))
+	}
+	return n
+}
+
+func (m *GetArchiveRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.File != nil {
+		l = m.File.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Format != 0 {
+		n += 1 + sovPfs(uint64(m.Format))
+	}
+	return n
+}
+
+func (m *OverwriteIndex) Size() (n int) {
+	var l int
+	_ = l
+	if m.Index != 0 {
+		n += 1 + sovPfs(uint64(m.Index))
+	}
+	return n
+}
+
+func (m *PutFileRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.File != nil {
+		l = m.File.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	l = len(m.Url)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Recursive {
+		n += 2
+	}
+	if m.Delimiter != 0 {
+		n += 1 + sovPfs(uint64(m.Delimiter))
+	}
+	if m.TargetFileDatums != 0 {
+		n += 1 + sovPfs(uint64(m.TargetFileDatums))
+	}
+	if m.TargetFileBytes != 0 {
+		n += 1 + sovPfs(uint64(m.TargetFileBytes))
+	}
+	if m.OverwriteIndex != nil {
+		l = m.OverwriteIndex.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	return n
+}
+
+func (m *PutFileRecord) Size() (n int) {
+	var l int
+	_ = l
+	if m.SizeBytes != 0 {
+		n += 1 + sovPfs(uint64(m.SizeBytes))
+	}
+	l = len(m.ObjectHash)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.OverwriteIndex != nil {
+		l = m.OverwriteIndex.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	return n
+}
+
+func (m *PutFileRecords) Size() (n int) {
+	var l int
+	_ = l
+	if m.Split {
+		n += 2
+	}
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovPfs(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Transaction) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	return n
+}
+
+func (m *TransactionInfo) Size() (n int) {
+	var l int
+	_ = l
+	if m.Transaction != nil {
+		l = m.Transaction.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Started != nil {
+		l = m.Started.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if len(m.Requests) > 0 {
+		for _, e := range m.Requests {
+			l = e.Size()
+			n += 1 + l + sovPfs(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TransactionRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.StartCommit != nil {
+		l = m.StartCommit.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Commit != nil {
+		l = m.Commit.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.FinishCommit != nil {
+		l = m.FinishCommit.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.PutFile != nil {
+		l = m.PutFile.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.SetBranch != nil {
+		l = m.SetBranch.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	return n
+}
+
+func (m *TransactionPutFile) Size() (n int) {
+	var l int
+	_ = l
+	if m.File != nil {
+		l = m.File.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Records != nil {
+		l = m.Records.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	return n
+}
+
+func (m *StartTransactionRequest) Size() (n int) {
+	var l int
+	_ = l
+	return n
+}
+
+func (m *FinishTransactionRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Transaction != nil {
+		l = m.Transaction.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	return n
+}
+
+func (m *DeleteTransactionRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Transaction != nil {
+		l = m.Transaction.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	return n
+}
+
+func (m *CopyFileRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Src != nil {
+		l = m.Src.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Dst != nil {
+		l = m.Dst.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Overwrite {
+		n += 2
+	}
+	return n
+}
+
+func (m *InspectFileRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.File != nil {
+		l = m.File.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	return n
+}
+
+func (m *ListFileRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.File != nil {
+		l = m.File.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Full {
+		n += 2
+	}
+	return n
+}
+
+func (m *GlobFileRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Commit != nil {
+		l = m.Commit.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	l = len(m.Pattern)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	return n
+}
+
+func (m *GrepFileRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Commit != nil {
+		l = m.Commit.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	l = len(m.Pattern)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	l = len(m.Regex)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.MaxMatches != 0 {
+		n += 1 + sovPfs(uint64(m.MaxMatches))
+	}
+	if m.MaxBytes != 0 {
+		n += 1 + sovPfs(uint64(m.MaxBytes))
+	}
+	return n
+}
+
+func (m *GrepFileResult) Size() (n int) {
+	var l int
+	_ = l
+	if m.File != nil {
+		l = m.File.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.LineNumber != 0 {
+		n += 1 + sovPfs(uint64(m.LineNumber))
+	}
+	l = len(m.Line)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	return n
+}
+
+func (m *FileInfos) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.FileInfo) > 0 {
+		for _, e := range m.FileInfo {
+			l = e.Size()
+			n += 1 + l + sovPfs(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DiffFileRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.NewFile != nil {
+		l = m.NewFile.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.OldFile != nil {
+		l = m.OldFile.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Shallow {
+		n += 2
+	}
+	return n
+}
+
+func (m *DiffFileResponse) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.NewFiles) > 0 {
+		for _, e := range m.NewFiles {
+			l = e.Size()
+			n += 1 + l + sovPfs(uint64(l))
+		}
+	}
+	if len(m.OldFiles) > 0 {
+		for _, e := range m.OldFiles {
+			l = e.Size()
+			n += 1 + l + sovPfs(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DeleteFileRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.File != nil {
+		l = m.File.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	return n
+}
+
+func (m *PutObjectRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if len(m.Tags) > 0 {
+		for _, e := range m.Tags {
+			l = e.Size()
+			n += 1 + l + sovPfs(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GetObjectsRequest) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Objects) > 0 {
+		for _, e := range m.Objects {
+			l = e.Size()
+			n += 1 + l + sovPfs(uint64(l))
+		}
+	}
+	if m.OffsetBytes != 0 {
+		n += 1 + sovPfs(uint64(m.OffsetBytes))
+	}
+	if m.SizeBytes != 0 {
+		n += 1 + sovPfs(uint64(m.SizeBytes))
+	}
+	if m.TotalSize != 0 {
+		n += 1 + sovPfs(uint64(m.TotalSize))
+	}
+	return n
+}
+
+func (m *TagObjectRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Object != nil {
+		l = m.Object.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if len(m.Tags) > 0 {
+		for _, e := range m.Tags {
+			l = e.Size()
+			n += 1 + l + sovPfs(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ListObjectsRequest) Size() (n int) {
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ListTagsRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Prefix)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.IncludeObject {
+		n += 2
+	}
+	return n
+}
+
+func (m *ListTagsResponse) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Tag)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Object != nil {
+		l = m.Object.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	return n
+}
+
+func (m *DeleteObjectsRequest) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Objects) > 0 {
+		for _, e := range m.Objects {
+			l = e.Size()
+			n += 1 + l + sovPfs(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DeleteObjectsResponse) Size() (n int) {
+	var l int
+	_ = l
+	return n
+}
+
+func (m *DeleteTagsRequest) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Tags) > 0 {
+		for _, s := range m.Tags {
+			l = len(s)
+			n += 1 + l + sovPfs(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DeleteTagsResponse) Size() (n int) {
+	var l int
+	_ = l
+	return n
+}
+
+func (m *CheckObjectRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Object != nil {
+		l = m.Object.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	return n
+}
+
+func (m *CheckObjectResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Exists {
+		n += 2
+	}
+	return n
+}
+
+func (m *Objects) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Objects) > 0 {
+		for _, e := range m.Objects {
+			l = e.Size()
+			n += 1 + l + sovPfs(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ObjectIndex) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Objects) > 0 {
+		for k, v := range m.Objects {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovPfs(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovPfs(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovPfs(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Tags) > 0 {
+		for k, v := range m.Tags {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovPfs(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovPfs(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovPfs(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func sovPfs(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozPfs(x uint64) (n int) {
+	return sovPfs(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Repo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Repo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Repo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BranchInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BranchInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BranchInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Head", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Head == nil {
+				m.Head = &Commit{}
+			}
+			if err := m.Head.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BranchInfos) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BranchInfos: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BranchInfos: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BranchInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BranchInfo = append(m.BranchInfo, &BranchInfo{})
+			if err := m.BranchInfo[len(m.BranchInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BranchMove) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BranchMove: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BranchMove: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Branch = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldHead", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OldHead == nil {
+				m.OldHead = &Commit{}
+			}
+			if err := m.OldHead.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewHead", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NewHead == nil {
+				m.NewHead = &Commit{}
+			}
+			if err := m.NewHead.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Time == nil {
+				m.Time = &google_protobuf2.Timestamp{}
+			}
+			if err := m.Time.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Principal", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Principal = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operation = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BranchHistory) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BranchHistory: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BranchHistory: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Moves", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Moves = append(m.Moves, &BranchMove{})
+			if err := m.Moves[len(m.Moves)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *File) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: File: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: File: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Commit == nil {
+				m.Commit = &Commit{}
+			}
+			if err := m.Commit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Block) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Block: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Block: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Object) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Object: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Object: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Tag) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Tag: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Tag: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RepoInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RepoInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RepoInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Repo == nil {
+				m.Repo = &Repo{}
+			}
+			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Created == nil {
+				m.Created = &google_protobuf2.Timestamp{}
+			}
+			if err := m.Created.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SizeBytes", wireType)
+			}
+			m.SizeBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SizeBytes |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provenance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provenance = append(m.Provenance, &Repo{})
+			if err := m.Provenance[len(m.Provenance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AuthInfo == nil {
+				m.AuthInfo = &RepoAuthInfo{}
+			}
+			if err := m.AuthInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BranchProtections", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BranchProtections = append(m.BranchProtections, &BranchProtection{})
+			if err := m.BranchProtections[len(m.BranchProtections)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetentionPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RetentionPolicy == nil {
+				m.RetentionPolicy = &RetentionPolicy{}
+			}
+			if err := m.RetentionPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quota", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Quota == nil {
+				m.Quota = &Quota{}
+			}
+			if err := m.Quota.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreatedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BranchProtection) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BranchProtection: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BranchProtection: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Branch = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedPrincipals", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedPrincipals = append(m.AllowedPrincipals, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FastForwardOnly", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.FastForwardOnly = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredPipeline", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequiredPipeline = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RetentionPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RetentionPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RetentionPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeepLast", wireType)
+			}
+			m.KeepLast = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.KeepLast |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeepNewerThan", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.KeepNewerThan == nil {
+				m.KeepNewerThan = &google_protobuf.Duration{}
+			}
+			if err := m.KeepNewerThan.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeepDaily", wireType)
+			}
+			m.KeepDaily = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.KeepDaily |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeepWeekly", wireType)
+			}
+			m.KeepWeekly = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.KeepWeekly |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Quota) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Quota: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Quota: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBytes", wireType)
+			}
+			m.MaxBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBytes |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCommits", wireType)
+			}
+			m.MaxCommits = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxCommits |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RepoAuthInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RepoAuthInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RepoAuthInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccessLevel", wireType)
+			}
+			m.AccessLevel = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AccessLevel |= (auth.Scope(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Commit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Commit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Commit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Repo == nil {
+				m.Repo = &Repo{}
+			}
+			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CommitInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommitInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommitInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Commit == nil {
+				m.Commit = &Commit{}
+			}
+			if err := m.Commit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentCommit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ParentCommit == nil {
+				m.ParentCommit = &Commit{}
+			}
+			if err := m.ParentCommit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Started", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Started == nil {
+				m.Started = &google_protobuf2.Timestamp{}
+			}
+			if err := m.Started.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Finished", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Finished == nil {
+				m.Finished = &google_protobuf2.Timestamp{}
+			}
+			if err := m.Finished.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SizeBytes", wireType)
+			}
+			m.SizeBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SizeBytes |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provenance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provenance = append(m.Provenance, &Commit{})
+			if err := m.Provenance[len(m.Provenance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tree", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Tree == nil {
+				m.Tree = &Object{}
+			}
+			if err := m.Tree.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FileInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FileInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FileInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field File", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.File == nil {
+				m.File = &File{}
+			}
+			if err := m.File.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FileType", wireType)
+			}
+			m.FileType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FileType |= (FileType(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SizeBytes", wireType)
+			}
+			m.SizeBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SizeBytes |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Children", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Children = append(m.Children, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
+			if m.Hash == nil {
+				m.Hash = []byte{}
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Objects", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Objects = append(m.Objects, &Object{})
+			if err := m.Objects[len(m.Objects)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ByteRange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ByteRange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ByteRange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lower", wireType)
+			}
+			m.Lower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Lower |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Upper", wireType)
+			}
+			m.Upper = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Upper |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlockRef) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlockRef: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlockRef: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Block == nil {
+				m.Block = &Block{}
+			}
+			if err := m.Block.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Range", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Range == nil {
+				m.Range = &ByteRange{}
+			}
+			if err := m.Range.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ObjectInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ObjectInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ObjectInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Object", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Object == nil {
+				m.Object = &Object{}
+			}
+			if err := m.Object.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockRef", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BlockRef == nil {
+				m.BlockRef = &BlockRef{}
+			}
+			if err := m.BlockRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CreateRepoRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreateRepoRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreateRepoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Repo == nil {
+				m.Repo = &Repo{}
+			}
+			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provenance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provenance = append(m.Provenance, &Repo{})
+			if err := m.Provenance[len(m.Provenance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Update", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Update = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InspectRepoRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InspectRepoRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InspectRepoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Repo == nil {
+				m.Repo = &Repo{}
+			}
+			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListRepoRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListRepoRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListRepoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provenance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provenance = append(m.Provenance, &Repo{})
+			if err := m.Provenance[len(m.Provenance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListRepoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListRepoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListRepoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepoInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RepoInfo = append(m.RepoInfo, &RepoInfo{})
+			if err := m.RepoInfo[len(m.RepoInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeleteRepoRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteRepoRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteRepoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Repo == nil {
+				m.Repo = &Repo{}
+			}
+			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Force", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Force = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field All", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.All = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ForkRepoRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ForkRepoRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ForkRepoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Repo == nil {
+				m.Repo = &Repo{}
+			}
+			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fork", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Fork == nil {
+				m.Fork = &Repo{}
+			}
+			if err := m.Fork.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RenameRepoRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RenameRepoRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RenameRepoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Repo == nil {
+				m.Repo = &Repo{}
+			}
+			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewRepo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NewRepo == nil {
+				m.NewRepo = &Repo{}
+			}
+			if err := m.NewRepo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPfs
 			}
-			mapEntrySize := 1 + len(k) + sovPfs(uint64(len(k))) + l
-			n += mapEntrySize + 1 + sovPfs(uint64(mapEntrySize))
-		}
-	}
-	if len(m.Tags) > 0 {
-		for k, v := range m.Tags {
-			_ = k
-			_ = v
-			l = 0
-			if v != nil {
-				l = v.Size()
-				l += 1 + sovPfs(uint64(l))
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			mapEntrySize := 1 + len(k) + sovPfs(uint64(len(k))) + l
-			n += mapEntrySize + 1 + sovPfs(uint64(mapEntrySize))
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func sovPfs(x uint64) (n int) {
-	for {
-		n++
-		x >>= 7
-		if x == 0 {
-			break
-		}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-func sozPfs(x uint64) (n int) {
-	return sovPfs(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Repo) Unmarshal(dAtA []byte) error {
+func (m *StartCommitRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6696,15 +13469,79 @@ func (m *Repo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Repo: wiretype end group for non-group")
+			return fmt.Errorf("proto: StartCommitRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Repo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StartCommitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Parent", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Parent == nil {
+				m.Parent = &Commit{}
+			}
+			if err := m.Parent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provenance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provenance = append(m.Provenance, &Commit{})
+			if err := m.Provenance[len(m.Provenance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6729,7 +13566,7 @@ func (m *Repo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Branch = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6752,7 +13589,7 @@ func (m *Repo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BranchInfo) Unmarshal(dAtA []byte) error {
+func (m *BuildCommitRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6775,15 +13612,112 @@ func (m *BranchInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BranchInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: BuildCommitRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BranchInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BuildCommitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Parent", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Parent == nil {
+				m.Parent = &Commit{}
+			}
+			if err := m.Parent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provenance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provenance = append(m.Provenance, &Commit{})
+			if err := m.Provenance[len(m.Provenance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tree", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Tree == nil {
+				m.Tree = &Object{}
+			}
+			if err := m.Tree.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6808,11 +13742,61 @@ func (m *BranchInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Branch = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FinishCommitRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FinishCommitRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FinishCommitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Head", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6836,10 +13820,10 @@ func (m *BranchInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Head == nil {
-				m.Head = &Commit{}
+			if m.Commit == nil {
+				m.Commit = &Commit{}
 			}
-			if err := m.Head.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Commit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6864,7 +13848,7 @@ func (m *BranchInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BranchInfos) Unmarshal(dAtA []byte) error {
+func (m *InspectCommitRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6887,15 +13871,15 @@ func (m *BranchInfos) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BranchInfos: wiretype end group for non-group")
+			return fmt.Errorf("proto: InspectCommitRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BranchInfos: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: InspectCommitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BranchInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6919,8 +13903,10 @@ func (m *BranchInfos) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BranchInfo = append(m.BranchInfo, &BranchInfo{})
-			if err := m.BranchInfo[len(m.BranchInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Commit == nil {
+				m.Commit = &Commit{}
+			}
+			if err := m.Commit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6945,7 +13931,7 @@ func (m *BranchInfos) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *File) Unmarshal(dAtA []byte) error {
+func (m *ListCommitRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6968,15 +13954,15 @@ func (m *File) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: File: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListCommitRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: File: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListCommitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7000,18 +13986,18 @@ func (m *File) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Commit == nil {
-				m.Commit = &Commit{}
+			if m.Repo == nil {
+				m.Repo = &Repo{}
 			}
-			if err := m.Commit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -7021,21 +14007,77 @@ func (m *File) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Path = string(dAtA[iNdEx:postIndex])
+			if m.From == nil {
+				m.From = &Commit{}
+			}
+			if err := m.From.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.To == nil {
+				m.To = &Commit{}
+			}
+			if err := m.To.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
+			}
+			m.Number = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Number |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -7057,7 +14099,7 @@ func (m *File) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Block) Unmarshal(dAtA []byte) error {
+func (m *CommitInfos) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7080,17 +14122,17 @@ func (m *Block) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Block: wiretype end group for non-group")
+			return fmt.Errorf("proto: CommitInfos: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Block: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CommitInfos: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitInfo", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -7100,20 +14142,22 @@ func (m *Block) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Hash = string(dAtA[iNdEx:postIndex])
+			m.CommitInfo = append(m.CommitInfo, &CommitInfo{})
+			if err := m.CommitInfo[len(m.CommitInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7136,7 +14180,7 @@ func (m *Block) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Object) Unmarshal(dAtA []byte) error {
+func (m *ListBranchRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7159,17 +14203,17 @@ func (m *Object) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Object: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListBranchRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Object: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListBranchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -7179,20 +14223,24 @@ func (m *Object) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Hash = string(dAtA[iNdEx:postIndex])
+			if m.Repo == nil {
+				m.Repo = &Repo{}
+			}
+			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7215,7 +14263,7 @@ func (m *Object) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Tag) Unmarshal(dAtA []byte) error {
+func (m *SetBranchRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7238,15 +14286,48 @@ func (m *Tag) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Tag: wiretype end group for non-group")
+			return fmt.Errorf("proto: SetBranchRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Tag: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SetBranchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Commit == nil {
+				m.Commit = &Commit{}
+			}
+			if err := m.Commit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7271,7 +14352,7 @@ func (m *Tag) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Branch = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7294,7 +14375,7 @@ func (m *Tag) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RepoInfo) Unmarshal(dAtA []byte) error {
+func (m *DeleteBranchRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7317,10 +14398,10 @@ func (m *RepoInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RepoInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeleteBranchRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RepoInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeleteBranchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7358,9 +14439,9 @@ func (m *RepoInfo) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -7370,47 +14451,74 @@ func (m *RepoInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Created == nil {
-				m.Created = &google_protobuf1.Timestamp{}
-			}
-			if err := m.Created.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Branch = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SizeBytes", wireType)
+			if skippy < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListBranchHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
 			}
-			m.SizeBytes = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SizeBytes |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-		case 4:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListBranchHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListBranchHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Provenance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7434,14 +14542,16 @@ func (m *RepoInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Provenance = append(m.Provenance, &Repo{})
-			if err := m.Provenance[len(m.Provenance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Repo == nil {
+				m.Repo = &Repo{}
+			}
+			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7466,13 +14576,13 @@ func (m *RepoInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.Branch = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AuthInfo", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
 			}
-			var msglen int
+			m.Number = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -7482,25 +14592,11 @@ func (m *RepoInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.Number |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPfs
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.AuthInfo == nil {
-				m.AuthInfo = &RepoAuthInfo{}
-			}
-			if err := m.AuthInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -7522,7 +14618,7 @@ func (m *RepoInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RepoAuthInfo) Unmarshal(dAtA []byte) error {
+func (m *SetBranchProtectionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7545,17 +14641,17 @@ func (m *RepoAuthInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RepoAuthInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: SetBranchProtectionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RepoAuthInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SetBranchProtectionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccessLevel", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
 			}
-			m.AccessLevel = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -7565,11 +14661,58 @@ func (m *RepoAuthInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AccessLevel |= (auth.Scope(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Repo == nil {
+				m.Repo = &Repo{}
+			}
+			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Protection", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Protection == nil {
+				m.Protection = &BranchProtection{}
+			}
+			if err := m.Protection.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -7591,7 +14734,7 @@ func (m *RepoAuthInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Commit) Unmarshal(dAtA []byte) error {
+func (m *DeleteBranchProtectionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7614,10 +14757,10 @@ func (m *Commit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Commit: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeleteBranchProtectionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Commit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeleteBranchProtectionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7655,7 +14798,7 @@ func (m *Commit) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7680,7 +14823,7 @@ func (m *Commit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ID = string(dAtA[iNdEx:postIndex])
+			m.Branch = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7703,7 +14846,7 @@ func (m *Commit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CommitInfo) Unmarshal(dAtA []byte) error {
+func (m *SetRetentionPolicyRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7726,15 +14869,15 @@ func (m *CommitInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CommitInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: SetRetentionPolicyRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CommitInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SetRetentionPolicyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7758,16 +14901,16 @@ func (m *CommitInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Commit == nil {
-				m.Commit = &Commit{}
+			if m.Repo == nil {
+				m.Repo = &Repo{}
 			}
-			if err := m.Commit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ParentCommit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7791,49 +14934,66 @@ func (m *CommitInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ParentCommit == nil {
-				m.ParentCommit = &Commit{}
+			if m.Policy == nil {
+				m.Policy = &RetentionPolicy{}
 			}
-			if err := m.ParentCommit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Policy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Started", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Started == nil {
-				m.Started = &google_protobuf1.Timestamp{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplyRetentionPolicyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
 			}
-			if err := m.Started.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 4:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplyRetentionPolicyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplyRetentionPolicyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Finished", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7857,18 +15017,18 @@ func (m *CommitInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Finished == nil {
-				m.Finished = &google_protobuf1.Timestamp{}
+			if m.Repo == nil {
+				m.Repo = &Repo{}
 			}
-			if err := m.Finished.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SizeBytes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
 			}
-			m.SizeBytes = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -7878,45 +15038,65 @@ func (m *CommitInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SizeBytes |= (uint64(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Provenance", wireType)
+			m.DryRun = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if skippy < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPfs
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplyRetentionPolicyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
 			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Provenance = append(m.Provenance, &Commit{})
-			if err := m.Provenance[len(m.Provenance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 7:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplyRetentionPolicyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplyRetentionPolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tree", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Deleted", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7940,10 +15120,8 @@ func (m *CommitInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Tree == nil {
-				m.Tree = &Object{}
-			}
-			if err := m.Tree.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Deleted = append(m.Deleted, &Commit{})
+			if err := m.Deleted[len(m.Deleted)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7968,7 +15146,7 @@ func (m *CommitInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FileInfo) Unmarshal(dAtA []byte) error {
+func (m *SetQuotaRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7991,15 +15169,15 @@ func (m *FileInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FileInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: SetQuotaRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FileInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SetQuotaRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field File", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8023,54 +15201,16 @@ func (m *FileInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.File == nil {
-				m.File = &File{}
+			if m.Repo == nil {
+				m.Repo = &Repo{}
 			}
-			if err := m.File.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FileType", wireType)
-			}
-			m.FileType = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.FileType |= (FileType(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SizeBytes", wireType)
-			}
-			m.SizeBytes = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SizeBytes |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Children", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Principal", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8095,42 +15235,11 @@ func (m *FileInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Children = append(m.Children, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthPfs
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
-			if m.Hash == nil {
-				m.Hash = []byte{}
-			}
+			m.Principal = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Objects", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Quota", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8154,8 +15263,10 @@ func (m *FileInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Objects = append(m.Objects, &Object{})
-			if err := m.Objects[len(m.Objects)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Quota == nil {
+				m.Quota = &Quota{}
+			}
+			if err := m.Quota.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8180,7 +15291,7 @@ func (m *FileInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ByteRange) Unmarshal(dAtA []byte) error {
+func (m *CheckQuotaRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8203,17 +15314,17 @@ func (m *ByteRange) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ByteRange: wiretype end group for non-group")
+			return fmt.Errorf("proto: CheckQuotaRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ByteRange: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CheckQuotaRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Lower", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
 			}
-			m.Lower = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -8223,16 +15334,30 @@ func (m *ByteRange) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Lower |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Repo == nil {
+				m.Repo = &Repo{}
+			}
+			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Upper", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AdditionalBytes", wireType)
 			}
-			m.Upper = 0
+			m.AdditionalBytes = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -8242,7 +15367,26 @@ func (m *ByteRange) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Upper |= (uint64(b) & 0x7F) << shift
+				m.AdditionalBytes |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdditionalCommits", wireType)
+			}
+			m.AdditionalCommits = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AdditionalCommits |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -8268,7 +15412,7 @@ func (m *ByteRange) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BlockRef) Unmarshal(dAtA []byte) error {
+func (m *QuotaUsageRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8284,85 +15428,19 @@ func (m *BlockRef) Unmarshal(dAtA []byte) error {
 			b := dAtA[iNdEx]
 			iNdEx++
 			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: BlockRef: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BlockRef: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthPfs
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Block == nil {
-				m.Block = &Block{}
-			}
-			if err := m.Block.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Range", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthPfs
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Range == nil {
-				m.Range = &ByteRange{}
-			}
-			if err := m.Range.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuotaUsageRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuotaUsageRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -8384,7 +15462,7 @@ func (m *BlockRef) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ObjectInfo) Unmarshal(dAtA []byte) error {
+func (m *Usage) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8407,17 +15485,17 @@ func (m *ObjectInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ObjectInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: Usage: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ObjectInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Usage: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Object", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogicalBytes", wireType)
 			}
-			var msglen int
+			m.LogicalBytes = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -8427,28 +15505,52 @@ func (m *ObjectInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.LogicalBytes |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPfs
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeduplicatedBytes", wireType)
 			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.DeduplicatedBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DeduplicatedBytes |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if m.Object == nil {
-				m.Object = &Object{}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commits", wireType)
 			}
-			if err := m.Object.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Commits = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Commits |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockRef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Quota", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8472,10 +15574,10 @@ func (m *ObjectInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.BlockRef == nil {
-				m.BlockRef = &BlockRef{}
+			if m.Quota == nil {
+				m.Quota = &Quota{}
 			}
-			if err := m.BlockRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Quota.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8500,7 +15602,7 @@ func (m *ObjectInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CreateRepoRequest) Unmarshal(dAtA []byte) error {
+func (m *RepoUsage) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8523,10 +15625,10 @@ func (m *CreateRepoRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CreateRepoRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RepoUsage: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CreateRepoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RepoUsage: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8564,9 +15666,9 @@ func (m *CreateRepoRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Provenance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedBy", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -8576,28 +15678,26 @@ func (m *CreateRepoRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Provenance = append(m.Provenance, &Repo{})
-			if err := m.Provenance[len(m.Provenance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.CreatedBy = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Usage", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -8607,41 +15707,25 @@ func (m *CreateRepoRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Update", wireType)
+			if m.Usage == nil {
+				m.Usage = &Usage{}
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.Usage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Update = bool(v != 0)
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -8663,7 +15747,7 @@ func (m *CreateRepoRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *InspectRepoRequest) Unmarshal(dAtA []byte) error {
+func (m *PrincipalUsage) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8686,15 +15770,44 @@ func (m *InspectRepoRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: InspectRepoRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PrincipalUsage: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InspectRepoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PrincipalUsage: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Principal", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Principal = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Usage", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8718,10 +15831,10 @@ func (m *InspectRepoRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Repo == nil {
-				m.Repo = &Repo{}
+			if m.Usage == nil {
+				m.Usage = &Usage{}
 			}
-			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Usage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8746,7 +15859,7 @@ func (m *InspectRepoRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListRepoRequest) Unmarshal(dAtA []byte) error {
+func (m *QuotaUsageResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8769,15 +15882,15 @@ func (m *ListRepoRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListRepoRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuotaUsageResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListRepoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuotaUsageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Provenance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Repos", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8801,8 +15914,39 @@ func (m *ListRepoRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Provenance = append(m.Provenance, &Repo{})
-			if err := m.Provenance[len(m.Provenance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Repos = append(m.Repos, &RepoUsage{})
+			if err := m.Repos[len(m.Repos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Principals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Principals = append(m.Principals, &PrincipalUsage{})
+			if err := m.Principals[len(m.Principals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8827,7 +15971,7 @@ func (m *ListRepoRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListRepoResponse) Unmarshal(dAtA []byte) error {
+func (m *DeleteCommitRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8850,15 +15994,15 @@ func (m *ListRepoResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListRepoResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeleteCommitRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListRepoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeleteCommitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RepoInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8882,8 +16026,10 @@ func (m *ListRepoResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RepoInfo = append(m.RepoInfo, &RepoInfo{})
-			if err := m.RepoInfo[len(m.RepoInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Commit == nil {
+				m.Commit = &Commit{}
+			}
+			if err := m.Commit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8908,7 +16054,7 @@ func (m *ListRepoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DeleteRepoRequest) Unmarshal(dAtA []byte) error {
+func (m *FlushCommitRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8931,15 +16077,15 @@ func (m *DeleteRepoRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DeleteRepoRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: FlushCommitRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeleteRepoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FlushCommitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Commits", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8963,18 +16109,16 @@ func (m *DeleteRepoRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Repo == nil {
-				m.Repo = &Repo{}
-			}
-			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Commits = append(m.Commits, &Commit{})
+			if err := m.Commits[len(m.Commits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Force", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToRepos", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -8984,32 +16128,23 @@ func (m *DeleteRepoRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Force = bool(v != 0)
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field All", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.All = bool(v != 0)
+			m.ToRepos = append(m.ToRepos, &Repo{})
+			if err := m.ToRepos[len(m.ToRepos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -9031,7 +16166,7 @@ func (m *DeleteRepoRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StartCommitRequest) Unmarshal(dAtA []byte) error {
+func (m *SubscribeCommitRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9054,15 +16189,15 @@ func (m *StartCommitRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StartCommitRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SubscribeCommitRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StartCommitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SubscribeCommitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Parent", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9086,18 +16221,18 @@ func (m *StartCommitRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Parent == nil {
-				m.Parent = &Commit{}
+			if m.Repo == nil {
+				m.Repo = &Repo{}
 			}
-			if err := m.Parent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Provenance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -9107,28 +16242,26 @@ func (m *StartCommitRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Provenance = append(m.Provenance, &Commit{})
-			if err := m.Provenance[len(m.Provenance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Branch = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -9138,20 +16271,24 @@ func (m *StartCommitRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Branch = string(dAtA[iNdEx:postIndex])
+			if m.From == nil {
+				m.From = &Commit{}
+			}
+			if err := m.From.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9174,7 +16311,7 @@ func (m *StartCommitRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BuildCommitRequest) Unmarshal(dAtA []byte) error {
+func (m *GetFileRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9197,15 +16334,15 @@ func (m *BuildCommitRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BuildCommitRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetFileRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BuildCommitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetFileRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Parent", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field File", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9229,18 +16366,18 @@ func (m *BuildCommitRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Parent == nil {
-				m.Parent = &Commit{}
+			if m.File == nil {
+				m.File = &File{}
 			}
-			if err := m.Parent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.File.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Provenance", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OffsetBytes", wireType)
 			}
-			var msglen int
+			m.OffsetBytes = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -9250,61 +16387,16 @@ func (m *BuildCommitRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.OffsetBytes |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPfs
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Provenance = append(m.Provenance, &Commit{})
-			if err := m.Provenance[len(m.Provenance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tree", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthPfs
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Tree == nil {
-				m.Tree = &Object{}
-			}
-			if err := m.Tree.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SizeBytes", wireType)
 			}
-			var stringLen uint64
+			m.SizeBytes = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -9314,21 +16406,11 @@ func (m *BuildCommitRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.SizeBytes |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPfs
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Branch = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -9350,7 +16432,7 @@ func (m *BuildCommitRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FinishCommitRequest) Unmarshal(dAtA []byte) error {
+func (m *GetArchiveRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9373,15 +16455,15 @@ func (m *FinishCommitRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FinishCommitRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetArchiveRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FinishCommitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetArchiveRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field File", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9405,13 +16487,32 @@ func (m *FinishCommitRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Commit == nil {
-				m.Commit = &Commit{}
+			if m.File == nil {
+				m.File = &File{}
 			}
-			if err := m.Commit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.File.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Format", wireType)
+			}
+			m.Format = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Format |= (ArchiveFormat(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -9433,7 +16534,7 @@ func (m *FinishCommitRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *InspectCommitRequest) Unmarshal(dAtA []byte) error {
+func (m *OverwriteIndex) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9456,17 +16557,17 @@ func (m *InspectCommitRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: InspectCommitRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: OverwriteIndex: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InspectCommitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OverwriteIndex: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
 			}
-			var msglen int
+			m.Index = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -9476,25 +16577,11 @@ func (m *InspectCommitRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.Index |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPfs
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Commit == nil {
-				m.Commit = &Commit{}
-			}
-			if err := m.Commit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -9516,7 +16603,7 @@ func (m *InspectCommitRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListCommitRequest) Unmarshal(dAtA []byte) error {
+func (m *PutFileRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9539,15 +16626,15 @@ func (m *ListCommitRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListCommitRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PutFileRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListCommitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PutFileRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field File", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9571,18 +16658,78 @@ func (m *ListCommitRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Repo == nil {
-				m.Repo = &Repo{}
+			if m.File == nil {
+				m.File = &File{}
 			}
-			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.File.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Url", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Url = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recursive", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -9592,30 +16739,17 @@ func (m *ListCommitRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPfs
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.From == nil {
-				m.From = &Commit{}
-			}
-			if err := m.From.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			m.Recursive = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delimiter", wireType)
 			}
-			var msglen int
+			m.Delimiter = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -9625,30 +16759,16 @@ func (m *ListCommitRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.Delimiter |= (Delimiter(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPfs
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.To == nil {
-				m.To = &Commit{}
-			}
-			if err := m.To.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
+		case 8:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetFileDatums", wireType)
 			}
-			m.Number = 0
+			m.TargetFileDatums = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -9658,64 +16778,33 @@ func (m *ListCommitRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Number |= (uint64(b) & 0x7F) << shift
+				m.TargetFileDatums |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPfs(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthPfs
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *CommitInfos) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPfs
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetFileBytes", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			m.TargetFileBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetFileBytes |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: CommitInfos: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CommitInfos: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CommitInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OverwriteIndex", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9739,8 +16828,10 @@ func (m *CommitInfos) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CommitInfo = append(m.CommitInfo, &CommitInfo{})
-			if err := m.CommitInfo[len(m.CommitInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.OverwriteIndex == nil {
+				m.OverwriteIndex = &OverwriteIndex{}
+			}
+			if err := m.OverwriteIndex.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9765,7 +16856,7 @@ func (m *CommitInfos) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListBranchRequest) Unmarshal(dAtA []byte) error {
+func (m *PutFileRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9788,17 +16879,17 @@ func (m *ListBranchRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListBranchRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PutFileRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListBranchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PutFileRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SizeBytes", wireType)
 			}
-			var msglen int
+			m.SizeBytes = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -9808,80 +16899,16 @@ func (m *ListBranchRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.SizeBytes |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPfs
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Repo == nil {
-				m.Repo = &Repo{}
-			}
-			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPfs(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthPfs
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SetBranchRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPfs
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SetBranchRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SetBranchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectHash", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -9891,30 +16918,26 @@ func (m *SetBranchRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Commit == nil {
-				m.Commit = &Commit{}
-			}
-			if err := m.Commit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ObjectHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OverwriteIndex", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -9924,20 +16947,24 @@ func (m *SetBranchRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Branch = string(dAtA[iNdEx:postIndex])
+			if m.OverwriteIndex == nil {
+				m.OverwriteIndex = &OverwriteIndex{}
+			}
+			if err := m.OverwriteIndex.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9960,7 +16987,7 @@ func (m *SetBranchRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DeleteBranchRequest) Unmarshal(dAtA []byte) error {
+func (m *PutFileRecords) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9983,17 +17010,17 @@ func (m *DeleteBranchRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DeleteBranchRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PutFileRecords: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeleteBranchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PutFileRecords: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Split", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -10003,30 +17030,17 @@ func (m *DeleteBranchRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPfs
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Repo == nil {
-				m.Repo = &Repo{}
-			}
-			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.Split = bool(v != 0)
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -10036,20 +17050,22 @@ func (m *DeleteBranchRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Branch = string(dAtA[iNdEx:postIndex])
+			m.Records = append(m.Records, &PutFileRecord{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10072,7 +17088,7 @@ func (m *DeleteBranchRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DeleteCommitRequest) Unmarshal(dAtA []byte) error {
+func (m *Transaction) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10095,17 +17111,17 @@ func (m *DeleteCommitRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DeleteCommitRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: Transaction: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeleteCommitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Transaction: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -10115,24 +17131,20 @@ func (m *DeleteCommitRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Commit == nil {
-				m.Commit = &Commit{}
-			}
-			if err := m.Commit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10155,7 +17167,7 @@ func (m *DeleteCommitRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FlushCommitRequest) Unmarshal(dAtA []byte) error {
+func (m *TransactionInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10178,15 +17190,15 @@ func (m *FlushCommitRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FlushCommitRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: TransactionInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FlushCommitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TransactionInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commits", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Transaction", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10210,14 +17222,16 @@ func (m *FlushCommitRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Commits = append(m.Commits, &Commit{})
-			if err := m.Commits[len(m.Commits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Transaction == nil {
+				m.Transaction = &Transaction{}
+			}
+			if err := m.Transaction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ToRepos", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Started", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10241,8 +17255,41 @@ func (m *FlushCommitRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ToRepos = append(m.ToRepos, &Repo{})
-			if err := m.ToRepos[len(m.ToRepos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Started == nil {
+				m.Started = &google_protobuf2.Timestamp{}
+			}
+			if err := m.Started.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Requests", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Requests = append(m.Requests, &TransactionRequest{})
+			if err := m.Requests[len(m.Requests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -10267,7 +17314,7 @@ func (m *FlushCommitRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SubscribeCommitRequest) Unmarshal(dAtA []byte) error {
+func (m *TransactionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10290,15 +17337,15 @@ func (m *SubscribeCommitRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SubscribeCommitRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: TransactionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SubscribeCommitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TransactionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartCommit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10322,18 +17369,18 @@ func (m *SubscribeCommitRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Repo == nil {
-				m.Repo = &Repo{}
+			if m.StartCommit == nil {
+				m.StartCommit = &StartCommitRequest{}
 			}
-			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.StartCommit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -10343,24 +17390,28 @@ func (m *SubscribeCommitRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Branch = string(dAtA[iNdEx:postIndex])
+			if m.Commit == nil {
+				m.Commit = &Commit{}
+			}
+			if err := m.Commit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FinishCommit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10384,10 +17435,76 @@ func (m *SubscribeCommitRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.From == nil {
-				m.From = &Commit{}
+			if m.FinishCommit == nil {
+				m.FinishCommit = &FinishCommitRequest{}
 			}
-			if err := m.From.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.FinishCommit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PutFile", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PutFile == nil {
+				m.PutFile = &TransactionPutFile{}
+			}
+			if err := m.PutFile.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SetBranch", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SetBranch == nil {
+				m.SetBranch = &SetBranchRequest{}
+			}
+			if err := m.SetBranch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -10412,7 +17529,7 @@ func (m *SubscribeCommitRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetFileRequest) Unmarshal(dAtA []byte) error {
+func (m *TransactionPutFile) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10435,10 +17552,10 @@ func (m *GetFileRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetFileRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: TransactionPutFile: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetFileRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TransactionPutFile: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -10475,10 +17592,10 @@ func (m *GetFileRequest) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OffsetBytes", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
 			}
-			m.OffsetBytes = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -10488,30 +17605,25 @@ func (m *GetFileRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.OffsetBytes |= (int64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SizeBytes", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
 			}
-			m.SizeBytes = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SizeBytes |= (int64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			if m.Records == nil {
+				m.Records = &PutFileRecords{}
+			}
+			if err := m.Records.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -10533,7 +17645,7 @@ func (m *GetFileRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OverwriteIndex) Unmarshal(dAtA []byte) error {
+func (m *StartTransactionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10546,41 +17658,22 @@ func (m *OverwriteIndex) Unmarshal(dAtA []byte) error {
 			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: OverwriteIndex: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OverwriteIndex: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
-			}
-			m.Index = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Index |= (int64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StartTransactionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StartTransactionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -10602,7 +17695,7 @@ func (m *OverwriteIndex) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PutFileRequest) Unmarshal(dAtA []byte) error {
+func (m *FinishTransactionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10625,15 +17718,15 @@ func (m *PutFileRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PutFileRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: FinishTransactionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PutFileRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FinishTransactionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field File", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Transaction", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10657,153 +17750,66 @@ func (m *PutFileRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.File == nil {
-				m.File = &File{}
+			if m.Transaction == nil {
+				m.Transaction = &Transaction{}
 			}
-			if err := m.File.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Transaction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if byteLen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + byteLen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
-			if m.Value == nil {
-				m.Value = []byte{}
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Url", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPfs
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeleteTransactionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Url = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Recursive", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Recursive = bool(v != 0)
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Delimiter", wireType)
-			}
-			m.Delimiter = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Delimiter |= (Delimiter(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetFileDatums", wireType)
-			}
-			m.TargetFileDatums = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TargetFileDatums |= (int64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetFileBytes", wireType)
-			}
-			m.TargetFileBytes = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TargetFileBytes |= (int64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-		case 10:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteTransactionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteTransactionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OverwriteIndex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Transaction", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10827,10 +17833,10 @@ func (m *PutFileRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.OverwriteIndex == nil {
-				m.OverwriteIndex = &OverwriteIndex{}
+			if m.Transaction == nil {
+				m.Transaction = &Transaction{}
 			}
-			if err := m.OverwriteIndex.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Transaction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -10855,7 +17861,7 @@ func (m *PutFileRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PutFileRecord) Unmarshal(dAtA []byte) error {
+func (m *CopyFileRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10878,36 +17884,17 @@ func (m *PutFileRecord) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PutFileRecord: wiretype end group for non-group")
+			return fmt.Errorf("proto: CopyFileRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PutFileRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CopyFileRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SizeBytes", wireType)
-			}
-			m.SizeBytes = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SizeBytes |= (int64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Src", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -10917,24 +17904,28 @@ func (m *PutFileRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ObjectHash = string(dAtA[iNdEx:postIndex])
+			if m.Src == nil {
+				m.Src = &File{}
+			}
+			if err := m.Src.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OverwriteIndex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Dst", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10958,13 +17949,33 @@ func (m *PutFileRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.OverwriteIndex == nil {
-				m.OverwriteIndex = &OverwriteIndex{}
+			if m.Dst == nil {
+				m.Dst = &File{}
 			}
-			if err := m.OverwriteIndex.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Dst.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			iNdEx = postIndex
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Overwrite", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Overwrite = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -10986,7 +17997,7 @@ func (m *PutFileRecord) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PutFileRecords) Unmarshal(dAtA []byte) error {
+func (m *InspectFileRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11009,35 +18020,15 @@ func (m *PutFileRecords) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PutFileRecords: wiretype end group for non-group")
+			return fmt.Errorf("proto: InspectFileRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PutFileRecords: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: InspectFileRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Split", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Split = bool(v != 0)
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field File", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11061,8 +18052,10 @@ func (m *PutFileRecords) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Records = append(m.Records, &PutFileRecord{})
-			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.File == nil {
+				m.File = &File{}
+			}
+			if err := m.File.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -11087,7 +18080,7 @@ func (m *PutFileRecords) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CopyFileRequest) Unmarshal(dAtA []byte) error {
+func (m *ListFileRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11110,15 +18103,15 @@ func (m *CopyFileRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CopyFileRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListFileRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CopyFileRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListFileRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Src", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field File", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11142,49 +18135,16 @@ func (m *CopyFileRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Src == nil {
-				m.Src = &File{}
+			if m.File == nil {
+				m.File = &File{}
 			}
-			if err := m.Src.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.File.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Dst", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthPfs
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Dst == nil {
-				m.Dst = &File{}
-			}
-			if err := m.Dst.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Overwrite", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Full", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -11201,7 +18161,7 @@ func (m *CopyFileRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.Overwrite = bool(v != 0)
+			m.Full = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -11223,7 +18183,7 @@ func (m *CopyFileRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *InspectFileRequest) Unmarshal(dAtA []byte) error {
+func (m *GlobFileRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11246,15 +18206,15 @@ func (m *InspectFileRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: InspectFileRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GlobFileRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InspectFileRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GlobFileRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field File", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11278,13 +18238,42 @@ func (m *InspectFileRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.File == nil {
-				m.File = &File{}
+			if m.Commit == nil {
+				m.Commit = &Commit{}
 			}
-			if err := m.File.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Commit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pattern", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pattern = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -11306,7 +18295,7 @@ func (m *InspectFileRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListFileRequest) Unmarshal(dAtA []byte) error {
+func (m *GrepFileRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11329,15 +18318,15 @@ func (m *ListFileRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListFileRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GrepFileRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListFileRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GrepFileRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field File", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11361,18 +18350,76 @@ func (m *ListFileRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.File == nil {
-				m.File = &File{}
+			if m.Commit == nil {
+				m.Commit = &Commit{}
 			}
-			if err := m.File.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Commit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pattern", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pattern = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Regex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Regex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Full", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMatches", wireType)
 			}
-			var v int
+			m.MaxMatches = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -11382,12 +18429,30 @@ func (m *ListFileRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				m.MaxMatches |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBytes", wireType)
+			}
+			m.MaxBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBytes |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Full = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -11409,7 +18474,7 @@ func (m *ListFileRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GlobFileRequest) Unmarshal(dAtA []byte) error {
+func (m *GrepFileResult) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11432,15 +18497,15 @@ func (m *GlobFileRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GlobFileRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GrepFileResult: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GlobFileRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GrepFileResult: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field File", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11464,16 +18529,35 @@ func (m *GlobFileRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Commit == nil {
-				m.Commit = &Commit{}
+			if m.File == nil {
+				m.File = &File{}
 			}
-			if err := m.Commit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.File.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LineNumber", wireType)
+			}
+			m.LineNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LineNumber |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pattern", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Line", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11498,7 +18582,7 @@ func (m *GlobFileRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Pattern = string(dAtA[iNdEx:postIndex])
+			m.Line = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -13452,162 +20536,242 @@ var (
 func init() { proto.RegisterFile("client/pfs/pfs.proto", fileDescriptorPfs) }
 
 var fileDescriptorPfs = []byte{
-	// 2500 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x59, 0x5b, 0x6f, 0xdb, 0xc8,
-	0x15, 0x36, 0x25, 0x59, 0xa2, 0x8e, 0x64, 0x5b, 0x9e, 0x78, 0x1d, 0xad, 0x9c, 0xc4, 0xde, 0x49,
-	0xb6, 0x4d, 0xb2, 0xa9, 0x63, 0x38, 0xbb, 0xcd, 0xe6, 0x8e, 0xf8, 0x92, 0xac, 0x17, 0x41, 0x1c,
-	0xd0, 0xee, 0x3e, 0x14, 0x28, 0x04, 0x8a, 0x1a, 0xc9, 0xdc, 0x50, 0x24, 0x97, 0x1c, 0xc5, 0xf1,
-	0x3e, 0xf4, 0xb5, 0x7d, 0xe9, 0x73, 0x0b, 0xf4, 0xa1, 0x7f, 0xa1, 0xbf, 0xa0, 0x40, 0xdf, 0x0a,
-	0x14, 0x28, 0xfa, 0x0b, 0x8a, 0x22, 0xfd, 0x19, 0x7d, 0x29, 0xe6, 0x42, 0x72, 0x78, 0x91, 0x25,
-	0x07, 0xe8, 0xc3, 0xae, 0x87, 0x73, 0x2e, 0x73, 0x6e, 0x73, 0xe6, 0x3b, 0x0a, 0xac, 0x58, 0x8e,
-	0x4d, 0x5c, 0x7a, 0xd7, 0x1f, 0x84, 0xec, 0xbf, 0x4d, 0x3f, 0xf0, 0xa8, 0x87, 0xca, 0xfe, 0x20,
-	0xec, 0xac, 0x0d, 0x3d, 0x6f, 0xe8, 0x90, 0xbb, 0x7c, 0xab, 0x37, 0x1e, 0xdc, 0x25, 0x23, 0x9f,
-	0x9e, 0x09, 0x8e, 0xce, 0x7a, 0x96, 0x48, 0xed, 0x11, 0x09, 0xa9, 0x39, 0xf2, 0x25, 0xc3, 0xb5,
-	0x2c, 0xc3, 0x69, 0x60, 0xfa, 0x3e, 0x09, 0xe4, 0x11, 0x9d, 0x95, 0xa1, 0x37, 0xf4, 0xf8, 0xf2,
-	0x2e, 0x5b, 0xc9, 0xdd, 0x55, 0x69, 0x8e, 0x39, 0xa6, 0x27, 0xfc, 0x7f, 0x62, 0x1f, 0x77, 0xa0,
-	0x62, 0x10, 0xdf, 0x43, 0x08, 0x2a, 0xae, 0x39, 0x22, 0x6d, 0x6d, 0x43, 0xbb, 0x59, 0x37, 0xf8,
-	0x1a, 0x3f, 0x07, 0xd8, 0x09, 0x4c, 0xd7, 0x3a, 0x39, 0x70, 0x07, 0x85, 0x1c, 0x68, 0x1d, 0x2a,
-	0x27, 0xc4, 0xec, 0xb7, 0x4b, 0x1b, 0xda, 0xcd, 0xc6, 0x76, 0x63, 0x93, 0x39, 0xba, 0xeb, 0x8d,
-	0x46, 0x36, 0x35, 0x38, 0x01, 0x3f, 0x83, 0x46, 0xa2, 0x22, 0x44, 0x5b, 0xd0, 0xe8, 0xf1, 0xcf,
-	0xae, 0xed, 0x0e, 0xbc, 0xb6, 0xb6, 0x51, 0xbe, 0xd9, 0xd8, 0x5e, 0xe2, 0x62, 0x09, 0x9b, 0x01,
-	0xbd, 0x78, 0x8d, 0x9f, 0x41, 0xe5, 0x85, 0xed, 0x10, 0x74, 0x1d, 0xaa, 0x16, 0x57, 0xcc, 0xcf,
-	0xcf, 0x9c, 0x25, 0x49, 0xcc, 0x44, 0xdf, 0xa4, 0x27, 0xdc, 0x9c, 0xba, 0xc1, 0xd7, 0x78, 0x0d,
-	0xe6, 0x77, 0x1c, 0xcf, 0x7a, 0xcb, 0x88, 0x27, 0x66, 0x78, 0x12, 0xd9, 0xcf, 0xd6, 0xf8, 0x0a,
-	0x54, 0x0f, 0x7b, 0xdf, 0x13, 0x8b, 0x16, 0x52, 0x3f, 0x85, 0xf2, 0xb1, 0x39, 0x2c, 0x0c, 0xcd,
-	0x7f, 0x35, 0xd0, 0x59, 0xdc, 0x78, 0x64, 0xae, 0x42, 0x25, 0x20, 0xbe, 0x27, 0x2d, 0xab, 0x73,
-	0xcb, 0x18, 0xd1, 0xe0, 0xdb, 0xe8, 0x4b, 0xa8, 0x59, 0x01, 0x31, 0x29, 0x89, 0xe2, 0xd4, 0xd9,
-	0x14, 0x29, 0xdc, 0x8c, 0x52, 0xb8, 0x79, 0x1c, 0xe5, 0xd8, 0x88, 0x58, 0xd1, 0x55, 0x80, 0xd0,
-	0xfe, 0x91, 0x74, 0x7b, 0x67, 0x94, 0x84, 0xed, 0xf2, 0x86, 0x76, 0xb3, 0x62, 0xd4, 0xd9, 0xce,
-	0x0e, 0xdb, 0x40, 0xb7, 0x00, 0xfc, 0xc0, 0x7b, 0x47, 0x5c, 0xd3, 0xb5, 0x48, 0xbb, 0xc2, 0x03,
-	0xa9, 0x9c, 0xac, 0x10, 0xd1, 0x06, 0x34, 0xfa, 0x24, 0xb4, 0x02, 0xdb, 0xa7, 0xb6, 0xe7, 0xb6,
-	0xe7, 0xb9, 0x1b, 0xea, 0x16, 0xda, 0x84, 0x3a, 0x2b, 0x09, 0x91, 0x94, 0x2a, 0xb7, 0x71, 0x39,
-	0xd6, 0xf5, 0x7c, 0x4c, 0x45, 0x5a, 0x74, 0x53, 0xae, 0xf0, 0x53, 0x68, 0xaa, 0x14, 0xb4, 0x09,
-	0x4d, 0xd3, 0xb2, 0x48, 0x18, 0x76, 0x1d, 0xf2, 0x8e, 0x38, 0x3c, 0x10, 0x8b, 0xdb, 0x8d, 0x4d,
-	0x5e, 0x67, 0x47, 0x96, 0xe7, 0x13, 0xa3, 0x21, 0x18, 0x5e, 0x31, 0x3a, 0x7e, 0x06, 0x55, 0x91,
-	0xb9, 0x69, 0xa1, 0x5b, 0x85, 0x92, 0x2d, 0xa2, 0x56, 0xdf, 0xa9, 0x7e, 0xf8, 0xd7, 0x7a, 0xe9,
-	0x60, 0xcf, 0x28, 0xd9, 0x7d, 0xfc, 0x97, 0x12, 0x80, 0xd0, 0xc0, 0xcf, 0x9f, 0xa9, 0x38, 0xb6,
-	0x60, 0xc1, 0x37, 0x03, 0xe2, 0xd2, 0xae, 0xe4, 0x2d, 0x28, 0xda, 0xa6, 0xe0, 0x90, 0xc6, 0x7d,
-	0x09, 0xb5, 0x90, 0x9a, 0x01, 0x4b, 0x5c, 0x79, 0x7a, 0xe2, 0x24, 0x2b, 0xfa, 0x39, 0xe8, 0x03,
-	0xdb, 0xb5, 0xc3, 0x13, 0xd2, 0x6f, 0x57, 0xa6, 0x8a, 0xc5, 0xbc, 0x99, 0x84, 0xcf, 0x67, 0x13,
-	0xfe, 0x45, 0x2a, 0xe1, 0x55, 0x9e, 0xf0, 0x94, 0xed, 0x6a, 0xca, 0xd7, 0xa1, 0x42, 0x03, 0x42,
-	0xda, 0x35, 0xc5, 0x45, 0x51, 0xe8, 0x06, 0x27, 0xe0, 0xbf, 0x6b, 0xa0, 0xb3, 0x7b, 0x15, 0xd5,
-	0xef, 0xc0, 0x76, 0x48, 0x2a, 0x09, 0x8c, 0x68, 0xf0, 0x6d, 0x74, 0x1b, 0xea, 0xec, 0x6f, 0x97,
-	0x9e, 0xf9, 0x84, 0x07, 0x6d, 0x71, 0x7b, 0x21, 0xe6, 0x39, 0x3e, 0xf3, 0x09, 0x73, 0x42, 0xac,
-	0xa6, 0x55, 0x6d, 0x07, 0x74, 0xeb, 0xc4, 0x76, 0xfa, 0x01, 0x71, 0xb9, 0x0b, 0x75, 0x23, 0xfe,
-	0x8e, 0x6f, 0x20, 0xb3, 0xb9, 0x29, 0x6e, 0x20, 0xfa, 0x1c, 0x6a, 0x1e, 0x37, 0x3b, 0x6c, 0xeb,
-	0x8a, 0xc7, 0xd2, 0x95, 0x88, 0x86, 0xef, 0x43, 0x9d, 0xe9, 0x37, 0x4c, 0x77, 0x48, 0xd0, 0x0a,
-	0xcc, 0x3b, 0xde, 0x29, 0x09, 0xb8, 0x3b, 0x15, 0x43, 0x7c, 0xb0, 0xdd, 0x31, 0xeb, 0x92, 0xdc,
-	0x81, 0x8a, 0x21, 0x3e, 0xb0, 0x01, 0x3a, 0x6f, 0x0e, 0x06, 0x19, 0xa0, 0x0d, 0x98, 0xef, 0xb1,
-	0xb5, 0x0c, 0x03, 0x88, 0xae, 0xc4, 0xa9, 0x82, 0x80, 0x6e, 0xc0, 0x7c, 0xc0, 0x8e, 0x90, 0x95,
-	0xb3, 0x28, 0x38, 0xa2, 0x83, 0x0d, 0x41, 0xc4, 0xbf, 0x02, 0x10, 0xf6, 0x45, 0xa5, 0x29, 0xac,
-	0x4c, 0x95, 0xa6, 0x74, 0x40, 0x92, 0x58, 0x84, 0xf9, 0x09, 0xdd, 0x80, 0x0c, 0xa4, 0xf2, 0x05,
-	0xe5, 0x78, 0x32, 0x30, 0xf4, 0x9e, 0x5c, 0xe1, 0xdf, 0x6b, 0xb0, 0xbc, 0xcb, 0x7b, 0x04, 0xbf,
-	0x27, 0xe4, 0x87, 0x31, 0x09, 0xa7, 0xde, 0xa3, 0x74, 0xb7, 0x28, 0x5d, 0xa0, 0x5b, 0x94, 0xf3,
-	0xdd, 0x62, 0x15, 0xaa, 0x63, 0xbf, 0x6f, 0x52, 0xc2, 0xcb, 0x5b, 0x37, 0xe4, 0x17, 0xbe, 0x07,
-	0xe8, 0xc0, 0x0d, 0x7d, 0xe6, 0xd8, 0xcc, 0x96, 0xe1, 0xc7, 0xb0, 0xf4, 0xca, 0x0e, 0x53, 0x12,
-	0x69, 0x63, 0xb5, 0x73, 0x8c, 0xc5, 0x4f, 0xa1, 0x95, 0x48, 0x87, 0xbe, 0xe7, 0x86, 0xbc, 0x5c,
-	0x99, 0x66, 0xf5, 0x85, 0x59, 0x88, 0xa5, 0x45, 0x23, 0x0b, 0xe4, 0x0a, 0xff, 0x12, 0x96, 0xf7,
-	0x88, 0x43, 0x2e, 0x14, 0xcb, 0x15, 0x98, 0x1f, 0x78, 0x81, 0x25, 0xaa, 0x40, 0x37, 0xc4, 0x07,
-	0x6a, 0x41, 0xd9, 0x74, 0x1c, 0x1e, 0x2e, 0xdd, 0x60, 0x4b, 0xfc, 0x6b, 0x40, 0x47, 0xac, 0x25,
-	0xc8, 0xeb, 0x29, 0x95, 0x5f, 0x87, 0xaa, 0xe8, 0x31, 0x85, 0xad, 0x4a, 0x90, 0x32, 0x77, 0xbd,
-	0x74, 0xfe, 0x5d, 0x5f, 0x85, 0xaa, 0x78, 0x2f, 0x65, 0xae, 0xe4, 0x17, 0xfe, 0x93, 0x06, 0x68,
-	0x67, 0x6c, 0x3b, 0xfd, 0xff, 0xb7, 0x01, 0x51, 0xb3, 0x29, 0x4f, 0x68, 0x36, 0x8a, 0x85, 0x95,
-	0x94, 0x85, 0x0f, 0xe1, 0xd2, 0x0b, 0xde, 0xfd, 0x72, 0x16, 0x4e, 0xed, 0xe6, 0xf8, 0x11, 0xac,
-	0xc8, 0x62, 0xfb, 0x08, 0xe1, 0xdf, 0x6a, 0xb0, 0xcc, 0xea, 0x26, 0x2d, 0x3a, 0x25, 0xef, 0xeb,
-	0x50, 0x19, 0x04, 0xde, 0xa8, 0x10, 0xeb, 0x30, 0x02, 0x5a, 0x83, 0x12, 0xf5, 0x52, 0x51, 0x90,
-	0xe4, 0x12, 0x65, 0x2f, 0x59, 0xd5, 0x1d, 0x8f, 0x7a, 0x24, 0xe0, 0x31, 0xa8, 0x18, 0xf2, 0x8b,
-	0x01, 0xa4, 0xe4, 0x21, 0xe3, 0x00, 0x49, 0xd8, 0x98, 0x07, 0x48, 0x09, 0x9b, 0x01, 0x56, 0xbc,
-	0xc6, 0xdb, 0xc2, 0x15, 0x01, 0x9f, 0x66, 0xbc, 0x74, 0x87, 0xd0, 0x3a, 0x22, 0x19, 0x91, 0x99,
-	0xde, 0xd0, 0x24, 0x93, 0xa5, 0x54, 0x26, 0x5f, 0xc1, 0x25, 0x71, 0x8f, 0x2e, 0x62, 0xc6, 0x44,
-	0x6d, 0x0f, 0x23, 0x6d, 0x1f, 0x91, 0x5a, 0x13, 0xd0, 0x0b, 0x67, 0x9c, 0x2d, 0xa9, 0xcf, 0xa1,
-	0x26, 0xe8, 0xa1, 0x0c, 0x69, 0x4a, 0x36, 0xa2, 0xa1, 0x1b, 0xa0, 0x53, 0xaf, 0xcb, 0x6c, 0x0b,
-	0xf3, 0x4d, 0xb2, 0x46, 0x3d, 0xf6, 0x37, 0xc4, 0x3e, 0xac, 0x1e, 0x8d, 0x7b, 0xac, 0x1f, 0xf6,
-	0xc8, 0x85, 0x2a, 0x68, 0x82, 0xbf, 0x71, 0x65, 0x95, 0x27, 0x54, 0x16, 0xfe, 0x01, 0x16, 0x5f,
-	0x12, 0xca, 0x9f, 0xe4, 0xe4, 0xa4, 0xf3, 0x9e, 0xec, 0xcf, 0xa0, 0xe9, 0x0d, 0x06, 0x21, 0xa1,
-	0xf2, 0x21, 0x66, 0xe7, 0x95, 0x8d, 0x86, 0xd8, 0x13, 0x4f, 0x71, 0xfe, 0xa5, 0x2e, 0x2b, 0x2f,
-	0x35, 0xfe, 0x09, 0x2c, 0x1e, 0xbe, 0x23, 0xc1, 0x69, 0x60, 0x53, 0x72, 0xe0, 0xf6, 0xc9, 0x7b,
-	0xd6, 0xf7, 0x6c, 0xb6, 0xe0, 0x67, 0x96, 0x0d, 0xf1, 0x81, 0xff, 0x5a, 0x82, 0xc5, 0x37, 0xe3,
-	0x8b, 0xd8, 0xb6, 0x02, 0xf3, 0xef, 0x4c, 0x67, 0x2c, 0xfa, 0x45, 0xd3, 0x10, 0x1f, 0xac, 0x7f,
-	0x8e, 0x03, 0x47, 0x82, 0x53, 0xb6, 0x44, 0x57, 0x58, 0x1f, 0xb7, 0xc6, 0x41, 0x68, 0xbf, 0x23,
-	0x1c, 0x94, 0xea, 0x46, 0xb2, 0x81, 0xee, 0x40, 0xbd, 0x4f, 0x1c, 0x7b, 0x64, 0x53, 0x12, 0x70,
-	0xc8, 0xb0, 0x28, 0xdf, 0xe3, 0xbd, 0x68, 0xd7, 0x48, 0x18, 0xd0, 0x1d, 0x40, 0xd4, 0x0c, 0x86,
-	0x84, 0x76, 0x39, 0x92, 0xe9, 0x9b, 0x74, 0x3c, 0x62, 0x90, 0x82, 0x39, 0xd2, 0x12, 0x14, 0x66,
-	0xe1, 0x1e, 0xdf, 0x47, 0xb7, 0x61, 0x59, 0xe5, 0x16, 0x11, 0xaa, 0x73, 0xe6, 0xa5, 0x84, 0x59,
-	0x84, 0xf1, 0x31, 0x2c, 0x79, 0x51, 0x9c, 0xba, 0x22, 0x3e, 0xc0, 0xfd, 0xbe, 0x24, 0xfa, 0x60,
-	0x2a, 0x86, 0xc6, 0xa2, 0x97, 0xfa, 0xfe, 0xb6, 0xa2, 0x97, 0x5a, 0x65, 0xfc, 0x3b, 0x0d, 0x16,
-	0xe2, 0x18, 0x5a, 0x5e, 0x90, 0xc5, 0x82, 0x5a, 0x26, 0x39, 0x68, 0x1d, 0x1a, 0x02, 0x39, 0x74,
-	0x39, 0x62, 0x12, 0xd5, 0x04, 0x62, 0xeb, 0x1b, 0x86, 0x9b, 0x0a, 0xac, 0x2a, 0xcf, 0x6c, 0x15,
-	0x3e, 0x56, 0x52, 0xca, 0xcc, 0x09, 0x59, 0xce, 0x42, 0xdf, 0x91, 0x37, 0x4f, 0x37, 0xc4, 0x07,
-	0xba, 0x03, 0xb5, 0x40, 0x30, 0xc8, 0xdb, 0x82, 0xb8, 0xf6, 0x94, 0xac, 0x11, 0xb1, 0x60, 0x1b,
-	0x96, 0x76, 0x3d, 0xff, 0x4c, 0xad, 0x94, 0x35, 0x28, 0x87, 0x81, 0x95, 0x2f, 0x14, 0xb6, 0xcb,
-	0x88, 0xfd, 0x30, 0x42, 0xe9, 0x2a, 0xb1, 0x1f, 0x52, 0x56, 0x1c, 0xb1, 0xd1, 0xf2, 0xd1, 0x4d,
-	0x36, 0x14, 0x24, 0x32, 0x7b, 0x5d, 0xe2, 0x3d, 0x81, 0x44, 0x2e, 0x50, 0xc9, 0x08, 0x2a, 0x83,
-	0xb1, 0xe3, 0x48, 0x20, 0xc0, 0xd7, 0xf8, 0x0d, 0x2c, 0xbd, 0x74, 0xbc, 0x9e, 0xaa, 0x65, 0xa6,
-	0xce, 0xda, 0x86, 0x9a, 0x6f, 0x52, 0x4a, 0x02, 0x57, 0xa6, 0x33, 0xfa, 0x64, 0xe0, 0x36, 0x42,
-	0xea, 0x61, 0x8c, 0xc5, 0x73, 0xe0, 0x26, 0x62, 0x11, 0x58, 0x9c, 0xbf, 0x0c, 0xa7, 0xb0, 0xb4,
-	0x67, 0x0f, 0x06, 0xaa, 0x29, 0x37, 0x40, 0x77, 0xc9, 0x69, 0xb7, 0xd8, 0xa9, 0x9a, 0x4b, 0x4e,
-	0xf9, 0xac, 0x7d, 0x03, 0x74, 0xcf, 0xe9, 0x0b, 0xae, 0x5c, 0xf8, 0x6b, 0x9e, 0xd3, 0xe7, 0x5c,
-	0x6d, 0xa8, 0x85, 0x27, 0xa6, 0xe3, 0x78, 0xa7, 0x32, 0x01, 0xd1, 0x27, 0xfe, 0x1e, 0x5a, 0xc9,
-	0xc1, 0x09, 0x2a, 0x8b, 0x4e, 0x0e, 0x27, 0x18, 0x2e, 0x8f, 0xe7, 0x4e, 0x46, 0xe7, 0x47, 0x95,
-	0x95, 0xe5, 0x95, 0x46, 0x84, 0xec, 0xf9, 0x13, 0x6f, 0xc5, 0x05, 0x32, 0xfd, 0x02, 0x5a, 0x6f,
-	0xc6, 0x54, 0x42, 0x14, 0x29, 0x12, 0x77, 0x25, 0x4d, 0xed, 0x4a, 0x57, 0xa0, 0x42, 0xcd, 0x61,
-	0x64, 0x84, 0xce, 0x15, 0x1d, 0x9b, 0x43, 0x83, 0xef, 0xe2, 0x3f, 0x6a, 0xb0, 0xfc, 0x92, 0x48,
-	0x45, 0xa1, 0xf2, 0xd6, 0x44, 0x33, 0x8b, 0x36, 0x79, 0x66, 0x29, 0x6c, 0xd1, 0x95, 0x69, 0x2d,
-	0x3a, 0x35, 0x4c, 0x5d, 0x05, 0xa0, 0x1e, 0x35, 0x9d, 0x2e, 0xdb, 0x92, 0xb0, 0xa2, 0xce, 0x77,
-	0x8e, 0xec, 0x1f, 0x09, 0xfe, 0x05, 0xb4, 0x8e, 0xcd, 0x61, 0xda, 0xcb, 0x99, 0xa6, 0x91, 0xf3,
-	0x9d, 0x5e, 0x01, 0xc4, 0xae, 0x49, 0xda, 0x69, 0x7c, 0x28, 0x2e, 0xcf, 0xb1, 0x39, 0x8c, 0xe3,
-	0xb0, 0x0a, 0x55, 0x3f, 0x20, 0x03, 0xfb, 0xbd, 0xfc, 0xe1, 0x44, 0x7e, 0xa1, 0x1b, 0xb0, 0x60,
-	0xbb, 0x96, 0x33, 0xee, 0x13, 0xa1, 0x43, 0x5e, 0x9f, 0xf4, 0x26, 0x3e, 0x10, 0xc8, 0x5e, 0x28,
-	0x94, 0x35, 0xd4, 0x82, 0x32, 0x35, 0x87, 0x52, 0x1d, 0x5b, 0x2a, 0xfe, 0x94, 0x26, 0xfa, 0x83,
-	0x9f, 0xc0, 0x8a, 0x28, 0x91, 0x8f, 0x4a, 0x14, 0xbe, 0x0c, 0x9f, 0x64, 0xc4, 0x85, 0x39, 0xf8,
-	0xa7, 0x51, 0xe9, 0xa9, 0x5e, 0x23, 0x19, 0x3c, 0x8d, 0x4f, 0xb7, 0x71, 0xc8, 0x54, 0x46, 0x29,
-	0xfe, 0x00, 0xd0, 0xee, 0x09, 0xb1, 0xde, 0x5e, 0x3c, 0x43, 0xf8, 0x67, 0x70, 0x29, 0x25, 0x2a,
-	0xe3, 0xb3, 0x0a, 0x55, 0xf2, 0xde, 0x0e, 0x69, 0x28, 0xdb, 0xb4, 0xfc, 0xc2, 0x5b, 0x50, 0x93,
-	0xb6, 0xcf, 0xea, 0xf3, 0x6f, 0x4a, 0xd0, 0x88, 0x86, 0x58, 0xf6, 0xf6, 0xdf, 0xcf, 0x8a, 0x5d,
-	0x55, 0xc4, 0x38, 0x8b, 0x5c, 0x87, 0xfb, 0x2e, 0x0d, 0xce, 0x92, 0x2a, 0xdf, 0x4c, 0xd5, 0x52,
-	0x27, 0x27, 0xc5, 0x22, 0x22, 0x44, 0x38, 0x5f, 0xe7, 0x00, 0x9a, 0xaa, 0x22, 0x96, 0xf2, 0xb7,
-	0xe4, 0x2c, 0x4a, 0xf9, 0x5b, 0x72, 0x86, 0xae, 0x47, 0x17, 0xb5, 0x70, 0x4e, 0x16, 0xb4, 0x87,
-	0xa5, 0xaf, 0xb5, 0xce, 0x1e, 0xd4, 0x63, 0xed, 0x05, 0x7a, 0x3e, 0x4b, 0xeb, 0x49, 0xc5, 0x21,
-	0xd1, 0x72, 0xfb, 0x0b, 0xf1, 0x3b, 0x09, 0xff, 0x71, 0xa3, 0x09, 0xba, 0xb1, 0x7f, 0xb4, 0x6f,
-	0x7c, 0xb7, 0xbf, 0xd7, 0x9a, 0x43, 0x3a, 0x54, 0x5e, 0x1c, 0xbc, 0xda, 0x6f, 0x69, 0xa8, 0x06,
-	0xe5, 0xbd, 0x03, 0xa3, 0x55, 0xba, 0x7d, 0x0b, 0xea, 0x31, 0xfc, 0x60, 0xf4, 0xd7, 0x87, 0xaf,
-	0xf7, 0x05, 0xe7, 0xb7, 0x47, 0x87, 0xaf, 0x5b, 0x1a, 0x5b, 0xbd, 0x3a, 0x78, 0xbd, 0xdf, 0x2a,
-	0x6d, 0xff, 0xb9, 0x09, 0xe5, 0xe7, 0x6f, 0x0e, 0xd0, 0x53, 0x80, 0x64, 0x9a, 0x47, 0xab, 0xe2,
-	0x69, 0xc8, 0x8e, 0xf7, 0x9d, 0xd5, 0xdc, 0x2f, 0x48, 0xfb, 0x23, 0x9f, 0x9e, 0xe1, 0x39, 0x74,
-	0x1f, 0x1a, 0xca, 0xd0, 0x8d, 0x2e, 0x73, 0x05, 0xf9, 0x31, 0xbc, 0x93, 0x1e, 0x81, 0xf1, 0x1c,
-	0x7a, 0x00, 0x7a, 0x34, 0x3a, 0xa3, 0x15, 0x4e, 0xcc, 0xcc, 0xe1, 0x9d, 0x4f, 0x32, 0xbb, 0xb2,
-	0x6e, 0xe7, 0x98, 0xcd, 0xc9, 0xd4, 0x2c, 0x6d, 0xce, 0x8d, 0xd1, 0xe7, 0xd8, 0xfc, 0x15, 0x34,
-	0x94, 0xc9, 0x58, 0xda, 0x9c, 0x9f, 0x95, 0x3b, 0xea, 0x43, 0x89, 0xe7, 0xd0, 0x0e, 0x34, 0xd5,
-	0x71, 0x11, 0xb5, 0x65, 0x5f, 0xcf, 0x4d, 0x90, 0xe7, 0x1c, 0xfd, 0x04, 0x16, 0x52, 0x63, 0x23,
-	0xfa, 0x54, 0x0d, 0x58, 0x5a, 0x4b, 0x76, 0xec, 0xc2, 0x73, 0xe8, 0x6b, 0x80, 0x64, 0x6e, 0x94,
-	0x9e, 0xe7, 0x06, 0xc9, 0x4e, 0x2b, 0x23, 0x18, 0x0a, 0xe3, 0xd5, 0x99, 0x46, 0x1a, 0x5f, 0x30,
-	0xe6, 0x9c, 0x63, 0xfc, 0x23, 0x68, 0x28, 0xb3, 0x8d, 0x8c, 0x5b, 0x7e, 0xda, 0x29, 0x30, 0x7c,
-	0x4b, 0x43, 0xbb, 0xb0, 0x94, 0x99, 0x5a, 0xd0, 0x9a, 0x08, 0x7c, 0xe1, 0x2c, 0x53, 0xac, 0xe4,
-	0x2b, 0x68, 0x28, 0x3f, 0x29, 0x48, 0x0b, 0xf2, 0x3f, 0x32, 0x64, 0x33, 0x27, 0xc3, 0x26, 0x86,
-	0x43, 0x25, 0x6c, 0xa9, 0x69, 0x51, 0x86, 0x4d, 0xf9, 0xe7, 0x02, 0x3c, 0x87, 0x1e, 0x43, 0x3d,
-	0x9e, 0x54, 0x91, 0x28, 0xc8, 0xec, 0xe4, 0x7a, 0x4e, 0xc0, 0xe2, 0xa0, 0x4b, 0x05, 0x6a, 0xd0,
-	0x67, 0xd5, 0xf1, 0x10, 0x6a, 0x12, 0xd0, 0xa2, 0x4b, 0x69, 0x78, 0x3b, 0x45, 0xf2, 0xa6, 0x86,
-	0x1e, 0x82, 0x1e, 0x41, 0x5e, 0x79, 0xc7, 0x32, 0x08, 0xf8, 0x9c, 0x73, 0x9f, 0x41, 0x4d, 0xce,
-	0x7c, 0xf2, 0xdc, 0xf4, 0x04, 0xd8, 0x59, 0xcb, 0x49, 0x72, 0x54, 0xf0, 0x1d, 0xeb, 0x5c, 0x3c,
-	0x57, 0x49, 0x67, 0xe0, 0x4a, 0x52, 0x9d, 0x41, 0x55, 0x94, 0x86, 0x56, 0x78, 0x0e, 0x6d, 0x8b,
-	0xce, 0xa0, 0x58, 0x9d, 0xc1, 0xc5, 0x9d, 0xc5, 0x94, 0x48, 0xc8, 0xbb, 0xc9, 0x62, 0xc4, 0x74,
-	0x44, 0x03, 0x62, 0x8e, 0x26, 0x48, 0x66, 0x0f, 0xdb, 0xd2, 0xd8, 0x71, 0x11, 0x62, 0x96, 0x42,
-	0x19, 0x00, 0x5d, 0x7c, 0x5c, 0xc4, 0x94, 0x3a, 0x2e, 0x2b, 0x59, 0x70, 0xdc, 0x03, 0xd0, 0x23,
-	0x70, 0x2a, 0x85, 0x32, 0x20, 0x59, 0xf6, 0xbd, 0x2c, 0x82, 0x55, 0xfb, 0x1e, 0x17, 0x56, 0xfb,
-	0xde, 0x6c, 0x29, 0x7d, 0xc2, 0x9f, 0x07, 0x42, 0xc9, 0x73, 0xc7, 0x41, 0x13, 0xd8, 0x26, 0x8b,
-	0x6f, 0xff, 0xa3, 0x0a, 0x75, 0xf1, 0x40, 0xb1, 0x87, 0xe3, 0x1e, 0xd4, 0x63, 0x10, 0x2b, 0x6f,
-	0x46, 0x16, 0xd4, 0x76, 0xd4, 0x47, 0x8d, 0x17, 0xe4, 0x03, 0x3e, 0xd9, 0x89, 0x8d, 0x23, 0x3e,
-	0xc3, 0x4d, 0x90, 0x6c, 0x2a, 0x92, 0xa1, 0x14, 0xad, 0xc7, 0x58, 0x17, 0xa9, 0x8a, 0xa7, 0x57,
-	0xe2, 0x3e, 0x40, 0x02, 0x93, 0x65, 0xdc, 0x72, 0xb8, 0x79, 0xba, 0x9a, 0xc7, 0xfc, 0x41, 0x4f,
-	0x79, 0x9c, 0x05, 0xb8, 0xe7, 0x04, 0xff, 0x6e, 0xdc, 0xf9, 0x8b, 0x7c, 0x58, 0x4a, 0x21, 0x13,
-	0x7e, 0x0d, 0x76, 0xa0, 0xa1, 0x80, 0x2c, 0x79, 0x7f, 0xf2, 0x88, 0xad, 0xd3, 0xce, 0x13, 0xe2,
-	0x8a, 0xb9, 0x0f, 0x0d, 0x05, 0x2c, 0x4b, 0x1d, 0x79, 0xf8, 0x9c, 0x49, 0xd4, 0x96, 0x86, 0xbe,
-	0x81, 0x85, 0x14, 0xe8, 0x94, 0xef, 0x54, 0x11, 0x8e, 0xed, 0x74, 0x8a, 0x48, 0xb1, 0x09, 0xf7,
-	0xa0, 0xfa, 0x92, 0x30, 0x1c, 0x8d, 0x62, 0x24, 0x3f, 0x3d, 0xd4, 0xb7, 0x00, 0x64, 0xb0, 0xd2,
-	0x82, 0x05, 0x61, 0x7a, 0x24, 0xba, 0x05, 0x83, 0x5a, 0xca, 0x9d, 0x57, 0x20, 0xb1, 0x82, 0x23,
-	0x52, 0xf8, 0x97, 0x9d, 0xf3, 0x2c, 0xba, 0x51, 0x5c, 0x5c, 0xbd, 0x51, 0xaa, 0x82, 0xcb, 0xb9,
-	0xfd, 0xd8, 0xbb, 0x47, 0x50, 0xdb, 0xf5, 0x46, 0xbe, 0x69, 0xd1, 0x8b, 0x5f, 0xa8, 0x9d, 0xd6,
-	0xdf, 0x3e, 0x5c, 0xd3, 0xfe, 0xf9, 0xe1, 0x9a, 0xf6, 0xef, 0x0f, 0xd7, 0xb4, 0x3f, 0xfc, 0xe7,
-	0xda, 0x5c, 0xaf, 0xca, 0x79, 0xee, 0xfd, 0x2f, 0x00, 0x00, 0xff, 0xff, 0x7c, 0x90, 0x8c, 0xfa,
-	0xbd, 0x1f, 0x00, 0x00,
+	// 3783 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x3a, 0xdb, 0x6e, 0x1b, 0xc9,
+	0x95, 0x6a, 0x92, 0xe2, 0xe5, 0x50, 0x12, 0xa9, 0xb2, 0x2c, 0xd3, 0x94, 0x6d, 0x69, 0xca, 0xf6,
+	0xac, 0xaf, 0xb2, 0x21, 0xcf, 0x8c, 0xc7, 0x77, 0x48, 0x96, 0x64, 0x6b, 0xa0, 0x91, 0xb5, 0x2d,
+	0x8d, 0x77, 0x31, 0xc0, 0x2c, 0xd1, 0x24, 0x8b, 0x54, 0x8f, 0x9a, 0xdd, 0xed, 0xee, 0xa6, 0x64,
+	0xce, 0xc3, 0xbe, 0xee, 0x3e, 0xec, 0x3e, 0xef, 0x02, 0x01, 0x92, 0x97, 0x7c, 0x40, 0x92, 0x0f,
+	0x08, 0x10, 0xe4, 0x25, 0x40, 0x80, 0x20, 0x5f, 0x10, 0x0c, 0x9c, 0x1f, 0xc8, 0x27, 0x04, 0x75,
+	0xe9, 0xee, 0xea, 0x0b, 0x2f, 0x72, 0x26, 0x0f, 0xb6, 0xaa, 0xcf, 0xad, 0x4e, 0x9d, 0x3a, 0x75,
+	0xea, 0x9c, 0x53, 0x84, 0x85, 0x96, 0xa1, 0x13, 0xd3, 0xbb, 0x67, 0x77, 0x5c, 0xfa, 0x6f, 0xd5,
+	0x76, 0x2c, 0xcf, 0x42, 0x59, 0xbb, 0xe3, 0xd6, 0xaf, 0x74, 0x2d, 0xab, 0x6b, 0x90, 0x7b, 0x0c,
+	0xd4, 0xec, 0x77, 0xee, 0xb5, 0xfb, 0x8e, 0xe6, 0xe9, 0x96, 0xc9, 0x89, 0xea, 0x4b, 0x71, 0x3c,
+	0xe9, 0xd9, 0xde, 0x40, 0x20, 0x97, 0xe3, 0x48, 0x4f, 0xef, 0x11, 0xd7, 0xd3, 0x7a, 0xb6, 0x20,
+	0x48, 0x48, 0x3f, 0x75, 0x34, 0xdb, 0x26, 0x8e, 0x50, 0xa1, 0xbe, 0xd0, 0xb5, 0xba, 0x16, 0x1b,
+	0xde, 0xa3, 0x23, 0x01, 0x5d, 0x14, 0xea, 0x6a, 0x7d, 0xef, 0x88, 0xfd, 0xc7, 0xe1, 0xb8, 0x0e,
+	0x39, 0x95, 0xd8, 0x16, 0x42, 0x90, 0x33, 0xb5, 0x1e, 0xa9, 0x29, 0x2b, 0xca, 0x8d, 0x92, 0xca,
+	0xc6, 0x78, 0x1d, 0x60, 0xc3, 0xd1, 0xcc, 0xd6, 0xd1, 0x8e, 0xd9, 0x49, 0xa5, 0x40, 0xcb, 0x90,
+	0x3b, 0x22, 0x5a, 0xbb, 0x96, 0x59, 0x51, 0x6e, 0x94, 0xd7, 0xca, 0xab, 0xd4, 0x10, 0x2f, 0xad,
+	0x5e, 0x4f, 0xf7, 0x54, 0x86, 0xc0, 0x2f, 0xa0, 0x1c, 0x8a, 0x70, 0xd1, 0x7d, 0x28, 0x37, 0xd9,
+	0x67, 0x43, 0x37, 0x3b, 0x56, 0x4d, 0x59, 0xc9, 0xde, 0x28, 0xaf, 0x55, 0x18, 0x5b, 0x48, 0xa6,
+	0x42, 0x33, 0x18, 0xe3, 0x1f, 0x15, 0x5f, 0x89, 0xaf, 0xad, 0x13, 0x82, 0x16, 0x21, 0xcf, 0x91,
+	0x42, 0x0d, 0xf1, 0x85, 0x3e, 0x85, 0xa2, 0x65, 0xb4, 0x1b, 0xc3, 0x94, 0x29, 0x58, 0x46, 0xfb,
+	0x35, 0xd1, 0xda, 0x94, 0xce, 0x24, 0xa7, 0x9c, 0x2e, 0x9b, 0x42, 0x67, 0x92, 0x53, 0x46, 0xb7,
+	0x0a, 0x39, 0x6a, 0xf7, 0x5a, 0x8e, 0xd1, 0xd4, 0x57, 0xb9, 0xcd, 0x57, 0x7d, 0x9b, 0xaf, 0x1e,
+	0xfa, 0x9b, 0xa2, 0x32, 0x3a, 0x74, 0x09, 0x4a, 0xb6, 0xa3, 0x9b, 0x2d, 0xdd, 0xd6, 0x8c, 0xda,
+	0x34, 0x53, 0x2d, 0x04, 0x50, 0xac, 0x65, 0x13, 0xee, 0x03, 0xb5, 0x3c, 0xc7, 0x06, 0x00, 0xfc,
+	0x05, 0xcc, 0xf2, 0x15, 0xbe, 0xd6, 0x5d, 0xcf, 0x72, 0x06, 0xe8, 0x3a, 0x4c, 0xf7, 0xac, 0x13,
+	0xe2, 0xa6, 0xd8, 0x87, 0x1a, 0x41, 0xe5, 0x58, 0xfc, 0x02, 0x72, 0xdb, 0xba, 0x41, 0xd0, 0x55,
+	0xc8, 0xb7, 0x98, 0xfa, 0x35, 0x25, 0xb9, 0x22, 0x81, 0xa2, 0xbb, 0x67, 0x6b, 0xde, 0x11, 0x33,
+	0x4e, 0x49, 0x65, 0x63, 0xbc, 0x04, 0xd3, 0x1b, 0x86, 0xd5, 0x3a, 0xa6, 0xc8, 0x23, 0xcd, 0xf5,
+	0x6d, 0xca, 0xc6, 0xf8, 0x12, 0xe4, 0xdf, 0x34, 0xbf, 0x27, 0x2d, 0x2f, 0x15, 0x7b, 0x11, 0xb2,
+	0x87, 0x5a, 0x37, 0xd5, 0x6b, 0x7e, 0x9f, 0x85, 0x22, 0x75, 0x29, 0xe6, 0x34, 0x97, 0x21, 0xe7,
+	0x10, 0xdb, 0x12, 0x9a, 0x95, 0x98, 0x66, 0x14, 0xa9, 0x32, 0x30, 0xfa, 0x0c, 0x0a, 0x2d, 0x87,
+	0x68, 0x1e, 0xf1, 0x77, 0x6d, 0x94, 0xa5, 0x7d, 0x52, 0x74, 0x19, 0xc0, 0xd5, 0x7f, 0x20, 0x8d,
+	0xe6, 0xc0, 0x23, 0x2e, 0xdb, 0xc6, 0x9c, 0x5a, 0xa2, 0x90, 0x0d, 0x0a, 0x40, 0x37, 0x01, 0x6c,
+	0xc7, 0x3a, 0x21, 0xa6, 0x66, 0xb6, 0xe8, 0x0e, 0x66, 0xa3, 0x33, 0x4b, 0x48, 0xb4, 0x02, 0xe5,
+	0x36, 0x71, 0x5b, 0x8e, 0x6e, 0xb3, 0xad, 0xe1, 0x1b, 0x27, 0x83, 0xd0, 0x2a, 0x94, 0xe8, 0x69,
+	0xe1, 0xfe, 0x9a, 0x67, 0x3a, 0xce, 0x07, 0xb2, 0xd6, 0xfb, 0x1e, 0xf7, 0xd8, 0xa2, 0x26, 0x46,
+	0x68, 0x13, 0x90, 0xf0, 0x70, 0xba, 0x02, 0xd2, 0xa2, 0x42, 0xdc, 0x5a, 0x81, 0x29, 0x71, 0x5e,
+	0xda, 0xc8, 0xfd, 0x00, 0xab, 0xce, 0x37, 0x63, 0x10, 0x17, 0xbd, 0x80, 0xaa, 0x43, 0x3c, 0x62,
+	0xd2, 0xaf, 0x86, 0x6d, 0x19, 0x7a, 0x6b, 0x50, 0x2b, 0xb2, 0xc9, 0x17, 0xc4, 0xe4, 0x02, 0xb9,
+	0xcf, 0x70, 0x6a, 0xc5, 0x89, 0x02, 0xd0, 0x0a, 0x4c, 0xbf, 0xeb, 0x5b, 0x9e, 0x56, 0x2b, 0x31,
+	0x2e, 0x60, 0x5c, 0xff, 0x4a, 0x21, 0x2a, 0x47, 0x50, 0x23, 0x0a, 0x7b, 0x36, 0x9a, 0x83, 0x1a,
+	0x70, 0xa7, 0x14, 0x90, 0x8d, 0x01, 0xfe, 0x8d, 0x02, 0xd5, 0xb8, 0xa6, 0x43, 0x4f, 0xdf, 0x5d,
+	0x40, 0x9a, 0x61, 0x58, 0xa7, 0xa4, 0xdd, 0x08, 0x9c, 0xde, 0xad, 0x65, 0x56, 0xb2, 0x37, 0x4a,
+	0xea, 0xbc, 0xc0, 0xec, 0x07, 0x08, 0x74, 0x0b, 0xe6, 0x3b, 0x9a, 0xeb, 0x35, 0x3a, 0x96, 0x73,
+	0xaa, 0x39, 0xed, 0x86, 0x65, 0x1a, 0x03, 0xb6, 0x8d, 0x45, 0xb5, 0x42, 0x11, 0xdb, 0x1c, 0xfe,
+	0xc6, 0x34, 0x06, 0xe8, 0x36, 0xcc, 0x3b, 0xe4, 0x5d, 0x5f, 0x77, 0xa8, 0x6c, 0xdd, 0x26, 0x86,
+	0x6e, 0xf2, 0x53, 0x59, 0x52, 0xab, 0x3e, 0x62, 0x5f, 0xc0, 0xf1, 0xaf, 0x15, 0xa8, 0xc4, 0x4c,
+	0x83, 0x96, 0xa0, 0x74, 0x4c, 0x88, 0xdd, 0x30, 0x34, 0x97, 0x1f, 0x90, 0x9c, 0x5a, 0xa4, 0x80,
+	0x5d, 0xcd, 0xf5, 0xd0, 0x3a, 0x54, 0x18, 0xd2, 0x24, 0xa7, 0xc4, 0x69, 0x78, 0x47, 0x9a, 0x29,
+	0xfc, 0xf0, 0x62, 0xc2, 0x0f, 0x37, 0x45, 0x0c, 0x57, 0x67, 0x29, 0xc7, 0x1e, 0x65, 0x38, 0x3c,
+	0xd2, 0x4c, 0x6a, 0x47, 0x26, 0xa2, 0xad, 0xe9, 0x62, 0x15, 0x39, 0x95, 0xcd, 0xb8, 0x49, 0x01,
+	0x68, 0x19, 0xca, 0x0c, 0x7d, 0x4a, 0xc8, 0xb1, 0x31, 0x60, 0x9a, 0xe7, 0x54, 0xc6, 0xf1, 0x6f,
+	0x0c, 0x82, 0xb7, 0x60, 0x9a, 0xed, 0x0b, 0x55, 0xb4, 0xa7, 0xbd, 0x17, 0x4e, 0x2d, 0x14, 0xed,
+	0x69, 0xef, 0xb9, 0x4f, 0x2f, 0x43, 0x99, 0x22, 0xf9, 0x61, 0x76, 0x99, 0x92, 0x39, 0x15, 0x7a,
+	0xda, 0x7b, 0x7e, 0xcc, 0x5d, 0xfc, 0x1c, 0x66, 0x64, 0x8f, 0x44, 0xab, 0x30, 0xa3, 0xb5, 0x5a,
+	0xc4, 0x75, 0x1b, 0x06, 0x39, 0x21, 0x06, 0x13, 0x38, 0xb7, 0x56, 0x5e, 0x65, 0xa1, 0xff, 0xa0,
+	0x65, 0xd9, 0x44, 0x2d, 0x73, 0x82, 0x5d, 0x8a, 0xc7, 0x2f, 0x20, 0xcf, 0x45, 0x8d, 0x3b, 0xb2,
+	0x8b, 0x90, 0xd1, 0xf9, 0x69, 0x2d, 0x6d, 0xe4, 0x3f, 0xfc, 0x65, 0x39, 0xb3, 0xb3, 0xa9, 0x66,
+	0xf4, 0x36, 0xfe, 0x6d, 0x06, 0x80, 0x4b, 0x60, 0xf3, 0x4f, 0x14, 0x94, 0xee, 0xc3, 0xac, 0xad,
+	0x39, 0xc4, 0xf4, 0xc4, 0xc2, 0xd2, 0x42, 0xf7, 0x0c, 0xa7, 0x10, 0xca, 0x7d, 0x06, 0x05, 0xd7,
+	0xd3, 0x1c, 0x1a, 0x30, 0xb2, 0xe3, 0x03, 0x86, 0x20, 0x45, 0x5f, 0x40, 0xb1, 0xa3, 0x9b, 0xba,
+	0x7b, 0x44, 0xda, 0x13, 0x44, 0xf4, 0x80, 0x36, 0x16, 0x68, 0xa6, 0xe3, 0x81, 0xe6, 0x76, 0x24,
+	0xd0, 0xe4, 0x57, 0xb2, 0x71, 0xdd, 0x25, 0x34, 0xbd, 0x2a, 0x3d, 0x87, 0x90, 0x5a, 0x41, 0x5a,
+	0x22, 0x0f, 0xb0, 0x2a, 0x43, 0xe0, 0x3f, 0x2a, 0x50, 0xa4, 0xf1, 0xdc, 0x8f, 0x9b, 0x1d, 0xdd,
+	0x20, 0x91, 0x4d, 0xa0, 0x48, 0x95, 0x81, 0xd1, 0x2d, 0x28, 0xd1, 0xbf, 0x0d, 0x6f, 0x60, 0x13,
+	0x66, 0xb4, 0xb9, 0xb5, 0xd9, 0x80, 0xe6, 0x70, 0x60, 0x13, 0xba, 0x08, 0x3e, 0x1a, 0x17, 0x2d,
+	0xeb, 0x50, 0x6c, 0x1d, 0xe9, 0x46, 0xdb, 0x21, 0x26, 0x5b, 0x42, 0x49, 0x0d, 0xbe, 0x83, 0xc8,
+	0x4f, 0x75, 0x9e, 0xe1, 0x91, 0x1f, 0x5d, 0x87, 0x82, 0xc5, 0xd4, 0x76, 0x6b, 0xc5, 0x95, 0x6c,
+	0x7c, 0x29, 0x3e, 0x0e, 0x3f, 0x84, 0x12, 0x95, 0xaf, 0x6a, 0x66, 0x97, 0xa0, 0x05, 0x98, 0xa6,
+	0x31, 0xc0, 0x11, 0x6e, 0xcd, 0x3f, 0x28, 0xb4, 0x4f, 0x13, 0x17, 0xe1, 0xcd, 0xfc, 0x03, 0xab,
+	0x50, 0x64, 0x97, 0x92, 0x4a, 0x3a, 0x34, 0x8a, 0x35, 0xe9, 0xb8, 0xa6, 0x48, 0x51, 0x8c, 0x63,
+	0x39, 0x02, 0x5d, 0x83, 0x69, 0x87, 0x4e, 0x21, 0x3c, 0x67, 0x8e, 0x53, 0xf8, 0x13, 0xab, 0x1c,
+	0x89, 0xbf, 0x03, 0xe0, 0xfa, 0xf9, 0xae, 0xc9, 0xb5, 0x8c, 0xb8, 0xa6, 0x58, 0x80, 0x40, 0x51,
+	0x0b, 0xb3, 0x19, 0x1a, 0x0e, 0xe9, 0x08, 0xe1, 0xb3, 0xd2, 0xf4, 0xa4, 0xa3, 0x16, 0x9b, 0x62,
+	0x84, 0xff, 0x4f, 0x81, 0xf9, 0x97, 0x2c, 0x72, 0xb2, 0x73, 0x42, 0xde, 0xf5, 0x89, 0x3b, 0xf6,
+	0x1c, 0x45, 0x6f, 0xa9, 0xcc, 0x19, 0x6e, 0xa9, 0x6c, 0xf2, 0x96, 0x5a, 0x84, 0x7c, 0xdf, 0x6e,
+	0x6b, 0x1e, 0x0f, 0x8d, 0x45, 0x55, 0x7c, 0xe1, 0x07, 0x80, 0x76, 0x4c, 0xd7, 0xa6, 0x0b, 0x9b,
+	0x58, 0x33, 0xfc, 0x14, 0x2a, 0xbb, 0xba, 0x1b, 0xe1, 0x88, 0x2a, 0xab, 0x8c, 0x50, 0x16, 0x3f,
+	0x87, 0x6a, 0xc8, 0xed, 0xda, 0x96, 0xe9, 0x32, 0x77, 0xa5, 0x92, 0xe5, 0xa4, 0x6f, 0x36, 0xe0,
+	0xe6, 0x17, 0xa8, 0x23, 0x46, 0xf8, 0x5b, 0x98, 0xdf, 0x24, 0x06, 0x39, 0x93, 0x2d, 0x17, 0x60,
+	0xba, 0x63, 0x39, 0x2d, 0xee, 0x05, 0x45, 0x95, 0x7f, 0xa0, 0x2a, 0x64, 0x35, 0xc3, 0x10, 0x17,
+	0x0b, 0x1d, 0xe2, 0x77, 0x50, 0xd9, 0xb6, 0x9c, 0xe3, 0x33, 0x48, 0xa6, 0xe7, 0xd0, 0x72, 0x8e,
+	0x6b, 0x99, 0x04, 0x9a, 0x82, 0xc7, 0xef, 0x0c, 0xfe, 0x77, 0x98, 0x57, 0x09, 0xcd, 0x8b, 0xce,
+	0x30, 0xe9, 0x35, 0x9e, 0xa4, 0x32, 0x92, 0xc4, 0xc4, 0x34, 0x45, 0xa5, 0x03, 0xfc, 0x9f, 0x80,
+	0x0e, 0x68, 0x7c, 0x13, 0xb1, 0x46, 0x88, 0xbe, 0x0a, 0x79, 0x1e, 0x30, 0x53, 0xe3, 0x2e, 0x47,
+	0xa1, 0xdb, 0x29, 0xbe, 0x37, 0x34, 0x70, 0x85, 0x97, 0x7e, 0x56, 0xbe, 0xf4, 0xf1, 0x2f, 0x14,
+	0x40, 0x1b, 0x7d, 0xdd, 0x68, 0xff, 0xb3, 0x15, 0xf0, 0x23, 0x67, 0x76, 0x48, 0xe4, 0x94, 0x34,
+	0xcc, 0x45, 0x34, 0x7c, 0x0c, 0xe7, 0xb6, 0x59, 0x28, 0x4f, 0x68, 0x38, 0xf6, 0x6a, 0xc2, 0x4f,
+	0x60, 0x41, 0x9c, 0x9c, 0x8f, 0x60, 0xfe, 0x6f, 0x05, 0xe6, 0xe9, 0x21, 0x88, 0xb2, 0x8e, 0xd9,
+	0xf5, 0x65, 0xc8, 0x75, 0x1c, 0xab, 0x97, 0x5a, 0x4b, 0x51, 0x04, 0x5a, 0x82, 0x8c, 0x67, 0xa5,
+	0x55, 0x2d, 0x19, 0x8f, 0x5e, 0xcb, 0x79, 0xb3, 0xdf, 0x6b, 0x12, 0x47, 0xa4, 0x18, 0xe2, 0x8b,
+	0x16, 0x60, 0xe1, 0xad, 0xcc, 0x0a, 0x30, 0xae, 0x63, 0xb2, 0x00, 0x0b, 0xc9, 0x54, 0x68, 0x05,
+	0x63, 0xbc, 0xc6, 0x97, 0xc2, 0x73, 0xc1, 0x09, 0x23, 0xc8, 0x1b, 0xa8, 0x1e, 0x90, 0x18, 0xcb,
+	0x44, 0x09, 0x41, 0xb8, 0x93, 0x99, 0xc8, 0x4e, 0xee, 0xc2, 0x39, 0x1e, 0x14, 0xce, 0xa2, 0xc6,
+	0x50, 0x69, 0x3a, 0xd4, 0xc2, 0x25, 0x89, 0xa2, 0xeb, 0x1f, 0x13, 0x29, 0x99, 0x3f, 0x1b, 0x31,
+	0xbf, 0x03, 0xf5, 0xc0, 0x12, 0x52, 0xca, 0x3f, 0xd9, 0x64, 0x9f, 0xb3, 0x53, 0x22, 0x78, 0x84,
+	0x5f, 0x0c, 0xa9, 0x21, 0x24, 0x42, 0xfc, 0x16, 0x2e, 0xcb, 0xc6, 0x3a, 0xf3, 0xb4, 0xc3, 0xcc,
+	0x76, 0x04, 0x17, 0x0f, 0x88, 0x17, 0x2f, 0x3d, 0x26, 0x93, 0x79, 0x07, 0xf2, 0xa2, 0x8c, 0xc9,
+	0x8c, 0x28, 0x63, 0x04, 0x0d, 0xfe, 0x06, 0x96, 0xd6, 0x6d, 0xdb, 0x18, 0x7c, 0xdc, 0x5c, 0x17,
+	0xa0, 0xd0, 0x76, 0x06, 0x0d, 0xa7, 0x6f, 0x8a, 0xfb, 0x20, 0xdf, 0x76, 0x06, 0x6a, 0xdf, 0xc4,
+	0x5b, 0x70, 0x29, 0x5d, 0xac, 0xb8, 0xa6, 0xae, 0x43, 0xa1, 0xcd, 0x0c, 0xd7, 0x16, 0x07, 0x23,
+	0xda, 0x1b, 0x10, 0x38, 0x6c, 0x43, 0xe5, 0x80, 0x78, 0xbc, 0x98, 0x9a, 0x4c, 0xa3, 0x48, 0x77,
+	0x20, 0x13, 0xef, 0x0e, 0x04, 0xb5, 0x5a, 0x76, 0x48, 0xad, 0x86, 0xff, 0x87, 0x26, 0x18, 0x47,
+	0xa4, 0x75, 0x7c, 0x96, 0x49, 0x6f, 0x42, 0x55, 0x6b, 0xb7, 0x75, 0xba, 0x4e, 0xcd, 0x10, 0xd9,
+	0x1f, 0xcf, 0xb4, 0x2a, 0x21, 0x9c, 0xe7, 0x80, 0xb4, 0x7e, 0x0b, 0x49, 0xfd, 0x22, 0x83, 0x7b,
+	0xf2, 0x7c, 0x88, 0xf1, 0x6b, 0x8d, 0x73, 0x30, 0xcf, 0x14, 0xf9, 0xc6, 0xd5, 0xba, 0x44, 0x68,
+	0x43, 0x93, 0xa0, 0x69, 0x06, 0x40, 0x57, 0x61, 0xd6, 0xb0, 0xba, 0x7a, 0x2b, 0x98, 0x95, 0x67,
+	0x7d, 0x33, 0x02, 0x18, 0x4c, 0xd9, 0x26, 0xed, 0xbe, 0x6d, 0xe8, 0x2d, 0x51, 0x83, 0x86, 0xfa,
+	0xcd, 0xcb, 0x18, 0x4e, 0x5e, 0x83, 0x42, 0x54, 0x2d, 0xff, 0x33, 0xb4, 0x5e, 0x6e, 0x98, 0xf5,
+	0x8e, 0xa1, 0x44, 0xcd, 0xc2, 0x95, 0x1b, 0x7b, 0xdf, 0xcb, 0x55, 0x71, 0x26, 0x56, 0x15, 0xd3,
+	0xc9, 0xfa, 0x54, 0x4c, 0x64, 0xab, 0xb8, 0x19, 0x38, 0x02, 0xef, 0xc3, 0x5c, 0x50, 0xe9, 0xf2,
+	0x19, 0x23, 0x9b, 0xaf, 0xa4, 0x6c, 0x3e, 0x97, 0x98, 0x19, 0x26, 0xd1, 0x02, 0x24, 0x5b, 0x5b,
+	0xf8, 0x2a, 0x4d, 0x7c, 0x89, 0x6d, 0xf9, 0x3d, 0xa2, 0xb9, 0x60, 0x21, 0x82, 0x97, 0x21, 0xd1,
+	0x03, 0x80, 0x60, 0x2a, 0x57, 0xdc, 0xb3, 0xe7, 0x18, 0x69, 0x54, 0x49, 0x55, 0x22, 0xa3, 0xd7,
+	0x26, 0x8f, 0x1f, 0x1f, 0x71, 0xf3, 0x69, 0x80, 0xb6, 0x8d, 0x7e, 0xfc, 0xc6, 0xbd, 0x1e, 0xee,
+	0x5e, 0xda, 0xc1, 0xf2, 0xb7, 0xf2, 0x1a, 0x14, 0x3d, 0xab, 0xc1, 0x97, 0x95, 0x48, 0x88, 0x0b,
+	0x9e, 0x45, 0xff, 0xba, 0xd8, 0x86, 0xc5, 0x83, 0x7e, 0x93, 0x66, 0x58, 0x4d, 0x72, 0xa6, 0x0b,
+	0x76, 0x58, 0xec, 0xf6, 0x2f, 0xde, 0xec, 0x90, 0x8b, 0x17, 0xbf, 0x83, 0xb9, 0x57, 0xc4, 0x63,
+	0xe5, 0x57, 0x38, 0xd3, 0xa8, 0xf2, 0xec, 0x13, 0x98, 0xb1, 0x3a, 0x1d, 0x97, 0x78, 0x92, 0x5b,
+	0x67, 0xd5, 0x32, 0x87, 0x71, 0x87, 0x4e, 0x56, 0x65, 0x59, 0xa9, 0x2a, 0xc3, 0xff, 0x01, 0xf3,
+	0xaf, 0x88, 0xb7, 0xee, 0xb4, 0x8e, 0xf4, 0x93, 0x49, 0x67, 0xbd, 0x05, 0xf9, 0x8e, 0xe5, 0xf4,
+	0x34, 0x4f, 0x54, 0x84, 0x88, 0x11, 0x08, 0x19, 0xdb, 0x0c, 0xa3, 0x0a, 0x0a, 0xfc, 0x29, 0xcc,
+	0xbd, 0x39, 0x21, 0xce, 0xa9, 0xa3, 0x7b, 0x64, 0xc7, 0x6c, 0x93, 0xf7, 0x34, 0x87, 0xd6, 0xe9,
+	0x80, 0x49, 0xcf, 0xaa, 0xfc, 0x03, 0xff, 0x2e, 0x03, 0x73, 0xfb, 0xfd, 0xb3, 0xac, 0x7d, 0x01,
+	0xa6, 0x4f, 0x34, 0xa3, 0xcf, 0x8f, 0xc8, 0x8c, 0xca, 0x3f, 0x68, 0x2e, 0xde, 0x77, 0xfc, 0xce,
+	0x28, 0x1d, 0xd2, 0x63, 0xe1, 0x90, 0x56, 0xdf, 0x71, 0xf5, 0x13, 0xc2, 0x1a, 0x6b, 0x45, 0x35,
+	0x04, 0xa0, 0x3b, 0x50, 0x6a, 0x13, 0x43, 0xef, 0xe9, 0x1e, 0x71, 0x58, 0xf9, 0x39, 0x27, 0x5c,
+	0x7c, 0xd3, 0x87, 0xaa, 0x21, 0x01, 0xba, 0x03, 0xc8, 0xd3, 0x9c, 0x2e, 0xf1, 0x1a, 0xac, 0x2a,
+	0x6e, 0x6b, 0x5e, 0xbf, 0xe7, 0xb2, 0x86, 0x59, 0x56, 0xad, 0x72, 0x0c, 0xd5, 0x70, 0x93, 0xc1,
+	0x69, 0xfb, 0x49, 0xa6, 0xe6, 0x3b, 0x50, 0x62, 0xc4, 0x95, 0x90, 0x98, 0x6f, 0xd3, 0x53, 0xa8,
+	0x58, 0xbe, 0x9d, 0x1a, 0xdc, 0x3e, 0xb0, 0xa2, 0x04, 0xa7, 0x28, 0x6a, 0x43, 0x75, 0xce, 0x8a,
+	0x7c, 0x7f, 0x95, 0x2b, 0x66, 0xaa, 0x59, 0xfc, 0xbf, 0x0a, 0xcc, 0x06, 0x36, 0x6c, 0x59, 0x4e,
+	0xbc, 0xaf, 0xa0, 0xc4, 0x36, 0x9f, 0x36, 0x7b, 0x78, 0x15, 0xda, 0x60, 0xd5, 0x37, 0xf7, 0x56,
+	0xe0, 0xa0, 0xd7, 0xb4, 0x06, 0x4f, 0xd1, 0x2a, 0x3b, 0xb1, 0x56, 0xf8, 0x10, 0xe6, 0x22, 0xea,
+	0xb8, 0x74, 0xcf, 0x5c, 0xdb, 0x10, 0x27, 0xbb, 0xa8, 0xf2, 0x0f, 0x74, 0x07, 0x0a, 0x0e, 0x27,
+	0x10, 0xa7, 0x91, 0x3b, 0x54, 0x84, 0x57, 0xf5, 0x49, 0xf0, 0x75, 0x28, 0x1f, 0x3a, 0x9a, 0xe9,
+	0x6a, 0x7e, 0xab, 0x90, 0xb6, 0x89, 0x94, 0x44, 0x9b, 0xe8, 0x57, 0x0a, 0x54, 0x24, 0x3a, 0x56,
+	0x90, 0xaf, 0x41, 0xd9, 0x0b, 0x41, 0xc2, 0xb1, 0xaa, 0x6c, 0x32, 0x89, 0x54, 0x95, 0x89, 0xe4,
+	0x46, 0x50, 0x66, 0xf2, 0x46, 0xd0, 0x03, 0x28, 0x3a, 0xdc, 0x8d, 0xe9, 0x99, 0xa3, 0x6b, 0xba,
+	0x90, 0x98, 0x86, 0xe3, 0xd5, 0x80, 0x10, 0xff, 0x3c, 0x03, 0x28, 0x49, 0x80, 0x1e, 0xc3, 0x0c,
+	0x13, 0xdb, 0x88, 0x44, 0x45, 0x2e, 0x2f, 0x59, 0x98, 0xa9, 0x65, 0x37, 0x84, 0x49, 0xb1, 0x34,
+	0x33, 0x3c, 0x19, 0x7e, 0x06, 0xb3, 0xbc, 0x13, 0xe5, 0xcf, 0xc0, 0xf7, 0xb8, 0x26, 0x4e, 0x5c,
+	0xa2, 0xb0, 0x51, 0x67, 0x3a, 0x12, 0x10, 0xad, 0x41, 0xd1, 0xee, 0x73, 0x1f, 0xaf, 0xe5, 0x24,
+	0xdd, 0xa4, 0xa5, 0xf8, 0x5b, 0x59, 0xb0, 0xf9, 0x00, 0x7d, 0x06, 0xc0, 0xa2, 0x16, 0x0f, 0x93,
+	0xd3, 0x52, 0xc6, 0x19, 0xcf, 0xe7, 0xd5, 0x92, 0xeb, 0x43, 0x70, 0x13, 0x50, 0x52, 0xe8, 0xb8,
+	0x38, 0x71, 0x57, 0xf6, 0xae, 0xd0, 0x77, 0xa3, 0x9e, 0x19, 0xba, 0xd7, 0x45, 0xb8, 0xc0, 0x8c,
+	0x9a, 0xdc, 0x08, 0xbc, 0x07, 0x35, 0x6e, 0x8d, 0x24, 0xee, 0x63, 0x5c, 0x8b, 0xca, 0xe3, 0xf7,
+	0xdf, 0x4f, 0x24, 0x4f, 0x87, 0xca, 0x4b, 0xcb, 0x1e, 0xc8, 0x31, 0x74, 0x09, 0xb2, 0xae, 0xd3,
+	0x4a, 0x9a, 0x86, 0x42, 0x29, 0xb2, 0xed, 0x7a, 0xb5, 0x4c, 0x02, 0xd9, 0x76, 0x3d, 0xf6, 0x94,
+	0xe4, 0x1f, 0x67, 0xd1, 0xda, 0x08, 0x01, 0x52, 0xbf, 0x67, 0xf2, 0x88, 0x8d, 0x37, 0x79, 0xbf,
+	0x67, 0x72, 0x0e, 0xda, 0x17, 0xec, 0xf4, 0x0d, 0x43, 0xa4, 0xd7, 0x6c, 0x8c, 0xf7, 0xa1, 0xf2,
+	0xca, 0xb0, 0x9a, 0xb2, 0x94, 0x89, 0x4a, 0xbe, 0x1a, 0x14, 0x6c, 0xcd, 0xf3, 0x88, 0x63, 0x8a,
+	0x40, 0xe7, 0x7f, 0xe2, 0x5f, 0x2a, 0x50, 0x79, 0xe5, 0x10, 0xfb, 0xa7, 0x13, 0x49, 0x03, 0x9d,
+	0x43, 0xba, 0x22, 0x5c, 0x96, 0x54, 0xfe, 0xe1, 0x37, 0xd7, 0x7b, 0x9a, 0xd7, 0x3a, 0x22, 0x2e,
+	0x3b, 0x2c, 0x59, 0xd6, 0x5c, 0xff, 0x9a, 0x43, 0xa2, 0xad, 0xf9, 0x69, 0x86, 0x0e, 0x5a, 0xf3,
+	0xb8, 0x0d, 0x73, 0xa1, 0x96, 0x6e, 0xdf, 0x18, 0x6b, 0xbd, 0x65, 0x28, 0xd3, 0xd7, 0x8a, 0x86,
+	0x28, 0x18, 0x79, 0x72, 0x00, 0x14, 0xb4, 0xc7, 0x20, 0xd4, 0xbc, 0xf4, 0x4b, 0x28, 0xc9, 0xc6,
+	0xb4, 0x9f, 0xea, 0x37, 0x87, 0xdd, 0xa0, 0xfd, 0x9b, 0xe8, 0xa7, 0xf9, 0x24, 0xbc, 0xfd, 0x4b,
+	0x47, 0xf8, 0x14, 0x2a, 0x9b, 0x7a, 0xa7, 0x23, 0x1b, 0x51, 0xf4, 0x97, 0xd2, 0x75, 0xa4, 0xfd,
+	0x25, 0x3a, 0x40, 0xd7, 0xf8, 0x93, 0x2a, 0xa3, 0x4a, 0xf8, 0x22, 0x7d, 0x50, 0x65, 0x54, 0x35,
+	0x28, 0xb8, 0x47, 0xec, 0x89, 0x47, 0x78, 0xa3, 0xff, 0x89, 0xbf, 0x87, 0x6a, 0x38, 0x71, 0xd8,
+	0x08, 0xf4, 0x67, 0x76, 0x87, 0x28, 0x2e, 0xa6, 0x67, 0x8b, 0xf4, 0xe7, 0xf7, 0x2f, 0xa0, 0x38,
+	0xad, 0x50, 0xc2, 0xa5, 0x4d, 0x0a, 0x7e, 0x64, 0xcf, 0xe0, 0xf6, 0xdb, 0x50, 0xdd, 0xef, 0x7b,
+	0xa2, 0x91, 0x24, 0x58, 0x82, 0xe4, 0x45, 0x91, 0x93, 0x97, 0x4b, 0x90, 0xf3, 0xb4, 0xae, 0xaf,
+	0x44, 0x91, 0x9f, 0x76, 0xad, 0xab, 0x32, 0x28, 0xfe, 0x99, 0xc2, 0x72, 0x35, 0x2e, 0xc8, 0x95,
+	0x52, 0x5e, 0xbf, 0x4d, 0xae, 0x0c, 0x6f, 0x93, 0xa7, 0x66, 0x8a, 0xb9, 0x71, 0x99, 0x62, 0xa4,
+	0x7f, 0x7f, 0x19, 0xc0, 0xb3, 0x3c, 0xcd, 0x68, 0x50, 0x90, 0x68, 0xfe, 0x94, 0x18, 0xe4, 0x40,
+	0xff, 0x81, 0xe0, 0x6f, 0xa0, 0x7a, 0xa8, 0x75, 0xa3, 0xab, 0x9c, 0xa8, 0x01, 0x3e, 0x7a, 0xd1,
+	0x0b, 0x80, 0x68, 0xcc, 0x88, 0x2e, 0x1a, 0xbf, 0xe1, 0x91, 0xe4, 0x50, 0xeb, 0x06, 0x76, 0x58,
+	0x84, 0xbc, 0xed, 0x90, 0x8e, 0xfe, 0xde, 0x7f, 0x32, 0xe4, 0x5f, 0xe8, 0x1a, 0xcc, 0xea, 0x66,
+	0xcb, 0xe8, 0xb7, 0x09, 0x97, 0x21, 0x62, 0x49, 0x14, 0x88, 0x77, 0xa0, 0x1a, 0x0a, 0x14, 0x3e,
+	0x54, 0x85, 0xac, 0xa7, 0x75, 0x85, 0x38, 0x3a, 0x94, 0xd6, 0x93, 0x19, 0xba, 0x1e, 0xfc, 0x0c,
+	0x16, 0xb8, 0x8b, 0x7c, 0xd4, 0x46, 0xe1, 0x0b, 0x70, 0x3e, 0xc6, 0xce, 0xd5, 0xc1, 0xff, 0xe2,
+	0xbb, 0x9e, 0xbc, 0x6a, 0x24, 0x8c, 0xa7, 0xb0, 0x07, 0x95, 0xc0, 0x64, 0x32, 0xa1, 0x60, 0x7f,
+	0x04, 0x88, 0x55, 0xf6, 0x67, 0xdf, 0x21, 0x7c, 0x17, 0xce, 0x45, 0x58, 0x85, 0x7d, 0x16, 0x21,
+	0x4f, 0xde, 0xeb, 0xae, 0xe7, 0x8a, 0x6c, 0x4e, 0x7c, 0xe1, 0xfb, 0x50, 0x10, 0xba, 0x4f, 0xba,
+	0xe6, 0xff, 0xca, 0x40, 0xd9, 0x7f, 0x37, 0xa1, 0x25, 0xc2, 0xc3, 0x38, 0xdb, 0x65, 0x89, 0x8d,
+	0x91, 0x88, 0xb1, 0xbb, 0x65, 0x7a, 0xce, 0x20, 0xf4, 0xf2, 0xd5, 0x88, 0x2f, 0xd5, 0x13, 0x5c,
+	0xd4, 0x22, 0x9c, 0x85, 0xd1, 0xd5, 0x77, 0x60, 0x46, 0x16, 0x44, 0xb7, 0xfc, 0x98, 0x0c, 0xfc,
+	0x2d, 0x3f, 0x26, 0x03, 0x74, 0xd5, 0x3f, 0xa8, 0xa9, 0x4f, 0x33, 0x1c, 0xf7, 0x38, 0xf3, 0xa5,
+	0x52, 0xdf, 0x84, 0x52, 0x20, 0x3d, 0x45, 0xce, 0x27, 0x51, 0x39, 0x11, 0x3b, 0x84, 0x52, 0x6e,
+	0xdd, 0xe6, 0x4f, 0x73, 0xec, 0x3d, 0x6d, 0x06, 0x8a, 0xea, 0xd6, 0xc1, 0x96, 0xfa, 0x76, 0x6b,
+	0xb3, 0x3a, 0x85, 0x8a, 0x90, 0xdb, 0xde, 0xd9, 0xdd, 0xaa, 0x2a, 0xa8, 0x00, 0xd9, 0xcd, 0x1d,
+	0xb5, 0x9a, 0xb9, 0x75, 0x17, 0x66, 0x23, 0x45, 0x17, 0xc5, 0x1c, 0xae, 0xab, 0xd5, 0x29, 0x04,
+	0x90, 0x3f, 0x5c, 0x57, 0x1b, 0xaf, 0xbe, 0xe5, 0xe4, 0xdf, 0xee, 0xec, 0x57, 0x33, 0xb7, 0x6e,
+	0x42, 0x29, 0x28, 0x6a, 0xa8, 0xb8, 0xbd, 0x37, 0x7b, 0x5b, 0x5c, 0xf0, 0x57, 0x07, 0x6f, 0xf6,
+	0xaa, 0x0a, 0x1d, 0xed, 0xee, 0xec, 0x6d, 0x55, 0x33, 0x6b, 0x7f, 0x5b, 0x80, 0xec, 0xfa, 0xfe,
+	0x0e, 0x7a, 0x0e, 0x10, 0xbe, 0x37, 0xa1, 0x45, 0x7e, 0x07, 0xc6, 0x1f, 0xa0, 0xea, 0x8b, 0x89,
+	0x8c, 0x78, 0x8b, 0xfe, 0xce, 0x08, 0x4f, 0xa1, 0x87, 0x50, 0x96, 0x9e, 0x85, 0x10, 0xcf, 0x0b,
+	0x93, 0x0f, 0x45, 0xf5, 0xe8, 0x23, 0x0d, 0x9e, 0x42, 0x8f, 0xa0, 0xe8, 0x3f, 0xee, 0x20, 0xde,
+	0xc2, 0x8b, 0xbd, 0x14, 0xd5, 0xcf, 0xc7, 0xa0, 0xc2, 0xcd, 0xa7, 0xa8, 0xce, 0xe1, 0xbb, 0x8e,
+	0xd0, 0x39, 0xf1, 0xd0, 0x33, 0x42, 0xe7, 0xc7, 0x50, 0xf4, 0xdf, 0x6e, 0xc4, 0xd4, 0xb1, 0xa7,
+	0x9c, 0x11, 0xbc, 0xcf, 0x01, 0xc2, 0x47, 0x18, 0x31, 0x77, 0xe2, 0x55, 0x66, 0x04, 0xff, 0xe7,
+	0x50, 0x96, 0x32, 0x7a, 0x34, 0x2c, 0xc7, 0xaf, 0xcb, 0xd9, 0x08, 0x9e, 0x42, 0x1b, 0x30, 0x23,
+	0xa7, 0xe9, 0x68, 0x68, 0xe6, 0x3e, 0x62, 0xea, 0x67, 0x30, 0x1b, 0x79, 0x87, 0x40, 0x17, 0xe5,
+	0xcd, 0x8a, 0x4a, 0x89, 0xf7, 0xf1, 0xf1, 0x14, 0xfa, 0x12, 0x20, 0x7c, 0x88, 0x10, 0x2b, 0x4f,
+	0xbc, 0x4c, 0xd4, 0xab, 0x31, 0x46, 0x97, 0x2b, 0x2f, 0x77, 0x81, 0x84, 0xf2, 0x29, 0x8d, 0xa1,
+	0x11, 0xca, 0x3f, 0x81, 0xb2, 0xd4, 0x0d, 0x12, 0x76, 0x4b, 0xf6, 0x87, 0x52, 0x14, 0xbf, 0xaf,
+	0xa0, 0x97, 0x50, 0x89, 0xf5, 0x79, 0xd0, 0x12, 0x37, 0x7c, 0x6a, 0xf7, 0x27, 0x5d, 0xc8, 0xe7,
+	0x50, 0x96, 0xde, 0xa8, 0x84, 0x06, 0xc9, 0x57, 0xab, 0xf8, 0xce, 0x09, 0xb3, 0x6d, 0x88, 0xe6,
+	0x7e, 0x60, 0xb6, 0x48, 0x09, 0x24, 0xcc, 0x26, 0xfd, 0xbe, 0x0d, 0x4f, 0xa1, 0xa7, 0x50, 0x0a,
+	0x4a, 0x25, 0x94, 0x5e, 0x3a, 0x8d, 0x30, 0x58, 0x60, 0x74, 0x21, 0x40, 0x36, 0xfa, 0xa4, 0x32,
+	0x5e, 0xc3, 0x7c, 0xe2, 0x75, 0x03, 0x5d, 0x8e, 0x2d, 0x21, 0xfa, 0xea, 0x51, 0x47, 0xd2, 0x4a,
+	0x04, 0x0a, 0x4f, 0xa1, 0x7d, 0x38, 0x97, 0xf2, 0x78, 0x81, 0x96, 0xa3, 0xab, 0x4a, 0xbc, 0x2f,
+	0x8c, 0xd0, 0xed, 0x2d, 0x2c, 0xa6, 0x3f, 0x4d, 0x20, 0x9c, 0x58, 0xe9, 0x59, 0xe4, 0xee, 0x01,
+	0x4a, 0x3e, 0x4d, 0xa0, 0x2b, 0xbe, 0xa2, 0xe9, 0xef, 0x08, 0x23, 0xe4, 0x7d, 0x07, 0x0b, 0x69,
+	0x2f, 0x05, 0x68, 0x85, 0xb7, 0xd4, 0x86, 0xbf, 0x4d, 0xd4, 0x3f, 0x19, 0x41, 0x11, 0xc4, 0xc2,
+	0xc7, 0x50, 0xf4, 0x5f, 0x10, 0x44, 0x2c, 0x8b, 0x3d, 0x28, 0x8c, 0x8e, 0x65, 0xe1, 0x53, 0x80,
+	0x1f, 0xfb, 0xe3, 0x6f, 0x03, 0x23, 0xf8, 0x5f, 0x00, 0x84, 0xed, 0x64, 0xc1, 0x9f, 0xe8, 0xe6,
+	0xd7, 0x2f, 0x24, 0xe0, 0x81, 0xf2, 0x9b, 0x50, 0x8d, 0x57, 0xe2, 0xe8, 0x52, 0x18, 0x11, 0x93,
+	0x45, 0x73, 0x3d, 0x51, 0x1f, 0xe3, 0x29, 0xb4, 0x0b, 0xf3, 0x89, 0xa2, 0x5d, 0x78, 0xe9, 0xb0,
+	0x62, 0x7e, 0xc4, 0xa2, 0x76, 0x83, 0x24, 0x2c, 0x21, 0x6d, 0x58, 0x29, 0x3f, 0xf2, 0xaa, 0x29,
+	0xf8, 0x4d, 0x8c, 0x58, 0x53, 0x62, 0x0c, 0xe7, 0x0d, 0x85, 0x6e, 0xad, 0x5f, 0xec, 0x8b, 0xad,
+	0x8d, 0xd5, 0xfe, 0x23, 0xb7, 0xa6, 0xf0, 0x8a, 0xc8, 0xf3, 0x46, 0xbb, 0xce, 0xf5, 0xa5, 0x04,
+	0x27, 0x2b, 0x01, 0xde, 0xd2, 0x34, 0x85, 0x45, 0xbb, 0x2d, 0x80, 0xb0, 0x6b, 0x2c, 0xf6, 0x36,
+	0xd1, 0x46, 0x1e, 0x2f, 0x26, 0x4c, 0x0f, 0x98, 0x2e, 0x91, 0xf4, 0x40, 0xd6, 0x27, 0x5a, 0x8e,
+	0xe1, 0x29, 0xda, 0x72, 0xf2, 0x3b, 0x09, 0x52, 0x7a, 0x20, 0xb3, 0xcc, 0x45, 0x58, 0x5c, 0x96,
+	0x52, 0xcc, 0xf9, 0x44, 0x07, 0x9e, 0x43, 0xb4, 0xde, 0x10, 0xce, 0xf8, 0x64, 0xf7, 0x15, 0x3a,
+	0x9d, 0xdf, 0x72, 0x10, 0x4c, 0xb1, 0x0e, 0x44, 0xfa, 0x74, 0x3e, 0x51, 0x64, 0xba, 0x38, 0x67,
+	0xca, 0x74, 0x8f, 0xa0, 0xe8, 0x17, 0xfa, 0x3e, 0x53, 0xb4, 0x3b, 0x51, 0x3f, 0x17, 0x83, 0xd2,
+	0x6e, 0x80, 0xcf, 0xea, 0xd7, 0xc2, 0x82, 0x35, 0x56, 0x93, 0xd7, 0xcf, 0xc7, 0xa0, 0xc9, 0xbc,
+	0x89, 0x31, 0xcb, 0x79, 0xd3, 0x64, 0x4e, 0xf5, 0x8c, 0xa5, 0x97, 0xc4, 0x23, 0xeb, 0x86, 0x81,
+	0x86, 0x90, 0x0d, 0x67, 0x5f, 0xfb, 0x53, 0x1e, 0x4a, 0x3c, 0x1f, 0xa6, 0x89, 0xe7, 0x03, 0x28,
+	0x05, 0x35, 0xb3, 0xb8, 0xdd, 0xe2, 0x35, 0x74, 0x5d, 0xce, 0xa1, 0xd9, 0x91, 0x78, 0xc4, 0xfa,
+	0xcd, 0x1c, 0x70, 0xc0, 0x3a, 0xcb, 0x43, 0x38, 0x67, 0x24, 0x4e, 0x57, 0xb0, 0x96, 0x82, 0xd2,
+	0x1a, 0xc9, 0x82, 0x27, 0x3d, 0x0b, 0x42, 0x58, 0x78, 0x16, 0xa2, 0xd5, 0xdf, 0x78, 0x31, 0x4f,
+	0x59, 0xfd, 0x10, 0x59, 0x71, 0xbc, 0x9e, 0x1e, 0x61, 0xfc, 0x7b, 0x41, 0xf6, 0x96, 0xb6, 0x86,
+	0x4a, 0xa4, 0x10, 0x62, 0x27, 0x68, 0x03, 0xca, 0x52, 0x4d, 0x27, 0x8e, 0x5e, 0xb2, 0x40, 0xac,
+	0xd7, 0x92, 0x88, 0xc0, 0x63, 0x1e, 0x42, 0x59, 0xaa, 0xcd, 0x85, 0x8c, 0x64, 0xb5, 0x1e, 0xdb,
+	0xa8, 0xfb, 0x0a, 0x7a, 0x0d, 0xb3, 0x91, 0x1a, 0x57, 0xe4, 0x9a, 0x69, 0x65, 0x73, 0xbd, 0x9e,
+	0x86, 0x0a, 0x54, 0x78, 0x00, 0xf9, 0x57, 0x84, 0x96, 0xed, 0x28, 0x68, 0x1c, 0x8c, 0x37, 0xf5,
+	0x4d, 0x00, 0x61, 0xac, 0x28, 0x63, 0x8a, 0x99, 0x9e, 0xf0, 0x40, 0x43, 0x2b, 0x3b, 0x29, 0x5c,
+	0x48, 0x15, 0x78, 0xfd, 0x7c, 0x0c, 0xea, 0xab, 0x76, 0x5f, 0xa1, 0x37, 0x60, 0x58, 0x88, 0x47,
+	0x4e, 0x94, 0x2c, 0xe0, 0x42, 0x02, 0x1e, 0xac, 0xee, 0x09, 0x14, 0x5e, 0x5a, 0x3d, 0x5b, 0x6b,
+	0x79, 0x67, 0x3f, 0x50, 0x1b, 0xd5, 0x3f, 0x7c, 0xb8, 0xa2, 0xfc, 0xf9, 0xc3, 0x15, 0xe5, 0xc7,
+	0x0f, 0x57, 0x94, 0xff, 0xff, 0xeb, 0x95, 0xa9, 0x66, 0x9e, 0xd1, 0x3c, 0xf8, 0xfb, 0x00, 0xe0,
+	0xb9, 0xf9, 0x58, 0x52, 0x32, 0x00, 0x00,
 }
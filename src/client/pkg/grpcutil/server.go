@@ -7,9 +7,11 @@ import (
 	"net"
 	"time"
 
+	"github.com/pachyderm/pachyderm/src/client/pkg/tracing"
 	"github.com/pachyderm/pachyderm/src/client/version"
 	"github.com/pachyderm/pachyderm/src/client/version/versionpb"
 
+	"golang.org/x/net/context"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/keepalive"
 )
@@ -23,6 +25,11 @@ var (
 type ServeOptions struct {
 	Version    *versionpb.Version
 	MaxMsgSize int
+	// UnaryInterceptor and StreamInterceptor, if set, are installed on the
+	// server, e.g. to record metrics for every RPC. They run inside the
+	// tracing interceptors that every server has.
+	UnaryInterceptor  grpc.UnaryServerInterceptor
+	StreamInterceptor grpc.StreamServerInterceptor
 }
 
 // ServeEnv are environment variables for serving.
@@ -43,7 +50,7 @@ func Serve(
 	if serveEnv.GRPCPort == 0 {
 		serveEnv.GRPCPort = 7070
 	}
-	grpcServer := grpc.NewServer(
+	serverOptions := []grpc.ServerOption{
 		grpc.MaxConcurrentStreams(math.MaxUint32),
 		grpc.MaxRecvMsgSize(options.MaxMsgSize),
 		grpc.MaxSendMsgSize(options.MaxMsgSize),
@@ -51,7 +58,20 @@ func Serve(
 			MinTime:             5 * time.Second,
 			PermitWithoutStream: true,
 		}),
+	}
+	unaryInterceptor := grpc.UnaryServerInterceptor(tracing.UnaryServerInterceptor)
+	if options.UnaryInterceptor != nil {
+		unaryInterceptor = chainUnaryServer(unaryInterceptor, options.UnaryInterceptor)
+	}
+	streamInterceptor := grpc.StreamServerInterceptor(tracing.StreamServerInterceptor)
+	if options.StreamInterceptor != nil {
+		streamInterceptor = chainStreamServer(streamInterceptor, options.StreamInterceptor)
+	}
+	serverOptions = append(serverOptions,
+		grpc.UnaryInterceptor(unaryInterceptor),
+		grpc.StreamInterceptor(streamInterceptor),
 	)
+	grpcServer := grpc.NewServer(serverOptions...)
 	registerFunc(grpcServer)
 	if options.Version != nil {
 		versionpb.RegisterAPIServer(grpcServer, version.NewAPIServer(options.Version, version.APIServerOptions{}))
@@ -62,3 +82,22 @@ func Serve(
 	}
 	return grpcServer.Serve(listener)
 }
+
+// chainUnaryServer returns an interceptor that runs 'outer', which calls
+// 'inner', which calls the handler.
+func chainUnaryServer(outer grpc.UnaryServerInterceptor, inner grpc.UnaryServerInterceptor) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		return outer(ctx, req, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+			return inner(ctx, req, info, handler)
+		})
+	}
+}
+
+// chainStreamServer is the streaming equivalent of chainUnaryServer.
+func chainStreamServer(outer grpc.StreamServerInterceptor, inner grpc.StreamServerInterceptor) grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return outer(srv, stream, info, func(srv interface{}, stream grpc.ServerStream) error {
+			return inner(srv, stream, info, handler)
+		})
+	}
+}
@@ -0,0 +1,83 @@
+package tracing
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+)
+
+// ExporterEnvVar is the environment variable read by SetExporterFromEnv.
+const ExporterEnvVar = "PACH_TRACE_EXPORTER"
+
+// SetExporterFromEnv installs the exporter named by $PACH_TRACE_EXPORTER, if
+// it's set. The only built-in exporter is "stderr", which writes each span
+// to stderr as a line of JSON; other exporters can be installed with
+// SetExporter.
+func SetExporterFromEnv() error {
+	switch name := os.Getenv(ExporterEnvVar); name {
+	case "":
+		return nil
+	case "stderr":
+		SetExporter(NewWriterExporter(os.Stderr))
+		return nil
+	default:
+		return fmt.Errorf("unrecognized trace exporter %q (set %s to \"stderr\" or leave it unset)", name, ExporterEnvVar)
+	}
+}
+
+// WriterExporter writes each span to an io.Writer as a line of JSON.
+type WriterExporter struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+// NewWriterExporter creates a WriterExporter that writes to 'w'.
+func NewWriterExporter(w io.Writer) *WriterExporter {
+	return &WriterExporter{w: w}
+}
+
+// Export implements the Exporter interface.
+func (e *WriterExporter) Export(span *Span) {
+	data, err := json.Marshal(span)
+	if err != nil {
+		return
+	}
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.w.Write(append(data, '\n'))
+}
+
+// InMemoryExporter keeps every span it's given in memory. It's meant for
+// tests.
+type InMemoryExporter struct {
+	mu    sync.Mutex
+	spans []*Span
+}
+
+// NewInMemoryExporter creates an empty InMemoryExporter.
+func NewInMemoryExporter() *InMemoryExporter {
+	return &InMemoryExporter{}
+}
+
+// Export implements the Exporter interface.
+func (e *InMemoryExporter) Export(span *Span) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.spans = append(e.spans, span)
+}
+
+// Spans returns the spans exported so far, in the order they finished.
+func (e *InMemoryExporter) Spans() []*Span {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return append([]*Span(nil), e.spans...)
+}
+
+// Reset discards the spans exported so far.
+func (e *InMemoryExporter) Reset() {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.spans = nil
+}
@@ -0,0 +1,144 @@
+package tracing
+
+import (
+	"io"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// InjectSpanContext returns a copy of 'ctx' whose outgoing gRPC metadata
+// carries the context of the span in 'ctx', so that the server's spans are
+// recorded as its children. It returns 'ctx' unchanged if it has no span.
+func InjectSpanContext(ctx context.Context) context.Context {
+	sc, ok := SpanContextFromContext(ctx)
+	if !ok {
+		return ctx
+	}
+	md, _ := metadata.FromOutgoingContext(ctx)
+	md = md.Copy()
+	md[MetadataKey] = []string{sc.String()}
+	return metadata.NewOutgoingContext(ctx, md)
+}
+
+// ExtractSpanContext returns a copy of 'ctx' carrying the span context sent
+// by the client in 'ctx's incoming gRPC metadata, if there is one.
+func ExtractSpanContext(ctx context.Context) context.Context {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok || len(md[MetadataKey]) == 0 {
+		return ctx
+	}
+	sc, err := ParseSpanContext(md[MetadataKey][0])
+	if err != nil {
+		return ctx
+	}
+	return ContextWithSpanContext(ctx, sc)
+}
+
+// parentFromOutgoing makes spans started from 'ctx' children of the span
+// named in its outgoing metadata, if 'ctx' doesn't already have a span of
+// its own. That's the case for requests that pachd forwards on behalf of a
+// client: APIClient.AddMetadata copies the client's metadata (including its
+// span context) into the forwarded request.
+func parentFromOutgoing(ctx context.Context) context.Context {
+	if _, ok := SpanContextFromContext(ctx); ok {
+		return ctx
+	}
+	md, ok := metadata.FromOutgoingContext(ctx)
+	if !ok || len(md[MetadataKey]) == 0 {
+		return ctx
+	}
+	sc, err := ParseSpanContext(md[MetadataKey][0])
+	if err != nil {
+		return ctx
+	}
+	return ContextWithSpanContext(ctx, sc)
+}
+
+// UnaryClientInterceptor records a span for each unary RPC a client makes,
+// and sends its context to the server.
+func UnaryClientInterceptor(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	span, ctx := StartSpan(parentFromOutgoing(ctx), method, "span.kind", "client")
+	err := invoker(InjectSpanContext(ctx), method, req, reply, cc, opts...)
+	span.FinishWithError(err)
+	return err
+}
+
+// StreamClientInterceptor records a span for each streaming RPC a client
+// makes, and sends its context to the server. The span ends when the stream
+// does.
+func StreamClientInterceptor(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	span, ctx := StartSpan(parentFromOutgoing(ctx), method, "span.kind", "client")
+	stream, err := streamer(InjectSpanContext(ctx), desc, cc, method, opts...)
+	if err != nil || span == nil {
+		span.FinishWithError(err)
+		return stream, err
+	}
+	return &clientStream{ClientStream: stream, span: span, serverStreams: desc.ServerStreams}, nil
+}
+
+// clientStream finishes its span when the stream ends, which is when
+// RecvMsg fails (with io.EOF, if the stream ended cleanly) or, for streams
+// where the server only sends one message, when RecvMsg first returns.
+type clientStream struct {
+	grpc.ClientStream
+	span          *Span
+	serverStreams bool
+}
+
+func (s *clientStream) SendMsg(m interface{}) error {
+	err := s.ClientStream.SendMsg(m)
+	if err != nil && err != io.EOF {
+		s.span.FinishWithError(err)
+	}
+	return err
+}
+
+func (s *clientStream) RecvMsg(m interface{}) error {
+	err := s.ClientStream.RecvMsg(m)
+	switch {
+	case err == io.EOF:
+		s.span.Finish()
+	case err != nil:
+		s.span.FinishWithError(err)
+	case !s.serverStreams:
+		s.span.Finish()
+	}
+	return err
+}
+
+// UnaryServerInterceptor records a span for each unary RPC a server handles,
+// as a child of the client's span if the client sent one.
+func UnaryServerInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if !Enabled() {
+		return handler(ctx, req)
+	}
+	span, ctx := StartSpan(ExtractSpanContext(ctx), info.FullMethod, "span.kind", "server")
+	resp, err := handler(ctx, req)
+	span.FinishWithError(err)
+	return resp, err
+}
+
+// StreamServerInterceptor records a span for each streaming RPC a server
+// handles, as a child of the client's span if the client sent one.
+func StreamServerInterceptor(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if !Enabled() {
+		return handler(srv, stream)
+	}
+	span, ctx := StartSpan(ExtractSpanContext(stream.Context()), info.FullMethod, "span.kind", "server")
+	err := handler(srv, &serverStream{ServerStream: stream, ctx: ctx})
+	span.FinishWithError(err)
+	return err
+}
+
+// serverStream overrides the context of a grpc.ServerStream, so that
+// handlers see the span started for them.
+type serverStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *serverStream) Context() context.Context {
+	return s.ctx
+}
@@ -0,0 +1,202 @@
+// Package tracing records spans for the work done on behalf of a request
+// as it crosses pachctl, pachd, its sidecars and workers, so that it's
+// possible to see where the time in e.g. a slow FlushCommit goes.
+//
+// A span's identity (its trace ID and span ID) travels with the request:
+// in-process via its context.Context, and between processes via gRPC
+// metadata (see MetadataKey). Spans are only recorded if an Exporter has
+// been installed with SetExporter; otherwise StartSpan returns a nil *Span,
+// whose methods are no-ops, so instrumented code costs next to nothing when
+// tracing is off.
+package tracing
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"golang.org/x/net/context"
+)
+
+// MetadataKey is the gRPC metadata key that carries the context of the span
+// that sent a request, as "<trace ID>/<span ID>".
+const MetadataKey = "pach-trace"
+
+// Span is a named, timed operation within a trace.
+type Span struct {
+	TraceID  string            `json:"trace_id"`
+	SpanID   string            `json:"span_id"`
+	ParentID string            `json:"parent_id,omitempty"`
+	Name     string            `json:"name"`
+	Start    time.Time         `json:"start"`
+	Duration time.Duration     `json:"duration"`
+	Tags     map[string]string `json:"tags,omitempty"`
+	Error    string            `json:"error,omitempty"`
+
+	mu       sync.Mutex
+	exporter Exporter
+	finished bool
+}
+
+// SpanContext identifies a span, and is all that's needed to start a child
+// of it (possibly in another process).
+type SpanContext struct {
+	TraceID string
+	SpanID  string
+}
+
+// String returns the form of 'sc' that's sent in gRPC metadata.
+func (sc SpanContext) String() string {
+	return sc.TraceID + "/" + sc.SpanID
+}
+
+// ParseSpanContext parses the output of SpanContext.String.
+func ParseSpanContext(s string) (SpanContext, error) {
+	parts := strings.Split(s, "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return SpanContext{}, fmt.Errorf("invalid span context %q", s)
+	}
+	return SpanContext{TraceID: parts[0], SpanID: parts[1]}, nil
+}
+
+// Exporter sends finished spans somewhere they can be inspected. Export is
+// called once per span, from the goroutine that finished it, so it should
+// not block for long.
+type Exporter interface {
+	Export(span *Span)
+}
+
+// exporterHolder lets a nil Exporter be stored in an atomic.Value
+type exporterHolder struct {
+	exporter Exporter
+}
+
+var exporter atomic.Value
+
+// SetExporter installs 'e' as the destination for all spans recorded by this
+// process. Passing nil turns tracing off.
+func SetExporter(e Exporter) {
+	exporter.Store(exporterHolder{e})
+}
+
+func currentExporter() Exporter {
+	holder, _ := exporter.Load().(exporterHolder)
+	return holder.exporter
+}
+
+// Enabled returns true if spans are being recorded.
+func Enabled() bool {
+	return currentExporter() != nil
+}
+
+type spanContextKey struct{}
+
+// ContextWithSpanContext returns a copy of 'ctx' in which spans started
+// will be children of 'sc'.
+func ContextWithSpanContext(ctx context.Context, sc SpanContext) context.Context {
+	return context.WithValue(ctx, spanContextKey{}, sc)
+}
+
+// SpanContextFromContext returns the context of the innermost span in 'ctx',
+// if there is one.
+func SpanContextFromContext(ctx context.Context) (SpanContext, bool) {
+	sc, ok := ctx.Value(spanContextKey{}).(SpanContext)
+	return sc, ok
+}
+
+// StartSpan starts a span called 'name' as a child of the span in 'ctx' (or
+// as the root of a new trace, if there isn't one), and returns it along with
+// a context carrying it. 'tags' are key/value pairs attached to the span.
+// If tracing is off, the returned span is nil and 'ctx' is returned as is.
+func StartSpan(ctx context.Context, name string, tags ...string) (*Span, context.Context) {
+	e := currentExporter()
+	if e == nil {
+		return nil, ctx
+	}
+	span := &Span{
+		SpanID:   newID(8),
+		Name:     name,
+		Start:    time.Now(),
+		exporter: e,
+	}
+	if parent, ok := SpanContextFromContext(ctx); ok {
+		span.TraceID = parent.TraceID
+		span.ParentID = parent.SpanID
+	} else {
+		span.TraceID = newID(16)
+	}
+	for i := 0; i+1 < len(tags); i += 2 {
+		span.SetTag(tags[i], tags[i+1])
+	}
+	return span, ContextWithSpanContext(ctx, span.Context())
+}
+
+// Context returns the context of 's', which can be used to start children
+// of it.
+func (s *Span) Context() SpanContext {
+	if s == nil {
+		return SpanContext{}
+	}
+	return SpanContext{TraceID: s.TraceID, SpanID: s.SpanID}
+}
+
+// SetTag attaches a key/value pair to 's'.
+func (s *Span) SetTag(key string, value string) {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.Tags == nil {
+		s.Tags = make(map[string]string)
+	}
+	s.Tags[key] = value
+}
+
+// SetError records that the operation 's' covers failed with 'err'. It does
+// nothing if 'err' is nil.
+func (s *Span) SetError(err error) {
+	if s == nil || err == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.Error = err.Error()
+}
+
+// Finish ends 's' and exports it. Only the first call has any effect.
+func (s *Span) Finish() {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	if s.finished {
+		s.mu.Unlock()
+		return
+	}
+	s.finished = true
+	s.Duration = time.Since(s.Start)
+	s.mu.Unlock()
+	s.exporter.Export(s)
+}
+
+// FinishWithError records 'err' (if it's not nil) and ends 's'.
+func (s *Span) FinishWithError(err error) {
+	s.SetError(err)
+	s.Finish()
+}
+
+// newID returns a random hex ID made from 'n' bytes.
+func newID(n int) string {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		// crypto/rand only fails if the OS can't provide randomness, in which
+		// case there's little else we could do
+		panic(fmt.Sprintf("could not generate span ID: %v", err))
+	}
+	return hex.EncodeToString(b)
+}
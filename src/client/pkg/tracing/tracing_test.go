@@ -0,0 +1,148 @@
+package tracing
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/pachyderm/pachyderm/src/client/pkg/require"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// withExporter installs an in-memory exporter for the duration of a test.
+func withExporter() *InMemoryExporter {
+	e := NewInMemoryExporter()
+	SetExporter(e)
+	return e
+}
+
+func TestDisabled(t *testing.T) {
+	SetExporter(nil)
+	ctx := context.Background()
+	span, spanCtx := StartSpan(ctx, "op")
+	require.True(t, span == nil)
+	require.Equal(t, ctx, spanCtx)
+	// Methods on a nil span are no-ops
+	span.SetTag("key", "value")
+	span.FinishWithError(fmt.Errorf("error"))
+}
+
+func TestParentChild(t *testing.T) {
+	e := withExporter()
+	defer SetExporter(nil)
+
+	parent, ctx := StartSpan(context.Background(), "parent", "repo", "data")
+	child, _ := StartSpan(ctx, "child")
+	child.FinishWithError(fmt.Errorf("failed"))
+	child.Finish() // only the first Finish exports the span
+	parent.Finish()
+
+	spans := e.Spans()
+	require.Equal(t, 2, len(spans))
+	require.Equal(t, "child", spans[0].Name)
+	require.Equal(t, "parent", spans[1].Name)
+	require.Equal(t, parent.TraceID, child.TraceID)
+	require.Equal(t, parent.SpanID, child.ParentID)
+	require.Equal(t, "", parent.ParentID)
+	require.Equal(t, "failed", child.Error)
+	require.Equal(t, "data", parent.Tags["repo"])
+
+	other, _ := StartSpan(context.Background(), "other")
+	require.NotEqual(t, parent.TraceID, other.TraceID)
+}
+
+func TestParseSpanContext(t *testing.T) {
+	sc := SpanContext{TraceID: "abc", SpanID: "def"}
+	parsed, err := ParseSpanContext(sc.String())
+	require.NoError(t, err)
+	require.Equal(t, sc, parsed)
+	for _, s := range []string{"", "abc", "abc/", "/def", "a/b/c"} {
+		_, err := ParseSpanContext(s)
+		require.YesError(t, err)
+	}
+}
+
+// incoming turns the metadata a client would send from 'ctx' into the
+// metadata a server would receive.
+func incoming(ctx context.Context) context.Context {
+	md, _ := metadata.FromOutgoingContext(ctx)
+	return metadata.NewIncomingContext(context.Background(), md)
+}
+
+func TestUnaryPropagation(t *testing.T) {
+	e := withExporter()
+	defer SetExporter(nil)
+
+	root, ctx := StartSpan(context.Background(), "pachctl")
+	var handlerSpan *Span
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		handlerSpan, _ = StartSpan(ctx, "driver")
+		handlerSpan.Finish()
+		return nil, nil
+	}
+	invoker := func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		_, err := UnaryServerInterceptor(incoming(ctx), req, &grpc.UnaryServerInfo{FullMethod: method}, handler)
+		return err
+	}
+	require.NoError(t, UnaryClientInterceptor(ctx, "/pfs.API/InspectFile", nil, nil, nil, invoker))
+	root.Finish()
+
+	// driver, server, client, pachctl
+	spans := e.Spans()
+	require.Equal(t, 4, len(spans))
+	for i := 0; i < 3; i++ {
+		require.Equal(t, spans[i+1].SpanID, spans[i].ParentID)
+		require.Equal(t, root.TraceID, spans[i].TraceID)
+	}
+	require.Equal(t, handlerSpan, spans[0])
+	require.Equal(t, "server", spans[1].Tags["span.kind"])
+	require.Equal(t, "client", spans[2].Tags["span.kind"])
+	require.Equal(t, "/pfs.API/InspectFile", spans[2].Name)
+}
+
+func TestForwardedPropagation(t *testing.T) {
+	e := withExporter()
+	defer SetExporter(nil)
+
+	// A request that's forwarded (its incoming metadata copied to its
+	// outgoing metadata) continues the original client's trace
+	md := metadata.Pairs(MetadataKey, SpanContext{TraceID: "trace", SpanID: "client"}.String())
+	ctx := metadata.NewOutgoingContext(context.Background(), md)
+	invoker := func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		return nil
+	}
+	require.NoError(t, UnaryClientInterceptor(ctx, "/pps.API/InspectJob", nil, nil, nil, invoker))
+	spans := e.Spans()
+	require.Equal(t, 1, len(spans))
+	require.Equal(t, "trace", spans[0].TraceID)
+	require.Equal(t, "client", spans[0].ParentID)
+}
+
+type fakeServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *fakeServerStream) Context() context.Context {
+	return s.ctx
+}
+
+func TestStreamServerPropagation(t *testing.T) {
+	e := withExporter()
+	defer SetExporter(nil)
+
+	md := metadata.Pairs(MetadataKey, SpanContext{TraceID: "trace", SpanID: "client"}.String())
+	stream := &fakeServerStream{ctx: metadata.NewIncomingContext(context.Background(), md)}
+	handler := func(srv interface{}, stream grpc.ServerStream) error {
+		sc, ok := SpanContextFromContext(stream.Context())
+		require.True(t, ok)
+		require.Equal(t, "trace", sc.TraceID)
+		return fmt.Errorf("stream failed")
+	}
+	require.YesError(t, StreamServerInterceptor(nil, stream, &grpc.StreamServerInfo{FullMethod: "/pfs.API/PutFile"}, handler))
+	spans := e.Spans()
+	require.Equal(t, 1, len(spans))
+	require.Equal(t, "client", spans[0].ParentID)
+	require.Equal(t, "stream failed", spans[0].Error)
+}
@@ -259,6 +259,38 @@ func (c APIClient) StopJob(jobID string) error {
 	return grpcutil.ScrubGRPC(err)
 }
 
+// EgressJob pushes the output of a job to its pipeline's egress URL. Only
+// the files that differ from the last output pushed to the same URL are sent.
+func (c APIClient) EgressJob(jobID string) (*pps.EgressInfo, error) {
+	egressInfo, err := c.PpsAPIClient.EgressJob(
+		c.Ctx(),
+		&pps.EgressJobRequest{
+			Job: NewJob(jobID),
+		},
+	)
+	return egressInfo, grpcutil.ScrubGRPC(err)
+}
+
+// SetAdmissionPolicy replaces the cluster's admission policy, which limits
+// the jobs that run at once. An empty policy lets every job run as soon as
+// it's created.
+func (c APIClient) SetAdmissionPolicy(policy *pps.AdmissionPolicy) error {
+	_, err := c.PpsAPIClient.SetAdmissionPolicy(
+		c.Ctx(),
+		policy,
+	)
+	return grpcutil.ScrubGRPC(err)
+}
+
+// GetAdmissionPolicy returns the cluster's admission policy.
+func (c APIClient) GetAdmissionPolicy() (*pps.AdmissionPolicy, error) {
+	policy, err := c.PpsAPIClient.GetAdmissionPolicy(
+		c.Ctx(),
+		&types.Empty{},
+	)
+	return policy, grpcutil.ScrubGRPC(err)
+}
+
 // RestartDatum restarts a datum that's being processed as part of a job.
 // datumFilter is a slice of strings which are matched against either the Path
 // or Hash of the datum, the order of the strings in datumFilter is irrelevant.
@@ -289,6 +321,25 @@ func (c APIClient) ListDatum(jobID string, pageSize int64, page int64) (*pps.Lis
 	return resp, nil
 }
 
+// ListDatumForInput returns the datums that a pipeline called
+// 'pipelineName' with 'input' would process if it were created now, along
+// with statistics about their sizes. 'pipelineName' may be empty.
+func (c APIClient) ListDatumForInput(pipelineName string, input *pps.Input, pageSize int64, page int64) (*pps.ListDatumForInputResponse, error) {
+	request := &pps.ListDatumForInputRequest{
+		Input:    input,
+		PageSize: pageSize,
+		Page:     page,
+	}
+	if pipelineName != "" {
+		request.Pipeline = NewPipeline(pipelineName)
+	}
+	resp, err := c.PpsAPIClient.ListDatumForInput(c.Ctx(), request)
+	if err != nil {
+		return nil, grpcutil.ScrubGRPC(err)
+	}
+	return resp, nil
+}
+
 // InspectDatum returns info about a single datum
 func (c APIClient) InspectDatum(jobID string, datumID string) (*pps.DatumInfo, error) {
 	datumInfo, err := c.PpsAPIClient.InspectDatum(
@@ -306,6 +357,41 @@ func (c APIClient) InspectDatum(jobID string, datumID string) (*pps.DatumInfo, e
 	return datumInfo, nil
 }
 
+// TraceFile returns the datums, and input files, that produced the file at
+// 'path' in the output of a pipeline, following inputs upstream through at
+// most 'depth' pipelines (or all of them, if 'depth' is 0).
+func (c APIClient) TraceFile(repoName string, commitID string, path string, depth int64) (*pps.FileTrace, error) {
+	fileTrace, err := c.PpsAPIClient.TraceFile(
+		c.Ctx(),
+		&pps.TraceFileRequest{
+			File:  NewFile(repoName, commitID, path),
+			Depth: depth,
+		},
+	)
+	if err != nil {
+		return nil, grpcutil.ScrubGRPC(err)
+	}
+	return fileTrace, nil
+}
+
+// ImpactAnalysis returns how many datums of each pipeline downstream of
+// 'branchName' in 'repoName' would be reprocessed if the files at 'paths'
+// changed.
+func (c APIClient) ImpactAnalysis(repoName string, branchName string, paths []string) ([]*pps.PipelineImpact, error) {
+	resp, err := c.PpsAPIClient.ImpactAnalysis(
+		c.Ctx(),
+		&pps.ImpactAnalysisRequest{
+			Repo:   NewRepo(repoName),
+			Branch: branchName,
+			Paths:  paths,
+		},
+	)
+	if err != nil {
+		return nil, grpcutil.ScrubGRPC(err)
+	}
+	return resp.Pipelines, nil
+}
+
 // LogsIter iterates through log messages returned from pps.GetLogs. Logs can
 // be fetched with 'Next()'. The log message received can be examined with
 // 'Message()', and any errors can be examined with 'Err()'.
@@ -431,6 +517,139 @@ func (c APIClient) InspectPipeline(pipelineName string) (*pps.PipelineInfo, erro
 	return pipelineInfo, grpcutil.ScrubGRPC(err)
 }
 
+// InspectPipelineVersion returns info about a past or present version of a
+// pipeline.
+func (c APIClient) InspectPipelineVersion(pipelineName string, version uint64) (*pps.PipelineInfo, error) {
+	pipelineInfo, err := c.PpsAPIClient.InspectPipeline(
+		c.Ctx(),
+		&pps.InspectPipelineRequest{
+			Pipeline: NewPipeline(pipelineName),
+			Version:  version,
+		},
+	)
+	return pipelineInfo, grpcutil.ScrubGRPC(err)
+}
+
+// InspectPipelineWorkerSpec returns info about a past or present version of
+// a pipeline (or the current one, if version is 0), including the pod
+// template of its workers in WorkerSpec.
+func (c APIClient) InspectPipelineWorkerSpec(pipelineName string, version uint64) (*pps.PipelineInfo, error) {
+	pipelineInfo, err := c.PpsAPIClient.InspectPipeline(
+		c.Ctx(),
+		&pps.InspectPipelineRequest{
+			Pipeline:   NewPipeline(pipelineName),
+			Version:    version,
+			WorkerSpec: true,
+		},
+	)
+	return pipelineInfo, grpcutil.ScrubGRPC(err)
+}
+
+// ListPipelineVersions returns info about every version of a pipeline, oldest
+// first.
+func (c APIClient) ListPipelineVersions(pipelineName string) ([]*pps.PipelineInfo, error) {
+	pipelineInfos, err := c.PpsAPIClient.ListPipelineVersions(
+		c.Ctx(),
+		&pps.ListPipelineVersionsRequest{
+			Pipeline: NewPipeline(pipelineName),
+		},
+	)
+	if err != nil {
+		return nil, grpcutil.ScrubGRPC(err)
+	}
+	return pipelineInfos.PipelineInfo, nil
+}
+
+// RollbackPipeline updates a pipeline to the spec and salt of one of its past
+// versions, so that datums already processed by that version aren't
+// reprocessed.
+func (c APIClient) RollbackPipeline(pipelineName string, version uint64) error {
+	_, err := c.PpsAPIClient.RollbackPipeline(
+		c.Ctx(),
+		&pps.RollbackPipelineRequest{
+			Pipeline: NewPipeline(pipelineName),
+			Version:  version,
+		},
+	)
+	return grpcutil.ScrubGRPC(err)
+}
+
+// ApplyPipelines creates the repos in 'repos' that don't exist, and creates
+// or updates the pipelines in 'pipelines', in dependency order. If prune is
+// set, pipelines that aren't in 'pipelines' are deleted. If dryRun is set,
+// the changes are validated and returned without being applied.
+func (c APIClient) ApplyPipelines(repos []*pfs.CreateRepoRequest, pipelines []*pps.CreatePipelineRequest, prune bool, reprocess bool, dryRun bool) ([]*pps.ApplyAction, error) {
+	response, err := c.PpsAPIClient.ApplyPipelines(
+		c.Ctx(),
+		&pps.ApplyPipelinesRequest{
+			Repos:     repos,
+			Pipelines: pipelines,
+			Prune:     prune,
+			Reprocess: reprocess,
+			DryRun:    dryRun,
+		},
+	)
+	if err != nil {
+		return nil, grpcutil.ScrubGRPC(err)
+	}
+	return response.Actions, nil
+}
+
+// CreateWebhook creates a webhook, which receives the events of the pipeline
+// 'pipelineName', or of every pipeline if it's empty, as JSON posted to 'url'.
+// If 'events' is empty the webhook receives every type of event. If 'secret'
+// is set, each delivery is signed with it.
+func (c APIClient) CreateWebhook(name string, url string, pipelineName string, events []pps.EventType, secret string, update bool) error {
+	var pipeline *pps.Pipeline
+	if pipelineName != "" {
+		pipeline = NewPipeline(pipelineName)
+	}
+	_, err := c.PpsAPIClient.CreateWebhook(
+		c.Ctx(),
+		&pps.CreateWebhookRequest{
+			Webhook: &pps.WebhookInfo{
+				Name:     name,
+				URL:      url,
+				Pipeline: pipeline,
+				Events:   events,
+				Secret:   secret,
+			},
+			Update: update,
+		},
+	)
+	return grpcutil.ScrubGRPC(err)
+}
+
+// ListWebhook returns info about the webhooks of the pipeline 'pipelineName',
+// or about all webhooks if it's empty.
+func (c APIClient) ListWebhook(pipelineName string) ([]*pps.WebhookInfo, error) {
+	var pipeline *pps.Pipeline
+	if pipelineName != "" {
+		pipeline = NewPipeline(pipelineName)
+	}
+	webhookInfos, err := c.PpsAPIClient.ListWebhook(
+		c.Ctx(),
+		&pps.ListWebhookRequest{
+			Pipeline: pipeline,
+		},
+	)
+	if err != nil {
+		return nil, grpcutil.ScrubGRPC(err)
+	}
+	return webhookInfos.WebhookInfo, nil
+}
+
+// DeleteWebhook deletes a webhook.
+func (c APIClient) DeleteWebhook(name string) error {
+	_, err := c.PpsAPIClient.DeleteWebhook(
+		c.Ctx(),
+		&pps.DeleteWebhookRequest{
+			Name: name,
+		},
+	)
+	return grpcutil.ScrubGRPC(err)
+}
+
 // ListPipeline returns info about all pipelines.
 func (c APIClient) ListPipeline() ([]*pps.PipelineInfo, error) {
 	pipelineInfos, err := c.PpsAPIClient.ListPipeline(
@@ -481,9 +700,10 @@ func (c APIClient) StopPipeline(name string) error {
 // RerunPipeline reruns a pipeline over a given set of commits. Exclude and
 // include are filters that either include or exclude the ancestors of the
 // given commits.  A commit is considered the ancestor of itself. The behavior
-// is the same as that of ListCommit.
-func (c APIClient) RerunPipeline(name string, include []*pfs.Commit, exclude []*pfs.Commit) error {
-	_, err := c.PpsAPIClient.RerunPipeline(
+// is the same as that of ListCommit. Every datum of the selected input commit
+// sets is reprocessed, and the jobs created to do so are returned.
+func (c APIClient) RerunPipeline(name string, include []*pfs.Commit, exclude []*pfs.Commit) ([]*pps.JobInfo, error) {
+	jobInfos, err := c.PpsAPIClient.RerunPipeline(
 		c.Ctx(),
 		&pps.RerunPipelineRequest{
 			Pipeline: NewPipeline(name),
@@ -491,7 +711,10 @@ func (c APIClient) RerunPipeline(name string, include []*pfs.Commit, exclude []*
 			Exclude:  exclude,
 		},
 	)
-	return grpcutil.ScrubGRPC(err)
+	if err != nil {
+		return nil, grpcutil.ScrubGRPC(err)
+	}
+	return jobInfos.JobInfo, nil
 }
 
 // CreatePipelineService creates a new pipeline service.
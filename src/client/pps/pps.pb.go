@@ -11,8 +11,13 @@
 		Secret
 		Transform
 		Egress
+		EgressInfo
 		Job
+		Spout
 		Service
+		ServicePort
+		ReadinessProbe
+		ServiceStatus
 		AtomInput
 		CronInput
 		Input
@@ -27,16 +32,31 @@
 		AggregateProcessStats
 		WorkerStatus
 		ResourceSpec
+		Toleration
+		AutoscalingSpec
+		AutoscalingStatus
+		PriorityClass
+		TeamQuota
+		AdmissionPolicy
+		SchedulingSpec
 		JobInfo
+		FailedDatum
+		DatumLineage
+		JobLineage
 		Worker
 		JobInfos
 		Pipeline
 		PipelineInput
 		PipelineInfo
 		PipelineInfos
+		Event
+		WebhookInfo
+		WebhookInfos
+		WebhookDelivery
 		CreateJobRequest
 		InspectJobRequest
 		ListJobRequest
+		EgressJobRequest
 		DeleteJobRequest
 		StopJobRequest
 		GetLogsRequest
@@ -45,13 +65,30 @@
 		InspectDatumRequest
 		ListDatumRequest
 		ListDatumResponse
+		ListDatumForInputRequest
+		DatumSizeBucket
+		ListDatumForInputResponse
 		CreatePipelineRequest
 		InspectPipelineRequest
+		ListPipelineVersionsRequest
+		RollbackPipelineRequest
 		ListPipelineRequest
 		DeletePipelineRequest
 		StartPipelineRequest
 		StopPipelineRequest
 		RerunPipelineRequest
+		ApplyPipelinesRequest
+		ApplyAction
+		ApplyPipelinesResponse
+		CreateWebhookRequest
+		ListWebhookRequest
+		DeleteWebhookRequest
+		TraceFileRequest
+		FileTrace
+		DatumTrace
+		ImpactAnalysisRequest
+		PipelineImpact
+		ImpactAnalysisResponse
 		GarbageCollectRequest
 		GarbageCollectResponse
 */
@@ -84,6 +121,30 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
 
+type EgressState int32
+
+const (
+	EgressState_EGRESS_RUNNING EgressState = 0
+	EgressState_EGRESS_SUCCESS EgressState = 1
+	EgressState_EGRESS_FAILURE EgressState = 2
+)
+
+var EgressState_name = map[int32]string{
+	0: "EGRESS_RUNNING",
+	1: "EGRESS_SUCCESS",
+	2: "EGRESS_FAILURE",
+}
+var EgressState_value = map[string]int32{
+	"EGRESS_RUNNING": 0,
+	"EGRESS_SUCCESS": 1,
+	"EGRESS_FAILURE": 2,
+}
+
+func (x EgressState) String() string {
+	return proto.EnumName(EgressState_name, int32(x))
+}
+func (EgressState) EnumDescriptor() ([]byte, []int) { return fileDescriptorPps, []int{0} }
+
 type JobState int32
 
 const (
@@ -92,6 +153,13 @@ const (
 	JobState_JOB_FAILURE  JobState = 2
 	JobState_JOB_SUCCESS  JobState = 3
 	JobState_JOB_KILLED   JobState = 4
+	// JOB_PARTIAL means that the job finished, but some of its datums failed
+	// and were skipped (see skip_failed_datums)
+	JobState_JOB_PARTIAL JobState = 5
+	// JOB_QUEUED means that the job is waiting for the admission policy to
+	// let it run (see SetAdmissionPolicy). The job's reason says what it's
+	// waiting for.
+	JobState_JOB_QUEUED JobState = 6
 )
 
 var JobState_name = map[int32]string{
@@ -100,6 +168,8 @@ var JobState_name = map[int32]string{
 	2: "JOB_FAILURE",
 	3: "JOB_SUCCESS",
 	4: "JOB_KILLED",
+	5: "JOB_PARTIAL",
+	6: "JOB_QUEUED",
 }
 var JobState_value = map[string]int32{
 	"JOB_STARTING": 0,
@@ -107,12 +177,14 @@ var JobState_value = map[string]int32{
 	"JOB_FAILURE":  2,
 	"JOB_SUCCESS":  3,
 	"JOB_KILLED":   4,
+	"JOB_PARTIAL":  5,
+	"JOB_QUEUED":   6,
 }
 
 func (x JobState) String() string {
 	return proto.EnumName(JobState_name, int32(x))
 }
-func (JobState) EnumDescriptor() ([]byte, []int) { return fileDescriptorPps, []int{0} }
+func (JobState) EnumDescriptor() ([]byte, []int) { return fileDescriptorPps, []int{1} }
 
 type DatumState int32
 
@@ -139,7 +211,7 @@ var DatumState_value = map[string]int32{
 func (x DatumState) String() string {
 	return proto.EnumName(DatumState_name, int32(x))
 }
-func (DatumState) EnumDescriptor() ([]byte, []int) { return fileDescriptorPps, []int{1} }
+func (DatumState) EnumDescriptor() ([]byte, []int) { return fileDescriptorPps, []int{2} }
 
 type WorkerState int32
 
@@ -163,7 +235,7 @@ var WorkerState_value = map[string]int32{
 func (x WorkerState) String() string {
 	return proto.EnumName(WorkerState_name, int32(x))
 }
-func (WorkerState) EnumDescriptor() ([]byte, []int) { return fileDescriptorPps, []int{2} }
+func (WorkerState) EnumDescriptor() ([]byte, []int) { return fileDescriptorPps, []int{3} }
 
 type PipelineState int32
 
@@ -204,7 +276,67 @@ var PipelineState_value = map[string]int32{
 func (x PipelineState) String() string {
 	return proto.EnumName(PipelineState_name, int32(x))
 }
-func (PipelineState) EnumDescriptor() ([]byte, []int) { return fileDescriptorPps, []int{3} }
+func (PipelineState) EnumDescriptor() ([]byte, []int) { return fileDescriptorPps, []int{4} }
+
+type EventType int32
+
+const (
+	EventType_EVENT_JOB_STARTED     EventType = 0
+	EventType_EVENT_JOB_SUCCEEDED   EventType = 1
+	EventType_EVENT_JOB_FAILED      EventType = 2
+	EventType_EVENT_JOB_KILLED      EventType = 3
+	EventType_EVENT_JOB_PARTIAL     EventType = 4
+	EventType_EVENT_PIPELINE_FAILED EventType = 5
+)
+
+var EventType_name = map[int32]string{
+	0: "EVENT_JOB_STARTED",
+	1: "EVENT_JOB_SUCCEEDED",
+	2: "EVENT_JOB_FAILED",
+	3: "EVENT_JOB_KILLED",
+	4: "EVENT_JOB_PARTIAL",
+	5: "EVENT_PIPELINE_FAILED",
+}
+var EventType_value = map[string]int32{
+	"EVENT_JOB_STARTED":     0,
+	"EVENT_JOB_SUCCEEDED":   1,
+	"EVENT_JOB_FAILED":      2,
+	"EVENT_JOB_KILLED":      3,
+	"EVENT_JOB_PARTIAL":     4,
+	"EVENT_PIPELINE_FAILED": 5,
+}
+
+func (x EventType) String() string {
+	return proto.EnumName(EventType_name, int32(x))
+}
+func (EventType) EnumDescriptor() ([]byte, []int) { return fileDescriptorPps, []int{5} }
+
+type ApplyActionType int32
+
+const (
+	ApplyActionType_APPLY_CREATE_REPO     ApplyActionType = 0
+	ApplyActionType_APPLY_CREATE_PIPELINE ApplyActionType = 1
+	ApplyActionType_APPLY_UPDATE_PIPELINE ApplyActionType = 2
+	ApplyActionType_APPLY_DELETE_PIPELINE ApplyActionType = 3
+)
+
+var ApplyActionType_name = map[int32]string{
+	0: "APPLY_CREATE_REPO",
+	1: "APPLY_CREATE_PIPELINE",
+	2: "APPLY_UPDATE_PIPELINE",
+	3: "APPLY_DELETE_PIPELINE",
+}
+var ApplyActionType_value = map[string]int32{
+	"APPLY_CREATE_REPO":     0,
+	"APPLY_CREATE_PIPELINE": 1,
+	"APPLY_UPDATE_PIPELINE": 2,
+	"APPLY_DELETE_PIPELINE": 3,
+}
+
+func (x ApplyActionType) String() string {
+	return proto.EnumName(ApplyActionType_name, int32(x))
+}
+func (ApplyActionType) EnumDescriptor() ([]byte, []int) { return fileDescriptorPps, []int{6} }
 
 type Secret struct {
 	// Name must be the name of the secret in kubernetes.
@@ -320,8 +452,22 @@ func (m *Transform) GetDebug() bool {
 	return false
 }
 
+// Egress pushes the files in each job's output commit to an external
+// system. Only the files that changed since the last commit that was
+// egressed to the same URL are pushed, and deleted files are deleted.
 type Egress struct {
+	// URL is where the output is pushed. Its scheme selects the kind of sink:
+	// - s3://, gcs://, gs://, as:// or wasb:// for an object store
+	// - pfs://host:port/repo/branch for a branch in another Pachyderm cluster
+	// - http:// or https:// for an HTTP endpoint, which receives a PUT for
+	//   each new or changed file and a DELETE for each deleted file
+	// - postgres:// or mysql:// for an SQL table, named by the URL's "table"
+	//   parameter, with the columns (path, line, data). Each line of a file
+	//   is a row, or with "format=json", each JSON value in it.
 	URL string `protobuf:"bytes,1,opt,name=URL,proto3" json:"URL,omitempty"`
+	// retries is the number of times egress is retried before the job fails;
+	// 0 means 3
+	Retries int64 `protobuf:"varint,2,opt,name=retries,proto3" json:"retries,omitempty"`
 }
 
 func (m *Egress) Reset()                    { *m = Egress{} }
@@ -336,6 +482,97 @@ func (m *Egress) GetURL() string {
 	return ""
 }
 
+func (m *Egress) GetRetries() int64 {
+	if m != nil {
+		return m.Retries
+	}
+	return 0
+}
+
+// EgressInfo is the status of a job's egress
+type EgressInfo struct {
+	State  EgressState `protobuf:"varint,1,opt,name=state,proto3,enum=pps.EgressState" json:"state,omitempty"`
+	Reason string      `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	URL    string      `protobuf:"bytes,3,opt,name=URL,proto3" json:"URL,omitempty"`
+	// base_commit is the previously egressed commit that the job's output was
+	// compared to, so only the differences were pushed. It's unset if all of
+	// the output was pushed.
+	BaseCommit   *pfs.Commit                 `protobuf:"bytes,4,opt,name=base_commit,json=baseCommit" json:"base_commit,omitempty"`
+	FilesPushed  int64                       `protobuf:"varint,5,opt,name=files_pushed,json=filesPushed,proto3" json:"files_pushed,omitempty"`
+	FilesDeleted int64                       `protobuf:"varint,6,opt,name=files_deleted,json=filesDeleted,proto3" json:"files_deleted,omitempty"`
+	BytesPushed  uint64                      `protobuf:"varint,7,opt,name=bytes_pushed,json=bytesPushed,proto3" json:"bytes_pushed,omitempty"`
+	Started      *google_protobuf1.Timestamp `protobuf:"bytes,8,opt,name=started" json:"started,omitempty"`
+	Finished     *google_protobuf1.Timestamp `protobuf:"bytes,9,opt,name=finished" json:"finished,omitempty"`
+}
+
+func (m *EgressInfo) Reset()                    { *m = EgressInfo{} }
+func (m *EgressInfo) String() string            { return proto.CompactTextString(m) }
+func (*EgressInfo) ProtoMessage()               {}
+func (*EgressInfo) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{3} }
+
+func (m *EgressInfo) GetState() EgressState {
+	if m != nil {
+		return m.State
+	}
+	return EgressState_EGRESS_RUNNING
+}
+
+func (m *EgressInfo) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *EgressInfo) GetURL() string {
+	if m != nil {
+		return m.URL
+	}
+	return ""
+}
+
+func (m *EgressInfo) GetBaseCommit() *pfs.Commit {
+	if m != nil {
+		return m.BaseCommit
+	}
+	return nil
+}
+
+func (m *EgressInfo) GetFilesPushed() int64 {
+	if m != nil {
+		return m.FilesPushed
+	}
+	return 0
+}
+
+func (m *EgressInfo) GetFilesDeleted() int64 {
+	if m != nil {
+		return m.FilesDeleted
+	}
+	return 0
+}
+
+func (m *EgressInfo) GetBytesPushed() uint64 {
+	if m != nil {
+		return m.BytesPushed
+	}
+	return 0
+}
+
+func (m *EgressInfo) GetStarted() *google_protobuf1.Timestamp {
+	if m != nil {
+		return m.Started
+	}
+	return nil
+}
+
+func (m *EgressInfo) GetFinished() *google_protobuf1.Timestamp {
+	if m != nil {
+		return m.Finished
+	}
+	return nil
+}
+
 type Job struct {
 	ID string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
@@ -343,7 +580,7 @@ type Job struct {
 func (m *Job) Reset()                    { *m = Job{} }
 func (m *Job) String() string            { return proto.CompactTextString(m) }
 func (*Job) ProtoMessage()               {}
-func (*Job) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{3} }
+func (*Job) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{4} }
 
 func (m *Job) GetID() string {
 	if m != nil {
@@ -352,15 +589,81 @@ func (m *Job) GetID() string {
 	return ""
 }
 
+// Spout makes a pipeline a long-running producer with no input. Its user
+// code runs forever, writing tar streams to the named pipe /pfs/out, and the
+// files in each complete stream are written to the output branch.
+type Spout struct {
+	// overwrite, if true, makes each commit replace the files on the output
+	// branch, rather than add to them
+	Overwrite bool `protobuf:"varint,1,opt,name=overwrite,proto3" json:"overwrite,omitempty"`
+	// commit_bytes and commit_interval batch writes into commits. A commit is
+	// finished after the write that brings it to commit_bytes, or once
+	// commit_interval has passed since its first write. If neither is set,
+	// each write is committed on its own.
+	CommitBytes    int64                      `protobuf:"varint,2,opt,name=commit_bytes,json=commitBytes,proto3" json:"commit_bytes,omitempty"`
+	CommitInterval *google_protobuf2.Duration `protobuf:"bytes,3,opt,name=commit_interval,json=commitInterval" json:"commit_interval,omitempty"`
+	// marker, if set, is the name of a file or directory in the tar streams
+	// that records the spout's progress. It isn't written to the output
+	// branch, but to the output repo's "marker" branch, in the same batch as
+	// the output, and it's given back to the user code at /pfs/<marker> when
+	// the spout restarts.
+	Marker string `protobuf:"bytes,4,opt,name=marker,proto3" json:"marker,omitempty"`
+}
+
+func (m *Spout) Reset()                    { *m = Spout{} }
+func (m *Spout) String() string            { return proto.CompactTextString(m) }
+func (*Spout) ProtoMessage()               {}
+func (*Spout) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{5} }
+
+func (m *Spout) GetOverwrite() bool {
+	if m != nil {
+		return m.Overwrite
+	}
+	return false
+}
+
+func (m *Spout) GetCommitBytes() int64 {
+	if m != nil {
+		return m.CommitBytes
+	}
+	return 0
+}
+
+func (m *Spout) GetCommitInterval() *google_protobuf2.Duration {
+	if m != nil {
+		return m.CommitInterval
+	}
+	return nil
+}
+
+func (m *Spout) GetMarker() string {
+	if m != nil {
+		return m.Marker
+	}
+	return ""
+}
+
+// Service makes a pipeline a long-running service, which serves the data of
+// its latest input commits. Each new input commit is rolled out to new pods,
+// which receive traffic once they're ready.
 type Service struct {
-	InternalPort int32 `protobuf:"varint,1,opt,name=internal_port,json=internalPort,proto3" json:"internal_port,omitempty"`
-	ExternalPort int32 `protobuf:"varint,2,opt,name=external_port,json=externalPort,proto3" json:"external_port,omitempty"`
+	// internal_port and external_port expose a single port. They're an
+	// alternative to ports.
+	InternalPort int32          `protobuf:"varint,1,opt,name=internal_port,json=internalPort,proto3" json:"internal_port,omitempty"`
+	ExternalPort int32          `protobuf:"varint,2,opt,name=external_port,json=externalPort,proto3" json:"external_port,omitempty"`
+	Ports        []*ServicePort `protobuf:"bytes,3,rep,name=ports" json:"ports,omitempty"`
+	// type is how the ports are exposed: "NodePort" (the default) or
+	// "ClusterIP"
+	Type string `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	// readiness_probe decides when a new pod may receive traffic. It defaults
+	// to a TCP check of the first port.
+	ReadinessProbe *ReadinessProbe `protobuf:"bytes,5,opt,name=readiness_probe,json=readinessProbe" json:"readiness_probe,omitempty"`
 }
 
 func (m *Service) Reset()                    { *m = Service{} }
 func (m *Service) String() string            { return proto.CompactTextString(m) }
 func (*Service) ProtoMessage()               {}
-func (*Service) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{4} }
+func (*Service) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{6} }
 
 func (m *Service) GetInternalPort() int32 {
 	if m != nil {
@@ -376,6 +679,186 @@ func (m *Service) GetExternalPort() int32 {
 	return 0
 }
 
+func (m *Service) GetPorts() []*ServicePort {
+	if m != nil {
+		return m.Ports
+	}
+	return nil
+}
+
+func (m *Service) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *Service) GetReadinessProbe() *ReadinessProbe {
+	if m != nil {
+		return m.ReadinessProbe
+	}
+	return nil
+}
+
+type ServicePort struct {
+	// name is required if a service has more than one port
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// internal_port is the port that the user code binds to
+	InternalPort int32 `protobuf:"varint,2,opt,name=internal_port,json=internalPort,proto3" json:"internal_port,omitempty"`
+	// external_port is the port on which the service is exposed: the node
+	// port of a NodePort service (chosen by kubernetes if it's 0), or the
+	// port of a ClusterIP service (internal_port if it's 0)
+	ExternalPort int32 `protobuf:"varint,3,opt,name=external_port,json=externalPort,proto3" json:"external_port,omitempty"`
+}
+
+func (m *ServicePort) Reset()                    { *m = ServicePort{} }
+func (m *ServicePort) String() string            { return proto.CompactTextString(m) }
+func (*ServicePort) ProtoMessage()               {}
+func (*ServicePort) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{7} }
+
+func (m *ServicePort) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ServicePort) GetInternalPort() int32 {
+	if m != nil {
+		return m.InternalPort
+	}
+	return 0
+}
+
+func (m *ServicePort) GetExternalPort() int32 {
+	if m != nil {
+		return m.ExternalPort
+	}
+	return 0
+}
+
+// ReadinessProbe is an HTTP GET of http_path, a command (exec), or, if
+// neither is set, a TCP connection to port.
+type ReadinessProbe struct {
+	HttpPath string `protobuf:"bytes,1,opt,name=http_path,json=httpPath,proto3" json:"http_path,omitempty"`
+	// port defaults to the internal port of the service's first port
+	Port         int32                      `protobuf:"varint,2,opt,name=port,proto3" json:"port,omitempty"`
+	Exec         []string                   `protobuf:"bytes,3,rep,name=exec" json:"exec,omitempty"`
+	InitialDelay *google_protobuf2.Duration `protobuf:"bytes,4,opt,name=initial_delay,json=initialDelay" json:"initial_delay,omitempty"`
+	Period       *google_protobuf2.Duration `protobuf:"bytes,5,opt,name=period" json:"period,omitempty"`
+	Timeout      *google_protobuf2.Duration `protobuf:"bytes,6,opt,name=timeout" json:"timeout,omitempty"`
+	// failure_threshold is the number of failed checks after which a ready
+	// pod stops receiving traffic
+	FailureThreshold int32 `protobuf:"varint,7,opt,name=failure_threshold,json=failureThreshold,proto3" json:"failure_threshold,omitempty"`
+}
+
+func (m *ReadinessProbe) Reset()                    { *m = ReadinessProbe{} }
+func (m *ReadinessProbe) String() string            { return proto.CompactTextString(m) }
+func (*ReadinessProbe) ProtoMessage()               {}
+func (*ReadinessProbe) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{8} }
+
+func (m *ReadinessProbe) GetHttpPath() string {
+	if m != nil {
+		return m.HttpPath
+	}
+	return ""
+}
+
+func (m *ReadinessProbe) GetPort() int32 {
+	if m != nil {
+		return m.Port
+	}
+	return 0
+}
+
+func (m *ReadinessProbe) GetExec() []string {
+	if m != nil {
+		return m.Exec
+	}
+	return nil
+}
+
+func (m *ReadinessProbe) GetInitialDelay() *google_protobuf2.Duration {
+	if m != nil {
+		return m.InitialDelay
+	}
+	return nil
+}
+
+func (m *ReadinessProbe) GetPeriod() *google_protobuf2.Duration {
+	if m != nil {
+		return m.Period
+	}
+	return nil
+}
+
+func (m *ReadinessProbe) GetTimeout() *google_protobuf2.Duration {
+	if m != nil {
+		return m.Timeout
+	}
+	return nil
+}
+
+func (m *ReadinessProbe) GetFailureThreshold() int32 {
+	if m != nil {
+		return m.FailureThreshold
+	}
+	return 0
+}
+
+// ServiceStatus is the data that a service pipeline is serving, and that
+// it's rolling out.
+type ServiceStatus struct {
+	// job is the ID of the job whose data is being served, and commits are
+	// its input commits
+	Job     string        `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
+	Commits []*pfs.Commit `protobuf:"bytes,2,rep,name=commits" json:"commits,omitempty"`
+	// pending_job is the job being rolled out, if there is one
+	PendingJob     string                      `protobuf:"bytes,3,opt,name=pending_job,json=pendingJob,proto3" json:"pending_job,omitempty"`
+	PendingCommits []*pfs.Commit               `protobuf:"bytes,4,rep,name=pending_commits,json=pendingCommits" json:"pending_commits,omitempty"`
+	Updated        *google_protobuf1.Timestamp `protobuf:"bytes,5,opt,name=updated" json:"updated,omitempty"`
+}
+
+func (m *ServiceStatus) Reset()                    { *m = ServiceStatus{} }
+func (m *ServiceStatus) String() string            { return proto.CompactTextString(m) }
+func (*ServiceStatus) ProtoMessage()               {}
+func (*ServiceStatus) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{9} }
+
+func (m *ServiceStatus) GetJob() string {
+	if m != nil {
+		return m.Job
+	}
+	return ""
+}
+
+func (m *ServiceStatus) GetCommits() []*pfs.Commit {
+	if m != nil {
+		return m.Commits
+	}
+	return nil
+}
+
+func (m *ServiceStatus) GetPendingJob() string {
+	if m != nil {
+		return m.PendingJob
+	}
+	return ""
+}
+
+func (m *ServiceStatus) GetPendingCommits() []*pfs.Commit {
+	if m != nil {
+		return m.PendingCommits
+	}
+	return nil
+}
+
+func (m *ServiceStatus) GetUpdated() *google_protobuf1.Timestamp {
+	if m != nil {
+		return m.Updated
+	}
+	return nil
+}
+
 type AtomInput struct {
 	Name       string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	Repo       string `protobuf:"bytes,2,opt,name=repo,proto3" json:"repo,omitempty"`
@@ -389,7 +872,7 @@ type AtomInput struct {
 func (m *AtomInput) Reset()                    { *m = AtomInput{} }
 func (m *AtomInput) String() string            { return proto.CompactTextString(m) }
 func (*AtomInput) ProtoMessage()               {}
-func (*AtomInput) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{5} }
+func (*AtomInput) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{10} }
 
 func (m *AtomInput) GetName() string {
 	if m != nil {
@@ -451,7 +934,7 @@ type CronInput struct {
 func (m *CronInput) Reset()                    { *m = CronInput{} }
 func (m *CronInput) String() string            { return proto.CompactTextString(m) }
 func (*CronInput) ProtoMessage()               {}
-func (*CronInput) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{6} }
+func (*CronInput) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{11} }
 
 func (m *CronInput) GetName() string {
 	if m != nil {
@@ -498,7 +981,7 @@ type Input struct {
 func (m *Input) Reset()                    { *m = Input{} }
 func (m *Input) String() string            { return proto.CompactTextString(m) }
 func (*Input) ProtoMessage()               {}
-func (*Input) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{7} }
+func (*Input) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{12} }
 
 func (m *Input) GetAtom() *AtomInput {
 	if m != nil {
@@ -538,7 +1021,7 @@ type JobInput struct {
 func (m *JobInput) Reset()                    { *m = JobInput{} }
 func (m *JobInput) String() string            { return proto.CompactTextString(m) }
 func (*JobInput) ProtoMessage()               {}
-func (*JobInput) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{8} }
+func (*JobInput) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{13} }
 
 func (m *JobInput) GetName() string {
 	if m != nil {
@@ -588,7 +1071,7 @@ type ParallelismSpec struct {
 func (m *ParallelismSpec) Reset()                    { *m = ParallelismSpec{} }
 func (m *ParallelismSpec) String() string            { return proto.CompactTextString(m) }
 func (*ParallelismSpec) ProtoMessage()               {}
-func (*ParallelismSpec) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{9} }
+func (*ParallelismSpec) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{14} }
 
 func (m *ParallelismSpec) GetConstant() uint64 {
 	if m != nil {
@@ -614,7 +1097,7 @@ type InputFile struct {
 func (m *InputFile) Reset()                    { *m = InputFile{} }
 func (m *InputFile) String() string            { return proto.CompactTextString(m) }
 func (*InputFile) ProtoMessage()               {}
-func (*InputFile) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{10} }
+func (*InputFile) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{15} }
 
 func (m *InputFile) GetPath() string {
 	if m != nil {
@@ -639,7 +1122,7 @@ type Datum struct {
 func (m *Datum) Reset()                    { *m = Datum{} }
 func (m *Datum) String() string            { return proto.CompactTextString(m) }
 func (*Datum) ProtoMessage()               {}
-func (*Datum) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{11} }
+func (*Datum) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{16} }
 
 func (m *Datum) GetID() string {
 	if m != nil {
@@ -666,7 +1149,7 @@ type DatumInfo struct {
 func (m *DatumInfo) Reset()                    { *m = DatumInfo{} }
 func (m *DatumInfo) String() string            { return proto.CompactTextString(m) }
 func (*DatumInfo) ProtoMessage()               {}
-func (*DatumInfo) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{12} }
+func (*DatumInfo) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{17} }
 
 func (m *DatumInfo) GetDatum() *Datum {
 	if m != nil {
@@ -710,7 +1193,7 @@ type DatumInfos struct {
 func (m *DatumInfos) Reset()                    { *m = DatumInfos{} }
 func (m *DatumInfos) String() string            { return proto.CompactTextString(m) }
 func (*DatumInfos) ProtoMessage()               {}
-func (*DatumInfos) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{13} }
+func (*DatumInfos) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{18} }
 
 func (m *DatumInfos) GetDatumInfo() []*DatumInfo {
 	if m != nil {
@@ -730,7 +1213,7 @@ type Aggregate struct {
 func (m *Aggregate) Reset()                    { *m = Aggregate{} }
 func (m *Aggregate) String() string            { return proto.CompactTextString(m) }
 func (*Aggregate) ProtoMessage()               {}
-func (*Aggregate) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{14} }
+func (*Aggregate) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{19} }
 
 func (m *Aggregate) GetCount() int64 {
 	if m != nil {
@@ -778,7 +1261,7 @@ type ProcessStats struct {
 func (m *ProcessStats) Reset()                    { *m = ProcessStats{} }
 func (m *ProcessStats) String() string            { return proto.CompactTextString(m) }
 func (*ProcessStats) ProtoMessage()               {}
-func (*ProcessStats) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{15} }
+func (*ProcessStats) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{20} }
 
 func (m *ProcessStats) GetDownloadTime() *google_protobuf2.Duration {
 	if m != nil {
@@ -826,7 +1309,7 @@ type AggregateProcessStats struct {
 func (m *AggregateProcessStats) Reset()                    { *m = AggregateProcessStats{} }
 func (m *AggregateProcessStats) String() string            { return proto.CompactTextString(m) }
 func (*AggregateProcessStats) ProtoMessage()               {}
-func (*AggregateProcessStats) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{16} }
+func (*AggregateProcessStats) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{21} }
 
 func (m *AggregateProcessStats) GetDownloadTime() *Aggregate {
 	if m != nil {
@@ -876,7 +1359,7 @@ type WorkerStatus struct {
 func (m *WorkerStatus) Reset()                    { *m = WorkerStatus{} }
 func (m *WorkerStatus) String() string            { return proto.CompactTextString(m) }
 func (*WorkerStatus) ProtoMessage()               {}
-func (*WorkerStatus) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{17} }
+func (*WorkerStatus) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{22} }
 
 func (m *WorkerStatus) GetWorkerID() string {
 	if m != nil {
@@ -936,7 +1419,7 @@ type ResourceSpec struct {
 func (m *ResourceSpec) Reset()                    { *m = ResourceSpec{} }
 func (m *ResourceSpec) String() string            { return proto.CompactTextString(m) }
 func (*ResourceSpec) ProtoMessage()               {}
-func (*ResourceSpec) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{18} }
+func (*ResourceSpec) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{23} }
 
 func (m *ResourceSpec) GetCpu() float32 {
 	if m != nil {
@@ -959,72 +1442,435 @@ func (m *ResourceSpec) GetGpu() int64 {
 	return 0
 }
 
-type JobInfo struct {
-	Job             *Job                        `protobuf:"bytes,1,opt,name=job" json:"job,omitempty"`
-	Transform       *Transform                  `protobuf:"bytes,2,opt,name=transform" json:"transform,omitempty"`
-	Pipeline        *Pipeline                   `protobuf:"bytes,3,opt,name=pipeline" json:"pipeline,omitempty"`
-	PipelineVersion uint64                      `protobuf:"varint,13,opt,name=pipeline_version,json=pipelineVersion,proto3" json:"pipeline_version,omitempty"`
-	ParallelismSpec *ParallelismSpec            `protobuf:"bytes,12,opt,name=parallelism_spec,json=parallelismSpec" json:"parallelism_spec,omitempty"`
-	Egress          *Egress                     `protobuf:"bytes,15,opt,name=egress" json:"egress,omitempty"`
-	ParentJob       *Job                        `protobuf:"bytes,6,opt,name=parent_job,json=parentJob" json:"parent_job,omitempty"`
-	Started         *google_protobuf1.Timestamp `protobuf:"bytes,7,opt,name=started" json:"started,omitempty"`
-	Finished        *google_protobuf1.Timestamp `protobuf:"bytes,8,opt,name=finished" json:"finished,omitempty"`
-	OutputCommit    *pfs.Commit                 `protobuf:"bytes,9,opt,name=output_commit,json=outputCommit" json:"output_commit,omitempty"`
-	State           JobState                    `protobuf:"varint,10,opt,name=state,proto3,enum=pps.JobState" json:"state,omitempty"`
-	Reason          string                      `protobuf:"bytes,35,opt,name=reason,proto3" json:"reason,omitempty"`
-	Service         *Service                    `protobuf:"bytes,14,opt,name=service" json:"service,omitempty"`
-	OutputRepo      *pfs.Repo                   `protobuf:"bytes,18,opt,name=output_repo,json=outputRepo" json:"output_repo,omitempty"`
-	OutputBranch    string                      `protobuf:"bytes,17,opt,name=output_branch,json=outputBranch,proto3" json:"output_branch,omitempty"`
-	Restart         uint64                      `protobuf:"varint,20,opt,name=restart,proto3" json:"restart,omitempty"`
-	DataProcessed   int64                       `protobuf:"varint,22,opt,name=data_processed,json=dataProcessed,proto3" json:"data_processed,omitempty"`
-	DataSkipped     int64                       `protobuf:"varint,30,opt,name=data_skipped,json=dataSkipped,proto3" json:"data_skipped,omitempty"`
-	DataTotal       int64                       `protobuf:"varint,23,opt,name=data_total,json=dataTotal,proto3" json:"data_total,omitempty"`
-	Stats           *ProcessStats               `protobuf:"bytes,31,opt,name=stats" json:"stats,omitempty"`
-	WorkerStatus    []*WorkerStatus             `protobuf:"bytes,24,rep,name=worker_status,json=workerStatus" json:"worker_status,omitempty"`
-	ResourceSpec    *ResourceSpec               `protobuf:"bytes,25,opt,name=resource_spec,json=resourceSpec" json:"resource_spec,omitempty"`
-	Input           *Input                      `protobuf:"bytes,26,opt,name=input" json:"input,omitempty"`
-	NewBranch       *pfs.BranchInfo             `protobuf:"bytes,27,opt,name=new_branch,json=newBranch" json:"new_branch,omitempty"`
-	Incremental     bool                        `protobuf:"varint,28,opt,name=incremental,proto3" json:"incremental,omitempty"`
-	StatsCommit     *pfs.Commit                 `protobuf:"bytes,29,opt,name=stats_commit,json=statsCommit" json:"stats_commit,omitempty"`
-	EnableStats     bool                        `protobuf:"varint,32,opt,name=enable_stats,json=enableStats,proto3" json:"enable_stats,omitempty"`
-	Salt            string                      `protobuf:"bytes,33,opt,name=salt,proto3" json:"salt,omitempty"`
-	Batch           bool                        `protobuf:"varint,34,opt,name=batch,proto3" json:"batch,omitempty"`
+// Toleration allows a pipeline's workers to be scheduled on nodes with
+// matching taints (see Kubernetes' Toleration).
+type Toleration struct {
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// operator is "Equal" (the default) or "Exists"
+	Operator string `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"`
+	Value    string `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	// effect is "NoSchedule", "PreferNoSchedule", or empty to match all effects
+	Effect string `protobuf:"bytes,4,opt,name=effect,proto3" json:"effect,omitempty"`
 }
 
-func (m *JobInfo) Reset()                    { *m = JobInfo{} }
-func (m *JobInfo) String() string            { return proto.CompactTextString(m) }
-func (*JobInfo) ProtoMessage()               {}
-func (*JobInfo) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{19} }
+func (m *Toleration) Reset()                    { *m = Toleration{} }
+func (m *Toleration) String() string            { return proto.CompactTextString(m) }
+func (*Toleration) ProtoMessage()               {}
+func (*Toleration) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{24} }
 
-func (m *JobInfo) GetJob() *Job {
+func (m *Toleration) GetKey() string {
 	if m != nil {
-		return m.Job
+		return m.Key
 	}
-	return nil
+	return ""
 }
 
-func (m *JobInfo) GetTransform() *Transform {
+func (m *Toleration) GetOperator() string {
 	if m != nil {
-		return m.Transform
+		return m.Operator
 	}
-	return nil
+	return ""
 }
 
-func (m *JobInfo) GetPipeline() *Pipeline {
+func (m *Toleration) GetValue() string {
 	if m != nil {
-		return m.Pipeline
+		return m.Value
 	}
-	return nil
+	return ""
 }
 
-func (m *JobInfo) GetPipelineVersion() uint64 {
+func (m *Toleration) GetEffect() string {
 	if m != nil {
-		return m.PipelineVersion
+		return m.Effect
 	}
-	return 0
+	return ""
 }
 
-func (m *JobInfo) GetParallelismSpec() *ParallelismSpec {
+// AutoscalingSpec makes a pipeline scale its workers between min_workers and
+// max_workers with the amount of work waiting for them, in place of its
+// parallelism_spec.
+type AutoscalingSpec struct {
+	// min_workers is the fewest workers the pipeline runs (default 1)
+	MinWorkers int64 `protobuf:"varint,1,opt,name=min_workers,json=minWorkers,proto3" json:"min_workers,omitempty"`
+	MaxWorkers int64 `protobuf:"varint,2,opt,name=max_workers,json=maxWorkers,proto3" json:"max_workers,omitempty"`
+	// target_queue_size is the number of pending datums per worker that the
+	// pipeline is scaled for (default max_queue_size)
+	TargetQueueSize int64 `protobuf:"varint,3,opt,name=target_queue_size,json=targetQueueSize,proto3" json:"target_queue_size,omitempty"`
+	// target_drain_time, if set, adds workers until the pending datums are
+	// expected to be processed within it, given the recent datum latency
+	TargetDrainTime *google_protobuf2.Duration `protobuf:"bytes,4,opt,name=target_drain_time,json=targetDrainTime" json:"target_drain_time,omitempty"`
+	// scale_up_cooldown is the least time between scaling the pipeline and
+	// scaling it up (default 30s)
+	ScaleUpCooldown *google_protobuf2.Duration `protobuf:"bytes,5,opt,name=scale_up_cooldown,json=scaleUpCooldown" json:"scale_up_cooldown,omitempty"`
+	// scale_down_cooldown is the least time between scaling the pipeline and
+	// scaling it down (default 5m)
+	ScaleDownCooldown *google_protobuf2.Duration `protobuf:"bytes,6,opt,name=scale_down_cooldown,json=scaleDownCooldown" json:"scale_down_cooldown,omitempty"`
+}
+
+func (m *AutoscalingSpec) Reset()                    { *m = AutoscalingSpec{} }
+func (m *AutoscalingSpec) String() string            { return proto.CompactTextString(m) }
+func (*AutoscalingSpec) ProtoMessage()               {}
+func (*AutoscalingSpec) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{25} }
+
+func (m *AutoscalingSpec) GetMinWorkers() int64 {
+	if m != nil {
+		return m.MinWorkers
+	}
+	return 0
+}
+
+func (m *AutoscalingSpec) GetMaxWorkers() int64 {
+	if m != nil {
+		return m.MaxWorkers
+	}
+	return 0
+}
+
+func (m *AutoscalingSpec) GetTargetQueueSize() int64 {
+	if m != nil {
+		return m.TargetQueueSize
+	}
+	return 0
+}
+
+func (m *AutoscalingSpec) GetTargetDrainTime() *google_protobuf2.Duration {
+	if m != nil {
+		return m.TargetDrainTime
+	}
+	return nil
+}
+
+func (m *AutoscalingSpec) GetScaleUpCooldown() *google_protobuf2.Duration {
+	if m != nil {
+		return m.ScaleUpCooldown
+	}
+	return nil
+}
+
+func (m *AutoscalingSpec) GetScaleDownCooldown() *google_protobuf2.Duration {
+	if m != nil {
+		return m.ScaleDownCooldown
+	}
+	return nil
+}
+
+// AutoscalingStatus is the latest decision of a pipeline's autoscaler, and
+// what it was based on.
+type AutoscalingStatus struct {
+	CurrentWorkers int64 `protobuf:"varint,1,opt,name=current_workers,json=currentWorkers,proto3" json:"current_workers,omitempty"`
+	DesiredWorkers int64 `protobuf:"varint,2,opt,name=desired_workers,json=desiredWorkers,proto3" json:"desired_workers,omitempty"`
+	// pending_datums is the number of datums of the running job that haven't
+	// finished
+	PendingDatums int64 `protobuf:"varint,3,opt,name=pending_datums,json=pendingDatums,proto3" json:"pending_datums,omitempty"`
+	// queued_datums is the sum of the queue sizes of the workers
+	QueuedDatums int64 `protobuf:"varint,4,opt,name=queued_datums,json=queuedDatums,proto3" json:"queued_datums,omitempty"`
+	// datum_latency is a moving average of the time recent datums have taken
+	DatumLatency *google_protobuf2.Duration  `protobuf:"bytes,5,opt,name=datum_latency,json=datumLatency" json:"datum_latency,omitempty"`
+	Reason       string                      `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+	LastScaled   *google_protobuf1.Timestamp `protobuf:"bytes,7,opt,name=last_scaled,json=lastScaled" json:"last_scaled,omitempty"`
+	Updated      *google_protobuf1.Timestamp `protobuf:"bytes,8,opt,name=updated" json:"updated,omitempty"`
+}
+
+func (m *AutoscalingStatus) Reset()                    { *m = AutoscalingStatus{} }
+func (m *AutoscalingStatus) String() string            { return proto.CompactTextString(m) }
+func (*AutoscalingStatus) ProtoMessage()               {}
+func (*AutoscalingStatus) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{26} }
+
+func (m *AutoscalingStatus) GetCurrentWorkers() int64 {
+	if m != nil {
+		return m.CurrentWorkers
+	}
+	return 0
+}
+
+func (m *AutoscalingStatus) GetDesiredWorkers() int64 {
+	if m != nil {
+		return m.DesiredWorkers
+	}
+	return 0
+}
+
+func (m *AutoscalingStatus) GetPendingDatums() int64 {
+	if m != nil {
+		return m.PendingDatums
+	}
+	return 0
+}
+
+func (m *AutoscalingStatus) GetQueuedDatums() int64 {
+	if m != nil {
+		return m.QueuedDatums
+	}
+	return 0
+}
+
+func (m *AutoscalingStatus) GetDatumLatency() *google_protobuf2.Duration {
+	if m != nil {
+		return m.DatumLatency
+	}
+	return nil
+}
+
+func (m *AutoscalingStatus) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *AutoscalingStatus) GetLastScaled() *google_protobuf1.Timestamp {
+	if m != nil {
+		return m.LastScaled
+	}
+	return nil
+}
+
+func (m *AutoscalingStatus) GetUpdated() *google_protobuf1.Timestamp {
+	if m != nil {
+		return m.Updated
+	}
+	return nil
+}
+
+// PriorityClass is a tier of pipelines. Queued jobs of higher priority
+// classes are admitted first.
+type PriorityClass struct {
+	Name     string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Priority int64  `protobuf:"varint,2,opt,name=priority,proto3" json:"priority,omitempty"`
+	// max_running_jobs and max_workers limit the jobs of the class that run at
+	// once, and the workers of their pipelines. 0 means no limit.
+	MaxRunningJobs int64 `protobuf:"varint,3,opt,name=max_running_jobs,json=maxRunningJobs,proto3" json:"max_running_jobs,omitempty"`
+	MaxWorkers     int64 `protobuf:"varint,4,opt,name=max_workers,json=maxWorkers,proto3" json:"max_workers,omitempty"`
+}
+
+func (m *PriorityClass) Reset()                    { *m = PriorityClass{} }
+func (m *PriorityClass) String() string            { return proto.CompactTextString(m) }
+func (*PriorityClass) ProtoMessage()               {}
+func (*PriorityClass) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{27} }
+
+func (m *PriorityClass) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *PriorityClass) GetPriority() int64 {
+	if m != nil {
+		return m.Priority
+	}
+	return 0
+}
+
+func (m *PriorityClass) GetMaxRunningJobs() int64 {
+	if m != nil {
+		return m.MaxRunningJobs
+	}
+	return 0
+}
+
+func (m *PriorityClass) GetMaxWorkers() int64 {
+	if m != nil {
+		return m.MaxWorkers
+	}
+	return 0
+}
+
+// TeamQuota limits the jobs of the pipelines of a team that run at once.
+type TeamQuota struct {
+	Team           string `protobuf:"bytes,1,opt,name=team,proto3" json:"team,omitempty"`
+	MaxRunningJobs int64  `protobuf:"varint,2,opt,name=max_running_jobs,json=maxRunningJobs,proto3" json:"max_running_jobs,omitempty"`
+	MaxWorkers     int64  `protobuf:"varint,3,opt,name=max_workers,json=maxWorkers,proto3" json:"max_workers,omitempty"`
+}
+
+func (m *TeamQuota) Reset()                    { *m = TeamQuota{} }
+func (m *TeamQuota) String() string            { return proto.CompactTextString(m) }
+func (*TeamQuota) ProtoMessage()               {}
+func (*TeamQuota) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{28} }
+
+func (m *TeamQuota) GetTeam() string {
+	if m != nil {
+		return m.Team
+	}
+	return ""
+}
+
+func (m *TeamQuota) GetMaxRunningJobs() int64 {
+	if m != nil {
+		return m.MaxRunningJobs
+	}
+	return 0
+}
+
+func (m *TeamQuota) GetMaxWorkers() int64 {
+	if m != nil {
+		return m.MaxWorkers
+	}
+	return 0
+}
+
+// AdmissionPolicy limits the jobs that run at once across the cluster. Jobs
+// that would exceed a limit are queued, and admitted in priority order,
+// sharing capacity fairly between the teams of a priority class.
+type AdmissionPolicy struct {
+	PriorityClasses []*PriorityClass `protobuf:"bytes,1,rep,name=priority_classes,json=priorityClasses" json:"priority_classes,omitempty"`
+	Teams           []*TeamQuota     `protobuf:"bytes,2,rep,name=teams" json:"teams,omitempty"`
+	// default_priority_class is the class of pipelines that don't set one
+	DefaultPriorityClass string `protobuf:"bytes,3,opt,name=default_priority_class,json=defaultPriorityClass,proto3" json:"default_priority_class,omitempty"`
+	// max_running_jobs limits the jobs running across the cluster. 0 means no
+	// limit.
+	MaxRunningJobs int64 `protobuf:"varint,4,opt,name=max_running_jobs,json=maxRunningJobs,proto3" json:"max_running_jobs,omitempty"`
+}
+
+func (m *AdmissionPolicy) Reset()                    { *m = AdmissionPolicy{} }
+func (m *AdmissionPolicy) String() string            { return proto.CompactTextString(m) }
+func (*AdmissionPolicy) ProtoMessage()               {}
+func (*AdmissionPolicy) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{29} }
+
+func (m *AdmissionPolicy) GetPriorityClasses() []*PriorityClass {
+	if m != nil {
+		return m.PriorityClasses
+	}
+	return nil
+}
+
+func (m *AdmissionPolicy) GetTeams() []*TeamQuota {
+	if m != nil {
+		return m.Teams
+	}
+	return nil
+}
+
+func (m *AdmissionPolicy) GetDefaultPriorityClass() string {
+	if m != nil {
+		return m.DefaultPriorityClass
+	}
+	return ""
+}
+
+func (m *AdmissionPolicy) GetMaxRunningJobs() int64 {
+	if m != nil {
+		return m.MaxRunningJobs
+	}
+	return 0
+}
+
+// SchedulingSpec controls which nodes a pipeline's workers may run on.
+type SchedulingSpec struct {
+	NodeSelector map[string]string `protobuf:"bytes,1,rep,name=node_selector,json=nodeSelector" json:"node_selector,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Tolerations  []*Toleration     `protobuf:"bytes,2,rep,name=tolerations" json:"tolerations,omitempty"`
+	// affinity is a Kubernetes Affinity, as JSON
+	Affinity string `protobuf:"bytes,3,opt,name=affinity,proto3" json:"affinity,omitempty"`
+}
+
+func (m *SchedulingSpec) Reset()                    { *m = SchedulingSpec{} }
+func (m *SchedulingSpec) String() string            { return proto.CompactTextString(m) }
+func (*SchedulingSpec) ProtoMessage()               {}
+func (*SchedulingSpec) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{30} }
+
+func (m *SchedulingSpec) GetNodeSelector() map[string]string {
+	if m != nil {
+		return m.NodeSelector
+	}
+	return nil
+}
+
+func (m *SchedulingSpec) GetTolerations() []*Toleration {
+	if m != nil {
+		return m.Tolerations
+	}
+	return nil
+}
+
+func (m *SchedulingSpec) GetAffinity() string {
+	if m != nil {
+		return m.Affinity
+	}
+	return ""
+}
+
+type JobInfo struct {
+	Job             *Job                        `protobuf:"bytes,1,opt,name=job" json:"job,omitempty"`
+	Transform       *Transform                  `protobuf:"bytes,2,opt,name=transform" json:"transform,omitempty"`
+	Pipeline        *Pipeline                   `protobuf:"bytes,3,opt,name=pipeline" json:"pipeline,omitempty"`
+	PipelineVersion uint64                      `protobuf:"varint,13,opt,name=pipeline_version,json=pipelineVersion,proto3" json:"pipeline_version,omitempty"`
+	ParallelismSpec *ParallelismSpec            `protobuf:"bytes,12,opt,name=parallelism_spec,json=parallelismSpec" json:"parallelism_spec,omitempty"`
+	Egress          *Egress                     `protobuf:"bytes,15,opt,name=egress" json:"egress,omitempty"`
+	ParentJob       *Job                        `protobuf:"bytes,6,opt,name=parent_job,json=parentJob" json:"parent_job,omitempty"`
+	Started         *google_protobuf1.Timestamp `protobuf:"bytes,7,opt,name=started" json:"started,omitempty"`
+	Finished        *google_protobuf1.Timestamp `protobuf:"bytes,8,opt,name=finished" json:"finished,omitempty"`
+	OutputCommit    *pfs.Commit                 `protobuf:"bytes,9,opt,name=output_commit,json=outputCommit" json:"output_commit,omitempty"`
+	State           JobState                    `protobuf:"varint,10,opt,name=state,proto3,enum=pps.JobState" json:"state,omitempty"`
+	Reason          string                      `protobuf:"bytes,35,opt,name=reason,proto3" json:"reason,omitempty"`
+	Service         *Service                    `protobuf:"bytes,14,opt,name=service" json:"service,omitempty"`
+	OutputRepo      *pfs.Repo                   `protobuf:"bytes,18,opt,name=output_repo,json=outputRepo" json:"output_repo,omitempty"`
+	OutputBranch    string                      `protobuf:"bytes,17,opt,name=output_branch,json=outputBranch,proto3" json:"output_branch,omitempty"`
+	Restart         uint64                      `protobuf:"varint,20,opt,name=restart,proto3" json:"restart,omitempty"`
+	DataProcessed   int64                       `protobuf:"varint,22,opt,name=data_processed,json=dataProcessed,proto3" json:"data_processed,omitempty"`
+	DataSkipped     int64                       `protobuf:"varint,30,opt,name=data_skipped,json=dataSkipped,proto3" json:"data_skipped,omitempty"`
+	DataTotal       int64                       `protobuf:"varint,23,opt,name=data_total,json=dataTotal,proto3" json:"data_total,omitempty"`
+	Stats           *ProcessStats               `protobuf:"bytes,31,opt,name=stats" json:"stats,omitempty"`
+	WorkerStatus    []*WorkerStatus             `protobuf:"bytes,24,rep,name=worker_status,json=workerStatus" json:"worker_status,omitempty"`
+	ResourceSpec    *ResourceSpec               `protobuf:"bytes,25,opt,name=resource_spec,json=resourceSpec" json:"resource_spec,omitempty"`
+	Input           *Input                      `protobuf:"bytes,26,opt,name=input" json:"input,omitempty"`
+	NewBranch       *pfs.BranchInfo             `protobuf:"bytes,27,opt,name=new_branch,json=newBranch" json:"new_branch,omitempty"`
+	Incremental     bool                        `protobuf:"varint,28,opt,name=incremental,proto3" json:"incremental,omitempty"`
+	StatsCommit     *pfs.Commit                 `protobuf:"bytes,29,opt,name=stats_commit,json=statsCommit" json:"stats_commit,omitempty"`
+	EnableStats     bool                        `protobuf:"varint,32,opt,name=enable_stats,json=enableStats,proto3" json:"enable_stats,omitempty"`
+	Salt            string                      `protobuf:"bytes,33,opt,name=salt,proto3" json:"salt,omitempty"`
+	Batch           bool                        `protobuf:"varint,34,opt,name=batch,proto3" json:"batch,omitempty"`
+	// rerun is true if the job was created by RerunPipeline. Rerun jobs
+	// reprocess every datum, ignoring the outputs of previous jobs.
+	Rerun bool `protobuf:"varint,36,opt,name=rerun,proto3" json:"rerun,omitempty"`
+	// failed_datums are the datums that failed and were skipped, if the
+	// pipeline skips failed datums
+	FailedDatums []*FailedDatum `protobuf:"bytes,37,rep,name=failed_datums,json=failedDatums" json:"failed_datums,omitempty"`
+	DataFailed   int64          `protobuf:"varint,38,opt,name=data_failed,json=dataFailed,proto3" json:"data_failed,omitempty"`
+	// lineage is the object holding the job's JobLineage, which records the
+	// datums that wrote each output file. It's unset for jobs that haven't
+	// finished successfully.
+	Lineage *pfs.Object `protobuf:"bytes,39,opt,name=lineage" json:"lineage,omitempty"`
+	// egress_info is the status of the job's egress, if it has one
+	EgressInfo *EgressInfo `protobuf:"bytes,40,opt,name=egress_info,json=egressInfo" json:"egress_info,omitempty"`
+	// priority_class and team are copied from the job's pipeline
+	PriorityClass string `protobuf:"bytes,41,opt,name=priority_class,json=priorityClass,proto3" json:"priority_class,omitempty"`
+	Team          string `protobuf:"bytes,42,opt,name=team,proto3" json:"team,omitempty"`
+}
+
+func (m *JobInfo) Reset()                    { *m = JobInfo{} }
+func (m *JobInfo) String() string            { return proto.CompactTextString(m) }
+func (*JobInfo) ProtoMessage()               {}
+func (*JobInfo) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{31} }
+
+func (m *JobInfo) GetJob() *Job {
+	if m != nil {
+		return m.Job
+	}
+	return nil
+}
+
+func (m *JobInfo) GetTransform() *Transform {
+	if m != nil {
+		return m.Transform
+	}
+	return nil
+}
+
+func (m *JobInfo) GetPipeline() *Pipeline {
+	if m != nil {
+		return m.Pipeline
+	}
+	return nil
+}
+
+func (m *JobInfo) GetPipelineVersion() uint64 {
+	if m != nil {
+		return m.PipelineVersion
+	}
+	return 0
+}
+
+func (m *JobInfo) GetParallelismSpec() *ParallelismSpec {
 	if m != nil {
 		return m.ParallelismSpec
 	}
@@ -1199,54 +2045,200 @@ func (m *JobInfo) GetBatch() bool {
 	return false
 }
 
-type Worker struct {
-	Name  string      `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	State WorkerState `protobuf:"varint,2,opt,name=state,proto3,enum=pps.WorkerState" json:"state,omitempty"`
+func (m *JobInfo) GetRerun() bool {
+	if m != nil {
+		return m.Rerun
+	}
+	return false
 }
 
-func (m *Worker) Reset()                    { *m = Worker{} }
-func (m *Worker) String() string            { return proto.CompactTextString(m) }
-func (*Worker) ProtoMessage()               {}
-func (*Worker) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{20} }
-
-func (m *Worker) GetName() string {
+func (m *JobInfo) GetFailedDatums() []*FailedDatum {
 	if m != nil {
-		return m.Name
+		return m.FailedDatums
 	}
-	return ""
+	return nil
 }
 
-func (m *Worker) GetState() WorkerState {
+func (m *JobInfo) GetDataFailed() int64 {
 	if m != nil {
-		return m.State
+		return m.DataFailed
 	}
-	return WorkerState_POD_RUNNING
+	return 0
 }
 
-type JobInfos struct {
-	JobInfo []*JobInfo `protobuf:"bytes,1,rep,name=job_info,json=jobInfo" json:"job_info,omitempty"`
+func (m *JobInfo) GetLineage() *pfs.Object {
+	if m != nil {
+		return m.Lineage
+	}
+	return nil
 }
 
-func (m *JobInfos) Reset()                    { *m = JobInfos{} }
-func (m *JobInfos) String() string            { return proto.CompactTextString(m) }
-func (*JobInfos) ProtoMessage()               {}
-func (*JobInfos) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{21} }
-
-func (m *JobInfos) GetJobInfo() []*JobInfo {
+func (m *JobInfo) GetEgressInfo() *EgressInfo {
 	if m != nil {
-		return m.JobInfo
+		return m.EgressInfo
 	}
 	return nil
 }
 
-type Pipeline struct {
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+func (m *JobInfo) GetPriorityClass() string {
+	if m != nil {
+		return m.PriorityClass
+	}
+	return ""
 }
 
-func (m *Pipeline) Reset()                    { *m = Pipeline{} }
-func (m *Pipeline) String() string            { return proto.CompactTextString(m) }
-func (*Pipeline) ProtoMessage()               {}
-func (*Pipeline) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{22} }
+func (m *JobInfo) GetTeam() string {
+	if m != nil {
+		return m.Team
+	}
+	return ""
+}
+
+// FailedDatum is a datum that failed all of its tries
+type FailedDatum struct {
+	DatumID string `protobuf:"bytes,1,opt,name=datum_id,json=datumId,proto3" json:"datum_id,omitempty"`
+	// exit_code is the exit code of the user code in the last try, or -1 if
+	// it didn't exit (e.g. it was killed after timing out)
+	ExitCode int32  `protobuf:"varint,2,opt,name=exit_code,json=exitCode,proto3" json:"exit_code,omitempty"`
+	Reason   string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	// logs is the file holding the datum's logs in the job's stats commit, if
+	// stats are enabled. Otherwise, use GetLogs with the datum's ID.
+	Logs *pfs.File `protobuf:"bytes,4,opt,name=logs" json:"logs,omitempty"`
+}
+
+func (m *FailedDatum) Reset()                    { *m = FailedDatum{} }
+func (m *FailedDatum) String() string            { return proto.CompactTextString(m) }
+func (*FailedDatum) ProtoMessage()               {}
+func (*FailedDatum) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{32} }
+
+func (m *FailedDatum) GetDatumID() string {
+	if m != nil {
+		return m.DatumID
+	}
+	return ""
+}
+
+func (m *FailedDatum) GetExitCode() int32 {
+	if m != nil {
+		return m.ExitCode
+	}
+	return 0
+}
+
+func (m *FailedDatum) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *FailedDatum) GetLogs() *pfs.File {
+	if m != nil {
+		return m.Logs
+	}
+	return nil
+}
+
+// DatumLineage records the input files a datum read and the output files
+// it wrote
+type DatumLineage struct {
+	DatumID string      `protobuf:"bytes,1,opt,name=datum_id,json=datumId,proto3" json:"datum_id,omitempty"`
+	Inputs  []*pfs.File `protobuf:"bytes,2,rep,name=inputs" json:"inputs,omitempty"`
+	// outputs are paths in the job's output commit
+	Outputs []string `protobuf:"bytes,3,rep,name=outputs" json:"outputs,omitempty"`
+}
+
+func (m *DatumLineage) Reset()                    { *m = DatumLineage{} }
+func (m *DatumLineage) String() string            { return proto.CompactTextString(m) }
+func (*DatumLineage) ProtoMessage()               {}
+func (*DatumLineage) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{33} }
+
+func (m *DatumLineage) GetDatumID() string {
+	if m != nil {
+		return m.DatumID
+	}
+	return ""
+}
+
+func (m *DatumLineage) GetInputs() []*pfs.File {
+	if m != nil {
+		return m.Inputs
+	}
+	return nil
+}
+
+func (m *DatumLineage) GetOutputs() []string {
+	if m != nil {
+		return m.Outputs
+	}
+	return nil
+}
+
+// JobLineage is the lineage of every datum in a job
+type JobLineage struct {
+	Datums []*DatumLineage `protobuf:"bytes,1,rep,name=datums" json:"datums,omitempty"`
+}
+
+func (m *JobLineage) Reset()                    { *m = JobLineage{} }
+func (m *JobLineage) String() string            { return proto.CompactTextString(m) }
+func (*JobLineage) ProtoMessage()               {}
+func (*JobLineage) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{34} }
+
+func (m *JobLineage) GetDatums() []*DatumLineage {
+	if m != nil {
+		return m.Datums
+	}
+	return nil
+}
+
+type Worker struct {
+	Name  string      `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	State WorkerState `protobuf:"varint,2,opt,name=state,proto3,enum=pps.WorkerState" json:"state,omitempty"`
+}
+
+func (m *Worker) Reset()                    { *m = Worker{} }
+func (m *Worker) String() string            { return proto.CompactTextString(m) }
+func (*Worker) ProtoMessage()               {}
+func (*Worker) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{35} }
+
+func (m *Worker) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Worker) GetState() WorkerState {
+	if m != nil {
+		return m.State
+	}
+	return WorkerState_POD_RUNNING
+}
+
+type JobInfos struct {
+	JobInfo []*JobInfo `protobuf:"bytes,1,rep,name=job_info,json=jobInfo" json:"job_info,omitempty"`
+}
+
+func (m *JobInfos) Reset()                    { *m = JobInfos{} }
+func (m *JobInfos) String() string            { return proto.CompactTextString(m) }
+func (*JobInfos) ProtoMessage()               {}
+func (*JobInfos) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{36} }
+
+func (m *JobInfos) GetJobInfo() []*JobInfo {
+	if m != nil {
+		return m.JobInfo
+	}
+	return nil
+}
+
+type Pipeline struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (m *Pipeline) Reset()                    { *m = Pipeline{} }
+func (m *Pipeline) String() string            { return proto.CompactTextString(m) }
+func (*Pipeline) ProtoMessage()               {}
+func (*Pipeline) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{37} }
 
 func (m *Pipeline) GetName() string {
 	if m != nil {
@@ -1267,7 +2259,7 @@ type PipelineInput struct {
 func (m *PipelineInput) Reset()                    { *m = PipelineInput{} }
 func (m *PipelineInput) String() string            { return proto.CompactTextString(m) }
 func (*PipelineInput) ProtoMessage()               {}
-func (*PipelineInput) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{23} }
+func (*PipelineInput) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{38} }
 
 func (m *PipelineInput) GetName() string {
 	if m != nil {
@@ -1336,12 +2328,29 @@ type PipelineInfo struct {
 	Reason             string                      `protobuf:"bytes,28,opt,name=reason,proto3" json:"reason,omitempty"`
 	MaxQueueSize       int64                       `protobuf:"varint,29,opt,name=max_queue_size,json=maxQueueSize,proto3" json:"max_queue_size,omitempty"`
 	Service            *Service                    `protobuf:"bytes,30,opt,name=service" json:"service,omitempty"`
+	DatumTries         int64                       `protobuf:"varint,31,opt,name=datum_tries,json=datumTries,proto3" json:"datum_tries,omitempty"`
+	DatumRetryBackoff  *google_protobuf2.Duration  `protobuf:"bytes,32,opt,name=datum_retry_backoff,json=datumRetryBackoff" json:"datum_retry_backoff,omitempty"`
+	DatumTimeout       *google_protobuf2.Duration  `protobuf:"bytes,33,opt,name=datum_timeout,json=datumTimeout" json:"datum_timeout,omitempty"`
+	JobTimeout         *google_protobuf2.Duration  `protobuf:"bytes,34,opt,name=job_timeout,json=jobTimeout" json:"job_timeout,omitempty"`
+	SkipFailedDatums   bool                        `protobuf:"varint,35,opt,name=skip_failed_datums,json=skipFailedDatums,proto3" json:"skip_failed_datums,omitempty"`
+	ResourceLimits     *ResourceSpec               `protobuf:"bytes,36,opt,name=resource_limits,json=resourceLimits" json:"resource_limits,omitempty"`
+	SchedulingSpec     *SchedulingSpec             `protobuf:"bytes,37,opt,name=scheduling_spec,json=schedulingSpec" json:"scheduling_spec,omitempty"`
+	PodPatch           string                      `protobuf:"bytes,38,opt,name=pod_patch,json=podPatch,proto3" json:"pod_patch,omitempty"`
+	// worker_spec is the pod template of the pipeline's workers, as JSON. It's
+	// only set by InspectPipeline, if it's requested.
+	WorkerSpec        string             `protobuf:"bytes,39,opt,name=worker_spec,json=workerSpec,proto3" json:"worker_spec,omitempty"`
+	AutoscalingSpec   *AutoscalingSpec   `protobuf:"bytes,40,opt,name=autoscaling_spec,json=autoscalingSpec" json:"autoscaling_spec,omitempty"`
+	AutoscalingStatus *AutoscalingStatus `protobuf:"bytes,41,opt,name=autoscaling_status,json=autoscalingStatus" json:"autoscaling_status,omitempty"`
+	PriorityClass     string             `protobuf:"bytes,42,opt,name=priority_class,json=priorityClass,proto3" json:"priority_class,omitempty"`
+	Team              string             `protobuf:"bytes,43,opt,name=team,proto3" json:"team,omitempty"`
+	Spout             *Spout             `protobuf:"bytes,44,opt,name=spout" json:"spout,omitempty"`
+	ServiceStatus     *ServiceStatus     `protobuf:"bytes,45,opt,name=service_status,json=serviceStatus" json:"service_status,omitempty"`
 }
 
 func (m *PipelineInfo) Reset()                    { *m = PipelineInfo{} }
 func (m *PipelineInfo) String() string            { return proto.CompactTextString(m) }
 func (*PipelineInfo) ProtoMessage()               {}
-func (*PipelineInfo) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{24} }
+func (*PipelineInfo) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{39} }
 
 func (m *PipelineInfo) GetID() string {
 	if m != nil {
@@ -1511,224 +2520,554 @@ func (m *PipelineInfo) GetService() *Service {
 	return nil
 }
 
-type PipelineInfos struct {
-	PipelineInfo []*PipelineInfo `protobuf:"bytes,1,rep,name=pipeline_info,json=pipelineInfo" json:"pipeline_info,omitempty"`
+func (m *PipelineInfo) GetDatumTries() int64 {
+	if m != nil {
+		return m.DatumTries
+	}
+	return 0
 }
 
-func (m *PipelineInfos) Reset()                    { *m = PipelineInfos{} }
-func (m *PipelineInfos) String() string            { return proto.CompactTextString(m) }
-func (*PipelineInfos) ProtoMessage()               {}
-func (*PipelineInfos) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{25} }
+func (m *PipelineInfo) GetDatumRetryBackoff() *google_protobuf2.Duration {
+	if m != nil {
+		return m.DatumRetryBackoff
+	}
+	return nil
+}
 
-func (m *PipelineInfos) GetPipelineInfo() []*PipelineInfo {
+func (m *PipelineInfo) GetDatumTimeout() *google_protobuf2.Duration {
 	if m != nil {
-		return m.PipelineInfo
+		return m.DatumTimeout
 	}
 	return nil
 }
 
-type CreateJobRequest struct {
-	Transform       *Transform       `protobuf:"bytes,1,opt,name=transform" json:"transform,omitempty"`
-	Pipeline        *Pipeline        `protobuf:"bytes,2,opt,name=pipeline" json:"pipeline,omitempty"`
-	PipelineVersion uint64           `protobuf:"varint,10,opt,name=pipeline_version,json=pipelineVersion,proto3" json:"pipeline_version,omitempty"`
-	ParallelismSpec *ParallelismSpec `protobuf:"bytes,7,opt,name=parallelism_spec,json=parallelismSpec" json:"parallelism_spec,omitempty"`
-	Egress          *Egress          `protobuf:"bytes,9,opt,name=egress" json:"egress,omitempty"`
-	// When service is defined, we create a long running job
-	// by using a k8s RC and Service instead of a k8s Job
-	Service      *Service        `protobuf:"bytes,8,opt,name=service" json:"service,omitempty"`
-	OutputRepo   *pfs.Repo       `protobuf:"bytes,12,opt,name=output_repo,json=outputRepo" json:"output_repo,omitempty"`
-	OutputBranch string          `protobuf:"bytes,11,opt,name=output_branch,json=outputBranch,proto3" json:"output_branch,omitempty"`
-	ParentJob    *Job            `protobuf:"bytes,13,opt,name=parent_job,json=parentJob" json:"parent_job,omitempty"`
-	ResourceSpec *ResourceSpec   `protobuf:"bytes,14,opt,name=resource_spec,json=resourceSpec" json:"resource_spec,omitempty"`
-	Input        *Input          `protobuf:"bytes,15,opt,name=input" json:"input,omitempty"`
-	NewBranch    *pfs.BranchInfo `protobuf:"bytes,16,opt,name=new_branch,json=newBranch" json:"new_branch,omitempty"`
-	Incremental  bool            `protobuf:"varint,17,opt,name=incremental,proto3" json:"incremental,omitempty"`
-	EnableStats  bool            `protobuf:"varint,18,opt,name=enable_stats,json=enableStats,proto3" json:"enable_stats,omitempty"`
-	Salt         string          `protobuf:"bytes,19,opt,name=salt,proto3" json:"salt,omitempty"`
-	Batch        bool            `protobuf:"varint,20,opt,name=batch,proto3" json:"batch,omitempty"`
+func (m *PipelineInfo) GetJobTimeout() *google_protobuf2.Duration {
+	if m != nil {
+		return m.JobTimeout
+	}
+	return nil
 }
 
-func (m *CreateJobRequest) Reset()                    { *m = CreateJobRequest{} }
-func (m *CreateJobRequest) String() string            { return proto.CompactTextString(m) }
-func (*CreateJobRequest) ProtoMessage()               {}
-func (*CreateJobRequest) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{26} }
+func (m *PipelineInfo) GetSkipFailedDatums() bool {
+	if m != nil {
+		return m.SkipFailedDatums
+	}
+	return false
+}
 
-func (m *CreateJobRequest) GetTransform() *Transform {
+func (m *PipelineInfo) GetResourceLimits() *ResourceSpec {
 	if m != nil {
-		return m.Transform
+		return m.ResourceLimits
 	}
 	return nil
 }
 
-func (m *CreateJobRequest) GetPipeline() *Pipeline {
+func (m *PipelineInfo) GetSchedulingSpec() *SchedulingSpec {
 	if m != nil {
-		return m.Pipeline
+		return m.SchedulingSpec
 	}
 	return nil
 }
 
-func (m *CreateJobRequest) GetPipelineVersion() uint64 {
+func (m *PipelineInfo) GetPodPatch() string {
 	if m != nil {
-		return m.PipelineVersion
+		return m.PodPatch
 	}
-	return 0
+	return ""
 }
 
-func (m *CreateJobRequest) GetParallelismSpec() *ParallelismSpec {
+func (m *PipelineInfo) GetWorkerSpec() string {
 	if m != nil {
-		return m.ParallelismSpec
+		return m.WorkerSpec
 	}
-	return nil
+	return ""
 }
 
-func (m *CreateJobRequest) GetEgress() *Egress {
+func (m *PipelineInfo) GetAutoscalingSpec() *AutoscalingSpec {
 	if m != nil {
-		return m.Egress
+		return m.AutoscalingSpec
 	}
 	return nil
 }
 
-func (m *CreateJobRequest) GetService() *Service {
+func (m *PipelineInfo) GetAutoscalingStatus() *AutoscalingStatus {
 	if m != nil {
-		return m.Service
+		return m.AutoscalingStatus
 	}
 	return nil
 }
 
-func (m *CreateJobRequest) GetOutputRepo() *pfs.Repo {
+func (m *PipelineInfo) GetPriorityClass() string {
 	if m != nil {
-		return m.OutputRepo
+		return m.PriorityClass
 	}
-	return nil
+	return ""
 }
 
-func (m *CreateJobRequest) GetOutputBranch() string {
+func (m *PipelineInfo) GetTeam() string {
 	if m != nil {
-		return m.OutputBranch
+		return m.Team
 	}
 	return ""
 }
 
-func (m *CreateJobRequest) GetParentJob() *Job {
+func (m *PipelineInfo) GetSpout() *Spout {
 	if m != nil {
-		return m.ParentJob
+		return m.Spout
 	}
 	return nil
 }
 
-func (m *CreateJobRequest) GetResourceSpec() *ResourceSpec {
+func (m *PipelineInfo) GetServiceStatus() *ServiceStatus {
 	if m != nil {
-		return m.ResourceSpec
+		return m.ServiceStatus
 	}
 	return nil
 }
 
-func (m *CreateJobRequest) GetInput() *Input {
+type PipelineInfos struct {
+	PipelineInfo []*PipelineInfo `protobuf:"bytes,1,rep,name=pipeline_info,json=pipelineInfo" json:"pipeline_info,omitempty"`
+}
+
+func (m *PipelineInfos) Reset()                    { *m = PipelineInfos{} }
+func (m *PipelineInfos) String() string            { return proto.CompactTextString(m) }
+func (*PipelineInfos) ProtoMessage()               {}
+func (*PipelineInfos) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{40} }
+
+func (m *PipelineInfos) GetPipelineInfo() []*PipelineInfo {
 	if m != nil {
-		return m.Input
+		return m.PipelineInfo
 	}
 	return nil
 }
 
-func (m *CreateJobRequest) GetNewBranch() *pfs.BranchInfo {
+// Event is a job or pipeline state change, which is delivered to webhooks
+type Event struct {
+	ID       string                      `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type     EventType                   `protobuf:"varint,2,opt,name=type,proto3,enum=pps.EventType" json:"type,omitempty"`
+	Time     *google_protobuf1.Timestamp `protobuf:"bytes,3,opt,name=time" json:"time,omitempty"`
+	Pipeline *Pipeline                   `protobuf:"bytes,4,opt,name=pipeline" json:"pipeline,omitempty"`
+	// job is set for job events
+	Job           *Job          `protobuf:"bytes,5,opt,name=job" json:"job,omitempty"`
+	JobState      JobState      `protobuf:"varint,6,opt,name=job_state,json=jobState,proto3,enum=pps.JobState" json:"job_state,omitempty"`
+	PipelineState PipelineState `protobuf:"varint,7,opt,name=pipeline_state,json=pipelineState,proto3,enum=pps.PipelineState" json:"pipeline_state,omitempty"`
+	Reason        string        `protobuf:"bytes,8,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *Event) Reset()                    { *m = Event{} }
+func (m *Event) String() string            { return proto.CompactTextString(m) }
+func (*Event) ProtoMessage()               {}
+func (*Event) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{41} }
+
+func (m *Event) GetID() string {
 	if m != nil {
-		return m.NewBranch
+		return m.ID
 	}
-	return nil
+	return ""
 }
 
-func (m *CreateJobRequest) GetIncremental() bool {
+func (m *Event) GetType() EventType {
 	if m != nil {
-		return m.Incremental
+		return m.Type
 	}
-	return false
+	return EventType_EVENT_JOB_STARTED
 }
 
-func (m *CreateJobRequest) GetEnableStats() bool {
+func (m *Event) GetTime() *google_protobuf1.Timestamp {
 	if m != nil {
-		return m.EnableStats
+		return m.Time
 	}
-	return false
+	return nil
 }
 
-func (m *CreateJobRequest) GetSalt() string {
+func (m *Event) GetPipeline() *Pipeline {
 	if m != nil {
-		return m.Salt
+		return m.Pipeline
 	}
-	return ""
+	return nil
 }
 
-func (m *CreateJobRequest) GetBatch() bool {
+func (m *Event) GetJob() *Job {
 	if m != nil {
-		return m.Batch
+		return m.Job
 	}
-	return false
+	return nil
 }
 
-type InspectJobRequest struct {
-	Job        *Job `protobuf:"bytes,1,opt,name=job" json:"job,omitempty"`
-	BlockState bool `protobuf:"varint,2,opt,name=block_state,json=blockState,proto3" json:"block_state,omitempty"`
+func (m *Event) GetJobState() JobState {
+	if m != nil {
+		return m.JobState
+	}
+	return JobState_JOB_STARTING
 }
 
-func (m *InspectJobRequest) Reset()                    { *m = InspectJobRequest{} }
-func (m *InspectJobRequest) String() string            { return proto.CompactTextString(m) }
-func (*InspectJobRequest) ProtoMessage()               {}
-func (*InspectJobRequest) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{27} }
-
-func (m *InspectJobRequest) GetJob() *Job {
+func (m *Event) GetPipelineState() PipelineState {
 	if m != nil {
-		return m.Job
+		return m.PipelineState
 	}
-	return nil
+	return PipelineState_PIPELINE_STARTING
 }
 
-func (m *InspectJobRequest) GetBlockState() bool {
+func (m *Event) GetReason() string {
 	if m != nil {
-		return m.BlockState
+		return m.Reason
 	}
-	return false
+	return ""
 }
 
-type ListJobRequest struct {
-	Pipeline     *Pipeline     `protobuf:"bytes,1,opt,name=pipeline" json:"pipeline,omitempty"`
-	InputCommit  []*pfs.Commit `protobuf:"bytes,2,rep,name=input_commit,json=inputCommit" json:"input_commit,omitempty"`
-	OutputCommit *pfs.Commit   `protobuf:"bytes,3,opt,name=output_commit,json=outputCommit" json:"output_commit,omitempty"`
+type WebhookInfo struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	URL  string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	// pipeline, if set, limits the webhook to the events of one pipeline;
+	// otherwise it receives the events of every pipeline
+	Pipeline *Pipeline `protobuf:"bytes,3,opt,name=pipeline" json:"pipeline,omitempty"`
+	// events are the types of event the webhook receives; all types if empty
+	Events []EventType `protobuf:"varint,4,rep,packed,name=events,enum=pps.EventType" json:"events,omitempty"`
+	// secret is the key of the HMAC-SHA256 signature of each delivery, which is
+	// sent in the X-Pachyderm-Signature header. It's never returned.
+	Secret string `protobuf:"bytes,5,opt,name=secret,proto3" json:"secret,omitempty"`
+	// recent_error is the error of the most recent delivery that was given up
+	RecentError string `protobuf:"bytes,6,opt,name=recent_error,json=recentError,proto3" json:"recent_error,omitempty"`
+}
+
+func (m *WebhookInfo) Reset()                    { *m = WebhookInfo{} }
+func (m *WebhookInfo) String() string            { return proto.CompactTextString(m) }
+func (*WebhookInfo) ProtoMessage()               {}
+func (*WebhookInfo) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{42} }
+
+func (m *WebhookInfo) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
 }
 
-func (m *ListJobRequest) Reset()                    { *m = ListJobRequest{} }
-func (m *ListJobRequest) String() string            { return proto.CompactTextString(m) }
-func (*ListJobRequest) ProtoMessage()               {}
-func (*ListJobRequest) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{28} }
-
-func (m *ListJobRequest) GetPipeline() *Pipeline {
+func (m *WebhookInfo) GetURL() string {
 	if m != nil {
-		return m.Pipeline
+		return m.URL
 	}
-	return nil
+	return ""
 }
 
-func (m *ListJobRequest) GetInputCommit() []*pfs.Commit {
+func (m *WebhookInfo) GetPipeline() *Pipeline {
 	if m != nil {
-		return m.InputCommit
+		return m.Pipeline
 	}
 	return nil
 }
 
-func (m *ListJobRequest) GetOutputCommit() *pfs.Commit {
+func (m *WebhookInfo) GetEvents() []EventType {
 	if m != nil {
-		return m.OutputCommit
+		return m.Events
 	}
 	return nil
 }
 
-type DeleteJobRequest struct {
-	Job *Job `protobuf:"bytes,1,opt,name=job" json:"job,omitempty"`
+func (m *WebhookInfo) GetSecret() string {
+	if m != nil {
+		return m.Secret
+	}
+	return ""
 }
 
-func (m *DeleteJobRequest) Reset()                    { *m = DeleteJobRequest{} }
+func (m *WebhookInfo) GetRecentError() string {
+	if m != nil {
+		return m.RecentError
+	}
+	return ""
+}
+
+type WebhookInfos struct {
+	WebhookInfo []*WebhookInfo `protobuf:"bytes,1,rep,name=webhook_info,json=webhookInfo" json:"webhook_info,omitempty"`
+}
+
+func (m *WebhookInfos) Reset()                    { *m = WebhookInfos{} }
+func (m *WebhookInfos) String() string            { return proto.CompactTextString(m) }
+func (*WebhookInfos) ProtoMessage()               {}
+func (*WebhookInfos) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{43} }
+
+func (m *WebhookInfos) GetWebhookInfo() []*WebhookInfo {
+	if m != nil {
+		return m.WebhookInfo
+	}
+	return nil
+}
+
+// WebhookDelivery is a pending delivery of an event to a webhook
+type WebhookDelivery struct {
+	Webhook     string                      `protobuf:"bytes,1,opt,name=webhook,proto3" json:"webhook,omitempty"`
+	Event       *Event                      `protobuf:"bytes,2,opt,name=event" json:"event,omitempty"`
+	Attempts    int64                       `protobuf:"varint,3,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	NextAttempt *google_protobuf1.Timestamp `protobuf:"bytes,4,opt,name=next_attempt,json=nextAttempt" json:"next_attempt,omitempty"`
+	LastError   string                      `protobuf:"bytes,5,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+}
+
+func (m *WebhookDelivery) Reset()                    { *m = WebhookDelivery{} }
+func (m *WebhookDelivery) String() string            { return proto.CompactTextString(m) }
+func (*WebhookDelivery) ProtoMessage()               {}
+func (*WebhookDelivery) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{44} }
+
+func (m *WebhookDelivery) GetWebhook() string {
+	if m != nil {
+		return m.Webhook
+	}
+	return ""
+}
+
+func (m *WebhookDelivery) GetEvent() *Event {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
+
+func (m *WebhookDelivery) GetAttempts() int64 {
+	if m != nil {
+		return m.Attempts
+	}
+	return 0
+}
+
+func (m *WebhookDelivery) GetNextAttempt() *google_protobuf1.Timestamp {
+	if m != nil {
+		return m.NextAttempt
+	}
+	return nil
+}
+
+func (m *WebhookDelivery) GetLastError() string {
+	if m != nil {
+		return m.LastError
+	}
+	return ""
+}
+
+type CreateJobRequest struct {
+	Transform       *Transform       `protobuf:"bytes,1,opt,name=transform" json:"transform,omitempty"`
+	Pipeline        *Pipeline        `protobuf:"bytes,2,opt,name=pipeline" json:"pipeline,omitempty"`
+	PipelineVersion uint64           `protobuf:"varint,10,opt,name=pipeline_version,json=pipelineVersion,proto3" json:"pipeline_version,omitempty"`
+	ParallelismSpec *ParallelismSpec `protobuf:"bytes,7,opt,name=parallelism_spec,json=parallelismSpec" json:"parallelism_spec,omitempty"`
+	Egress          *Egress          `protobuf:"bytes,9,opt,name=egress" json:"egress,omitempty"`
+	// When service is defined, we create a long running job
+	// by using a k8s RC and Service instead of a k8s Job
+	Service      *Service        `protobuf:"bytes,8,opt,name=service" json:"service,omitempty"`
+	OutputRepo   *pfs.Repo       `protobuf:"bytes,12,opt,name=output_repo,json=outputRepo" json:"output_repo,omitempty"`
+	OutputBranch string          `protobuf:"bytes,11,opt,name=output_branch,json=outputBranch,proto3" json:"output_branch,omitempty"`
+	ParentJob    *Job            `protobuf:"bytes,13,opt,name=parent_job,json=parentJob" json:"parent_job,omitempty"`
+	ResourceSpec *ResourceSpec   `protobuf:"bytes,14,opt,name=resource_spec,json=resourceSpec" json:"resource_spec,omitempty"`
+	Input        *Input          `protobuf:"bytes,15,opt,name=input" json:"input,omitempty"`
+	NewBranch    *pfs.BranchInfo `protobuf:"bytes,16,opt,name=new_branch,json=newBranch" json:"new_branch,omitempty"`
+	Incremental  bool            `protobuf:"varint,17,opt,name=incremental,proto3" json:"incremental,omitempty"`
+	EnableStats  bool            `protobuf:"varint,18,opt,name=enable_stats,json=enableStats,proto3" json:"enable_stats,omitempty"`
+	Salt         string          `protobuf:"bytes,19,opt,name=salt,proto3" json:"salt,omitempty"`
+	Batch        bool            `protobuf:"varint,20,opt,name=batch,proto3" json:"batch,omitempty"`
+	Rerun        bool            `protobuf:"varint,21,opt,name=rerun,proto3" json:"rerun,omitempty"`
+}
+
+func (m *CreateJobRequest) Reset()                    { *m = CreateJobRequest{} }
+func (m *CreateJobRequest) String() string            { return proto.CompactTextString(m) }
+func (*CreateJobRequest) ProtoMessage()               {}
+func (*CreateJobRequest) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{45} }
+
+func (m *CreateJobRequest) GetTransform() *Transform {
+	if m != nil {
+		return m.Transform
+	}
+	return nil
+}
+
+func (m *CreateJobRequest) GetPipeline() *Pipeline {
+	if m != nil {
+		return m.Pipeline
+	}
+	return nil
+}
+
+func (m *CreateJobRequest) GetPipelineVersion() uint64 {
+	if m != nil {
+		return m.PipelineVersion
+	}
+	return 0
+}
+
+func (m *CreateJobRequest) GetParallelismSpec() *ParallelismSpec {
+	if m != nil {
+		return m.ParallelismSpec
+	}
+	return nil
+}
+
+func (m *CreateJobRequest) GetEgress() *Egress {
+	if m != nil {
+		return m.Egress
+	}
+	return nil
+}
+
+func (m *CreateJobRequest) GetService() *Service {
+	if m != nil {
+		return m.Service
+	}
+	return nil
+}
+
+func (m *CreateJobRequest) GetOutputRepo() *pfs.Repo {
+	if m != nil {
+		return m.OutputRepo
+	}
+	return nil
+}
+
+func (m *CreateJobRequest) GetOutputBranch() string {
+	if m != nil {
+		return m.OutputBranch
+	}
+	return ""
+}
+
+func (m *CreateJobRequest) GetParentJob() *Job {
+	if m != nil {
+		return m.ParentJob
+	}
+	return nil
+}
+
+func (m *CreateJobRequest) GetResourceSpec() *ResourceSpec {
+	if m != nil {
+		return m.ResourceSpec
+	}
+	return nil
+}
+
+func (m *CreateJobRequest) GetInput() *Input {
+	if m != nil {
+		return m.Input
+	}
+	return nil
+}
+
+func (m *CreateJobRequest) GetNewBranch() *pfs.BranchInfo {
+	if m != nil {
+		return m.NewBranch
+	}
+	return nil
+}
+
+func (m *CreateJobRequest) GetIncremental() bool {
+	if m != nil {
+		return m.Incremental
+	}
+	return false
+}
+
+func (m *CreateJobRequest) GetEnableStats() bool {
+	if m != nil {
+		return m.EnableStats
+	}
+	return false
+}
+
+func (m *CreateJobRequest) GetSalt() string {
+	if m != nil {
+		return m.Salt
+	}
+	return ""
+}
+
+func (m *CreateJobRequest) GetBatch() bool {
+	if m != nil {
+		return m.Batch
+	}
+	return false
+}
+
+func (m *CreateJobRequest) GetRerun() bool {
+	if m != nil {
+		return m.Rerun
+	}
+	return false
+}
+
+type InspectJobRequest struct {
+	Job        *Job `protobuf:"bytes,1,opt,name=job" json:"job,omitempty"`
+	BlockState bool `protobuf:"varint,2,opt,name=block_state,json=blockState,proto3" json:"block_state,omitempty"`
+}
+
+func (m *InspectJobRequest) Reset()                    { *m = InspectJobRequest{} }
+func (m *InspectJobRequest) String() string            { return proto.CompactTextString(m) }
+func (*InspectJobRequest) ProtoMessage()               {}
+func (*InspectJobRequest) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{46} }
+
+func (m *InspectJobRequest) GetJob() *Job {
+	if m != nil {
+		return m.Job
+	}
+	return nil
+}
+
+func (m *InspectJobRequest) GetBlockState() bool {
+	if m != nil {
+		return m.BlockState
+	}
+	return false
+}
+
+type ListJobRequest struct {
+	Pipeline     *Pipeline     `protobuf:"bytes,1,opt,name=pipeline" json:"pipeline,omitempty"`
+	InputCommit  []*pfs.Commit `protobuf:"bytes,2,rep,name=input_commit,json=inputCommit" json:"input_commit,omitempty"`
+	OutputCommit *pfs.Commit   `protobuf:"bytes,3,opt,name=output_commit,json=outputCommit" json:"output_commit,omitempty"`
+}
+
+func (m *ListJobRequest) Reset()                    { *m = ListJobRequest{} }
+func (m *ListJobRequest) String() string            { return proto.CompactTextString(m) }
+func (*ListJobRequest) ProtoMessage()               {}
+func (*ListJobRequest) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{47} }
+
+func (m *ListJobRequest) GetPipeline() *Pipeline {
+	if m != nil {
+		return m.Pipeline
+	}
+	return nil
+}
+
+func (m *ListJobRequest) GetInputCommit() []*pfs.Commit {
+	if m != nil {
+		return m.InputCommit
+	}
+	return nil
+}
+
+func (m *ListJobRequest) GetOutputCommit() *pfs.Commit {
+	if m != nil {
+		return m.OutputCommit
+	}
+	return nil
+}
+
+type EgressJobRequest struct {
+	Job *Job `protobuf:"bytes,1,opt,name=job" json:"job,omitempty"`
+}
+
+func (m *EgressJobRequest) Reset()                    { *m = EgressJobRequest{} }
+func (m *EgressJobRequest) String() string            { return proto.CompactTextString(m) }
+func (*EgressJobRequest) ProtoMessage()               {}
+func (*EgressJobRequest) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{48} }
+
+func (m *EgressJobRequest) GetJob() *Job {
+	if m != nil {
+		return m.Job
+	}
+	return nil
+}
+
+type DeleteJobRequest struct {
+	Job *Job `protobuf:"bytes,1,opt,name=job" json:"job,omitempty"`
+}
+
+func (m *DeleteJobRequest) Reset()                    { *m = DeleteJobRequest{} }
 func (m *DeleteJobRequest) String() string            { return proto.CompactTextString(m) }
 func (*DeleteJobRequest) ProtoMessage()               {}
-func (*DeleteJobRequest) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{29} }
+func (*DeleteJobRequest) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{49} }
 
 func (m *DeleteJobRequest) GetJob() *Job {
 	if m != nil {
@@ -1744,7 +3083,7 @@ type StopJobRequest struct {
 func (m *StopJobRequest) Reset()                    { *m = StopJobRequest{} }
 func (m *StopJobRequest) String() string            { return proto.CompactTextString(m) }
 func (*StopJobRequest) ProtoMessage()               {}
-func (*StopJobRequest) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{30} }
+func (*StopJobRequest) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{50} }
 
 func (m *StopJobRequest) GetJob() *Job {
 	if m != nil {
@@ -1773,7 +3112,7 @@ type GetLogsRequest struct {
 func (m *GetLogsRequest) Reset()                    { *m = GetLogsRequest{} }
 func (m *GetLogsRequest) String() string            { return proto.CompactTextString(m) }
 func (*GetLogsRequest) ProtoMessage()               {}
-func (*GetLogsRequest) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{31} }
+func (*GetLogsRequest) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{51} }
 
 func (m *GetLogsRequest) GetPipeline() *Pipeline {
 	if m != nil {
@@ -1832,7 +3171,7 @@ type LogMessage struct {
 func (m *LogMessage) Reset()                    { *m = LogMessage{} }
 func (m *LogMessage) String() string            { return proto.CompactTextString(m) }
 func (*LogMessage) ProtoMessage()               {}
-func (*LogMessage) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{32} }
+func (*LogMessage) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{52} }
 
 func (m *LogMessage) GetPipelineName() string {
 	if m != nil {
@@ -1905,7 +3244,7 @@ type RestartDatumRequest struct {
 func (m *RestartDatumRequest) Reset()                    { *m = RestartDatumRequest{} }
 func (m *RestartDatumRequest) String() string            { return proto.CompactTextString(m) }
 func (*RestartDatumRequest) ProtoMessage()               {}
-func (*RestartDatumRequest) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{33} }
+func (*RestartDatumRequest) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{53} }
 
 func (m *RestartDatumRequest) GetJob() *Job {
 	if m != nil {
@@ -1928,7 +3267,7 @@ type InspectDatumRequest struct {
 func (m *InspectDatumRequest) Reset()                    { *m = InspectDatumRequest{} }
 func (m *InspectDatumRequest) String() string            { return proto.CompactTextString(m) }
 func (*InspectDatumRequest) ProtoMessage()               {}
-func (*InspectDatumRequest) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{34} }
+func (*InspectDatumRequest) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{54} }
 
 func (m *InspectDatumRequest) GetDatum() *Datum {
 	if m != nil {
@@ -1946,7 +3285,7 @@ type ListDatumRequest struct {
 func (m *ListDatumRequest) Reset()                    { *m = ListDatumRequest{} }
 func (m *ListDatumRequest) String() string            { return proto.CompactTextString(m) }
 func (*ListDatumRequest) ProtoMessage()               {}
-func (*ListDatumRequest) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{35} }
+func (*ListDatumRequest) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{55} }
 
 func (m *ListDatumRequest) GetJob() *Job {
 	if m != nil {
@@ -1978,7 +3317,7 @@ type ListDatumResponse struct {
 func (m *ListDatumResponse) Reset()                    { *m = ListDatumResponse{} }
 func (m *ListDatumResponse) String() string            { return proto.CompactTextString(m) }
 func (*ListDatumResponse) ProtoMessage()               {}
-func (*ListDatumResponse) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{36} }
+func (*ListDatumResponse) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{56} }
 
 func (m *ListDatumResponse) GetDatumInfos() []*DatumInfo {
 	if m != nil {
@@ -2001,9 +3340,161 @@ func (m *ListDatumResponse) GetPage() int64 {
 	return 0
 }
 
-type CreatePipelineRequest struct {
-	Pipeline           *Pipeline                  `protobuf:"bytes,1,opt,name=pipeline" json:"pipeline,omitempty"`
-	Transform          *Transform                 `protobuf:"bytes,2,opt,name=transform" json:"transform,omitempty"`
+type ListDatumForInputRequest struct {
+	// pipeline is the name of the pipeline the input is for, if any. It's used
+	// to fill in the same defaults that CreatePipeline would.
+	Pipeline *Pipeline `protobuf:"bytes,1,opt,name=pipeline" json:"pipeline,omitempty"`
+	Input    *Input    `protobuf:"bytes,2,opt,name=input" json:"input,omitempty"`
+	PageSize int64     `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Page     int64     `protobuf:"varint,4,opt,name=page,proto3" json:"page,omitempty"`
+}
+
+func (m *ListDatumForInputRequest) Reset()                    { *m = ListDatumForInputRequest{} }
+func (m *ListDatumForInputRequest) String() string            { return proto.CompactTextString(m) }
+func (*ListDatumForInputRequest) ProtoMessage()               {}
+func (*ListDatumForInputRequest) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{57} }
+
+func (m *ListDatumForInputRequest) GetPipeline() *Pipeline {
+	if m != nil {
+		return m.Pipeline
+	}
+	return nil
+}
+
+func (m *ListDatumForInputRequest) GetInput() *Input {
+	if m != nil {
+		return m.Input
+	}
+	return nil
+}
+
+func (m *ListDatumForInputRequest) GetPageSize() int64 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *ListDatumForInputRequest) GetPage() int64 {
+	if m != nil {
+		return m.Page
+	}
+	return 0
+}
+
+// DatumSizeBucket counts the datums whose total size is at least
+// lower_bound_bytes and less than upper_bound_bytes
+type DatumSizeBucket struct {
+	LowerBoundBytes int64 `protobuf:"varint,1,opt,name=lower_bound_bytes,json=lowerBoundBytes,proto3" json:"lower_bound_bytes,omitempty"`
+	UpperBoundBytes int64 `protobuf:"varint,2,opt,name=upper_bound_bytes,json=upperBoundBytes,proto3" json:"upper_bound_bytes,omitempty"`
+	Count           int64 `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (m *DatumSizeBucket) Reset()                    { *m = DatumSizeBucket{} }
+func (m *DatumSizeBucket) String() string            { return proto.CompactTextString(m) }
+func (*DatumSizeBucket) ProtoMessage()               {}
+func (*DatumSizeBucket) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{58} }
+
+func (m *DatumSizeBucket) GetLowerBoundBytes() int64 {
+	if m != nil {
+		return m.LowerBoundBytes
+	}
+	return 0
+}
+
+func (m *DatumSizeBucket) GetUpperBoundBytes() int64 {
+	if m != nil {
+		return m.UpperBoundBytes
+	}
+	return 0
+}
+
+func (m *DatumSizeBucket) GetCount() int64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+type ListDatumForInputResponse struct {
+	// datum_infos are the datums on the requested page, which have no job and
+	// are in the STARTING state
+	DatumInfos    []*DatumInfo `protobuf:"bytes,1,rep,name=datum_infos,json=datumInfos" json:"datum_infos,omitempty"`
+	TotalPages    int64        `protobuf:"varint,2,opt,name=total_pages,json=totalPages,proto3" json:"total_pages,omitempty"`
+	Page          int64        `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	DatumCount    int64        `protobuf:"varint,4,opt,name=datum_count,json=datumCount,proto3" json:"datum_count,omitempty"`
+	TotalBytes    int64        `protobuf:"varint,5,opt,name=total_bytes,json=totalBytes,proto3" json:"total_bytes,omitempty"`
+	MinDatumBytes int64        `protobuf:"varint,6,opt,name=min_datum_bytes,json=minDatumBytes,proto3" json:"min_datum_bytes,omitempty"`
+	MaxDatumBytes int64        `protobuf:"varint,7,opt,name=max_datum_bytes,json=maxDatumBytes,proto3" json:"max_datum_bytes,omitempty"`
+	// size_histogram counts the datums by size, from the smallest non-empty
+	// bucket to the largest
+	SizeHistogram []*DatumSizeBucket `protobuf:"bytes,8,rep,name=size_histogram,json=sizeHistogram" json:"size_histogram,omitempty"`
+}
+
+func (m *ListDatumForInputResponse) Reset()                    { *m = ListDatumForInputResponse{} }
+func (m *ListDatumForInputResponse) String() string            { return proto.CompactTextString(m) }
+func (*ListDatumForInputResponse) ProtoMessage()               {}
+func (*ListDatumForInputResponse) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{59} }
+
+func (m *ListDatumForInputResponse) GetDatumInfos() []*DatumInfo {
+	if m != nil {
+		return m.DatumInfos
+	}
+	return nil
+}
+
+func (m *ListDatumForInputResponse) GetTotalPages() int64 {
+	if m != nil {
+		return m.TotalPages
+	}
+	return 0
+}
+
+func (m *ListDatumForInputResponse) GetPage() int64 {
+	if m != nil {
+		return m.Page
+	}
+	return 0
+}
+
+func (m *ListDatumForInputResponse) GetDatumCount() int64 {
+	if m != nil {
+		return m.DatumCount
+	}
+	return 0
+}
+
+func (m *ListDatumForInputResponse) GetTotalBytes() int64 {
+	if m != nil {
+		return m.TotalBytes
+	}
+	return 0
+}
+
+func (m *ListDatumForInputResponse) GetMinDatumBytes() int64 {
+	if m != nil {
+		return m.MinDatumBytes
+	}
+	return 0
+}
+
+func (m *ListDatumForInputResponse) GetMaxDatumBytes() int64 {
+	if m != nil {
+		return m.MaxDatumBytes
+	}
+	return 0
+}
+
+func (m *ListDatumForInputResponse) GetSizeHistogram() []*DatumSizeBucket {
+	if m != nil {
+		return m.SizeHistogram
+	}
+	return nil
+}
+
+type CreatePipelineRequest struct {
+	Pipeline           *Pipeline                  `protobuf:"bytes,1,opt,name=pipeline" json:"pipeline,omitempty"`
+	Transform          *Transform                 `protobuf:"bytes,2,opt,name=transform" json:"transform,omitempty"`
 	ParallelismSpec    *ParallelismSpec           `protobuf:"bytes,7,opt,name=parallelism_spec,json=parallelismSpec" json:"parallelism_spec,omitempty"`
 	Egress             *Egress                    `protobuf:"bytes,9,opt,name=egress" json:"egress,omitempty"`
 	Update             bool                       `protobuf:"varint,5,opt,name=update,proto3" json:"update,omitempty"`
@@ -2021,12 +3512,40 @@ type CreatePipelineRequest struct {
 	Batch        bool     `protobuf:"varint,19,opt,name=batch,proto3" json:"batch,omitempty"`
 	MaxQueueSize int64    `protobuf:"varint,20,opt,name=max_queue_size,json=maxQueueSize,proto3" json:"max_queue_size,omitempty"`
 	Service      *Service `protobuf:"bytes,21,opt,name=service" json:"service,omitempty"`
+	// datum_tries is the number of times each datum is tried before it's
+	// considered failed (default 4)
+	DatumTries int64 `protobuf:"varint,22,opt,name=datum_tries,json=datumTries,proto3" json:"datum_tries,omitempty"`
+	// datum_retry_backoff is the wait before a datum's first retry, which
+	// doubles with each retry (by default, retries wait ~1s)
+	DatumRetryBackoff *google_protobuf2.Duration `protobuf:"bytes,23,opt,name=datum_retry_backoff,json=datumRetryBackoff" json:"datum_retry_backoff,omitempty"`
+	// datum_timeout is how long the user code may run on one datum before it's
+	// killed and the try fails
+	DatumTimeout *google_protobuf2.Duration `protobuf:"bytes,24,opt,name=datum_timeout,json=datumTimeout" json:"datum_timeout,omitempty"`
+	// job_timeout is how long a job may run before it fails
+	JobTimeout *google_protobuf2.Duration `protobuf:"bytes,25,opt,name=job_timeout,json=jobTimeout" json:"job_timeout,omitempty"`
+	// skip_failed_datums makes jobs skip datums that fail all of their tries,
+	// finishing in the JOB_PARTIAL state, instead of failing
+	SkipFailedDatums bool `protobuf:"varint,26,opt,name=skip_failed_datums,json=skipFailedDatums,proto3" json:"skip_failed_datums,omitempty"`
+	// resource_limits caps the resources the user container of each worker
+	// may use. Only the resources that are set are limited.
+	ResourceLimits *ResourceSpec   `protobuf:"bytes,27,opt,name=resource_limits,json=resourceLimits" json:"resource_limits,omitempty"`
+	SchedulingSpec *SchedulingSpec `protobuf:"bytes,28,opt,name=scheduling_spec,json=schedulingSpec" json:"scheduling_spec,omitempty"`
+	// pod_patch is applied to the pod spec of the pipeline's workers. It's
+	// either a JSON patch (a JSON array) or a strategic merge patch (a JSON
+	// object), as accepted by kubectl.
+	PodPatch        string           `protobuf:"bytes,29,opt,name=pod_patch,json=podPatch,proto3" json:"pod_patch,omitempty"`
+	AutoscalingSpec *AutoscalingSpec `protobuf:"bytes,30,opt,name=autoscaling_spec,json=autoscalingSpec" json:"autoscaling_spec,omitempty"`
+	// priority_class and team decide when the pipeline's jobs are admitted,
+	// if there's an admission policy
+	PriorityClass string `protobuf:"bytes,31,opt,name=priority_class,json=priorityClass,proto3" json:"priority_class,omitempty"`
+	Team          string `protobuf:"bytes,32,opt,name=team,proto3" json:"team,omitempty"`
+	Spout         *Spout `protobuf:"bytes,33,opt,name=spout" json:"spout,omitempty"`
 }
 
 func (m *CreatePipelineRequest) Reset()                    { *m = CreatePipelineRequest{} }
 func (m *CreatePipelineRequest) String() string            { return proto.CompactTextString(m) }
 func (*CreatePipelineRequest) ProtoMessage()               {}
-func (*CreatePipelineRequest) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{37} }
+func (*CreatePipelineRequest) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{60} }
 
 func (m *CreatePipelineRequest) GetPipeline() *Pipeline {
 	if m != nil {
@@ -2147,14 +3666,104 @@ func (m *CreatePipelineRequest) GetService() *Service {
 	return nil
 }
 
+func (m *CreatePipelineRequest) GetDatumTries() int64 {
+	if m != nil {
+		return m.DatumTries
+	}
+	return 0
+}
+
+func (m *CreatePipelineRequest) GetDatumRetryBackoff() *google_protobuf2.Duration {
+	if m != nil {
+		return m.DatumRetryBackoff
+	}
+	return nil
+}
+
+func (m *CreatePipelineRequest) GetDatumTimeout() *google_protobuf2.Duration {
+	if m != nil {
+		return m.DatumTimeout
+	}
+	return nil
+}
+
+func (m *CreatePipelineRequest) GetJobTimeout() *google_protobuf2.Duration {
+	if m != nil {
+		return m.JobTimeout
+	}
+	return nil
+}
+
+func (m *CreatePipelineRequest) GetSkipFailedDatums() bool {
+	if m != nil {
+		return m.SkipFailedDatums
+	}
+	return false
+}
+
+func (m *CreatePipelineRequest) GetResourceLimits() *ResourceSpec {
+	if m != nil {
+		return m.ResourceLimits
+	}
+	return nil
+}
+
+func (m *CreatePipelineRequest) GetSchedulingSpec() *SchedulingSpec {
+	if m != nil {
+		return m.SchedulingSpec
+	}
+	return nil
+}
+
+func (m *CreatePipelineRequest) GetPodPatch() string {
+	if m != nil {
+		return m.PodPatch
+	}
+	return ""
+}
+
+func (m *CreatePipelineRequest) GetAutoscalingSpec() *AutoscalingSpec {
+	if m != nil {
+		return m.AutoscalingSpec
+	}
+	return nil
+}
+
+func (m *CreatePipelineRequest) GetPriorityClass() string {
+	if m != nil {
+		return m.PriorityClass
+	}
+	return ""
+}
+
+func (m *CreatePipelineRequest) GetTeam() string {
+	if m != nil {
+		return m.Team
+	}
+	return ""
+}
+
+func (m *CreatePipelineRequest) GetSpout() *Spout {
+	if m != nil {
+		return m.Spout
+	}
+	return nil
+}
+
 type InspectPipelineRequest struct {
 	Pipeline *Pipeline `protobuf:"bytes,1,opt,name=pipeline" json:"pipeline,omitempty"`
+	// version, if set, selects a past version of the pipeline's spec from its
+	// history rather than the current one
+	Version uint64 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	// worker_spec, if set, makes the response include the pod template of
+	// the pipeline's workers
+	WorkerSpec bool `protobuf:"varint,3,opt,name=worker_spec,json=workerSpec,proto3" json:"worker_spec,omitempty"`
 }
 
 func (m *InspectPipelineRequest) Reset()                    { *m = InspectPipelineRequest{} }
 func (m *InspectPipelineRequest) String() string            { return proto.CompactTextString(m) }
 func (*InspectPipelineRequest) ProtoMessage()               {}
-func (*InspectPipelineRequest) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{38} }
+func (*InspectPipelineRequest) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{61} }
 
 func (m *InspectPipelineRequest) GetPipeline() *Pipeline {
 	if m != nil {
@@ -2163,13 +3772,69 @@ func (m *InspectPipelineRequest) GetPipeline() *Pipeline {
 	return nil
 }
 
+func (m *InspectPipelineRequest) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *InspectPipelineRequest) GetWorkerSpec() bool {
+	if m != nil {
+		return m.WorkerSpec
+	}
+	return false
+}
+
+type ListPipelineVersionsRequest struct {
+	Pipeline *Pipeline `protobuf:"bytes,1,opt,name=pipeline" json:"pipeline,omitempty"`
+}
+
+func (m *ListPipelineVersionsRequest) Reset()                    { *m = ListPipelineVersionsRequest{} }
+func (m *ListPipelineVersionsRequest) String() string            { return proto.CompactTextString(m) }
+func (*ListPipelineVersionsRequest) ProtoMessage()               {}
+func (*ListPipelineVersionsRequest) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{62} }
+
+func (m *ListPipelineVersionsRequest) GetPipeline() *Pipeline {
+	if m != nil {
+		return m.Pipeline
+	}
+	return nil
+}
+
+// RollbackPipelineRequest reinstates the spec of a past version of a
+// pipeline, along with its salt, as a new version of the pipeline.
+type RollbackPipelineRequest struct {
+	Pipeline *Pipeline `protobuf:"bytes,1,opt,name=pipeline" json:"pipeline,omitempty"`
+	Version  uint64    `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (m *RollbackPipelineRequest) Reset()                    { *m = RollbackPipelineRequest{} }
+func (m *RollbackPipelineRequest) String() string            { return proto.CompactTextString(m) }
+func (*RollbackPipelineRequest) ProtoMessage()               {}
+func (*RollbackPipelineRequest) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{63} }
+
+func (m *RollbackPipelineRequest) GetPipeline() *Pipeline {
+	if m != nil {
+		return m.Pipeline
+	}
+	return nil
+}
+
+func (m *RollbackPipelineRequest) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
 type ListPipelineRequest struct {
 }
 
 func (m *ListPipelineRequest) Reset()                    { *m = ListPipelineRequest{} }
 func (m *ListPipelineRequest) String() string            { return proto.CompactTextString(m) }
 func (*ListPipelineRequest) ProtoMessage()               {}
-func (*ListPipelineRequest) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{39} }
+func (*ListPipelineRequest) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{64} }
 
 type DeletePipelineRequest struct {
 	Pipeline   *Pipeline `protobuf:"bytes,1,opt,name=pipeline" json:"pipeline,omitempty"`
@@ -2181,7 +3846,7 @@ type DeletePipelineRequest struct {
 func (m *DeletePipelineRequest) Reset()                    { *m = DeletePipelineRequest{} }
 func (m *DeletePipelineRequest) String() string            { return proto.CompactTextString(m) }
 func (*DeletePipelineRequest) ProtoMessage()               {}
-func (*DeletePipelineRequest) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{40} }
+func (*DeletePipelineRequest) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{65} }
 
 func (m *DeletePipelineRequest) GetPipeline() *Pipeline {
 	if m != nil {
@@ -2218,7 +3883,7 @@ type StartPipelineRequest struct {
 func (m *StartPipelineRequest) Reset()                    { *m = StartPipelineRequest{} }
 func (m *StartPipelineRequest) String() string            { return proto.CompactTextString(m) }
 func (*StartPipelineRequest) ProtoMessage()               {}
-func (*StartPipelineRequest) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{41} }
+func (*StartPipelineRequest) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{66} }
 
 func (m *StartPipelineRequest) GetPipeline() *Pipeline {
 	if m != nil {
@@ -2234,7 +3899,7 @@ type StopPipelineRequest struct {
 func (m *StopPipelineRequest) Reset()                    { *m = StopPipelineRequest{} }
 func (m *StopPipelineRequest) String() string            { return proto.CompactTextString(m) }
 func (*StopPipelineRequest) ProtoMessage()               {}
-func (*StopPipelineRequest) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{42} }
+func (*StopPipelineRequest) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{67} }
 
 func (m *StopPipelineRequest) GetPipeline() *Pipeline {
 	if m != nil {
@@ -2243,6 +3908,10 @@ func (m *StopPipelineRequest) GetPipeline() *Pipeline {
 	return nil
 }
 
+// RerunPipelineRequest selects the input commit sets of a pipeline's previous
+// jobs to rerun. A job is rerun if one of its input commits is an ancestor of
+// (or is) a commit in 'include', and none of them is an ancestor of a commit
+// in 'exclude'. An empty 'include' selects every job.
 type RerunPipelineRequest struct {
 	Pipeline *Pipeline     `protobuf:"bytes,1,opt,name=pipeline" json:"pipeline,omitempty"`
 	Exclude  []*pfs.Commit `protobuf:"bytes,2,rep,name=exclude" json:"exclude,omitempty"`
@@ -2252,7 +3921,7 @@ type RerunPipelineRequest struct {
 func (m *RerunPipelineRequest) Reset()                    { *m = RerunPipelineRequest{} }
 func (m *RerunPipelineRequest) String() string            { return proto.CompactTextString(m) }
 func (*RerunPipelineRequest) ProtoMessage()               {}
-func (*RerunPipelineRequest) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{43} }
+func (*RerunPipelineRequest) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{68} }
 
 func (m *RerunPipelineRequest) GetPipeline() *Pipeline {
 	if m != nil {
@@ -2275,73 +3944,489 @@ func (m *RerunPipelineRequest) GetInclude() []*pfs.Commit {
 	return nil
 }
 
-type GarbageCollectRequest struct {
+// ApplyPipelinesRequest describes the desired state of a set of repos and
+// pipelines. Repos that don't exist are created, pipelines that don't exist
+// are created and pipelines whose spec differs are updated, in dependency
+// order.
+type ApplyPipelinesRequest struct {
+	Repos     []*pfs.CreateRepoRequest `protobuf:"bytes,1,rep,name=repos" json:"repos,omitempty"`
+	Pipelines []*CreatePipelineRequest `protobuf:"bytes,2,rep,name=pipelines" json:"pipelines,omitempty"`
+	// prune deletes the pipelines that aren't in 'pipelines'
+	Prune bool `protobuf:"varint,3,opt,name=prune,proto3" json:"prune,omitempty"`
+	// reprocess makes updated pipelines reprocess all of their datums
+	Reprocess bool `protobuf:"varint,4,opt,name=reprocess,proto3" json:"reprocess,omitempty"`
+	// dry_run validates the changes and returns them without applying them
+	DryRun bool `protobuf:"varint,5,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
 }
 
-func (m *GarbageCollectRequest) Reset()                    { *m = GarbageCollectRequest{} }
-func (m *GarbageCollectRequest) String() string            { return proto.CompactTextString(m) }
-func (*GarbageCollectRequest) ProtoMessage()               {}
-func (*GarbageCollectRequest) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{44} }
+func (m *ApplyPipelinesRequest) Reset()                    { *m = ApplyPipelinesRequest{} }
+func (m *ApplyPipelinesRequest) String() string            { return proto.CompactTextString(m) }
+func (*ApplyPipelinesRequest) ProtoMessage()               {}
+func (*ApplyPipelinesRequest) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{69} }
 
-type GarbageCollectResponse struct {
+func (m *ApplyPipelinesRequest) GetRepos() []*pfs.CreateRepoRequest {
+	if m != nil {
+		return m.Repos
+	}
+	return nil
 }
 
-func (m *GarbageCollectResponse) Reset()                    { *m = GarbageCollectResponse{} }
-func (m *GarbageCollectResponse) String() string            { return proto.CompactTextString(m) }
-func (*GarbageCollectResponse) ProtoMessage()               {}
-func (*GarbageCollectResponse) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{45} }
+func (m *ApplyPipelinesRequest) GetPipelines() []*CreatePipelineRequest {
+	if m != nil {
+		return m.Pipelines
+	}
+	return nil
+}
 
-func init() {
-	proto.RegisterType((*Secret)(nil), "pps.Secret")
-	proto.RegisterType((*Transform)(nil), "pps.Transform")
-	proto.RegisterType((*Egress)(nil), "pps.Egress")
-	proto.RegisterType((*Job)(nil), "pps.Job")
-	proto.RegisterType((*Service)(nil), "pps.Service")
-	proto.RegisterType((*AtomInput)(nil), "pps.AtomInput")
-	proto.RegisterType((*CronInput)(nil), "pps.CronInput")
-	proto.RegisterType((*Input)(nil), "pps.Input")
-	proto.RegisterType((*JobInput)(nil), "pps.JobInput")
-	proto.RegisterType((*ParallelismSpec)(nil), "pps.ParallelismSpec")
-	proto.RegisterType((*InputFile)(nil), "pps.InputFile")
-	proto.RegisterType((*Datum)(nil), "pps.Datum")
-	proto.RegisterType((*DatumInfo)(nil), "pps.DatumInfo")
-	proto.RegisterType((*DatumInfos)(nil), "pps.DatumInfos")
-	proto.RegisterType((*Aggregate)(nil), "pps.Aggregate")
-	proto.RegisterType((*ProcessStats)(nil), "pps.ProcessStats")
-	proto.RegisterType((*AggregateProcessStats)(nil), "pps.AggregateProcessStats")
-	proto.RegisterType((*WorkerStatus)(nil), "pps.WorkerStatus")
-	proto.RegisterType((*ResourceSpec)(nil), "pps.ResourceSpec")
-	proto.RegisterType((*JobInfo)(nil), "pps.JobInfo")
-	proto.RegisterType((*Worker)(nil), "pps.Worker")
-	proto.RegisterType((*JobInfos)(nil), "pps.JobInfos")
-	proto.RegisterType((*Pipeline)(nil), "pps.Pipeline")
-	proto.RegisterType((*PipelineInput)(nil), "pps.PipelineInput")
-	proto.RegisterType((*PipelineInfo)(nil), "pps.PipelineInfo")
-	proto.RegisterType((*PipelineInfos)(nil), "pps.PipelineInfos")
-	proto.RegisterType((*CreateJobRequest)(nil), "pps.CreateJobRequest")
-	proto.RegisterType((*InspectJobRequest)(nil), "pps.InspectJobRequest")
-	proto.RegisterType((*ListJobRequest)(nil), "pps.ListJobRequest")
-	proto.RegisterType((*DeleteJobRequest)(nil), "pps.DeleteJobRequest")
-	proto.RegisterType((*StopJobRequest)(nil), "pps.StopJobRequest")
-	proto.RegisterType((*GetLogsRequest)(nil), "pps.GetLogsRequest")
-	proto.RegisterType((*LogMessage)(nil), "pps.LogMessage")
-	proto.RegisterType((*RestartDatumRequest)(nil), "pps.RestartDatumRequest")
-	proto.RegisterType((*InspectDatumRequest)(nil), "pps.InspectDatumRequest")
-	proto.RegisterType((*ListDatumRequest)(nil), "pps.ListDatumRequest")
-	proto.RegisterType((*ListDatumResponse)(nil), "pps.ListDatumResponse")
-	proto.RegisterType((*CreatePipelineRequest)(nil), "pps.CreatePipelineRequest")
-	proto.RegisterType((*InspectPipelineRequest)(nil), "pps.InspectPipelineRequest")
-	proto.RegisterType((*ListPipelineRequest)(nil), "pps.ListPipelineRequest")
-	proto.RegisterType((*DeletePipelineRequest)(nil), "pps.DeletePipelineRequest")
-	proto.RegisterType((*StartPipelineRequest)(nil), "pps.StartPipelineRequest")
-	proto.RegisterType((*StopPipelineRequest)(nil), "pps.StopPipelineRequest")
-	proto.RegisterType((*RerunPipelineRequest)(nil), "pps.RerunPipelineRequest")
-	proto.RegisterType((*GarbageCollectRequest)(nil), "pps.GarbageCollectRequest")
-	proto.RegisterType((*GarbageCollectResponse)(nil), "pps.GarbageCollectResponse")
-	proto.RegisterEnum("pps.JobState", JobState_name, JobState_value)
+func (m *ApplyPipelinesRequest) GetPrune() bool {
+	if m != nil {
+		return m.Prune
+	}
+	return false
+}
+
+func (m *ApplyPipelinesRequest) GetReprocess() bool {
+	if m != nil {
+		return m.Reprocess
+	}
+	return false
+}
+
+func (m *ApplyPipelinesRequest) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
+
+type ApplyAction struct {
+	Type ApplyActionType `protobuf:"varint,1,opt,name=type,proto3,enum=pps.ApplyActionType" json:"type,omitempty"`
+	Name string          `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// changed_fields are the pipeline spec fields that an update changes
+	ChangedFields []string `protobuf:"bytes,3,rep,name=changed_fields,json=changedFields" json:"changed_fields,omitempty"`
+	// reprocess is set if an update reprocesses all of the pipeline's datums
+	Reprocess bool `protobuf:"varint,4,opt,name=reprocess,proto3" json:"reprocess,omitempty"`
+	// downstream are the pipelines whose input is changed by this action
+	Downstream []string `protobuf:"bytes,5,rep,name=downstream" json:"downstream,omitempty"`
+}
+
+func (m *ApplyAction) Reset()                    { *m = ApplyAction{} }
+func (m *ApplyAction) String() string            { return proto.CompactTextString(m) }
+func (*ApplyAction) ProtoMessage()               {}
+func (*ApplyAction) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{70} }
+
+func (m *ApplyAction) GetType() ApplyActionType {
+	if m != nil {
+		return m.Type
+	}
+	return ApplyActionType_APPLY_CREATE_REPO
+}
+
+func (m *ApplyAction) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ApplyAction) GetChangedFields() []string {
+	if m != nil {
+		return m.ChangedFields
+	}
+	return nil
+}
+
+func (m *ApplyAction) GetReprocess() bool {
+	if m != nil {
+		return m.Reprocess
+	}
+	return false
+}
+
+func (m *ApplyAction) GetDownstream() []string {
+	if m != nil {
+		return m.Downstream
+	}
+	return nil
+}
+
+type ApplyPipelinesResponse struct {
+	// actions are the changes, in the order they're (or would be) applied
+	Actions []*ApplyAction `protobuf:"bytes,1,rep,name=actions" json:"actions,omitempty"`
+}
+
+func (m *ApplyPipelinesResponse) Reset()                    { *m = ApplyPipelinesResponse{} }
+func (m *ApplyPipelinesResponse) String() string            { return proto.CompactTextString(m) }
+func (*ApplyPipelinesResponse) ProtoMessage()               {}
+func (*ApplyPipelinesResponse) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{71} }
+
+func (m *ApplyPipelinesResponse) GetActions() []*ApplyAction {
+	if m != nil {
+		return m.Actions
+	}
+	return nil
+}
+
+type CreateWebhookRequest struct {
+	Webhook *WebhookInfo `protobuf:"bytes,1,opt,name=webhook" json:"webhook,omitempty"`
+	Update  bool         `protobuf:"varint,2,opt,name=update,proto3" json:"update,omitempty"`
+}
+
+func (m *CreateWebhookRequest) Reset()                    { *m = CreateWebhookRequest{} }
+func (m *CreateWebhookRequest) String() string            { return proto.CompactTextString(m) }
+func (*CreateWebhookRequest) ProtoMessage()               {}
+func (*CreateWebhookRequest) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{72} }
+
+func (m *CreateWebhookRequest) GetWebhook() *WebhookInfo {
+	if m != nil {
+		return m.Webhook
+	}
+	return nil
+}
+
+func (m *CreateWebhookRequest) GetUpdate() bool {
+	if m != nil {
+		return m.Update
+	}
+	return false
+}
+
+type ListWebhookRequest struct {
+	// pipeline, if set, limits the results to the webhooks of one pipeline
+	Pipeline *Pipeline `protobuf:"bytes,1,opt,name=pipeline" json:"pipeline,omitempty"`
+}
+
+func (m *ListWebhookRequest) Reset()                    { *m = ListWebhookRequest{} }
+func (m *ListWebhookRequest) String() string            { return proto.CompactTextString(m) }
+func (*ListWebhookRequest) ProtoMessage()               {}
+func (*ListWebhookRequest) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{73} }
+
+func (m *ListWebhookRequest) GetPipeline() *Pipeline {
+	if m != nil {
+		return m.Pipeline
+	}
+	return nil
+}
+
+type DeleteWebhookRequest struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (m *DeleteWebhookRequest) Reset()                    { *m = DeleteWebhookRequest{} }
+func (m *DeleteWebhookRequest) String() string            { return proto.CompactTextString(m) }
+func (*DeleteWebhookRequest) ProtoMessage()               {}
+func (*DeleteWebhookRequest) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{74} }
+
+func (m *DeleteWebhookRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+type TraceFileRequest struct {
+	File *pfs.File `protobuf:"bytes,1,opt,name=file" json:"file,omitempty"`
+	// depth limits how many pipelines upstream the trace goes; 0 means no
+	// limit
+	Depth int64 `protobuf:"varint,2,opt,name=depth,proto3" json:"depth,omitempty"`
+}
+
+func (m *TraceFileRequest) Reset()                    { *m = TraceFileRequest{} }
+func (m *TraceFileRequest) String() string            { return proto.CompactTextString(m) }
+func (*TraceFileRequest) ProtoMessage()               {}
+func (*TraceFileRequest) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{75} }
+
+func (m *TraceFileRequest) GetFile() *pfs.File {
+	if m != nil {
+		return m.File
+	}
+	return nil
+}
+
+func (m *TraceFileRequest) GetDepth() int64 {
+	if m != nil {
+		return m.Depth
+	}
+	return 0
+}
+
+// FileTrace is the lineage of a file: the job that wrote it (if a pipeline
+// did), and the datums of that job whose output includes it.
+type FileTrace struct {
+	File *pfs.File `protobuf:"bytes,1,opt,name=file" json:"file,omitempty"`
+	// job is unset if the file wasn't written by a pipeline
+	Job    *Job          `protobuf:"bytes,2,opt,name=job" json:"job,omitempty"`
+	Datums []*DatumTrace `protobuf:"bytes,3,rep,name=datums" json:"datums,omitempty"`
+}
+
+func (m *FileTrace) Reset()                    { *m = FileTrace{} }
+func (m *FileTrace) String() string            { return proto.CompactTextString(m) }
+func (*FileTrace) ProtoMessage()               {}
+func (*FileTrace) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{76} }
+
+func (m *FileTrace) GetFile() *pfs.File {
+	if m != nil {
+		return m.File
+	}
+	return nil
+}
+
+func (m *FileTrace) GetJob() *Job {
+	if m != nil {
+		return m.Job
+	}
+	return nil
+}
+
+func (m *FileTrace) GetDatums() []*DatumTrace {
+	if m != nil {
+		return m.Datums
+	}
+	return nil
+}
+
+// DatumTrace is a datum that wrote a traced file, and the lineage of each of
+// its inputs
+type DatumTrace struct {
+	DatumID string       `protobuf:"bytes,1,opt,name=datum_id,json=datumId,proto3" json:"datum_id,omitempty"`
+	Inputs  []*FileTrace `protobuf:"bytes,2,rep,name=inputs" json:"inputs,omitempty"`
+}
+
+func (m *DatumTrace) Reset()                    { *m = DatumTrace{} }
+func (m *DatumTrace) String() string            { return proto.CompactTextString(m) }
+func (*DatumTrace) ProtoMessage()               {}
+func (*DatumTrace) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{77} }
+
+func (m *DatumTrace) GetDatumID() string {
+	if m != nil {
+		return m.DatumID
+	}
+	return ""
+}
+
+func (m *DatumTrace) GetInputs() []*FileTrace {
+	if m != nil {
+		return m.Inputs
+	}
+	return nil
+}
+
+type ImpactAnalysisRequest struct {
+	Repo   *pfs.Repo `protobuf:"bytes,1,opt,name=repo" json:"repo,omitempty"`
+	Branch string    `protobuf:"bytes,2,opt,name=branch,proto3" json:"branch,omitempty"`
+	// paths are the files or directories in the branch that would change
+	Paths []string `protobuf:"bytes,3,rep,name=paths" json:"paths,omitempty"`
+}
+
+func (m *ImpactAnalysisRequest) Reset()                    { *m = ImpactAnalysisRequest{} }
+func (m *ImpactAnalysisRequest) String() string            { return proto.CompactTextString(m) }
+func (*ImpactAnalysisRequest) ProtoMessage()               {}
+func (*ImpactAnalysisRequest) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{78} }
+
+func (m *ImpactAnalysisRequest) GetRepo() *pfs.Repo {
+	if m != nil {
+		return m.Repo
+	}
+	return nil
+}
+
+func (m *ImpactAnalysisRequest) GetBranch() string {
+	if m != nil {
+		return m.Branch
+	}
+	return ""
+}
+
+func (m *ImpactAnalysisRequest) GetPaths() []string {
+	if m != nil {
+		return m.Paths
+	}
+	return nil
+}
+
+// PipelineImpact is the effect a change would have on a pipeline downstream
+// of it
+type PipelineImpact struct {
+	Pipeline *Pipeline `protobuf:"bytes,1,opt,name=pipeline" json:"pipeline,omitempty"`
+	// datums_total is the number of datums in the pipeline's current input
+	DatumsTotal int64 `protobuf:"varint,2,opt,name=datums_total,json=datumsTotal,proto3" json:"datums_total,omitempty"`
+	// datums_invalidated is the number of datums that would be processed:
+	// those that read changed files, plus any that aren't in the datum cache
+	DatumsInvalidated int64 `protobuf:"varint,3,opt,name=datums_invalidated,json=datumsInvalidated,proto3" json:"datums_invalidated,omitempty"`
+	// datums_reused is the number of datums whose output would be reused from
+	// the datum cache
+	DatumsReused int64 `protobuf:"varint,4,opt,name=datums_reused,json=datumsReused,proto3" json:"datums_reused,omitempty"`
+	// estimated_process_time is the total time, summed across workers, that
+	// the invalidated datums would take to download, process and upload,
+	// based on the pipeline's previous jobs. It's unset if there are no
+	// previous jobs to go by.
+	EstimatedProcessTime *google_protobuf2.Duration `protobuf:"bytes,5,opt,name=estimated_process_time,json=estimatedProcessTime" json:"estimated_process_time,omitempty"`
+}
+
+func (m *PipelineImpact) Reset()                    { *m = PipelineImpact{} }
+func (m *PipelineImpact) String() string            { return proto.CompactTextString(m) }
+func (*PipelineImpact) ProtoMessage()               {}
+func (*PipelineImpact) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{79} }
+
+func (m *PipelineImpact) GetPipeline() *Pipeline {
+	if m != nil {
+		return m.Pipeline
+	}
+	return nil
+}
+
+func (m *PipelineImpact) GetDatumsTotal() int64 {
+	if m != nil {
+		return m.DatumsTotal
+	}
+	return 0
+}
+
+func (m *PipelineImpact) GetDatumsInvalidated() int64 {
+	if m != nil {
+		return m.DatumsInvalidated
+	}
+	return 0
+}
+
+func (m *PipelineImpact) GetDatumsReused() int64 {
+	if m != nil {
+		return m.DatumsReused
+	}
+	return 0
+}
+
+func (m *PipelineImpact) GetEstimatedProcessTime() *google_protobuf2.Duration {
+	if m != nil {
+		return m.EstimatedProcessTime
+	}
+	return nil
+}
+
+type ImpactAnalysisResponse struct {
+	// pipelines are the affected pipelines, upstream pipelines first
+	Pipelines []*PipelineImpact `protobuf:"bytes,1,rep,name=pipelines" json:"pipelines,omitempty"`
+}
+
+func (m *ImpactAnalysisResponse) Reset()                    { *m = ImpactAnalysisResponse{} }
+func (m *ImpactAnalysisResponse) String() string            { return proto.CompactTextString(m) }
+func (*ImpactAnalysisResponse) ProtoMessage()               {}
+func (*ImpactAnalysisResponse) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{80} }
+
+func (m *ImpactAnalysisResponse) GetPipelines() []*PipelineImpact {
+	if m != nil {
+		return m.Pipelines
+	}
+	return nil
+}
+
+type GarbageCollectRequest struct {
+}
+
+func (m *GarbageCollectRequest) Reset()                    { *m = GarbageCollectRequest{} }
+func (m *GarbageCollectRequest) String() string            { return proto.CompactTextString(m) }
+func (*GarbageCollectRequest) ProtoMessage()               {}
+func (*GarbageCollectRequest) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{81} }
+
+type GarbageCollectResponse struct {
+}
+
+func (m *GarbageCollectResponse) Reset()                    { *m = GarbageCollectResponse{} }
+func (m *GarbageCollectResponse) String() string            { return proto.CompactTextString(m) }
+func (*GarbageCollectResponse) ProtoMessage()               {}
+func (*GarbageCollectResponse) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{82} }
+
+func init() {
+	proto.RegisterType((*Secret)(nil), "pps.Secret")
+	proto.RegisterType((*Transform)(nil), "pps.Transform")
+	proto.RegisterType((*Egress)(nil), "pps.Egress")
+	proto.RegisterType((*EgressInfo)(nil), "pps.EgressInfo")
+	proto.RegisterType((*Job)(nil), "pps.Job")
+	proto.RegisterType((*Spout)(nil), "pps.Spout")
+	proto.RegisterType((*Service)(nil), "pps.Service")
+	proto.RegisterType((*ServicePort)(nil), "pps.ServicePort")
+	proto.RegisterType((*ReadinessProbe)(nil), "pps.ReadinessProbe")
+	proto.RegisterType((*ServiceStatus)(nil), "pps.ServiceStatus")
+	proto.RegisterType((*AtomInput)(nil), "pps.AtomInput")
+	proto.RegisterType((*CronInput)(nil), "pps.CronInput")
+	proto.RegisterType((*Input)(nil), "pps.Input")
+	proto.RegisterType((*JobInput)(nil), "pps.JobInput")
+	proto.RegisterType((*ParallelismSpec)(nil), "pps.ParallelismSpec")
+	proto.RegisterType((*InputFile)(nil), "pps.InputFile")
+	proto.RegisterType((*Datum)(nil), "pps.Datum")
+	proto.RegisterType((*DatumInfo)(nil), "pps.DatumInfo")
+	proto.RegisterType((*DatumInfos)(nil), "pps.DatumInfos")
+	proto.RegisterType((*Aggregate)(nil), "pps.Aggregate")
+	proto.RegisterType((*ProcessStats)(nil), "pps.ProcessStats")
+	proto.RegisterType((*AggregateProcessStats)(nil), "pps.AggregateProcessStats")
+	proto.RegisterType((*WorkerStatus)(nil), "pps.WorkerStatus")
+	proto.RegisterType((*ResourceSpec)(nil), "pps.ResourceSpec")
+	proto.RegisterType((*Toleration)(nil), "pps.Toleration")
+	proto.RegisterType((*AutoscalingSpec)(nil), "pps.AutoscalingSpec")
+	proto.RegisterType((*AutoscalingStatus)(nil), "pps.AutoscalingStatus")
+	proto.RegisterType((*PriorityClass)(nil), "pps.PriorityClass")
+	proto.RegisterType((*TeamQuota)(nil), "pps.TeamQuota")
+	proto.RegisterType((*AdmissionPolicy)(nil), "pps.AdmissionPolicy")
+	proto.RegisterType((*SchedulingSpec)(nil), "pps.SchedulingSpec")
+	proto.RegisterType((*JobInfo)(nil), "pps.JobInfo")
+	proto.RegisterType((*FailedDatum)(nil), "pps.FailedDatum")
+	proto.RegisterType((*DatumLineage)(nil), "pps.DatumLineage")
+	proto.RegisterType((*JobLineage)(nil), "pps.JobLineage")
+	proto.RegisterType((*Worker)(nil), "pps.Worker")
+	proto.RegisterType((*JobInfos)(nil), "pps.JobInfos")
+	proto.RegisterType((*Pipeline)(nil), "pps.Pipeline")
+	proto.RegisterType((*PipelineInput)(nil), "pps.PipelineInput")
+	proto.RegisterType((*PipelineInfo)(nil), "pps.PipelineInfo")
+	proto.RegisterType((*PipelineInfos)(nil), "pps.PipelineInfos")
+	proto.RegisterType((*Event)(nil), "pps.Event")
+	proto.RegisterType((*WebhookInfo)(nil), "pps.WebhookInfo")
+	proto.RegisterType((*WebhookInfos)(nil), "pps.WebhookInfos")
+	proto.RegisterType((*WebhookDelivery)(nil), "pps.WebhookDelivery")
+	proto.RegisterType((*CreateJobRequest)(nil), "pps.CreateJobRequest")
+	proto.RegisterType((*InspectJobRequest)(nil), "pps.InspectJobRequest")
+	proto.RegisterType((*ListJobRequest)(nil), "pps.ListJobRequest")
+	proto.RegisterType((*EgressJobRequest)(nil), "pps.EgressJobRequest")
+	proto.RegisterType((*DeleteJobRequest)(nil), "pps.DeleteJobRequest")
+	proto.RegisterType((*StopJobRequest)(nil), "pps.StopJobRequest")
+	proto.RegisterType((*GetLogsRequest)(nil), "pps.GetLogsRequest")
+	proto.RegisterType((*LogMessage)(nil), "pps.LogMessage")
+	proto.RegisterType((*RestartDatumRequest)(nil), "pps.RestartDatumRequest")
+	proto.RegisterType((*InspectDatumRequest)(nil), "pps.InspectDatumRequest")
+	proto.RegisterType((*ListDatumRequest)(nil), "pps.ListDatumRequest")
+	proto.RegisterType((*ListDatumResponse)(nil), "pps.ListDatumResponse")
+	proto.RegisterType((*ListDatumForInputRequest)(nil), "pps.ListDatumForInputRequest")
+	proto.RegisterType((*DatumSizeBucket)(nil), "pps.DatumSizeBucket")
+	proto.RegisterType((*ListDatumForInputResponse)(nil), "pps.ListDatumForInputResponse")
+	proto.RegisterType((*CreatePipelineRequest)(nil), "pps.CreatePipelineRequest")
+	proto.RegisterType((*InspectPipelineRequest)(nil), "pps.InspectPipelineRequest")
+	proto.RegisterType((*ListPipelineVersionsRequest)(nil), "pps.ListPipelineVersionsRequest")
+	proto.RegisterType((*RollbackPipelineRequest)(nil), "pps.RollbackPipelineRequest")
+	proto.RegisterType((*ListPipelineRequest)(nil), "pps.ListPipelineRequest")
+	proto.RegisterType((*DeletePipelineRequest)(nil), "pps.DeletePipelineRequest")
+	proto.RegisterType((*StartPipelineRequest)(nil), "pps.StartPipelineRequest")
+	proto.RegisterType((*StopPipelineRequest)(nil), "pps.StopPipelineRequest")
+	proto.RegisterType((*RerunPipelineRequest)(nil), "pps.RerunPipelineRequest")
+	proto.RegisterType((*ApplyPipelinesRequest)(nil), "pps.ApplyPipelinesRequest")
+	proto.RegisterType((*ApplyAction)(nil), "pps.ApplyAction")
+	proto.RegisterType((*ApplyPipelinesResponse)(nil), "pps.ApplyPipelinesResponse")
+	proto.RegisterType((*CreateWebhookRequest)(nil), "pps.CreateWebhookRequest")
+	proto.RegisterType((*ListWebhookRequest)(nil), "pps.ListWebhookRequest")
+	proto.RegisterType((*DeleteWebhookRequest)(nil), "pps.DeleteWebhookRequest")
+	proto.RegisterType((*TraceFileRequest)(nil), "pps.TraceFileRequest")
+	proto.RegisterType((*FileTrace)(nil), "pps.FileTrace")
+	proto.RegisterType((*DatumTrace)(nil), "pps.DatumTrace")
+	proto.RegisterType((*ImpactAnalysisRequest)(nil), "pps.ImpactAnalysisRequest")
+	proto.RegisterType((*PipelineImpact)(nil), "pps.PipelineImpact")
+	proto.RegisterType((*ImpactAnalysisResponse)(nil), "pps.ImpactAnalysisResponse")
+	proto.RegisterType((*GarbageCollectRequest)(nil), "pps.GarbageCollectRequest")
+	proto.RegisterType((*GarbageCollectResponse)(nil), "pps.GarbageCollectResponse")
+	proto.RegisterEnum("pps.EgressState", EgressState_name, EgressState_value)
+	proto.RegisterEnum("pps.JobState", JobState_name, JobState_value)
 	proto.RegisterEnum("pps.DatumState", DatumState_name, DatumState_value)
 	proto.RegisterEnum("pps.WorkerState", WorkerState_name, WorkerState_value)
 	proto.RegisterEnum("pps.PipelineState", PipelineState_name, PipelineState_value)
+	proto.RegisterEnum("pps.EventType", EventType_name, EventType_value)
+	proto.RegisterEnum("pps.ApplyActionType", ApplyActionType_name, ApplyActionType_value)
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -2360,16 +4445,46 @@ type APIClient interface {
 	ListJob(ctx context.Context, in *ListJobRequest, opts ...grpc.CallOption) (*JobInfos, error)
 	DeleteJob(ctx context.Context, in *DeleteJobRequest, opts ...grpc.CallOption) (*google_protobuf.Empty, error)
 	StopJob(ctx context.Context, in *StopJobRequest, opts ...grpc.CallOption) (*google_protobuf.Empty, error)
+	// EgressJob retries the egress of a job whose egress failed.
+	EgressJob(ctx context.Context, in *EgressJobRequest, opts ...grpc.CallOption) (*EgressInfo, error)
+	// SetAdmissionPolicy replaces the cluster's admission policy. An empty
+	// policy lets every job run as soon as it's created.
+	SetAdmissionPolicy(ctx context.Context, in *AdmissionPolicy, opts ...grpc.CallOption) (*google_protobuf.Empty, error)
+	GetAdmissionPolicy(ctx context.Context, in *google_protobuf.Empty, opts ...grpc.CallOption) (*AdmissionPolicy, error)
 	InspectDatum(ctx context.Context, in *InspectDatumRequest, opts ...grpc.CallOption) (*DatumInfo, error)
 	ListDatum(ctx context.Context, in *ListDatumRequest, opts ...grpc.CallOption) (*ListDatumResponse, error)
 	RestartDatum(ctx context.Context, in *RestartDatumRequest, opts ...grpc.CallOption) (*google_protobuf.Empty, error)
+	// ListDatumForInput returns the datums that a pipeline with the given
+	// input would process if it were created now, without creating anything.
+	ListDatumForInput(ctx context.Context, in *ListDatumForInputRequest, opts ...grpc.CallOption) (*ListDatumForInputResponse, error)
+	// TraceFile returns the datums, and the input files, that produced a file
+	// in a pipeline's output, following the inputs upstream through other
+	// pipelines.
+	TraceFile(ctx context.Context, in *TraceFileRequest, opts ...grpc.CallOption) (*FileTrace, error)
+	// ImpactAnalysis reports how much reprocessing a change to the files in a
+	// branch would cause in each pipeline downstream of it, without making
+	// the change.
+	ImpactAnalysis(ctx context.Context, in *ImpactAnalysisRequest, opts ...grpc.CallOption) (*ImpactAnalysisResponse, error)
 	CreatePipeline(ctx context.Context, in *CreatePipelineRequest, opts ...grpc.CallOption) (*google_protobuf.Empty, error)
 	InspectPipeline(ctx context.Context, in *InspectPipelineRequest, opts ...grpc.CallOption) (*PipelineInfo, error)
 	ListPipeline(ctx context.Context, in *ListPipelineRequest, opts ...grpc.CallOption) (*PipelineInfos, error)
 	DeletePipeline(ctx context.Context, in *DeletePipelineRequest, opts ...grpc.CallOption) (*google_protobuf.Empty, error)
 	StartPipeline(ctx context.Context, in *StartPipelineRequest, opts ...grpc.CallOption) (*google_protobuf.Empty, error)
 	StopPipeline(ctx context.Context, in *StopPipelineRequest, opts ...grpc.CallOption) (*google_protobuf.Empty, error)
-	RerunPipeline(ctx context.Context, in *RerunPipelineRequest, opts ...grpc.CallOption) (*google_protobuf.Empty, error)
+	// RerunPipeline creates jobs that reprocess the selected input commit sets
+	// of a pipeline's previous jobs.
+	RerunPipeline(ctx context.Context, in *RerunPipelineRequest, opts ...grpc.CallOption) (*JobInfos, error)
+	// ListPipelineVersions returns every version of a pipeline's spec, oldest
+	// first.
+	ListPipelineVersions(ctx context.Context, in *ListPipelineVersionsRequest, opts ...grpc.CallOption) (*PipelineInfos, error)
+	RollbackPipeline(ctx context.Context, in *RollbackPipelineRequest, opts ...grpc.CallOption) (*google_protobuf.Empty, error)
+	// ApplyPipelines brings a set of repos and pipelines to the state described
+	// by their specs.
+	ApplyPipelines(ctx context.Context, in *ApplyPipelinesRequest, opts ...grpc.CallOption) (*ApplyPipelinesResponse, error)
+	// Webhooks receive job and pipeline state changes as JSON events
+	CreateWebhook(ctx context.Context, in *CreateWebhookRequest, opts ...grpc.CallOption) (*google_protobuf.Empty, error)
+	ListWebhook(ctx context.Context, in *ListWebhookRequest, opts ...grpc.CallOption) (*WebhookInfos, error)
+	DeleteWebhook(ctx context.Context, in *DeleteWebhookRequest, opts ...grpc.CallOption) (*google_protobuf.Empty, error)
 	// DeleteAll deletes everything
 	DeleteAll(ctx context.Context, in *google_protobuf.Empty, opts ...grpc.CallOption) (*google_protobuf.Empty, error)
 	GetLogs(ctx context.Context, in *GetLogsRequest, opts ...grpc.CallOption) (API_GetLogsClient, error)
@@ -2430,18 +4545,45 @@ func (c *aPIClient) StopJob(ctx context.Context, in *StopJobRequest, opts ...grp
 	return out, nil
 }
 
-func (c *aPIClient) InspectDatum(ctx context.Context, in *InspectDatumRequest, opts ...grpc.CallOption) (*DatumInfo, error) {
-	out := new(DatumInfo)
-	err := grpc.Invoke(ctx, "/pps.API/InspectDatum", in, out, c.cc, opts...)
+func (c *aPIClient) EgressJob(ctx context.Context, in *EgressJobRequest, opts ...grpc.CallOption) (*EgressInfo, error) {
+	out := new(EgressInfo)
+	err := grpc.Invoke(ctx, "/pps.API/EgressJob", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *aPIClient) ListDatum(ctx context.Context, in *ListDatumRequest, opts ...grpc.CallOption) (*ListDatumResponse, error) {
-	out := new(ListDatumResponse)
-	err := grpc.Invoke(ctx, "/pps.API/ListDatum", in, out, c.cc, opts...)
+func (c *aPIClient) SetAdmissionPolicy(ctx context.Context, in *AdmissionPolicy, opts ...grpc.CallOption) (*google_protobuf.Empty, error) {
+	out := new(google_protobuf.Empty)
+	err := grpc.Invoke(ctx, "/pps.API/SetAdmissionPolicy", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) GetAdmissionPolicy(ctx context.Context, in *google_protobuf.Empty, opts ...grpc.CallOption) (*AdmissionPolicy, error) {
+	out := new(AdmissionPolicy)
+	err := grpc.Invoke(ctx, "/pps.API/GetAdmissionPolicy", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) InspectDatum(ctx context.Context, in *InspectDatumRequest, opts ...grpc.CallOption) (*DatumInfo, error) {
+	out := new(DatumInfo)
+	err := grpc.Invoke(ctx, "/pps.API/InspectDatum", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) ListDatum(ctx context.Context, in *ListDatumRequest, opts ...grpc.CallOption) (*ListDatumResponse, error) {
+	out := new(ListDatumResponse)
+	err := grpc.Invoke(ctx, "/pps.API/ListDatum", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -2457,6 +4599,33 @@ func (c *aPIClient) RestartDatum(ctx context.Context, in *RestartDatumRequest, o
 	return out, nil
 }
 
+func (c *aPIClient) ListDatumForInput(ctx context.Context, in *ListDatumForInputRequest, opts ...grpc.CallOption) (*ListDatumForInputResponse, error) {
+	out := new(ListDatumForInputResponse)
+	err := grpc.Invoke(ctx, "/pps.API/ListDatumForInput", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) TraceFile(ctx context.Context, in *TraceFileRequest, opts ...grpc.CallOption) (*FileTrace, error) {
+	out := new(FileTrace)
+	err := grpc.Invoke(ctx, "/pps.API/TraceFile", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) ImpactAnalysis(ctx context.Context, in *ImpactAnalysisRequest, opts ...grpc.CallOption) (*ImpactAnalysisResponse, error) {
+	out := new(ImpactAnalysisResponse)
+	err := grpc.Invoke(ctx, "/pps.API/ImpactAnalysis", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *aPIClient) CreatePipeline(ctx context.Context, in *CreatePipelineRequest, opts ...grpc.CallOption) (*google_protobuf.Empty, error) {
 	out := new(google_protobuf.Empty)
 	err := grpc.Invoke(ctx, "/pps.API/CreatePipeline", in, out, c.cc, opts...)
@@ -2511,8 +4680,8 @@ func (c *aPIClient) StopPipeline(ctx context.Context, in *StopPipelineRequest, o
 	return out, nil
 }
 
-func (c *aPIClient) RerunPipeline(ctx context.Context, in *RerunPipelineRequest, opts ...grpc.CallOption) (*google_protobuf.Empty, error) {
-	out := new(google_protobuf.Empty)
+func (c *aPIClient) RerunPipeline(ctx context.Context, in *RerunPipelineRequest, opts ...grpc.CallOption) (*JobInfos, error) {
+	out := new(JobInfos)
 	err := grpc.Invoke(ctx, "/pps.API/RerunPipeline", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
@@ -2520,6 +4689,60 @@ func (c *aPIClient) RerunPipeline(ctx context.Context, in *RerunPipelineRequest,
 	return out, nil
 }
 
+func (c *aPIClient) ListPipelineVersions(ctx context.Context, in *ListPipelineVersionsRequest, opts ...grpc.CallOption) (*PipelineInfos, error) {
+	out := new(PipelineInfos)
+	err := grpc.Invoke(ctx, "/pps.API/ListPipelineVersions", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) RollbackPipeline(ctx context.Context, in *RollbackPipelineRequest, opts ...grpc.CallOption) (*google_protobuf.Empty, error) {
+	out := new(google_protobuf.Empty)
+	err := grpc.Invoke(ctx, "/pps.API/RollbackPipeline", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) ApplyPipelines(ctx context.Context, in *ApplyPipelinesRequest, opts ...grpc.CallOption) (*ApplyPipelinesResponse, error) {
+	out := new(ApplyPipelinesResponse)
+	err := grpc.Invoke(ctx, "/pps.API/ApplyPipelines", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) CreateWebhook(ctx context.Context, in *CreateWebhookRequest, opts ...grpc.CallOption) (*google_protobuf.Empty, error) {
+	out := new(google_protobuf.Empty)
+	err := grpc.Invoke(ctx, "/pps.API/CreateWebhook", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) ListWebhook(ctx context.Context, in *ListWebhookRequest, opts ...grpc.CallOption) (*WebhookInfos, error) {
+	out := new(WebhookInfos)
+	err := grpc.Invoke(ctx, "/pps.API/ListWebhook", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) DeleteWebhook(ctx context.Context, in *DeleteWebhookRequest, opts ...grpc.CallOption) (*google_protobuf.Empty, error) {
+	out := new(google_protobuf.Empty)
+	err := grpc.Invoke(ctx, "/pps.API/DeleteWebhook", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *aPIClient) DeleteAll(ctx context.Context, in *google_protobuf.Empty, opts ...grpc.CallOption) (*google_protobuf.Empty, error) {
 	out := new(google_protobuf.Empty)
 	err := grpc.Invoke(ctx, "/pps.API/DeleteAll", in, out, c.cc, opts...)
@@ -2578,16 +4801,46 @@ type APIServer interface {
 	ListJob(context.Context, *ListJobRequest) (*JobInfos, error)
 	DeleteJob(context.Context, *DeleteJobRequest) (*google_protobuf.Empty, error)
 	StopJob(context.Context, *StopJobRequest) (*google_protobuf.Empty, error)
+	// EgressJob retries the egress of a job whose egress failed.
+	EgressJob(context.Context, *EgressJobRequest) (*EgressInfo, error)
+	// SetAdmissionPolicy replaces the cluster's admission policy. An empty
+	// policy lets every job run as soon as it's created.
+	SetAdmissionPolicy(context.Context, *AdmissionPolicy) (*google_protobuf.Empty, error)
+	GetAdmissionPolicy(context.Context, *google_protobuf.Empty) (*AdmissionPolicy, error)
 	InspectDatum(context.Context, *InspectDatumRequest) (*DatumInfo, error)
 	ListDatum(context.Context, *ListDatumRequest) (*ListDatumResponse, error)
 	RestartDatum(context.Context, *RestartDatumRequest) (*google_protobuf.Empty, error)
+	// ListDatumForInput returns the datums that a pipeline with the given
+	// input would process if it were created now, without creating anything.
+	ListDatumForInput(context.Context, *ListDatumForInputRequest) (*ListDatumForInputResponse, error)
+	// TraceFile returns the datums, and the input files, that produced a file
+	// in a pipeline's output, following the inputs upstream through other
+	// pipelines.
+	TraceFile(context.Context, *TraceFileRequest) (*FileTrace, error)
+	// ImpactAnalysis reports how much reprocessing a change to the files in a
+	// branch would cause in each pipeline downstream of it, without making
+	// the change.
+	ImpactAnalysis(context.Context, *ImpactAnalysisRequest) (*ImpactAnalysisResponse, error)
 	CreatePipeline(context.Context, *CreatePipelineRequest) (*google_protobuf.Empty, error)
 	InspectPipeline(context.Context, *InspectPipelineRequest) (*PipelineInfo, error)
 	ListPipeline(context.Context, *ListPipelineRequest) (*PipelineInfos, error)
 	DeletePipeline(context.Context, *DeletePipelineRequest) (*google_protobuf.Empty, error)
 	StartPipeline(context.Context, *StartPipelineRequest) (*google_protobuf.Empty, error)
 	StopPipeline(context.Context, *StopPipelineRequest) (*google_protobuf.Empty, error)
-	RerunPipeline(context.Context, *RerunPipelineRequest) (*google_protobuf.Empty, error)
+	// RerunPipeline creates jobs that reprocess the selected input commit sets
+	// of a pipeline's previous jobs.
+	RerunPipeline(context.Context, *RerunPipelineRequest) (*JobInfos, error)
+	// ListPipelineVersions returns every version of a pipeline's spec, oldest
+	// first.
+	ListPipelineVersions(context.Context, *ListPipelineVersionsRequest) (*PipelineInfos, error)
+	RollbackPipeline(context.Context, *RollbackPipelineRequest) (*google_protobuf.Empty, error)
+	// ApplyPipelines brings a set of repos and pipelines to the state described
+	// by their specs.
+	ApplyPipelines(context.Context, *ApplyPipelinesRequest) (*ApplyPipelinesResponse, error)
+	// Webhooks receive job and pipeline state changes as JSON events
+	CreateWebhook(context.Context, *CreateWebhookRequest) (*google_protobuf.Empty, error)
+	ListWebhook(context.Context, *ListWebhookRequest) (*WebhookInfos, error)
+	DeleteWebhook(context.Context, *DeleteWebhookRequest) (*google_protobuf.Empty, error)
 	// DeleteAll deletes everything
 	DeleteAll(context.Context, *google_protobuf.Empty) (*google_protobuf.Empty, error)
 	GetLogs(*GetLogsRequest, API_GetLogsServer) error
@@ -2689,6 +4942,60 @@ func _API_StopJob_Handler(srv interface{}, ctx context.Context, dec func(interfa
 	return interceptor(ctx, in, info, handler)
 }
 
+func _API_EgressJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EgressJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).EgressJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pps.API/EgressJob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).EgressJob(ctx, req.(*EgressJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_SetAdmissionPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdmissionPolicy)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).SetAdmissionPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pps.API/SetAdmissionPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).SetAdmissionPolicy(ctx, req.(*AdmissionPolicy))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_GetAdmissionPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(google_protobuf.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).GetAdmissionPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pps.API/GetAdmissionPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).GetAdmissionPolicy(ctx, req.(*google_protobuf.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _API_InspectDatum_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(InspectDatumRequest)
 	if err := dec(in); err != nil {
@@ -2743,6 +5050,60 @@ func _API_RestartDatum_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _API_ListDatumForInput_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDatumForInputRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).ListDatumForInput(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pps.API/ListDatumForInput",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).ListDatumForInput(ctx, req.(*ListDatumForInputRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_TraceFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TraceFileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).TraceFile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pps.API/TraceFile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).TraceFile(ctx, req.(*TraceFileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_ImpactAnalysis_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImpactAnalysisRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).ImpactAnalysis(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pps.API/ImpactAnalysis",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).ImpactAnalysis(ctx, req.(*ImpactAnalysisRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _API_CreatePipeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreatePipelineRequest)
 	if err := dec(in); err != nil {
@@ -2869,6 +5230,114 @@ func _API_RerunPipeline_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _API_ListPipelineVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPipelineVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).ListPipelineVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pps.API/ListPipelineVersions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).ListPipelineVersions(ctx, req.(*ListPipelineVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_RollbackPipeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RollbackPipelineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).RollbackPipeline(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pps.API/RollbackPipeline",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).RollbackPipeline(ctx, req.(*RollbackPipelineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_ApplyPipelines_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplyPipelinesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).ApplyPipelines(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pps.API/ApplyPipelines",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).ApplyPipelines(ctx, req.(*ApplyPipelinesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_CreateWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).CreateWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pps.API/CreateWebhook",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).CreateWebhook(ctx, req.(*CreateWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_ListWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).ListWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pps.API/ListWebhook",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).ListWebhook(ctx, req.(*ListWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_DeleteWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).DeleteWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pps.API/DeleteWebhook",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).DeleteWebhook(ctx, req.(*DeleteWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _API_DeleteAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(google_protobuf.Empty)
 	if err := dec(in); err != nil {
@@ -2950,6 +5419,18 @@ var _API_serviceDesc = grpc.ServiceDesc{
 			MethodName: "StopJob",
 			Handler:    _API_StopJob_Handler,
 		},
+		{
+			MethodName: "EgressJob",
+			Handler:    _API_EgressJob_Handler,
+		},
+		{
+			MethodName: "SetAdmissionPolicy",
+			Handler:    _API_SetAdmissionPolicy_Handler,
+		},
+		{
+			MethodName: "GetAdmissionPolicy",
+			Handler:    _API_GetAdmissionPolicy_Handler,
+		},
 		{
 			MethodName: "InspectDatum",
 			Handler:    _API_InspectDatum_Handler,
@@ -2962,6 +5443,18 @@ var _API_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RestartDatum",
 			Handler:    _API_RestartDatum_Handler,
 		},
+		{
+			MethodName: "ListDatumForInput",
+			Handler:    _API_ListDatumForInput_Handler,
+		},
+		{
+			MethodName: "TraceFile",
+			Handler:    _API_TraceFile_Handler,
+		},
+		{
+			MethodName: "ImpactAnalysis",
+			Handler:    _API_ImpactAnalysis_Handler,
+		},
 		{
 			MethodName: "CreatePipeline",
 			Handler:    _API_CreatePipeline_Handler,
@@ -2991,8 +5484,32 @@ var _API_serviceDesc = grpc.ServiceDesc{
 			Handler:    _API_RerunPipeline_Handler,
 		},
 		{
-			MethodName: "DeleteAll",
-			Handler:    _API_DeleteAll_Handler,
+			MethodName: "ListPipelineVersions",
+			Handler:    _API_ListPipelineVersions_Handler,
+		},
+		{
+			MethodName: "RollbackPipeline",
+			Handler:    _API_RollbackPipeline_Handler,
+		},
+		{
+			MethodName: "ApplyPipelines",
+			Handler:    _API_ApplyPipelines_Handler,
+		},
+		{
+			MethodName: "CreateWebhook",
+			Handler:    _API_CreateWebhook_Handler,
+		},
+		{
+			MethodName: "ListWebhook",
+			Handler:    _API_ListWebhook_Handler,
+		},
+		{
+			MethodName: "DeleteWebhook",
+			Handler:    _API_DeleteWebhook_Handler,
+		},
+		{
+			MethodName: "DeleteAll",
+			Handler:    _API_DeleteAll_Handler,
 		},
 		{
 			MethodName: "GarbageCollect",
@@ -3198,6 +5715,91 @@ func (m *Egress) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintPps(dAtA, i, uint64(len(m.URL)))
 		i += copy(dAtA[i:], m.URL)
 	}
+	if m.Retries != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Retries))
+	}
+	return i, nil
+}
+
+func (m *EgressInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EgressInfo) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.State != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.State))
+	}
+	if len(m.Reason) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Reason)))
+		i += copy(dAtA[i:], m.Reason)
+	}
+	if len(m.URL) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(len(m.URL)))
+		i += copy(dAtA[i:], m.URL)
+	}
+	if m.BaseCommit != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.BaseCommit.Size()))
+		n3, err := m.BaseCommit.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n3
+	}
+	if m.FilesPushed != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.FilesPushed))
+	}
+	if m.FilesDeleted != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.FilesDeleted))
+	}
+	if m.BytesPushed != 0 {
+		dAtA[i] = 0x38
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.BytesPushed))
+	}
+	if m.Started != nil {
+		dAtA[i] = 0x42
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Started.Size()))
+		n4, err := m.Started.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n4
+	}
+	if m.Finished != nil {
+		dAtA[i] = 0x4a
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Finished.Size()))
+		n5, err := m.Finished.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n5
+	}
 	return i, nil
 }
 
@@ -3225,7 +5827,7 @@ func (m *Job) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *Service) Marshal() (dAtA []byte, err error) {
+func (m *Spout) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -3235,25 +5837,46 @@ func (m *Service) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Service) MarshalTo(dAtA []byte) (int, error) {
+func (m *Spout) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.InternalPort != 0 {
+	if m.Overwrite {
 		dAtA[i] = 0x8
 		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.InternalPort))
+		if m.Overwrite {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
 	}
-	if m.ExternalPort != 0 {
+	if m.CommitBytes != 0 {
 		dAtA[i] = 0x10
 		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.ExternalPort))
+		i = encodeVarintPps(dAtA, i, uint64(m.CommitBytes))
+	}
+	if m.CommitInterval != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.CommitInterval.Size()))
+		n6, err := m.CommitInterval.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n6
+	}
+	if len(m.Marker) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Marker)))
+		i += copy(dAtA[i:], m.Marker)
 	}
 	return i, nil
 }
 
-func (m *AtomInput) Marshal() (dAtA []byte, err error) {
+func (m *Service) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -3263,61 +5886,53 @@ func (m *AtomInput) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *AtomInput) MarshalTo(dAtA []byte) (int, error) {
+func (m *Service) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.Name) > 0 {
-		dAtA[i] = 0xa
+	if m.InternalPort != 0 {
+		dAtA[i] = 0x8
 		i++
-		i = encodeVarintPps(dAtA, i, uint64(len(m.Name)))
-		i += copy(dAtA[i:], m.Name)
+		i = encodeVarintPps(dAtA, i, uint64(m.InternalPort))
 	}
-	if len(m.Repo) > 0 {
-		dAtA[i] = 0x12
+	if m.ExternalPort != 0 {
+		dAtA[i] = 0x10
 		i++
-		i = encodeVarintPps(dAtA, i, uint64(len(m.Repo)))
-		i += copy(dAtA[i:], m.Repo)
+		i = encodeVarintPps(dAtA, i, uint64(m.ExternalPort))
 	}
-	if len(m.Branch) > 0 {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintPps(dAtA, i, uint64(len(m.Branch)))
-		i += copy(dAtA[i:], m.Branch)
+	if len(m.Ports) > 0 {
+		for _, msg := range m.Ports {
+			dAtA[i] = 0x1a
+			i++
+			i = encodeVarintPps(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
 	}
-	if len(m.Commit) > 0 {
+	if len(m.Type) > 0 {
 		dAtA[i] = 0x22
 		i++
-		i = encodeVarintPps(dAtA, i, uint64(len(m.Commit)))
-		i += copy(dAtA[i:], m.Commit)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Type)))
+		i += copy(dAtA[i:], m.Type)
 	}
-	if len(m.Glob) > 0 {
+	if m.ReadinessProbe != nil {
 		dAtA[i] = 0x2a
 		i++
-		i = encodeVarintPps(dAtA, i, uint64(len(m.Glob)))
-		i += copy(dAtA[i:], m.Glob)
-	}
-	if m.Lazy {
-		dAtA[i] = 0x30
-		i++
-		if m.Lazy {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+		i = encodeVarintPps(dAtA, i, uint64(m.ReadinessProbe.Size()))
+		n7, err := m.ReadinessProbe.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
 		}
-		i++
-	}
-	if len(m.FromCommit) > 0 {
-		dAtA[i] = 0x3a
-		i++
-		i = encodeVarintPps(dAtA, i, uint64(len(m.FromCommit)))
-		i += copy(dAtA[i:], m.FromCommit)
+		i += n7
 	}
 	return i, nil
 }
 
-func (m *CronInput) Marshal() (dAtA []byte, err error) {
+func (m *ServicePort) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -3327,7 +5942,7 @@ func (m *CronInput) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *CronInput) MarshalTo(dAtA []byte) (int, error) {
+func (m *ServicePort) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -3338,38 +5953,99 @@ func (m *CronInput) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintPps(dAtA, i, uint64(len(m.Name)))
 		i += copy(dAtA[i:], m.Name)
 	}
-	if len(m.Repo) > 0 {
-		dAtA[i] = 0x12
+	if m.InternalPort != 0 {
+		dAtA[i] = 0x10
 		i++
-		i = encodeVarintPps(dAtA, i, uint64(len(m.Repo)))
-		i += copy(dAtA[i:], m.Repo)
+		i = encodeVarintPps(dAtA, i, uint64(m.InternalPort))
 	}
-	if len(m.Commit) > 0 {
-		dAtA[i] = 0x1a
+	if m.ExternalPort != 0 {
+		dAtA[i] = 0x18
 		i++
-		i = encodeVarintPps(dAtA, i, uint64(len(m.Commit)))
-		i += copy(dAtA[i:], m.Commit)
+		i = encodeVarintPps(dAtA, i, uint64(m.ExternalPort))
 	}
-	if len(m.Spec) > 0 {
+	return i, nil
+}
+
+func (m *ReadinessProbe) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReadinessProbe) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.HttpPath) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(len(m.HttpPath)))
+		i += copy(dAtA[i:], m.HttpPath)
+	}
+	if m.Port != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Port))
+	}
+	if len(m.Exec) > 0 {
+		for _, s := range m.Exec {
+			dAtA[i] = 0x1a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if m.InitialDelay != nil {
 		dAtA[i] = 0x22
 		i++
-		i = encodeVarintPps(dAtA, i, uint64(len(m.Spec)))
-		i += copy(dAtA[i:], m.Spec)
+		i = encodeVarintPps(dAtA, i, uint64(m.InitialDelay.Size()))
+		n8, err := m.InitialDelay.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n8
 	}
-	if m.Start != nil {
+	if m.Period != nil {
 		dAtA[i] = 0x2a
 		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.Start.Size()))
-		n3, err := m.Start.MarshalTo(dAtA[i:])
+		i = encodeVarintPps(dAtA, i, uint64(m.Period.Size()))
+		n9, err := m.Period.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n3
+		i += n9
+	}
+	if m.Timeout != nil {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Timeout.Size()))
+		n10, err := m.Timeout.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n10
+	}
+	if m.FailureThreshold != 0 {
+		dAtA[i] = 0x38
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.FailureThreshold))
 	}
 	return i, nil
 }
 
-func (m *Input) Marshal() (dAtA []byte, err error) {
+func (m *ServiceStatus) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -3379,23 +6055,19 @@ func (m *Input) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Input) MarshalTo(dAtA []byte) (int, error) {
+func (m *ServiceStatus) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Atom != nil {
+	if len(m.Job) > 0 {
 		dAtA[i] = 0xa
 		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.Atom.Size()))
-		n4, err := m.Atom.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n4
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Job)))
+		i += copy(dAtA[i:], m.Job)
 	}
-	if len(m.Cross) > 0 {
-		for _, msg := range m.Cross {
+	if len(m.Commits) > 0 {
+		for _, msg := range m.Commits {
 			dAtA[i] = 0x12
 			i++
 			i = encodeVarintPps(dAtA, i, uint64(msg.Size()))
@@ -3406,9 +6078,15 @@ func (m *Input) MarshalTo(dAtA []byte) (int, error) {
 			i += n
 		}
 	}
-	if len(m.Union) > 0 {
-		for _, msg := range m.Union {
-			dAtA[i] = 0x1a
+	if len(m.PendingJob) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(len(m.PendingJob)))
+		i += copy(dAtA[i:], m.PendingJob)
+	}
+	if len(m.PendingCommits) > 0 {
+		for _, msg := range m.PendingCommits {
+			dAtA[i] = 0x22
 			i++
 			i = encodeVarintPps(dAtA, i, uint64(msg.Size()))
 			n, err := msg.MarshalTo(dAtA[i:])
@@ -3418,15 +6096,193 @@ func (m *Input) MarshalTo(dAtA []byte) (int, error) {
 			i += n
 		}
 	}
-	if m.Cron != nil {
-		dAtA[i] = 0x22
+	if m.Updated != nil {
+		dAtA[i] = 0x2a
 		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.Cron.Size()))
-		n5, err := m.Cron.MarshalTo(dAtA[i:])
+		i = encodeVarintPps(dAtA, i, uint64(m.Updated.Size()))
+		n11, err := m.Updated.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n5
+		i += n11
+	}
+	return i, nil
+}
+
+func (m *AtomInput) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AtomInput) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
+	}
+	if len(m.Repo) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Repo)))
+		i += copy(dAtA[i:], m.Repo)
+	}
+	if len(m.Branch) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Branch)))
+		i += copy(dAtA[i:], m.Branch)
+	}
+	if len(m.Commit) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Commit)))
+		i += copy(dAtA[i:], m.Commit)
+	}
+	if len(m.Glob) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Glob)))
+		i += copy(dAtA[i:], m.Glob)
+	}
+	if m.Lazy {
+		dAtA[i] = 0x30
+		i++
+		if m.Lazy {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if len(m.FromCommit) > 0 {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(len(m.FromCommit)))
+		i += copy(dAtA[i:], m.FromCommit)
+	}
+	return i, nil
+}
+
+func (m *CronInput) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CronInput) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
+	}
+	if len(m.Repo) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Repo)))
+		i += copy(dAtA[i:], m.Repo)
+	}
+	if len(m.Commit) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Commit)))
+		i += copy(dAtA[i:], m.Commit)
+	}
+	if len(m.Spec) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Spec)))
+		i += copy(dAtA[i:], m.Spec)
+	}
+	if m.Start != nil {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Start.Size()))
+		n12, err := m.Start.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n12
+	}
+	return i, nil
+}
+
+func (m *Input) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Input) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Atom != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Atom.Size()))
+		n13, err := m.Atom.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n13
+	}
+	if len(m.Cross) > 0 {
+		for _, msg := range m.Cross {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintPps(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.Union) > 0 {
+		for _, msg := range m.Union {
+			dAtA[i] = 0x1a
+			i++
+			i = encodeVarintPps(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.Cron != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Cron.Size()))
+		n14, err := m.Cron.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n14
 	}
 	return i, nil
 }
@@ -3450,11 +6306,11 @@ func (m *JobInput) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.Commit.Size()))
-		n6, err := m.Commit.MarshalTo(dAtA[i:])
+		n15, err := m.Commit.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n6
+		i += n15
 	}
 	if len(m.Glob) > 0 {
 		dAtA[i] = 0x12
@@ -3564,11 +6420,11 @@ func (m *Datum) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.Job.Size()))
-		n7, err := m.Job.MarshalTo(dAtA[i:])
+		n16, err := m.Job.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n7
+		i += n16
 	}
 	return i, nil
 }
@@ -3592,11 +6448,11 @@ func (m *DatumInfo) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.Datum.Size()))
-		n8, err := m.Datum.MarshalTo(dAtA[i:])
+		n17, err := m.Datum.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n8
+		i += n17
 	}
 	if m.State != 0 {
 		dAtA[i] = 0x10
@@ -3607,21 +6463,21 @@ func (m *DatumInfo) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x1a
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.Stats.Size()))
-		n9, err := m.Stats.MarshalTo(dAtA[i:])
+		n18, err := m.Stats.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n9
+		i += n18
 	}
 	if m.PfsState != nil {
 		dAtA[i] = 0x22
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.PfsState.Size()))
-		n10, err := m.PfsState.MarshalTo(dAtA[i:])
+		n19, err := m.PfsState.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n10
+		i += n19
 	}
 	if len(m.Data) > 0 {
 		for _, msg := range m.Data {
@@ -3730,31 +6586,31 @@ func (m *ProcessStats) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.DownloadTime.Size()))
-		n11, err := m.DownloadTime.MarshalTo(dAtA[i:])
+		n20, err := m.DownloadTime.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n11
+		i += n20
 	}
 	if m.ProcessTime != nil {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.ProcessTime.Size()))
-		n12, err := m.ProcessTime.MarshalTo(dAtA[i:])
+		n21, err := m.ProcessTime.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n12
+		i += n21
 	}
 	if m.UploadTime != nil {
 		dAtA[i] = 0x1a
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.UploadTime.Size()))
-		n13, err := m.UploadTime.MarshalTo(dAtA[i:])
+		n22, err := m.UploadTime.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n13
+		i += n22
 	}
 	if m.DownloadBytes != 0 {
 		dAtA[i] = 0x20
@@ -3788,51 +6644,51 @@ func (m *AggregateProcessStats) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.DownloadTime.Size()))
-		n14, err := m.DownloadTime.MarshalTo(dAtA[i:])
+		n23, err := m.DownloadTime.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n14
+		i += n23
 	}
 	if m.ProcessTime != nil {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.ProcessTime.Size()))
-		n15, err := m.ProcessTime.MarshalTo(dAtA[i:])
+		n24, err := m.ProcessTime.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n15
+		i += n24
 	}
 	if m.UploadTime != nil {
 		dAtA[i] = 0x1a
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.UploadTime.Size()))
-		n16, err := m.UploadTime.MarshalTo(dAtA[i:])
+		n25, err := m.UploadTime.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n16
+		i += n25
 	}
 	if m.DownloadBytes != nil {
 		dAtA[i] = 0x22
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.DownloadBytes.Size()))
-		n17, err := m.DownloadBytes.MarshalTo(dAtA[i:])
+		n26, err := m.DownloadBytes.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n17
+		i += n26
 	}
 	if m.UploadBytes != nil {
 		dAtA[i] = 0x2a
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.UploadBytes.Size()))
-		n18, err := m.UploadBytes.MarshalTo(dAtA[i:])
+		n27, err := m.UploadBytes.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n18
+		i += n27
 	}
 	return i, nil
 }
@@ -3880,21 +6736,21 @@ func (m *WorkerStatus) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x22
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.Started.Size()))
-		n19, err := m.Started.MarshalTo(dAtA[i:])
+		n28, err := m.Started.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n19
+		i += n28
 	}
 	if m.Stats != nil {
 		dAtA[i] = 0x2a
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.Stats.Size()))
-		n20, err := m.Stats.MarshalTo(dAtA[i:])
+		n29, err := m.Stats.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n20
+		i += n29
 	}
 	if m.QueueSize != 0 {
 		dAtA[i] = 0x30
@@ -3938,7 +6794,7 @@ func (m *ResourceSpec) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *JobInfo) Marshal() (dAtA []byte, err error) {
+func (m *Toleration) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -3948,299 +6804,176 @@ func (m *JobInfo) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *JobInfo) MarshalTo(dAtA []byte) (int, error) {
+func (m *Toleration) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Job != nil {
+	if len(m.Key) > 0 {
 		dAtA[i] = 0xa
 		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.Job.Size()))
-		n21, err := m.Job.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n21
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Key)))
+		i += copy(dAtA[i:], m.Key)
 	}
-	if m.Transform != nil {
+	if len(m.Operator) > 0 {
 		dAtA[i] = 0x12
 		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.Transform.Size()))
-		n22, err := m.Transform.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n22
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Operator)))
+		i += copy(dAtA[i:], m.Operator)
 	}
-	if m.Pipeline != nil {
+	if len(m.Value) > 0 {
 		dAtA[i] = 0x1a
 		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.Pipeline.Size()))
-		n23, err := m.Pipeline.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n23
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Value)))
+		i += copy(dAtA[i:], m.Value)
 	}
-	if m.ParentJob != nil {
-		dAtA[i] = 0x32
+	if len(m.Effect) > 0 {
+		dAtA[i] = 0x22
 		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.ParentJob.Size()))
-		n24, err := m.ParentJob.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n24
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Effect)))
+		i += copy(dAtA[i:], m.Effect)
 	}
-	if m.Started != nil {
-		dAtA[i] = 0x3a
-		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.Started.Size()))
-		n25, err := m.Started.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n25
+	return i, nil
+}
+
+func (m *AutoscalingSpec) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	if m.Finished != nil {
-		dAtA[i] = 0x42
-		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.Finished.Size()))
-		n26, err := m.Finished.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n26
-	}
-	if m.OutputCommit != nil {
-		dAtA[i] = 0x4a
-		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.OutputCommit.Size()))
-		n27, err := m.OutputCommit.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n27
-	}
-	if m.State != 0 {
-		dAtA[i] = 0x50
+	return dAtA[:n], nil
+}
+
+func (m *AutoscalingSpec) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.MinWorkers != 0 {
+		dAtA[i] = 0x8
 		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.State))
+		i = encodeVarintPps(dAtA, i, uint64(m.MinWorkers))
 	}
-	if m.ParallelismSpec != nil {
-		dAtA[i] = 0x62
+	if m.MaxWorkers != 0 {
+		dAtA[i] = 0x10
 		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.ParallelismSpec.Size()))
-		n28, err := m.ParallelismSpec.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n28
+		i = encodeVarintPps(dAtA, i, uint64(m.MaxWorkers))
 	}
-	if m.PipelineVersion != 0 {
-		dAtA[i] = 0x68
+	if m.TargetQueueSize != 0 {
+		dAtA[i] = 0x18
 		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.PipelineVersion))
+		i = encodeVarintPps(dAtA, i, uint64(m.TargetQueueSize))
 	}
-	if m.Service != nil {
-		dAtA[i] = 0x72
+	if m.TargetDrainTime != nil {
+		dAtA[i] = 0x22
 		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.Service.Size()))
-		n29, err := m.Service.MarshalTo(dAtA[i:])
+		i = encodeVarintPps(dAtA, i, uint64(m.TargetDrainTime.Size()))
+		n30, err := m.TargetDrainTime.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n29
+		i += n30
 	}
-	if m.Egress != nil {
-		dAtA[i] = 0x7a
+	if m.ScaleUpCooldown != nil {
+		dAtA[i] = 0x2a
 		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.Egress.Size()))
-		n30, err := m.Egress.MarshalTo(dAtA[i:])
+		i = encodeVarintPps(dAtA, i, uint64(m.ScaleUpCooldown.Size()))
+		n31, err := m.ScaleUpCooldown.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n30
-	}
-	if len(m.OutputBranch) > 0 {
-		dAtA[i] = 0x8a
-		i++
-		dAtA[i] = 0x1
-		i++
-		i = encodeVarintPps(dAtA, i, uint64(len(m.OutputBranch)))
-		i += copy(dAtA[i:], m.OutputBranch)
+		i += n31
 	}
-	if m.OutputRepo != nil {
-		dAtA[i] = 0x92
-		i++
-		dAtA[i] = 0x1
+	if m.ScaleDownCooldown != nil {
+		dAtA[i] = 0x32
 		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.OutputRepo.Size()))
-		n31, err := m.OutputRepo.MarshalTo(dAtA[i:])
+		i = encodeVarintPps(dAtA, i, uint64(m.ScaleDownCooldown.Size()))
+		n32, err := m.ScaleDownCooldown.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n31
+		i += n32
 	}
-	if m.Restart != 0 {
-		dAtA[i] = 0xa0
-		i++
-		dAtA[i] = 0x1
-		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.Restart))
+	return i, nil
+}
+
+func (m *AutoscalingStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	if m.DataProcessed != 0 {
-		dAtA[i] = 0xb0
-		i++
-		dAtA[i] = 0x1
+	return dAtA[:n], nil
+}
+
+func (m *AutoscalingStatus) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.CurrentWorkers != 0 {
+		dAtA[i] = 0x8
 		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.DataProcessed))
+		i = encodeVarintPps(dAtA, i, uint64(m.CurrentWorkers))
 	}
-	if m.DataTotal != 0 {
-		dAtA[i] = 0xb8
-		i++
-		dAtA[i] = 0x1
+	if m.DesiredWorkers != 0 {
+		dAtA[i] = 0x10
 		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.DataTotal))
-	}
-	if len(m.WorkerStatus) > 0 {
-		for _, msg := range m.WorkerStatus {
-			dAtA[i] = 0xc2
-			i++
-			dAtA[i] = 0x1
-			i++
-			i = encodeVarintPps(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
+		i = encodeVarintPps(dAtA, i, uint64(m.DesiredWorkers))
 	}
-	if m.ResourceSpec != nil {
-		dAtA[i] = 0xca
-		i++
-		dAtA[i] = 0x1
+	if m.PendingDatums != 0 {
+		dAtA[i] = 0x18
 		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.ResourceSpec.Size()))
-		n32, err := m.ResourceSpec.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n32
+		i = encodeVarintPps(dAtA, i, uint64(m.PendingDatums))
 	}
-	if m.Input != nil {
-		dAtA[i] = 0xd2
+	if m.QueuedDatums != 0 {
+		dAtA[i] = 0x20
 		i++
-		dAtA[i] = 0x1
+		i = encodeVarintPps(dAtA, i, uint64(m.QueuedDatums))
+	}
+	if m.DatumLatency != nil {
+		dAtA[i] = 0x2a
 		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.Input.Size()))
-		n33, err := m.Input.MarshalTo(dAtA[i:])
+		i = encodeVarintPps(dAtA, i, uint64(m.DatumLatency.Size()))
+		n33, err := m.DatumLatency.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
 		i += n33
 	}
-	if m.NewBranch != nil {
-		dAtA[i] = 0xda
+	if len(m.Reason) > 0 {
+		dAtA[i] = 0x32
 		i++
-		dAtA[i] = 0x1
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Reason)))
+		i += copy(dAtA[i:], m.Reason)
+	}
+	if m.LastScaled != nil {
+		dAtA[i] = 0x3a
 		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.NewBranch.Size()))
-		n34, err := m.NewBranch.MarshalTo(dAtA[i:])
+		i = encodeVarintPps(dAtA, i, uint64(m.LastScaled.Size()))
+		n34, err := m.LastScaled.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
 		i += n34
 	}
-	if m.Incremental {
-		dAtA[i] = 0xe0
-		i++
-		dAtA[i] = 0x1
-		i++
-		if m.Incremental {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
-	}
-	if m.StatsCommit != nil {
-		dAtA[i] = 0xea
-		i++
-		dAtA[i] = 0x1
+	if m.Updated != nil {
+		dAtA[i] = 0x42
 		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.StatsCommit.Size()))
-		n35, err := m.StatsCommit.MarshalTo(dAtA[i:])
+		i = encodeVarintPps(dAtA, i, uint64(m.Updated.Size()))
+		n35, err := m.Updated.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
 		i += n35
 	}
-	if m.DataSkipped != 0 {
-		dAtA[i] = 0xf0
-		i++
-		dAtA[i] = 0x1
-		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.DataSkipped))
-	}
-	if m.Stats != nil {
-		dAtA[i] = 0xfa
-		i++
-		dAtA[i] = 0x1
-		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.Stats.Size()))
-		n36, err := m.Stats.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n36
-	}
-	if m.EnableStats {
-		dAtA[i] = 0x80
-		i++
-		dAtA[i] = 0x2
-		i++
-		if m.EnableStats {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
-	}
-	if len(m.Salt) > 0 {
-		dAtA[i] = 0x8a
-		i++
-		dAtA[i] = 0x2
-		i++
-		i = encodeVarintPps(dAtA, i, uint64(len(m.Salt)))
-		i += copy(dAtA[i:], m.Salt)
-	}
-	if m.Batch {
-		dAtA[i] = 0x90
-		i++
-		dAtA[i] = 0x2
-		i++
-		if m.Batch {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
-	}
-	if len(m.Reason) > 0 {
-		dAtA[i] = 0x9a
-		i++
-		dAtA[i] = 0x2
-		i++
-		i = encodeVarintPps(dAtA, i, uint64(len(m.Reason)))
-		i += copy(dAtA[i:], m.Reason)
-	}
 	return i, nil
 }
 
-func (m *Worker) Marshal() (dAtA []byte, err error) {
+func (m *PriorityClass) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -4250,7 +6983,7 @@ func (m *Worker) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Worker) MarshalTo(dAtA []byte) (int, error) {
+func (m *PriorityClass) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -4261,15 +6994,25 @@ func (m *Worker) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintPps(dAtA, i, uint64(len(m.Name)))
 		i += copy(dAtA[i:], m.Name)
 	}
-	if m.State != 0 {
+	if m.Priority != 0 {
 		dAtA[i] = 0x10
 		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.State))
+		i = encodeVarintPps(dAtA, i, uint64(m.Priority))
+	}
+	if m.MaxRunningJobs != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.MaxRunningJobs))
+	}
+	if m.MaxWorkers != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.MaxWorkers))
 	}
 	return i, nil
 }
 
-func (m *JobInfos) Marshal() (dAtA []byte, err error) {
+func (m *TeamQuota) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -4279,27 +7022,31 @@ func (m *JobInfos) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *JobInfos) MarshalTo(dAtA []byte) (int, error) {
+func (m *TeamQuota) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.JobInfo) > 0 {
-		for _, msg := range m.JobInfo {
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintPps(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
+	if len(m.Team) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Team)))
+		i += copy(dAtA[i:], m.Team)
+	}
+	if m.MaxRunningJobs != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.MaxRunningJobs))
+	}
+	if m.MaxWorkers != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.MaxWorkers))
 	}
 	return i, nil
 }
 
-func (m *Pipeline) Marshal() (dAtA []byte, err error) {
+func (m *AdmissionPolicy) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -4309,21 +7056,50 @@ func (m *Pipeline) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Pipeline) MarshalTo(dAtA []byte) (int, error) {
+func (m *AdmissionPolicy) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.Name) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintPps(dAtA, i, uint64(len(m.Name)))
-		i += copy(dAtA[i:], m.Name)
-	}
-	return i, nil
-}
+	if len(m.PriorityClasses) > 0 {
+		for _, msg := range m.PriorityClasses {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintPps(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.Teams) > 0 {
+		for _, msg := range m.Teams {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintPps(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.DefaultPriorityClass) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(len(m.DefaultPriorityClass)))
+		i += copy(dAtA[i:], m.DefaultPriorityClass)
+	}
+	if m.MaxRunningJobs != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.MaxRunningJobs))
+	}
+	return i, nil
+}
 
-func (m *PipelineInput) Marshal() (dAtA []byte, err error) {
+func (m *SchedulingSpec) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -4333,63 +7109,50 @@ func (m *PipelineInput) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PipelineInput) MarshalTo(dAtA []byte) (int, error) {
+func (m *SchedulingSpec) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Repo != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.Repo.Size()))
-		n37, err := m.Repo.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
+	if len(m.NodeSelector) > 0 {
+		for k, _ := range m.NodeSelector {
+			dAtA[i] = 0xa
+			i++
+			v := m.NodeSelector[k]
+			mapSize := 1 + len(k) + sovPps(uint64(len(k))) + 1 + len(v) + sovPps(uint64(len(v)))
+			i = encodeVarintPps(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintPps(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintPps(dAtA, i, uint64(len(v)))
+			i += copy(dAtA[i:], v)
 		}
-		i += n37
-	}
-	if len(m.Branch) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintPps(dAtA, i, uint64(len(m.Branch)))
-		i += copy(dAtA[i:], m.Branch)
-	}
-	if len(m.Glob) > 0 {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintPps(dAtA, i, uint64(len(m.Glob)))
-		i += copy(dAtA[i:], m.Glob)
 	}
-	if m.Lazy {
-		dAtA[i] = 0x20
-		i++
-		if m.Lazy {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	if len(m.Tolerations) > 0 {
+		for _, msg := range m.Tolerations {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintPps(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
 		}
-		i++
-	}
-	if len(m.Name) > 0 {
-		dAtA[i] = 0x2a
-		i++
-		i = encodeVarintPps(dAtA, i, uint64(len(m.Name)))
-		i += copy(dAtA[i:], m.Name)
 	}
-	if m.From != nil {
-		dAtA[i] = 0x32
+	if len(m.Affinity) > 0 {
+		dAtA[i] = 0x1a
 		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.From.Size()))
-		n38, err := m.From.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n38
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Affinity)))
+		i += copy(dAtA[i:], m.Affinity)
 	}
 	return i, nil
 }
 
-func (m *PipelineInfo) Marshal() (dAtA []byte, err error) {
+func (m *JobInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -4399,154 +7162,214 @@ func (m *PipelineInfo) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PipelineInfo) MarshalTo(dAtA []byte) (int, error) {
+func (m *JobInfo) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Pipeline != nil {
+	if m.Job != nil {
 		dAtA[i] = 0xa
 		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.Pipeline.Size()))
-		n39, err := m.Pipeline.MarshalTo(dAtA[i:])
+		i = encodeVarintPps(dAtA, i, uint64(m.Job.Size()))
+		n36, err := m.Job.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n39
+		i += n36
 	}
 	if m.Transform != nil {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.Transform.Size()))
-		n40, err := m.Transform.MarshalTo(dAtA[i:])
+		n37, err := m.Transform.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n40
+		i += n37
 	}
-	if m.CreatedAt != nil {
+	if m.Pipeline != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Pipeline.Size()))
+		n38, err := m.Pipeline.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n38
+	}
+	if m.ParentJob != nil {
 		dAtA[i] = 0x32
 		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.CreatedAt.Size()))
-		n41, err := m.CreatedAt.MarshalTo(dAtA[i:])
+		i = encodeVarintPps(dAtA, i, uint64(m.ParentJob.Size()))
+		n39, err := m.ParentJob.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n41
+		i += n39
 	}
-	if m.State != 0 {
-		dAtA[i] = 0x38
+	if m.Started != nil {
+		dAtA[i] = 0x3a
 		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.State))
+		i = encodeVarintPps(dAtA, i, uint64(m.Started.Size()))
+		n40, err := m.Started.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n40
 	}
-	if len(m.RecentError) > 0 {
+	if m.Finished != nil {
 		dAtA[i] = 0x42
 		i++
-		i = encodeVarintPps(dAtA, i, uint64(len(m.RecentError)))
-		i += copy(dAtA[i:], m.RecentError)
+		i = encodeVarintPps(dAtA, i, uint64(m.Finished.Size()))
+		n41, err := m.Finished.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n41
 	}
-	if len(m.JobCounts) > 0 {
-		for k, _ := range m.JobCounts {
-			dAtA[i] = 0x4a
-			i++
-			v := m.JobCounts[k]
-			mapSize := 1 + sovPps(uint64(k)) + 1 + sovPps(uint64(v))
-			i = encodeVarintPps(dAtA, i, uint64(mapSize))
-			dAtA[i] = 0x8
-			i++
-			i = encodeVarintPps(dAtA, i, uint64(k))
-			dAtA[i] = 0x10
-			i++
-			i = encodeVarintPps(dAtA, i, uint64(v))
+	if m.OutputCommit != nil {
+		dAtA[i] = 0x4a
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.OutputCommit.Size()))
+		n42, err := m.OutputCommit.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
 		}
+		i += n42
+	}
+	if m.State != 0 {
+		dAtA[i] = 0x50
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.State))
 	}
 	if m.ParallelismSpec != nil {
-		dAtA[i] = 0x52
+		dAtA[i] = 0x62
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.ParallelismSpec.Size()))
-		n42, err := m.ParallelismSpec.MarshalTo(dAtA[i:])
+		n43, err := m.ParallelismSpec.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n42
+		i += n43
 	}
-	if m.Version != 0 {
-		dAtA[i] = 0x58
+	if m.PipelineVersion != 0 {
+		dAtA[i] = 0x68
 		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.Version))
+		i = encodeVarintPps(dAtA, i, uint64(m.PipelineVersion))
+	}
+	if m.Service != nil {
+		dAtA[i] = 0x72
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Service.Size()))
+		n44, err := m.Service.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n44
 	}
 	if m.Egress != nil {
 		dAtA[i] = 0x7a
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.Egress.Size()))
-		n43, err := m.Egress.MarshalTo(dAtA[i:])
+		n45, err := m.Egress.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n43
+		i += n45
 	}
 	if len(m.OutputBranch) > 0 {
-		dAtA[i] = 0x82
+		dAtA[i] = 0x8a
 		i++
 		dAtA[i] = 0x1
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(len(m.OutputBranch)))
 		i += copy(dAtA[i:], m.OutputBranch)
 	}
-	if len(m.ID) > 0 {
-		dAtA[i] = 0x8a
+	if m.OutputRepo != nil {
+		dAtA[i] = 0x92
 		i++
 		dAtA[i] = 0x1
 		i++
-		i = encodeVarintPps(dAtA, i, uint64(len(m.ID)))
-		i += copy(dAtA[i:], m.ID)
+		i = encodeVarintPps(dAtA, i, uint64(m.OutputRepo.Size()))
+		n46, err := m.OutputRepo.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n46
 	}
-	if m.ScaleDownThreshold != nil {
-		dAtA[i] = 0x92
+	if m.Restart != 0 {
+		dAtA[i] = 0xa0
 		i++
 		dAtA[i] = 0x1
 		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.ScaleDownThreshold.Size()))
-		n44, err := m.ScaleDownThreshold.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
+		i = encodeVarintPps(dAtA, i, uint64(m.Restart))
+	}
+	if m.DataProcessed != 0 {
+		dAtA[i] = 0xb0
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.DataProcessed))
+	}
+	if m.DataTotal != 0 {
+		dAtA[i] = 0xb8
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.DataTotal))
+	}
+	if len(m.WorkerStatus) > 0 {
+		for _, msg := range m.WorkerStatus {
+			dAtA[i] = 0xc2
+			i++
+			dAtA[i] = 0x1
+			i++
+			i = encodeVarintPps(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
 		}
-		i += n44
 	}
 	if m.ResourceSpec != nil {
-		dAtA[i] = 0x9a
+		dAtA[i] = 0xca
 		i++
 		dAtA[i] = 0x1
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.ResourceSpec.Size()))
-		n45, err := m.ResourceSpec.MarshalTo(dAtA[i:])
+		n47, err := m.ResourceSpec.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n45
+		i += n47
 	}
 	if m.Input != nil {
-		dAtA[i] = 0xa2
+		dAtA[i] = 0xd2
 		i++
 		dAtA[i] = 0x1
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.Input.Size()))
-		n46, err := m.Input.MarshalTo(dAtA[i:])
+		n48, err := m.Input.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n46
+		i += n48
 	}
-	if len(m.Description) > 0 {
-		dAtA[i] = 0xaa
+	if m.NewBranch != nil {
+		dAtA[i] = 0xda
 		i++
 		dAtA[i] = 0x1
 		i++
-		i = encodeVarintPps(dAtA, i, uint64(len(m.Description)))
-		i += copy(dAtA[i:], m.Description)
+		i = encodeVarintPps(dAtA, i, uint64(m.NewBranch.Size()))
+		n49, err := m.NewBranch.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n49
 	}
 	if m.Incremental {
-		dAtA[i] = 0xb0
+		dAtA[i] = 0xe0
 		i++
 		dAtA[i] = 0x1
 		i++
@@ -4557,19 +7380,42 @@ func (m *PipelineInfo) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i++
 	}
-	if len(m.CacheSize) > 0 {
-		dAtA[i] = 0xba
-		i++
+	if m.StatsCommit != nil {
+		dAtA[i] = 0xea
+		i++
 		dAtA[i] = 0x1
 		i++
-		i = encodeVarintPps(dAtA, i, uint64(len(m.CacheSize)))
-		i += copy(dAtA[i:], m.CacheSize)
+		i = encodeVarintPps(dAtA, i, uint64(m.StatsCommit.Size()))
+		n50, err := m.StatsCommit.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n50
 	}
-	if m.EnableStats {
-		dAtA[i] = 0xc0
+	if m.DataSkipped != 0 {
+		dAtA[i] = 0xf0
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.DataSkipped))
+	}
+	if m.Stats != nil {
+		dAtA[i] = 0xfa
 		i++
 		dAtA[i] = 0x1
 		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Stats.Size()))
+		n51, err := m.Stats.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n51
+	}
+	if m.EnableStats {
+		dAtA[i] = 0x80
+		i++
+		dAtA[i] = 0x2
+		i++
 		if m.EnableStats {
 			dAtA[i] = 1
 		} else {
@@ -4578,25 +7424,17 @@ func (m *PipelineInfo) MarshalTo(dAtA []byte) (int, error) {
 		i++
 	}
 	if len(m.Salt) > 0 {
-		dAtA[i] = 0xca
+		dAtA[i] = 0x8a
 		i++
-		dAtA[i] = 0x1
+		dAtA[i] = 0x2
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(len(m.Salt)))
 		i += copy(dAtA[i:], m.Salt)
 	}
-	if len(m.Capability) > 0 {
-		dAtA[i] = 0xd2
-		i++
-		dAtA[i] = 0x1
-		i++
-		i = encodeVarintPps(dAtA, i, uint64(len(m.Capability)))
-		i += copy(dAtA[i:], m.Capability)
-	}
 	if m.Batch {
-		dAtA[i] = 0xd8
+		dAtA[i] = 0x90
 		i++
-		dAtA[i] = 0x1
+		dAtA[i] = 0x2
 		i++
 		if m.Batch {
 			dAtA[i] = 1
@@ -4606,36 +7444,90 @@ func (m *PipelineInfo) MarshalTo(dAtA []byte) (int, error) {
 		i++
 	}
 	if len(m.Reason) > 0 {
-		dAtA[i] = 0xe2
+		dAtA[i] = 0x9a
 		i++
-		dAtA[i] = 0x1
+		dAtA[i] = 0x2
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(len(m.Reason)))
 		i += copy(dAtA[i:], m.Reason)
 	}
-	if m.MaxQueueSize != 0 {
-		dAtA[i] = 0xe8
+	if m.Rerun {
+		dAtA[i] = 0xa0
 		i++
-		dAtA[i] = 0x1
+		dAtA[i] = 0x2
+		i++
+		if m.Rerun {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
 		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.MaxQueueSize))
 	}
-	if m.Service != nil {
-		dAtA[i] = 0xf2
+	if len(m.FailedDatums) > 0 {
+		for _, msg := range m.FailedDatums {
+			dAtA[i] = 0xaa
+			i++
+			dAtA[i] = 0x2
+			i++
+			i = encodeVarintPps(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.DataFailed != 0 {
+		dAtA[i] = 0xb0
 		i++
-		dAtA[i] = 0x1
+		dAtA[i] = 0x2
 		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.Service.Size()))
-		n47, err := m.Service.MarshalTo(dAtA[i:])
+		i = encodeVarintPps(dAtA, i, uint64(m.DataFailed))
+	}
+	if m.Lineage != nil {
+		dAtA[i] = 0xba
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Lineage.Size()))
+		n52, err := m.Lineage.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n47
+		i += n52
+	}
+	if m.EgressInfo != nil {
+		dAtA[i] = 0xc2
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.EgressInfo.Size()))
+		n53, err := m.EgressInfo.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n53
+	}
+	if len(m.PriorityClass) > 0 {
+		dAtA[i] = 0xca
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(len(m.PriorityClass)))
+		i += copy(dAtA[i:], m.PriorityClass)
+	}
+	if len(m.Team) > 0 {
+		dAtA[i] = 0xd2
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Team)))
+		i += copy(dAtA[i:], m.Team)
 	}
 	return i, nil
 }
 
-func (m *PipelineInfos) Marshal() (dAtA []byte, err error) {
+func (m *FailedDatum) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -4645,13 +7537,109 @@ func (m *PipelineInfos) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PipelineInfos) MarshalTo(dAtA []byte) (int, error) {
+func (m *FailedDatum) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.PipelineInfo) > 0 {
-		for _, msg := range m.PipelineInfo {
+	if len(m.DatumID) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(len(m.DatumID)))
+		i += copy(dAtA[i:], m.DatumID)
+	}
+	if m.ExitCode != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.ExitCode))
+	}
+	if len(m.Reason) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Reason)))
+		i += copy(dAtA[i:], m.Reason)
+	}
+	if m.Logs != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Logs.Size()))
+		n54, err := m.Logs.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n54
+	}
+	return i, nil
+}
+
+func (m *DatumLineage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DatumLineage) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.DatumID) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(len(m.DatumID)))
+		i += copy(dAtA[i:], m.DatumID)
+	}
+	if len(m.Inputs) > 0 {
+		for _, msg := range m.Inputs {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintPps(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.Outputs) > 0 {
+		for _, s := range m.Outputs {
+			dAtA[i] = 0x1a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	return i, nil
+}
+
+func (m *JobLineage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JobLineage) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Datums) > 0 {
+		for _, msg := range m.Datums {
 			dAtA[i] = 0xa
 			i++
 			i = encodeVarintPps(dAtA, i, uint64(msg.Size()))
@@ -4665,7 +7653,7 @@ func (m *PipelineInfos) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *CreateJobRequest) Marshal() (dAtA []byte, err error) {
+func (m *Worker) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -4675,172 +7663,56 @@ func (m *CreateJobRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *CreateJobRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *Worker) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Transform != nil {
+	if len(m.Name) > 0 {
 		dAtA[i] = 0xa
 		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.Transform.Size()))
-		n48, err := m.Transform.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n48
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
 	}
-	if m.Pipeline != nil {
-		dAtA[i] = 0x12
+	if m.State != 0 {
+		dAtA[i] = 0x10
 		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.Pipeline.Size()))
-		n49, err := m.Pipeline.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n49
+		i = encodeVarintPps(dAtA, i, uint64(m.State))
 	}
-	if m.ParallelismSpec != nil {
-		dAtA[i] = 0x3a
-		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.ParallelismSpec.Size()))
-		n50, err := m.ParallelismSpec.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n50
+	return i, nil
+}
+
+func (m *JobInfos) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	if m.Service != nil {
-		dAtA[i] = 0x42
-		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.Service.Size()))
-		n51, err := m.Service.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
+	return dAtA[:n], nil
+}
+
+func (m *JobInfos) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.JobInfo) > 0 {
+		for _, msg := range m.JobInfo {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintPps(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
 		}
-		i += n51
-	}
-	if m.Egress != nil {
-		dAtA[i] = 0x4a
-		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.Egress.Size()))
-		n52, err := m.Egress.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n52
-	}
-	if m.PipelineVersion != 0 {
-		dAtA[i] = 0x50
-		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.PipelineVersion))
-	}
-	if len(m.OutputBranch) > 0 {
-		dAtA[i] = 0x5a
-		i++
-		i = encodeVarintPps(dAtA, i, uint64(len(m.OutputBranch)))
-		i += copy(dAtA[i:], m.OutputBranch)
-	}
-	if m.OutputRepo != nil {
-		dAtA[i] = 0x62
-		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.OutputRepo.Size()))
-		n53, err := m.OutputRepo.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n53
-	}
-	if m.ParentJob != nil {
-		dAtA[i] = 0x6a
-		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.ParentJob.Size()))
-		n54, err := m.ParentJob.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n54
-	}
-	if m.ResourceSpec != nil {
-		dAtA[i] = 0x72
-		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.ResourceSpec.Size()))
-		n55, err := m.ResourceSpec.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n55
-	}
-	if m.Input != nil {
-		dAtA[i] = 0x7a
-		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.Input.Size()))
-		n56, err := m.Input.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n56
-	}
-	if m.NewBranch != nil {
-		dAtA[i] = 0x82
-		i++
-		dAtA[i] = 0x1
-		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.NewBranch.Size()))
-		n57, err := m.NewBranch.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n57
-	}
-	if m.Incremental {
-		dAtA[i] = 0x88
-		i++
-		dAtA[i] = 0x1
-		i++
-		if m.Incremental {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
-	}
-	if m.EnableStats {
-		dAtA[i] = 0x90
-		i++
-		dAtA[i] = 0x1
-		i++
-		if m.EnableStats {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
-	}
-	if len(m.Salt) > 0 {
-		dAtA[i] = 0x9a
-		i++
-		dAtA[i] = 0x1
-		i++
-		i = encodeVarintPps(dAtA, i, uint64(len(m.Salt)))
-		i += copy(dAtA[i:], m.Salt)
-	}
-	if m.Batch {
-		dAtA[i] = 0xa0
-		i++
-		dAtA[i] = 0x1
-		i++
-		if m.Batch {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
 	}
 	return i, nil
 }
 
-func (m *InspectJobRequest) Marshal() (dAtA []byte, err error) {
+func (m *Pipeline) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -4850,35 +7722,21 @@ func (m *InspectJobRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *InspectJobRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *Pipeline) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Job != nil {
+	if len(m.Name) > 0 {
 		dAtA[i] = 0xa
 		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.Job.Size()))
-		n58, err := m.Job.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n58
-	}
-	if m.BlockState {
-		dAtA[i] = 0x10
-		i++
-		if m.BlockState {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
 	}
 	return i, nil
 }
 
-func (m *ListJobRequest) Marshal() (dAtA []byte, err error) {
+func (m *PipelineInput) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -4888,75 +7746,63 @@ func (m *ListJobRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ListJobRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *PipelineInput) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Pipeline != nil {
+	if m.Repo != nil {
 		dAtA[i] = 0xa
 		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.Pipeline.Size()))
-		n59, err := m.Pipeline.MarshalTo(dAtA[i:])
+		i = encodeVarintPps(dAtA, i, uint64(m.Repo.Size()))
+		n55, err := m.Repo.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n59
+		i += n55
 	}
-	if len(m.InputCommit) > 0 {
-		for _, msg := range m.InputCommit {
-			dAtA[i] = 0x12
-			i++
-			i = encodeVarintPps(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
+	if len(m.Branch) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Branch)))
+		i += copy(dAtA[i:], m.Branch)
 	}
-	if m.OutputCommit != nil {
+	if len(m.Glob) > 0 {
 		dAtA[i] = 0x1a
 		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.OutputCommit.Size()))
-		n60, err := m.OutputCommit.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Glob)))
+		i += copy(dAtA[i:], m.Glob)
+	}
+	if m.Lazy {
+		dAtA[i] = 0x20
+		i++
+		if m.Lazy {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
-		i += n60
+		i++
 	}
-	return i, nil
-}
-
-func (m *DeleteJobRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+	if len(m.Name) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
 	}
-	return dAtA[:n], nil
-}
-
-func (m *DeleteJobRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Job != nil {
-		dAtA[i] = 0xa
+	if m.From != nil {
+		dAtA[i] = 0x32
 		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.Job.Size()))
-		n61, err := m.Job.MarshalTo(dAtA[i:])
+		i = encodeVarintPps(dAtA, i, uint64(m.From.Size()))
+		n56, err := m.From.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n61
+		i += n56
 	}
 	return i, nil
 }
 
-func (m *StopJobRequest) Marshal() (dAtA []byte, err error) {
+func (m *PipelineInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -4966,231 +7812,402 @@ func (m *StopJobRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *StopJobRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *PipelineInfo) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Job != nil {
+	if m.Pipeline != nil {
 		dAtA[i] = 0xa
 		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.Job.Size()))
-		n62, err := m.Job.MarshalTo(dAtA[i:])
+		i = encodeVarintPps(dAtA, i, uint64(m.Pipeline.Size()))
+		n57, err := m.Pipeline.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n62
-	}
-	return i, nil
-}
-
-func (m *GetLogsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+		i += n57
 	}
-	return dAtA[:n], nil
-}
-
-func (m *GetLogsRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Job != nil {
-		dAtA[i] = 0xa
+	if m.Transform != nil {
+		dAtA[i] = 0x12
 		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.Job.Size()))
-		n63, err := m.Job.MarshalTo(dAtA[i:])
+		i = encodeVarintPps(dAtA, i, uint64(m.Transform.Size()))
+		n58, err := m.Transform.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n63
+		i += n58
 	}
-	if m.Pipeline != nil {
-		dAtA[i] = 0x12
+	if m.CreatedAt != nil {
+		dAtA[i] = 0x32
 		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.Pipeline.Size()))
-		n64, err := m.Pipeline.MarshalTo(dAtA[i:])
+		i = encodeVarintPps(dAtA, i, uint64(m.CreatedAt.Size()))
+		n59, err := m.CreatedAt.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n64
+		i += n59
 	}
-	if len(m.DataFilters) > 0 {
-		for _, s := range m.DataFilters {
-			dAtA[i] = 0x1a
-			i++
-			l = len(s)
-			for l >= 1<<7 {
-				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
-				l >>= 7
-				i++
-			}
-			dAtA[i] = uint8(l)
+	if m.State != 0 {
+		dAtA[i] = 0x38
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.State))
+	}
+	if len(m.RecentError) > 0 {
+		dAtA[i] = 0x42
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(len(m.RecentError)))
+		i += copy(dAtA[i:], m.RecentError)
+	}
+	if len(m.JobCounts) > 0 {
+		for k, _ := range m.JobCounts {
+			dAtA[i] = 0x4a
 			i++
-			i += copy(dAtA[i:], s)
+			v := m.JobCounts[k]
+			mapSize := 1 + sovPps(uint64(k)) + 1 + sovPps(uint64(v))
+			i = encodeVarintPps(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0x8
+			i++
+			i = encodeVarintPps(dAtA, i, uint64(k))
+			dAtA[i] = 0x10
+			i++
+			i = encodeVarintPps(dAtA, i, uint64(v))
 		}
 	}
-	if m.Master {
-		dAtA[i] = 0x28
+	if m.ParallelismSpec != nil {
+		dAtA[i] = 0x52
 		i++
-		if m.Master {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+		i = encodeVarintPps(dAtA, i, uint64(m.ParallelismSpec.Size()))
+		n60, err := m.ParallelismSpec.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
 		}
+		i += n60
+	}
+	if m.Version != 0 {
+		dAtA[i] = 0x58
 		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Version))
 	}
-	if m.Datum != nil {
-		dAtA[i] = 0x32
+	if m.Egress != nil {
+		dAtA[i] = 0x7a
 		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.Datum.Size()))
-		n65, err := m.Datum.MarshalTo(dAtA[i:])
+		i = encodeVarintPps(dAtA, i, uint64(m.Egress.Size()))
+		n61, err := m.Egress.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n65
+		i += n61
 	}
-	return i, nil
-}
-
-func (m *LogMessage) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+	if len(m.OutputBranch) > 0 {
+		dAtA[i] = 0x82
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(len(m.OutputBranch)))
+		i += copy(dAtA[i:], m.OutputBranch)
 	}
-	return dAtA[:n], nil
-}
-
-func (m *LogMessage) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.PipelineName) > 0 {
-		dAtA[i] = 0xa
+	if len(m.ID) > 0 {
+		dAtA[i] = 0x8a
 		i++
-		i = encodeVarintPps(dAtA, i, uint64(len(m.PipelineName)))
-		i += copy(dAtA[i:], m.PipelineName)
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(len(m.ID)))
+		i += copy(dAtA[i:], m.ID)
 	}
-	if len(m.JobID) > 0 {
-		dAtA[i] = 0x1a
+	if m.ScaleDownThreshold != nil {
+		dAtA[i] = 0x92
 		i++
-		i = encodeVarintPps(dAtA, i, uint64(len(m.JobID)))
-		i += copy(dAtA[i:], m.JobID)
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.ScaleDownThreshold.Size()))
+		n62, err := m.ScaleDownThreshold.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n62
 	}
-	if len(m.Data) > 0 {
-		for _, msg := range m.Data {
-			dAtA[i] = 0x22
-			i++
-			i = encodeVarintPps(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
+	if m.ResourceSpec != nil {
+		dAtA[i] = 0x9a
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.ResourceSpec.Size()))
+		n63, err := m.ResourceSpec.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
 		}
+		i += n63
 	}
-	if m.Ts != nil {
-		dAtA[i] = 0x2a
+	if m.Input != nil {
+		dAtA[i] = 0xa2
 		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.Ts.Size()))
-		n66, err := m.Ts.MarshalTo(dAtA[i:])
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Input.Size()))
+		n64, err := m.Input.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n66
+		i += n64
 	}
-	if len(m.Message) > 0 {
-		dAtA[i] = 0x32
+	if len(m.Description) > 0 {
+		dAtA[i] = 0xaa
 		i++
-		i = encodeVarintPps(dAtA, i, uint64(len(m.Message)))
-		i += copy(dAtA[i:], m.Message)
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Description)))
+		i += copy(dAtA[i:], m.Description)
 	}
-	if len(m.WorkerID) > 0 {
-		dAtA[i] = 0x3a
+	if m.Incremental {
+		dAtA[i] = 0xb0
+		i++
+		dAtA[i] = 0x1
+		i++
+		if m.Incremental {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
 		i++
-		i = encodeVarintPps(dAtA, i, uint64(len(m.WorkerID)))
-		i += copy(dAtA[i:], m.WorkerID)
 	}
-	if m.User {
-		dAtA[i] = 0x40
+	if len(m.CacheSize) > 0 {
+		dAtA[i] = 0xba
 		i++
-		if m.User {
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(len(m.CacheSize)))
+		i += copy(dAtA[i:], m.CacheSize)
+	}
+	if m.EnableStats {
+		dAtA[i] = 0xc0
+		i++
+		dAtA[i] = 0x1
+		i++
+		if m.EnableStats {
 			dAtA[i] = 1
 		} else {
 			dAtA[i] = 0
 		}
 		i++
 	}
-	if len(m.DatumID) > 0 {
-		dAtA[i] = 0x4a
+	if len(m.Salt) > 0 {
+		dAtA[i] = 0xca
 		i++
-		i = encodeVarintPps(dAtA, i, uint64(len(m.DatumID)))
-		i += copy(dAtA[i:], m.DatumID)
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Salt)))
+		i += copy(dAtA[i:], m.Salt)
 	}
-	if m.Master {
-		dAtA[i] = 0x50
+	if len(m.Capability) > 0 {
+		dAtA[i] = 0xd2
 		i++
-		if m.Master {
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Capability)))
+		i += copy(dAtA[i:], m.Capability)
+	}
+	if m.Batch {
+		dAtA[i] = 0xd8
+		i++
+		dAtA[i] = 0x1
+		i++
+		if m.Batch {
 			dAtA[i] = 1
 		} else {
 			dAtA[i] = 0
 		}
 		i++
 	}
-	return i, nil
-}
-
-func (m *RestartDatumRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+	if len(m.Reason) > 0 {
+		dAtA[i] = 0xe2
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Reason)))
+		i += copy(dAtA[i:], m.Reason)
 	}
-	return dAtA[:n], nil
-}
-
-func (m *RestartDatumRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Job != nil {
-		dAtA[i] = 0xa
+	if m.MaxQueueSize != 0 {
+		dAtA[i] = 0xe8
 		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.Job.Size()))
-		n67, err := m.Job.MarshalTo(dAtA[i:])
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.MaxQueueSize))
+	}
+	if m.Service != nil {
+		dAtA[i] = 0xf2
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Service.Size()))
+		n65, err := m.Service.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n67
+		i += n65
 	}
-	if len(m.DataFilters) > 0 {
-		for _, s := range m.DataFilters {
-			dAtA[i] = 0x12
-			i++
-			l = len(s)
-			for l >= 1<<7 {
-				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
-				l >>= 7
-				i++
-			}
-			dAtA[i] = uint8(l)
-			i++
-			i += copy(dAtA[i:], s)
-		}
+	if m.DatumTries != 0 {
+		dAtA[i] = 0xf8
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.DatumTries))
 	}
-	return i, nil
-}
+	if m.DatumRetryBackoff != nil {
+		dAtA[i] = 0x82
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.DatumRetryBackoff.Size()))
+		n66, err := m.DatumRetryBackoff.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n66
+	}
+	if m.DatumTimeout != nil {
+		dAtA[i] = 0x8a
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.DatumTimeout.Size()))
+		n67, err := m.DatumTimeout.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n67
+	}
+	if m.JobTimeout != nil {
+		dAtA[i] = 0x92
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.JobTimeout.Size()))
+		n68, err := m.JobTimeout.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n68
+	}
+	if m.SkipFailedDatums {
+		dAtA[i] = 0x98
+		i++
+		dAtA[i] = 0x2
+		i++
+		if m.SkipFailedDatums {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.ResourceLimits != nil {
+		dAtA[i] = 0xa2
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.ResourceLimits.Size()))
+		n69, err := m.ResourceLimits.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n69
+	}
+	if m.SchedulingSpec != nil {
+		dAtA[i] = 0xaa
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.SchedulingSpec.Size()))
+		n70, err := m.SchedulingSpec.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n70
+	}
+	if len(m.PodPatch) > 0 {
+		dAtA[i] = 0xb2
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(len(m.PodPatch)))
+		i += copy(dAtA[i:], m.PodPatch)
+	}
+	if len(m.WorkerSpec) > 0 {
+		dAtA[i] = 0xba
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(len(m.WorkerSpec)))
+		i += copy(dAtA[i:], m.WorkerSpec)
+	}
+	if m.AutoscalingSpec != nil {
+		dAtA[i] = 0xc2
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.AutoscalingSpec.Size()))
+		n71, err := m.AutoscalingSpec.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n71
+	}
+	if m.AutoscalingStatus != nil {
+		dAtA[i] = 0xca
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.AutoscalingStatus.Size()))
+		n72, err := m.AutoscalingStatus.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n72
+	}
+	if len(m.PriorityClass) > 0 {
+		dAtA[i] = 0xd2
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(len(m.PriorityClass)))
+		i += copy(dAtA[i:], m.PriorityClass)
+	}
+	if len(m.Team) > 0 {
+		dAtA[i] = 0xda
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Team)))
+		i += copy(dAtA[i:], m.Team)
+	}
+	if m.Spout != nil {
+		dAtA[i] = 0xe2
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Spout.Size()))
+		n73, err := m.Spout.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n73
+	}
+	if m.ServiceStatus != nil {
+		dAtA[i] = 0xea
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.ServiceStatus.Size()))
+		n74, err := m.ServiceStatus.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n74
+	}
+	return i, nil
+}
 
-func (m *InspectDatumRequest) Marshal() (dAtA []byte, err error) {
+func (m *PipelineInfos) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -5200,25 +8217,102 @@ func (m *InspectDatumRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *InspectDatumRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *PipelineInfos) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Datum != nil {
+	if len(m.PipelineInfo) > 0 {
+		for _, msg := range m.PipelineInfo {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintPps(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *Event) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Event) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.ID) > 0 {
 		dAtA[i] = 0xa
 		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.Datum.Size()))
-		n68, err := m.Datum.MarshalTo(dAtA[i:])
+		i = encodeVarintPps(dAtA, i, uint64(len(m.ID)))
+		i += copy(dAtA[i:], m.ID)
+	}
+	if m.Type != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Type))
+	}
+	if m.Time != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Time.Size()))
+		n75, err := m.Time.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n68
+		i += n75
+	}
+	if m.Pipeline != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Pipeline.Size()))
+		n76, err := m.Pipeline.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n76
+	}
+	if m.Job != nil {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Job.Size()))
+		n77, err := m.Job.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n77
+	}
+	if m.JobState != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.JobState))
+	}
+	if m.PipelineState != 0 {
+		dAtA[i] = 0x38
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.PipelineState))
+	}
+	if len(m.Reason) > 0 {
+		dAtA[i] = 0x42
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Reason)))
+		i += copy(dAtA[i:], m.Reason)
 	}
 	return i, nil
 }
 
-func (m *ListDatumRequest) Marshal() (dAtA []byte, err error) {
+func (m *WebhookInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -5228,35 +8322,66 @@ func (m *ListDatumRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ListDatumRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *WebhookInfo) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Job != nil {
+	if len(m.Name) > 0 {
 		dAtA[i] = 0xa
 		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.Job.Size()))
-		n69, err := m.Job.MarshalTo(dAtA[i:])
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
+	}
+	if len(m.URL) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(len(m.URL)))
+		i += copy(dAtA[i:], m.URL)
+	}
+	if m.Pipeline != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Pipeline.Size()))
+		n78, err := m.Pipeline.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n69
+		i += n78
 	}
-	if m.PageSize != 0 {
-		dAtA[i] = 0x10
+	if len(m.Events) > 0 {
+		dAtA80 := make([]byte, len(m.Events)*10)
+		var j79 int
+		for _, num := range m.Events {
+			for num >= 1<<7 {
+				dAtA80[j79] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j79++
+			}
+			dAtA80[j79] = uint8(num)
+			j79++
+		}
+		dAtA[i] = 0x22
 		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.PageSize))
+		i = encodeVarintPps(dAtA, i, uint64(j79))
+		i += copy(dAtA[i:], dAtA80[:j79])
 	}
-	if m.Page != 0 {
-		dAtA[i] = 0x18
+	if len(m.Secret) > 0 {
+		dAtA[i] = 0x2a
 		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.Page))
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Secret)))
+		i += copy(dAtA[i:], m.Secret)
+	}
+	if len(m.RecentError) > 0 {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(len(m.RecentError)))
+		i += copy(dAtA[i:], m.RecentError)
 	}
 	return i, nil
 }
 
-func (m *ListDatumResponse) Marshal() (dAtA []byte, err error) {
+func (m *WebhookInfos) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -5266,13 +8391,13 @@ func (m *ListDatumResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ListDatumResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *WebhookInfos) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.DatumInfos) > 0 {
-		for _, msg := range m.DatumInfos {
+	if len(m.WebhookInfo) > 0 {
+		for _, msg := range m.WebhookInfo {
 			dAtA[i] = 0xa
 			i++
 			i = encodeVarintPps(dAtA, i, uint64(msg.Size()))
@@ -5283,20 +8408,10 @@ func (m *ListDatumResponse) MarshalTo(dAtA []byte) (int, error) {
 			i += n
 		}
 	}
-	if m.TotalPages != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.TotalPages))
-	}
-	if m.Page != 0 {
-		dAtA[i] = 0x18
-		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.Page))
-	}
 	return i, nil
 }
 
-func (m *CreatePipelineRequest) Marshal() (dAtA []byte, err error) {
+func (m *WebhookDelivery) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -5306,105 +8421,183 @@ func (m *CreatePipelineRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *CreatePipelineRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *WebhookDelivery) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Pipeline != nil {
+	if len(m.Webhook) > 0 {
 		dAtA[i] = 0xa
 		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.Pipeline.Size()))
-		n70, err := m.Pipeline.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n70
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Webhook)))
+		i += copy(dAtA[i:], m.Webhook)
 	}
-	if m.Transform != nil {
+	if m.Event != nil {
 		dAtA[i] = 0x12
 		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.Transform.Size()))
-		n71, err := m.Transform.MarshalTo(dAtA[i:])
+		i = encodeVarintPps(dAtA, i, uint64(m.Event.Size()))
+		n81, err := m.Event.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n71
+		i += n81
 	}
-	if m.Update {
-		dAtA[i] = 0x28
+	if m.Attempts != 0 {
+		dAtA[i] = 0x18
 		i++
-		if m.Update {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+		i = encodeVarintPps(dAtA, i, uint64(m.Attempts))
+	}
+	if m.NextAttempt != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.NextAttempt.Size()))
+		n82, err := m.NextAttempt.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n82
+	}
+	if len(m.LastError) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(len(m.LastError)))
+		i += copy(dAtA[i:], m.LastError)
+	}
+	return i, nil
+}
+
+func (m *CreateJobRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreateJobRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Transform != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Transform.Size()))
+		n83, err := m.Transform.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
 		}
+		i += n83
+	}
+	if m.Pipeline != nil {
+		dAtA[i] = 0x12
 		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Pipeline.Size()))
+		n84, err := m.Pipeline.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n84
 	}
 	if m.ParallelismSpec != nil {
 		dAtA[i] = 0x3a
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.ParallelismSpec.Size()))
-		n72, err := m.ParallelismSpec.MarshalTo(dAtA[i:])
+		n85, err := m.ParallelismSpec.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n72
+		i += n85
+	}
+	if m.Service != nil {
+		dAtA[i] = 0x42
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Service.Size()))
+		n86, err := m.Service.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n86
 	}
 	if m.Egress != nil {
 		dAtA[i] = 0x4a
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.Egress.Size()))
-		n73, err := m.Egress.MarshalTo(dAtA[i:])
+		n87, err := m.Egress.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n73
+		i += n87
+	}
+	if m.PipelineVersion != 0 {
+		dAtA[i] = 0x50
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.PipelineVersion))
 	}
 	if len(m.OutputBranch) > 0 {
-		dAtA[i] = 0x52
+		dAtA[i] = 0x5a
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(len(m.OutputBranch)))
 		i += copy(dAtA[i:], m.OutputBranch)
 	}
-	if m.ScaleDownThreshold != nil {
-		dAtA[i] = 0x5a
+	if m.OutputRepo != nil {
+		dAtA[i] = 0x62
 		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.ScaleDownThreshold.Size()))
-		n74, err := m.ScaleDownThreshold.MarshalTo(dAtA[i:])
+		i = encodeVarintPps(dAtA, i, uint64(m.OutputRepo.Size()))
+		n88, err := m.OutputRepo.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n74
+		i += n88
+	}
+	if m.ParentJob != nil {
+		dAtA[i] = 0x6a
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.ParentJob.Size()))
+		n89, err := m.ParentJob.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n89
 	}
 	if m.ResourceSpec != nil {
-		dAtA[i] = 0x62
+		dAtA[i] = 0x72
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.ResourceSpec.Size()))
-		n75, err := m.ResourceSpec.MarshalTo(dAtA[i:])
+		n90, err := m.ResourceSpec.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n75
+		i += n90
 	}
 	if m.Input != nil {
-		dAtA[i] = 0x6a
+		dAtA[i] = 0x7a
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.Input.Size()))
-		n76, err := m.Input.MarshalTo(dAtA[i:])
+		n91, err := m.Input.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n76
+		i += n91
 	}
-	if len(m.Description) > 0 {
-		dAtA[i] = 0x72
+	if m.NewBranch != nil {
+		dAtA[i] = 0x82
 		i++
-		i = encodeVarintPps(dAtA, i, uint64(len(m.Description)))
-		i += copy(dAtA[i:], m.Description)
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.NewBranch.Size()))
+		n92, err := m.NewBranch.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n92
 	}
 	if m.Incremental {
-		dAtA[i] = 0x78
+		dAtA[i] = 0x88
+		i++
+		dAtA[i] = 0x1
 		i++
 		if m.Incremental {
 			dAtA[i] = 1
@@ -5413,16 +8606,8 @@ func (m *CreatePipelineRequest) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i++
 	}
-	if len(m.CacheSize) > 0 {
-		dAtA[i] = 0x82
-		i++
-		dAtA[i] = 0x1
-		i++
-		i = encodeVarintPps(dAtA, i, uint64(len(m.CacheSize)))
-		i += copy(dAtA[i:], m.CacheSize)
-	}
 	if m.EnableStats {
-		dAtA[i] = 0x88
+		dAtA[i] = 0x90
 		i++
 		dAtA[i] = 0x1
 		i++
@@ -5433,20 +8618,16 @@ func (m *CreatePipelineRequest) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i++
 	}
-	if m.Reprocess {
-		dAtA[i] = 0x90
+	if len(m.Salt) > 0 {
+		dAtA[i] = 0x9a
 		i++
 		dAtA[i] = 0x1
 		i++
-		if m.Reprocess {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Salt)))
+		i += copy(dAtA[i:], m.Salt)
 	}
 	if m.Batch {
-		dAtA[i] = 0x98
+		dAtA[i] = 0xa0
 		i++
 		dAtA[i] = 0x1
 		i++
@@ -5457,29 +8638,22 @@ func (m *CreatePipelineRequest) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i++
 	}
-	if m.MaxQueueSize != 0 {
-		dAtA[i] = 0xa0
-		i++
-		dAtA[i] = 0x1
-		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.MaxQueueSize))
-	}
-	if m.Service != nil {
-		dAtA[i] = 0xaa
+	if m.Rerun {
+		dAtA[i] = 0xa8
 		i++
 		dAtA[i] = 0x1
 		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.Service.Size()))
-		n77, err := m.Service.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
+		if m.Rerun {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
-		i += n77
+		i++
 	}
 	return i, nil
 }
 
-func (m *InspectPipelineRequest) Marshal() (dAtA []byte, err error) {
+func (m *InspectJobRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -5489,25 +8663,35 @@ func (m *InspectPipelineRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *InspectPipelineRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *InspectJobRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Pipeline != nil {
+	if m.Job != nil {
 		dAtA[i] = 0xa
 		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.Pipeline.Size()))
-		n78, err := m.Pipeline.MarshalTo(dAtA[i:])
+		i = encodeVarintPps(dAtA, i, uint64(m.Job.Size()))
+		n93, err := m.Job.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n78
+		i += n93
+	}
+	if m.BlockState {
+		dAtA[i] = 0x10
+		i++
+		if m.BlockState {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
 	}
 	return i, nil
 }
 
-func (m *ListPipelineRequest) Marshal() (dAtA []byte, err error) {
+func (m *ListJobRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -5517,15 +8701,47 @@ func (m *ListPipelineRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ListPipelineRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *ListJobRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
+	if m.Pipeline != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Pipeline.Size()))
+		n94, err := m.Pipeline.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n94
+	}
+	if len(m.InputCommit) > 0 {
+		for _, msg := range m.InputCommit {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintPps(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.OutputCommit != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.OutputCommit.Size()))
+		n95, err := m.OutputCommit.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n95
+	}
 	return i, nil
 }
 
-func (m *DeletePipelineRequest) Marshal() (dAtA []byte, err error) {
+func (m *EgressJobRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -5535,55 +8751,25 @@ func (m *DeletePipelineRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DeletePipelineRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *EgressJobRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Pipeline != nil {
+	if m.Job != nil {
 		dAtA[i] = 0xa
 		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.Pipeline.Size()))
-		n79, err := m.Pipeline.MarshalTo(dAtA[i:])
+		i = encodeVarintPps(dAtA, i, uint64(m.Job.Size()))
+		n96, err := m.Job.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n79
-	}
-	if m.DeleteJobs {
-		dAtA[i] = 0x10
-		i++
-		if m.DeleteJobs {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
-	}
-	if m.DeleteRepo {
-		dAtA[i] = 0x18
-		i++
-		if m.DeleteRepo {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
-	}
-	if m.All {
-		dAtA[i] = 0x20
-		i++
-		if m.All {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
+		i += n96
 	}
 	return i, nil
 }
 
-func (m *StartPipelineRequest) Marshal() (dAtA []byte, err error) {
+func (m *DeleteJobRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -5593,25 +8779,25 @@ func (m *StartPipelineRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *StartPipelineRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *DeleteJobRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Pipeline != nil {
+	if m.Job != nil {
 		dAtA[i] = 0xa
 		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.Pipeline.Size()))
-		n80, err := m.Pipeline.MarshalTo(dAtA[i:])
+		i = encodeVarintPps(dAtA, i, uint64(m.Job.Size()))
+		n97, err := m.Job.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n80
+		i += n97
 	}
 	return i, nil
 }
 
-func (m *StopPipelineRequest) Marshal() (dAtA []byte, err error) {
+func (m *StopJobRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -5621,25 +8807,25 @@ func (m *StopPipelineRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *StopPipelineRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *StopJobRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Pipeline != nil {
+	if m.Job != nil {
 		dAtA[i] = 0xa
 		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.Pipeline.Size()))
-		n81, err := m.Pipeline.MarshalTo(dAtA[i:])
+		i = encodeVarintPps(dAtA, i, uint64(m.Job.Size()))
+		n98, err := m.Job.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n81
+		i += n98
 	}
 	return i, nil
 }
 
-func (m *RerunPipelineRequest) Marshal() (dAtA []byte, err error) {
+func (m *GetLogsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -5649,36 +8835,99 @@ func (m *RerunPipelineRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RerunPipelineRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *GetLogsRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Pipeline != nil {
+	if m.Job != nil {
 		dAtA[i] = 0xa
 		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.Pipeline.Size()))
-		n82, err := m.Pipeline.MarshalTo(dAtA[i:])
+		i = encodeVarintPps(dAtA, i, uint64(m.Job.Size()))
+		n99, err := m.Job.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n82
+		i += n99
 	}
-	if len(m.Exclude) > 0 {
-		for _, msg := range m.Exclude {
-			dAtA[i] = 0x12
+	if m.Pipeline != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Pipeline.Size()))
+		n100, err := m.Pipeline.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n100
+	}
+	if len(m.DataFilters) > 0 {
+		for _, s := range m.DataFilters {
+			dAtA[i] = 0x1a
 			i++
-			i = encodeVarintPps(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
 			}
-			i += n
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
 		}
 	}
-	if len(m.Include) > 0 {
-		for _, msg := range m.Include {
-			dAtA[i] = 0x1a
+	if m.Master {
+		dAtA[i] = 0x28
+		i++
+		if m.Master {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.Datum != nil {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Datum.Size()))
+		n101, err := m.Datum.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n101
+	}
+	return i, nil
+}
+
+func (m *LogMessage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LogMessage) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.PipelineName) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(len(m.PipelineName)))
+		i += copy(dAtA[i:], m.PipelineName)
+	}
+	if len(m.JobID) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(len(m.JobID)))
+		i += copy(dAtA[i:], m.JobID)
+	}
+	if len(m.Data) > 0 {
+		for _, msg := range m.Data {
+			dAtA[i] = 0x22
 			i++
 			i = encodeVarintPps(dAtA, i, uint64(msg.Size()))
 			n, err := msg.MarshalTo(dAtA[i:])
@@ -5688,10 +8937,58 @@ func (m *RerunPipelineRequest) MarshalTo(dAtA []byte) (int, error) {
 			i += n
 		}
 	}
+	if m.Ts != nil {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Ts.Size()))
+		n102, err := m.Ts.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n102
+	}
+	if len(m.Message) > 0 {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Message)))
+		i += copy(dAtA[i:], m.Message)
+	}
+	if len(m.WorkerID) > 0 {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(len(m.WorkerID)))
+		i += copy(dAtA[i:], m.WorkerID)
+	}
+	if m.User {
+		dAtA[i] = 0x40
+		i++
+		if m.User {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if len(m.DatumID) > 0 {
+		dAtA[i] = 0x4a
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(len(m.DatumID)))
+		i += copy(dAtA[i:], m.DatumID)
+	}
+	if m.Master {
+		dAtA[i] = 0x50
+		i++
+		if m.Master {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
 	return i, nil
 }
 
-func (m *GarbageCollectRequest) Marshal() (dAtA []byte, err error) {
+func (m *RestartDatumRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -5701,15 +8998,40 @@ func (m *GarbageCollectRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GarbageCollectRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *RestartDatumRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
+	if m.Job != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Job.Size()))
+		n103, err := m.Job.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n103
+	}
+	if len(m.DataFilters) > 0 {
+		for _, s := range m.DataFilters {
+			dAtA[i] = 0x12
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
 	return i, nil
 }
 
-func (m *GarbageCollectResponse) Marshal() (dAtA []byte, err error) {
+func (m *InspectDatumRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -5719,1160 +9041,10355 @@ func (m *GarbageCollectResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GarbageCollectResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *InspectDatumRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
+	if m.Datum != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Datum.Size()))
+		n104, err := m.Datum.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n104
+	}
 	return i, nil
 }
 
-func encodeFixed64Pps(dAtA []byte, offset int, v uint64) int {
-	dAtA[offset] = uint8(v)
-	dAtA[offset+1] = uint8(v >> 8)
-	dAtA[offset+2] = uint8(v >> 16)
-	dAtA[offset+3] = uint8(v >> 24)
-	dAtA[offset+4] = uint8(v >> 32)
-	dAtA[offset+5] = uint8(v >> 40)
-	dAtA[offset+6] = uint8(v >> 48)
-	dAtA[offset+7] = uint8(v >> 56)
-	return offset + 8
-}
-func encodeFixed32Pps(dAtA []byte, offset int, v uint32) int {
-	dAtA[offset] = uint8(v)
-	dAtA[offset+1] = uint8(v >> 8)
-	dAtA[offset+2] = uint8(v >> 16)
-	dAtA[offset+3] = uint8(v >> 24)
-	return offset + 4
-}
-func encodeVarintPps(dAtA []byte, offset int, v uint64) int {
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *ListDatumRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return offset + 1
+	return dAtA[:n], nil
 }
-func (m *Secret) Size() (n int) {
+
+func (m *ListDatumRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovPps(uint64(l))
+	if m.Job != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Job.Size()))
+		n105, err := m.Job.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n105
 	}
-	l = len(m.MountPath)
-	if l > 0 {
-		n += 1 + l + sovPps(uint64(l))
+	if m.PageSize != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.PageSize))
 	}
-	l = len(m.EnvVar)
-	if l > 0 {
-		n += 1 + l + sovPps(uint64(l))
+	if m.Page != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Page))
 	}
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovPps(uint64(l))
+	return i, nil
+}
+
+func (m *ListDatumResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *Transform) Size() (n int) {
+func (m *ListDatumResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Image)
-	if l > 0 {
-		n += 1 + l + sovPps(uint64(l))
+	if len(m.DatumInfos) > 0 {
+		for _, msg := range m.DatumInfos {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintPps(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
 	}
-	if len(m.Cmd) > 0 {
-		for _, s := range m.Cmd {
-			l = len(s)
-			n += 1 + l + sovPps(uint64(l))
-		}
+	if m.TotalPages != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.TotalPages))
 	}
-	if len(m.Env) > 0 {
-		for k, v := range m.Env {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovPps(uint64(len(k))) + 1 + len(v) + sovPps(uint64(len(v)))
-			n += mapEntrySize + 1 + sovPps(uint64(mapEntrySize))
-		}
+	if m.Page != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Page))
 	}
-	if len(m.Secrets) > 0 {
-		for _, e := range m.Secrets {
-			l = e.Size()
-			n += 1 + l + sovPps(uint64(l))
-		}
+	return i, nil
+}
+
+func (m *ListDatumForInputRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Stdin) > 0 {
-		for _, s := range m.Stdin {
-			l = len(s)
-			n += 1 + l + sovPps(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *ListDatumForInputRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Pipeline != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Pipeline.Size()))
+		n106, err := m.Pipeline.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
 		}
+		i += n106
 	}
-	if len(m.AcceptReturnCode) > 0 {
-		l = 0
-		for _, e := range m.AcceptReturnCode {
-			l += sovPps(uint64(e))
+	if m.Input != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Input.Size()))
+		n107, err := m.Input.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
 		}
-		n += 1 + sovPps(uint64(l)) + l
+		i += n107
 	}
-	if m.Debug {
-		n += 2
+	if m.PageSize != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.PageSize))
 	}
-	if len(m.ImagePullSecrets) > 0 {
-		for _, s := range m.ImagePullSecrets {
-			l = len(s)
-			n += 1 + l + sovPps(uint64(l))
-		}
+	if m.Page != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Page))
 	}
-	return n
+	return i, nil
 }
 
-func (m *Egress) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.URL)
-	if l > 0 {
-		n += 1 + l + sovPps(uint64(l))
+func (m *DatumSizeBucket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *Job) Size() (n int) {
+func (m *DatumSizeBucket) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ID)
-	if l > 0 {
-		n += 1 + l + sovPps(uint64(l))
+	if m.LowerBoundBytes != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.LowerBoundBytes))
 	}
-	return n
+	if m.UpperBoundBytes != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.UpperBoundBytes))
+	}
+	if m.Count != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Count))
+	}
+	return i, nil
 }
 
-func (m *Service) Size() (n int) {
-	var l int
-	_ = l
-	if m.InternalPort != 0 {
-		n += 1 + sovPps(uint64(m.InternalPort))
-	}
-	if m.ExternalPort != 0 {
-		n += 1 + sovPps(uint64(m.ExternalPort))
+func (m *ListDatumForInputResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *AtomInput) Size() (n int) {
+func (m *ListDatumForInputResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovPps(uint64(l))
+	if len(m.DatumInfos) > 0 {
+		for _, msg := range m.DatumInfos {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintPps(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
 	}
-	l = len(m.Repo)
-	if l > 0 {
-		n += 1 + l + sovPps(uint64(l))
+	if m.TotalPages != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.TotalPages))
 	}
-	l = len(m.Branch)
-	if l > 0 {
-		n += 1 + l + sovPps(uint64(l))
+	if m.Page != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Page))
 	}
-	l = len(m.Commit)
-	if l > 0 {
-		n += 1 + l + sovPps(uint64(l))
+	if m.DatumCount != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.DatumCount))
 	}
-	l = len(m.Glob)
-	if l > 0 {
-		n += 1 + l + sovPps(uint64(l))
+	if m.TotalBytes != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.TotalBytes))
 	}
-	if m.Lazy {
-		n += 2
+	if m.MinDatumBytes != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.MinDatumBytes))
 	}
-	l = len(m.FromCommit)
-	if l > 0 {
-		n += 1 + l + sovPps(uint64(l))
+	if m.MaxDatumBytes != 0 {
+		dAtA[i] = 0x38
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.MaxDatumBytes))
 	}
-	return n
+	if len(m.SizeHistogram) > 0 {
+		for _, msg := range m.SizeHistogram {
+			dAtA[i] = 0x42
+			i++
+			i = encodeVarintPps(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
 }
 
-func (m *CronInput) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovPps(uint64(l))
-	}
-	l = len(m.Repo)
-	if l > 0 {
-		n += 1 + l + sovPps(uint64(l))
-	}
-	l = len(m.Commit)
-	if l > 0 {
-		n += 1 + l + sovPps(uint64(l))
-	}
-	l = len(m.Spec)
-	if l > 0 {
-		n += 1 + l + sovPps(uint64(l))
-	}
-	if m.Start != nil {
-		l = m.Start.Size()
-		n += 1 + l + sovPps(uint64(l))
+func (m *CreatePipelineRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *Input) Size() (n int) {
+func (m *CreatePipelineRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Atom != nil {
-		l = m.Atom.Size()
-		n += 1 + l + sovPps(uint64(l))
-	}
-	if len(m.Cross) > 0 {
-		for _, e := range m.Cross {
-			l = e.Size()
-			n += 1 + l + sovPps(uint64(l))
+	if m.Pipeline != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Pipeline.Size()))
+		n108, err := m.Pipeline.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
 		}
+		i += n108
 	}
-	if len(m.Union) > 0 {
-		for _, e := range m.Union {
-			l = e.Size()
-			n += 1 + l + sovPps(uint64(l))
+	if m.Transform != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Transform.Size()))
+		n109, err := m.Transform.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
 		}
+		i += n109
 	}
-	if m.Cron != nil {
-		l = m.Cron.Size()
-		n += 1 + l + sovPps(uint64(l))
+	if m.Update {
+		dAtA[i] = 0x28
+		i++
+		if m.Update {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
 	}
-	return n
-}
-
-func (m *JobInput) Size() (n int) {
-	var l int
-	_ = l
-	if m.Commit != nil {
-		l = m.Commit.Size()
-		n += 1 + l + sovPps(uint64(l))
+	if m.ParallelismSpec != nil {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.ParallelismSpec.Size()))
+		n110, err := m.ParallelismSpec.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n110
 	}
-	l = len(m.Glob)
-	if l > 0 {
-		n += 1 + l + sovPps(uint64(l))
+	if m.Egress != nil {
+		dAtA[i] = 0x4a
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Egress.Size()))
+		n111, err := m.Egress.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n111
 	}
-	if m.Lazy {
-		n += 2
+	if len(m.OutputBranch) > 0 {
+		dAtA[i] = 0x52
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(len(m.OutputBranch)))
+		i += copy(dAtA[i:], m.OutputBranch)
 	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovPps(uint64(l))
+	if m.ScaleDownThreshold != nil {
+		dAtA[i] = 0x5a
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.ScaleDownThreshold.Size()))
+		n112, err := m.ScaleDownThreshold.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n112
 	}
-	return n
-}
-
-func (m *ParallelismSpec) Size() (n int) {
-	var l int
-	_ = l
-	if m.Constant != 0 {
-		n += 1 + sovPps(uint64(m.Constant))
+	if m.ResourceSpec != nil {
+		dAtA[i] = 0x62
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.ResourceSpec.Size()))
+		n113, err := m.ResourceSpec.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n113
 	}
-	if m.Coefficient != 0 {
-		n += 9
+	if m.Input != nil {
+		dAtA[i] = 0x6a
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Input.Size()))
+		n114, err := m.Input.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n114
 	}
-	return n
-}
-
-func (m *InputFile) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Path)
-	if l > 0 {
-		n += 1 + l + sovPps(uint64(l))
+	if len(m.Description) > 0 {
+		dAtA[i] = 0x72
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Description)))
+		i += copy(dAtA[i:], m.Description)
 	}
-	l = len(m.Hash)
-	if l > 0 {
-		n += 1 + l + sovPps(uint64(l))
+	if m.Incremental {
+		dAtA[i] = 0x78
+		i++
+		if m.Incremental {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
 	}
-	return n
-}
-
-func (m *Datum) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.ID)
-	if l > 0 {
-		n += 1 + l + sovPps(uint64(l))
+	if len(m.CacheSize) > 0 {
+		dAtA[i] = 0x82
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(len(m.CacheSize)))
+		i += copy(dAtA[i:], m.CacheSize)
 	}
-	if m.Job != nil {
-		l = m.Job.Size()
-		n += 1 + l + sovPps(uint64(l))
+	if m.EnableStats {
+		dAtA[i] = 0x88
+		i++
+		dAtA[i] = 0x1
+		i++
+		if m.EnableStats {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
 	}
-	return n
-}
-
-func (m *DatumInfo) Size() (n int) {
-	var l int
-	_ = l
-	if m.Datum != nil {
-		l = m.Datum.Size()
-		n += 1 + l + sovPps(uint64(l))
+	if m.Reprocess {
+		dAtA[i] = 0x90
+		i++
+		dAtA[i] = 0x1
+		i++
+		if m.Reprocess {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
 	}
-	if m.State != 0 {
-		n += 1 + sovPps(uint64(m.State))
+	if m.Batch {
+		dAtA[i] = 0x98
+		i++
+		dAtA[i] = 0x1
+		i++
+		if m.Batch {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
 	}
-	if m.Stats != nil {
-		l = m.Stats.Size()
-		n += 1 + l + sovPps(uint64(l))
+	if m.MaxQueueSize != 0 {
+		dAtA[i] = 0xa0
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.MaxQueueSize))
 	}
-	if m.PfsState != nil {
-		l = m.PfsState.Size()
-		n += 1 + l + sovPps(uint64(l))
+	if m.Service != nil {
+		dAtA[i] = 0xaa
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Service.Size()))
+		n115, err := m.Service.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n115
 	}
-	if len(m.Data) > 0 {
-		for _, e := range m.Data {
-			l = e.Size()
-			n += 1 + l + sovPps(uint64(l))
+	if m.DatumTries != 0 {
+		dAtA[i] = 0xb0
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.DatumTries))
+	}
+	if m.DatumRetryBackoff != nil {
+		dAtA[i] = 0xba
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.DatumRetryBackoff.Size()))
+		n116, err := m.DatumRetryBackoff.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
 		}
+		i += n116
 	}
-	return n
-}
-
-func (m *DatumInfos) Size() (n int) {
-	var l int
-	_ = l
-	if len(m.DatumInfo) > 0 {
-		for _, e := range m.DatumInfo {
-			l = e.Size()
-			n += 1 + l + sovPps(uint64(l))
+	if m.DatumTimeout != nil {
+		dAtA[i] = 0xc2
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.DatumTimeout.Size()))
+		n117, err := m.DatumTimeout.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
 		}
+		i += n117
 	}
-	return n
-}
-
-func (m *Aggregate) Size() (n int) {
-	var l int
-	_ = l
-	if m.Count != 0 {
-		n += 1 + sovPps(uint64(m.Count))
+	if m.JobTimeout != nil {
+		dAtA[i] = 0xca
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.JobTimeout.Size()))
+		n118, err := m.JobTimeout.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n118
 	}
-	if m.Mean != 0 {
-		n += 9
+	if m.SkipFailedDatums {
+		dAtA[i] = 0xd0
+		i++
+		dAtA[i] = 0x1
+		i++
+		if m.SkipFailedDatums {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
 	}
-	if m.Stddev != 0 {
-		n += 9
+	if m.ResourceLimits != nil {
+		dAtA[i] = 0xda
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.ResourceLimits.Size()))
+		n119, err := m.ResourceLimits.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n119
 	}
-	if m.FifthPercentile != 0 {
-		n += 9
+	if m.SchedulingSpec != nil {
+		dAtA[i] = 0xe2
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.SchedulingSpec.Size()))
+		n120, err := m.SchedulingSpec.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n120
 	}
-	if m.NinetyFifthPercentile != 0 {
-		n += 9
+	if len(m.PodPatch) > 0 {
+		dAtA[i] = 0xea
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(len(m.PodPatch)))
+		i += copy(dAtA[i:], m.PodPatch)
 	}
-	return n
-}
-
-func (m *ProcessStats) Size() (n int) {
-	var l int
-	_ = l
-	if m.DownloadTime != nil {
-		l = m.DownloadTime.Size()
-		n += 1 + l + sovPps(uint64(l))
+	if m.AutoscalingSpec != nil {
+		dAtA[i] = 0xf2
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.AutoscalingSpec.Size()))
+		n121, err := m.AutoscalingSpec.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n121
 	}
-	if m.ProcessTime != nil {
-		l = m.ProcessTime.Size()
-		n += 1 + l + sovPps(uint64(l))
+	if len(m.PriorityClass) > 0 {
+		dAtA[i] = 0xfa
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(len(m.PriorityClass)))
+		i += copy(dAtA[i:], m.PriorityClass)
 	}
-	if m.UploadTime != nil {
-		l = m.UploadTime.Size()
-		n += 1 + l + sovPps(uint64(l))
+	if len(m.Team) > 0 {
+		dAtA[i] = 0x82
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Team)))
+		i += copy(dAtA[i:], m.Team)
 	}
-	if m.DownloadBytes != 0 {
-		n += 1 + sovPps(uint64(m.DownloadBytes))
+	if m.Spout != nil {
+		dAtA[i] = 0x8a
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Spout.Size()))
+		n122, err := m.Spout.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n122
 	}
-	if m.UploadBytes != 0 {
-		n += 1 + sovPps(uint64(m.UploadBytes))
+	return i, nil
+}
+
+func (m *InspectPipelineRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *AggregateProcessStats) Size() (n int) {
+func (m *InspectPipelineRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.DownloadTime != nil {
-		l = m.DownloadTime.Size()
-		n += 1 + l + sovPps(uint64(l))
-	}
-	if m.ProcessTime != nil {
-		l = m.ProcessTime.Size()
-		n += 1 + l + sovPps(uint64(l))
+	if m.Pipeline != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Pipeline.Size()))
+		n123, err := m.Pipeline.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n123
 	}
-	if m.UploadTime != nil {
-		l = m.UploadTime.Size()
-		n += 1 + l + sovPps(uint64(l))
+	if m.Version != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Version))
 	}
-	if m.DownloadBytes != nil {
-		l = m.DownloadBytes.Size()
-		n += 1 + l + sovPps(uint64(l))
+	if m.WorkerSpec {
+		dAtA[i] = 0x18
+		i++
+		if m.WorkerSpec {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
 	}
-	if m.UploadBytes != nil {
-		l = m.UploadBytes.Size()
-		n += 1 + l + sovPps(uint64(l))
+	return i, nil
+}
+
+func (m *ListPipelineVersionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *WorkerStatus) Size() (n int) {
+func (m *ListPipelineVersionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.WorkerID)
-	if l > 0 {
-		n += 1 + l + sovPps(uint64(l))
-	}
-	l = len(m.JobID)
-	if l > 0 {
-		n += 1 + l + sovPps(uint64(l))
-	}
-	if len(m.Data) > 0 {
-		for _, e := range m.Data {
-			l = e.Size()
-			n += 1 + l + sovPps(uint64(l))
+	if m.Pipeline != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Pipeline.Size()))
+		n124, err := m.Pipeline.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
 		}
+		i += n124
 	}
-	if m.Started != nil {
-		l = m.Started.Size()
-		n += 1 + l + sovPps(uint64(l))
-	}
-	if m.Stats != nil {
-		l = m.Stats.Size()
-		n += 1 + l + sovPps(uint64(l))
-	}
-	if m.QueueSize != 0 {
-		n += 1 + sovPps(uint64(m.QueueSize))
+	return i, nil
+}
+
+func (m *RollbackPipelineRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ResourceSpec) Size() (n int) {
+func (m *RollbackPipelineRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Cpu != 0 {
-		n += 5
+	if m.Pipeline != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Pipeline.Size()))
+		n125, err := m.Pipeline.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n125
 	}
-	l = len(m.Memory)
-	if l > 0 {
-		n += 1 + l + sovPps(uint64(l))
+	if m.Version != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Version))
 	}
-	if m.Gpu != 0 {
-		n += 1 + sovPps(uint64(m.Gpu))
+	return i, nil
+}
+
+func (m *ListPipelineRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *JobInfo) Size() (n int) {
+func (m *ListPipelineRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Job != nil {
-		l = m.Job.Size()
-		n += 1 + l + sovPps(uint64(l))
-	}
-	if m.Transform != nil {
-		l = m.Transform.Size()
-		n += 1 + l + sovPps(uint64(l))
+	return i, nil
+}
+
+func (m *DeletePipelineRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *DeletePipelineRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
 	if m.Pipeline != nil {
-		l = m.Pipeline.Size()
-		n += 1 + l + sovPps(uint64(l))
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Pipeline.Size()))
+		n126, err := m.Pipeline.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n126
 	}
-	if m.ParentJob != nil {
-		l = m.ParentJob.Size()
-		n += 1 + l + sovPps(uint64(l))
+	if m.DeleteJobs {
+		dAtA[i] = 0x10
+		i++
+		if m.DeleteJobs {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
 	}
-	if m.Started != nil {
-		l = m.Started.Size()
-		n += 1 + l + sovPps(uint64(l))
+	if m.DeleteRepo {
+		dAtA[i] = 0x18
+		i++
+		if m.DeleteRepo {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
 	}
-	if m.Finished != nil {
-		l = m.Finished.Size()
-		n += 1 + l + sovPps(uint64(l))
+	if m.All {
+		dAtA[i] = 0x20
+		i++
+		if m.All {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
 	}
-	if m.OutputCommit != nil {
-		l = m.OutputCommit.Size()
-		n += 1 + l + sovPps(uint64(l))
+	return i, nil
+}
+
+func (m *StartPipelineRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	if m.State != 0 {
-		n += 1 + sovPps(uint64(m.State))
-	}
-	if m.ParallelismSpec != nil {
-		l = m.ParallelismSpec.Size()
-		n += 1 + l + sovPps(uint64(l))
-	}
-	if m.PipelineVersion != 0 {
-		n += 1 + sovPps(uint64(m.PipelineVersion))
-	}
-	if m.Service != nil {
-		l = m.Service.Size()
-		n += 1 + l + sovPps(uint64(l))
-	}
-	if m.Egress != nil {
-		l = m.Egress.Size()
-		n += 1 + l + sovPps(uint64(l))
-	}
-	l = len(m.OutputBranch)
-	if l > 0 {
-		n += 2 + l + sovPps(uint64(l))
-	}
-	if m.OutputRepo != nil {
-		l = m.OutputRepo.Size()
-		n += 2 + l + sovPps(uint64(l))
-	}
-	if m.Restart != 0 {
-		n += 2 + sovPps(uint64(m.Restart))
-	}
-	if m.DataProcessed != 0 {
-		n += 2 + sovPps(uint64(m.DataProcessed))
-	}
-	if m.DataTotal != 0 {
-		n += 2 + sovPps(uint64(m.DataTotal))
-	}
-	if len(m.WorkerStatus) > 0 {
-		for _, e := range m.WorkerStatus {
-			l = e.Size()
-			n += 2 + l + sovPps(uint64(l))
-		}
-	}
-	if m.ResourceSpec != nil {
-		l = m.ResourceSpec.Size()
-		n += 2 + l + sovPps(uint64(l))
-	}
-	if m.Input != nil {
-		l = m.Input.Size()
-		n += 2 + l + sovPps(uint64(l))
-	}
-	if m.NewBranch != nil {
-		l = m.NewBranch.Size()
-		n += 2 + l + sovPps(uint64(l))
-	}
-	if m.Incremental {
-		n += 3
-	}
-	if m.StatsCommit != nil {
-		l = m.StatsCommit.Size()
-		n += 2 + l + sovPps(uint64(l))
-	}
-	if m.DataSkipped != 0 {
-		n += 2 + sovPps(uint64(m.DataSkipped))
-	}
-	if m.Stats != nil {
-		l = m.Stats.Size()
-		n += 2 + l + sovPps(uint64(l))
-	}
-	if m.EnableStats {
-		n += 3
-	}
-	l = len(m.Salt)
-	if l > 0 {
-		n += 2 + l + sovPps(uint64(l))
-	}
-	if m.Batch {
-		n += 3
-	}
-	l = len(m.Reason)
-	if l > 0 {
-		n += 2 + l + sovPps(uint64(l))
-	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *Worker) Size() (n int) {
+func (m *StartPipelineRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovPps(uint64(l))
+	if m.Pipeline != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Pipeline.Size()))
+		n127, err := m.Pipeline.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n127
 	}
-	if m.State != 0 {
-		n += 1 + sovPps(uint64(m.State))
+	return i, nil
+}
+
+func (m *StopPipelineRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *JobInfos) Size() (n int) {
+func (m *StopPipelineRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if len(m.JobInfo) > 0 {
-		for _, e := range m.JobInfo {
-			l = e.Size()
-			n += 1 + l + sovPps(uint64(l))
+	if m.Pipeline != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Pipeline.Size()))
+		n128, err := m.Pipeline.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
 		}
+		i += n128
 	}
-	return n
+	return i, nil
 }
 
-func (m *Pipeline) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovPps(uint64(l))
+func (m *RerunPipelineRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *PipelineInput) Size() (n int) {
+func (m *RerunPipelineRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Repo != nil {
-		l = m.Repo.Size()
-		n += 1 + l + sovPps(uint64(l))
-	}
-	l = len(m.Branch)
-	if l > 0 {
-		n += 1 + l + sovPps(uint64(l))
-	}
-	l = len(m.Glob)
-	if l > 0 {
-		n += 1 + l + sovPps(uint64(l))
+	if m.Pipeline != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Pipeline.Size()))
+		n129, err := m.Pipeline.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n129
 	}
-	if m.Lazy {
-		n += 2
+	if len(m.Exclude) > 0 {
+		for _, msg := range m.Exclude {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintPps(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
 	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovPps(uint64(l))
+	if len(m.Include) > 0 {
+		for _, msg := range m.Include {
+			dAtA[i] = 0x1a
+			i++
+			i = encodeVarintPps(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
 	}
-	if m.From != nil {
-		l = m.From.Size()
-		n += 1 + l + sovPps(uint64(l))
+	return i, nil
+}
+
+func (m *ApplyPipelinesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *PipelineInfo) Size() (n int) {
+func (m *ApplyPipelinesRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Pipeline != nil {
-		l = m.Pipeline.Size()
-		n += 1 + l + sovPps(uint64(l))
-	}
-	if m.Transform != nil {
-		l = m.Transform.Size()
-		n += 1 + l + sovPps(uint64(l))
-	}
-	if m.CreatedAt != nil {
-		l = m.CreatedAt.Size()
-		n += 1 + l + sovPps(uint64(l))
-	}
-	if m.State != 0 {
-		n += 1 + sovPps(uint64(m.State))
-	}
-	l = len(m.RecentError)
-	if l > 0 {
-		n += 1 + l + sovPps(uint64(l))
+	if len(m.Repos) > 0 {
+		for _, msg := range m.Repos {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintPps(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
 	}
-	if len(m.JobCounts) > 0 {
-		for k, v := range m.JobCounts {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + sovPps(uint64(k)) + 1 + sovPps(uint64(v))
-			n += mapEntrySize + 1 + sovPps(uint64(mapEntrySize))
+	if len(m.Pipelines) > 0 {
+		for _, msg := range m.Pipelines {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintPps(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
 		}
 	}
-	if m.ParallelismSpec != nil {
-		l = m.ParallelismSpec.Size()
-		n += 1 + l + sovPps(uint64(l))
+	if m.Prune {
+		dAtA[i] = 0x18
+		i++
+		if m.Prune {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
 	}
-	if m.Version != 0 {
-		n += 1 + sovPps(uint64(m.Version))
-	}
-	if m.Egress != nil {
-		l = m.Egress.Size()
-		n += 1 + l + sovPps(uint64(l))
-	}
-	l = len(m.OutputBranch)
-	if l > 0 {
-		n += 2 + l + sovPps(uint64(l))
-	}
-	l = len(m.ID)
-	if l > 0 {
-		n += 2 + l + sovPps(uint64(l))
-	}
-	if m.ScaleDownThreshold != nil {
-		l = m.ScaleDownThreshold.Size()
-		n += 2 + l + sovPps(uint64(l))
-	}
-	if m.ResourceSpec != nil {
-		l = m.ResourceSpec.Size()
-		n += 2 + l + sovPps(uint64(l))
-	}
-	if m.Input != nil {
-		l = m.Input.Size()
-		n += 2 + l + sovPps(uint64(l))
-	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 2 + l + sovPps(uint64(l))
-	}
-	if m.Incremental {
-		n += 3
-	}
-	l = len(m.CacheSize)
-	if l > 0 {
-		n += 2 + l + sovPps(uint64(l))
-	}
-	if m.EnableStats {
-		n += 3
-	}
-	l = len(m.Salt)
-	if l > 0 {
-		n += 2 + l + sovPps(uint64(l))
-	}
-	l = len(m.Capability)
-	if l > 0 {
-		n += 2 + l + sovPps(uint64(l))
-	}
-	if m.Batch {
-		n += 3
-	}
-	l = len(m.Reason)
-	if l > 0 {
-		n += 2 + l + sovPps(uint64(l))
-	}
-	if m.MaxQueueSize != 0 {
-		n += 2 + sovPps(uint64(m.MaxQueueSize))
+	if m.Reprocess {
+		dAtA[i] = 0x20
+		i++
+		if m.Reprocess {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
 	}
-	if m.Service != nil {
-		l = m.Service.Size()
-		n += 2 + l + sovPps(uint64(l))
+	if m.DryRun {
+		dAtA[i] = 0x28
+		i++
+		if m.DryRun {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
 	}
-	return n
+	return i, nil
 }
 
-func (m *PipelineInfos) Size() (n int) {
-	var l int
-	_ = l
-	if len(m.PipelineInfo) > 0 {
-		for _, e := range m.PipelineInfo {
-			l = e.Size()
-			n += 1 + l + sovPps(uint64(l))
-		}
+func (m *ApplyAction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *CreateJobRequest) Size() (n int) {
+func (m *ApplyAction) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Transform != nil {
-		l = m.Transform.Size()
-		n += 1 + l + sovPps(uint64(l))
-	}
-	if m.Pipeline != nil {
-		l = m.Pipeline.Size()
-		n += 1 + l + sovPps(uint64(l))
-	}
-	if m.ParallelismSpec != nil {
-		l = m.ParallelismSpec.Size()
-		n += 1 + l + sovPps(uint64(l))
-	}
-	if m.Service != nil {
-		l = m.Service.Size()
-		n += 1 + l + sovPps(uint64(l))
-	}
-	if m.Egress != nil {
-		l = m.Egress.Size()
-		n += 1 + l + sovPps(uint64(l))
-	}
-	if m.PipelineVersion != 0 {
-		n += 1 + sovPps(uint64(m.PipelineVersion))
-	}
-	l = len(m.OutputBranch)
-	if l > 0 {
-		n += 1 + l + sovPps(uint64(l))
-	}
-	if m.OutputRepo != nil {
-		l = m.OutputRepo.Size()
-		n += 1 + l + sovPps(uint64(l))
-	}
-	if m.ParentJob != nil {
-		l = m.ParentJob.Size()
-		n += 1 + l + sovPps(uint64(l))
-	}
-	if m.ResourceSpec != nil {
-		l = m.ResourceSpec.Size()
-		n += 1 + l + sovPps(uint64(l))
-	}
-	if m.Input != nil {
-		l = m.Input.Size()
-		n += 1 + l + sovPps(uint64(l))
-	}
-	if m.NewBranch != nil {
-		l = m.NewBranch.Size()
-		n += 2 + l + sovPps(uint64(l))
+	if m.Type != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Type))
 	}
-	if m.Incremental {
-		n += 3
+	if len(m.Name) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
 	}
-	if m.EnableStats {
-		n += 3
+	if len(m.ChangedFields) > 0 {
+		for _, s := range m.ChangedFields {
+			dAtA[i] = 0x1a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
 	}
-	l = len(m.Salt)
-	if l > 0 {
-		n += 2 + l + sovPps(uint64(l))
+	if m.Reprocess {
+		dAtA[i] = 0x20
+		i++
+		if m.Reprocess {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
 	}
-	if m.Batch {
-		n += 3
+	if len(m.Downstream) > 0 {
+		for _, s := range m.Downstream {
+			dAtA[i] = 0x2a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
 	}
-	return n
+	return i, nil
 }
 
-func (m *InspectJobRequest) Size() (n int) {
-	var l int
-	_ = l
-	if m.Job != nil {
-		l = m.Job.Size()
-		n += 1 + l + sovPps(uint64(l))
-	}
-	if m.BlockState {
-		n += 2
+func (m *ApplyPipelinesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ListJobRequest) Size() (n int) {
+func (m *ApplyPipelinesResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Pipeline != nil {
-		l = m.Pipeline.Size()
-		n += 1 + l + sovPps(uint64(l))
-	}
-	if len(m.InputCommit) > 0 {
-		for _, e := range m.InputCommit {
-			l = e.Size()
-			n += 1 + l + sovPps(uint64(l))
+	if len(m.Actions) > 0 {
+		for _, msg := range m.Actions {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintPps(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
 		}
 	}
-	if m.OutputCommit != nil {
-		l = m.OutputCommit.Size()
-		n += 1 + l + sovPps(uint64(l))
-	}
-	return n
+	return i, nil
 }
 
-func (m *DeleteJobRequest) Size() (n int) {
-	var l int
-	_ = l
-	if m.Job != nil {
-		l = m.Job.Size()
-		n += 1 + l + sovPps(uint64(l))
+func (m *CreateWebhookRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *StopJobRequest) Size() (n int) {
+func (m *CreateWebhookRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Job != nil {
-		l = m.Job.Size()
-		n += 1 + l + sovPps(uint64(l))
-	}
-	return n
+	if m.Webhook != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Webhook.Size()))
+		n130, err := m.Webhook.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n130
+	}
+	if m.Update {
+		dAtA[i] = 0x10
+		i++
+		if m.Update {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
 }
 
-func (m *GetLogsRequest) Size() (n int) {
+func (m *ListWebhookRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListWebhookRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Job != nil {
-		l = m.Job.Size()
-		n += 1 + l + sovPps(uint64(l))
-	}
 	if m.Pipeline != nil {
-		l = m.Pipeline.Size()
-		n += 1 + l + sovPps(uint64(l))
-	}
-	if len(m.DataFilters) > 0 {
-		for _, s := range m.DataFilters {
-			l = len(s)
-			n += 1 + l + sovPps(uint64(l))
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Pipeline.Size()))
+		n131, err := m.Pipeline.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
 		}
+		i += n131
 	}
-	if m.Master {
-		n += 2
-	}
-	if m.Datum != nil {
-		l = m.Datum.Size()
-		n += 1 + l + sovPps(uint64(l))
+	return i, nil
+}
+
+func (m *DeleteWebhookRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *LogMessage) Size() (n int) {
+func (m *DeleteWebhookRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.PipelineName)
-	if l > 0 {
-		n += 1 + l + sovPps(uint64(l))
+	if len(m.Name) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
 	}
-	l = len(m.JobID)
-	if l > 0 {
-		n += 1 + l + sovPps(uint64(l))
+	return i, nil
+}
+
+func (m *TraceFileRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Data) > 0 {
-		for _, e := range m.Data {
-			l = e.Size()
-			n += 1 + l + sovPps(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *TraceFileRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.File != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.File.Size()))
+		n132, err := m.File.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
 		}
+		i += n132
 	}
-	if m.Ts != nil {
-		l = m.Ts.Size()
-		n += 1 + l + sovPps(uint64(l))
-	}
-	l = len(m.Message)
-	if l > 0 {
-		n += 1 + l + sovPps(uint64(l))
-	}
-	l = len(m.WorkerID)
-	if l > 0 {
-		n += 1 + l + sovPps(uint64(l))
-	}
-	if m.User {
-		n += 2
-	}
-	l = len(m.DatumID)
-	if l > 0 {
-		n += 1 + l + sovPps(uint64(l))
+	if m.Depth != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Depth))
 	}
-	if m.Master {
-		n += 2
+	return i, nil
+}
+
+func (m *FileTrace) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *RestartDatumRequest) Size() (n int) {
+func (m *FileTrace) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
+	if m.File != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.File.Size()))
+		n133, err := m.File.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n133
+	}
 	if m.Job != nil {
-		l = m.Job.Size()
-		n += 1 + l + sovPps(uint64(l))
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Job.Size()))
+		n134, err := m.Job.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n134
 	}
-	if len(m.DataFilters) > 0 {
-		for _, s := range m.DataFilters {
-			l = len(s)
-			n += 1 + l + sovPps(uint64(l))
+	if len(m.Datums) > 0 {
+		for _, msg := range m.Datums {
+			dAtA[i] = 0x1a
+			i++
+			i = encodeVarintPps(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
 		}
 	}
-	return n
+	return i, nil
 }
 
-func (m *InspectDatumRequest) Size() (n int) {
-	var l int
-	_ = l
-	if m.Datum != nil {
-		l = m.Datum.Size()
-		n += 1 + l + sovPps(uint64(l))
+func (m *DatumTrace) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ListDatumRequest) Size() (n int) {
+func (m *DatumTrace) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Job != nil {
-		l = m.Job.Size()
-		n += 1 + l + sovPps(uint64(l))
+	if len(m.DatumID) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(len(m.DatumID)))
+		i += copy(dAtA[i:], m.DatumID)
 	}
-	if m.PageSize != 0 {
-		n += 1 + sovPps(uint64(m.PageSize))
+	if len(m.Inputs) > 0 {
+		for _, msg := range m.Inputs {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintPps(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
 	}
-	if m.Page != 0 {
-		n += 1 + sovPps(uint64(m.Page))
+	return i, nil
+}
+
+func (m *ImpactAnalysisRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ListDatumResponse) Size() (n int) {
+func (m *ImpactAnalysisRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if len(m.DatumInfos) > 0 {
-		for _, e := range m.DatumInfos {
-			l = e.Size()
-			n += 1 + l + sovPps(uint64(l))
+	if m.Repo != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Repo.Size()))
+		n135, err := m.Repo.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
 		}
+		i += n135
 	}
-	if m.TotalPages != 0 {
-		n += 1 + sovPps(uint64(m.TotalPages))
+	if len(m.Branch) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Branch)))
+		i += copy(dAtA[i:], m.Branch)
 	}
-	if m.Page != 0 {
-		n += 1 + sovPps(uint64(m.Page))
+	if len(m.Paths) > 0 {
+		for _, s := range m.Paths {
+			dAtA[i] = 0x1a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
 	}
-	return n
+	return i, nil
 }
 
-func (m *CreatePipelineRequest) Size() (n int) {
+func (m *PipelineImpact) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PipelineImpact) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
 	if m.Pipeline != nil {
-		l = m.Pipeline.Size()
-		n += 1 + l + sovPps(uint64(l))
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Pipeline.Size()))
+		n136, err := m.Pipeline.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n136
 	}
-	if m.Transform != nil {
-		l = m.Transform.Size()
-		n += 1 + l + sovPps(uint64(l))
+	if m.DatumsTotal != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.DatumsTotal))
 	}
-	if m.Update {
-		n += 2
+	if m.DatumsInvalidated != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.DatumsInvalidated))
 	}
-	if m.ParallelismSpec != nil {
-		l = m.ParallelismSpec.Size()
-		n += 1 + l + sovPps(uint64(l))
+	if m.DatumsReused != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.DatumsReused))
 	}
-	if m.Egress != nil {
-		l = m.Egress.Size()
-		n += 1 + l + sovPps(uint64(l))
+	if m.EstimatedProcessTime != nil {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.EstimatedProcessTime.Size()))
+		n137, err := m.EstimatedProcessTime.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n137
 	}
-	l = len(m.OutputBranch)
+	return i, nil
+}
+
+func (m *ImpactAnalysisResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ImpactAnalysisResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Pipelines) > 0 {
+		for _, msg := range m.Pipelines {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintPps(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *GarbageCollectRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GarbageCollectRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
+func (m *GarbageCollectResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GarbageCollectResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
+func encodeFixed64Pps(dAtA []byte, offset int, v uint64) int {
+	dAtA[offset] = uint8(v)
+	dAtA[offset+1] = uint8(v >> 8)
+	dAtA[offset+2] = uint8(v >> 16)
+	dAtA[offset+3] = uint8(v >> 24)
+	dAtA[offset+4] = uint8(v >> 32)
+	dAtA[offset+5] = uint8(v >> 40)
+	dAtA[offset+6] = uint8(v >> 48)
+	dAtA[offset+7] = uint8(v >> 56)
+	return offset + 8
+}
+func encodeFixed32Pps(dAtA []byte, offset int, v uint32) int {
+	dAtA[offset] = uint8(v)
+	dAtA[offset+1] = uint8(v >> 8)
+	dAtA[offset+2] = uint8(v >> 16)
+	dAtA[offset+3] = uint8(v >> 24)
+	return offset + 4
+}
+func encodeVarintPps(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func (m *Secret) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Name)
 	if l > 0 {
 		n += 1 + l + sovPps(uint64(l))
 	}
-	if m.ScaleDownThreshold != nil {
-		l = m.ScaleDownThreshold.Size()
+	l = len(m.MountPath)
+	if l > 0 {
 		n += 1 + l + sovPps(uint64(l))
 	}
-	if m.ResourceSpec != nil {
-		l = m.ResourceSpec.Size()
+	l = len(m.EnvVar)
+	if l > 0 {
 		n += 1 + l + sovPps(uint64(l))
 	}
-	if m.Input != nil {
-		l = m.Input.Size()
+	l = len(m.Key)
+	if l > 0 {
 		n += 1 + l + sovPps(uint64(l))
 	}
-	l = len(m.Description)
+	return n
+}
+
+func (m *Transform) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Image)
 	if l > 0 {
 		n += 1 + l + sovPps(uint64(l))
 	}
-	if m.Incremental {
-		n += 2
+	if len(m.Cmd) > 0 {
+		for _, s := range m.Cmd {
+			l = len(s)
+			n += 1 + l + sovPps(uint64(l))
+		}
 	}
-	l = len(m.CacheSize)
-	if l > 0 {
-		n += 2 + l + sovPps(uint64(l))
+	if len(m.Env) > 0 {
+		for k, v := range m.Env {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovPps(uint64(len(k))) + 1 + len(v) + sovPps(uint64(len(v)))
+			n += mapEntrySize + 1 + sovPps(uint64(mapEntrySize))
+		}
 	}
-	if m.EnableStats {
-		n += 3
+	if len(m.Secrets) > 0 {
+		for _, e := range m.Secrets {
+			l = e.Size()
+			n += 1 + l + sovPps(uint64(l))
+		}
 	}
-	if m.Reprocess {
-		n += 3
+	if len(m.Stdin) > 0 {
+		for _, s := range m.Stdin {
+			l = len(s)
+			n += 1 + l + sovPps(uint64(l))
+		}
 	}
-	if m.Batch {
-		n += 3
+	if len(m.AcceptReturnCode) > 0 {
+		l = 0
+		for _, e := range m.AcceptReturnCode {
+			l += sovPps(uint64(e))
+		}
+		n += 1 + sovPps(uint64(l)) + l
 	}
-	if m.MaxQueueSize != 0 {
-		n += 2 + sovPps(uint64(m.MaxQueueSize))
+	if m.Debug {
+		n += 2
 	}
-	if m.Service != nil {
-		l = m.Service.Size()
-		n += 2 + l + sovPps(uint64(l))
+	if len(m.ImagePullSecrets) > 0 {
+		for _, s := range m.ImagePullSecrets {
+			l = len(s)
+			n += 1 + l + sovPps(uint64(l))
+		}
 	}
 	return n
 }
 
-func (m *InspectPipelineRequest) Size() (n int) {
+func (m *Egress) Size() (n int) {
 	var l int
 	_ = l
-	if m.Pipeline != nil {
-		l = m.Pipeline.Size()
+	l = len(m.URL)
+	if l > 0 {
 		n += 1 + l + sovPps(uint64(l))
 	}
+	if m.Retries != 0 {
+		n += 1 + sovPps(uint64(m.Retries))
+	}
 	return n
 }
 
-func (m *ListPipelineRequest) Size() (n int) {
+func (m *EgressInfo) Size() (n int) {
 	var l int
 	_ = l
+	if m.State != 0 {
+		n += 1 + sovPps(uint64(m.State))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	l = len(m.URL)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.BaseCommit != nil {
+		l = m.BaseCommit.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.FilesPushed != 0 {
+		n += 1 + sovPps(uint64(m.FilesPushed))
+	}
+	if m.FilesDeleted != 0 {
+		n += 1 + sovPps(uint64(m.FilesDeleted))
+	}
+	if m.BytesPushed != 0 {
+		n += 1 + sovPps(uint64(m.BytesPushed))
+	}
+	if m.Started != nil {
+		l = m.Started.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.Finished != nil {
+		l = m.Finished.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
 	return n
 }
 
-func (m *DeletePipelineRequest) Size() (n int) {
+func (m *Job) Size() (n int) {
 	var l int
 	_ = l
-	if m.Pipeline != nil {
-		l = m.Pipeline.Size()
+	l = len(m.ID)
+	if l > 0 {
 		n += 1 + l + sovPps(uint64(l))
 	}
-	if m.DeleteJobs {
-		n += 2
-	}
-	if m.DeleteRepo {
-		n += 2
-	}
-	if m.All {
-		n += 2
-	}
 	return n
 }
 
-func (m *StartPipelineRequest) Size() (n int) {
+func (m *Spout) Size() (n int) {
 	var l int
 	_ = l
-	if m.Pipeline != nil {
-		l = m.Pipeline.Size()
+	if m.Overwrite {
+		n += 2
+	}
+	if m.CommitBytes != 0 {
+		n += 1 + sovPps(uint64(m.CommitBytes))
+	}
+	if m.CommitInterval != nil {
+		l = m.CommitInterval.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	l = len(m.Marker)
+	if l > 0 {
 		n += 1 + l + sovPps(uint64(l))
 	}
 	return n
 }
 
-func (m *StopPipelineRequest) Size() (n int) {
+func (m *Service) Size() (n int) {
 	var l int
 	_ = l
-	if m.Pipeline != nil {
-		l = m.Pipeline.Size()
+	if m.InternalPort != 0 {
+		n += 1 + sovPps(uint64(m.InternalPort))
+	}
+	if m.ExternalPort != 0 {
+		n += 1 + sovPps(uint64(m.ExternalPort))
+	}
+	if len(m.Ports) > 0 {
+		for _, e := range m.Ports {
+			l = e.Size()
+			n += 1 + l + sovPps(uint64(l))
+		}
+	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.ReadinessProbe != nil {
+		l = m.ReadinessProbe.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	return n
+}
+
+func (m *ServicePort) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.InternalPort != 0 {
+		n += 1 + sovPps(uint64(m.InternalPort))
+	}
+	if m.ExternalPort != 0 {
+		n += 1 + sovPps(uint64(m.ExternalPort))
+	}
+	return n
+}
+
+func (m *ReadinessProbe) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.HttpPath)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.Port != 0 {
+		n += 1 + sovPps(uint64(m.Port))
+	}
+	if len(m.Exec) > 0 {
+		for _, s := range m.Exec {
+			l = len(s)
+			n += 1 + l + sovPps(uint64(l))
+		}
+	}
+	if m.InitialDelay != nil {
+		l = m.InitialDelay.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.Period != nil {
+		l = m.Period.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.Timeout != nil {
+		l = m.Timeout.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.FailureThreshold != 0 {
+		n += 1 + sovPps(uint64(m.FailureThreshold))
+	}
+	return n
+}
+
+func (m *ServiceStatus) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Job)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if len(m.Commits) > 0 {
+		for _, e := range m.Commits {
+			l = e.Size()
+			n += 1 + l + sovPps(uint64(l))
+		}
+	}
+	l = len(m.PendingJob)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if len(m.PendingCommits) > 0 {
+		for _, e := range m.PendingCommits {
+			l = e.Size()
+			n += 1 + l + sovPps(uint64(l))
+		}
+	}
+	if m.Updated != nil {
+		l = m.Updated.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	return n
+}
+
+func (m *AtomInput) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	l = len(m.Repo)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	l = len(m.Branch)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	l = len(m.Commit)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	l = len(m.Glob)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.Lazy {
+		n += 2
+	}
+	l = len(m.FromCommit)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	return n
+}
+
+func (m *CronInput) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	l = len(m.Repo)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	l = len(m.Commit)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	l = len(m.Spec)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.Start != nil {
+		l = m.Start.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	return n
+}
+
+func (m *Input) Size() (n int) {
+	var l int
+	_ = l
+	if m.Atom != nil {
+		l = m.Atom.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if len(m.Cross) > 0 {
+		for _, e := range m.Cross {
+			l = e.Size()
+			n += 1 + l + sovPps(uint64(l))
+		}
+	}
+	if len(m.Union) > 0 {
+		for _, e := range m.Union {
+			l = e.Size()
+			n += 1 + l + sovPps(uint64(l))
+		}
+	}
+	if m.Cron != nil {
+		l = m.Cron.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	return n
+}
+
+func (m *JobInput) Size() (n int) {
+	var l int
+	_ = l
+	if m.Commit != nil {
+		l = m.Commit.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	l = len(m.Glob)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.Lazy {
+		n += 2
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	return n
+}
+
+func (m *ParallelismSpec) Size() (n int) {
+	var l int
+	_ = l
+	if m.Constant != 0 {
+		n += 1 + sovPps(uint64(m.Constant))
+	}
+	if m.Coefficient != 0 {
+		n += 9
+	}
+	return n
+}
+
+func (m *InputFile) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	return n
+}
+
+func (m *Datum) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.Job != nil {
+		l = m.Job.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	return n
+}
+
+func (m *DatumInfo) Size() (n int) {
+	var l int
+	_ = l
+	if m.Datum != nil {
+		l = m.Datum.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.State != 0 {
+		n += 1 + sovPps(uint64(m.State))
+	}
+	if m.Stats != nil {
+		l = m.Stats.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.PfsState != nil {
+		l = m.PfsState.Size()
 		n += 1 + l + sovPps(uint64(l))
 	}
-	return n
-}
+	if len(m.Data) > 0 {
+		for _, e := range m.Data {
+			l = e.Size()
+			n += 1 + l + sovPps(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DatumInfos) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.DatumInfo) > 0 {
+		for _, e := range m.DatumInfo {
+			l = e.Size()
+			n += 1 + l + sovPps(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Aggregate) Size() (n int) {
+	var l int
+	_ = l
+	if m.Count != 0 {
+		n += 1 + sovPps(uint64(m.Count))
+	}
+	if m.Mean != 0 {
+		n += 9
+	}
+	if m.Stddev != 0 {
+		n += 9
+	}
+	if m.FifthPercentile != 0 {
+		n += 9
+	}
+	if m.NinetyFifthPercentile != 0 {
+		n += 9
+	}
+	return n
+}
+
+func (m *ProcessStats) Size() (n int) {
+	var l int
+	_ = l
+	if m.DownloadTime != nil {
+		l = m.DownloadTime.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.ProcessTime != nil {
+		l = m.ProcessTime.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.UploadTime != nil {
+		l = m.UploadTime.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.DownloadBytes != 0 {
+		n += 1 + sovPps(uint64(m.DownloadBytes))
+	}
+	if m.UploadBytes != 0 {
+		n += 1 + sovPps(uint64(m.UploadBytes))
+	}
+	return n
+}
+
+func (m *AggregateProcessStats) Size() (n int) {
+	var l int
+	_ = l
+	if m.DownloadTime != nil {
+		l = m.DownloadTime.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.ProcessTime != nil {
+		l = m.ProcessTime.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.UploadTime != nil {
+		l = m.UploadTime.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.DownloadBytes != nil {
+		l = m.DownloadBytes.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.UploadBytes != nil {
+		l = m.UploadBytes.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	return n
+}
+
+func (m *WorkerStatus) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.WorkerID)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	l = len(m.JobID)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if len(m.Data) > 0 {
+		for _, e := range m.Data {
+			l = e.Size()
+			n += 1 + l + sovPps(uint64(l))
+		}
+	}
+	if m.Started != nil {
+		l = m.Started.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.Stats != nil {
+		l = m.Stats.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.QueueSize != 0 {
+		n += 1 + sovPps(uint64(m.QueueSize))
+	}
+	return n
+}
+
+func (m *ResourceSpec) Size() (n int) {
+	var l int
+	_ = l
+	if m.Cpu != 0 {
+		n += 5
+	}
+	l = len(m.Memory)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.Gpu != 0 {
+		n += 1 + sovPps(uint64(m.Gpu))
+	}
+	return n
+}
+
+func (m *Toleration) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	l = len(m.Effect)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	return n
+}
+
+func (m *AutoscalingSpec) Size() (n int) {
+	var l int
+	_ = l
+	if m.MinWorkers != 0 {
+		n += 1 + sovPps(uint64(m.MinWorkers))
+	}
+	if m.MaxWorkers != 0 {
+		n += 1 + sovPps(uint64(m.MaxWorkers))
+	}
+	if m.TargetQueueSize != 0 {
+		n += 1 + sovPps(uint64(m.TargetQueueSize))
+	}
+	if m.TargetDrainTime != nil {
+		l = m.TargetDrainTime.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.ScaleUpCooldown != nil {
+		l = m.ScaleUpCooldown.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.ScaleDownCooldown != nil {
+		l = m.ScaleDownCooldown.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	return n
+}
+
+func (m *AutoscalingStatus) Size() (n int) {
+	var l int
+	_ = l
+	if m.CurrentWorkers != 0 {
+		n += 1 + sovPps(uint64(m.CurrentWorkers))
+	}
+	if m.DesiredWorkers != 0 {
+		n += 1 + sovPps(uint64(m.DesiredWorkers))
+	}
+	if m.PendingDatums != 0 {
+		n += 1 + sovPps(uint64(m.PendingDatums))
+	}
+	if m.QueuedDatums != 0 {
+		n += 1 + sovPps(uint64(m.QueuedDatums))
+	}
+	if m.DatumLatency != nil {
+		l = m.DatumLatency.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.LastScaled != nil {
+		l = m.LastScaled.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.Updated != nil {
+		l = m.Updated.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	return n
+}
+
+func (m *PriorityClass) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.Priority != 0 {
+		n += 1 + sovPps(uint64(m.Priority))
+	}
+	if m.MaxRunningJobs != 0 {
+		n += 1 + sovPps(uint64(m.MaxRunningJobs))
+	}
+	if m.MaxWorkers != 0 {
+		n += 1 + sovPps(uint64(m.MaxWorkers))
+	}
+	return n
+}
+
+func (m *TeamQuota) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Team)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.MaxRunningJobs != 0 {
+		n += 1 + sovPps(uint64(m.MaxRunningJobs))
+	}
+	if m.MaxWorkers != 0 {
+		n += 1 + sovPps(uint64(m.MaxWorkers))
+	}
+	return n
+}
+
+func (m *AdmissionPolicy) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.PriorityClasses) > 0 {
+		for _, e := range m.PriorityClasses {
+			l = e.Size()
+			n += 1 + l + sovPps(uint64(l))
+		}
+	}
+	if len(m.Teams) > 0 {
+		for _, e := range m.Teams {
+			l = e.Size()
+			n += 1 + l + sovPps(uint64(l))
+		}
+	}
+	l = len(m.DefaultPriorityClass)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.MaxRunningJobs != 0 {
+		n += 1 + sovPps(uint64(m.MaxRunningJobs))
+	}
+	return n
+}
+
+func (m *SchedulingSpec) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.NodeSelector) > 0 {
+		for k, v := range m.NodeSelector {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovPps(uint64(len(k))) + 1 + len(v) + sovPps(uint64(len(v)))
+			n += mapEntrySize + 1 + sovPps(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Tolerations) > 0 {
+		for _, e := range m.Tolerations {
+			l = e.Size()
+			n += 1 + l + sovPps(uint64(l))
+		}
+	}
+	l = len(m.Affinity)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	return n
+}
+
+func (m *JobInfo) Size() (n int) {
+	var l int
+	_ = l
+	if m.Job != nil {
+		l = m.Job.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.Transform != nil {
+		l = m.Transform.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.Pipeline != nil {
+		l = m.Pipeline.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.ParentJob != nil {
+		l = m.ParentJob.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.Started != nil {
+		l = m.Started.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.Finished != nil {
+		l = m.Finished.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.OutputCommit != nil {
+		l = m.OutputCommit.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.State != 0 {
+		n += 1 + sovPps(uint64(m.State))
+	}
+	if m.ParallelismSpec != nil {
+		l = m.ParallelismSpec.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.PipelineVersion != 0 {
+		n += 1 + sovPps(uint64(m.PipelineVersion))
+	}
+	if m.Service != nil {
+		l = m.Service.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.Egress != nil {
+		l = m.Egress.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	l = len(m.OutputBranch)
+	if l > 0 {
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if m.OutputRepo != nil {
+		l = m.OutputRepo.Size()
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if m.Restart != 0 {
+		n += 2 + sovPps(uint64(m.Restart))
+	}
+	if m.DataProcessed != 0 {
+		n += 2 + sovPps(uint64(m.DataProcessed))
+	}
+	if m.DataTotal != 0 {
+		n += 2 + sovPps(uint64(m.DataTotal))
+	}
+	if len(m.WorkerStatus) > 0 {
+		for _, e := range m.WorkerStatus {
+			l = e.Size()
+			n += 2 + l + sovPps(uint64(l))
+		}
+	}
+	if m.ResourceSpec != nil {
+		l = m.ResourceSpec.Size()
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if m.Input != nil {
+		l = m.Input.Size()
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if m.NewBranch != nil {
+		l = m.NewBranch.Size()
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if m.Incremental {
+		n += 3
+	}
+	if m.StatsCommit != nil {
+		l = m.StatsCommit.Size()
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if m.DataSkipped != 0 {
+		n += 2 + sovPps(uint64(m.DataSkipped))
+	}
+	if m.Stats != nil {
+		l = m.Stats.Size()
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if m.EnableStats {
+		n += 3
+	}
+	l = len(m.Salt)
+	if l > 0 {
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if m.Batch {
+		n += 3
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if m.Rerun {
+		n += 3
+	}
+	if len(m.FailedDatums) > 0 {
+		for _, e := range m.FailedDatums {
+			l = e.Size()
+			n += 2 + l + sovPps(uint64(l))
+		}
+	}
+	if m.DataFailed != 0 {
+		n += 2 + sovPps(uint64(m.DataFailed))
+	}
+	if m.Lineage != nil {
+		l = m.Lineage.Size()
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if m.EgressInfo != nil {
+		l = m.EgressInfo.Size()
+		n += 2 + l + sovPps(uint64(l))
+	}
+	l = len(m.PriorityClass)
+	if l > 0 {
+		n += 2 + l + sovPps(uint64(l))
+	}
+	l = len(m.Team)
+	if l > 0 {
+		n += 2 + l + sovPps(uint64(l))
+	}
+	return n
+}
+
+func (m *FailedDatum) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.DatumID)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.ExitCode != 0 {
+		n += 1 + sovPps(uint64(m.ExitCode))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.Logs != nil {
+		l = m.Logs.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	return n
+}
+
+func (m *DatumLineage) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.DatumID)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if len(m.Inputs) > 0 {
+		for _, e := range m.Inputs {
+			l = e.Size()
+			n += 1 + l + sovPps(uint64(l))
+		}
+	}
+	if len(m.Outputs) > 0 {
+		for _, s := range m.Outputs {
+			l = len(s)
+			n += 1 + l + sovPps(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *JobLineage) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Datums) > 0 {
+		for _, e := range m.Datums {
+			l = e.Size()
+			n += 1 + l + sovPps(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Worker) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.State != 0 {
+		n += 1 + sovPps(uint64(m.State))
+	}
+	return n
+}
+
+func (m *JobInfos) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.JobInfo) > 0 {
+		for _, e := range m.JobInfo {
+			l = e.Size()
+			n += 1 + l + sovPps(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Pipeline) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	return n
+}
+
+func (m *PipelineInput) Size() (n int) {
+	var l int
+	_ = l
+	if m.Repo != nil {
+		l = m.Repo.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	l = len(m.Branch)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	l = len(m.Glob)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.Lazy {
+		n += 2
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.From != nil {
+		l = m.From.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	return n
+}
+
+func (m *PipelineInfo) Size() (n int) {
+	var l int
+	_ = l
+	if m.Pipeline != nil {
+		l = m.Pipeline.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.Transform != nil {
+		l = m.Transform.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.CreatedAt != nil {
+		l = m.CreatedAt.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.State != 0 {
+		n += 1 + sovPps(uint64(m.State))
+	}
+	l = len(m.RecentError)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if len(m.JobCounts) > 0 {
+		for k, v := range m.JobCounts {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + sovPps(uint64(k)) + 1 + sovPps(uint64(v))
+			n += mapEntrySize + 1 + sovPps(uint64(mapEntrySize))
+		}
+	}
+	if m.ParallelismSpec != nil {
+		l = m.ParallelismSpec.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.Version != 0 {
+		n += 1 + sovPps(uint64(m.Version))
+	}
+	if m.Egress != nil {
+		l = m.Egress.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	l = len(m.OutputBranch)
+	if l > 0 {
+		n += 2 + l + sovPps(uint64(l))
+	}
+	l = len(m.ID)
+	if l > 0 {
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if m.ScaleDownThreshold != nil {
+		l = m.ScaleDownThreshold.Size()
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if m.ResourceSpec != nil {
+		l = m.ResourceSpec.Size()
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if m.Input != nil {
+		l = m.Input.Size()
+		n += 2 + l + sovPps(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if m.Incremental {
+		n += 3
+	}
+	l = len(m.CacheSize)
+	if l > 0 {
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if m.EnableStats {
+		n += 3
+	}
+	l = len(m.Salt)
+	if l > 0 {
+		n += 2 + l + sovPps(uint64(l))
+	}
+	l = len(m.Capability)
+	if l > 0 {
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if m.Batch {
+		n += 3
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if m.MaxQueueSize != 0 {
+		n += 2 + sovPps(uint64(m.MaxQueueSize))
+	}
+	if m.Service != nil {
+		l = m.Service.Size()
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if m.DatumTries != 0 {
+		n += 2 + sovPps(uint64(m.DatumTries))
+	}
+	if m.DatumRetryBackoff != nil {
+		l = m.DatumRetryBackoff.Size()
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if m.DatumTimeout != nil {
+		l = m.DatumTimeout.Size()
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if m.JobTimeout != nil {
+		l = m.JobTimeout.Size()
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if m.SkipFailedDatums {
+		n += 3
+	}
+	if m.ResourceLimits != nil {
+		l = m.ResourceLimits.Size()
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if m.SchedulingSpec != nil {
+		l = m.SchedulingSpec.Size()
+		n += 2 + l + sovPps(uint64(l))
+	}
+	l = len(m.PodPatch)
+	if l > 0 {
+		n += 2 + l + sovPps(uint64(l))
+	}
+	l = len(m.WorkerSpec)
+	if l > 0 {
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if m.AutoscalingSpec != nil {
+		l = m.AutoscalingSpec.Size()
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if m.AutoscalingStatus != nil {
+		l = m.AutoscalingStatus.Size()
+		n += 2 + l + sovPps(uint64(l))
+	}
+	l = len(m.PriorityClass)
+	if l > 0 {
+		n += 2 + l + sovPps(uint64(l))
+	}
+	l = len(m.Team)
+	if l > 0 {
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if m.Spout != nil {
+		l = m.Spout.Size()
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if m.ServiceStatus != nil {
+		l = m.ServiceStatus.Size()
+		n += 2 + l + sovPps(uint64(l))
+	}
+	return n
+}
+
+func (m *PipelineInfos) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.PipelineInfo) > 0 {
+		for _, e := range m.PipelineInfo {
+			l = e.Size()
+			n += 1 + l + sovPps(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Event) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.Type != 0 {
+		n += 1 + sovPps(uint64(m.Type))
+	}
+	if m.Time != nil {
+		l = m.Time.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.Pipeline != nil {
+		l = m.Pipeline.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.Job != nil {
+		l = m.Job.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.JobState != 0 {
+		n += 1 + sovPps(uint64(m.JobState))
+	}
+	if m.PipelineState != 0 {
+		n += 1 + sovPps(uint64(m.PipelineState))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	return n
+}
+
+func (m *WebhookInfo) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	l = len(m.URL)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.Pipeline != nil {
+		l = m.Pipeline.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if len(m.Events) > 0 {
+		l = 0
+		for _, e := range m.Events {
+			l += sovPps(uint64(e))
+		}
+		n += 1 + sovPps(uint64(l)) + l
+	}
+	l = len(m.Secret)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	l = len(m.RecentError)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	return n
+}
+
+func (m *WebhookInfos) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.WebhookInfo) > 0 {
+		for _, e := range m.WebhookInfo {
+			l = e.Size()
+			n += 1 + l + sovPps(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *WebhookDelivery) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Webhook)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.Event != nil {
+		l = m.Event.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.Attempts != 0 {
+		n += 1 + sovPps(uint64(m.Attempts))
+	}
+	if m.NextAttempt != nil {
+		l = m.NextAttempt.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	l = len(m.LastError)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	return n
+}
+
+func (m *CreateJobRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Transform != nil {
+		l = m.Transform.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.Pipeline != nil {
+		l = m.Pipeline.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.ParallelismSpec != nil {
+		l = m.ParallelismSpec.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.Service != nil {
+		l = m.Service.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.Egress != nil {
+		l = m.Egress.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.PipelineVersion != 0 {
+		n += 1 + sovPps(uint64(m.PipelineVersion))
+	}
+	l = len(m.OutputBranch)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.OutputRepo != nil {
+		l = m.OutputRepo.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.ParentJob != nil {
+		l = m.ParentJob.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.ResourceSpec != nil {
+		l = m.ResourceSpec.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.Input != nil {
+		l = m.Input.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.NewBranch != nil {
+		l = m.NewBranch.Size()
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if m.Incremental {
+		n += 3
+	}
+	if m.EnableStats {
+		n += 3
+	}
+	l = len(m.Salt)
+	if l > 0 {
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if m.Batch {
+		n += 3
+	}
+	if m.Rerun {
+		n += 3
+	}
+	return n
+}
+
+func (m *InspectJobRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Job != nil {
+		l = m.Job.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.BlockState {
+		n += 2
+	}
+	return n
+}
+
+func (m *ListJobRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Pipeline != nil {
+		l = m.Pipeline.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if len(m.InputCommit) > 0 {
+		for _, e := range m.InputCommit {
+			l = e.Size()
+			n += 1 + l + sovPps(uint64(l))
+		}
+	}
+	if m.OutputCommit != nil {
+		l = m.OutputCommit.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	return n
+}
+
+func (m *EgressJobRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Job != nil {
+		l = m.Job.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	return n
+}
+
+func (m *DeleteJobRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Job != nil {
+		l = m.Job.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	return n
+}
+
+func (m *StopJobRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Job != nil {
+		l = m.Job.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	return n
+}
+
+func (m *GetLogsRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Job != nil {
+		l = m.Job.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.Pipeline != nil {
+		l = m.Pipeline.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if len(m.DataFilters) > 0 {
+		for _, s := range m.DataFilters {
+			l = len(s)
+			n += 1 + l + sovPps(uint64(l))
+		}
+	}
+	if m.Master {
+		n += 2
+	}
+	if m.Datum != nil {
+		l = m.Datum.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	return n
+}
+
+func (m *LogMessage) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.PipelineName)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	l = len(m.JobID)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if len(m.Data) > 0 {
+		for _, e := range m.Data {
+			l = e.Size()
+			n += 1 + l + sovPps(uint64(l))
+		}
+	}
+	if m.Ts != nil {
+		l = m.Ts.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	l = len(m.WorkerID)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.User {
+		n += 2
+	}
+	l = len(m.DatumID)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.Master {
+		n += 2
+	}
+	return n
+}
+
+func (m *RestartDatumRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Job != nil {
+		l = m.Job.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if len(m.DataFilters) > 0 {
+		for _, s := range m.DataFilters {
+			l = len(s)
+			n += 1 + l + sovPps(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *InspectDatumRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Datum != nil {
+		l = m.Datum.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	return n
+}
+
+func (m *ListDatumRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Job != nil {
+		l = m.Job.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.PageSize != 0 {
+		n += 1 + sovPps(uint64(m.PageSize))
+	}
+	if m.Page != 0 {
+		n += 1 + sovPps(uint64(m.Page))
+	}
+	return n
+}
+
+func (m *ListDatumResponse) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.DatumInfos) > 0 {
+		for _, e := range m.DatumInfos {
+			l = e.Size()
+			n += 1 + l + sovPps(uint64(l))
+		}
+	}
+	if m.TotalPages != 0 {
+		n += 1 + sovPps(uint64(m.TotalPages))
+	}
+	if m.Page != 0 {
+		n += 1 + sovPps(uint64(m.Page))
+	}
+	return n
+}
+
+func (m *ListDatumForInputRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Pipeline != nil {
+		l = m.Pipeline.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.Input != nil {
+		l = m.Input.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.PageSize != 0 {
+		n += 1 + sovPps(uint64(m.PageSize))
+	}
+	if m.Page != 0 {
+		n += 1 + sovPps(uint64(m.Page))
+	}
+	return n
+}
+
+func (m *DatumSizeBucket) Size() (n int) {
+	var l int
+	_ = l
+	if m.LowerBoundBytes != 0 {
+		n += 1 + sovPps(uint64(m.LowerBoundBytes))
+	}
+	if m.UpperBoundBytes != 0 {
+		n += 1 + sovPps(uint64(m.UpperBoundBytes))
+	}
+	if m.Count != 0 {
+		n += 1 + sovPps(uint64(m.Count))
+	}
+	return n
+}
+
+func (m *ListDatumForInputResponse) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.DatumInfos) > 0 {
+		for _, e := range m.DatumInfos {
+			l = e.Size()
+			n += 1 + l + sovPps(uint64(l))
+		}
+	}
+	if m.TotalPages != 0 {
+		n += 1 + sovPps(uint64(m.TotalPages))
+	}
+	if m.Page != 0 {
+		n += 1 + sovPps(uint64(m.Page))
+	}
+	if m.DatumCount != 0 {
+		n += 1 + sovPps(uint64(m.DatumCount))
+	}
+	if m.TotalBytes != 0 {
+		n += 1 + sovPps(uint64(m.TotalBytes))
+	}
+	if m.MinDatumBytes != 0 {
+		n += 1 + sovPps(uint64(m.MinDatumBytes))
+	}
+	if m.MaxDatumBytes != 0 {
+		n += 1 + sovPps(uint64(m.MaxDatumBytes))
+	}
+	if len(m.SizeHistogram) > 0 {
+		for _, e := range m.SizeHistogram {
+			l = e.Size()
+			n += 1 + l + sovPps(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CreatePipelineRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Pipeline != nil {
+		l = m.Pipeline.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.Transform != nil {
+		l = m.Transform.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.Update {
+		n += 2
+	}
+	if m.ParallelismSpec != nil {
+		l = m.ParallelismSpec.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.Egress != nil {
+		l = m.Egress.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	l = len(m.OutputBranch)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.ScaleDownThreshold != nil {
+		l = m.ScaleDownThreshold.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.ResourceSpec != nil {
+		l = m.ResourceSpec.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.Input != nil {
+		l = m.Input.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.Incremental {
+		n += 2
+	}
+	l = len(m.CacheSize)
+	if l > 0 {
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if m.EnableStats {
+		n += 3
+	}
+	if m.Reprocess {
+		n += 3
+	}
+	if m.Batch {
+		n += 3
+	}
+	if m.MaxQueueSize != 0 {
+		n += 2 + sovPps(uint64(m.MaxQueueSize))
+	}
+	if m.Service != nil {
+		l = m.Service.Size()
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if m.DatumTries != 0 {
+		n += 2 + sovPps(uint64(m.DatumTries))
+	}
+	if m.DatumRetryBackoff != nil {
+		l = m.DatumRetryBackoff.Size()
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if m.DatumTimeout != nil {
+		l = m.DatumTimeout.Size()
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if m.JobTimeout != nil {
+		l = m.JobTimeout.Size()
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if m.SkipFailedDatums {
+		n += 3
+	}
+	if m.ResourceLimits != nil {
+		l = m.ResourceLimits.Size()
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if m.SchedulingSpec != nil {
+		l = m.SchedulingSpec.Size()
+		n += 2 + l + sovPps(uint64(l))
+	}
+	l = len(m.PodPatch)
+	if l > 0 {
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if m.AutoscalingSpec != nil {
+		l = m.AutoscalingSpec.Size()
+		n += 2 + l + sovPps(uint64(l))
+	}
+	l = len(m.PriorityClass)
+	if l > 0 {
+		n += 2 + l + sovPps(uint64(l))
+	}
+	l = len(m.Team)
+	if l > 0 {
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if m.Spout != nil {
+		l = m.Spout.Size()
+		n += 2 + l + sovPps(uint64(l))
+	}
+	return n
+}
+
+func (m *InspectPipelineRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Pipeline != nil {
+		l = m.Pipeline.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.Version != 0 {
+		n += 1 + sovPps(uint64(m.Version))
+	}
+	if m.WorkerSpec {
+		n += 2
+	}
+	return n
+}
+
+func (m *ListPipelineVersionsRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Pipeline != nil {
+		l = m.Pipeline.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	return n
+}
+
+func (m *RollbackPipelineRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Pipeline != nil {
+		l = m.Pipeline.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.Version != 0 {
+		n += 1 + sovPps(uint64(m.Version))
+	}
+	return n
+}
+
+func (m *ListPipelineRequest) Size() (n int) {
+	var l int
+	_ = l
+	return n
+}
+
+func (m *DeletePipelineRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Pipeline != nil {
+		l = m.Pipeline.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.DeleteJobs {
+		n += 2
+	}
+	if m.DeleteRepo {
+		n += 2
+	}
+	if m.All {
+		n += 2
+	}
+	return n
+}
+
+func (m *StartPipelineRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Pipeline != nil {
+		l = m.Pipeline.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	return n
+}
+
+func (m *StopPipelineRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Pipeline != nil {
+		l = m.Pipeline.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	return n
+}
+
+func (m *RerunPipelineRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Pipeline != nil {
+		l = m.Pipeline.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if len(m.Exclude) > 0 {
+		for _, e := range m.Exclude {
+			l = e.Size()
+			n += 1 + l + sovPps(uint64(l))
+		}
+	}
+	if len(m.Include) > 0 {
+		for _, e := range m.Include {
+			l = e.Size()
+			n += 1 + l + sovPps(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ApplyPipelinesRequest) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Repos) > 0 {
+		for _, e := range m.Repos {
+			l = e.Size()
+			n += 1 + l + sovPps(uint64(l))
+		}
+	}
+	if len(m.Pipelines) > 0 {
+		for _, e := range m.Pipelines {
+			l = e.Size()
+			n += 1 + l + sovPps(uint64(l))
+		}
+	}
+	if m.Prune {
+		n += 2
+	}
+	if m.Reprocess {
+		n += 2
+	}
+	if m.DryRun {
+		n += 2
+	}
+	return n
+}
+
+func (m *ApplyAction) Size() (n int) {
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovPps(uint64(m.Type))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if len(m.ChangedFields) > 0 {
+		for _, s := range m.ChangedFields {
+			l = len(s)
+			n += 1 + l + sovPps(uint64(l))
+		}
+	}
+	if m.Reprocess {
+		n += 2
+	}
+	if len(m.Downstream) > 0 {
+		for _, s := range m.Downstream {
+			l = len(s)
+			n += 1 + l + sovPps(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ApplyPipelinesResponse) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Actions) > 0 {
+		for _, e := range m.Actions {
+			l = e.Size()
+			n += 1 + l + sovPps(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CreateWebhookRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Webhook != nil {
+		l = m.Webhook.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.Update {
+		n += 2
+	}
+	return n
+}
+
+func (m *ListWebhookRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Pipeline != nil {
+		l = m.Pipeline.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	return n
+}
+
+func (m *DeleteWebhookRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	return n
+}
+
+func (m *TraceFileRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.File != nil {
+		l = m.File.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.Depth != 0 {
+		n += 1 + sovPps(uint64(m.Depth))
+	}
+	return n
+}
+
+func (m *FileTrace) Size() (n int) {
+	var l int
+	_ = l
+	if m.File != nil {
+		l = m.File.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.Job != nil {
+		l = m.Job.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if len(m.Datums) > 0 {
+		for _, e := range m.Datums {
+			l = e.Size()
+			n += 1 + l + sovPps(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DatumTrace) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.DatumID)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if len(m.Inputs) > 0 {
+		for _, e := range m.Inputs {
+			l = e.Size()
+			n += 1 + l + sovPps(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ImpactAnalysisRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Repo != nil {
+		l = m.Repo.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	l = len(m.Branch)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if len(m.Paths) > 0 {
+		for _, s := range m.Paths {
+			l = len(s)
+			n += 1 + l + sovPps(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PipelineImpact) Size() (n int) {
+	var l int
+	_ = l
+	if m.Pipeline != nil {
+		l = m.Pipeline.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.DatumsTotal != 0 {
+		n += 1 + sovPps(uint64(m.DatumsTotal))
+	}
+	if m.DatumsInvalidated != 0 {
+		n += 1 + sovPps(uint64(m.DatumsInvalidated))
+	}
+	if m.DatumsReused != 0 {
+		n += 1 + sovPps(uint64(m.DatumsReused))
+	}
+	if m.EstimatedProcessTime != nil {
+		l = m.EstimatedProcessTime.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	return n
+}
+
+func (m *ImpactAnalysisResponse) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Pipelines) > 0 {
+		for _, e := range m.Pipelines {
+			l = e.Size()
+			n += 1 + l + sovPps(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GarbageCollectRequest) Size() (n int) {
+	var l int
+	_ = l
+	return n
+}
+
+func (m *GarbageCollectResponse) Size() (n int) {
+	var l int
+	_ = l
+	return n
+}
+
+func sovPps(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozPps(x uint64) (n int) {
+	return sovPps(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Secret) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Secret: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Secret: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MountPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MountPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnvVar", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EnvVar = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Transform) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Transform: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Transform: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Image = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cmd", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cmd = append(m.Cmd, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Env", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var keykey uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				keykey |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			var stringLenmapkey uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLenmapkey |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLenmapkey := int(stringLenmapkey)
+			if intStringLenmapkey < 0 {
+				return ErrInvalidLengthPps
+			}
+			postStringIndexmapkey := iNdEx + intStringLenmapkey
+			if postStringIndexmapkey > l {
+				return io.ErrUnexpectedEOF
+			}
+			mapkey := string(dAtA[iNdEx:postStringIndexmapkey])
+			iNdEx = postStringIndexmapkey
+			if m.Env == nil {
+				m.Env = make(map[string]string)
+			}
+			if iNdEx < postIndex {
+				var valuekey uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPps
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					valuekey |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				var stringLenmapvalue uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPps
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLenmapvalue |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLenmapvalue := int(stringLenmapvalue)
+				if intStringLenmapvalue < 0 {
+					return ErrInvalidLengthPps
+				}
+				postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+				if postStringIndexmapvalue > l {
+					return io.ErrUnexpectedEOF
+				}
+				mapvalue := string(dAtA[iNdEx:postStringIndexmapvalue])
+				iNdEx = postStringIndexmapvalue
+				m.Env[mapkey] = mapvalue
+			} else {
+				var mapvalue string
+				m.Env[mapkey] = mapvalue
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Secrets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Secrets = append(m.Secrets, &Secret{})
+			if err := m.Secrets[len(m.Secrets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stdin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stdin = append(m.Stdin, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPps
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= (int64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.AcceptReturnCode = append(m.AcceptReturnCode, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPps
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthPps
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPps
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= (int64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.AcceptReturnCode = append(m.AcceptReturnCode, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field AcceptReturnCode", wireType)
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Debug", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Debug = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ImagePullSecrets", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ImagePullSecrets = append(m.ImagePullSecrets, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Egress) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Egress: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Egress: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.URL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Retries", wireType)
+			}
+			m.Retries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Retries |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EgressInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EgressInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EgressInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= (EgressState(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.URL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseCommit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BaseCommit == nil {
+				m.BaseCommit = &pfs.Commit{}
+			}
+			if err := m.BaseCommit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FilesPushed", wireType)
+			}
+			m.FilesPushed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FilesPushed |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FilesDeleted", wireType)
+			}
+			m.FilesDeleted = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FilesDeleted |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BytesPushed", wireType)
+			}
+			m.BytesPushed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BytesPushed |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Started", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Started == nil {
+				m.Started = &google_protobuf1.Timestamp{}
+			}
+			if err := m.Started.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Finished", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Finished == nil {
+				m.Finished = &google_protobuf1.Timestamp{}
+			}
+			if err := m.Finished.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Job) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Job: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Job: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Spout) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Spout: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Spout: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Overwrite", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Overwrite = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitBytes", wireType)
+			}
+			m.CommitBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CommitBytes |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitInterval", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CommitInterval == nil {
+				m.CommitInterval = &google_protobuf2.Duration{}
+			}
+			if err := m.CommitInterval.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Marker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Marker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Service) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Service: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Service: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InternalPort", wireType)
+			}
+			m.InternalPort = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InternalPort |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalPort", wireType)
+			}
+			m.ExternalPort = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExternalPort |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ports", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ports = append(m.Ports, &ServicePort{})
+			if err := m.Ports[len(m.Ports)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadinessProbe", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ReadinessProbe == nil {
+				m.ReadinessProbe = &ReadinessProbe{}
+			}
+			if err := m.ReadinessProbe.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ServicePort) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ServicePort: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ServicePort: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InternalPort", wireType)
+			}
+			m.InternalPort = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InternalPort |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalPort", wireType)
+			}
+			m.ExternalPort = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExternalPort |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReadinessProbe) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReadinessProbe: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReadinessProbe: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HttpPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HttpPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Port", wireType)
+			}
+			m.Port = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Port |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Exec", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Exec = append(m.Exec, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialDelay", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.InitialDelay == nil {
+				m.InitialDelay = &google_protobuf2.Duration{}
+			}
+			if err := m.InitialDelay.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Period == nil {
+				m.Period = &google_protobuf2.Duration{}
+			}
+			if err := m.Period.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Timeout == nil {
+				m.Timeout = &google_protobuf2.Duration{}
+			}
+			if err := m.Timeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailureThreshold", wireType)
+			}
+			m.FailureThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FailureThreshold |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ServiceStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ServiceStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ServiceStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Job = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Commits = append(m.Commits, &pfs.Commit{})
+			if err := m.Commits[len(m.Commits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingJob", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingJob = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingCommits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingCommits = append(m.PendingCommits, &pfs.Commit{})
+			if err := m.PendingCommits[len(m.PendingCommits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Updated", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Updated == nil {
+				m.Updated = &google_protobuf1.Timestamp{}
+			}
+			if err := m.Updated.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AtomInput) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AtomInput: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AtomInput: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Repo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Branch = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Commit = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Glob", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Glob = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lazy", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Lazy = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromCommit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromCommit = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CronInput) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CronInput: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CronInput: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Repo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Commit = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Spec = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Start == nil {
+				m.Start = &google_protobuf1.Timestamp{}
+			}
+			if err := m.Start.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Input) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Input: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Input: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Atom", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Atom == nil {
+				m.Atom = &AtomInput{}
+			}
+			if err := m.Atom.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cross", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cross = append(m.Cross, &Input{})
+			if err := m.Cross[len(m.Cross)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Union", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Union = append(m.Union, &Input{})
+			if err := m.Union[len(m.Union)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cron", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Cron == nil {
+				m.Cron = &CronInput{}
+			}
+			if err := m.Cron.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobInput) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobInput: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobInput: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Commit == nil {
+				m.Commit = &pfs.Commit{}
+			}
+			if err := m.Commit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Glob", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Glob = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lazy", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Lazy = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ParallelismSpec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ParallelismSpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ParallelismSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constant", wireType)
+			}
+			m.Constant = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constant |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coefficient", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 8
+			v = uint64(dAtA[iNdEx-8])
+			v |= uint64(dAtA[iNdEx-7]) << 8
+			v |= uint64(dAtA[iNdEx-6]) << 16
+			v |= uint64(dAtA[iNdEx-5]) << 24
+			v |= uint64(dAtA[iNdEx-4]) << 32
+			v |= uint64(dAtA[iNdEx-3]) << 40
+			v |= uint64(dAtA[iNdEx-2]) << 48
+			v |= uint64(dAtA[iNdEx-1]) << 56
+			m.Coefficient = float64(math.Float64frombits(v))
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InputFile) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InputFile: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InputFile: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
+			if m.Hash == nil {
+				m.Hash = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Datum) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Datum: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Datum: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Job == nil {
+				m.Job = &Job{}
+			}
+			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DatumInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DatumInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DatumInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Datum", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Datum == nil {
+				m.Datum = &Datum{}
+			}
+			if err := m.Datum.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= (DatumState(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Stats == nil {
+				m.Stats = &ProcessStats{}
+			}
+			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PfsState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PfsState == nil {
+				m.PfsState = &pfs.File{}
+			}
+			if err := m.PfsState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data, &pfs.FileInfo{})
+			if err := m.Data[len(m.Data)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DatumInfos) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DatumInfos: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DatumInfos: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DatumInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DatumInfo = append(m.DatumInfo, &DatumInfo{})
+			if err := m.DatumInfo[len(m.DatumInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Aggregate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Aggregate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Aggregate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mean", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 8
+			v = uint64(dAtA[iNdEx-8])
+			v |= uint64(dAtA[iNdEx-7]) << 8
+			v |= uint64(dAtA[iNdEx-6]) << 16
+			v |= uint64(dAtA[iNdEx-5]) << 24
+			v |= uint64(dAtA[iNdEx-4]) << 32
+			v |= uint64(dAtA[iNdEx-3]) << 40
+			v |= uint64(dAtA[iNdEx-2]) << 48
+			v |= uint64(dAtA[iNdEx-1]) << 56
+			m.Mean = float64(math.Float64frombits(v))
+		case 3:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stddev", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 8
+			v = uint64(dAtA[iNdEx-8])
+			v |= uint64(dAtA[iNdEx-7]) << 8
+			v |= uint64(dAtA[iNdEx-6]) << 16
+			v |= uint64(dAtA[iNdEx-5]) << 24
+			v |= uint64(dAtA[iNdEx-4]) << 32
+			v |= uint64(dAtA[iNdEx-3]) << 40
+			v |= uint64(dAtA[iNdEx-2]) << 48
+			v |= uint64(dAtA[iNdEx-1]) << 56
+			m.Stddev = float64(math.Float64frombits(v))
+		case 4:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FifthPercentile", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 8
+			v = uint64(dAtA[iNdEx-8])
+			v |= uint64(dAtA[iNdEx-7]) << 8
+			v |= uint64(dAtA[iNdEx-6]) << 16
+			v |= uint64(dAtA[iNdEx-5]) << 24
+			v |= uint64(dAtA[iNdEx-4]) << 32
+			v |= uint64(dAtA[iNdEx-3]) << 40
+			v |= uint64(dAtA[iNdEx-2]) << 48
+			v |= uint64(dAtA[iNdEx-1]) << 56
+			m.FifthPercentile = float64(math.Float64frombits(v))
+		case 5:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NinetyFifthPercentile", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 8
+			v = uint64(dAtA[iNdEx-8])
+			v |= uint64(dAtA[iNdEx-7]) << 8
+			v |= uint64(dAtA[iNdEx-6]) << 16
+			v |= uint64(dAtA[iNdEx-5]) << 24
+			v |= uint64(dAtA[iNdEx-4]) << 32
+			v |= uint64(dAtA[iNdEx-3]) << 40
+			v |= uint64(dAtA[iNdEx-2]) << 48
+			v |= uint64(dAtA[iNdEx-1]) << 56
+			m.NinetyFifthPercentile = float64(math.Float64frombits(v))
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProcessStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProcessStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProcessStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DownloadTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DownloadTime == nil {
+				m.DownloadTime = &google_protobuf2.Duration{}
+			}
+			if err := m.DownloadTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProcessTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ProcessTime == nil {
+				m.ProcessTime = &google_protobuf2.Duration{}
+			}
+			if err := m.ProcessTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UploadTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.UploadTime == nil {
+				m.UploadTime = &google_protobuf2.Duration{}
+			}
+			if err := m.UploadTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DownloadBytes", wireType)
+			}
+			m.DownloadBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DownloadBytes |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UploadBytes", wireType)
+			}
+			m.UploadBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UploadBytes |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AggregateProcessStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AggregateProcessStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AggregateProcessStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DownloadTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DownloadTime == nil {
+				m.DownloadTime = &Aggregate{}
+			}
+			if err := m.DownloadTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProcessTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ProcessTime == nil {
+				m.ProcessTime = &Aggregate{}
+			}
+			if err := m.ProcessTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UploadTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.UploadTime == nil {
+				m.UploadTime = &Aggregate{}
+			}
+			if err := m.UploadTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DownloadBytes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DownloadBytes == nil {
+				m.DownloadBytes = &Aggregate{}
+			}
+			if err := m.DownloadBytes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UploadBytes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.UploadBytes == nil {
+				m.UploadBytes = &Aggregate{}
+			}
+			if err := m.UploadBytes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WorkerStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WorkerStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WorkerStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkerID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WorkerID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data, &InputFile{})
+			if err := m.Data[len(m.Data)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Started", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Started == nil {
+				m.Started = &google_protobuf1.Timestamp{}
+			}
+			if err := m.Started.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Stats == nil {
+				m.Stats = &ProcessStats{}
+			}
+			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueueSize", wireType)
+			}
+			m.QueueSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QueueSize |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResourceSpec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResourceSpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResourceSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cpu", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 4
+			v = uint32(dAtA[iNdEx-4])
+			v |= uint32(dAtA[iNdEx-3]) << 8
+			v |= uint32(dAtA[iNdEx-2]) << 16
+			v |= uint32(dAtA[iNdEx-1]) << 24
+			m.Cpu = float32(math.Float32frombits(v))
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Memory", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Memory = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gpu", wireType)
+			}
+			m.Gpu = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Gpu |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Toleration) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Toleration: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Toleration: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Effect", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Effect = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AutoscalingSpec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AutoscalingSpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AutoscalingSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinWorkers", wireType)
+			}
+			m.MinWorkers = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinWorkers |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxWorkers", wireType)
+			}
+			m.MaxWorkers = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxWorkers |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetQueueSize", wireType)
+			}
+			m.TargetQueueSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetQueueSize |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetDrainTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TargetDrainTime == nil {
+				m.TargetDrainTime = &google_protobuf2.Duration{}
+			}
+			if err := m.TargetDrainTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScaleUpCooldown", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ScaleUpCooldown == nil {
+				m.ScaleUpCooldown = &google_protobuf2.Duration{}
+			}
+			if err := m.ScaleUpCooldown.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScaleDownCooldown", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ScaleDownCooldown == nil {
+				m.ScaleDownCooldown = &google_protobuf2.Duration{}
+			}
+			if err := m.ScaleDownCooldown.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AutoscalingStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AutoscalingStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AutoscalingStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentWorkers", wireType)
+			}
+			m.CurrentWorkers = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentWorkers |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DesiredWorkers", wireType)
+			}
+			m.DesiredWorkers = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DesiredWorkers |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingDatums", wireType)
+			}
+			m.PendingDatums = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PendingDatums |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueuedDatums", wireType)
+			}
+			m.QueuedDatums = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QueuedDatums |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DatumLatency", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DatumLatency == nil {
+				m.DatumLatency = &google_protobuf2.Duration{}
+			}
+			if err := m.DatumLatency.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastScaled", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastScaled == nil {
+				m.LastScaled = &google_protobuf1.Timestamp{}
+			}
+			if err := m.LastScaled.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Updated", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Updated == nil {
+				m.Updated = &google_protobuf1.Timestamp{}
+			}
+			if err := m.Updated.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PriorityClass) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PriorityClass: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PriorityClass: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+			}
+			m.Priority = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Priority |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRunningJobs", wireType)
+			}
+			m.MaxRunningJobs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRunningJobs |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxWorkers", wireType)
+			}
+			m.MaxWorkers = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxWorkers |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TeamQuota) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TeamQuota: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TeamQuota: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Team", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Team = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRunningJobs", wireType)
+			}
+			m.MaxRunningJobs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRunningJobs |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxWorkers", wireType)
+			}
+			m.MaxWorkers = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxWorkers |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AdmissionPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AdmissionPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AdmissionPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriorityClasses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriorityClasses = append(m.PriorityClasses, &PriorityClass{})
+			if err := m.PriorityClasses[len(m.PriorityClasses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Teams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Teams = append(m.Teams, &TeamQuota{})
+			if err := m.Teams[len(m.Teams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultPriorityClass", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DefaultPriorityClass = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRunningJobs", wireType)
+			}
+			m.MaxRunningJobs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRunningJobs |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SchedulingSpec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SchedulingSpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SchedulingSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeSelector", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var keykey uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				keykey |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			var stringLenmapkey uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLenmapkey |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLenmapkey := int(stringLenmapkey)
+			if intStringLenmapkey < 0 {
+				return ErrInvalidLengthPps
+			}
+			postStringIndexmapkey := iNdEx + intStringLenmapkey
+			if postStringIndexmapkey > l {
+				return io.ErrUnexpectedEOF
+			}
+			mapkey := string(dAtA[iNdEx:postStringIndexmapkey])
+			iNdEx = postStringIndexmapkey
+			if m.NodeSelector == nil {
+				m.NodeSelector = make(map[string]string)
+			}
+			if iNdEx < postIndex {
+				var valuekey uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPps
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					valuekey |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				var stringLenmapvalue uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPps
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLenmapvalue |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLenmapvalue := int(stringLenmapvalue)
+				if intStringLenmapvalue < 0 {
+					return ErrInvalidLengthPps
+				}
+				postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+				if postStringIndexmapvalue > l {
+					return io.ErrUnexpectedEOF
+				}
+				mapvalue := string(dAtA[iNdEx:postStringIndexmapvalue])
+				iNdEx = postStringIndexmapvalue
+				m.NodeSelector[mapkey] = mapvalue
+			} else {
+				var mapvalue string
+				m.NodeSelector[mapkey] = mapvalue
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tolerations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tolerations = append(m.Tolerations, &Toleration{})
+			if err := m.Tolerations[len(m.Tolerations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Affinity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Affinity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Job == nil {
+				m.Job = &Job{}
+			}
+			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transform", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Transform == nil {
+				m.Transform = &Transform{}
+			}
+			if err := m.Transform.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pipeline == nil {
+				m.Pipeline = &Pipeline{}
+			}
+			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentJob", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ParentJob == nil {
+				m.ParentJob = &Job{}
+			}
+			if err := m.ParentJob.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Started", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Started == nil {
+				m.Started = &google_protobuf1.Timestamp{}
+			}
+			if err := m.Started.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Finished", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Finished == nil {
+				m.Finished = &google_protobuf1.Timestamp{}
+			}
+			if err := m.Finished.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutputCommit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OutputCommit == nil {
+				m.OutputCommit = &pfs.Commit{}
+			}
+			if err := m.OutputCommit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= (JobState(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParallelismSpec", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ParallelismSpec == nil {
+				m.ParallelismSpec = &ParallelismSpec{}
+			}
+			if err := m.ParallelismSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PipelineVersion", wireType)
+			}
+			m.PipelineVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PipelineVersion |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Service == nil {
+				m.Service = &Service{}
+			}
+			if err := m.Service.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Egress", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Egress == nil {
+				m.Egress = &Egress{}
+			}
+			if err := m.Egress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutputBranch", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OutputBranch = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutputRepo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OutputRepo == nil {
+				m.OutputRepo = &pfs.Repo{}
+			}
+			if err := m.OutputRepo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Restart", wireType)
+			}
+			m.Restart = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Restart |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataProcessed", wireType)
+			}
+			m.DataProcessed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DataProcessed |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 23:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataTotal", wireType)
+			}
+			m.DataTotal = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DataTotal |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkerStatus", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WorkerStatus = append(m.WorkerStatus, &WorkerStatus{})
+			if err := m.WorkerStatus[len(m.WorkerStatus)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceSpec", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ResourceSpec == nil {
+				m.ResourceSpec = &ResourceSpec{}
+			}
+			if err := m.ResourceSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Input == nil {
+				m.Input = &Input{}
+			}
+			if err := m.Input.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 27:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewBranch", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NewBranch == nil {
+				m.NewBranch = &pfs.BranchInfo{}
+			}
+			if err := m.NewBranch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 28:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Incremental", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Incremental = bool(v != 0)
+		case 29:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StatsCommit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StatsCommit == nil {
+				m.StatsCommit = &pfs.Commit{}
+			}
+			if err := m.StatsCommit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 30:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataSkipped", wireType)
+			}
+			m.DataSkipped = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DataSkipped |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 31:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Stats == nil {
+				m.Stats = &ProcessStats{}
+			}
+			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 32:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableStats", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EnableStats = bool(v != 0)
+		case 33:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Salt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Salt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 34:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Batch", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Batch = bool(v != 0)
+		case 35:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 36:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rerun", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Rerun = bool(v != 0)
+		case 37:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedDatums", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FailedDatums = append(m.FailedDatums, &FailedDatum{})
+			if err := m.FailedDatums[len(m.FailedDatums)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 38:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataFailed", wireType)
+			}
+			m.DataFailed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DataFailed |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 39:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lineage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Lineage == nil {
+				m.Lineage = &pfs.Object{}
+			}
+			if err := m.Lineage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 40:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EgressInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EgressInfo == nil {
+				m.EgressInfo = &EgressInfo{}
+			}
+			if err := m.EgressInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 41:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriorityClass", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriorityClass = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 42:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Team", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Team = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FailedDatum) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FailedDatum: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FailedDatum: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DatumID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DatumID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExitCode", wireType)
+			}
+			m.ExitCode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExitCode |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Logs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Logs == nil {
+				m.Logs = &pfs.File{}
+			}
+			if err := m.Logs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DatumLineage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DatumLineage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DatumLineage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DatumID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DatumID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inputs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Inputs = append(m.Inputs, &pfs.File{})
+			if err := m.Inputs[len(m.Inputs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Outputs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Outputs = append(m.Outputs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *RerunPipelineRequest) Size() (n int) {
-	var l int
-	_ = l
-	if m.Pipeline != nil {
-		l = m.Pipeline.Size()
-		n += 1 + l + sovPps(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	if len(m.Exclude) > 0 {
-		for _, e := range m.Exclude {
-			l = e.Size()
-			n += 1 + l + sovPps(uint64(l))
+	return nil
+}
+func (m *JobLineage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
 		}
-	}
-	if len(m.Include) > 0 {
-		for _, e := range m.Include {
-			l = e.Size()
-			n += 1 + l + sovPps(uint64(l))
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobLineage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobLineage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Datums", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Datums = append(m.Datums, &DatumLineage{})
+			if err := m.Datums[len(m.Datums)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
-
-func (m *GarbageCollectRequest) Size() (n int) {
-	var l int
-	_ = l
-	return n
-}
-
-func (m *GarbageCollectResponse) Size() (n int) {
-	var l int
-	_ = l
-	return n
-}
 
-func sovPps(x uint64) (n int) {
-	for {
-		n++
-		x >>= 7
-		if x == 0 {
-			break
-		}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-func sozPps(x uint64) (n int) {
-	return sovPps(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Secret) Unmarshal(dAtA []byte) error {
+func (m *Worker) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6895,10 +19412,10 @@ func (m *Secret) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Secret: wiretype end group for non-group")
+			return fmt.Errorf("proto: Worker: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Secret: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Worker: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6931,10 +19448,10 @@ func (m *Secret) Unmarshal(dAtA []byte) error {
 			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MountPath", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
 			}
-			var stringLen uint64
+			m.State = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -6944,26 +19461,66 @@ func (m *Secret) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.State |= (WorkerState(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MountPath = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobInfos) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobInfos: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobInfos: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EnvVar", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JobInfo", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -6973,24 +19530,76 @@ func (m *Secret) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EnvVar = string(dAtA[iNdEx:postIndex])
+			m.JobInfo = append(m.JobInfo, &JobInfo{})
+			if err := m.JobInfo[len(m.JobInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Pipeline) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Pipeline: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Pipeline: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7015,7 +19624,7 @@ func (m *Secret) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7038,7 +19647,7 @@ func (m *Secret) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Transform) Unmarshal(dAtA []byte) error {
+func (m *PipelineInput) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7061,17 +19670,17 @@ func (m *Transform) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Transform: wiretype end group for non-group")
+			return fmt.Errorf("proto: PipelineInput: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Transform: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PipelineInput: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -7081,24 +19690,28 @@ func (m *Transform) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Image = string(dAtA[iNdEx:postIndex])
+			if m.Repo == nil {
+				m.Repo = &pfs.Repo{}
+			}
+			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cmd", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7123,13 +19736,13 @@ func (m *Transform) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Cmd = append(m.Cmd, string(dAtA[iNdEx:postIndex]))
+			m.Branch = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Env", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Glob", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -7139,113 +19752,26 @@ func (m *Transform) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var keykey uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				keykey |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			var stringLenmapkey uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLenmapkey |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLenmapkey := int(stringLenmapkey)
-			if intStringLenmapkey < 0 {
-				return ErrInvalidLengthPps
-			}
-			postStringIndexmapkey := iNdEx + intStringLenmapkey
-			if postStringIndexmapkey > l {
-				return io.ErrUnexpectedEOF
-			}
-			mapkey := string(dAtA[iNdEx:postStringIndexmapkey])
-			iNdEx = postStringIndexmapkey
-			if m.Env == nil {
-				m.Env = make(map[string]string)
-			}
-			if iNdEx < postIndex {
-				var valuekey uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPps
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					valuekey |= (uint64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				var stringLenmapvalue uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPps
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLenmapvalue |= (uint64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLenmapvalue := int(stringLenmapvalue)
-				if intStringLenmapvalue < 0 {
-					return ErrInvalidLengthPps
-				}
-				postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-				if postStringIndexmapvalue > l {
-					return io.ErrUnexpectedEOF
-				}
-				mapvalue := string(dAtA[iNdEx:postStringIndexmapvalue])
-				iNdEx = postStringIndexmapvalue
-				m.Env[mapkey] = mapvalue
-			} else {
-				var mapvalue string
-				m.Env[mapkey] = mapvalue
-			}
+			m.Glob = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Secrets", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lazy", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -7255,26 +19781,15 @@ func (m *Transform) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Secrets = append(m.Secrets, &Secret{})
-			if err := m.Secrets[len(m.Secrets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.Lazy = bool(v != 0)
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stdin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7299,95 +19814,13 @@ func (m *Transform) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Stdin = append(m.Stdin, string(dAtA[iNdEx:postIndex]))
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 6:
-			if wireType == 0 {
-				var v int64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPps
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= (int64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.AcceptReturnCode = append(m.AcceptReturnCode, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPps
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= (int(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthPps
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				for iNdEx < postIndex {
-					var v int64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowPps
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= (int64(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.AcceptReturnCode = append(m.AcceptReturnCode, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field AcceptReturnCode", wireType)
-			}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Debug", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Debug = bool(v != 0)
-		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ImagePullSecrets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -7397,20 +19830,24 @@ func (m *Transform) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ImagePullSecrets = append(m.ImagePullSecrets, string(dAtA[iNdEx:postIndex]))
+			if m.From == nil {
+				m.From = &pfs.Commit{}
+			}
+			if err := m.From.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7433,7 +19870,7 @@ func (m *Transform) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Egress) Unmarshal(dAtA []byte) error {
+func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7456,17 +19893,17 @@ func (m *Egress) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Egress: wiretype end group for non-group")
+			return fmt.Errorf("proto: PipelineInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Egress: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PipelineInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -7476,74 +19913,113 @@ func (m *Egress) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.URL = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
+			if m.Pipeline == nil {
+				m.Pipeline = &Pipeline{}
+			}
+			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if skippy < 0 {
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transform", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Job) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPps
+			if m.Transform == nil {
+				m.Transform = &Transform{}
 			}
-			if iNdEx >= l {
+			if err := m.Transform.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.CreatedAt == nil {
+				m.CreatedAt = &google_protobuf1.Timestamp{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Job: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Job: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if err := m.CreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= (PipelineState(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RecentError", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7568,63 +20044,109 @@ func (m *Job) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ID = string(dAtA[iNdEx:postIndex])
+			m.RecentError = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobCounts", wireType)
 			}
-			if skippy < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Service) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPps
+			var keykey uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				keykey |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			var mapkey int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				mapkey |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.JobCounts == nil {
+				m.JobCounts = make(map[int32]int32)
+			}
+			if iNdEx < postIndex {
+				var valuekey uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPps
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					valuekey |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				var mapvalue int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPps
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					mapvalue |= (int32(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.JobCounts[mapkey] = mapvalue
+			} else {
+				var mapvalue int32
+				m.JobCounts[mapkey] = mapvalue
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Service: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Service: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InternalPort", wireType)
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParallelismSpec", wireType)
 			}
-			m.InternalPort = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -7634,16 +20156,30 @@ func (m *Service) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.InternalPort |= (int32(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ParallelismSpec == nil {
+				m.ParallelismSpec = &ParallelismSpec{}
+			}
+			if err := m.ParallelismSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExternalPort", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
-			m.ExternalPort = 0
+			m.Version = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -7653,66 +20189,16 @@ func (m *Service) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ExternalPort |= (int32(b) & 0x7F) << shift
+				m.Version |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthPps
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *AtomInput) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPps
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: AtomInput: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AtomInput: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Egress", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -7722,24 +20208,28 @@ func (m *AtomInput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if m.Egress == nil {
+				m.Egress = &Egress{}
+			}
+			if err := m.Egress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 16:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OutputBranch", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7764,11 +20254,11 @@ func (m *AtomInput) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Repo = string(dAtA[iNdEx:postIndex])
+			m.OutputBranch = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 17:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7793,13 +20283,13 @@ func (m *AtomInput) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Branch = string(dAtA[iNdEx:postIndex])
+			m.ID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 18:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScaleDownThreshold", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -7809,26 +20299,30 @@ func (m *AtomInput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Commit = string(dAtA[iNdEx:postIndex])
+			if m.ScaleDownThreshold == nil {
+				m.ScaleDownThreshold = &google_protobuf2.Duration{}
+			}
+			if err := m.ScaleDownThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		case 19:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Glob", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceSpec", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -7838,26 +20332,30 @@ func (m *AtomInput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Glob = string(dAtA[iNdEx:postIndex])
+			if m.ResourceSpec == nil {
+				m.ResourceSpec = &ResourceSpec{}
+			}
+			if err := m.ResourceSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Lazy", wireType)
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -7867,15 +20365,28 @@ func (m *AtomInput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Lazy = bool(v != 0)
-		case 7:
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Input == nil {
+				m.Input = &Input{}
+			}
+			if err := m.Input.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FromCommit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7900,61 +20411,31 @@ func (m *AtomInput) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FromCommit = string(dAtA[iNdEx:postIndex])
+			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthPps
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *CronInput) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPps
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Incremental", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: CronInput: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CronInput: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Incremental = bool(v != 0)
+		case 23:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CacheSize", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7979,13 +20460,13 @@ func (m *CronInput) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.CacheSize = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+		case 24:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableStats", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -7995,24 +20476,15 @@ func (m *CronInput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Repo = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+			m.EnableStats = bool(v != 0)
+		case 25:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Salt", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8037,11 +20509,11 @@ func (m *CronInput) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Commit = string(dAtA[iNdEx:postIndex])
+			m.Salt = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 26:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Capability", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8066,13 +20538,13 @@ func (m *CronInput) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Spec = string(dAtA[iNdEx:postIndex])
+			m.Capability = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+		case 27:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Batch", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -8082,80 +20554,17 @@ func (m *CronInput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Start == nil {
-				m.Start = &google_protobuf1.Timestamp{}
-			}
-			if err := m.Start.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthPps
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Input) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPps
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Input: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Input: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Batch = bool(v != 0)
+		case 28:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Atom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -8165,30 +20574,26 @@ func (m *Input) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Atom == nil {
-				m.Atom = &AtomInput{}
-			}
-			if err := m.Atom.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cross", wireType)
+		case 29:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxQueueSize", wireType)
 			}
-			var msglen int
+			m.MaxQueueSize = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -8198,26 +20603,14 @@ func (m *Input) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.MaxQueueSize |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Cross = append(m.Cross, &Input{})
-			if err := m.Cross[len(m.Cross)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
+		case 30:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Union", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8241,16 +20634,18 @@ func (m *Input) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Union = append(m.Union, &Input{})
-			if err := m.Union[len(m.Union)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Service == nil {
+				m.Service = &Service{}
+			}
+			if err := m.Service.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cron", wireType)
+		case 31:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DatumTries", wireType)
 			}
-			var msglen int
+			m.DatumTries = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -8260,78 +20655,14 @@ func (m *Input) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.DatumTries |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Cron == nil {
-				m.Cron = &CronInput{}
-			}
-			if err := m.Cron.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthPps
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *JobInput) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPps
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: JobInput: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JobInput: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 32:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DatumRetryBackoff", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8355,18 +20686,18 @@ func (m *JobInput) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Commit == nil {
-				m.Commit = &pfs.Commit{}
+			if m.DatumRetryBackoff == nil {
+				m.DatumRetryBackoff = &google_protobuf2.Duration{}
 			}
-			if err := m.Commit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DatumRetryBackoff.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 33:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Glob", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DatumTimeout", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -8376,46 +20707,30 @@ func (m *JobInput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Glob = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Lazy", wireType)
+			if m.DatumTimeout == nil {
+				m.DatumTimeout = &google_protobuf2.Duration{}
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.DatumTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Lazy = bool(v != 0)
-		case 4:
+			iNdEx = postIndex
+		case 34:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JobTimeout", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -8425,76 +20740,30 @@ func (m *JobInput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthPps
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ParallelismSpec) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPps
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.JobTimeout == nil {
+				m.JobTimeout = &google_protobuf2.Duration{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.JobTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ParallelismSpec: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ParallelismSpec: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 2:
+			iNdEx = postIndex
+		case 35:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Constant", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SkipFailedDatums", wireType)
 			}
-			m.Constant = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -8504,84 +20773,17 @@ func (m *ParallelismSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Constant |= (uint64(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Coefficient", wireType)
-			}
-			var v uint64
-			if (iNdEx + 8) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += 8
-			v = uint64(dAtA[iNdEx-8])
-			v |= uint64(dAtA[iNdEx-7]) << 8
-			v |= uint64(dAtA[iNdEx-6]) << 16
-			v |= uint64(dAtA[iNdEx-5]) << 24
-			v |= uint64(dAtA[iNdEx-4]) << 32
-			v |= uint64(dAtA[iNdEx-3]) << 40
-			v |= uint64(dAtA[iNdEx-2]) << 48
-			v |= uint64(dAtA[iNdEx-1]) << 56
-			m.Coefficient = float64(math.Float64frombits(v))
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthPps
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *InputFile) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPps
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: InputFile: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InputFile: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 4:
+			m.SkipFailedDatums = bool(v != 0)
+		case 36:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceLimits", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -8591,26 +20793,30 @@ func (m *InputFile) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Path = string(dAtA[iNdEx:postIndex])
+			if m.ResourceLimits == nil {
+				m.ResourceLimits = &ResourceSpec{}
+			}
+			if err := m.ResourceLimits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		case 37:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SchedulingSpec", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -8620,76 +20826,28 @@ func (m *InputFile) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
-			if m.Hash == nil {
-				m.Hash = []byte{}
+			if m.SchedulingSpec == nil {
+				m.SchedulingSpec = &SchedulingSpec{}
 			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.SchedulingSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthPps
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Datum) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPps
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Datum: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Datum: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			}
+			iNdEx = postIndex
+		case 38:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PodPatch", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8714,13 +20872,13 @@ func (m *Datum) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ID = string(dAtA[iNdEx:postIndex])
+			m.PodPatch = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 39:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkerSpec", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -8730,78 +20888,24 @@ func (m *Datum) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Job == nil {
-				m.Job = &Job{}
-			}
-			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.WorkerSpec = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthPps
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DatumInfo) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPps
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DatumInfo: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DatumInfo: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 40:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Datum", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoscalingSpec", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8825,18 +20929,18 @@ func (m *DatumInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Datum == nil {
-				m.Datum = &Datum{}
+			if m.AutoscalingSpec == nil {
+				m.AutoscalingSpec = &AutoscalingSpec{}
 			}
-			if err := m.Datum.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AutoscalingSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+		case 41:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoscalingStatus", wireType)
 			}
-			m.State = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -8846,16 +20950,30 @@ func (m *DatumInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.State |= (DatumState(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AutoscalingStatus == nil {
+				m.AutoscalingStatus = &AutoscalingStatus{}
+			}
+			if err := m.AutoscalingStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 42:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PriorityClass", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -8865,30 +20983,26 @@ func (m *DatumInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Stats == nil {
-				m.Stats = &ProcessStats{}
-			}
-			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.PriorityClass = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 43:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PfsState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Team", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -8898,28 +21012,24 @@ func (m *DatumInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PfsState == nil {
-				m.PfsState = &pfs.File{}
-			}
-			if err := m.PfsState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Team = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 44:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spout", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8943,64 +21053,16 @@ func (m *DatumInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = append(m.Data, &pfs.FileInfo{})
-			if err := m.Data[len(m.Data)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.Spout == nil {
+				m.Spout = &Spout{}
 			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.Spout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthPps
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DatumInfos) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPps
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DatumInfos: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DatumInfos: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 45:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DatumInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceStatus", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9024,8 +21086,10 @@ func (m *DatumInfos) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DatumInfo = append(m.DatumInfo, &DatumInfo{})
-			if err := m.DatumInfo[len(m.DatumInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.ServiceStatus == nil {
+				m.ServiceStatus = &ServiceStatus{}
+			}
+			if err := m.ServiceStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9050,7 +21114,7 @@ func (m *DatumInfos) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Aggregate) Unmarshal(dAtA []byte) error {
+func (m *PipelineInfos) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9073,103 +21137,43 @@ func (m *Aggregate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Aggregate: wiretype end group for non-group")
+			return fmt.Errorf("proto: PipelineInfos: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Aggregate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PipelineInfos: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PipelineInfo", wireType)
 			}
-			m.Count = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Count |= (int64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Mean", wireType)
-			}
-			var v uint64
-			if (iNdEx + 8) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += 8
-			v = uint64(dAtA[iNdEx-8])
-			v |= uint64(dAtA[iNdEx-7]) << 8
-			v |= uint64(dAtA[iNdEx-6]) << 16
-			v |= uint64(dAtA[iNdEx-5]) << 24
-			v |= uint64(dAtA[iNdEx-4]) << 32
-			v |= uint64(dAtA[iNdEx-3]) << 40
-			v |= uint64(dAtA[iNdEx-2]) << 48
-			v |= uint64(dAtA[iNdEx-1]) << 56
-			m.Mean = float64(math.Float64frombits(v))
-		case 3:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stddev", wireType)
-			}
-			var v uint64
-			if (iNdEx + 8) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += 8
-			v = uint64(dAtA[iNdEx-8])
-			v |= uint64(dAtA[iNdEx-7]) << 8
-			v |= uint64(dAtA[iNdEx-6]) << 16
-			v |= uint64(dAtA[iNdEx-5]) << 24
-			v |= uint64(dAtA[iNdEx-4]) << 32
-			v |= uint64(dAtA[iNdEx-3]) << 40
-			v |= uint64(dAtA[iNdEx-2]) << 48
-			v |= uint64(dAtA[iNdEx-1]) << 56
-			m.Stddev = float64(math.Float64frombits(v))
-		case 4:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FifthPercentile", wireType)
-			}
-			var v uint64
-			if (iNdEx + 8) > l {
-				return io.ErrUnexpectedEOF
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx += 8
-			v = uint64(dAtA[iNdEx-8])
-			v |= uint64(dAtA[iNdEx-7]) << 8
-			v |= uint64(dAtA[iNdEx-6]) << 16
-			v |= uint64(dAtA[iNdEx-5]) << 24
-			v |= uint64(dAtA[iNdEx-4]) << 32
-			v |= uint64(dAtA[iNdEx-3]) << 40
-			v |= uint64(dAtA[iNdEx-2]) << 48
-			v |= uint64(dAtA[iNdEx-1]) << 56
-			m.FifthPercentile = float64(math.Float64frombits(v))
-		case 5:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NinetyFifthPercentile", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthPps
 			}
-			var v uint64
-			if (iNdEx + 8) > l {
+			postIndex := iNdEx + msglen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += 8
-			v = uint64(dAtA[iNdEx-8])
-			v |= uint64(dAtA[iNdEx-7]) << 8
-			v |= uint64(dAtA[iNdEx-6]) << 16
-			v |= uint64(dAtA[iNdEx-5]) << 24
-			v |= uint64(dAtA[iNdEx-4]) << 32
-			v |= uint64(dAtA[iNdEx-3]) << 40
-			v |= uint64(dAtA[iNdEx-2]) << 48
-			v |= uint64(dAtA[iNdEx-1]) << 56
-			m.NinetyFifthPercentile = float64(math.Float64frombits(v))
+			m.PipelineInfo = append(m.PipelineInfo, &PipelineInfo{})
+			if err := m.PipelineInfo[len(m.PipelineInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -9191,7 +21195,7 @@ func (m *Aggregate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ProcessStats) Unmarshal(dAtA []byte) error {
+func (m *Event) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9214,15 +21218,63 @@ func (m *ProcessStats) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ProcessStats: wiretype end group for non-group")
+			return fmt.Errorf("proto: Event: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ProcessStats: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Event: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DownloadTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= (EventType(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9246,16 +21298,16 @@ func (m *ProcessStats) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DownloadTime == nil {
-				m.DownloadTime = &google_protobuf2.Duration{}
+			if m.Time == nil {
+				m.Time = &google_protobuf1.Timestamp{}
 			}
-			if err := m.DownloadTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Time.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProcessTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9279,16 +21331,16 @@ func (m *ProcessStats) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ProcessTime == nil {
-				m.ProcessTime = &google_protobuf2.Duration{}
+			if m.Pipeline == nil {
+				m.Pipeline = &Pipeline{}
 			}
-			if err := m.ProcessTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UploadTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9312,18 +21364,18 @@ func (m *ProcessStats) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.UploadTime == nil {
-				m.UploadTime = &google_protobuf2.Duration{}
+			if m.Job == nil {
+				m.Job = &Job{}
 			}
-			if err := m.UploadTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DownloadBytes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JobState", wireType)
 			}
-			m.DownloadBytes = 0
+			m.JobState = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -9333,16 +21385,16 @@ func (m *ProcessStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DownloadBytes |= (uint64(b) & 0x7F) << shift
+				m.JobState |= (JobState(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UploadBytes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PipelineState", wireType)
 			}
-			m.UploadBytes = 0
+			m.PipelineState = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -9352,11 +21404,40 @@ func (m *ProcessStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UploadBytes |= (uint64(b) & 0x7F) << shift
+				m.PipelineState |= (PipelineState(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -9378,7 +21459,7 @@ func (m *ProcessStats) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AggregateProcessStats) Unmarshal(dAtA []byte) error {
+func (m *WebhookInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9401,17 +21482,17 @@ func (m *AggregateProcessStats) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AggregateProcessStats: wiretype end group for non-group")
+			return fmt.Errorf("proto: WebhookInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AggregateProcessStats: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WebhookInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DownloadTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -9421,30 +21502,26 @@ func (m *AggregateProcessStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DownloadTime == nil {
-				m.DownloadTime = &Aggregate{}
-			}
-			if err := m.DownloadTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProcessTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -9454,28 +21531,24 @@ func (m *AggregateProcessStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ProcessTime == nil {
-				m.ProcessTime = &Aggregate{}
-			}
-			if err := m.ProcessTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.URL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UploadTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9499,18 +21572,80 @@ func (m *AggregateProcessStats) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.UploadTime == nil {
-				m.UploadTime = &Aggregate{}
+			if m.Pipeline == nil {
+				m.Pipeline = &Pipeline{}
 			}
-			if err := m.UploadTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 4:
+			iNdEx = postIndex
+		case 4:
+			if wireType == 0 {
+				var v EventType
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPps
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= (EventType(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Events = append(m.Events, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPps
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthPps
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for iNdEx < postIndex {
+					var v EventType
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPps
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= (EventType(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Events = append(m.Events, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DownloadBytes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Secret", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -9520,30 +21655,26 @@ func (m *AggregateProcessStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DownloadBytes == nil {
-				m.DownloadBytes = &Aggregate{}
-			}
-			if err := m.DownloadBytes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Secret = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UploadBytes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RecentError", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -9553,24 +21684,20 @@ func (m *AggregateProcessStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.UploadBytes == nil {
-				m.UploadBytes = &Aggregate{}
-			}
-			if err := m.UploadBytes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.RecentError = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9593,7 +21720,7 @@ func (m *AggregateProcessStats) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *WorkerStatus) Unmarshal(dAtA []byte) error {
+func (m *WebhookInfos) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9616,17 +21743,17 @@ func (m *WorkerStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: WorkerStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: WebhookInfos: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WorkerStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WebhookInfos: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkerID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WebhookInfo", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -9636,24 +21763,76 @@ func (m *WorkerStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WorkerID = string(dAtA[iNdEx:postIndex])
+			m.WebhookInfo = append(m.WebhookInfo, &WebhookInfo{})
+			if err := m.WebhookInfo[len(m.WebhookInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WebhookDelivery) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WebhookDelivery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WebhookDelivery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Webhook", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9678,11 +21857,11 @@ func (m *WorkerStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JobID = string(dAtA[iNdEx:postIndex])
+			m.Webhook = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Event", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9706,16 +21885,18 @@ func (m *WorkerStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = append(m.Data, &InputFile{})
-			if err := m.Data[len(m.Data)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Event == nil {
+				m.Event = &Event{}
+			}
+			if err := m.Event.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Started", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempts", wireType)
 			}
-			var msglen int
+			m.Attempts = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -9725,28 +21906,14 @@ func (m *WorkerStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.Attempts |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Started == nil {
-				m.Started = &google_protobuf1.Timestamp{}
-			}
-			if err := m.Started.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NextAttempt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9770,99 +21937,16 @@ func (m *WorkerStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Stats == nil {
-				m.Stats = &ProcessStats{}
+			if m.NextAttempt == nil {
+				m.NextAttempt = &google_protobuf1.Timestamp{}
 			}
-			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.NextAttempt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QueueSize", wireType)
-			}
-			m.QueueSize = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.QueueSize |= (int64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthPps
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ResourceSpec) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPps
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ResourceSpec: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResourceSpec: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cpu", wireType)
-			}
-			var v uint32
-			if (iNdEx + 4) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += 4
-			v = uint32(dAtA[iNdEx-4])
-			v |= uint32(dAtA[iNdEx-3]) << 8
-			v |= uint32(dAtA[iNdEx-2]) << 16
-			v |= uint32(dAtA[iNdEx-1]) << 24
-			m.Cpu = float32(math.Float32frombits(v))
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Memory", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastError", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9879,35 +21963,16 @@ func (m *ResourceSpec) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Memory = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Gpu", wireType)
-			}
-			m.Gpu = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Gpu |= (int64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.LastError = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -9929,7 +21994,7 @@ func (m *ResourceSpec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JobInfo) Unmarshal(dAtA []byte) error {
+func (m *CreateJobRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9952,46 +22017,13 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JobInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: CreateJobRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JobInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CreateJobRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Job == nil {
-				m.Job = &Job{}
-			}
-			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Transform", wireType)
 			}
@@ -10024,7 +22056,7 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
 			}
@@ -10057,9 +22089,9 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ParentJob", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ParallelismSpec", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10083,16 +22115,16 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ParentJob == nil {
-				m.ParentJob = &Job{}
+			if m.ParallelismSpec == nil {
+				m.ParallelismSpec = &ParallelismSpec{}
 			}
-			if err := m.ParentJob.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ParallelismSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Started", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10116,16 +22148,16 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Started == nil {
-				m.Started = &google_protobuf1.Timestamp{}
+			if m.Service == nil {
+				m.Service = &Service{}
 			}
-			if err := m.Started.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Service.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Finished", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Egress", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10149,18 +22181,18 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Finished == nil {
-				m.Finished = &google_protobuf1.Timestamp{}
+			if m.Egress == nil {
+				m.Egress = &Egress{}
 			}
-			if err := m.Finished.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Egress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OutputCommit", wireType)
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PipelineVersion", wireType)
 			}
-			var msglen int
+			m.PipelineVersion = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -10170,30 +22202,16 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.PipelineVersion |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.OutputCommit == nil {
-				m.OutputCommit = &pfs.Commit{}
-			}
-			if err := m.OutputCommit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutputBranch", wireType)
 			}
-			m.State = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -10203,14 +22221,24 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.State |= (JobState(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OutputBranch = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ParallelismSpec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OutputRepo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10234,35 +22262,16 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ParallelismSpec == nil {
-				m.ParallelismSpec = &ParallelismSpec{}
+			if m.OutputRepo == nil {
+				m.OutputRepo = &pfs.Repo{}
 			}
-			if err := m.ParallelismSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.OutputRepo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 13:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PipelineVersion", wireType)
-			}
-			m.PipelineVersion = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PipelineVersion |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentJob", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10286,16 +22295,16 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Service == nil {
-				m.Service = &Service{}
+			if m.ParentJob == nil {
+				m.ParentJob = &Job{}
 			}
-			if err := m.Service.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ParentJob.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 15:
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Egress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceSpec", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10319,18 +22328,18 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Egress == nil {
-				m.Egress = &Egress{}
+			if m.ResourceSpec == nil {
+				m.ResourceSpec = &ResourceSpec{}
 			}
-			if err := m.Egress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ResourceSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 17:
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OutputBranch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -10340,24 +22349,28 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OutputBranch = string(dAtA[iNdEx:postIndex])
+			if m.Input == nil {
+				m.Input = &Input{}
+			}
+			if err := m.Input.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 18:
+		case 16:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OutputRepo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewBranch", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10381,37 +22394,18 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.OutputRepo == nil {
-				m.OutputRepo = &pfs.Repo{}
+			if m.NewBranch == nil {
+				m.NewBranch = &pfs.BranchInfo{}
 			}
-			if err := m.OutputRepo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.NewBranch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 20:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Restart", wireType)
-			}
-			m.Restart = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Restart |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 22:
+		case 17:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataProcessed", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Incremental", wireType)
 			}
-			m.DataProcessed = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -10421,16 +22415,17 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DataProcessed |= (int64(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 23:
+			m.Incremental = bool(v != 0)
+		case 18:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataTotal", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableStats", wireType)
 			}
-			m.DataTotal = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -10440,16 +22435,17 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DataTotal |= (int64(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 24:
+			m.EnableStats = bool(v != 0)
+		case 19:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkerStatus", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Salt", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -10459,28 +22455,26 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WorkerStatus = append(m.WorkerStatus, &WorkerStatus{})
-			if err := m.WorkerStatus[len(m.WorkerStatus)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Salt = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 25:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResourceSpec", wireType)
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Batch", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -10490,30 +22484,17 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.ResourceSpec == nil {
-				m.ResourceSpec = &ResourceSpec{}
-			}
-			if err := m.ResourceSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 26:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
+			m.Batch = bool(v != 0)
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rerun", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -10523,28 +22504,65 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			m.Rerun = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Input == nil {
-				m.Input = &Input{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InspectJobRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
 			}
-			if err := m.Input.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 27:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InspectJobRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InspectJobRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewBranch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10568,16 +22586,16 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.NewBranch == nil {
-				m.NewBranch = &pfs.BranchInfo{}
+			if m.Job == nil {
+				m.Job = &Job{}
 			}
-			if err := m.NewBranch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 28:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Incremental", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockState", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -10594,10 +22612,60 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.Incremental = bool(v != 0)
-		case 29:
+			m.BlockState = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListJobRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListJobRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListJobRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StatsCommit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10621,18 +22689,18 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.StatsCommit == nil {
-				m.StatsCommit = &pfs.Commit{}
+			if m.Pipeline == nil {
+				m.Pipeline = &Pipeline{}
 			}
-			if err := m.StatsCommit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 30:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataSkipped", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InputCommit", wireType)
 			}
-			m.DataSkipped = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -10642,14 +22710,26 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DataSkipped |= (int64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 31:
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InputCommit = append(m.InputCommit, &pfs.Commit{})
+			if err := m.InputCommit[len(m.InputCommit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OutputCommit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10673,38 +22753,68 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Stats == nil {
-				m.Stats = &ProcessStats{}
+			if m.OutputCommit == nil {
+				m.OutputCommit = &pfs.Commit{}
 			}
-			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.OutputCommit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 32:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EnableStats", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if skippy < 0 {
+				return ErrInvalidLengthPps
 			}
-			m.EnableStats = bool(v != 0)
-		case 33:
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EgressJobRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EgressJobRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EgressJobRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Salt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -10714,46 +22824,80 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Salt = string(dAtA[iNdEx:postIndex])
+			if m.Job == nil {
+				m.Job = &Job{}
+			}
+			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 34:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Batch", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if skippy < 0 {
+				return ErrInvalidLengthPps
 			}
-			m.Batch = bool(v != 0)
-		case 35:
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeleteJobRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteJobRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteJobRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -10763,20 +22907,24 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reason = string(dAtA[iNdEx:postIndex])
+			if m.Job == nil {
+				m.Job = &Job{}
+			}
+			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10799,7 +22947,7 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Worker) Unmarshal(dAtA []byte) error {
+func (m *StopJobRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10822,17 +22970,17 @@ func (m *Worker) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Worker: wiretype end group for non-group")
+			return fmt.Errorf("proto: StopJobRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Worker: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StopJobRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -10842,40 +22990,25 @@ func (m *Worker) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			if m.Job == nil {
+				m.Job = &Job{}
 			}
-			m.State = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.State |= (WorkerState(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -10897,7 +23030,7 @@ func (m *Worker) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JobInfos) Unmarshal(dAtA []byte) error {
+func (m *GetLogsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10920,15 +23053,130 @@ func (m *JobInfos) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JobInfos: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetLogsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JobInfos: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetLogsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Job == nil {
+				m.Job = &Job{}
+			}
+			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pipeline == nil {
+				m.Pipeline = &Pipeline{}
+			}
+			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataFilters", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DataFilters = append(m.DataFilters, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Master", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Master = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Datum", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10952,8 +23200,10 @@ func (m *JobInfos) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JobInfo = append(m.JobInfo, &JobInfo{})
-			if err := m.JobInfo[len(m.JobInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Datum == nil {
+				m.Datum = &Datum{}
+			}
+			if err := m.Datum.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -10978,7 +23228,7 @@ func (m *JobInfos) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Pipeline) Unmarshal(dAtA []byte) error {
+func (m *LogMessage) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11001,15 +23251,15 @@ func (m *Pipeline) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Pipeline: wiretype end group for non-group")
+			return fmt.Errorf("proto: LogMessage: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Pipeline: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LogMessage: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PipelineName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11034,61 +23284,71 @@ func (m *Pipeline) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.PipelineName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobID", wireType)
 			}
-			if skippy < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *PipelineInput) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPps
+			m.JobID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
-			if iNdEx >= l {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Data = append(m.Data, &InputFile{})
+			if err := m.Data[len(m.Data)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: PipelineInput: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PipelineInput: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Ts", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11112,16 +23372,16 @@ func (m *PipelineInput) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Repo == nil {
-				m.Repo = &pfs.Repo{}
+			if m.Ts == nil {
+				m.Ts = &google_protobuf1.Timestamp{}
 			}
-			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Ts.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11146,11 +23406,11 @@ func (m *PipelineInput) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Branch = string(dAtA[iNdEx:postIndex])
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Glob", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkerID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11175,11 +23435,11 @@ func (m *PipelineInput) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Glob = string(dAtA[iNdEx:postIndex])
+			m.WorkerID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 8:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Lazy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -11196,10 +23456,10 @@ func (m *PipelineInput) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.Lazy = bool(v != 0)
-		case 5:
+			m.User = bool(v != 0)
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DatumID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11224,13 +23484,116 @@ func (m *PipelineInput) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.DatumID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Master", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Master = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RestartDatumRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RestartDatumRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RestartDatumRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Job == nil {
+				m.Job = &Job{}
+			}
+			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DataFilters", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -11240,24 +23603,20 @@ func (m *PipelineInput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.From == nil {
-				m.From = &pfs.Commit{}
-			}
-			if err := m.From.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.DataFilters = append(m.DataFilters, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11280,7 +23639,7 @@ func (m *PipelineInput) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
+func (m *InspectDatumRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11303,15 +23662,15 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PipelineInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: InspectDatumRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PipelineInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: InspectDatumRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Datum", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11335,49 +23694,66 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pipeline == nil {
-				m.Pipeline = &Pipeline{}
+			if m.Datum == nil {
+				m.Datum = &Datum{}
 			}
-			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Datum.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Transform", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Transform == nil {
-				m.Transform = &Transform{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListDatumRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
 			}
-			if err := m.Transform.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 6:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListDatumRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListDatumRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11401,18 +23777,18 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CreatedAt == nil {
-				m.CreatedAt = &google_protobuf1.Timestamp{}
+			if m.Job == nil {
+				m.Job = &Job{}
 			}
-			if err := m.CreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PageSize", wireType)
 			}
-			m.State = 0
+			m.PageSize = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -11422,16 +23798,16 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.State |= (PipelineState(b) & 0x7F) << shift
+				m.PageSize |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecentError", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Page", wireType)
 			}
-			var stringLen uint64
+			m.Page = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -11441,120 +23817,64 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.Page |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.RecentError = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobCounts", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			var keykey uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				keykey |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			var mapkey int32
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				mapkey |= (int32(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if m.JobCounts == nil {
-				m.JobCounts = make(map[int32]int32)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListDatumResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
 			}
-			if iNdEx < postIndex {
-				var valuekey uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPps
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					valuekey |= (uint64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				var mapvalue int32
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPps
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					mapvalue |= (int32(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.JobCounts[mapkey] = mapvalue
-			} else {
-				var mapvalue int32
-				m.JobCounts[mapkey] = mapvalue
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 10:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListDatumResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListDatumResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ParallelismSpec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DatumInfos", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11578,18 +23898,16 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ParallelismSpec == nil {
-				m.ParallelismSpec = &ParallelismSpec{}
-			}
-			if err := m.ParallelismSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.DatumInfos = append(m.DatumInfos, &DatumInfo{})
+			if err := m.DatumInfos[len(m.DatumInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 11:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalPages", wireType)
 			}
-			m.Version = 0
+			m.TotalPages = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -11599,16 +23917,16 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Version |= (uint64(b) & 0x7F) << shift
+				m.TotalPages |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 15:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Egress", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Page", wireType)
 			}
-			var msglen int
+			m.Page = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -11618,30 +23936,66 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.Page |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Egress == nil {
-				m.Egress = &Egress{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListDatumForInputRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
 			}
-			if err := m.Egress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 16:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListDatumForInputRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListDatumForInputRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OutputBranch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -11651,53 +24005,28 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OutputBranch = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 17:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPps
+			if m.Pipeline == nil {
+				m.Pipeline = &Pipeline{}
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.ID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 18:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScaleDownThreshold", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11721,18 +24050,18 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ScaleDownThreshold == nil {
-				m.ScaleDownThreshold = &google_protobuf2.Duration{}
+			if m.Input == nil {
+				m.Input = &Input{}
 			}
-			if err := m.ScaleDownThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Input.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 19:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResourceSpec", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PageSize", wireType)
 			}
-			var msglen int
+			m.PageSize = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -11742,30 +24071,16 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.PageSize |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.ResourceSpec == nil {
-				m.ResourceSpec = &ResourceSpec{}
-			}
-			if err := m.ResourceSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 20:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Page", wireType)
 			}
-			var msglen int
+			m.Page = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -11775,30 +24090,66 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.Page |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Input == nil {
-				m.Input = &Input{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DatumSizeBucket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
 			}
-			if err := m.Input.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 21:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			var stringLen uint64
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DatumSizeBucket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DatumSizeBucket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LowerBoundBytes", wireType)
+			}
+			m.LowerBoundBytes = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -11808,26 +24159,35 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.LowerBoundBytes |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPps
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpperBoundBytes", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.UpperBoundBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpperBoundBytes |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 22:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Incremental", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
 			}
-			var v int
+			m.Count = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -11837,17 +24197,66 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				m.Count |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Incremental = bool(v != 0)
-		case 23:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListDatumForInputResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListDatumForInputResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListDatumForInputResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CacheSize", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DatumInfos", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -11857,26 +24266,28 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CacheSize = string(dAtA[iNdEx:postIndex])
+			m.DatumInfos = append(m.DatumInfos, &DatumInfo{})
+			if err := m.DatumInfos[len(m.DatumInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 24:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EnableStats", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalPages", wireType)
 			}
-			var v int
+			m.TotalPages = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -11886,17 +24297,16 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				m.TotalPages |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.EnableStats = bool(v != 0)
-		case 25:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Salt", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Page", wireType)
 			}
-			var stringLen uint64
+			m.Page = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -11906,26 +24316,16 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.Page |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Salt = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 26:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Capability", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DatumCount", wireType)
 			}
-			var stringLen uint64
+			m.DatumCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -11935,26 +24335,16 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.DatumCount |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Capability = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 27:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Batch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalBytes", wireType)
 			}
-			var v int
+			m.TotalBytes = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -11964,17 +24354,16 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				m.TotalBytes |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Batch = bool(v != 0)
-		case 28:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinDatumBytes", wireType)
 			}
-			var stringLen uint64
+			m.MinDatumBytes = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -11984,26 +24373,16 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.MinDatumBytes |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Reason = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 29:
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxQueueSize", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDatumBytes", wireType)
 			}
-			m.MaxQueueSize = 0
+			m.MaxDatumBytes = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -12013,14 +24392,14 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxQueueSize |= (int64(b) & 0x7F) << shift
+				m.MaxDatumBytes |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 30:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SizeHistogram", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12044,10 +24423,8 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Service == nil {
-				m.Service = &Service{}
-			}
-			if err := m.Service.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.SizeHistogram = append(m.SizeHistogram, &DatumSizeBucket{})
+			if err := m.SizeHistogram[len(m.SizeHistogram)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -12072,7 +24449,7 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PipelineInfos) Unmarshal(dAtA []byte) error {
+func (m *CreatePipelineRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12091,19 +24468,105 @@ func (m *PipelineInfos) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: PipelineInfos: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PipelineInfos: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreatePipelineRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreatePipelineRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pipeline == nil {
+				m.Pipeline = &Pipeline{}
+			}
+			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transform", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Transform == nil {
+				m.Transform = &Transform{}
+			}
+			if err := m.Transform.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Update", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Update = bool(v != 0)
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PipelineInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ParallelismSpec", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12127,64 +24590,78 @@ func (m *PipelineInfos) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PipelineInfo = append(m.PipelineInfo, &PipelineInfo{})
-			if err := m.PipelineInfo[len(m.PipelineInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.ParallelismSpec == nil {
+				m.ParallelismSpec = &ParallelismSpec{}
+			}
+			if err := m.ParallelismSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Egress", wireType)
 			}
-			if skippy < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *CreateJobRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPps
+			if m.Egress == nil {
+				m.Egress = &Egress{}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if err := m.Egress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutputBranch", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: CreateJobRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CreateJobRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OutputBranch = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Transform", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScaleDownThreshold", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12208,16 +24685,16 @@ func (m *CreateJobRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Transform == nil {
-				m.Transform = &Transform{}
+			if m.ScaleDownThreshold == nil {
+				m.ScaleDownThreshold = &google_protobuf2.Duration{}
 			}
-			if err := m.Transform.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ScaleDownThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceSpec", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12241,16 +24718,16 @@ func (m *CreateJobRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pipeline == nil {
-				m.Pipeline = &Pipeline{}
+			if m.ResourceSpec == nil {
+				m.ResourceSpec = &ResourceSpec{}
 			}
-			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ResourceSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ParallelismSpec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12274,18 +24751,18 @@ func (m *CreateJobRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ParallelismSpec == nil {
-				m.ParallelismSpec = &ParallelismSpec{}
+			if m.Input == nil {
+				m.Input = &Input{}
 			}
-			if err := m.ParallelismSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Input.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -12295,30 +24772,46 @@ func (m *CreateJobRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Service == nil {
-				m.Service = &Service{}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Incremental", wireType)
 			}
-			if err := m.Service.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 9:
+			m.Incremental = bool(v != 0)
+		case 16:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Egress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CacheSize", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -12328,30 +24821,46 @@ func (m *CreateJobRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Egress == nil {
-				m.Egress = &Egress{}
+			m.CacheSize = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableStats", wireType)
 			}
-			if err := m.Egress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 10:
+			m.EnableStats = bool(v != 0)
+		case 18:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PipelineVersion", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reprocess", wireType)
 			}
-			m.PipelineVersion = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -12361,16 +24870,37 @@ func (m *CreateJobRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PipelineVersion |= (uint64(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 11:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OutputBranch", wireType)
+			m.Reprocess = bool(v != 0)
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Batch", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Batch = bool(v != 0)
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxQueueSize", wireType)
 			}
-			var stringLen uint64
+			m.MaxQueueSize = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -12380,24 +24910,14 @@ func (m *CreateJobRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.MaxQueueSize |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.OutputBranch = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 12:
+		case 21:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OutputRepo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12421,18 +24941,18 @@ func (m *CreateJobRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.OutputRepo == nil {
-				m.OutputRepo = &pfs.Repo{}
+			if m.Service == nil {
+				m.Service = &Service{}
 			}
-			if err := m.OutputRepo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Service.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 13:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ParentJob", wireType)
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DatumTries", wireType)
 			}
-			var msglen int
+			m.DatumTries = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -12442,28 +24962,14 @@ func (m *CreateJobRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.DatumTries |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.ParentJob == nil {
-				m.ParentJob = &Job{}
-			}
-			if err := m.ParentJob.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 14:
+		case 23:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResourceSpec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DatumRetryBackoff", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12487,16 +24993,16 @@ func (m *CreateJobRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ResourceSpec == nil {
-				m.ResourceSpec = &ResourceSpec{}
+			if m.DatumRetryBackoff == nil {
+				m.DatumRetryBackoff = &google_protobuf2.Duration{}
 			}
-			if err := m.ResourceSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DatumRetryBackoff.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 15:
+		case 24:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DatumTimeout", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12520,16 +25026,16 @@ func (m *CreateJobRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Input == nil {
-				m.Input = &Input{}
+			if m.DatumTimeout == nil {
+				m.DatumTimeout = &google_protobuf2.Duration{}
 			}
-			if err := m.Input.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DatumTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 16:
+		case 25:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewBranch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JobTimeout", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12553,36 +25059,16 @@ func (m *CreateJobRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.NewBranch == nil {
-				m.NewBranch = &pfs.BranchInfo{}
+			if m.JobTimeout == nil {
+				m.JobTimeout = &google_protobuf2.Duration{}
 			}
-			if err := m.NewBranch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.JobTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 17:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Incremental", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Incremental = bool(v != 0)
-		case 18:
+		case 26:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EnableStats", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SkipFailedDatums", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -12599,12 +25085,12 @@ func (m *CreateJobRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.EnableStats = bool(v != 0)
-		case 19:
+			m.SkipFailedDatums = bool(v != 0)
+		case 27:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Salt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceLimits", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -12614,94 +25100,28 @@ func (m *CreateJobRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Salt = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 20:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Batch", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if m.ResourceLimits == nil {
+				m.ResourceLimits = &ResourceSpec{}
 			}
-			m.Batch = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.ResourceLimits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthPps
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *InspectJobRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPps
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: InspectJobRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InspectJobRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 28:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SchedulingSpec", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12725,88 +25145,18 @@ func (m *InspectJobRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Job == nil {
-				m.Job = &Job{}
+			if m.SchedulingSpec == nil {
+				m.SchedulingSpec = &SchedulingSpec{}
 			}
-			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SchedulingSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockState", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.BlockState = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthPps
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ListJobRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPps
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ListJobRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListJobRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 29:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PodPatch", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -12816,28 +25166,24 @@ func (m *ListJobRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pipeline == nil {
-				m.Pipeline = &Pipeline{}
-			}
-			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.PodPatch = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 30:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InputCommit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoscalingSpec", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12861,16 +25207,18 @@ func (m *ListJobRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InputCommit = append(m.InputCommit, &pfs.Commit{})
-			if err := m.InputCommit[len(m.InputCommit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.AutoscalingSpec == nil {
+				m.AutoscalingSpec = &AutoscalingSpec{}
+			}
+			if err := m.AutoscalingSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 31:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OutputCommit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PriorityClass", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -12880,80 +25228,26 @@ func (m *ListJobRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.OutputCommit == nil {
-				m.OutputCommit = &pfs.Commit{}
-			}
-			if err := m.OutputCommit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.PriorityClass = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthPps
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DeleteJobRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPps
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DeleteJobRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeleteJobRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 32:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Team", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -12963,78 +25257,24 @@ func (m *DeleteJobRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Job == nil {
-				m.Job = &Job{}
-			}
-			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Team = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthPps
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *StopJobRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPps
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: StopJobRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StopJobRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 33:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spout", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13058,10 +25298,10 @@ func (m *StopJobRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Job == nil {
-				m.Job = &Job{}
+			if m.Spout == nil {
+				m.Spout = &Spout{}
 			}
-			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Spout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -13086,7 +25326,7 @@ func (m *StopJobRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetLogsRequest) Unmarshal(dAtA []byte) error {
+func (m *InspectPipelineRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13109,46 +25349,13 @@ func (m *GetLogsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetLogsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: InspectPipelineRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetLogsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: InspectPipelineRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Job == nil {
-				m.Job = &Job{}
-			}
-			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
 			}
@@ -13181,11 +25388,11 @@ func (m *GetLogsRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataFilters", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
-			var stringLen uint64
+			m.Version = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -13195,24 +25402,14 @@ func (m *GetLogsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.Version |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DataFilters = append(m.DataFilters, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Master", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkerSpec", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -13226,43 +25423,10 @@ func (m *GetLogsRequest) Unmarshal(dAtA []byte) error {
 				iNdEx++
 				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
-					break
-				}
-			}
-			m.Master = bool(v != 0)
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Datum", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Datum == nil {
-				m.Datum = &Datum{}
-			}
-			if err := m.Datum.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+					break
+				}
 			}
-			iNdEx = postIndex
+			m.WorkerSpec = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -13284,7 +25448,7 @@ func (m *GetLogsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LogMessage) Unmarshal(dAtA []byte) error {
+func (m *ListPipelineVersionsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13307,17 +25471,17 @@ func (m *LogMessage) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LogMessage: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListPipelineVersionsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LogMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListPipelineVersionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PipelineName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -13327,84 +25491,78 @@ func (m *LogMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PipelineName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobID", wireType)
+			if m.Pipeline == nil {
+				m.Pipeline = &Pipeline{}
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JobID = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthPps
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RollbackPipelineRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
 			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = append(m.Data, &InputFile{})
-			if err := m.Data[len(m.Data)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 5:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RollbackPipelineRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RollbackPipelineRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13428,76 +25586,18 @@ func (m *LogMessage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Ts == nil {
-				m.Ts = &google_protobuf1.Timestamp{}
+			if m.Pipeline == nil {
+				m.Pipeline = &Pipeline{}
 			}
-			if err := m.Ts.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Message = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkerID", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.WorkerID = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 8:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
-			var v int
+			m.Version = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -13507,61 +25607,61 @@ func (m *LogMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				m.Version |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.User = bool(v != 0)
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DatumID", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DatumID = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Master", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListPipelineRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
 			}
-			m.Master = bool(v != 0)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListPipelineRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListPipelineRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -13583,7 +25683,7 @@ func (m *LogMessage) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RestartDatumRequest) Unmarshal(dAtA []byte) error {
+func (m *DeletePipelineRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13606,15 +25706,15 @@ func (m *RestartDatumRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RestartDatumRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeletePipelineRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RestartDatumRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeletePipelineRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13638,18 +25738,18 @@ func (m *RestartDatumRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Job == nil {
-				m.Job = &Job{}
+			if m.Pipeline == nil {
+				m.Pipeline = &Pipeline{}
 			}
-			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataFilters", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeleteJobs", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -13659,21 +25759,52 @@ func (m *RestartDatumRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPps
+			m.DeleteJobs = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeleteRepo", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.DataFilters = append(m.DataFilters, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
+			m.DeleteRepo = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field All", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.All = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -13695,7 +25826,7 @@ func (m *RestartDatumRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *InspectDatumRequest) Unmarshal(dAtA []byte) error {
+func (m *StartPipelineRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13718,15 +25849,15 @@ func (m *InspectDatumRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: InspectDatumRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: StartPipelineRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InspectDatumRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StartPipelineRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Datum", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13750,10 +25881,10 @@ func (m *InspectDatumRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Datum == nil {
-				m.Datum = &Datum{}
+			if m.Pipeline == nil {
+				m.Pipeline = &Pipeline{}
 			}
-			if err := m.Datum.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -13778,7 +25909,7 @@ func (m *InspectDatumRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListDatumRequest) Unmarshal(dAtA []byte) error {
+func (m *StopPipelineRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13801,15 +25932,15 @@ func (m *ListDatumRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListDatumRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: StopPipelineRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListDatumRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StopPipelineRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13833,51 +25964,13 @@ func (m *ListDatumRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Job == nil {
-				m.Job = &Job{}
+			if m.Pipeline == nil {
+				m.Pipeline = &Pipeline{}
 			}
-			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PageSize", wireType)
-			}
-			m.PageSize = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PageSize |= (int64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Page", wireType)
-			}
-			m.Page = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Page |= (int64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -13899,7 +25992,7 @@ func (m *ListDatumRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListDatumResponse) Unmarshal(dAtA []byte) error {
+func (m *RerunPipelineRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13922,15 +26015,15 @@ func (m *ListDatumResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListDatumResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RerunPipelineRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListDatumResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RerunPipelineRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DatumInfos", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13954,16 +26047,18 @@ func (m *ListDatumResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DatumInfos = append(m.DatumInfos, &DatumInfo{})
-			if err := m.DatumInfos[len(m.DatumInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pipeline == nil {
+				m.Pipeline = &Pipeline{}
+			}
+			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalPages", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Exclude", wireType)
 			}
-			m.TotalPages = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -13973,16 +26068,28 @@ func (m *ListDatumResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TotalPages |= (int64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Exclude = append(m.Exclude, &pfs.Commit{})
+			if err := m.Exclude[len(m.Exclude)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Page", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Include", wireType)
 			}
-			m.Page = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -13992,11 +26099,23 @@ func (m *ListDatumResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Page |= (int64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Include = append(m.Include, &pfs.Commit{})
+			if err := m.Include[len(m.Include)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -14018,7 +26137,7 @@ func (m *ListDatumResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CreatePipelineRequest) Unmarshal(dAtA []byte) error {
+func (m *ApplyPipelinesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14033,56 +26152,23 @@ func (m *CreatePipelineRequest) Unmarshal(dAtA []byte) error {
 			}
 			b := dAtA[iNdEx]
 			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: CreatePipelineRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CreatePipelineRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pipeline == nil {
-				m.Pipeline = &Pipeline{}
-			}
-			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 2:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplyPipelinesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplyPipelinesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Transform", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Repos", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14106,36 +26192,14 @@ func (m *CreatePipelineRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Transform == nil {
-				m.Transform = &Transform{}
-			}
-			if err := m.Transform.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Repos = append(m.Repos, &pfs.CreateRepoRequest{})
+			if err := m.Repos[len(m.Repos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Update", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Update = bool(v != 0)
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ParallelismSpec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pipelines", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14159,18 +26223,16 @@ func (m *CreatePipelineRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ParallelismSpec == nil {
-				m.ParallelismSpec = &ParallelismSpec{}
-			}
-			if err := m.ParallelismSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Pipelines = append(m.Pipelines, &CreatePipelineRequest{})
+			if err := m.Pipelines[len(m.Pipelines)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Egress", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prune", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -14180,30 +26242,17 @@ func (m *CreatePipelineRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Egress == nil {
-				m.Egress = &Egress{}
-			}
-			if err := m.Egress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 10:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OutputBranch", wireType)
+			m.Prune = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reprocess", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -14213,26 +26262,17 @@ func (m *CreatePipelineRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.OutputBranch = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 11:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScaleDownThreshold", wireType)
+			m.Reprocess = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -14242,30 +26282,67 @@ func (m *CreatePipelineRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			m.DryRun = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ScaleDownThreshold == nil {
-				m.ScaleDownThreshold = &google_protobuf2.Duration{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplyAction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
 			}
-			if err := m.ScaleDownThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 12:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResourceSpec", wireType)
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			var msglen int
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplyAction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplyAction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -14275,30 +26352,16 @@ func (m *CreatePipelineRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.Type |= (ApplyActionType(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.ResourceSpec == nil {
-				m.ResourceSpec = &ResourceSpec{}
-			}
-			if err := m.ResourceSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 13:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -14308,28 +26371,24 @@ func (m *CreatePipelineRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Input == nil {
-				m.Input = &Input{}
-			}
-			if err := m.Input.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 14:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangedFields", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14354,11 +26413,11 @@ func (m *CreatePipelineRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.ChangedFields = append(m.ChangedFields, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 15:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Incremental", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reprocess", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -14375,10 +26434,10 @@ func (m *CreatePipelineRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.Incremental = bool(v != 0)
-		case 16:
+			m.Reprocess = bool(v != 0)
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CacheSize", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Downstream", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14403,33 +26462,63 @@ func (m *CreatePipelineRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CacheSize = string(dAtA[iNdEx:postIndex])
+			m.Downstream = append(m.Downstream, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 17:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EnableStats", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplyPipelinesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			m.EnableStats = bool(v != 0)
-		case 18:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reprocess", wireType)
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplyPipelinesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplyPipelinesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Actions", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -14439,54 +26528,76 @@ func (m *CreatePipelineRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Reprocess = bool(v != 0)
-		case 19:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Batch", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthPps
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.Batch = bool(v != 0)
-		case 20:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxQueueSize", wireType)
+			m.Actions = append(m.Actions, &ApplyAction{})
+			if err := m.Actions[len(m.Actions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.MaxQueueSize = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MaxQueueSize |= (int64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-		case 21:
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CreateWebhookRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreateWebhookRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreateWebhookRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Webhook", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14510,13 +26621,33 @@ func (m *CreatePipelineRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Service == nil {
-				m.Service = &Service{}
+			if m.Webhook == nil {
+				m.Webhook = &WebhookInfo{}
 			}
-			if err := m.Service.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Webhook.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Update", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Update = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -14538,7 +26669,7 @@ func (m *CreatePipelineRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *InspectPipelineRequest) Unmarshal(dAtA []byte) error {
+func (m *ListWebhookRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14561,10 +26692,10 @@ func (m *InspectPipelineRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: InspectPipelineRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListWebhookRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InspectPipelineRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListWebhookRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -14621,7 +26752,7 @@ func (m *InspectPipelineRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListPipelineRequest) Unmarshal(dAtA []byte) error {
+func (m *DeleteWebhookRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14644,12 +26775,41 @@ func (m *ListPipelineRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListPipelineRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeleteWebhookRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListPipelineRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeleteWebhookRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -14671,7 +26831,7 @@ func (m *ListPipelineRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DeletePipelineRequest) Unmarshal(dAtA []byte) error {
+func (m *TraceFileRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14694,15 +26854,15 @@ func (m *DeletePipelineRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DeletePipelineRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: TraceFileRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeletePipelineRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TraceFileRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field File", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14726,18 +26886,18 @@ func (m *DeletePipelineRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pipeline == nil {
-				m.Pipeline = &Pipeline{}
+			if m.File == nil {
+				m.File = &pfs.File{}
 			}
-			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.File.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeleteJobs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Depth", wireType)
 			}
-			var v int
+			m.Depth = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -14747,17 +26907,99 @@ func (m *DeletePipelineRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				m.Depth |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.DeleteJobs = bool(v != 0)
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeleteRepo", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FileTrace) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FileTrace: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FileTrace: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field File", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.File == nil {
+				m.File = &pfs.File{}
+			}
+			if err := m.File.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			var v int
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -14767,17 +27009,30 @@ func (m *DeletePipelineRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.DeleteRepo = bool(v != 0)
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field All", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthPps
 			}
-			var v int
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Job == nil {
+				m.Job = &Job{}
+			}
+			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Datums", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -14787,12 +27042,23 @@ func (m *DeletePipelineRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.All = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Datums = append(m.Datums, &DatumTrace{})
+			if err := m.Datums[len(m.Datums)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -14814,7 +27080,7 @@ func (m *DeletePipelineRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StartPipelineRequest) Unmarshal(dAtA []byte) error {
+func (m *DatumTrace) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14837,15 +27103,44 @@ func (m *StartPipelineRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StartPipelineRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DatumTrace: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StartPipelineRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DatumTrace: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DatumID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DatumID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inputs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14869,10 +27164,8 @@ func (m *StartPipelineRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pipeline == nil {
-				m.Pipeline = &Pipeline{}
-			}
-			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Inputs = append(m.Inputs, &FileTrace{})
+			if err := m.Inputs[len(m.Inputs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -14897,7 +27190,7 @@ func (m *StartPipelineRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StopPipelineRequest) Unmarshal(dAtA []byte) error {
+func (m *ImpactAnalysisRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14920,15 +27213,15 @@ func (m *StopPipelineRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StopPipelineRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ImpactAnalysisRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StopPipelineRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ImpactAnalysisRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14952,13 +27245,71 @@ func (m *StopPipelineRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pipeline == nil {
-				m.Pipeline = &Pipeline{}
+			if m.Repo == nil {
+				m.Repo = &pfs.Repo{}
 			}
-			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Branch = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paths", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Paths = append(m.Paths, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -14980,7 +27331,7 @@ func (m *StopPipelineRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RerunPipelineRequest) Unmarshal(dAtA []byte) error {
+func (m *PipelineImpact) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15003,10 +27354,10 @@ func (m *RerunPipelineRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RerunPipelineRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PipelineImpact: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RerunPipelineRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PipelineImpact: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -15043,8 +27394,65 @@ func (m *RerunPipelineRequest) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DatumsTotal", wireType)
+			}
+			m.DatumsTotal = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DatumsTotal |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DatumsInvalidated", wireType)
+			}
+			m.DatumsInvalidated = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DatumsInvalidated |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DatumsReused", wireType)
+			}
+			m.DatumsReused = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DatumsReused |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Exclude", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EstimatedProcessTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15068,14 +27476,66 @@ func (m *RerunPipelineRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Exclude = append(m.Exclude, &pfs.Commit{})
-			if err := m.Exclude[len(m.Exclude)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.EstimatedProcessTime == nil {
+				m.EstimatedProcessTime = &google_protobuf2.Duration{}
+			}
+			if err := m.EstimatedProcessTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ImpactAnalysisResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ImpactAnalysisResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ImpactAnalysisResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Include", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pipelines", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15099,8 +27559,8 @@ func (m *RerunPipelineRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Include = append(m.Include, &pfs.Commit{})
-			if err := m.Include[len(m.Include)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Pipelines = append(m.Pipelines, &PipelineImpact{})
+			if err := m.Pipelines[len(m.Pipelines)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -15333,213 +27793,378 @@ var (
 func init() { proto.RegisterFile("client/pps/pps.proto", fileDescriptorPps) }
 
 var fileDescriptorPps = []byte{
-	// 3325 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x5a, 0xcd, 0x73, 0xdc, 0xc6,
-	0x95, 0xe7, 0x7c, 0x0f, 0xde, 0x60, 0x86, 0xc3, 0xe6, 0x87, 0xa0, 0x91, 0x45, 0x52, 0xd0, 0x4a,
-	0x96, 0x54, 0x5e, 0xca, 0xa6, 0xbc, 0x5a, 0xaf, 0xed, 0xb5, 0xcd, 0x2f, 0xa9, 0x48, 0x71, 0xe5,
-	0x59, 0x50, 0xf2, 0x1e, 0x51, 0x18, 0xa0, 0x67, 0x08, 0x09, 0x03, 0xc0, 0x00, 0x86, 0x12, 0x7d,
-	0xda, 0xff, 0x20, 0x95, 0x8f, 0x4a, 0xa5, 0x52, 0x95, 0x93, 0x0f, 0xb9, 0xe4, 0x90, 0x73, 0x2a,
-	0xd7, 0x54, 0xf9, 0x94, 0xca, 0x5f, 0xa0, 0x4a, 0x29, 0xff, 0x44, 0x2e, 0xa9, 0x4a, 0xf5, 0xeb,
-	0x06, 0x06, 0x98, 0x01, 0x87, 0xa4, 0x95, 0x03, 0xab, 0xd0, 0xaf, 0x5f, 0x77, 0xbf, 0x7e, 0xdd,
-	0xef, 0xf7, 0x7e, 0xfd, 0x86, 0xb0, 0x64, 0x3a, 0x36, 0x75, 0xa3, 0xfb, 0xbe, 0x1f, 0xb2, 0xbf,
-	0x0d, 0x3f, 0xf0, 0x22, 0x8f, 0x94, 0x7c, 0x3f, 0xec, 0x5c, 0x1b, 0x78, 0xde, 0xc0, 0xa1, 0xf7,
-	0x51, 0xd4, 0x1b, 0xf5, 0xef, 0xd3, 0xa1, 0x1f, 0x9d, 0x72, 0x8d, 0xce, 0xda, 0x64, 0x67, 0x64,
-	0x0f, 0x69, 0x18, 0x19, 0x43, 0x5f, 0x28, 0xac, 0x4e, 0x2a, 0x58, 0xa3, 0xc0, 0x88, 0x6c, 0xcf,
-	0x15, 0xfd, 0x4b, 0x03, 0x6f, 0xe0, 0xe1, 0xe7, 0x7d, 0xf6, 0x15, 0x4b, 0x63, 0x73, 0xfa, 0x21,
-	0xfb, 0xe3, 0x52, 0xb5, 0x0f, 0xd5, 0x23, 0x6a, 0x06, 0x34, 0x22, 0x04, 0xca, 0xae, 0x31, 0xa4,
-	0x4a, 0x61, 0xbd, 0x70, 0x47, 0xd2, 0xf0, 0x9b, 0x5c, 0x07, 0x18, 0x7a, 0x23, 0x37, 0xd2, 0x7d,
-	0x23, 0x3a, 0x56, 0x8a, 0xd8, 0x23, 0xa1, 0xa4, 0x6b, 0x44, 0xc7, 0xe4, 0x0a, 0xd4, 0xa8, 0x7b,
-	0xa2, 0x9f, 0x18, 0x81, 0x52, 0xc2, 0xbe, 0x2a, 0x75, 0x4f, 0xbe, 0x31, 0x02, 0xd2, 0x86, 0xd2,
-	0x4b, 0x7a, 0xaa, 0x94, 0x51, 0xc8, 0x3e, 0xd5, 0x3f, 0x15, 0x41, 0x7a, 0x16, 0x18, 0x6e, 0xd8,
-	0xf7, 0x82, 0x21, 0x59, 0x82, 0x8a, 0x3d, 0x34, 0x06, 0xf1, 0x62, 0xbc, 0xc1, 0x46, 0x99, 0x43,
-	0x4b, 0x29, 0xae, 0x97, 0xd8, 0x28, 0x73, 0x68, 0x91, 0xbb, 0x50, 0xa2, 0xee, 0x89, 0x52, 0x5a,
-	0x2f, 0xdd, 0x69, 0x6c, 0x5e, 0xd9, 0x60, 0x5e, 0x4c, 0x26, 0xd9, 0xd8, 0x73, 0x4f, 0xf6, 0xdc,
-	0x28, 0x38, 0xd5, 0x98, 0x0e, 0xb9, 0x05, 0xb5, 0x10, 0x37, 0x12, 0x2a, 0x65, 0x54, 0x6f, 0xa0,
-	0x3a, 0xdf, 0x9c, 0x16, 0xf7, 0xb1, 0x95, 0xc3, 0xc8, 0xb2, 0x5d, 0xa5, 0x82, 0xab, 0xf0, 0x06,
-	0xf9, 0x00, 0x88, 0x61, 0x9a, 0xd4, 0x8f, 0xf4, 0x80, 0x46, 0xa3, 0xc0, 0xd5, 0x4d, 0xcf, 0xa2,
-	0x4a, 0x75, 0xbd, 0x74, 0xa7, 0xa4, 0xb5, 0x79, 0x8f, 0x86, 0x1d, 0x3b, 0x9e, 0x45, 0xd9, 0x1c,
-	0x16, 0xed, 0x8d, 0x06, 0x4a, 0x6d, 0xbd, 0x70, 0xa7, 0xae, 0xf1, 0x06, 0x9b, 0x03, 0xb7, 0xa1,
-	0xfb, 0x23, 0xc7, 0xd1, 0x63, 0x5b, 0x24, 0x5c, 0xa6, 0x8d, 0x3d, 0xdd, 0x91, 0xe3, 0x70, 0x7b,
-	0xc2, 0xce, 0x43, 0xa8, 0xc7, 0xf6, 0xc7, 0xde, 0x2a, 0x24, 0xde, 0x62, 0x2b, 0x9c, 0x18, 0xce,
-	0x88, 0x0a, 0x97, 0xf3, 0xc6, 0xa7, 0xc5, 0x4f, 0x0a, 0x6a, 0x07, 0xaa, 0x7b, 0x83, 0x80, 0x86,
-	0x21, 0x1b, 0xf5, 0x5c, 0x3b, 0x8c, 0x47, 0x3d, 0xd7, 0x0e, 0xd5, 0xeb, 0x50, 0x3a, 0xf0, 0x7a,
-	0x64, 0x05, 0x8a, 0xb6, 0xc5, 0xe5, 0xdb, 0xd5, 0xb7, 0x6f, 0xd6, 0x8a, 0xfb, 0xbb, 0x5a, 0xd1,
-	0xb6, 0xd4, 0x23, 0xa8, 0x1d, 0xd1, 0xe0, 0xc4, 0x36, 0x29, 0xb9, 0x09, 0x4d, 0xdb, 0x8d, 0x68,
-	0xe0, 0x1a, 0x8e, 0xee, 0x7b, 0x41, 0x84, 0xda, 0x15, 0x4d, 0x8e, 0x85, 0x5d, 0x2f, 0x88, 0x98,
-	0x12, 0x7d, 0x9d, 0x56, 0x2a, 0x72, 0xa5, 0x58, 0xc8, 0x94, 0xd4, 0xdf, 0x15, 0x40, 0xda, 0x8a,
-	0xbc, 0xe1, 0xbe, 0xeb, 0x8f, 0xf2, 0xef, 0x10, 0x81, 0x72, 0x40, 0x7d, 0x4f, 0x6c, 0x05, 0xbf,
-	0xc9, 0x0a, 0x54, 0x7b, 0x81, 0xe1, 0x9a, 0xc7, 0xf1, 0xbd, 0xe1, 0x2d, 0x26, 0x37, 0xbd, 0xe1,
-	0xd0, 0x8e, 0xc4, 0xd5, 0x11, 0x2d, 0x36, 0xc7, 0xc0, 0xf1, 0x7a, 0x4a, 0x85, 0xcf, 0xc1, 0xbe,
-	0x99, 0xcc, 0x31, 0xbe, 0x3b, 0x55, 0xaa, 0x78, 0x08, 0xf8, 0x4d, 0xd6, 0xa0, 0xd1, 0x0f, 0xbc,
-	0xa1, 0x2e, 0x26, 0xa9, 0xa1, 0x3a, 0x30, 0xd1, 0x0e, 0x4a, 0xd4, 0x9f, 0x16, 0x40, 0xda, 0x09,
-	0x3c, 0xf7, 0xd2, 0xe6, 0x8a, 0x19, 0x4b, 0x93, 0x66, 0x85, 0x3e, 0x35, 0x85, 0xb1, 0xf8, 0x4d,
-	0x3e, 0x64, 0x17, 0xcc, 0x08, 0x22, 0xb4, 0xb5, 0xb1, 0xd9, 0xd9, 0xe0, 0xc1, 0xba, 0x11, 0x07,
-	0xeb, 0xc6, 0xb3, 0x38, 0x9a, 0x35, 0xae, 0xa8, 0xfe, 0xbc, 0x00, 0x15, 0x6e, 0x8f, 0x0a, 0x65,
-	0x23, 0xf2, 0x86, 0x68, 0x4f, 0x63, 0xb3, 0x85, 0x17, 0x38, 0x71, 0xae, 0x86, 0x7d, 0x64, 0x1d,
-	0x2a, 0x66, 0xe0, 0x85, 0x21, 0x86, 0x49, 0x63, 0x13, 0x50, 0x89, 0x2b, 0xf0, 0x0e, 0xa6, 0x31,
-	0x72, 0x6d, 0xcf, 0x15, 0x61, 0x93, 0xd1, 0xc0, 0x0e, 0xb6, 0x8e, 0x19, 0x78, 0x2e, 0xda, 0x1d,
-	0xaf, 0x93, 0x78, 0x45, 0xc3, 0x3e, 0xf5, 0x25, 0xd4, 0x0f, 0xbc, 0x1e, 0xb7, 0xeb, 0x66, 0xb2,
-	0x7f, 0x6e, 0x59, 0x63, 0x83, 0x01, 0x08, 0x77, 0xe9, 0xd4, 0x19, 0x15, 0x73, 0xce, 0xa8, 0x94,
-	0x3a, 0xa3, 0xd8, 0xe9, 0xe5, 0xb1, 0xd3, 0xd5, 0xe7, 0x30, 0xdf, 0x35, 0x02, 0xc3, 0x71, 0xa8,
-	0x63, 0x87, 0xc3, 0x23, 0xe6, 0xc7, 0x0e, 0xd4, 0x4d, 0xcf, 0x0d, 0x23, 0xc3, 0xe5, 0x17, 0xaf,
-	0xac, 0x25, 0x6d, 0xb2, 0x0e, 0x0d, 0xd3, 0xa3, 0xfd, 0xbe, 0x6d, 0x32, 0x44, 0xc3, 0xd9, 0x0b,
-	0x5a, 0x5a, 0x74, 0x50, 0xae, 0x17, 0xda, 0x45, 0xf5, 0x01, 0x48, 0xb8, 0x81, 0x47, 0xb6, 0x83,
-	0x07, 0x8b, 0x28, 0x26, 0xd6, 0x65, 0xdf, 0x4c, 0x76, 0x6c, 0x84, 0xc7, 0x78, 0x56, 0xb2, 0x86,
-	0xdf, 0xea, 0x67, 0x50, 0xd9, 0x35, 0xa2, 0xd1, 0xf0, 0xac, 0x38, 0x22, 0x1d, 0x28, 0xbd, 0x10,
-	0xfb, 0x6c, 0x6c, 0xd6, 0xd1, 0x79, 0x07, 0x5e, 0x4f, 0x63, 0x42, 0xf5, 0x87, 0x02, 0x48, 0x38,
-	0x7a, 0xdf, 0xed, 0x7b, 0xec, 0x24, 0x2c, 0xd6, 0x10, 0x6e, 0xe3, 0x27, 0x81, 0xdd, 0x1a, 0xef,
-	0x20, 0xb7, 0xf0, 0xb6, 0x44, 0x3c, 0xd0, 0x5b, 0x9b, 0xf3, 0x63, 0x8d, 0x23, 0x26, 0xd6, 0x78,
-	0x2f, 0x79, 0x9f, 0xab, 0x85, 0xb8, 0xd5, 0xc6, 0xe6, 0x02, 0xaa, 0x75, 0x03, 0xcf, 0xa4, 0x61,
-	0xc8, 0x14, 0x43, 0xae, 0x18, 0x92, 0xdb, 0x20, 0xf9, 0xfd, 0x50, 0xe7, 0x73, 0xf2, 0xe3, 0x95,
-	0xf0, 0xb0, 0x98, 0x0b, 0xb4, 0xba, 0xdf, 0x47, 0x75, 0x4a, 0x6e, 0x40, 0xd9, 0x32, 0x22, 0x03,
-	0x51, 0xb0, 0xb1, 0xd9, 0x4c, 0x54, 0x98, 0xd9, 0x1a, 0x76, 0xa9, 0x9f, 0x01, 0x24, 0x3b, 0x09,
-	0xc9, 0xbf, 0x03, 0xa0, 0xc5, 0xba, 0xed, 0xf6, 0x3d, 0xa5, 0x80, 0xc3, 0x5a, 0x63, 0x6b, 0x71,
-	0x9c, 0x64, 0xc5, 0x9f, 0xea, 0xef, 0x19, 0x2c, 0x0c, 0x06, 0x01, 0x1d, 0xb0, 0xd5, 0x96, 0xa0,
-	0x62, 0xb2, 0xa4, 0x81, 0x7e, 0x28, 0x69, 0xbc, 0xc1, 0x9c, 0x3f, 0xa4, 0x86, 0x8b, 0x5b, 0x2f,
-	0x68, 0xf8, 0xcd, 0x22, 0x2d, 0x8c, 0x2c, 0x8b, 0x9e, 0x88, 0x43, 0x15, 0x2d, 0x72, 0x17, 0xda,
-	0x7d, 0xbb, 0x1f, 0x1d, 0xeb, 0x3e, 0x0d, 0x4c, 0xea, 0x46, 0xb6, 0xc3, 0xb7, 0x57, 0xd0, 0xe6,
-	0x51, 0xde, 0x4d, 0xc4, 0xe4, 0x21, 0x5c, 0x71, 0x6d, 0x97, 0x46, 0xa7, 0xfa, 0xd4, 0x88, 0x0a,
-	0x8e, 0x58, 0xe6, 0xdd, 0x8f, 0xb2, 0xe3, 0xd4, 0x9f, 0x15, 0x41, 0x4e, 0xbb, 0x94, 0x7c, 0x01,
-	0x4d, 0xcb, 0x7b, 0xe5, 0x3a, 0x9e, 0x61, 0xe9, 0x2c, 0x05, 0x8b, 0x53, 0xbc, 0x3a, 0x15, 0xd1,
-	0xbb, 0x22, 0xfd, 0x6a, 0x72, 0xac, 0xcf, 0x62, 0x9c, 0x7c, 0x0e, 0xb2, 0xcf, 0xe7, 0xe3, 0xc3,
-	0x8b, 0xe7, 0x0d, 0x6f, 0x08, 0x75, 0x1c, 0xfd, 0x29, 0x34, 0x46, 0xfe, 0x78, 0xed, 0xd2, 0x79,
-	0x83, 0x81, 0x6b, 0xe3, 0xd8, 0x5b, 0xd0, 0x4a, 0x2c, 0xef, 0x9d, 0x46, 0x34, 0x44, 0x5f, 0x95,
-	0xb5, 0x64, 0x3f, 0xdb, 0x4c, 0x48, 0x6e, 0x80, 0x2c, 0x96, 0xe0, 0x4a, 0x15, 0x54, 0x12, 0xcb,
-	0xa2, 0x8a, 0xfa, 0xeb, 0x22, 0x2c, 0x27, 0xe7, 0x98, 0xf1, 0xce, 0x83, 0x7c, 0xef, 0x08, 0xd0,
-	0x8a, 0x87, 0x4c, 0xb8, 0xe4, 0xa3, 0x5c, 0x97, 0x4c, 0x8e, 0xc9, 0xf8, 0xe1, 0x7e, 0x9e, 0x1f,
-	0x26, 0x47, 0xa4, 0x37, 0xff, 0x1f, 0xb9, 0x9b, 0x9f, 0x1e, 0x33, 0xe1, 0x8c, 0x8f, 0x72, 0x9c,
-	0x91, 0x63, 0x5a, 0xda, 0x39, 0xff, 0x28, 0x80, 0xfc, 0x7f, 0x5e, 0xf0, 0x92, 0x06, 0xcc, 0x25,
-	0xa3, 0x90, 0xdc, 0x05, 0xe9, 0x15, 0xb6, 0xf5, 0x04, 0x38, 0xe4, 0xb7, 0x6f, 0xd6, 0xea, 0x5c,
-	0x69, 0x7f, 0x57, 0xab, 0xf3, 0xee, 0x7d, 0x8b, 0xac, 0x43, 0xf5, 0x85, 0xd7, 0x63, 0x7a, 0x88,
-	0x97, 0xdb, 0xd2, 0xdb, 0x37, 0x6b, 0x15, 0x06, 0xb8, 0xbb, 0x5a, 0xe5, 0x85, 0xd7, 0xdb, 0xb7,
-	0x18, 0x48, 0x63, 0x88, 0x96, 0x52, 0xb1, 0x96, 0xa0, 0x19, 0x8f, 0x51, 0xf2, 0x31, 0xd4, 0x30,
-	0x87, 0x50, 0x4b, 0x6c, 0x72, 0x56, 0xba, 0x89, 0x55, 0xc7, 0x68, 0x52, 0x39, 0x07, 0x4d, 0xae,
-	0x03, 0x7c, 0x3b, 0xa2, 0x23, 0xaa, 0x87, 0xf6, 0x77, 0x14, 0x13, 0x6d, 0x49, 0x93, 0x50, 0x72,
-	0x64, 0x7f, 0x47, 0xd5, 0x03, 0x90, 0x35, 0x1a, 0x7a, 0xa3, 0xc0, 0xa4, 0x08, 0xd9, 0x8c, 0xbf,
-	0xf9, 0x23, 0xdc, 0x78, 0x51, 0x63, 0x9f, 0x2c, 0x9c, 0x87, 0x74, 0xe8, 0x05, 0xa7, 0x22, 0x2b,
-	0x88, 0x16, 0xd3, 0x1c, 0xf8, 0x23, 0x3c, 0xcc, 0x92, 0xc6, 0x3e, 0xd5, 0xdf, 0x4a, 0x50, 0xc3,
-	0x7c, 0xd3, 0xf7, 0x62, 0x80, 0x2d, 0xe4, 0x00, 0x2c, 0xf9, 0x00, 0xa4, 0x28, 0x66, 0x80, 0x99,
-	0xeb, 0x93, 0xf0, 0x42, 0x6d, 0xac, 0x40, 0xee, 0x42, 0xdd, 0xb7, 0x7d, 0xea, 0xd8, 0x6e, 0x7c,
-	0x73, 0x9a, 0x7c, 0xb3, 0x42, 0xa8, 0x25, 0xdd, 0xe4, 0x7d, 0x00, 0xdf, 0x08, 0xa8, 0x1b, 0xe9,
-	0x6c, 0xed, 0xea, 0xc4, 0xda, 0x12, 0xef, 0x63, 0xf4, 0x2a, 0xe5, 0xf3, 0xda, 0xc5, 0x7d, 0xfe,
-	0x10, 0xea, 0x7d, 0xdb, 0xb5, 0xc3, 0x63, 0x6a, 0x29, 0xf5, 0x73, 0x87, 0x25, 0xba, 0xe4, 0x43,
-	0x68, 0x7a, 0xa3, 0xc8, 0x1f, 0x45, 0x31, 0xa7, 0x91, 0xa6, 0x33, 0xb0, 0xcc, 0x35, 0x78, 0x8b,
-	0xdc, 0x8c, 0x53, 0x0a, 0x60, 0x4a, 0x69, 0xc6, 0x7b, 0xc8, 0x24, 0x94, 0x2f, 0xa1, 0xed, 0x8f,
-	0x13, 0xae, 0x8e, 0x2c, 0x46, 0xc6, 0x99, 0x97, 0xb8, 0x83, 0xb2, 0xd9, 0x58, 0x9b, 0xf7, 0x27,
-	0xd2, 0xf3, 0x5d, 0x68, 0xc7, 0xae, 0xd3, 0x4f, 0x68, 0x10, 0x32, 0xbe, 0xd1, 0x44, 0xfc, 0x98,
-	0x8f, 0xe5, 0xdf, 0x70, 0x31, 0xb9, 0xcd, 0x98, 0x39, 0xf2, 0x4e, 0xa5, 0x85, 0x4b, 0xc8, 0x82,
-	0x99, 0xa3, 0x4c, 0x8b, 0x3b, 0x19, 0xcb, 0xa0, 0x48, 0x6d, 0x95, 0xf9, 0x78, 0x8f, 0x7e, 0xb8,
-	0xc1, 0xd9, 0xae, 0x26, 0xba, 0x18, 0x29, 0x15, 0xfe, 0x10, 0x04, 0x72, 0x01, 0x2f, 0x96, 0x70,
-	0xc1, 0x36, 0xa7, 0x91, 0xf7, 0xa0, 0x21, 0x94, 0x90, 0xca, 0x91, 0x54, 0x1e, 0xd4, 0xa8, 0xef,
-	0x69, 0xc0, 0x7b, 0xd9, 0x37, 0x51, 0xa0, 0x16, 0x50, 0xce, 0xd8, 0x96, 0xd0, 0xfe, 0xb8, 0x89,
-	0x28, 0x6a, 0x44, 0x86, 0x2e, 0xd0, 0x88, 0x5a, 0xca, 0x0a, 0xde, 0xd7, 0x26, 0x93, 0x76, 0x63,
-	0x21, 0x0b, 0x12, 0x54, 0x8b, 0xbc, 0xc8, 0x70, 0x94, 0x2b, 0x3c, 0x48, 0x98, 0xe4, 0x19, 0x13,
-	0x90, 0x87, 0xd0, 0x14, 0x98, 0x10, 0x22, 0x48, 0x28, 0x0a, 0xc6, 0x33, 0x0f, 0xba, 0x34, 0x7a,
-	0x68, 0xf2, 0xab, 0x34, 0x96, 0x3c, 0x84, 0x66, 0x20, 0x82, 0x8b, 0x1f, 0xcf, 0xd5, 0x54, 0xb0,
-	0xa6, 0xc3, 0x4e, 0x93, 0x83, 0x74, 0x10, 0xae, 0x43, 0xc5, 0x66, 0x28, 0xa1, 0x74, 0x52, 0x9c,
-	0x43, 0xb0, 0x3f, 0xec, 0x20, 0x1b, 0x00, 0x2e, 0x7d, 0x15, 0xfb, 0xef, 0x1a, 0xaa, 0xcd, 0xa3,
-	0x73, 0xb8, 0xfb, 0x78, 0x2e, 0x77, 0xe9, 0x2b, 0xe1, 0xcd, 0x75, 0x68, 0xd8, 0xae, 0x19, 0xd0,
-	0x21, 0x75, 0xd9, 0x0e, 0xdf, 0x43, 0x2e, 0x97, 0x16, 0x91, 0x0d, 0x90, 0x11, 0x30, 0xe2, 0x3b,
-	0x7a, 0x7d, 0xfa, 0x8e, 0x36, 0x50, 0x41, 0x5c, 0xd1, 0x1b, 0x20, 0xa3, 0xcb, 0xc2, 0x97, 0xb6,
-	0xef, 0x53, 0x4b, 0x59, 0x45, 0xa7, 0x35, 0x98, 0xec, 0x88, 0x8b, 0xc6, 0x18, 0xb5, 0x76, 0x0e,
-	0x46, 0xdd, 0x00, 0x99, 0xba, 0x46, 0xcf, 0xa1, 0x3a, 0xd7, 0x5f, 0xe7, 0xe6, 0x71, 0x19, 0x4f,
-	0x55, 0x8c, 0xa6, 0x1b, 0x4e, 0xa4, 0xdc, 0x10, 0x34, 0xdd, 0x70, 0x22, 0x46, 0x49, 0x7a, 0x46,
-	0x64, 0x1e, 0x2b, 0x2a, 0x7f, 0xc3, 0x61, 0x83, 0xe1, 0x55, 0x40, 0x8d, 0xd0, 0x73, 0x95, 0x9b,
-	0x1c, 0xaf, 0x78, 0xeb, 0xa0, 0x5c, 0x2f, 0xb7, 0x2b, 0x07, 0xe5, 0x7a, 0xa5, 0x5d, 0x55, 0x77,
-	0xa1, 0xca, 0x8f, 0x2d, 0xf7, 0xf9, 0x70, 0x3b, 0x4b, 0xe8, 0xda, 0x13, 0xc7, 0x1c, 0x07, 0xa0,
-	0xfa, 0x40, 0xd0, 0x6b, 0xc6, 0xad, 0xde, 0x87, 0x3a, 0xe6, 0x82, 0x31, 0xb3, 0x92, 0xe3, 0xa0,
-	0xc5, 0xb3, 0xa8, 0xbd, 0xe0, 0x1f, 0xea, 0x2a, 0xd4, 0x63, 0xe4, 0xca, 0x5b, 0x5c, 0xfd, 0xbe,
-	0x00, 0xcd, 0x58, 0x81, 0x33, 0xf7, 0xeb, 0xe2, 0x35, 0x53, 0x98, 0x0c, 0x81, 0xc9, 0x77, 0x58,
-	0x31, 0xf3, 0x0e, 0x8b, 0xb9, 0x7c, 0x29, 0x87, 0xcb, 0x97, 0x73, 0xb8, 0x7c, 0x25, 0xe5, 0x81,
-	0x35, 0x28, 0xb3, 0x07, 0x97, 0x80, 0xd0, 0xcc, 0x25, 0xc0, 0x0e, 0xf5, 0x17, 0x75, 0x90, 0xc7,
-	0x56, 0xf6, 0xbd, 0x0c, 0x4a, 0x17, 0x66, 0xa3, 0xf4, 0xe5, 0xe0, 0xff, 0xbf, 0x00, 0xcc, 0x80,
-	0x1a, 0x11, 0xb5, 0x74, 0x23, 0x12, 0x06, 0xcd, 0x82, 0x5d, 0x49, 0x68, 0x6f, 0x45, 0xe4, 0x4e,
-	0x7c, 0x8e, 0x35, 0x3c, 0x47, 0x92, 0x31, 0x28, 0x03, 0xa5, 0x37, 0x40, 0x0e, 0x28, 0x23, 0x91,
-	0x3a, 0x0d, 0x02, 0x2f, 0x40, 0x74, 0x97, 0xb4, 0x06, 0x97, 0xed, 0x31, 0x11, 0xf9, 0x12, 0x80,
-	0x1d, 0x30, 0xd2, 0x5e, 0x5e, 0x12, 0x68, 0x6c, 0xae, 0x67, 0x66, 0x64, 0x7e, 0x60, 0xe7, 0xbd,
-	0x83, 0x2a, 0xbc, 0xac, 0x21, 0xbd, 0x88, 0xdb, 0xb9, 0x70, 0x0d, 0x97, 0x81, 0x6b, 0x05, 0x6a,
-	0x31, 0x4a, 0x37, 0x38, 0xca, 0x89, 0xe6, 0x8f, 0x44, 0xdd, 0x76, 0x0e, 0xea, 0xf2, 0xf7, 0xd2,
-	0xc2, 0xd4, 0x7b, 0xe9, 0x09, 0x2c, 0x85, 0xa6, 0xe1, 0x50, 0x9d, 0x11, 0x2e, 0x3d, 0x3a, 0x0e,
-	0x68, 0x78, 0xec, 0x39, 0x96, 0x80, 0xe5, 0x19, 0x94, 0x96, 0xe0, 0xb0, 0x5d, 0xef, 0x95, 0xfb,
-	0x2c, 0x1e, 0x34, 0x0d, 0x8b, 0x8b, 0x97, 0x84, 0xc5, 0xa5, 0xb3, 0x60, 0x71, 0x1d, 0x1a, 0x16,
-	0x0d, 0xcd, 0xc0, 0xf6, 0xd9, 0xe2, 0xca, 0x32, 0x3f, 0xc6, 0x94, 0x68, 0x12, 0x08, 0x57, 0xa6,
-	0x81, 0xf0, 0x3a, 0x80, 0x69, 0x98, 0xc7, 0x82, 0x30, 0x5d, 0xe1, 0xf5, 0x32, 0x94, 0x30, 0xc2,
-	0x34, 0x85, 0x55, 0xca, 0xd9, 0x58, 0x75, 0x35, 0x85, 0x55, 0xab, 0x6c, 0x56, 0xdf, 0xe8, 0xd9,
-	0x8e, 0x1d, 0x9d, 0x22, 0xae, 0x4b, 0x5a, 0x4a, 0x32, 0xc6, 0xb2, 0x6b, 0xf9, 0x58, 0xf6, 0x5e,
-	0x1a, 0xcb, 0xc8, 0xbf, 0x41, 0x6b, 0x68, 0xbc, 0xd6, 0x53, 0xc4, 0xee, 0x3a, 0xc2, 0xaf, 0x3c,
-	0x34, 0x5e, 0xff, 0x6f, 0xcc, 0xed, 0xd2, 0x49, 0x7b, 0x75, 0x46, 0xd2, 0xee, 0x7c, 0x0e, 0xad,
-	0xec, 0xb5, 0x4d, 0x57, 0xb3, 0x2a, 0x39, 0xd5, 0xac, 0x4a, 0xaa, 0x9a, 0x75, 0x50, 0xae, 0x97,
-	0xda, 0x65, 0x8e, 0xae, 0xea, 0xe3, 0x34, 0x76, 0x31, 0x58, 0x7c, 0x08, 0xcd, 0x84, 0x62, 0xa4,
-	0xb0, 0x71, 0x61, 0x2a, 0x70, 0x34, 0xd9, 0x4f, 0xb5, 0xd4, 0xef, 0x2b, 0xd0, 0xde, 0xc1, 0x40,
-	0x66, 0xcc, 0x8d, 0x7e, 0x3b, 0xa2, 0x61, 0x94, 0x05, 0x8e, 0xc2, 0x65, 0x78, 0x63, 0x71, 0x36,
-	0x22, 0xe5, 0x85, 0x66, 0xed, 0x32, 0xa1, 0x99, 0xf2, 0x74, 0xfd, 0x62, 0xf4, 0x48, 0x3a, 0x3b,
-	0x50, 0xf3, 0x68, 0x19, 0xe4, 0xd3, 0xb2, 0xa9, 0x98, 0x6e, 0x9c, 0xcf, 0xa4, 0xe4, 0x59, 0x4c,
-	0x2a, 0xcb, 0xa0, 0x9b, 0x67, 0x33, 0xe8, 0xa9, 0x18, 0x6e, 0x5d, 0x32, 0x86, 0xe7, 0x2f, 0x46,
-	0x6d, 0xda, 0x97, 0xa5, 0x36, 0x0b, 0xd3, 0x11, 0x3d, 0x19, 0xb2, 0xe4, 0xec, 0x90, 0x5d, 0xcc,
-	0xa3, 0x17, 0x4b, 0xa9, 0x90, 0xcc, 0x5c, 0xf7, 0x2e, 0x2c, 0xec, 0xbb, 0x6c, 0xf7, 0x51, 0xea,
-	0x96, 0xce, 0x7a, 0xf9, 0xac, 0x41, 0xa3, 0xe7, 0x78, 0xe6, 0x4b, 0x7d, 0xcc, 0x2f, 0xea, 0x1a,
-	0xa0, 0x08, 0xf3, 0x91, 0xfa, 0x9b, 0x02, 0xb4, 0x0e, 0xed, 0x30, 0x3d, 0xdf, 0x25, 0x32, 0xeb,
-	0x06, 0xc8, 0xe8, 0xc3, 0x98, 0xc3, 0x15, 0xe3, 0x22, 0x7a, 0x8a, 0xc3, 0xa1, 0x82, 0xe0, 0x70,
-	0x53, 0x0f, 0x93, 0xd2, 0x39, 0x0f, 0x13, 0x75, 0x03, 0xda, 0xbb, 0xd4, 0xa1, 0x99, 0xb0, 0x9c,
-	0xb1, 0x61, 0xf5, 0x03, 0x68, 0x1d, 0x45, 0x9e, 0x7f, 0x41, 0xed, 0x3f, 0x14, 0xa0, 0xf5, 0x98,
-	0x46, 0x87, 0xde, 0x20, 0xbc, 0x88, 0x37, 0x2f, 0x11, 0xe1, 0x31, 0x5b, 0xed, 0xdb, 0x4e, 0x44,
-	0x83, 0x10, 0x1f, 0xe4, 0x12, 0x67, 0xab, 0x8f, 0xb8, 0x08, 0xdf, 0xb9, 0x46, 0x18, 0xd1, 0x00,
-	0x99, 0x50, 0x5d, 0x13, 0xad, 0x71, 0x01, 0xb0, 0x7a, 0x46, 0x01, 0x50, 0x5c, 0x86, 0x3f, 0x16,
-	0x01, 0x0e, 0xbd, 0xc1, 0xff, 0xd0, 0x30, 0x34, 0x06, 0x58, 0x9e, 0x4f, 0xa2, 0x38, 0x45, 0xf2,
-	0x12, 0x98, 0x7b, 0xca, 0x78, 0xd6, 0xb8, 0x82, 0x50, 0x3a, 0xa7, 0x82, 0x50, 0x9e, 0x51, 0x41,
-	0xb8, 0x07, 0xc5, 0xa4, 0x10, 0x30, 0x8b, 0x1a, 0x15, 0xa3, 0x90, 0x91, 0x88, 0x21, 0xb7, 0x10,
-	0xf7, 0x23, 0x69, 0x71, 0x33, 0x5b, 0xf8, 0xa8, 0xcd, 0x2c, 0x7c, 0x10, 0x28, 0x8f, 0x42, 0xca,
-	0x69, 0x52, 0x5d, 0xc3, 0x6f, 0x72, 0x1b, 0xea, 0xa2, 0xb8, 0x68, 0x21, 0xb8, 0x49, 0xdb, 0x8d,
-	0xb7, 0x6f, 0xd6, 0x6a, 0xbc, 0xb2, 0xb8, 0xab, 0xd5, 0x78, 0x5d, 0xd1, 0x4a, 0xb9, 0x19, 0xd2,
-	0x6e, 0x56, 0x9f, 0xc1, 0xa2, 0xc6, 0x1f, 0x6d, 0xdc, 0xb7, 0x17, 0x38, 0xff, 0xc9, 0x43, 0x2d,
-	0x4e, 0x1d, 0xaa, 0xfa, 0x9f, 0xb0, 0x28, 0x22, 0x34, 0x33, 0xeb, 0xb9, 0x45, 0x5d, 0x55, 0x87,
-	0x36, 0x8b, 0xc3, 0x0b, 0xdb, 0x72, 0x0d, 0x24, 0xdf, 0x18, 0x88, 0x64, 0x5c, 0xc4, 0x64, 0x5c,
-	0x67, 0x02, 0x4c, 0xc4, 0x58, 0xb6, 0x1e, 0x50, 0x51, 0x2b, 0xc1, 0x6f, 0xf5, 0x14, 0x16, 0x52,
-	0x0b, 0x84, 0xbe, 0xe7, 0x86, 0x58, 0x28, 0x1b, 0x57, 0x68, 0xc3, 0x33, 0x4a, 0xb4, 0x60, 0x8d,
-	0x4b, 0xba, 0x6b, 0xd0, 0xc0, 0x37, 0xab, 0xce, 0xe6, 0x0c, 0xc5, 0xc2, 0x80, 0xa2, 0x2e, 0x93,
-	0xe4, 0x2e, 0xfd, 0xe7, 0x0a, 0x2c, 0xf3, 0xe4, 0x9a, 0x44, 0xca, 0xe5, 0xb1, 0xe6, 0x72, 0x2c,
-	0x7e, 0x05, 0xaa, 0x23, 0xdf, 0x62, 0x98, 0x27, 0x82, 0x8b, 0xb7, 0xde, 0x3d, 0xf3, 0x5e, 0x28,
-	0xa3, 0x4e, 0xa5, 0x49, 0xc8, 0x49, 0x93, 0x67, 0x51, 0xdc, 0xc6, 0xbf, 0x84, 0xe2, 0xca, 0x97,
-	0x4c, 0x8f, 0xcd, 0x0b, 0x52, 0xdc, 0xd6, 0xb9, 0x14, 0x77, 0xfe, 0x3c, 0x8a, 0xdb, 0x3e, 0x8f,
-	0xe2, 0x2e, 0x4c, 0xe7, 0xcb, 0xf7, 0x40, 0x0a, 0xa8, 0x28, 0xaa, 0x88, 0x7c, 0x3a, 0x16, 0x8c,
-	0x33, 0xe7, 0x62, 0x9a, 0xcc, 0x4e, 0x93, 0xd6, 0xa5, 0xd9, 0xa4, 0x75, 0x79, 0x06, 0x95, 0xca,
-	0xe4, 0xe1, 0x1d, 0x58, 0x11, 0x51, 0xfe, 0xe3, 0x2f, 0xb4, 0xba, 0x0c, 0x8b, 0x2c, 0x20, 0x27,
-	0x66, 0x50, 0x7f, 0x59, 0x80, 0x65, 0x9e, 0xf2, 0xde, 0x21, 0x58, 0xd6, 0xd8, 0xa1, 0xb1, 0x39,
-	0x18, 0xad, 0x0a, 0xe3, 0xbc, 0x6f, 0xc5, 0x99, 0x34, 0x4c, 0x29, 0x20, 0x47, 0x2b, 0xa5, 0x15,
-	0x90, 0x98, 0xb5, 0xa1, 0x64, 0x38, 0x8e, 0x78, 0xb8, 0xb3, 0x4f, 0x75, 0x0b, 0x96, 0x8e, 0x18,
-	0x5c, 0xbe, 0xc3, 0x96, 0xbf, 0x82, 0x45, 0x96, 0x9d, 0xdf, 0x61, 0x86, 0x9f, 0x14, 0x60, 0x49,
-	0xa3, 0xc1, 0xc8, 0x7d, 0x07, 0xe7, 0xdc, 0x82, 0x1a, 0x7d, 0x6d, 0x3a, 0x23, 0x8b, 0xe6, 0x11,
-	0x96, 0xb8, 0x8f, 0xa9, 0xd9, 0x2e, 0x57, 0x2b, 0xe5, 0xa8, 0x89, 0x3e, 0xf5, 0x0a, 0x2c, 0x3f,
-	0x36, 0x82, 0x9e, 0x31, 0xa0, 0x3b, 0x9e, 0xe3, 0x50, 0x33, 0x8a, 0x0f, 0x52, 0x81, 0x95, 0xc9,
-	0x0e, 0x8e, 0xba, 0xf7, 0x74, 0xac, 0xe3, 0xf0, 0x1f, 0xd5, 0xda, 0x20, 0x1f, 0x7c, 0xbd, 0xad,
-	0x1f, 0x3d, 0xdb, 0xd2, 0x9e, 0xed, 0x3f, 0x7d, 0xdc, 0x9e, 0x23, 0xf3, 0xd0, 0x60, 0x12, 0xed,
-	0xf9, 0xd3, 0xa7, 0x4c, 0x50, 0x88, 0x05, 0x8f, 0xb6, 0xf6, 0x0f, 0x9f, 0x6b, 0x7b, 0xed, 0x62,
-	0x2c, 0x38, 0x7a, 0xbe, 0xb3, 0xb3, 0x77, 0x74, 0xd4, 0x2e, 0x91, 0x16, 0x00, 0x13, 0x3c, 0xd9,
-	0x3f, 0x3c, 0xdc, 0xdb, 0x6d, 0x97, 0xef, 0x7d, 0x25, 0x7e, 0x86, 0xe3, 0x4b, 0x00, 0x54, 0xd9,
-	0xd8, 0xbd, 0xdd, 0xf6, 0x1c, 0x69, 0x40, 0x2d, 0x1e, 0x56, 0xc0, 0xc6, 0x93, 0xfd, 0x6e, 0x77,
-	0x6f, 0xb7, 0x5d, 0x24, 0x32, 0xd4, 0x13, 0x23, 0x4a, 0xf7, 0xbe, 0x84, 0x46, 0xaa, 0x00, 0xc5,
-	0x56, 0xec, 0x7e, 0xbd, 0x9b, 0xd8, 0x34, 0x17, 0x0b, 0xc6, 0x73, 0xb5, 0x00, 0x98, 0x40, 0x2c,
-	0x54, 0xbc, 0xf7, 0xff, 0xa9, 0xb2, 0x12, 0x9f, 0x63, 0x19, 0x16, 0xba, 0xfb, 0xdd, 0xbd, 0xc3,
-	0xfd, 0xa7, 0x7b, 0xe9, 0xed, 0x2e, 0x41, 0x3b, 0x11, 0x8f, 0xf7, 0x7c, 0x05, 0x16, 0xc7, 0xd2,
-	0xbd, 0x44, 0xbd, 0x98, 0x51, 0x8f, 0x3d, 0x52, 0x22, 0x8b, 0x30, 0x9f, 0x48, 0xbb, 0x5b, 0xcf,
-	0x8f, 0x98, 0x17, 0x36, 0xff, 0x5e, 0x87, 0xd2, 0x56, 0x77, 0x9f, 0x6c, 0x80, 0x94, 0x3c, 0xed,
-	0xc8, 0xb2, 0xf8, 0xe1, 0x3a, 0xfb, 0xd4, 0xeb, 0x24, 0xd9, 0x55, 0x9d, 0x23, 0x1f, 0x03, 0x8c,
-	0x59, 0x36, 0x59, 0x11, 0x90, 0x38, 0x41, 0xbb, 0x3b, 0x99, 0x72, 0x9b, 0x3a, 0x47, 0xee, 0x43,
-	0x4d, 0x10, 0x69, 0xb2, 0x88, 0x5d, 0x59, 0x5a, 0xdd, 0x69, 0xa6, 0xf5, 0x43, 0x75, 0x8e, 0x7c,
-	0x0e, 0x52, 0x42, 0x6d, 0x85, 0x59, 0x93, 0x54, 0xb7, 0xb3, 0x32, 0x95, 0x05, 0xf6, 0x86, 0x7e,
-	0x74, 0xaa, 0xce, 0x91, 0x4f, 0xa0, 0x26, 0x88, 0xae, 0x58, 0x2e, 0x4b, 0x7b, 0x67, 0x8c, 0xfc,
-	0x14, 0xe4, 0x34, 0x45, 0x21, 0x4a, 0x7a, 0x83, 0x69, 0xfe, 0xd1, 0x99, 0x20, 0x02, 0xdc, 0xe6,
-	0x84, 0x44, 0x08, 0x9b, 0x27, 0x59, 0x4b, 0x67, 0x65, 0x52, 0xcc, 0x6f, 0xbd, 0x3a, 0x47, 0xb6,
-	0xf1, 0xb7, 0x9f, 0x84, 0x72, 0x89, 0x95, 0x73, 0x58, 0xd8, 0x0c, 0xeb, 0x1f, 0x41, 0x2b, 0x4b,
-	0x25, 0x48, 0x27, 0x75, 0xa2, 0x13, 0xa8, 0x30, 0x63, 0x9e, 0x1d, 0x98, 0x9f, 0x80, 0x70, 0x72,
-	0x2d, 0xed, 0x88, 0xc9, 0x99, 0xa6, 0x2b, 0x08, 0xea, 0x1c, 0xf9, 0x02, 0xe4, 0x34, 0x84, 0x8b,
-	0x0d, 0xe5, 0xa0, 0x7a, 0x87, 0x4c, 0x0d, 0x0f, 0xf9, 0x66, 0xb2, 0x50, 0x2f, 0x36, 0x93, 0x8b,
-	0xff, 0x33, 0x36, 0xb3, 0x0b, 0xcd, 0x0c, 0x34, 0x93, 0xab, 0xe2, 0x4a, 0x4c, 0xc3, 0xf5, 0x8c,
-	0x59, 0xb6, 0x41, 0x4e, 0xa3, 0xb3, 0xd8, 0x4d, 0x0e, 0x60, 0xcf, 0xb6, 0x24, 0x03, 0xcf, 0xc2,
-	0x92, 0x3c, 0xc8, 0x9e, 0x31, 0xcb, 0x7f, 0xc7, 0xa1, 0xb1, 0xe5, 0x38, 0xe4, 0x0c, 0xb5, 0x19,
-	0xc3, 0x1f, 0x40, 0x4d, 0xbc, 0xea, 0x44, 0x6c, 0x64, 0xdf, 0x78, 0x1d, 0xfe, 0x1f, 0x13, 0xe3,
-	0xb7, 0x93, 0x3a, 0xf7, 0x61, 0x81, 0x3c, 0x81, 0x56, 0x16, 0xae, 0xc5, 0x59, 0xe4, 0x82, 0x7b,
-	0xe7, 0x5a, 0x6e, 0x5f, 0x7c, 0xd3, 0xb7, 0xdb, 0x3f, 0xbc, 0x5d, 0x2d, 0xfc, 0xe5, 0xed, 0x6a,
-	0xe1, 0xaf, 0x6f, 0x57, 0x0b, 0xbf, 0xfa, 0xdb, 0xea, 0x5c, 0xaf, 0x8a, 0x56, 0x3e, 0xf8, 0x67,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0xf8, 0xc9, 0x91, 0x3c, 0xe1, 0x27, 0x00, 0x00,
+	// 5961 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x7c, 0xcb, 0x6f, 0x1b, 0x59,
+	0x76, 0xb7, 0xf8, 0x12, 0xc9, 0x43, 0x8a, 0xa4, 0xae, 0x25, 0x99, 0xa6, 0xdb, 0xb6, 0x5c, 0xdd,
+	0x7e, 0xb4, 0xa6, 0x47, 0xdd, 0xed, 0xf6, 0xf4, 0xcc, 0x74, 0xf7, 0x4c, 0x8f, 0x2c, 0xd2, 0x1e,
+	0xb9, 0xf5, 0xb9, 0x39, 0x25, 0x79, 0x06, 0x5f, 0x10, 0xa4, 0x50, 0x24, 0x2f, 0xa5, 0xb2, 0x8b,
+	0x55, 0x35, 0x55, 0x45, 0xd9, 0xea, 0x45, 0x12, 0x24, 0xab, 0x64, 0x13, 0x24, 0x01, 0x82, 0x99,
+	0x00, 0x59, 0x25, 0x9b, 0x04, 0x59, 0x64, 0x3b, 0x41, 0xb2, 0x0c, 0x32, 0x08, 0x12, 0x60, 0x36,
+	0x09, 0xb2, 0x6a, 0x04, 0x0e, 0xb2, 0xcb, 0x9f, 0x90, 0x00, 0xc1, 0xb9, 0x8f, 0xaa, 0x5b, 0xc5,
+	0x12, 0x29, 0xb5, 0x27, 0x59, 0x08, 0xa8, 0x7b, 0xce, 0xb9, 0xaf, 0x73, 0xef, 0x3d, 0x8f, 0xdf,
+	0xbd, 0x14, 0xac, 0x0d, 0x6d, 0x8b, 0x3a, 0xe1, 0xbb, 0x9e, 0x17, 0xe0, 0xdf, 0xb6, 0xe7, 0xbb,
+	0xa1, 0x4b, 0x0a, 0x9e, 0x17, 0x74, 0xae, 0x1e, 0xb9, 0xee, 0x91, 0x4d, 0xdf, 0x65, 0xa4, 0xc1,
+	0x74, 0xfc, 0x2e, 0x9d, 0x78, 0xe1, 0x29, 0x97, 0xe8, 0xdc, 0x48, 0x33, 0x43, 0x6b, 0x42, 0x83,
+	0xd0, 0x9c, 0x78, 0x42, 0xe0, 0x7a, 0x5a, 0x60, 0x34, 0xf5, 0xcd, 0xd0, 0x72, 0x1d, 0xc1, 0x5f,
+	0x3b, 0x72, 0x8f, 0x5c, 0xf6, 0xf9, 0x2e, 0x7e, 0x49, 0xaa, 0x1c, 0xce, 0x38, 0xc0, 0x3f, 0x4e,
+	0xd5, 0xc6, 0xb0, 0x7c, 0x40, 0x87, 0x3e, 0x0d, 0x09, 0x81, 0xa2, 0x63, 0x4e, 0x68, 0x3b, 0xb7,
+	0x99, 0xbb, 0x5b, 0xd5, 0xd9, 0x37, 0xb9, 0x06, 0x30, 0x71, 0xa7, 0x4e, 0x68, 0x78, 0x66, 0x78,
+	0xdc, 0xce, 0x33, 0x4e, 0x95, 0x51, 0xfa, 0x66, 0x78, 0x4c, 0x2e, 0x43, 0x99, 0x3a, 0x27, 0xc6,
+	0x89, 0xe9, 0xb7, 0x0b, 0x8c, 0xb7, 0x4c, 0x9d, 0x93, 0x1f, 0x9a, 0x3e, 0x69, 0x41, 0xe1, 0x39,
+	0x3d, 0x6d, 0x17, 0x19, 0x11, 0x3f, 0xb5, 0xbf, 0xcb, 0x43, 0xf5, 0xd0, 0x37, 0x9d, 0x60, 0xec,
+	0xfa, 0x13, 0xb2, 0x06, 0x25, 0x6b, 0x62, 0x1e, 0xc9, 0xce, 0x78, 0x01, 0x6b, 0x0d, 0x27, 0xa3,
+	0x76, 0x7e, 0xb3, 0x80, 0xb5, 0x86, 0x93, 0x11, 0x79, 0x1b, 0x0a, 0xd4, 0x39, 0x69, 0x17, 0x36,
+	0x0b, 0x77, 0x6b, 0xf7, 0x2e, 0x6f, 0xa3, 0x16, 0xa3, 0x46, 0xb6, 0x7b, 0xce, 0x49, 0xcf, 0x09,
+	0xfd, 0x53, 0x1d, 0x65, 0xc8, 0x2d, 0x28, 0x07, 0x6c, 0x22, 0x41, 0xbb, 0xc8, 0xc4, 0x6b, 0x4c,
+	0x9c, 0x4f, 0x4e, 0x97, 0x3c, 0xec, 0x39, 0x08, 0x47, 0x96, 0xd3, 0x2e, 0xb1, 0x5e, 0x78, 0x81,
+	0xbc, 0x03, 0xc4, 0x1c, 0x0e, 0xa9, 0x17, 0x1a, 0x3e, 0x0d, 0xa7, 0xbe, 0x63, 0x0c, 0xdd, 0x11,
+	0x6d, 0x2f, 0x6f, 0x16, 0xee, 0x16, 0xf4, 0x16, 0xe7, 0xe8, 0x8c, 0xb1, 0xeb, 0x8e, 0x28, 0xb6,
+	0x31, 0xa2, 0x83, 0xe9, 0x51, 0xbb, 0xbc, 0x99, 0xbb, 0x5b, 0xd1, 0x79, 0x01, 0xdb, 0x60, 0xd3,
+	0x30, 0xbc, 0xa9, 0x6d, 0x1b, 0x72, 0x2c, 0x55, 0xd6, 0x4d, 0x8b, 0x71, 0xfa, 0x53, 0xdb, 0xe6,
+	0xe3, 0x09, 0x3a, 0x1f, 0x42, 0x45, 0x8e, 0x5f, 0x6a, 0x2b, 0x17, 0x69, 0x0b, 0x7b, 0x38, 0x31,
+	0xed, 0x29, 0x15, 0x2a, 0xe7, 0x85, 0x8f, 0xf2, 0xdf, 0xca, 0x69, 0xf7, 0x61, 0xb9, 0x77, 0xe4,
+	0xd3, 0x20, 0xc0, 0x5a, 0x4f, 0xf5, 0x7d, 0x59, 0xeb, 0xa9, 0xbe, 0x4f, 0xda, 0x50, 0xf6, 0x69,
+	0xe8, 0x5b, 0x34, 0x60, 0xf5, 0x0a, 0xba, 0x2c, 0x6a, 0xff, 0x91, 0x07, 0xe0, 0xd5, 0xf6, 0x9c,
+	0xb1, 0x4b, 0x6e, 0xa3, 0x12, 0xcc, 0x90, 0xab, 0xbf, 0x71, 0xaf, 0xc5, 0x34, 0xc5, 0xf9, 0x07,
+	0x48, 0xd7, 0x39, 0x9b, 0x6c, 0xc0, 0xb2, 0x4f, 0xcd, 0xc0, 0x75, 0xc4, 0x38, 0x44, 0x49, 0x76,
+	0x5d, 0x88, 0xbb, 0x7e, 0x07, 0x6a, 0x03, 0x33, 0xa0, 0xc6, 0xd0, 0x9d, 0x4c, 0xac, 0x90, 0x2d,
+	0x3c, 0x5b, 0x81, 0x71, 0xb0, 0xbd, 0xcb, 0x48, 0x3a, 0x20, 0x9f, 0x7f, 0x93, 0x9b, 0x50, 0x1f,
+	0x5b, 0x36, 0x0d, 0x0c, 0x6f, 0x1a, 0x1c, 0xd3, 0x51, 0xbb, 0xc4, 0x46, 0x5b, 0x63, 0xb4, 0x3e,
+	0x23, 0x91, 0x37, 0x61, 0x85, 0x8b, 0x8c, 0xa8, 0x4d, 0x43, 0x3a, 0x6a, 0x2f, 0x33, 0x19, 0x5e,
+	0xaf, 0xcb, 0x69, 0xd8, 0xce, 0xe0, 0x34, 0x8c, 0xdb, 0xc1, 0xf5, 0x28, 0xea, 0x35, 0x46, 0x13,
+	0xed, 0xdc, 0x87, 0x72, 0x10, 0x9a, 0x3e, 0xb6, 0x50, 0x61, 0x83, 0xea, 0x6c, 0xf3, 0xd3, 0xb3,
+	0x2d, 0x4f, 0xcf, 0xf6, 0xa1, 0x3c, 0x5e, 0xba, 0x14, 0x25, 0x1f, 0x42, 0x65, 0x6c, 0x39, 0x16,
+	0x6b, 0xb4, 0xba, 0xb0, 0x5a, 0x24, 0xab, 0x5d, 0x83, 0xc2, 0x63, 0x77, 0x40, 0x36, 0x20, 0x6f,
+	0x8d, 0xf8, 0xca, 0x3c, 0x58, 0x7e, 0xf5, 0xe5, 0x8d, 0xfc, 0x5e, 0x57, 0xcf, 0x5b, 0x23, 0xed,
+	0xcf, 0x72, 0x50, 0x3a, 0xf0, 0xdc, 0x69, 0x48, 0xde, 0x80, 0xaa, 0x7b, 0x42, 0xfd, 0x17, 0xbe,
+	0x25, 0x56, 0xa1, 0xa2, 0xc7, 0x04, 0x9c, 0x17, 0x57, 0xa4, 0xc1, 0xa6, 0x22, 0x56, 0xb3, 0xc6,
+	0x69, 0x0f, 0x90, 0x44, 0x1e, 0x40, 0x53, 0x88, 0x58, 0x4e, 0x48, 0xfd, 0x13, 0xd3, 0x66, 0xcb,
+	0x51, 0xbb, 0x77, 0x65, 0x66, 0xa0, 0x5d, 0x61, 0x1d, 0xf4, 0x06, 0xaf, 0xb1, 0x27, 0x2a, 0xe0,
+	0xf2, 0x4e, 0x4c, 0xff, 0x39, 0xf5, 0xc5, 0x41, 0x15, 0x25, 0xed, 0x9f, 0x72, 0x50, 0x3e, 0xa0,
+	0xfe, 0x89, 0x35, 0xa4, 0xb8, 0x0e, 0xac, 0x03, 0xc7, 0xb4, 0x0d, 0xcf, 0xf5, 0x43, 0x36, 0xd8,
+	0x92, 0x5e, 0x97, 0xc4, 0xbe, 0xeb, 0x87, 0x28, 0x44, 0x5f, 0xaa, 0x42, 0x79, 0x2e, 0x44, 0x5f,
+	0x2a, 0x42, 0xb7, 0xa1, 0x84, 0xbc, 0x40, 0x9c, 0xe6, 0x96, 0x38, 0x9e, 0xac, 0x1b, 0x14, 0xd0,
+	0x39, 0x1b, 0xed, 0x50, 0x78, 0xea, 0x51, 0x31, 0x26, 0xf6, 0x4d, 0x3e, 0x81, 0xa6, 0x4f, 0xcd,
+	0x91, 0xe5, 0xd0, 0x20, 0x30, 0x3c, 0xdf, 0x1d, 0x50, 0xb6, 0x67, 0x6a, 0xf7, 0x2e, 0xb1, 0x56,
+	0x74, 0xc9, 0xeb, 0x23, 0x4b, 0x6f, 0xf8, 0x89, 0xb2, 0xf6, 0x1c, 0x6a, 0x4a, 0x3f, 0x99, 0x86,
+	0x6e, 0x66, 0x9a, 0xf9, 0xf3, 0x4c, 0xb3, 0x30, 0x3b, 0x4d, 0xed, 0x2f, 0xf2, 0xd0, 0x48, 0x8e,
+	0x87, 0x5c, 0x85, 0xea, 0x71, 0x18, 0x7a, 0xdc, 0x88, 0xf2, 0x5e, 0x2b, 0x48, 0x60, 0x36, 0x94,
+	0x40, 0x51, 0xe9, 0x90, 0x7d, 0x23, 0x8d, 0xbe, 0xa4, 0x43, 0xa6, 0xa9, 0xaa, 0xce, 0xbe, 0xc9,
+	0x77, 0x71, 0x84, 0x56, 0x68, 0x99, 0x36, 0x1e, 0x09, 0xf3, 0xb4, 0x5d, 0x5c, 0xb4, 0xdc, 0x75,
+	0x21, 0xdf, 0x45, 0x71, 0xf2, 0x3e, 0x2c, 0x7b, 0xd4, 0xb7, 0xdc, 0x51, 0xbb, 0xb4, 0xa8, 0xa2,
+	0x10, 0x24, 0x1f, 0x40, 0x19, 0x5d, 0x8f, 0x3b, 0x0d, 0xdb, 0xcb, 0x8b, 0xea, 0x48, 0x49, 0xf2,
+	0x35, 0x58, 0x1d, 0x9b, 0x96, 0x3d, 0xf5, 0xa9, 0x11, 0x1e, 0xfb, 0x34, 0x38, 0x76, 0x6d, 0x7e,
+	0x30, 0x4b, 0x7a, 0x4b, 0x30, 0x0e, 0x25, 0x5d, 0xfb, 0xe7, 0x1c, 0xac, 0x88, 0xa5, 0x41, 0xc3,
+	0x33, 0x65, 0x56, 0xed, 0x99, 0x3b, 0x90, 0x56, 0xed, 0x99, 0x3b, 0x40, 0xc3, 0xce, 0xf7, 0x6d,
+	0xc0, 0x3c, 0x43, 0xca, 0xac, 0x48, 0x1e, 0xb9, 0x01, 0x35, 0x8f, 0x3a, 0x23, 0xcb, 0x39, 0x32,
+	0xb0, 0x01, 0x6e, 0x9b, 0x40, 0x90, 0xf0, 0x50, 0xde, 0x87, 0xa6, 0x14, 0x90, 0xed, 0x15, 0x67,
+	0xdb, 0x6b, 0x08, 0x99, 0x5d, 0xd1, 0xec, 0x7d, 0x28, 0x4f, 0xbd, 0x91, 0x19, 0x52, 0xa9, 0xb7,
+	0xb9, 0xf6, 0x43, 0x88, 0x6a, 0x7f, 0x99, 0x83, 0xea, 0x4e, 0xe8, 0x4e, 0xf6, 0x1c, 0x6f, 0x9a,
+	0xbd, 0xe1, 0x08, 0x14, 0x7d, 0xea, 0xb9, 0xc2, 0xb0, 0xb2, 0x6f, 0x3c, 0x8f, 0x03, 0xdf, 0x74,
+	0x86, 0xc7, 0xd2, 0x9b, 0xf2, 0x12, 0xd2, 0x15, 0xbb, 0x5a, 0xd5, 0x45, 0x09, 0xdb, 0x38, 0xb2,
+	0xdd, 0x01, 0x1b, 0x58, 0x55, 0x67, 0xdf, 0x48, 0xb3, 0xcd, 0x2f, 0x4e, 0xd9, 0x82, 0x55, 0x74,
+	0xf6, 0x8d, 0xaa, 0x19, 0xfb, 0xee, 0x44, 0x1a, 0xe7, 0x32, 0x57, 0x0d, 0x92, 0xf8, 0x2c, 0xb5,
+	0xdf, 0xcf, 0x41, 0x75, 0xd7, 0x77, 0x9d, 0x0b, 0x0f, 0x57, 0xb4, 0x58, 0x48, 0x0f, 0x2b, 0xf0,
+	0xe8, 0x50, 0x1e, 0x60, 0xfc, 0x26, 0xef, 0x31, 0x8f, 0xe3, 0x87, 0xe7, 0x50, 0x22, 0x17, 0xd4,
+	0xfe, 0x30, 0x07, 0x25, 0x3e, 0x1e, 0x0d, 0x8a, 0x66, 0xe8, 0x4e, 0xd8, 0x78, 0x6a, 0xf7, 0x1a,
+	0xec, 0xc4, 0x47, 0xca, 0xd5, 0x19, 0x8f, 0x6c, 0x42, 0x69, 0xe8, 0xbb, 0x81, 0xdc, 0x22, 0xc0,
+	0x84, 0xb8, 0x00, 0x67, 0xa0, 0xc4, 0xd4, 0xb1, 0x5c, 0xa7, 0x5d, 0x98, 0x95, 0x60, 0x0c, 0xec,
+	0x67, 0xe8, 0xbb, 0x4e, 0xbb, 0xa8, 0xf4, 0x13, 0x69, 0x45, 0x67, 0x3c, 0xed, 0x39, 0x54, 0x1e,
+	0xbb, 0x03, 0x3e, 0xae, 0x37, 0xa3, 0xf9, 0xe7, 0x66, 0xdd, 0x5d, 0x7a, 0x8d, 0xf2, 0x19, 0x6b,
+	0x54, 0x50, 0xd6, 0x48, 0x2a, 0xbd, 0x18, 0x2b, 0x5d, 0x7b, 0x0a, 0xcd, 0xbe, 0xe9, 0x9b, 0xb6,
+	0x4d, 0x6d, 0x2b, 0x98, 0x1c, 0xa0, 0x1e, 0x3b, 0x50, 0x19, 0xba, 0x4e, 0x10, 0x9a, 0x0e, 0xb7,
+	0x18, 0x45, 0x3d, 0x2a, 0x93, 0x4d, 0xa8, 0x0d, 0x5d, 0x3a, 0x1e, 0x5b, 0x43, 0x8c, 0xf3, 0x58,
+	0xeb, 0x39, 0x5d, 0x25, 0x3d, 0x2e, 0x56, 0x72, 0xad, 0xbc, 0xf6, 0x01, 0x54, 0xd9, 0x04, 0x1e,
+	0x5a, 0x36, 0x5b, 0x58, 0x66, 0x96, 0x44, 0xbf, 0x9e, 0x30, 0x49, 0xc7, 0x66, 0x70, 0xcc, 0xd6,
+	0xaa, 0xae, 0xb3, 0x6f, 0xed, 0x63, 0x28, 0x75, 0xcd, 0x70, 0x3a, 0x39, 0xcb, 0xb7, 0x91, 0x0e,
+	0x3f, 0xb8, 0x79, 0xa6, 0x8a, 0x0a, 0x53, 0xde, 0x63, 0x77, 0xc0, 0x8e, 0xb0, 0xf6, 0xf3, 0x1c,
+	0x54, 0x59, 0x6d, 0x16, 0x7d, 0x6c, 0x42, 0x69, 0x84, 0x05, 0xa1, 0x36, 0xbe, 0x12, 0x8c, 0xad,
+	0x73, 0x06, 0xb9, 0x25, 0xe3, 0x93, 0x3c, 0x8b, 0x4f, 0x9a, 0xb1, 0x44, 0x22, 0x3c, 0xb9, 0xc3,
+	0xc5, 0x02, 0xe1, 0xf9, 0x56, 0x99, 0x58, 0xdf, 0x77, 0x87, 0x22, 0x8e, 0x09, 0xb8, 0x60, 0x40,
+	0x6e, 0x43, 0xd5, 0x1b, 0x07, 0x06, 0x6f, 0x93, 0x2f, 0x6f, 0x95, 0x2d, 0x16, 0xaa, 0x40, 0xaf,
+	0x78, 0x63, 0x26, 0x8e, 0x7e, 0xb7, 0x38, 0x32, 0x43, 0x93, 0xc5, 0x86, 0xb5, 0x7b, 0x2b, 0x91,
+	0x08, 0x0e, 0x5b, 0x67, 0x2c, 0xed, 0x63, 0x80, 0x68, 0x26, 0x01, 0xf9, 0x3a, 0x00, 0x1b, 0xb1,
+	0x61, 0x39, 0x63, 0xb7, 0x9d, 0xdb, 0x2c, 0x44, 0x1b, 0x27, 0x12, 0xd2, 0xab, 0x23, 0xf9, 0xa9,
+	0xfd, 0x15, 0x9a, 0x85, 0xa3, 0x23, 0x9f, 0x1e, 0x61, 0x6f, 0x6b, 0x50, 0x1a, 0x62, 0x28, 0xcd,
+	0xf4, 0x50, 0xd0, 0x79, 0x01, 0x95, 0x3f, 0xa1, 0x26, 0x8f, 0xb8, 0x72, 0x3a, 0xfb, 0xc6, 0x93,
+	0x16, 0x84, 0xa3, 0x11, 0x3d, 0x11, 0x8b, 0x2a, 0x4a, 0xe4, 0x6d, 0x68, 0x8d, 0xad, 0x71, 0x78,
+	0x6c, 0x78, 0xd4, 0x1f, 0x52, 0x27, 0xb4, 0x6c, 0x3e, 0xbd, 0x9c, 0xde, 0x64, 0xf4, 0x7e, 0x44,
+	0x26, 0x1f, 0xc2, 0x65, 0xc7, 0x72, 0x68, 0x78, 0x6a, 0xcc, 0xd4, 0x28, 0xb1, 0x1a, 0xeb, 0x9c,
+	0xfd, 0x30, 0x59, 0x4f, 0xfb, 0x83, 0x3c, 0xd4, 0x55, 0x95, 0xa2, 0x1f, 0x1a, 0xb9, 0x2f, 0x1c,
+	0xdb, 0x35, 0x47, 0x06, 0xda, 0xfc, 0x76, 0x6e, 0x91, 0x6b, 0xa8, 0x4b, 0x79, 0x3c, 0xe3, 0xe4,
+	0x13, 0xa8, 0x7b, 0xbc, 0x3d, 0x5e, 0x3d, 0xbf, 0xa8, 0x7a, 0x4d, 0x88, 0xb3, 0xda, 0x1f, 0x41,
+	0x6d, 0xea, 0xc5, 0x7d, 0x2f, 0x0c, 0x79, 0x80, 0x4b, 0xb3, 0xba, 0xb7, 0xa0, 0x11, 0x8d, 0x9c,
+	0xc7, 0x55, 0x45, 0x76, 0x82, 0xa2, 0xf9, 0xf0, 0xc8, 0xea, 0x26, 0xd4, 0xa7, 0x9e, 0x22, 0x54,
+	0x62, 0x42, 0xa2, 0x5b, 0x26, 0xa2, 0xfd, 0x71, 0x1e, 0xd6, 0xa3, 0x75, 0x4c, 0x68, 0xe7, 0x83,
+	0x6c, 0xed, 0x08, 0xa3, 0x25, 0xab, 0xa4, 0x54, 0xf2, 0x7e, 0xa6, 0x4a, 0xd2, 0x75, 0x12, 0x7a,
+	0x78, 0x37, 0x4b, 0x0f, 0xe9, 0x1a, 0xea, 0xe4, 0xbf, 0x91, 0x39, 0xf9, 0xd9, 0x3a, 0x29, 0x65,
+	0xbc, 0x9f, 0xa1, 0x8c, 0x8c, 0xa1, 0xa9, 0xca, 0xf9, 0xef, 0x1c, 0xd4, 0x7f, 0xe4, 0x62, 0x20,
+	0x29, 0x5c, 0xfa, 0xdb, 0x50, 0x7d, 0xc1, 0xca, 0x46, 0x64, 0x38, 0xea, 0xaf, 0xbe, 0xbc, 0x51,
+	0xe1, 0x42, 0x7b, 0x5d, 0xbd, 0xc2, 0xd9, 0x7b, 0x23, 0xb2, 0x09, 0xcb, 0xcf, 0xdc, 0x01, 0xca,
+	0x31, 0x7b, 0xf9, 0xa0, 0xfa, 0xea, 0xcb, 0x1b, 0x25, 0x34, 0xb8, 0x5d, 0xbd, 0xf4, 0xcc, 0x1d,
+	0xec, 0x8d, 0xd0, 0x48, 0xb3, 0x23, 0x5a, 0x50, 0xce, 0x5a, 0x64, 0xcd, 0xf8, 0x19, 0x55, 0x63,
+	0xfe, 0xe2, 0xf9, 0x63, 0xfe, 0xc8, 0x9a, 0x94, 0x16, 0x58, 0x93, 0x6b, 0x00, 0x3f, 0x9e, 0xd2,
+	0x29, 0x35, 0x02, 0xeb, 0x0b, 0x2a, 0xf2, 0x92, 0x2a, 0xa3, 0x1c, 0x58, 0x5f, 0x50, 0xed, 0x31,
+	0xd4, 0x75, 0x1a, 0xb8, 0x53, 0x7f, 0x48, 0x99, 0xc9, 0xc6, 0xac, 0xd6, 0x9b, 0xb2, 0x89, 0xe7,
+	0x75, 0xfc, 0x64, 0x71, 0x37, 0x9d, 0xb8, 0xfe, 0xa9, 0x4c, 0xab, 0x78, 0x09, 0x25, 0x8f, 0xbc,
+	0x29, 0x5b, 0xcc, 0x82, 0x8e, 0x9f, 0xda, 0x31, 0xc0, 0xa1, 0x6b, 0x53, 0xbe, 0x99, 0x33, 0xf2,
+	0xc4, 0x0e, 0x54, 0x5c, 0x0f, 0xd9, 0xae, 0x2f, 0xda, 0x8a, 0xca, 0x71, 0x0e, 0x59, 0x50, 0x72,
+	0x48, 0xec, 0x9b, 0x8e, 0xc7, 0x74, 0x18, 0xc5, 0x12, 0xbc, 0xa4, 0xfd, 0x6b, 0x1e, 0x9a, 0x3b,
+	0xd3, 0xd0, 0x0d, 0x86, 0xa6, 0x6d, 0x39, 0x47, 0x6c, 0xe4, 0x37, 0xa0, 0x36, 0xb1, 0x1c, 0x83,
+	0xaf, 0x4e, 0x20, 0xcc, 0x14, 0x4c, 0x2c, 0x87, 0xaf, 0x1c, 0x8b, 0xb9, 0x26, 0xe6, 0xcb, 0x48,
+	0x20, 0x2f, 0x04, 0xcc, 0x97, 0x52, 0x60, 0x0b, 0x56, 0x43, 0xd3, 0x3f, 0xa2, 0xa1, 0xa1, 0x68,
+	0x8c, 0xcf, 0xaf, 0xc9, 0x19, 0x3f, 0x90, 0x7a, 0x23, 0xbd, 0x48, 0x76, 0xe4, 0x9b, 0x96, 0xc3,
+	0x37, 0xf6, 0xc2, 0x20, 0x57, 0x34, 0xd3, 0xc5, 0x2a, 0x6c, 0xa3, 0xf7, 0x60, 0x15, 0xe7, 0x40,
+	0x8d, 0xa9, 0x67, 0x0c, 0x5d, 0xd7, 0xc6, 0xfd, 0xbc, 0x38, 0xe4, 0x6d, 0xb2, 0x3a, 0x4f, 0xbd,
+	0x5d, 0x51, 0x83, 0xec, 0xc1, 0x25, 0xde, 0x0c, 0x96, 0xe2, 0x86, 0x16, 0xc6, 0xc1, 0xbc, 0xf3,
+	0xae, 0xfb, 0xc2, 0x91, 0x4d, 0x69, 0xff, 0x95, 0x87, 0x55, 0x55, 0xb5, 0xfc, 0x54, 0xdc, 0x81,
+	0xe6, 0x70, 0xea, 0xfb, 0xd4, 0x09, 0x53, 0x0a, 0x6e, 0x08, 0xb2, 0xd4, 0xe1, 0x1d, 0x68, 0x8e,
+	0x68, 0x60, 0xf9, 0x74, 0x94, 0x52, 0x74, 0x43, 0x90, 0xa5, 0xe0, 0x2d, 0x90, 0xc1, 0xab, 0xc1,
+	0x5c, 0x4e, 0x20, 0x34, 0xbd, 0x22, 0xa8, 0xcc, 0x25, 0x05, 0x98, 0xc5, 0xb0, 0xc5, 0x18, 0x49,
+	0xa9, 0x22, 0xcf, 0xac, 0x39, 0x51, 0x08, 0xa1, 0x95, 0xc7, 0x2f, 0xc3, 0x36, 0x43, 0xea, 0x0c,
+	0x4f, 0x17, 0x6b, 0xb0, 0xce, 0xe4, 0xf7, 0xb9, 0xb8, 0x82, 0x1c, 0x2c, 0x27, 0x90, 0x83, 0x8f,
+	0xa1, 0x66, 0x9b, 0x41, 0x68, 0x30, 0x2d, 0xf1, 0xbc, 0x60, 0xfe, 0xf1, 0x04, 0x14, 0x3f, 0x60,
+	0xd2, 0x6a, 0x2c, 0x5e, 0x39, 0x7f, 0x2c, 0xfe, 0xbb, 0x39, 0x58, 0xe9, 0xfb, 0x96, 0xeb, 0x5b,
+	0xe1, 0xe9, 0xae, 0x6d, 0x06, 0x41, 0x66, 0x80, 0xdb, 0x81, 0x8a, 0x27, 0x84, 0x84, 0x7a, 0xa3,
+	0x32, 0xb9, 0x0b, 0x2d, 0xdc, 0xe6, 0xfe, 0xd4, 0x71, 0x44, 0x7a, 0x21, 0x55, 0xdb, 0x98, 0x98,
+	0x2f, 0x75, 0x4e, 0x7e, 0xec, 0x0e, 0x66, 0x0e, 0x44, 0x31, 0x7d, 0x20, 0xb4, 0x67, 0x50, 0x3d,
+	0xa4, 0xe6, 0xe4, 0x07, 0x53, 0x37, 0x34, 0x71, 0x1c, 0x21, 0x35, 0x27, 0x72, 0x1c, 0xf8, 0x9d,
+	0xd9, 0x57, 0xfe, 0x3c, 0x7d, 0x15, 0x66, 0xfa, 0xfa, 0x97, 0x1c, 0x34, 0x77, 0x46, 0x13, 0x2b,
+	0x08, 0x2c, 0xd7, 0xe9, 0xbb, 0xb6, 0x35, 0x3c, 0x25, 0xdf, 0x81, 0x96, 0x9c, 0x96, 0x31, 0x44,
+	0x65, 0xd0, 0x40, 0x84, 0x2d, 0x44, 0xd8, 0x3b, 0x45, 0x51, 0x7a, 0xd3, 0x53, 0x8b, 0x34, 0x20,
+	0x6f, 0x41, 0x09, 0x47, 0x29, 0xc3, 0x6c, 0x6e, 0x7e, 0xa3, 0x09, 0xe9, 0x9c, 0x49, 0xee, 0xc3,
+	0xc6, 0x88, 0x8e, 0xcd, 0xa9, 0x1d, 0x1a, 0xc9, 0xce, 0x84, 0x29, 0x5a, 0x13, 0xdc, 0xe4, 0xaa,
+	0x64, 0xcd, 0xbc, 0x98, 0x35, 0x73, 0xed, 0x3f, 0x73, 0xd0, 0x38, 0x18, 0x1e, 0xd3, 0xd1, 0x34,
+	0x32, 0x55, 0x8f, 0x61, 0xc5, 0x71, 0x47, 0xd4, 0x08, 0xa8, 0x4d, 0x87, 0x68, 0x0d, 0xf9, 0xa4,
+	0x6e, 0x71, 0x90, 0x21, 0x21, 0xbb, 0xfd, 0xc4, 0x1d, 0xd1, 0x03, 0x21, 0xc7, 0x01, 0xc4, 0xba,
+	0xa3, 0x90, 0xc8, 0xfb, 0x50, 0x0b, 0x23, 0xa3, 0x2b, 0xa7, 0xca, 0x63, 0xd0, 0xd8, 0x18, 0xeb,
+	0xaa, 0x0c, 0xee, 0x1e, 0x73, 0x8c, 0x28, 0x50, 0x78, 0x2a, 0xe6, 0x18, 0x95, 0x3b, 0x9f, 0xc2,
+	0xea, 0x4c, 0x8f, 0x17, 0x82, 0xfc, 0xfe, 0xbc, 0x06, 0x65, 0x96, 0x74, 0x8c, 0x5d, 0x19, 0x65,
+	0xe7, 0x32, 0xa2, 0x6c, 0xf2, 0x0e, 0x54, 0x43, 0x09, 0x8e, 0x26, 0x62, 0x88, 0x08, 0x32, 0xd5,
+	0x63, 0x01, 0xf2, 0x36, 0x54, 0x3c, 0xcb, 0xa3, 0xb6, 0xe5, 0xc8, 0xf0, 0x61, 0x85, 0xef, 0x00,
+	0x41, 0xd4, 0x23, 0x36, 0xb9, 0x03, 0xe0, 0x99, 0xcc, 0x52, 0x61, 0xdf, 0xcb, 0xa9, 0xbe, 0xab,
+	0x9c, 0xc7, 0x53, 0xec, 0xc8, 0xf1, 0x96, 0xbf, 0x1a, 0xd8, 0x56, 0x39, 0x3f, 0xd8, 0x46, 0xde,
+	0x83, 0x15, 0x77, 0x1a, 0x7a, 0xd3, 0x50, 0x26, 0xb6, 0xd5, 0xd9, 0x34, 0xac, 0xce, 0x25, 0x78,
+	0x89, 0xbc, 0x29, 0xf3, 0x0a, 0x60, 0x79, 0xc5, 0x8a, 0x9c, 0x43, 0x22, 0xab, 0xf8, 0x14, 0x5a,
+	0x5e, 0x9c, 0x75, 0x19, 0x2c, 0x95, 0xad, 0xb3, 0x96, 0xd7, 0xb8, 0x82, 0x92, 0x29, 0x99, 0xde,
+	0xf4, 0x92, 0x04, 0x8c, 0xca, 0xa5, 0xea, 0x8c, 0x13, 0xea, 0xe3, 0xe9, 0x6b, 0xaf, 0xb0, 0x20,
+	0xb2, 0x29, 0xe9, 0x3f, 0xe4, 0x64, 0x72, 0x1b, 0x41, 0x6b, 0x06, 0x7f, 0xb4, 0x1b, 0xac, 0x8b,
+	0xba, 0x8a, 0x8a, 0xe9, 0x92, 0x89, 0xa9, 0x26, 0x65, 0xf0, 0x6c, 0xbb, 0x29, 0xe7, 0x18, 0x21,
+	0xb6, 0xba, 0x60, 0xa1, 0x61, 0x17, 0xfa, 0x10, 0x28, 0xc2, 0x2a, 0xdb, 0x49, 0x42, 0x05, 0x0f,
+	0x18, 0x8d, 0x6c, 0x41, 0x4d, 0x08, 0xb1, 0x7c, 0x9e, 0x28, 0xc9, 0x90, 0x4e, 0x3d, 0x57, 0x07,
+	0xce, 0xc5, 0x6f, 0x8e, 0x27, 0xf3, 0xb4, 0x7d, 0x8d, 0x8d, 0x5f, 0x16, 0x59, 0x28, 0x6d, 0x86,
+	0xa6, 0x21, 0x42, 0x52, 0x3a, 0x6a, 0x6f, 0x70, 0x57, 0x83, 0xd4, 0xbe, 0x24, 0x62, 0xa4, 0xc4,
+	0xc4, 0x42, 0x37, 0x34, 0xed, 0xf6, 0x65, 0x1e, 0x29, 0x21, 0xe5, 0x10, 0x09, 0xe4, 0x43, 0x58,
+	0x11, 0x81, 0x61, 0xc0, 0x7c, 0x62, 0xbb, 0xbd, 0x59, 0x88, 0x22, 0x2f, 0x35, 0x84, 0xd4, 0xeb,
+	0x2f, 0x94, 0x12, 0xd6, 0xf3, 0x45, 0x84, 0xc5, 0x97, 0xe7, 0x8a, 0x12, 0xb1, 0xa9, 0xb1, 0x97,
+	0x5e, 0xf7, 0x95, 0x12, 0x26, 0x9e, 0x16, 0x86, 0x8a, 0xed, 0x8e, 0x92, 0x78, 0x0a, 0x08, 0x80,
+	0x31, 0xc8, 0x36, 0x80, 0x43, 0x5f, 0x48, 0xfd, 0x5d, 0x65, 0x62, 0x4d, 0xa6, 0x1c, 0xae, 0x3e,
+	0x9e, 0xd0, 0x39, 0xf4, 0x05, 0x2f, 0x62, 0xca, 0x6d, 0x39, 0x43, 0x9f, 0x4e, 0xa8, 0x83, 0x33,
+	0x7c, 0x83, 0x25, 0xf4, 0x2a, 0x89, 0x6c, 0x43, 0x1d, 0x27, 0x17, 0xc8, 0x3d, 0x7a, 0x6d, 0x76,
+	0x8f, 0xd6, 0x98, 0x40, 0x0c, 0x8d, 0x33, 0x95, 0x05, 0xcf, 0x2d, 0xcf, 0xa3, 0xa3, 0xf6, 0x75,
+	0x0e, 0xfd, 0x22, 0xed, 0x80, 0x93, 0xe2, 0x40, 0xf5, 0xc6, 0x82, 0x40, 0xf5, 0x26, 0xd4, 0xa9,
+	0x63, 0x0e, 0x6c, 0x6a, 0x70, 0xf9, 0x4d, 0x3e, 0x3c, 0x4e, 0x63, 0x92, 0x0c, 0xab, 0x31, 0xed,
+	0xb0, 0x7d, 0x53, 0x60, 0x35, 0xa6, 0x1d, 0xa2, 0x25, 0x1a, 0x98, 0xe1, 0xf0, 0xb8, 0xad, 0x31,
+	0x79, 0x5e, 0x50, 0x3c, 0xfa, 0x9b, 0x09, 0x8f, 0xbe, 0x06, 0x25, 0x9f, 0xfa, 0x53, 0xa7, 0xfd,
+	0x16, 0x97, 0x66, 0x05, 0xf2, 0x0d, 0x58, 0x41, 0xb0, 0x2f, 0x0e, 0x32, 0x6e, 0x29, 0xa0, 0xef,
+	0x43, 0xc6, 0xe1, 0x19, 0x7f, 0x7d, 0x1c, 0x17, 0x98, 0x4f, 0x63, 0xb3, 0xe7, 0xc4, 0xf6, 0x6d,
+	0xee, 0xd3, 0x90, 0xc4, 0xeb, 0x20, 0x18, 0x88, 0xe7, 0x07, 0xaf, 0x8e, 0xee, 0x28, 0x9a, 0xfc,
+	0x7c, 0xf0, 0x8c, 0x0e, 0x43, 0x5d, 0xf2, 0xc8, 0x7b, 0x50, 0xe3, 0x87, 0x82, 0x27, 0xe6, 0x77,
+	0xe5, 0x42, 0x46, 0x87, 0x86, 0x2d, 0x24, 0xd0, 0xe8, 0x9b, 0x05, 0x4f, 0x49, 0x5f, 0xf5, 0x36,
+	0x9b, 0xe6, 0x8a, 0x97, 0x0e, 0x1d, 0x98, 0xcb, 0xde, 0x8a, 0x5d, 0xf6, 0xe3, 0x62, 0xa5, 0xd8,
+	0x2a, 0x3d, 0x2e, 0x56, 0x4a, 0xad, 0x65, 0xed, 0x77, 0x72, 0x50, 0x53, 0xa6, 0x47, 0x6e, 0x43,
+	0x45, 0x00, 0x04, 0x32, 0xf5, 0xa9, 0xbd, 0xfa, 0xf2, 0x46, 0x99, 0x31, 0xf7, 0xba, 0x7a, 0x99,
+	0x31, 0xf7, 0x46, 0x08, 0x11, 0xd3, 0x97, 0x56, 0xc8, 0xef, 0x9d, 0x38, 0x14, 0x5c, 0x41, 0x02,
+	0xbb, 0x6f, 0x8a, 0x55, 0x5f, 0x48, 0xa8, 0xfe, 0x1a, 0x14, 0x6d, 0xf7, 0x28, 0x98, 0x45, 0x34,
+	0x18, 0x59, 0x0b, 0xa0, 0xce, 0xfa, 0xd9, 0x17, 0x4a, 0x39, 0xef, 0x58, 0x6e, 0xc2, 0x32, 0x3b,
+	0x0d, 0xd2, 0xf5, 0x29, 0x0d, 0x0b, 0x06, 0x5a, 0x06, 0x6e, 0x27, 0x02, 0x81, 0x51, 0xcb, 0xa2,
+	0xf6, 0x4d, 0x80, 0xc7, 0xee, 0x40, 0x76, 0xf9, 0x36, 0x2c, 0x8b, 0xf5, 0xcf, 0x29, 0x47, 0x5b,
+	0x1d, 0x95, 0x2e, 0x04, 0xb4, 0x2e, 0x2c, 0xf3, 0x23, 0x9f, 0x19, 0x9e, 0xdd, 0x4e, 0x22, 0x42,
+	0xad, 0x94, 0x89, 0x90, 0xc6, 0x5b, 0xfb, 0x40, 0xe0, 0x73, 0x08, 0xce, 0xdc, 0x81, 0x0a, 0x4b,
+	0x26, 0x63, 0x68, 0xa6, 0x2e, 0x0d, 0x3e, 0x5b, 0xfe, 0xf2, 0x33, 0xfe, 0xa1, 0x5d, 0x87, 0x8a,
+	0xf4, 0x7a, 0x59, 0x9d, 0x6b, 0x7f, 0x8a, 0x11, 0xa4, 0x10, 0xe0, 0xd0, 0xdf, 0x35, 0x01, 0x87,
+	0xe6, 0xd2, 0xe6, 0x33, 0x0d, 0xe4, 0xe6, 0x13, 0x40, 0xae, 0x04, 0x03, 0x0b, 0x19, 0x60, 0x60,
+	0x31, 0x03, 0x0c, 0x2c, 0x29, 0x1a, 0xb8, 0x01, 0x45, 0x44, 0x6c, 0xdb, 0xcb, 0xca, 0xb6, 0x17,
+	0x06, 0x84, 0x31, 0xb4, 0xdf, 0x6a, 0x40, 0x3d, 0x1e, 0xe5, 0xd8, 0x4d, 0x78, 0xf8, 0xdc, 0x7c,
+	0x0f, 0x7f, 0xb1, 0xd0, 0xe1, 0xdb, 0x00, 0x43, 0x9f, 0x62, 0x70, 0x6d, 0x98, 0xf2, 0x6a, 0x60,
+	0x9e, 0xcb, 0xae, 0x0a, 0xe9, 0x9d, 0x90, 0xdc, 0x95, 0xeb, 0x58, 0x66, 0xeb, 0x48, 0x12, 0x03,
+	0x4a, 0xb8, 0xe1, 0x9b, 0x50, 0xf7, 0x29, 0xa2, 0x50, 0x06, 0xf5, 0x7d, 0xd7, 0x67, 0x91, 0x41,
+	0x55, 0xaf, 0x71, 0x5a, 0x0f, 0x49, 0xe4, 0x53, 0x00, 0x5c, 0x60, 0x86, 0x9b, 0xf1, 0x9b, 0xd6,
+	0xda, 0xbd, 0xcd, 0x44, 0x8b, 0xa8, 0x07, 0x5c, 0xef, 0x5d, 0x26, 0xc2, 0x83, 0xbd, 0xea, 0x33,
+	0x59, 0xce, 0x74, 0xf5, 0x70, 0x11, 0x57, 0xdf, 0x86, 0xb2, 0xf4, 0xf0, 0x35, 0xee, 0x21, 0x45,
+	0xf1, 0x2b, 0x7a, 0xec, 0x56, 0x86, 0xc7, 0xe6, 0x80, 0xeb, 0xea, 0x0c, 0xe0, 0xfa, 0x19, 0xac,
+	0x29, 0x19, 0x6a, 0x7c, 0xd7, 0x42, 0x16, 0x65, 0x6a, 0x24, 0x4a, 0x51, 0xa3, 0x8b, 0x98, 0x59,
+	0x97, 0x7a, 0xe9, 0x82, 0x2e, 0x75, 0xed, 0x2c, 0x97, 0xba, 0x09, 0xb5, 0x11, 0x0d, 0x86, 0xbe,
+	0xe5, 0x61, 0xe7, 0xed, 0x75, 0xbe, 0x8c, 0x0a, 0x29, 0xed, 0x44, 0x37, 0x66, 0x9d, 0xe8, 0x35,
+	0x80, 0xa1, 0x39, 0x3c, 0x16, 0xf8, 0xc1, 0x65, 0xd6, 0x44, 0x95, 0x51, 0x18, 0x72, 0x90, 0xf6,
+	0x73, 0xed, 0xb3, 0xfd, 0xdc, 0x15, 0xc5, 0xcf, 0x5d, 0xc7, 0x56, 0x3d, 0x73, 0x60, 0xd9, 0x18,
+	0xb6, 0x77, 0x18, 0x47, 0xa1, 0xc4, 0x7e, 0xf0, 0x6a, 0xb6, 0x1f, 0x7c, 0x23, 0x61, 0x8c, 0xdf,
+	0x02, 0x4c, 0x53, 0x54, 0x9c, 0xe3, 0x1a, 0xcf, 0xab, 0x27, 0xe6, 0xcb, 0x18, 0xe4, 0x50, 0x02,
+	0xbe, 0xeb, 0xf3, 0x02, 0x3e, 0xee, 0x08, 0xa7, 0x13, 0x83, 0x5f, 0xe7, 0xdf, 0x88, 0x1c, 0xe1,
+	0x74, 0x72, 0x88, 0x14, 0xc4, 0x27, 0xb8, 0x80, 0x4f, 0x43, 0xff, 0xd4, 0x18, 0x98, 0xc3, 0xe7,
+	0xee, 0x78, 0xdc, 0xde, 0x5c, 0xb4, 0xf8, 0xab, 0xac, 0x96, 0x8e, 0x95, 0x1e, 0xf0, 0x3a, 0x71,
+	0xae, 0x2f, 0x2f, 0xfb, 0x6e, 0x9e, 0x2f, 0xd7, 0x3f, 0xe4, 0xe2, 0x88, 0xc9, 0xe2, 0x31, 0x94,
+	0xb5, 0xb5, 0x45, 0xb5, 0xf1, 0xd0, 0xca, 0xba, 0xef, 0x00, 0xc1, 0x48, 0xc7, 0x48, 0x06, 0x0b,
+	0x6f, 0x32, 0x85, 0xb7, 0x90, 0xf3, 0x50, 0x0d, 0x0f, 0x3e, 0x82, 0xa6, 0xdc, 0x7d, 0x86, 0x6d,
+	0xb1, 0x2b, 0xbc, 0xb7, 0xce, 0xda, 0xa7, 0x0d, 0x29, 0xb9, 0xcf, 0x04, 0xf1, 0x0a, 0x39, 0x88,
+	0xf2, 0x40, 0xbe, 0xc7, 0x6f, 0x29, 0x57, 0xc8, 0xc9, 0x1c, 0x51, 0x6f, 0x04, 0x89, 0x32, 0xfa,
+	0x67, 0xcf, 0x1d, 0xe1, 0x0d, 0xee, 0xf0, 0x98, 0x85, 0x25, 0x55, 0xbd, 0xe2, 0xb9, 0xa3, 0x3e,
+	0x96, 0x71, 0xb1, 0x64, 0x1c, 0x8b, 0xcd, 0xde, 0x61, 0x6c, 0xe0, 0x24, 0x56, 0xfb, 0x53, 0x68,
+	0x99, 0x31, 0x00, 0xc4, 0xa5, 0xee, 0x2a, 0x76, 0x26, 0x05, 0xbc, 0xe9, 0x4d, 0x33, 0x49, 0x20,
+	0x3d, 0x20, 0x89, 0x06, 0x78, 0xb8, 0xfc, 0x36, 0x6b, 0x62, 0x63, 0xa6, 0x09, 0xc6, 0xd5, 0x57,
+	0xcd, 0x34, 0x29, 0x23, 0xc8, 0xd9, 0x9a, 0x17, 0xe4, 0x7c, 0x4d, 0xc1, 0x25, 0x36, 0xa1, 0x14,
+	0x78, 0xb8, 0xbc, 0xef, 0x28, 0x07, 0x9d, 0xbd, 0x65, 0xd0, 0x39, 0x83, 0x7c, 0x1b, 0x1a, 0x62,
+	0xf7, 0xca, 0xf1, 0x7d, 0x9d, 0x89, 0x12, 0x75, 0x87, 0x8b, 0xb1, 0xad, 0x04, 0x6a, 0xb1, 0xf3,
+	0x09, 0x34, 0x92, 0x46, 0x5a, 0xcd, 0x8f, 0x4b, 0x19, 0xf9, 0x71, 0x49, 0xc9, 0x8f, 0x1f, 0x17,
+	0x2b, 0x85, 0x56, 0x91, 0x47, 0x61, 0xda, 0x23, 0xd5, 0x53, 0x63, 0x10, 0xf0, 0x21, 0xac, 0x44,
+	0xc9, 0x98, 0x12, 0x09, 0xac, 0xce, 0xb8, 0x09, 0xbd, 0xee, 0x29, 0x25, 0xed, 0x67, 0x79, 0x28,
+	0xf5, 0x4e, 0xa8, 0x13, 0x9e, 0x79, 0xe1, 0xa5, 0x89, 0x77, 0x0a, 0x3c, 0x22, 0xe1, 0xee, 0x92,
+	0xd5, 0x38, 0x3c, 0xf5, 0xa8, 0x78, 0xb7, 0xb0, 0x0d, 0x45, 0x05, 0x9f, 0x9f, 0xe7, 0x23, 0x99,
+	0x5c, 0xc2, 0x65, 0x17, 0xe7, 0xbb, 0x6c, 0x81, 0x04, 0x94, 0xb2, 0x90, 0x80, 0x2d, 0x40, 0x27,
+	0x27, 0xee, 0xbb, 0x96, 0xb3, 0x72, 0xdd, 0xca, 0x33, 0xf1, 0x85, 0xcb, 0x16, 0x29, 0x68, 0x91,
+	0x6b, 0x5e, 0xf1, 0xd4, 0xa2, 0x62, 0x0a, 0x2b, 0xaa, 0x29, 0xd4, 0xfe, 0x21, 0x07, 0xb5, 0x1f,
+	0xd1, 0xc1, 0xb1, 0xeb, 0x3e, 0x67, 0x81, 0x48, 0x56, 0x40, 0x77, 0x05, 0x0a, 0x53, 0xdf, 0x16,
+	0x30, 0x7f, 0xf9, 0xd5, 0x97, 0x37, 0xf0, 0x19, 0x91, 0x8e, 0xb4, 0x8b, 0x20, 0x13, 0xb7, 0x61,
+	0x99, 0xa2, 0xca, 0xf9, 0x55, 0xfe, 0xec, 0x2a, 0x08, 0x2e, 0x8e, 0x94, 0x3f, 0xc8, 0x12, 0x21,
+	0x95, 0x28, 0xcd, 0x04, 0x19, 0xcb, 0x33, 0x41, 0x86, 0xb6, 0x0b, 0x75, 0x65, 0x2e, 0x78, 0xc3,
+	0x53, 0x7f, 0xc1, 0xcb, 0xea, 0x7e, 0x12, 0x01, 0x69, 0x2c, 0xa8, 0xd7, 0x5e, 0xc4, 0x05, 0xed,
+	0xef, 0x73, 0xd0, 0x14, 0xcc, 0x2e, 0xb5, 0xad, 0x13, 0xea, 0x9f, 0x62, 0xec, 0x20, 0x44, 0x84,
+	0x62, 0x64, 0x11, 0xcf, 0x1a, 0x1b, 0x77, 0x3b, 0xaf, 0x9c, 0x35, 0x36, 0x29, 0x9d, 0x33, 0x18,
+	0xde, 0x14, 0x86, 0x74, 0xe2, 0x85, 0x12, 0xf8, 0x8b, 0xca, 0xe4, 0x3b, 0x50, 0x77, 0xe8, 0xcb,
+	0xd0, 0x10, 0x84, 0x73, 0x5c, 0x81, 0xd4, 0x50, 0x7e, 0x87, 0x8b, 0xa3, 0xaf, 0x65, 0x08, 0x2d,
+	0x57, 0x08, 0x57, 0x57, 0x15, 0x29, 0x5c, 0x1d, 0x3f, 0x2b, 0x41, 0x6b, 0x97, 0x85, 0x73, 0xb8,
+	0xdb, 0xe8, 0x8f, 0xa7, 0x34, 0x08, 0x93, 0xe1, 0x63, 0xee, 0x22, 0xc8, 0x53, 0x7e, 0xfe, 0xfa,
+	0x66, 0x05, 0x68, 0xe5, 0x8b, 0x04, 0x68, 0x8a, 0xbf, 0xad, 0x9c, 0x0f, 0x60, 0xa9, 0x9e, 0x1d,
+	0xae, 0x65, 0x01, 0x3b, 0x90, 0x0d, 0xec, 0xcc, 0x44, 0x76, 0xb5, 0xc5, 0x58, 0x4c, 0x7d, 0x1e,
+	0x16, 0x93, 0xc4, 0xe0, 0x56, 0xce, 0xc6, 0xe0, 0x66, 0x22, 0xb9, 0xc6, 0x05, 0x23, 0xb9, 0xe6,
+	0xf9, 0xc0, 0x91, 0xd6, 0x45, 0xc1, 0x91, 0xd5, 0xd9, 0xb8, 0x2e, 0x1d, 0xb8, 0x91, 0xb3, 0x03,
+	0xb7, 0x4b, 0x59, 0x00, 0xc5, 0x9a, 0x1a, 0x98, 0x45, 0x40, 0xc4, 0xba, 0x02, 0x44, 0x24, 0x9c,
+	0x43, 0x1f, 0x56, 0xf7, 0x1c, 0xd4, 0x49, 0xa8, 0xec, 0xdd, 0x79, 0x88, 0xea, 0x0d, 0xa8, 0x0d,
+	0x6c, 0x77, 0xf8, 0xdc, 0x88, 0x73, 0xcf, 0x8a, 0x0e, 0x8c, 0xc4, 0x2c, 0xa0, 0xf6, 0x27, 0x39,
+	0x68, 0xec, 0x5b, 0x81, 0xda, 0xde, 0x05, 0xb2, 0xae, 0x6d, 0xa8, 0x33, 0xcd, 0x4a, 0x6c, 0x28,
+	0xe3, 0x79, 0x53, 0x8d, 0x09, 0xf0, 0xc2, 0x2c, 0xe0, 0x59, 0x58, 0x00, 0x78, 0x6a, 0xdb, 0xd0,
+	0xe2, 0x7b, 0xf8, 0x7c, 0x13, 0x46, 0x79, 0xfe, 0xb6, 0xf2, 0x9c, 0xf2, 0xef, 0x40, 0xe3, 0x20,
+	0x74, 0xbd, 0x73, 0x4a, 0xff, 0x75, 0x0e, 0x1a, 0x8f, 0x68, 0xb8, 0xef, 0x1e, 0x05, 0xe7, 0xd1,
+	0xfe, 0x05, 0xec, 0x84, 0x44, 0xcd, 0xc6, 0x96, 0x1d, 0x52, 0x5f, 0x82, 0x12, 0x0c, 0x4b, 0x7a,
+	0xc8, 0x49, 0xfc, 0xb1, 0x63, 0x10, 0x52, 0x6e, 0xd3, 0x2a, 0xba, 0x28, 0xc5, 0xaf, 0x51, 0x96,
+	0xcf, 0x78, 0x8d, 0x22, 0x36, 0xcf, 0xdf, 0xe4, 0x01, 0xf6, 0xdd, 0xa3, 0xff, 0x47, 0x83, 0x00,
+	0x91, 0x8d, 0x37, 0x95, 0xb8, 0x42, 0x71, 0x6e, 0x51, 0x10, 0xf1, 0x04, 0x9d, 0x5c, 0x7c, 0x9d,
+	0x5d, 0x58, 0x70, 0x9d, 0x5d, 0x9c, 0x73, 0x9d, 0xbd, 0x05, 0xf9, 0xe8, 0x56, 0x7a, 0x9e, 0x19,
+	0xcf, 0x73, 0x60, 0x66, 0xc2, 0x47, 0x28, 0x7c, 0x99, 0x2c, 0x26, 0x6f, 0xe1, 0xcb, 0x73, 0x6f,
+	0xe1, 0x09, 0x14, 0xa7, 0x01, 0xe5, 0x29, 0x77, 0x45, 0x67, 0xdf, 0x09, 0xf0, 0xa8, 0x3a, 0x07,
+	0x3c, 0x8a, 0xd5, 0x0c, 0xaa, 0x9a, 0xb5, 0x43, 0xb8, 0xa4, 0x73, 0xf0, 0x98, 0xeb, 0xf6, 0x1c,
+	0xeb, 0x9f, 0x5e, 0xd4, 0xfc, 0xcc, 0xa2, 0x6a, 0xdf, 0x84, 0x4b, 0xe2, 0x44, 0x27, 0x5a, 0x5d,
+	0xf8, 0xc2, 0x48, 0x33, 0xa0, 0x85, 0xe7, 0xf6, 0xdc, 0x63, 0xc1, 0xf8, 0xdf, 0x3c, 0x12, 0x89,
+	0x9d, 0xbc, 0x1f, 0x34, 0x8f, 0x78, 0x52, 0xc7, 0xde, 0x50, 0x1d, 0xc9, 0x8b, 0x6d, 0xf6, 0xad,
+	0x9d, 0xc2, 0xaa, 0xd2, 0x41, 0xe0, 0xb9, 0x4e, 0xc0, 0x5e, 0x6d, 0xc4, 0xcf, 0x85, 0x82, 0x33,
+	0xde, 0x0b, 0x41, 0xf4, 0x5e, 0x88, 0xe1, 0xa1, 0x0c, 0x3b, 0x37, 0xb0, 0xcd, 0xe8, 0x82, 0x9d,
+	0x91, 0xfa, 0x48, 0xc9, 0xec, 0xfa, 0x27, 0x39, 0x68, 0x47, 0x7d, 0x3f, 0x74, 0x7d, 0x6e, 0xc4,
+	0x2f, 0x6e, 0x9e, 0x22, 0x8f, 0x90, 0x3f, 0xcb, 0x23, 0x24, 0xb4, 0x52, 0x38, 0x43, 0x2b, 0x45,
+	0x65, 0x68, 0xbf, 0x01, 0x4d, 0xfe, 0x8c, 0xcb, 0xfa, 0x82, 0x3e, 0x98, 0x0e, 0x9f, 0xd3, 0x10,
+	0x9f, 0x08, 0xd8, 0xee, 0x0b, 0xea, 0x1b, 0x03, 0x77, 0xea, 0xc8, 0x67, 0x26, 0xfc, 0x26, 0xbc,
+	0xc9, 0x18, 0x0f, 0x90, 0xce, 0x5f, 0xa3, 0x6c, 0xc1, 0xea, 0xd4, 0xf3, 0x52, 0xb2, 0x5c, 0x29,
+	0x4d, 0xc6, 0x50, 0x64, 0xa3, 0xd7, 0x55, 0x05, 0xe5, 0x75, 0x95, 0xf6, 0x8b, 0x3c, 0x5c, 0xc9,
+	0xd0, 0xcd, 0xff, 0xe5, 0xfa, 0xc4, 0xb9, 0x3d, 0x1f, 0x5f, 0x51, 0xc9, 0xed, 0x59, 0x0a, 0x14,
+	0xb7, 0x1a, 0xbf, 0xb9, 0x91, 0xad, 0xf2, 0xb9, 0xdd, 0x86, 0x26, 0x3e, 0xcc, 0xe0, 0xad, 0x70,
+	0x21, 0xfe, 0x0c, 0x65, 0x65, 0x62, 0x39, 0x6c, 0xa4, 0xb1, 0x9c, 0xf9, 0x32, 0x21, 0x57, 0x16,
+	0x72, 0xe6, 0x4b, 0x45, 0xee, 0x63, 0x68, 0xe0, 0x12, 0x1a, 0xc7, 0x56, 0x10, 0xba, 0x47, 0xbe,
+	0x39, 0x69, 0x57, 0x36, 0x0b, 0x51, 0x90, 0x95, 0x5a, 0x31, 0x7d, 0x05, 0x65, 0xbf, 0x2f, 0x45,
+	0xb5, 0x9f, 0x02, 0xac, 0xf3, 0x88, 0x30, 0xda, 0x43, 0x17, 0xdf, 0x6b, 0x17, 0x03, 0x20, 0x37,
+	0x60, 0x99, 0xdf, 0xee, 0x4b, 0x5b, 0xce, 0x4b, 0xaf, 0x1f, 0x2e, 0x9e, 0x2b, 0x0c, 0x9c, 0x89,
+	0xed, 0x20, 0x23, 0xb6, 0x3b, 0x0b, 0x9d, 0xab, 0xfd, 0x52, 0xd0, 0xb9, 0xfa, 0x05, 0x63, 0xba,
+	0x95, 0x73, 0xa2, 0x73, 0x8d, 0x85, 0xe8, 0x5c, 0x73, 0x11, 0x3a, 0xd7, 0x5a, 0x84, 0xce, 0xad,
+	0xce, 0x06, 0x79, 0x6f, 0x40, 0xd5, 0xa7, 0xe2, 0x2e, 0x51, 0x04, 0x81, 0x31, 0x21, 0x0e, 0xf7,
+	0x2e, 0xa9, 0xe1, 0xde, 0x2c, 0xde, 0xb6, 0x36, 0x1f, 0x6f, 0x5b, 0xbf, 0x00, 0xde, 0xb6, 0x71,
+	0x5e, 0xbc, 0xed, 0xf2, 0x2f, 0x03, 0x6f, 0x6b, 0xbf, 0x16, 0xde, 0x76, 0xe5, 0xf5, 0xf1, 0xb6,
+	0xce, 0xf9, 0xf1, 0xb6, 0xab, 0xaf, 0x81, 0xb7, 0xbd, 0xf1, 0x15, 0xf1, 0xb6, 0x6b, 0x29, 0xbc,
+	0x2d, 0x0b, 0x4e, 0xbb, 0x7e, 0x11, 0x38, 0x6d, 0x16, 0x07, 0xbb, 0x31, 0x0f, 0x07, 0xdb, 0xcc,
+	0xc2, 0xc1, 0x6e, 0x9e, 0x81, 0x83, 0x25, 0x32, 0x8e, 0x5f, 0x87, 0x0d, 0x11, 0x9f, 0xbc, 0x86,
+	0x6d, 0x54, 0x2e, 0x19, 0xf2, 0xc9, 0x4b, 0x86, 0x14, 0xf0, 0xc8, 0xdf, 0x93, 0x2b, 0xc0, 0xa3,
+	0xf6, 0x7d, 0xb8, 0x8a, 0xde, 0xae, 0x9f, 0xcc, 0x4e, 0x83, 0x8b, 0x0f, 0x42, 0xfb, 0x35, 0xb8,
+	0xac, 0xbb, 0xb6, 0x8d, 0xfb, 0xfe, 0x7f, 0x63, 0x2a, 0xda, 0x3a, 0x5c, 0x52, 0x47, 0x2a, 0xda,
+	0xd6, 0xfe, 0x28, 0x07, 0xeb, 0x3c, 0x23, 0x79, 0x8d, 0x5e, 0xf1, 0x70, 0xb3, 0x36, 0xe2, 0xe7,
+	0x54, 0x15, 0x1d, 0x46, 0x32, 0xd1, 0x09, 0x14, 0x01, 0x96, 0x88, 0x17, 0x54, 0x01, 0x96, 0x7d,
+	0xb7, 0xa0, 0x60, 0xda, 0xb6, 0xb8, 0xa3, 0xc3, 0x4f, 0x6d, 0x07, 0xd6, 0x0e, 0x30, 0x9a, 0xfd,
+	0xea, 0xc3, 0xd2, 0xbe, 0x07, 0x97, 0x30, 0x79, 0x7a, 0x8d, 0x16, 0x7e, 0x2f, 0x07, 0x6b, 0x3a,
+	0xa6, 0xb9, 0xaf, 0xa1, 0x9c, 0x5b, 0x50, 0xa6, 0x2f, 0x87, 0xf6, 0x94, 0xdd, 0x3b, 0xcf, 0xfe,
+	0xbc, 0x46, 0xf0, 0x50, 0xcc, 0x72, 0xb8, 0x58, 0x21, 0x43, 0x4c, 0xf0, 0xb4, 0x7f, 0xcc, 0xc1,
+	0xfa, 0x8e, 0xe7, 0xd9, 0xa7, 0xb2, 0xa7, 0x20, 0xc6, 0x88, 0x4a, 0xa8, 0x5c, 0x19, 0x55, 0x6d,
+	0xf0, 0xea, 0x2c, 0x6e, 0x60, 0x60, 0x07, 0x17, 0xd3, 0xb9, 0x10, 0xf9, 0x16, 0x54, 0xe5, 0x08,
+	0xe5, 0x35, 0x74, 0x47, 0xfc, 0x20, 0x23, 0x23, 0xd2, 0xd0, 0x63, 0x61, 0xf4, 0x16, 0x9e, 0x3f,
+	0x15, 0xd0, 0x61, 0x45, 0xe7, 0x85, 0xa4, 0x87, 0x29, 0xa6, 0x3d, 0xcc, 0x65, 0x28, 0x8f, 0xfc,
+	0x53, 0x7c, 0x7a, 0x26, 0x03, 0x8a, 0x91, 0x7f, 0xaa, 0x4f, 0x1d, 0x7c, 0xb0, 0x5f, 0x63, 0xd3,
+	0xd9, 0x19, 0x32, 0x67, 0x78, 0x57, 0x60, 0xbe, 0xfc, 0x77, 0x93, 0xc2, 0xda, 0xc4, 0x7c, 0x05,
+	0xf9, 0x95, 0x98, 0x67, 0x5e, 0xc1, 0x3c, 0x6f, 0x41, 0x63, 0x78, 0x6c, 0x3a, 0x47, 0x74, 0x64,
+	0x8c, 0x2d, 0x6a, 0x8f, 0x64, 0x9e, 0xba, 0x22, 0xa8, 0x0f, 0x19, 0x71, 0xc1, 0x58, 0xaf, 0x03,
+	0x60, 0x48, 0x11, 0x84, 0x3e, 0x9a, 0x26, 0xfe, 0x2b, 0x56, 0x85, 0xa2, 0x75, 0x61, 0x23, 0xbd,
+	0x00, 0x22, 0xba, 0xdd, 0x82, 0xb2, 0x39, 0xe4, 0x6f, 0xda, 0x54, 0xd0, 0x52, 0x19, 0xbf, 0x2e,
+	0x05, 0xb4, 0x5f, 0x81, 0x35, 0xae, 0x69, 0x81, 0x5a, 0xca, 0x55, 0xdc, 0x4a, 0x82, 0x96, 0x59,
+	0xc0, 0xa7, 0x14, 0x50, 0xa2, 0xb4, 0xbc, 0x1a, 0xa5, 0x69, 0x9f, 0x02, 0xc1, 0xa3, 0x9e, 0x6a,
+	0xf9, 0x02, 0xdb, 0x7e, 0x0b, 0xd6, 0xb8, 0x4d, 0x48, 0x35, 0x91, 0x75, 0x77, 0xff, 0x08, 0x5a,
+	0x87, 0xbe, 0x39, 0xa4, 0x2c, 0x9f, 0x16, 0x72, 0xd7, 0xa0, 0x88, 0x3f, 0x23, 0x4d, 0xdc, 0xde,
+	0xf3, 0x7c, 0x1b, 0xc9, 0xfc, 0xe7, 0xbd, 0x9e, 0xf8, 0xbd, 0x73, 0x41, 0xe7, 0x05, 0xcd, 0x85,
+	0x2a, 0xca, 0xb0, 0xc6, 0x16, 0xb5, 0x30, 0xe7, 0xb7, 0x30, 0xe4, 0x4e, 0xf4, 0x24, 0xa2, 0xa0,
+	0x3c, 0x2c, 0xec, 0xf2, 0x48, 0xc3, 0x1c, 0xc6, 0x0f, 0x22, 0x7e, 0x15, 0x20, 0xa6, 0x9e, 0xfb,
+	0xf1, 0xc6, 0xed, 0xd4, 0xe3, 0x0d, 0x1e, 0x45, 0x47, 0x23, 0x97, 0x2f, 0x38, 0xb4, 0x11, 0xac,
+	0xef, 0x4d, 0x3c, 0x73, 0x18, 0xee, 0x38, 0xa6, 0x7d, 0x1a, 0x58, 0x81, 0xa2, 0x9c, 0xaf, 0xf2,
+	0xb4, 0x01, 0x8f, 0x9d, 0x19, 0x1e, 0xcb, 0x2d, 0xcd, 0x0b, 0xda, 0x6f, 0xe7, 0xa1, 0x11, 0x5d,
+	0xb2, 0xb0, 0xee, 0x2e, 0x62, 0x9a, 0x38, 0x00, 0x30, 0x9d, 0x04, 0xe2, 0x01, 0x59, 0x3e, 0x7a,
+	0x0b, 0x35, 0x9d, 0x04, 0xfc, 0x09, 0xd9, 0xd7, 0x81, 0x08, 0x11, 0xcb, 0x39, 0x31, 0x6d, 0x8b,
+	0xbf, 0x0e, 0xe6, 0xd9, 0x16, 0x0f, 0xbd, 0x82, 0xbd, 0x98, 0x81, 0xa1, 0xbb, 0x10, 0xf7, 0xe9,
+	0x34, 0x10, 0xbf, 0x0f, 0x28, 0x88, 0xf8, 0x2a, 0xd0, 0x19, 0x8d, 0x7c, 0x0e, 0x1b, 0x34, 0x08,
+	0xad, 0x09, 0xd6, 0x30, 0x12, 0x3f, 0xcc, 0x58, 0xf8, 0x08, 0x7a, 0x2d, 0xaa, 0xd8, 0x8f, 0x7f,
+	0xac, 0xa1, 0x7d, 0x06, 0x1b, 0x69, 0x5d, 0x8b, 0x23, 0xf9, 0xbe, 0x6a, 0xe6, 0xf8, 0xa1, 0xbc,
+	0x94, 0xbc, 0x99, 0x62, 0xf5, 0x14, 0xfb, 0xa6, 0x5d, 0x86, 0xf5, 0x47, 0xa6, 0x3f, 0x30, 0x8f,
+	0xe8, 0xae, 0x6b, 0xdb, 0x74, 0x28, 0x33, 0x7b, 0xad, 0x0d, 0x1b, 0x69, 0x06, 0xef, 0x65, 0x6b,
+	0x0f, 0x6a, 0xca, 0x8f, 0xbb, 0x09, 0x81, 0x46, 0xef, 0x91, 0xde, 0x3b, 0x38, 0x30, 0xf4, 0xa7,
+	0x4f, 0x9e, 0xec, 0x3d, 0x79, 0xd4, 0x5a, 0x52, 0x68, 0x07, 0x4f, 0x77, 0x77, 0x7b, 0x07, 0x07,
+	0xad, 0x9c, 0x42, 0x7b, 0xb8, 0xb3, 0xb7, 0xff, 0x54, 0xef, 0xb5, 0xf2, 0x5b, 0xbf, 0x99, 0x63,
+	0x0f, 0x6c, 0x78, 0x43, 0x2d, 0xa8, 0x3f, 0xfe, 0xfc, 0x81, 0x71, 0x70, 0xb8, 0xa3, 0x1f, 0xf2,
+	0x66, 0x9a, 0x50, 0x43, 0x8a, 0x6c, 0x37, 0x27, 0x09, 0x51, 0x03, 0x92, 0x20, 0x7b, 0x29, 0x90,
+	0x06, 0x00, 0x12, 0x3e, 0xdb, 0xdb, 0xdf, 0xef, 0x75, 0x5b, 0x45, 0x29, 0xd0, 0xc7, 0x36, 0x77,
+	0xf6, 0x5b, 0x25, 0x29, 0xf0, 0x83, 0xa7, 0xbd, 0xa7, 0xbd, 0x6e, 0x6b, 0x79, 0xeb, 0x7b, 0xe2,
+	0x5c, 0xf0, 0x31, 0x00, 0x2c, 0x63, 0xe3, 0xbd, 0x6e, 0x6b, 0x89, 0xd4, 0xa0, 0x1c, 0x8f, 0x1e,
+	0x0b, 0x9f, 0xed, 0xf5, 0xfb, 0xbd, 0x6e, 0x2b, 0x4f, 0xea, 0x50, 0x89, 0x46, 0x59, 0xd8, 0xfa,
+	0x14, 0x6a, 0xca, 0xd3, 0x21, 0xec, 0xb1, 0xff, 0x79, 0x57, 0x51, 0x86, 0x20, 0xc4, 0x6d, 0x35,
+	0x00, 0x90, 0x20, 0x3a, 0x62, 0x5a, 0x58, 0x49, 0xdc, 0x8c, 0x91, 0x75, 0x58, 0xed, 0xef, 0xf5,
+	0x7b, 0xfb, 0x7b, 0x4f, 0x7a, 0xaa, 0x3e, 0xd6, 0xa0, 0x15, 0x91, 0x63, 0xa5, 0x5c, 0x86, 0x4b,
+	0x31, 0xb5, 0x17, 0x89, 0xe7, 0x13, 0xe2, 0x52, 0x65, 0x05, 0x72, 0x09, 0x9a, 0x11, 0xb5, 0xbf,
+	0xf3, 0xf4, 0x00, 0xd5, 0xb4, 0xf5, 0xd3, 0x1c, 0x54, 0xa3, 0x7b, 0x2e, 0xec, 0xbe, 0xf7, 0xc3,
+	0xde, 0x93, 0x43, 0x23, 0x5a, 0x0f, 0xa6, 0x90, 0xcb, 0x70, 0x49, 0x21, 0xe3, 0x74, 0x7a, 0xdd,
+	0x5e, 0xb7, 0x95, 0xc3, 0x8e, 0x62, 0x86, 0x9c, 0x56, 0x92, 0x2a, 0x16, 0xa4, 0x90, 0x6c, 0x5b,
+	0x2e, 0x4b, 0x91, 0x5c, 0x81, 0x75, 0x4e, 0x4e, 0x8c, 0xb8, 0xd7, 0x6d, 0x95, 0xb6, 0x4e, 0xa1,
+	0x99, 0x72, 0x8a, 0xd8, 0xc8, 0x4e, 0xbf, 0xbf, 0xff, 0xff, 0x8d, 0x5d, 0xbd, 0xb7, 0x73, 0x88,
+	0xd3, 0xee, 0x7f, 0xde, 0x5a, 0xc2, 0x46, 0x12, 0x64, 0xd9, 0x56, 0x2b, 0x17, 0xb3, 0x9e, 0xf6,
+	0xbb, 0x09, 0x56, 0x3e, 0x66, 0x75, 0x7b, 0xfb, 0x3d, 0x95, 0x55, 0xb8, 0xf7, 0xb7, 0x4d, 0x28,
+	0xec, 0xf4, 0xf7, 0xc8, 0x36, 0x54, 0xb9, 0xff, 0xc2, 0x2b, 0x91, 0x75, 0x25, 0x72, 0x88, 0xa1,
+	0xea, 0x4e, 0x64, 0x98, 0xb5, 0x25, 0x72, 0x1f, 0x20, 0xbe, 0x1a, 0x20, 0x1b, 0x22, 0x51, 0x4e,
+	0xdd, 0x15, 0x74, 0x12, 0xef, 0xc7, 0xb4, 0x25, 0xf2, 0x2e, 0x94, 0x05, 0xfa, 0x4f, 0xf8, 0xb1,
+	0x4d, 0xde, 0x05, 0x74, 0x56, 0x54, 0xf9, 0x40, 0x5b, 0x22, 0x9f, 0x40, 0x35, 0xc2, 0xd7, 0xc5,
+	0xb0, 0xd2, 0x78, 0x7b, 0x67, 0x63, 0xc6, 0xbc, 0xf4, 0xf0, 0x9f, 0x83, 0x68, 0x4b, 0xe4, 0x5b,
+	0x50, 0x16, 0x68, 0xbb, 0xe8, 0x2e, 0x89, 0xbd, 0xcf, 0xa9, 0xf9, 0x0d, 0xa8, 0x46, 0xf7, 0x00,
+	0xa2, 0xdf, 0xf4, 0xbd, 0x40, 0x27, 0xfd, 0x3c, 0x52, 0x5b, 0x22, 0x5d, 0x20, 0x07, 0x34, 0x4c,
+	0xff, 0x86, 0x40, 0x84, 0x3d, 0x49, 0xea, 0x9c, 0xce, 0xbb, 0x40, 0x1e, 0xcd, 0xb6, 0x72, 0x86,
+	0x7c, 0x27, 0xb3, 0x75, 0x6d, 0x89, 0x7c, 0x04, 0x75, 0x15, 0xea, 0x25, 0x6d, 0x75, 0x8d, 0x54,
+	0x1c, 0xb7, 0x93, 0x02, 0xec, 0xb8, 0xda, 0x23, 0xd0, 0x4f, 0x4c, 0x3f, 0x8d, 0xfe, 0x76, 0x36,
+	0xd2, 0x64, 0x6e, 0x3c, 0xb5, 0x25, 0xf2, 0x80, 0xfd, 0xa0, 0x2b, 0x82, 0xae, 0x45, 0xcf, 0x19,
+	0x68, 0xf6, 0x1c, 0x1d, 0x1c, 0xc2, 0xea, 0x0c, 0xec, 0x48, 0xae, 0x25, 0xbb, 0x4c, 0x41, 0xb5,
+	0x9d, 0xeb, 0x67, 0xb1, 0xa3, 0x91, 0xdd, 0x87, 0x6a, 0x14, 0xdc, 0x88, 0x79, 0xa5, 0x83, 0x9d,
+	0x4e, 0x2a, 0x00, 0xd0, 0x96, 0xc8, 0x67, 0xd0, 0x48, 0xba, 0x23, 0xc2, 0x43, 0xeb, 0xcc, 0x78,
+	0xa0, 0x73, 0x35, 0x93, 0x17, 0x0d, 0xe1, 0x21, 0x34, 0x92, 0x21, 0x39, 0x99, 0x13, 0xa7, 0xcf,
+	0x51, 0xd0, 0x2e, 0x34, 0x53, 0x99, 0x32, 0xb9, 0xaa, 0xae, 0x70, 0xba, 0xa5, 0xd9, 0x07, 0x1c,
+	0xda, 0x12, 0xf9, 0x2e, 0xd4, 0xd5, 0x24, 0x52, 0xac, 0x54, 0x46, 0x5e, 0xd9, 0x21, 0x33, 0xd5,
+	0x03, 0x3e, 0x99, 0x64, 0xb2, 0x29, 0x26, 0x93, 0x99, 0x81, 0xce, 0xdd, 0xf1, 0x2b, 0x89, 0xe4,
+	0x90, 0x5c, 0x11, 0xc7, 0x75, 0x36, 0x61, 0x9c, 0xd3, 0xca, 0x03, 0xa8, 0xab, 0xf9, 0xa1, 0x98,
+	0x4d, 0x46, 0xca, 0x38, 0xa7, 0x8d, 0x8f, 0x61, 0x25, 0x91, 0x20, 0x8a, 0x91, 0x64, 0x25, 0x8d,
+	0xb3, 0xd6, 0xea, 0x09, 0xac, 0x65, 0xa1, 0x07, 0x64, 0x73, 0x46, 0xad, 0x29, 0x60, 0xe1, 0x0c,
+	0xf5, 0x3e, 0x86, 0x56, 0x1a, 0x43, 0x20, 0x6f, 0xf0, 0xf1, 0x64, 0x43, 0x0b, 0x73, 0x26, 0xf6,
+	0x19, 0x34, 0x92, 0x69, 0x8e, 0x58, 0xaa, 0xcc, 0xe4, 0xb3, 0x73, 0x35, 0x93, 0x17, 0x6d, 0xe2,
+	0x2e, 0xac, 0x24, 0xb2, 0x1d, 0xa1, 0xa5, 0xac, 0x0c, 0x68, 0xae, 0xae, 0x6b, 0x4a, 0x5e, 0x43,
+	0x2e, 0x47, 0x5a, 0x4a, 0xb5, 0xb0, 0x9a, 0x4e, 0x99, 0x02, 0x3e, 0x84, 0x44, 0x4e, 0x23, 0x86,
+	0x90, 0x95, 0xe7, 0xcc, 0x19, 0xc2, 0x77, 0xa4, 0x7f, 0xd9, 0xb1, 0xed, 0x33, 0x2d, 0xec, 0xd9,
+	0xd5, 0x3f, 0x80, 0xb2, 0xb8, 0x9f, 0x15, 0x0e, 0x26, 0x79, 0x5b, 0x2b, 0x5c, 0x44, 0x7c, 0x0b,
+	0xaa, 0x2d, 0xbd, 0x97, 0xc3, 0x95, 0x48, 0xc6, 0x9d, 0x62, 0x25, 0x32, 0xa3, 0xd4, 0xce, 0xd5,
+	0x4c, 0x9e, 0x5c, 0x89, 0x07, 0xad, 0x9f, 0xbf, 0xba, 0x9e, 0xfb, 0xc5, 0xab, 0xeb, 0xb9, 0x7f,
+	0x7b, 0x75, 0x3d, 0xf7, 0x93, 0x7f, 0xbf, 0xbe, 0x34, 0x58, 0x66, 0xa3, 0xfc, 0xe0, 0x7f, 0x06,
+	0x00, 0xe0, 0x64, 0xf1, 0x45, 0x4e, 0x4b, 0x00, 0x00,
 }
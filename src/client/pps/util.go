@@ -6,8 +6,15 @@ import (
 	"github.com/pachyderm/pachyderm/src/client/pfs"
 )
 
+// CronBranch is the branch of a cron input's repo that ticks are committed to.
+const CronBranch = "master"
+
 // VisitInput visits each input recursively in ascending order (root last)
 func VisitInput(input *Input, f func(*Input)) {
+	if input == nil {
+		// Spouts have no input
+		return
+	}
 	switch {
 	case input.Cross != nil:
 		for _, input := range input.Cross {
@@ -72,3 +79,16 @@ func InputCommits(input *Input) []*pfs.Commit {
 	})
 	return result
 }
+
+// ServicePorts returns the ports of a Service, whether they're given as
+// ports or as its single internal and external port.
+func ServicePorts(service *Service) []*ServicePort {
+	if len(service.Ports) > 0 || service.InternalPort == 0 {
+		return service.Ports
+	}
+	return []*ServicePort{{
+		Name:         "user-port",
+		InternalPort: service.InternalPort,
+		ExternalPort: service.ExternalPort,
+	}}
+}
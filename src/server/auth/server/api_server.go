@@ -26,6 +26,7 @@ import (
 	enterpriseclient "github.com/pachyderm/pachyderm/src/client/enterprise"
 	"github.com/pachyderm/pachyderm/src/client/pkg/grpcutil"
 	"github.com/pachyderm/pachyderm/src/client/pkg/uuid"
+	"github.com/pachyderm/pachyderm/src/server/pkg/authdb"
 	"github.com/pachyderm/pachyderm/src/server/pkg/backoff"
 	col "github.com/pachyderm/pachyderm/src/server/pkg/collection"
 	"github.com/pachyderm/pachyderm/src/server/pkg/log"
@@ -39,7 +40,6 @@ const (
 	DisableAuthenticationEnvVar = "PACHYDERM_AUTHENTICATION_DISABLED_FOR_TESTING"
 
 	tokensPrefix = "/tokens"
-	aclsPrefix   = "/acls"
 	adminsPrefix = "/admins"
 
 	defaultTokenTTLSecs = 14 * 24 * 60 * 60 // two weeks
@@ -137,13 +137,7 @@ func NewAuthServer(pachdAddress string, etcdAddress string, etcdPrefix string) (
 			&authclient.User{},
 			nil,
 		),
-		acls: col.NewCollection(
-			etcdClient,
-			path.Join(etcdPrefix, aclsPrefix),
-			nil,
-			&authclient.ACL{},
-			nil,
-		),
+		acls: authdb.ACLs(etcdClient, etcdPrefix),
 		admins: col.NewCollection(
 			etcdClient,
 			path.Join(etcdPrefix, adminsPrefix),
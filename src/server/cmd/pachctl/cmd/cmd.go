@@ -23,6 +23,7 @@ import (
 	"github.com/pachyderm/pachyderm/src/client"
 	"github.com/pachyderm/pachyderm/src/client/pkg/config"
 	"github.com/pachyderm/pachyderm/src/client/pkg/grpcutil"
+	"github.com/pachyderm/pachyderm/src/client/pkg/tracing"
 	"github.com/pachyderm/pachyderm/src/client/version"
 	"github.com/pachyderm/pachyderm/src/client/version/versionpb"
 	authcmds "github.com/pachyderm/pachyderm/src/server/auth/cmds"
@@ -57,6 +58,7 @@ func PachctlCmd() (*cobra.Command, error) {
 
 Environment variables:
   ADDRESS=<host>:<port>, the pachd server to connect to (e.g. 127.0.0.1:30650).
+  PACH_TRACE_EXPORTER=stderr, write a trace of each RPC made to stderr.
 `,
 		PersistentPreRun: func(cmd *cobra.Command, args []string) {
 			if !verbose {
@@ -65,6 +67,9 @@ Environment variables:
 				l.Level = log.FatalLevel
 				grpclog.SetLogger(l)
 			}
+			if err := tracing.SetExporterFromEnv(); err != nil {
+				cmdutil.ErrorAndExit("%v", err)
+			}
 		},
 	}
 	rootCmd.PersistentFlags().BoolVarP(&verbose, "verbose", "v", false, "Output verbose logs")
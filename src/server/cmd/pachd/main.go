@@ -18,6 +18,7 @@ import (
 	"github.com/pachyderm/pachyderm/src/client/pkg/discovery"
 	"github.com/pachyderm/pachyderm/src/client/pkg/grpcutil"
 	"github.com/pachyderm/pachyderm/src/client/pkg/shard"
+	"github.com/pachyderm/pachyderm/src/client/pkg/tracing"
 	"github.com/pachyderm/pachyderm/src/client/pkg/uuid"
 	ppsclient "github.com/pachyderm/pachyderm/src/client/pps"
 	"github.com/pachyderm/pachyderm/src/client/version"
@@ -32,8 +33,11 @@ import (
 	"github.com/pachyderm/pachyderm/src/server/pkg/metrics"
 	"github.com/pachyderm/pachyderm/src/server/pkg/migration"
 	"github.com/pachyderm/pachyderm/src/server/pkg/netutil"
+	"github.com/pachyderm/pachyderm/src/server/pkg/prom"
 	pps_server "github.com/pachyderm/pachyderm/src/server/pps/server"
 
+	_ "github.com/go-sql-driver/mysql" // for SQL egress
+	_ "github.com/lib/pq"              // for SQL egress
 	log "github.com/sirupsen/logrus"
 	flag "github.com/spf13/pflag"
 	"golang.org/x/sync/errgroup"
@@ -91,6 +95,10 @@ func main() {
 }
 
 func doSidecarMode(appEnvObj interface{}) error {
+	if err := tracing.SetExporterFromEnv(); err != nil {
+		return err
+	}
+	http.Handle("/metrics", prom.Handler())
 	go func() {
 		log.Println(http.ListenAndServe(":651", nil))
 	}()
@@ -131,7 +139,7 @@ func doSidecarMode(appEnvObj interface{}) error {
 	if err != nil {
 		return err
 	}
-	pfsAPIServer, err := pfs_server.NewAPIServer(address, []string{etcdAddress}, appEnv.PFSEtcdPrefix, int64(pfsCacheSize))
+	pfsAPIServer, err := pfs_server.NewAPIServer(address, []string{etcdAddress}, appEnv.PFSEtcdPrefix, appEnv.PPSEtcdPrefix, appEnv.AuthEtcdPrefix, int64(pfsCacheSize))
 	if err != nil {
 		return err
 	}
@@ -172,8 +180,10 @@ func doSidecarMode(appEnvObj interface{}) error {
 			eprsclient.RegisterAPIServer(s, enterpriseAPIServer)
 		},
 		grpcutil.ServeOptions{
-			Version:    version.Version,
-			MaxMsgSize: grpcutil.MaxMsgSize,
+			Version:           version.Version,
+			MaxMsgSize:        grpcutil.MaxMsgSize,
+			UnaryInterceptor:  prom.UnaryServerInterceptor,
+			StreamInterceptor: prom.StreamServerInterceptor,
 		},
 		grpcutil.ServeEnv{
 			GRPCPort: appEnv.Port,
@@ -182,6 +192,9 @@ func doSidecarMode(appEnvObj interface{}) error {
 }
 
 func doFullMode(appEnvObj interface{}) error {
+	if err := tracing.SetExporterFromEnv(); err != nil {
+		return err
+	}
 	appEnv := appEnvObj.(*appEnv)
 	if migrate != "" {
 		parts := strings.Split(migrate, "-")
@@ -195,6 +208,7 @@ func doFullMode(appEnvObj interface{}) error {
 		return nil
 	}
 
+	http.Handle("/metrics", prom.Handler())
 	go func() {
 		log.Println(http.ListenAndServe(":651", nil))
 	}()
@@ -273,7 +287,7 @@ func doFullMode(appEnvObj interface{}) error {
 		address,
 	)
 	cacheServer := cache_server.NewCacheServer(router, appEnv.NumShards)
-	pfsAPIServer, err := pfs_server.NewAPIServer(address, []string{etcdAddress}, appEnv.PFSEtcdPrefix, int64(pfsCacheSize))
+	pfsAPIServer, err := pfs_server.NewAPIServer(address, []string{etcdAddress}, appEnv.PFSEtcdPrefix, appEnv.PPSEtcdPrefix, appEnv.AuthEtcdPrefix, int64(pfsCacheSize))
 	if err != nil {
 		return err
 	}
@@ -350,8 +364,10 @@ func doFullMode(appEnvObj interface{}) error {
 				deployclient.RegisterAPIServer(s, deployServer)
 			},
 			grpcutil.ServeOptions{
-				Version:    version.Version,
-				MaxMsgSize: grpcutil.MaxMsgSize,
+				Version:           version.Version,
+				MaxMsgSize:        grpcutil.MaxMsgSize,
+				UnaryInterceptor:  prom.UnaryServerInterceptor,
+				StreamInterceptor: prom.StreamServerInterceptor,
 			},
 			grpcutil.ServeEnv{
 				GRPCPort: appEnv.Port,
@@ -11,11 +11,15 @@ import (
 	"golang.org/x/sync/errgroup"
 
 	etcd "github.com/coreos/etcd/clientv3"
+	_ "github.com/go-sql-driver/mysql" // for SQL egress
+	_ "github.com/lib/pq"              // for SQL egress
 	"github.com/pachyderm/pachyderm/src/client"
 	"github.com/pachyderm/pachyderm/src/client/pkg/grpcutil"
+	"github.com/pachyderm/pachyderm/src/client/pkg/tracing"
 	"github.com/pachyderm/pachyderm/src/client/pps"
 	"github.com/pachyderm/pachyderm/src/client/version"
 	"github.com/pachyderm/pachyderm/src/server/pkg/cmdutil"
+	"github.com/pachyderm/pachyderm/src/server/pkg/prom"
 	ppsserver "github.com/pachyderm/pachyderm/src/server/pps"
 	"github.com/pachyderm/pachyderm/src/server/worker"
 	"google.golang.org/grpc"
@@ -73,6 +77,10 @@ func getPipelineInfo(etcdClient *etcd.Client, appEnv *appEnv) (*pps.PipelineInfo
 }
 
 func do(appEnvObj interface{}) error {
+	if err := tracing.SetExporterFromEnv(); err != nil {
+		return err
+	}
+	http.Handle("/metrics", prom.Handler())
 	go func() {
 		log.Println(http.ListenAndServe(":652", nil))
 	}()
@@ -119,8 +127,10 @@ func do(appEnvObj interface{}) error {
 				close(ready)
 			},
 			grpcutil.ServeOptions{
-				Version:    version.Version,
-				MaxMsgSize: grpcutil.MaxMsgSize,
+				Version:           version.Version,
+				MaxMsgSize:        grpcutil.MaxMsgSize,
+				UnaryInterceptor:  prom.UnaryServerInterceptor,
+				StreamInterceptor: prom.StreamServerInterceptor,
 			},
 			grpcutil.ServeEnv{
 				GRPCPort: client.PPSWorkerPort,
@@ -15,6 +15,7 @@ import (
 	"net/url"
 	"os"
 	"path"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
@@ -580,7 +581,7 @@ func TestEgressFailure(t *testing.T) {
 				Cmd: []string{"cp", path.Join("/pfs", dataRepo, "file"), "/pfs/out/file"},
 			},
 			Input:  client.NewAtomInput(dataRepo, "/"),
-			Egress: &pps.Egress{"invalid://blahblah"},
+			Egress: &pps.Egress{URL: "invalid://blahblah"},
 		})
 	require.NoError(t, err)
 
@@ -600,6 +601,15 @@ func TestEgressFailure(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, pps.JobState_JOB_FAILURE, jobInfo.State)
 	require.True(t, strings.Contains(jobInfo.Reason, "egress"))
+	// The job's output is kept, so that its egress can be retried
+	require.NotNil(t, jobInfo.OutputCommit)
+	require.NotNil(t, jobInfo.EgressInfo)
+	require.Equal(t, pps.EgressState_EGRESS_FAILURE, jobInfo.EgressInfo.State)
+	_, err = c.EgressJob(jobInfo.Job.ID)
+	require.NoError(t, err)
+	jobInfo, err = c.InspectJob(jobInfo.Job.ID, false)
+	require.NoError(t, err)
+	require.Equal(t, pps.JobState_JOB_FAILURE, jobInfo.State)
 }
 
 func TestLazyPipelinePropagation(t *testing.T) {
@@ -2065,6 +2075,258 @@ func TestUpdatePipeline(t *testing.T) {
 	require.Equal(t, "buzz\n", buffer.String())
 }
 
+func TestApplyPipelines(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+
+	c := getPachClient(t)
+	defer require.NoError(t, c.DeleteAll())
+	dataRepo := uniqueString("TestApplyPipelines_data")
+	pipelineA := uniqueString("pipelineA")
+	pipelineB := uniqueString("pipelineB")
+	pipelineRequest := func(name string, input string, stdin string) *pps.CreatePipelineRequest {
+		return &pps.CreatePipelineRequest{
+			Pipeline: client.NewPipeline(name),
+			Transform: &pps.Transform{
+				Cmd:   []string{"bash"},
+				Stdin: []string{stdin},
+			},
+			ParallelismSpec: &pps.ParallelismSpec{
+				Constant: 1,
+			},
+			Input: client.NewAtomInput(input, "/*"),
+		}
+	}
+	repos := []*pfs.CreateRepoRequest{{Repo: client.NewRepo(dataRepo)}}
+	// pipelineB is listed first, but depends on pipelineA
+	pipelines := []*pps.CreatePipelineRequest{
+		pipelineRequest(pipelineB, pipelineA, fmt.Sprintf("cp /pfs/%s/* /pfs/out/", pipelineA)),
+		pipelineRequest(pipelineA, dataRepo, fmt.Sprintf("cp /pfs/%s/* /pfs/out/", dataRepo)),
+	}
+
+	// A dry run plans the changes without applying them
+	actions, err := c.ApplyPipelines(repos, pipelines, false, false, true)
+	require.NoError(t, err)
+	require.Equal(t, 3, len(actions))
+	require.Equal(t, pps.ApplyActionType_APPLY_CREATE_REPO, actions[0].Type)
+	require.Equal(t, dataRepo, actions[0].Name)
+	require.Equal(t, pps.ApplyActionType_APPLY_CREATE_PIPELINE, actions[1].Type)
+	require.Equal(t, pipelineA, actions[1].Name)
+	require.Equal(t, pps.ApplyActionType_APPLY_CREATE_PIPELINE, actions[2].Type)
+	require.Equal(t, pipelineB, actions[2].Name)
+	_, err = c.InspectRepo(dataRepo)
+	require.YesError(t, err)
+
+	_, err = c.ApplyPipelines(repos, pipelines, false, false, false)
+	require.NoError(t, err)
+	_, err = c.InspectPipeline(pipelineA)
+	require.NoError(t, err)
+	_, err = c.InspectPipeline(pipelineB)
+	require.NoError(t, err)
+
+	// Applying the same specs again changes nothing
+	actions, err = c.ApplyPipelines(repos, pipelines, false, false, true)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(actions))
+
+	// Changing pipelineA's transform updates it, which affects pipelineB
+	pipelines[1] = pipelineRequest(pipelineA, dataRepo, fmt.Sprintf("cp -r /pfs/%s/* /pfs/out/", dataRepo))
+	actions, err = c.ApplyPipelines(repos, pipelines, false, false, false)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(actions))
+	require.Equal(t, pps.ApplyActionType_APPLY_UPDATE_PIPELINE, actions[0].Type)
+	require.Equal(t, []string{"transform"}, actions[0].ChangedFields)
+	require.Equal(t, []string{pipelineB}, actions[0].Downstream)
+	pipelineInfo, err := c.InspectPipeline(pipelineA)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), pipelineInfo.Version)
+
+	// Pruning deletes pipelineB, which is no longer in the specs
+	actions, err = c.ApplyPipelines(repos, pipelines[1:], true, false, false)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(actions))
+	require.Equal(t, pps.ApplyActionType_APPLY_DELETE_PIPELINE, actions[0].Type)
+	require.Equal(t, pipelineB, actions[0].Name)
+	_, err = c.InspectPipeline(pipelineB)
+	require.YesError(t, err)
+
+	// Invalid specs are rejected before anything is applied
+	_, err = c.ApplyPipelines(nil, []*pps.CreatePipelineRequest{
+		pipelineRequest(pipelineB, uniqueString("nonexistent"), "true"),
+	}, false, false, false)
+	require.YesError(t, err)
+	_, err = c.InspectPipeline(pipelineB)
+	require.YesError(t, err)
+}
+
+func TestApplySpout(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+
+	c := getPachClient(t)
+	defer require.NoError(t, c.DeleteAll())
+	pipelineName := uniqueString("TestApplySpout")
+	request := &pps.CreatePipelineRequest{
+		Pipeline: client.NewPipeline(pipelineName),
+		Transform: &pps.Transform{
+			Cmd:   []string{"bash"},
+			Stdin: []string{"while true; do sleep 1; done"},
+		},
+		Spout: &pps.Spout{},
+	}
+
+	// Spouts have no input
+	actions, err := c.ApplyPipelines(nil, []*pps.CreatePipelineRequest{request}, false, false, true)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(actions))
+	require.Equal(t, pps.ApplyActionType_APPLY_CREATE_PIPELINE, actions[0].Type)
+	require.Equal(t, pipelineName, actions[0].Name)
+
+	// Other pipelines need one
+	request.Spout = nil
+	_, err = c.ApplyPipelines(nil, []*pps.CreatePipelineRequest{request}, false, false, true)
+	require.YesError(t, err)
+}
+
+func TestRollbackPipeline(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+
+	c := getPachClient(t)
+	defer require.NoError(t, c.DeleteAll())
+	dataRepo := uniqueString("TestRollbackPipeline_data")
+	require.NoError(t, c.CreateRepo(dataRepo))
+	pipelineName := uniqueString("pipeline")
+	createPipeline := func(stdin string, update bool) {
+		_, err := c.PpsAPIClient.CreatePipeline(
+			context.Background(),
+			&pps.CreatePipelineRequest{
+				Pipeline: client.NewPipeline(pipelineName),
+				Transform: &pps.Transform{
+					Cmd:   []string{"bash"},
+					Stdin: []string{stdin},
+				},
+				ParallelismSpec: &pps.ParallelismSpec{
+					Constant: 1,
+				},
+				Input:     client.NewAtomInput(dataRepo, "/*"),
+				Update:    update,
+				Reprocess: update,
+			})
+		require.NoError(t, err)
+	}
+	v1 := fmt.Sprintf("cp /pfs/%s/* /pfs/out/", dataRepo)
+	createPipeline(v1, false)
+	putFile := func(file string) *pfs.CommitInfo {
+		commit, err := c.StartCommit(dataRepo, "master")
+		require.NoError(t, err)
+		_, err = c.PutFile(dataRepo, commit.ID, file, strings.NewReader(file+"\n"))
+		require.NoError(t, err)
+		require.NoError(t, c.FinishCommit(dataRepo, commit.ID))
+		commitIter, err := c.FlushCommit([]*pfs.Commit{commit}, nil)
+		require.NoError(t, err)
+		commitInfos := collectCommitInfos(t, commitIter)
+		require.Equal(t, 1, len(commitInfos))
+		return commitInfos[0]
+	}
+	putFile("a")
+	pipelineInfo, err := c.InspectPipeline(pipelineName)
+	require.NoError(t, err)
+	salt := pipelineInfo.Salt
+
+	createPipeline(fmt.Sprintf("for f in /pfs/%s/*; do echo v2 > /pfs/out/$(basename $f); done", dataRepo), true)
+	pipelineInfos, err := c.ListPipelineVersions(pipelineName)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(pipelineInfos))
+	require.Equal(t, uint64(1), pipelineInfos[0].Version)
+	require.Equal(t, uint64(2), pipelineInfos[1].Version)
+	pipelineInfo, err = c.InspectPipelineVersion(pipelineName, 1)
+	require.NoError(t, err)
+	require.Equal(t, []string{v1}, pipelineInfo.Transform.Stdin)
+
+	// Rolling back reinstates version 1's spec and salt as version 3
+	require.NoError(t, c.RollbackPipeline(pipelineName, 1))
+	pipelineInfo, err = c.InspectPipeline(pipelineName)
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), pipelineInfo.Version)
+	require.Equal(t, salt, pipelineInfo.Salt)
+	require.Equal(t, []string{v1}, pipelineInfo.Transform.Stdin)
+
+	// The datum processed by version 1 isn't reprocessed
+	outputCommitInfo := putFile("b")
+	jobInfos, err := c.ListJob(pipelineName, nil, outputCommitInfo.Commit)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(jobInfos))
+	require.Equal(t, int64(1), jobInfos[0].DataProcessed)
+	require.Equal(t, int64(1), jobInfos[0].DataSkipped)
+	var buffer bytes.Buffer
+	require.NoError(t, c.GetFile(pipelineName, outputCommitInfo.Commit.ID, "a", 0, 0, &buffer))
+	require.Equal(t, "a\n", buffer.String())
+
+	// Rolling back to the current or an unknown version fails
+	require.YesError(t, c.RollbackPipeline(pipelineName, 3))
+	require.YesError(t, c.RollbackPipeline(pipelineName, 0))
+}
+
+func TestWebhooks(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+
+	c := getPachClient(t)
+	defer require.NoError(t, c.DeleteAll())
+	dataRepo := uniqueString("TestWebhooks_data")
+	require.NoError(t, c.CreateRepo(dataRepo))
+	pipelineName := uniqueString("pipeline")
+	require.NoError(t, c.CreatePipeline(
+		pipelineName,
+		"",
+		[]string{"true"},
+		nil,
+		&pps.ParallelismSpec{
+			Constant: 1,
+		},
+		client.NewAtomInput(dataRepo, "/*"),
+		"",
+		false,
+	))
+
+	pipelineHook := uniqueString("pipeline-hook")
+	clusterHook := uniqueString("cluster-hook")
+	require.NoError(t, c.CreateWebhook(pipelineHook, "http://example.com/hook", pipelineName,
+		[]pps.EventType{pps.EventType_EVENT_JOB_FAILED}, "secret", false))
+	require.NoError(t, c.CreateWebhook(clusterHook, "https://example.com/hook", "", nil, "", false))
+	// Names are unique unless the webhook is updated
+	require.YesError(t, c.CreateWebhook(clusterHook, "https://example.com/hook", "", nil, "", false))
+	require.NoError(t, c.CreateWebhook(clusterHook, "https://example.com/other", "", nil, "", true))
+	// Only http(s) urls are accepted
+	require.YesError(t, c.CreateWebhook(uniqueString("bad-hook"), "ftp://example.com", "", nil, "", false))
+
+	webhookInfos, err := c.ListWebhook(pipelineName)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(webhookInfos))
+	require.Equal(t, pipelineHook, webhookInfos[0].Name)
+	require.Equal(t, []pps.EventType{pps.EventType_EVENT_JOB_FAILED}, webhookInfos[0].Events)
+	// Secrets are never returned
+	require.Equal(t, "", webhookInfos[0].Secret)
+	webhookInfos, err = c.ListWebhook("")
+	require.NoError(t, err)
+	require.Equal(t, 2, len(webhookInfos))
+
+	// A pipeline's webhooks are deleted with it
+	require.NoError(t, c.DeletePipeline(pipelineName, false))
+	webhookInfos, err = c.ListWebhook("")
+	require.NoError(t, err)
+	require.Equal(t, 1, len(webhookInfos))
+	require.Equal(t, clusterHook, webhookInfos[0].Name)
+	require.Equal(t, "https://example.com/other", webhookInfos[0].URL)
+	require.NoError(t, c.DeleteWebhook(clusterHook))
+	require.YesError(t, c.DeleteWebhook(clusterHook))
+}
+
 func TestStopPipeline(t *testing.T) {
 	if testing.Short() {
 		t.Skip("Skipping integration tests in short mode")
@@ -2111,6 +2373,136 @@ func TestStopPipeline(t *testing.T) {
 	require.Equal(t, "foo\n", buffer.String())
 }
 
+func TestRerunPipeline(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+
+	c := getPachClient(t)
+	defer require.NoError(t, c.DeleteAll())
+	dataRepo := uniqueString("TestRerunPipeline_data")
+	require.NoError(t, c.CreateRepo(dataRepo))
+	pipelineName := uniqueString("pipeline")
+	// The output differs each time a datum is processed
+	require.NoError(t, c.CreatePipeline(
+		pipelineName,
+		"",
+		[]string{"bash"},
+		[]string{
+			fmt.Sprintf("cp /pfs/%s/file /pfs/out/file", dataRepo),
+			"date +%s%N >> /pfs/out/file",
+		},
+		&pps.ParallelismSpec{
+			Constant: 1,
+		},
+		client.NewAtomInput(dataRepo, "/*"),
+		"",
+		false,
+	))
+
+	var commits []*pfs.Commit
+	var outputs []string
+	for i := 0; i < 2; i++ {
+		commit, err := c.StartCommit(dataRepo, "master")
+		require.NoError(t, err)
+		_, err = c.PutFile(dataRepo, commit.ID, "file", strings.NewReader(fmt.Sprintf("%d\n", i)))
+		require.NoError(t, err)
+		require.NoError(t, c.FinishCommit(dataRepo, commit.ID))
+		commitIter, err := c.FlushCommit([]*pfs.Commit{commit}, nil)
+		require.NoError(t, err)
+		commitInfos := collectCommitInfos(t, commitIter)
+		require.Equal(t, 1, len(commitInfos))
+		var buffer bytes.Buffer
+		require.NoError(t, c.GetFile(pipelineName, commitInfos[0].Commit.ID, "file", 0, 0, &buffer))
+		commits = append(commits, commit)
+		outputs = append(outputs, buffer.String())
+	}
+
+	// Rerun only the first input commit
+	jobInfos, err := c.RerunPipeline(pipelineName, []*pfs.Commit{commits[0]}, nil)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(jobInfos))
+	jobInfo, err := c.InspectJob(jobInfos[0].Job.ID, true)
+	require.NoError(t, err)
+	require.Equal(t, pps.JobState_JOB_SUCCESS, jobInfo.State)
+	require.Equal(t, int64(1), jobInfo.DataProcessed)
+	require.Equal(t, int64(0), jobInfo.DataSkipped)
+	var buffer bytes.Buffer
+	require.NoError(t, c.GetFile(pipelineName, jobInfo.OutputCommit.ID, "file", 0, 0, &buffer))
+	require.True(t, strings.HasPrefix(buffer.String(), "0\n"))
+	require.NotEqual(t, outputs[0], buffer.String())
+
+	// The output branch still holds the output of the newest input commit
+	buffer.Reset()
+	require.NoError(t, c.GetFile(pipelineName, "master", "file", 0, 0, &buffer))
+	require.Equal(t, outputs[1], buffer.String())
+
+	// Excluding the first input commit leaves nothing to rerun
+	jobInfos, err = c.RerunPipeline(pipelineName, []*pfs.Commit{commits[0]}, []*pfs.Commit{commits[0]})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(jobInfos))
+}
+
+func TestSkipFailedDatums(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+
+	c := getPachClient(t)
+	defer require.NoError(t, c.DeleteAll())
+	dataRepo := uniqueString("TestSkipFailedDatums_data")
+	require.NoError(t, c.CreateRepo(dataRepo))
+	pipelineName := uniqueString("pipeline")
+	// Fails on the datum named "bad" and copies every other datum
+	_, err := c.PpsAPIClient.CreatePipeline(
+		context.Background(),
+		&pps.CreatePipelineRequest{
+			Pipeline: client.NewPipeline(pipelineName),
+			Transform: &pps.Transform{
+				Cmd: []string{"bash"},
+				Stdin: []string{
+					fmt.Sprintf("if [ -e /pfs/%s/bad ]; then exit 3; fi", dataRepo),
+					fmt.Sprintf("cp /pfs/%s/* /pfs/out/", dataRepo),
+				},
+			},
+			ParallelismSpec: &pps.ParallelismSpec{
+				Constant: 1,
+			},
+			Input:             client.NewAtomInput(dataRepo, "/*"),
+			DatumTries:        2,
+			DatumRetryBackoff: types.DurationProto(time.Second),
+			SkipFailedDatums:  true,
+		})
+	require.NoError(t, err)
+
+	commit, err := c.StartCommit(dataRepo, "master")
+	require.NoError(t, err)
+	_, err = c.PutFile(dataRepo, commit.ID, "good", strings.NewReader("good\n"))
+	require.NoError(t, err)
+	_, err = c.PutFile(dataRepo, commit.ID, "bad", strings.NewReader("bad\n"))
+	require.NoError(t, err)
+	require.NoError(t, c.FinishCommit(dataRepo, commit.ID))
+	commitIter, err := c.FlushCommit([]*pfs.Commit{commit}, nil)
+	require.NoError(t, err)
+	commitInfos := collectCommitInfos(t, commitIter)
+	require.Equal(t, 1, len(commitInfos))
+
+	jobInfos, err := c.ListJob(pipelineName, nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(jobInfos))
+	jobInfo, err := c.InspectJob(jobInfos[0].Job.ID, true)
+	require.NoError(t, err)
+	require.Equal(t, pps.JobState_JOB_PARTIAL, jobInfo.State)
+	require.Equal(t, int64(1), jobInfo.DataFailed)
+	require.Equal(t, 1, len(jobInfo.FailedDatums))
+	require.Equal(t, int32(3), jobInfo.FailedDatums[0].ExitCode)
+
+	// The output of the datum that succeeded is committed
+	var buffer bytes.Buffer
+	require.NoError(t, c.GetFile(pipelineName, jobInfo.OutputCommit.ID, "good", 0, 0, &buffer))
+	require.Equal(t, "good\n", buffer.String())
+}
+
 func TestPipelineAutoScaledown(t *testing.T) {
 	if testing.Short() {
 		t.Skip("Skipping integration tests in short mode")
@@ -4920,6 +5312,764 @@ func TestFixPipeline(t *testing.T) {
 	}, backoff.NewTestingBackOff()))
 }
 
+func TestTraceFile(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+	c := getPachClient(t)
+	defer require.NoError(t, c.DeleteAll())
+
+	dataRepo := uniqueString("TestTraceFile_data")
+	require.NoError(t, c.CreateRepo(dataRepo))
+	commit, err := c.StartCommit(dataRepo, "master")
+	require.NoError(t, err)
+	_, err = c.PutFile(dataRepo, commit.ID, "a", strings.NewReader("a\n"))
+	require.NoError(t, err)
+	_, err = c.PutFile(dataRepo, commit.ID, "b", strings.NewReader("b\n"))
+	require.NoError(t, err)
+	require.NoError(t, c.FinishCommit(dataRepo, commit.ID))
+
+	// 'copy' has one datum per file, and 'concat' one datum for all of them
+	copyPipeline := uniqueString("TestTraceFile_copy")
+	require.NoError(t, c.CreatePipeline(
+		copyPipeline,
+		"",
+		[]string{"bash"},
+		[]string{
+			fmt.Sprintf("cp /pfs/%s/* /pfs/out/", dataRepo),
+		},
+		nil,
+		client.NewAtomInput(dataRepo, "/*"),
+		"",
+		false,
+	))
+	concatPipeline := uniqueString("TestTraceFile_concat")
+	require.NoError(t, c.CreatePipeline(
+		concatPipeline,
+		"",
+		[]string{"bash"},
+		[]string{
+			fmt.Sprintf("cat /pfs/%s/* > /pfs/out/all", copyPipeline),
+		},
+		nil,
+		client.NewAtomInput(copyPipeline, "/"),
+		"",
+		false,
+	))
+
+	commitIter, err := c.FlushCommit([]*pfs.Commit{commit}, nil)
+	require.NoError(t, err)
+	commitInfos := collectCommitInfos(t, commitIter)
+	require.Equal(t, 2, len(commitInfos))
+
+	// The job's lineage is recorded just after its output commit is finished
+	var trace *pps.FileTrace
+	require.NoError(t, backoff.Retry(func() error {
+		trace, err = c.TraceFile(concatPipeline, "master", "/all", 0)
+		if err != nil {
+			return err
+		}
+		if len(trace.Datums) != 1 {
+			return fmt.Errorf("expected 1 datum, got %d", len(trace.Datums))
+		}
+		return nil
+	}, backoff.NewTestingBackOff()))
+	require.NotNil(t, trace.Job)
+	require.Equal(t, 1, len(trace.Datums[0].Inputs))
+	copyTrace := trace.Datums[0].Inputs[0]
+	require.Equal(t, copyPipeline, copyTrace.File.Commit.Repo.Name)
+	require.NotNil(t, copyTrace.Job)
+	require.Equal(t, 2, len(copyTrace.Datums))
+	var inputs []string
+	for _, datum := range copyTrace.Datums {
+		require.Equal(t, 1, len(datum.Inputs))
+		input := datum.Inputs[0]
+		require.Equal(t, commit.ID, input.File.Commit.ID)
+		// Files in input repos weren't written by a job
+		require.True(t, input.Job == nil)
+		require.Equal(t, 0, len(input.Datums))
+		inputs = append(inputs, input.File.Path)
+	}
+	sort.Strings(inputs)
+	require.Equal(t, []string{"/a", "/b"}, inputs)
+
+	// Tracing a single file of 'copy' only finds the datum that wrote it
+	trace, err = c.TraceFile(copyPipeline, "master", "/b", 0)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(trace.Datums))
+	require.Equal(t, "/b", trace.Datums[0].Inputs[0].File.Path)
+
+	// A depth of 1 stops at the inputs of 'concat'
+	trace, err = c.TraceFile(concatPipeline, "master", "/all", 1)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(trace.Datums))
+	require.True(t, trace.Datums[0].Inputs[0].Job == nil)
+}
+
+func TestImpactAnalysis(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+	c := getPachClient(t)
+	defer require.NoError(t, c.DeleteAll())
+
+	dataRepo := uniqueString("TestImpactAnalysis_data")
+	require.NoError(t, c.CreateRepo(dataRepo))
+	commit, err := c.StartCommit(dataRepo, "master")
+	require.NoError(t, err)
+	_, err = c.PutFile(dataRepo, commit.ID, "a", strings.NewReader("a\n"))
+	require.NoError(t, err)
+	_, err = c.PutFile(dataRepo, commit.ID, "b", strings.NewReader("b\n"))
+	require.NoError(t, err)
+	require.NoError(t, c.FinishCommit(dataRepo, commit.ID))
+
+	copyPipeline := uniqueString("TestImpactAnalysis_copy")
+	require.NoError(t, c.CreatePipeline(
+		copyPipeline,
+		"",
+		[]string{"bash"},
+		[]string{
+			fmt.Sprintf("cp /pfs/%s/* /pfs/out/", dataRepo),
+		},
+		nil,
+		client.NewAtomInput(dataRepo, "/*"),
+		"",
+		false,
+	))
+	concatPipeline := uniqueString("TestImpactAnalysis_concat")
+	require.NoError(t, c.CreatePipeline(
+		concatPipeline,
+		"",
+		[]string{"bash"},
+		[]string{
+			fmt.Sprintf("cat /pfs/%s/* > /pfs/out/all", copyPipeline),
+		},
+		nil,
+		client.NewAtomInput(copyPipeline, "/"),
+		"",
+		false,
+	))
+	commitIter, err := c.FlushCommit([]*pfs.Commit{commit}, nil)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(collectCommitInfos(t, commitIter)))
+	for _, pipeline := range []string{copyPipeline, concatPipeline} {
+		jobInfos, err := c.ListJob(pipeline, nil, nil)
+		require.NoError(t, err)
+		require.Equal(t, 1, len(jobInfos))
+		_, err = c.InspectJob(jobInfos[0].Job.ID, true)
+		require.NoError(t, err)
+	}
+
+	// Changing /a invalidates one datum of 'copy', and through its output,
+	// the only datum of 'concat'
+	impacts, err := c.ImpactAnalysis(dataRepo, "master", []string{"/a"})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(impacts))
+	require.Equal(t, copyPipeline, impacts[0].Pipeline.Name)
+	require.Equal(t, int64(2), impacts[0].DatumsTotal)
+	require.Equal(t, int64(1), impacts[0].DatumsInvalidated)
+	require.Equal(t, int64(1), impacts[0].DatumsReused)
+	require.NotNil(t, impacts[0].EstimatedProcessTime)
+	require.Equal(t, concatPipeline, impacts[1].Pipeline.Name)
+	require.Equal(t, int64(1), impacts[1].DatumsTotal)
+	require.Equal(t, int64(1), impacts[1].DatumsInvalidated)
+
+	// A path that no datum reads doesn't invalidate anything, so nothing
+	// downstream of 'copy' is affected
+	impacts, err = c.ImpactAnalysis(dataRepo, "master", []string{"/c"})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(impacts))
+	require.Equal(t, int64(0), impacts[0].DatumsInvalidated)
+	require.Equal(t, int64(2), impacts[0].DatumsReused)
+}
+
+func TestListDatumForInput(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+	c := getPachClient(t)
+	defer require.NoError(t, c.DeleteAll())
+
+	dataRepo := uniqueString("TestListDatumForInput_data")
+	require.NoError(t, c.CreateRepo(dataRepo))
+	commit, err := c.StartCommit(dataRepo, "master")
+	require.NoError(t, err)
+	for name, size := range map[string]int{"a": 1, "b": 2000, "c": 5000} {
+		_, err = c.PutFile(dataRepo, commit.ID, name, strings.NewReader(strings.Repeat("x", size)))
+		require.NoError(t, err)
+	}
+	require.NoError(t, c.FinishCommit(dataRepo, commit.ID))
+
+	pipeline := uniqueString("TestListDatumForInput")
+	resp, err := c.ListDatumForInput(pipeline, client.NewAtomInput(dataRepo, "/*"), 2, 0)
+	require.NoError(t, err)
+	require.Equal(t, int64(3), resp.DatumCount)
+	require.Equal(t, int64(7001), resp.TotalBytes)
+	require.Equal(t, int64(1), resp.MinDatumBytes)
+	require.Equal(t, int64(5000), resp.MaxDatumBytes)
+	require.Equal(t, int64(2), resp.TotalPages)
+	// Datums are ordered largest first
+	require.Equal(t, 2, len(resp.DatumInfos))
+	require.Equal(t, "/c", resp.DatumInfos[0].Data[0].File.Path)
+	require.Equal(t, commit.ID, resp.DatumInfos[0].Data[0].File.Commit.ID)
+	require.Equal(t, 3, len(resp.SizeHistogram))
+	for i, bounds := range [][2]int64{{0, 1024}, {1024, 4096}, {4096, 16384}} {
+		require.Equal(t, bounds[0], resp.SizeHistogram[i].LowerBoundBytes)
+		require.Equal(t, bounds[1], resp.SizeHistogram[i].UpperBoundBytes)
+		require.Equal(t, int64(1), resp.SizeHistogram[i].Count)
+	}
+
+	resp, err = c.ListDatumForInput(pipeline, client.NewAtomInput(dataRepo, "/"), 0, 0)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), resp.DatumCount)
+	require.Equal(t, 1, len(resp.DatumInfos))
+	require.Equal(t, int64(7001), resp.MaxDatumBytes)
+
+	// Nothing was created
+	pipelineInfos, err := c.ListPipeline()
+	require.NoError(t, err)
+	require.Equal(t, 0, len(pipelineInfos))
+
+	_, err = c.ListDatumForInput(pipeline, client.NewAtomInput("nonexistent", "/*"), 0, 0)
+	require.YesError(t, err)
+}
+
+func TestPipelinePodPatch(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+
+	c := getPachClient(t)
+	defer require.NoError(t, c.DeleteAll())
+	dataRepo := uniqueString("TestPipelinePodPatch_data")
+	pipelineName := uniqueString("TestPipelinePodPatch_Pipeline")
+	require.NoError(t, c.CreateRepo(dataRepo))
+	request := &pps.CreatePipelineRequest{
+		Pipeline: &pps.Pipeline{pipelineName},
+		Transform: &pps.Transform{
+			Cmd: []string{"bash"},
+			Stdin: []string{
+				fmt.Sprintf("cp /pfs/%s/file /pfs/out/file", dataRepo),
+				"echo $PATCHED > /pfs/out/env",
+				"mount | grep /dev/shm-patched > /pfs/out/mount",
+			},
+		},
+		ParallelismSpec: &pps.ParallelismSpec{
+			Constant: 1,
+		},
+		ResourceLimits: &pps.ResourceSpec{
+			Memory: "1G",
+		},
+		SchedulingSpec: &pps.SchedulingSpec{
+			Tolerations: []*pps.Toleration{{Key: "dedicated", Operator: "Exists", Effect: "NoSchedule"}},
+		},
+		PodPatch: `{
+			"containers": [{
+				"name": "user",
+				"env": [{"name": "PATCHED", "value": "yes"}],
+				"volumeMounts": [{"name": "shm", "mountPath": "/dev/shm-patched"}]
+			}],
+			"volumes": [{"name": "shm", "emptyDir": {"medium": "Memory"}}]
+		}`,
+		Input: client.NewAtomInput(dataRepo, "/*"),
+	}
+
+	// Patches that break the workers are rejected
+	badRequest := *request
+	badRequest.PodPatch = `[{"op": "remove", "path": "/containers/0"}]`
+	_, err := c.PpsAPIClient.CreatePipeline(context.Background(), &badRequest)
+	require.YesError(t, err)
+	badRequest.PodPatch = `{"containers": [{"name": "user"`
+	_, err = c.PpsAPIClient.CreatePipeline(context.Background(), &badRequest)
+	require.YesError(t, err)
+	badRequest.PodPatch = ""
+	badRequest.ResourceSpec = &pps.ResourceSpec{Memory: "2G"}
+	_, err = c.PpsAPIClient.CreatePipeline(context.Background(), &badRequest)
+	require.YesError(t, err)
+
+	_, err = c.PpsAPIClient.CreatePipeline(context.Background(), request)
+	require.NoError(t, err)
+
+	// The worker spec shows the patched pod
+	pipelineInfo, err := c.InspectPipelineWorkerSpec(pipelineName, 0)
+	require.NoError(t, err)
+	var workerSpec api.PodTemplateSpec
+	require.NoError(t, json.Unmarshal([]byte(pipelineInfo.WorkerSpec), &workerSpec))
+	require.True(t, strings.Contains(workerSpec.Annotations[api.TolerationsAnnotationKey], "dedicated"))
+	userContainer := workerSpec.Spec.Containers[0]
+	mem := userContainer.Resources.Limits[api.ResourceMemory]
+	require.Equal(t, "1G", mem.String())
+	require.OneOfEquals(t, "PATCHED", envNames(userContainer.Env))
+	pipelineInfo, err = c.InspectPipeline(pipelineName)
+	require.NoError(t, err)
+	require.Equal(t, "", pipelineInfo.WorkerSpec)
+
+	commit, err := c.StartCommit(dataRepo, "master")
+	require.NoError(t, err)
+	_, err = c.PutFile(dataRepo, commit.ID, "file", strings.NewReader("foo\n"))
+	require.NoError(t, err)
+	require.NoError(t, c.FinishCommit(dataRepo, commit.ID))
+	commitIter, err := c.FlushCommit([]*pfs.Commit{commit}, nil)
+	require.NoError(t, err)
+	commitInfos := collectCommitInfos(t, commitIter)
+	require.Equal(t, 1, len(commitInfos))
+	var buf bytes.Buffer
+	require.NoError(t, c.GetFile(pipelineName, commitInfos[0].Commit.ID, "env", 0, 0, &buf))
+	require.Equal(t, "yes\n", buf.String())
+	buf.Reset()
+	require.NoError(t, c.GetFile(pipelineName, commitInfos[0].Commit.ID, "mount", 0, 0, &buf))
+	require.True(t, strings.Contains(buf.String(), "tmpfs"))
+}
+
+func envNames(env []api.EnvVar) []string {
+	var result []string
+	for _, envVar := range env {
+		result = append(result, envVar.Name)
+	}
+	return result
+}
+
+func TestAutoscaling(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+
+	c := getPachClient(t)
+	defer require.NoError(t, c.DeleteAll())
+	dataRepo := uniqueString("TestAutoscaling_data")
+	pipelineName := uniqueString("TestAutoscaling_Pipeline")
+	require.NoError(t, c.CreateRepo(dataRepo))
+	request := &pps.CreatePipelineRequest{
+		Pipeline: &pps.Pipeline{pipelineName},
+		Transform: &pps.Transform{
+			Cmd: []string{"bash"},
+			Stdin: []string{
+				"sleep 5",
+				fmt.Sprintf("cp /pfs/%s/* /pfs/out/", dataRepo),
+			},
+		},
+		AutoscalingSpec: &pps.AutoscalingSpec{
+			MinWorkers:      1,
+			MaxWorkers:      3,
+			TargetQueueSize: 2,
+			ScaleUpCooldown: types.DurationProto(time.Second),
+		},
+		Input: client.NewAtomInput(dataRepo, "/*"),
+	}
+
+	// Invalid specs are rejected
+	badRequest := *request
+	badRequest.AutoscalingSpec = &pps.AutoscalingSpec{MinWorkers: 3, MaxWorkers: 1}
+	_, err := c.PpsAPIClient.CreatePipeline(context.Background(), &badRequest)
+	require.YesError(t, err)
+	badRequest.AutoscalingSpec = request.AutoscalingSpec
+	badRequest.ScaleDownThreshold = types.DurationProto(time.Minute)
+	_, err = c.PpsAPIClient.CreatePipeline(context.Background(), &badRequest)
+	require.YesError(t, err)
+
+	_, err = c.PpsAPIClient.CreatePipeline(context.Background(), request)
+	require.NoError(t, err)
+
+	// The pipeline starts with min_workers workers
+	pipelineInfo, err := c.InspectPipeline(pipelineName)
+	require.NoError(t, err)
+	require.NoError(t, backoff.Retry(func() error {
+		rc, err := pipelineRc(t, pipelineInfo)
+		if err != nil {
+			return err
+		}
+		if rc.Spec.Replicas != 1 {
+			return fmt.Errorf("expected 1 worker, got %d", rc.Spec.Replicas)
+		}
+		return nil
+	}, backoff.NewTestingBackOff()))
+
+	commit, err := c.StartCommit(dataRepo, "master")
+	require.NoError(t, err)
+	for i := 0; i < 20; i++ {
+		_, err = c.PutFile(dataRepo, commit.ID, fmt.Sprintf("file%d", i), strings.NewReader("foo"))
+		require.NoError(t, err)
+	}
+	require.NoError(t, c.FinishCommit(dataRepo, commit.ID))
+
+	// With 20 slow datums pending, the pipeline is scaled up to max_workers
+	require.NoError(t, backoff.Retry(func() error {
+		pipelineInfo, err := c.InspectPipeline(pipelineName)
+		if err != nil {
+			return err
+		}
+		status := pipelineInfo.AutoscalingStatus
+		if status == nil {
+			return fmt.Errorf("no autoscaling status yet")
+		}
+		if status.CurrentWorkers != 3 {
+			return fmt.Errorf("expected 3 workers, got %d (%s)", status.CurrentWorkers, status.Reason)
+		}
+		return nil
+	}, backoff.NewTestingBackOff()))
+
+	commitIter, err := c.FlushCommit([]*pfs.Commit{commit}, nil)
+	require.NoError(t, err)
+	commitInfos := collectCommitInfos(t, commitIter)
+	require.Equal(t, 1, len(commitInfos))
+	fileInfos, err := c.ListFile(pipelineName, commitInfos[0].Commit.ID, "/")
+	require.NoError(t, err)
+	require.Equal(t, 20, len(fileInfos))
+}
+
+func TestAdmissionPolicy(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+
+	c := getPachClient(t)
+	defer require.NoError(t, c.DeleteAll())
+	defer require.NoError(t, c.SetAdmissionPolicy(&pps.AdmissionPolicy{}))
+	require.YesError(t, c.SetAdmissionPolicy(&pps.AdmissionPolicy{DefaultPriorityClass: "missing"}))
+	require.NoError(t, c.SetAdmissionPolicy(&pps.AdmissionPolicy{
+		PriorityClasses: []*pps.PriorityClass{
+			{Name: "high", Priority: 10},
+			{Name: "low", Priority: 1},
+		},
+		DefaultPriorityClass: "low",
+		MaxRunningJobs:       1,
+	}))
+	policy, err := c.GetAdmissionPolicy()
+	require.NoError(t, err)
+	require.Equal(t, int64(1), policy.MaxRunningJobs)
+
+	dataRepo := uniqueString("TestAdmissionPolicy_data")
+	require.NoError(t, c.CreateRepo(dataRepo))
+	createPipeline := func(name string, priorityClass string) error {
+		_, err := c.PpsAPIClient.CreatePipeline(context.Background(), &pps.CreatePipelineRequest{
+			Pipeline: &pps.Pipeline{name},
+			Transform: &pps.Transform{
+				Cmd: []string{"bash"},
+				Stdin: []string{
+					"sleep 20",
+					fmt.Sprintf("cp /pfs/%s/* /pfs/out/", dataRepo),
+				},
+			},
+			ParallelismSpec: &pps.ParallelismSpec{
+				Constant: 1,
+			},
+			Input:         client.NewAtomInput(dataRepo, "/*"),
+			PriorityClass: priorityClass,
+		})
+		return err
+	}
+	require.YesError(t, createPipeline(uniqueString("bad"), "missing"))
+	pipelineA := uniqueString("TestAdmissionPolicyA")
+	pipelineB := uniqueString("TestAdmissionPolicyB")
+	require.NoError(t, createPipeline(pipelineA, ""))
+	require.NoError(t, createPipeline(pipelineB, "high"))
+
+	commit, err := c.StartCommit(dataRepo, "master")
+	require.NoError(t, err)
+	_, err = c.PutFile(dataRepo, commit.ID, "file", strings.NewReader("foo"))
+	require.NoError(t, err)
+	require.NoError(t, c.FinishCommit(dataRepo, commit.ID))
+
+	// Only one job runs at once, and the other waits with a reason
+	require.NoError(t, backoff.Retry(func() error {
+		jobInfos, err := c.ListJob("", nil, nil)
+		if err != nil {
+			return err
+		}
+		if len(jobInfos) != 2 {
+			return fmt.Errorf("expected 2 jobs, got %d", len(jobInfos))
+		}
+		var queued int
+		for _, jobInfo := range jobInfos {
+			if jobInfo.State == pps.JobState_JOB_QUEUED && jobInfo.Reason != "waiting for admission" {
+				queued++
+			}
+		}
+		if queued != 1 {
+			return fmt.Errorf("expected 1 queued job with a reason, got %d", queued)
+		}
+		return nil
+	}, backoff.NewTestingBackOff()))
+
+	commitIter, err := c.FlushCommit([]*pfs.Commit{commit}, nil)
+	require.NoError(t, err)
+	commitInfos := collectCommitInfos(t, commitIter)
+	require.Equal(t, 2, len(commitInfos))
+	jobInfos, err := c.ListJob("", nil, nil)
+	require.NoError(t, err)
+	for _, jobInfo := range jobInfos {
+		require.Equal(t, pps.JobState_JOB_SUCCESS, jobInfo.State)
+	}
+}
+
+func TestRenameInputRepo(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+
+	c := getPachClient(t)
+	defer require.NoError(t, c.DeleteAll())
+	dataRepo := uniqueString("TestRenameInputRepo_data")
+	require.NoError(t, c.CreateRepo(dataRepo))
+	pipelineName := uniqueString("TestRenameInputRepo_pipeline")
+	require.NoError(t, c.CreatePipeline(
+		pipelineName,
+		"",
+		[]string{"bash"},
+		[]string{"cp /pfs/*/* /pfs/out/"},
+		&pps.ParallelismSpec{
+			Constant: 1,
+		},
+		client.NewAtomInput(dataRepo, "/*"),
+		"",
+		false,
+	))
+	commit, err := c.StartCommit(dataRepo, "master")
+	require.NoError(t, err)
+	_, err = c.PutFile(dataRepo, commit.ID, "foo", strings.NewReader("foo\n"))
+	require.NoError(t, err)
+	require.NoError(t, c.FinishCommit(dataRepo, commit.ID))
+	commitIter, err := c.FlushCommit([]*pfs.Commit{commit}, nil)
+	require.NoError(t, err)
+	commitInfos := collectCommitInfos(t, commitIter)
+	require.Equal(t, 1, len(commitInfos))
+
+	// The output repo of a pipeline can't be renamed
+	require.YesError(t, c.RenameRepo(pipelineName, uniqueString("renamed")))
+
+	newName := uniqueString("TestRenameInputRepo_renamed")
+	require.NoError(t, c.RenameRepo(dataRepo, newName))
+	pipelineInfo, err := c.InspectPipeline(pipelineName)
+	require.NoError(t, err)
+	require.Equal(t, newName, pipelineInfo.Input.Atom.Repo)
+	// The input keeps its name, so the pipeline's code still works
+	require.Equal(t, dataRepo, pipelineInfo.Input.Atom.Name)
+	repoInfo, err := c.InspectRepo(pipelineName)
+	require.NoError(t, err)
+	require.Equal(t, newName, repoInfo.Provenance[0].Name)
+
+	// The pipeline processes the renamed repo's new commits
+	commit, err = c.StartCommit(newName, "master")
+	require.NoError(t, err)
+	_, err = c.PutFile(newName, commit.ID, "bar", strings.NewReader("bar\n"))
+	require.NoError(t, err)
+	require.NoError(t, c.FinishCommit(newName, commit.ID))
+	commitIter, err = c.FlushCommit([]*pfs.Commit{commit}, nil)
+	require.NoError(t, err)
+	commitInfos = collectCommitInfos(t, commitIter)
+	require.Equal(t, 1, len(commitInfos))
+	var buf bytes.Buffer
+	require.NoError(t, c.GetFile(pipelineName, commitInfos[0].Commit.ID, "bar", 0, 0, &buf))
+	require.Equal(t, "bar\n", buf.String())
+}
+
+func TestSpout(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+
+	c := getPachClient(t)
+	defer require.NoError(t, c.DeleteAll())
+	pipelineName := uniqueString("TestSpout")
+	request := &pps.CreatePipelineRequest{
+		Pipeline: &pps.Pipeline{pipelineName},
+		Transform: &pps.Transform{
+			Cmd: []string{"bash"},
+			Stdin: []string{
+				// Resume from the marker, and write one file (and the
+				// marker) per write
+				"n=$(cat /pfs/offset 2>/dev/null || echo 0)",
+				"mkdir -p /tmp/spout",
+				"while true; do",
+				"  n=$((n+1))",
+				"  echo $n >/tmp/spout/offset",
+				"  echo foo >/tmp/spout/file$n",
+				"  tar -C /tmp/spout -cf /pfs/out file$n offset",
+				"  rm /tmp/spout/file$n",
+				"  sleep 1",
+				"done",
+			},
+		},
+		Spout: &pps.Spout{Marker: "offset"},
+	}
+
+	// Spouts can't have an input
+	badRequest := *request
+	badRequest.Input = client.NewAtomInput("foo", "/*")
+	_, err := c.PpsAPIClient.CreatePipeline(context.Background(), &badRequest)
+	require.YesError(t, err)
+	badRequest.Input = nil
+	badRequest.Spout = &pps.Spout{Marker: "out"}
+	_, err = c.PpsAPIClient.CreatePipeline(context.Background(), &badRequest)
+	require.YesError(t, err)
+
+	_, err = c.PpsAPIClient.CreatePipeline(context.Background(), request)
+	require.NoError(t, err)
+
+	// Each write is a commit, and the marker matches what's been written
+	require.NoError(t, backoff.Retry(func() error {
+		commitInfos, err := c.ListCommit(pipelineName, "master", "", 0)
+		if err != nil {
+			return err
+		}
+		if len(commitInfos) < 3 {
+			return fmt.Errorf("expected at least 3 commits, got %d", len(commitInfos))
+		}
+		return nil
+	}, backoff.NewTestingBackOff()))
+	require.NoError(t, backoff.Retry(func() error {
+		var buf bytes.Buffer
+		if err := c.GetFile(pipelineName, "marker", "/offset", 0, 0, &buf); err != nil {
+			return err
+		}
+		fileInfos, err := c.ListFile(pipelineName, "marker", "/")
+		if err != nil {
+			return err
+		}
+		if len(fileInfos) != 1 {
+			return fmt.Errorf("expected only the marker on the marker branch, got %d files", len(fileInfos))
+		}
+		n := strings.TrimSpace(buf.String())
+		if _, err := c.InspectFile(pipelineName, "master", "/file"+n); err != nil {
+			return fmt.Errorf("marker %s is ahead of the output: %v", n, err)
+		}
+		if _, err := c.InspectFile(pipelineName, "master", "/offset"); err == nil {
+			return fmt.Errorf("marker was written to the output branch")
+		}
+		return nil
+	}, backoff.NewTestingBackOff()))
+
+	// Spouts don't take an input, so they can't be rerun
+	_, err = c.PpsAPIClient.RerunPipeline(context.Background(), &pps.RerunPipelineRequest{
+		Pipeline: client.NewPipeline(pipelineName),
+	})
+	require.YesError(t, err)
+}
+
+func TestServiceRollout(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+	c := getPachClient(t)
+	defer require.NoError(t, c.DeleteAll())
+
+	dataRepo := uniqueString("TestServiceRollout_data")
+	require.NoError(t, c.CreateRepo(dataRepo))
+	commit1, err := c.StartCommit(dataRepo, "master")
+	require.NoError(t, err)
+	_, err = c.PutFile(dataRepo, commit1.ID, "file", strings.NewReader("foo"))
+	require.NoError(t, err)
+	require.NoError(t, c.FinishCommit(dataRepo, commit1.ID))
+
+	pipeline := uniqueString("pipelineservicerollout")
+	request := &pps.CreatePipelineRequest{
+		Pipeline: client.NewPipeline(pipeline),
+		Transform: &pps.Transform{
+			Image: "trinitronx/python-simplehttpserver",
+			Cmd:   []string{"sh"},
+			Stdin: []string{
+				"cd /pfs",
+				"exec python -m SimpleHTTPServer 8000",
+			},
+		},
+		ParallelismSpec: &pps.ParallelismSpec{Constant: 1},
+		Input:           client.NewAtomInput(dataRepo, "/"),
+		Service: &pps.Service{
+			Type: "ClusterIP",
+			Ports: []*pps.ServicePort{
+				{Name: "http", InternalPort: 8000, ExternalPort: 80},
+				{Name: "other", InternalPort: 8001},
+			},
+			ReadinessProbe: &pps.ReadinessProbe{
+				HttpPath: fmt.Sprintf("/%s/file", dataRepo),
+				Period:   types.DurationProto(time.Second),
+			},
+		},
+	}
+
+	// Invalid services are rejected
+	badRequest := *request
+	badRequest.Service = &pps.Service{
+		InternalPort: 8000,
+		Ports:        request.Service.Ports,
+	}
+	_, err = c.PpsAPIClient.CreatePipeline(context.Background(), &badRequest)
+	require.YesError(t, err)
+	badRequest.Service = &pps.Service{
+		Ports: []*pps.ServicePort{{InternalPort: 8000}, {InternalPort: 8001}},
+	}
+	_, err = c.PpsAPIClient.CreatePipeline(context.Background(), &badRequest)
+	require.YesError(t, err)
+
+	_, err = c.PpsAPIClient.CreatePipeline(context.Background(), request)
+	require.NoError(t, err)
+
+	// The service is exposed on both ports
+	pipelineInfo, err := c.InspectPipeline(pipeline)
+	require.NoError(t, err)
+	kubeClient := getKubeClient(t)
+	rcName := ppsserver.PipelineRcName(pipeline, pipelineInfo.Version)
+	require.NoError(t, backoff.Retry(func() error {
+		svc, err := kubeClient.Services("default").Get(rcName + "-user")
+		if err != nil {
+			return err
+		}
+		if svc.Spec.Type != api.ServiceTypeClusterIP || len(svc.Spec.Ports) != 2 {
+			return fmt.Errorf("expected a ClusterIP service with 2 ports, got %v", svc.Spec)
+		}
+		return nil
+	}, backoff.NewTestingBackOff()))
+
+	waitForServing := func(commit *pfs.Commit) {
+		require.NoError(t, backoff.Retry(func() error {
+			pipelineInfo, err := c.InspectPipeline(pipeline)
+			if err != nil {
+				return err
+			}
+			status := pipelineInfo.ServiceStatus
+			if status == nil || len(status.Commits) != 1 || status.Commits[0].ID != commit.ID {
+				return fmt.Errorf("expected to be serving %s, got %v", commit.ID, status)
+			}
+			if status.PendingJob != "" {
+				return fmt.Errorf("expected the rollout to be finished")
+			}
+			return nil
+		}, backoff.NewTestingBackOff()))
+	}
+	waitForServing(commit1)
+
+	// A new commit is rolled out, and the old pod is replaced
+	pods, err := kubeClient.Pods("default").List(api.ListOptions{
+		LabelSelector: labels.SelectorFromSet(map[string]string{"app": rcName}),
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(pods.Items))
+	oldPod := pods.Items[0].Name
+	commit2, err := c.StartCommit(dataRepo, "master")
+	require.NoError(t, err)
+	_, err = c.PutFile(dataRepo, commit2.ID, "file", strings.NewReader("bar"))
+	require.NoError(t, err)
+	require.NoError(t, c.FinishCommit(dataRepo, commit2.ID))
+	waitForServing(commit2)
+	require.NoError(t, backoff.Retry(func() error {
+		pods, err := kubeClient.Pods("default").List(api.ListOptions{
+			LabelSelector: labels.SelectorFromSet(map[string]string{"app": rcName}),
+		})
+		if err != nil {
+			return err
+		}
+		for _, pod := range pods.Items {
+			if pod.Name == oldPod {
+				return fmt.Errorf("old pod %s hasn't been deleted", oldPod)
+			}
+			if pod.Labels[ppsserver.ServingLabel] != "true" {
+				return fmt.Errorf("pod %s isn't receiving traffic", pod.Name)
+			}
+		}
+		return nil
+	}, backoff.NewTestingBackOff()))
+}
+
 func TestListJobOutput(t *testing.T) {
 	if testing.Short() {
 		t.Skip("Skipping integration tests in short mode")
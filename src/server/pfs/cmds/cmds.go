@@ -13,10 +13,13 @@ import (
 	"strings"
 	"syscall"
 	"text/tabwriter"
+	"time"
 
 	"golang.org/x/sync/errgroup"
 
+	"github.com/docker/go-units"
 	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/types"
 	"github.com/pachyderm/pachyderm/src/client"
 	"github.com/pachyderm/pachyderm/src/client/limit"
 	pfsclient "github.com/pachyderm/pachyderm/src/client/pfs"
@@ -195,6 +198,37 @@ func Cmds(noMetrics *bool) []*cobra.Command {
 	deleteRepo.Flags().BoolVarP(&force, "force", "f", false, "remove the repo regardless of errors; use with care")
 	deleteRepo.Flags().BoolVar(&all, "all", false, "remove all repos")
 
+	forkRepo := &cobra.Command{
+		Use:   "fork-repo <repo-name> <fork-name>",
+		Short: "Create a copy of a repo without copying its data.",
+		Long: `Create a new repo with a copy of each finished commit and each branch of a
+repo. The copies share the trees of the original commits, so no data is
+copied, but they have new commit IDs. The fork has no provenance.`,
+		Run: cmdutil.RunFixedArgs(2, func(args []string) error {
+			client, err := client.NewOnUserMachine(metrics, "user")
+			if err != nil {
+				return err
+			}
+			return client.ForkRepo(args[0], args[1], description)
+		}),
+	}
+	forkRepo.Flags().StringVarP(&description, "description", "d", "", "A description of the fork.")
+
+	renameRepo := &cobra.Command{
+		Use:   "rename-repo <repo-name> <new-name>",
+		Short: "Rename a repo.",
+		Long: `Rename a repo. Its commits keep their IDs, and the pipelines that take the
+repo as an input are updated to take the renamed repo (which restarts them).
+Repos with open commits, and the output repos of pipelines, can't be renamed.`,
+		Run: cmdutil.RunFixedArgs(2, func(args []string) error {
+			client, err := client.NewOnUserMachine(metrics, "user")
+			if err != nil {
+				return err
+			}
+			return client.RenameRepo(args[0], args[1])
+		}),
+	}
+
 	commit := &cobra.Command{
 		Use:   "commit",
 		Short: "Docs for commits.",
@@ -271,7 +305,23 @@ $ pachctl start-commit test -p XXX
 	inspectCommit := &cobra.Command{
 		Use:   "inspect-commit repo-name commit-id",
 		Short: "Return info about a commit.",
-		Long:  "Return info about a commit.",
+		Long: `Return info about a commit.
+
+Anywhere a commit is expected, it can be given as a branch, a commit ID, a
+unique prefix of a commit ID, or one of the revision expressions below.
+` + codestart + `# the parent and the grandparent of the head of branch "master"
+$ pachctl inspect-commit foo master^
+$ pachctl inspect-commit foo master~2
+
+# the head of branch "master" at midnight UTC on October 1st 2017
+$ pachctl inspect-commit foo master@{2017-10-01T00:00}
+
+# the head of branch "master" three moves ago
+$ pachctl inspect-commit foo master@{-3}
+
+# the commit of repo "foo" that was made from commit "master" of repo "data"
+$ pachctl inspect-commit foo "provenance-of(data@master)"
+` + codeend,
 		Run: cmdutil.RunFixedArgs(2, func(args []string) error {
 			client, err := client.NewOnUserMachine(metrics, "user")
 			if err != nil {
@@ -554,6 +604,289 @@ $ pachctl set-branch foo test master` + codeend,
 		}),
 	}
 
+	var historyNumber uint64
+	var restore int
+	branchLog := &cobra.Command{
+		Use:   "branch-log <repo-name> <branch-name>",
+		Short: "Return the history of a branch's head.",
+		Long: `Return the history of a branch's head: every move of the branch, newest
+first, with the time, the principal who moved it, and its old and new head.
+Moves are recorded by start-commit, set-branch, delete-branch, delete-commit
+and finish-transaction. The head a branch had n moves ago can be named as
+<branch>@{-n} wherever a commit is expected.
+
+Examples:
+
+` + codestart + `# Return the history of branch master in repo foo.
+$ pachctl branch-log foo master
+
+# Move branch master in repo foo back to the head it had before its last move,
+# e.g. to undo a mistaken set-branch or delete-branch. This is the same as
+# "pachctl set-branch foo master@{-1} master".
+$ pachctl branch-log foo master --restore 1` + codeend,
+		Run: cmdutil.RunFixedArgs(2, func(args []string) error {
+			client, err := client.NewOnUserMachine(metrics, "user")
+			if err != nil {
+				return err
+			}
+			if restore > 0 {
+				return client.RestoreBranch(args[0], args[1], restore)
+			}
+			moves, err := client.ListBranchHistory(args[0], args[1], historyNumber)
+			if err != nil {
+				return err
+			}
+			if raw {
+				for _, move := range moves {
+					if err := marshaller.Marshal(os.Stdout, move); err != nil {
+						return err
+					}
+				}
+				return nil
+			}
+			writer := tabwriter.NewWriter(os.Stdout, 20, 1, 3, ' ', 0)
+			pretty.PrintBranchMoveHeader(writer)
+			for i, move := range moves {
+				pretty.PrintBranchMove(writer, i+1, move)
+			}
+			return writer.Flush()
+		}),
+	}
+	branchLog.Flags().Uint64VarP(&historyNumber, "number", "n", 0, "list only this many moves; if set to zero, list all moves")
+	branchLog.Flags().IntVar(&restore, "restore", 0, "move the branch back to the head it had this many moves ago, instead of listing its history")
+	rawFlag(branchLog)
+
+	var allowedPrincipals cmdutil.RepeatedStringArg
+	var fastForwardOnly bool
+	var requiredPipeline string
+	setBranchProtection := &cobra.Command{
+		Use:   "set-branch-protection <repo-name> <branch-name>",
+		Short: "Restrict how a branch may be moved.",
+		Long: `Restrict how a branch may be moved. The rule applies to set-branch, and
+to start-commit and finish-commit for commits made directly on the branch.
+Setting a rule replaces any existing rule for the branch.
+
+Examples:
+
+` + codestart + `# Only allow alice and bob to move master in repo foo.
+$ pachctl set-branch-protection foo master --principal alice --principal bob
+
+# Only allow master to be moved to commits that the pipeline "validate" has
+# processed successfully, and never to rewind it.
+$ pachctl set-branch-protection foo master --fast-forward-only --require-pipeline validate` + codeend,
+		Run: cmdutil.RunFixedArgs(2, func(args []string) error {
+			client, err := client.NewOnUserMachine(metrics, "user")
+			if err != nil {
+				return err
+			}
+			return client.SetBranchProtection(args[0], &pfsclient.BranchProtection{
+				Branch:            args[1],
+				AllowedPrincipals: allowedPrincipals,
+				FastForwardOnly:   fastForwardOnly,
+				RequiredPipeline:  requiredPipeline,
+			})
+		}),
+	}
+	setBranchProtection.Flags().Var(&allowedPrincipals, "principal", "A principal allowed to move the branch; may be repeated. If unset, anyone with WRITER access may move it.")
+	setBranchProtection.Flags().BoolVar(&fastForwardOnly, "fast-forward-only", false, "Only allow the branch to move to descendants of its current head.")
+	setBranchProtection.Flags().StringVar(&requiredPipeline, "require-pipeline", "", "Only allow the branch to move to commits this pipeline has successfully processed.")
+
+	deleteBranchProtection := &cobra.Command{
+		Use:   "delete-branch-protection <repo-name> <branch-name>",
+		Short: "Remove the protection rule for a branch.",
+		Long:  "Remove the protection rule for a branch.",
+		Run: cmdutil.RunFixedArgs(2, func(args []string) error {
+			client, err := client.NewOnUserMachine(metrics, "user")
+			if err != nil {
+				return err
+			}
+			return client.DeleteBranchProtection(args[0], args[1])
+		}),
+	}
+
+	var keepLast uint64
+	var keepNewerThan time.Duration
+	var keepDaily uint64
+	var keepWeekly uint64
+	setRetentionPolicy := &cobra.Command{
+		Use:   "set-retention-policy <repo-name>",
+		Short: "Set which commits in a repo are kept.",
+		Long: `Set which commits in a repo are kept. Pachyderm periodically deletes the
+finished commits in the repo that none of the flags keep, unless they're the
+head of a branch or in the provenance of a downstream commit. Since each
+commit contains the complete state of the repo, deleting a commit doesn't
+change its descendants. Setting no flags removes the repo's retention policy.
+
+Examples:
+
+` + codestart + `# Keep the last 100 commits in repo foo, and any commits less than a day old.
+$ pachctl set-retention-policy foo --keep-last 100 --keep-newer-than 24h
+
+# Keep one commit per day for the last week, and one per week for the last year.
+$ pachctl set-retention-policy foo --keep-daily 7 --keep-weekly 52` + codeend,
+		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
+			client, err := client.NewOnUserMachine(metrics, "user")
+			if err != nil {
+				return err
+			}
+			var policy *pfsclient.RetentionPolicy
+			if keepLast != 0 || keepNewerThan != 0 || keepDaily != 0 || keepWeekly != 0 {
+				policy = &pfsclient.RetentionPolicy{
+					KeepLast:   keepLast,
+					KeepDaily:  keepDaily,
+					KeepWeekly: keepWeekly,
+				}
+				if keepNewerThan != 0 {
+					policy.KeepNewerThan = types.DurationProto(keepNewerThan)
+				}
+			}
+			return client.SetRetentionPolicy(args[0], policy)
+		}),
+	}
+	setRetentionPolicy.Flags().Uint64Var(&keepLast, "keep-last", 0, "Keep this many of the most recent commits.")
+	setRetentionPolicy.Flags().DurationVar(&keepNewerThan, "keep-newer-than", 0, "Keep commits started less than this long ago, e.g. 720h.")
+	setRetentionPolicy.Flags().Uint64Var(&keepDaily, "keep-daily", 0, "Keep the most recent commit of each of this many days.")
+	setRetentionPolicy.Flags().Uint64Var(&keepWeekly, "keep-weekly", 0, "Keep the most recent commit of each of this many weeks.")
+
+	var dryRun bool
+	applyRetentionPolicy := &cobra.Command{
+		Use:   "apply-retention-policy <repo-name>",
+		Short: "Delete the commits a repo's retention policy doesn't keep.",
+		Long: `Delete the commits a repo's retention policy doesn't keep, and print their
+IDs. Pachyderm does this periodically; this command does it immediately.`,
+		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
+			client, err := client.NewOnUserMachine(metrics, "user")
+			if err != nil {
+				return err
+			}
+			deleted, err := client.ApplyRetentionPolicy(args[0], dryRun)
+			if err != nil {
+				return err
+			}
+			for _, commit := range deleted {
+				fmt.Println(commit.ID)
+			}
+			return nil
+		}),
+	}
+	applyRetentionPolicy.Flags().BoolVar(&dryRun, "dry-run", false, "Print the commits that would be deleted, without deleting them.")
+
+	quota := &cobra.Command{
+		Use:   "quota",
+		Short: "Storage quotas for repos and principals.",
+		Long: `Quotas limit the bytes and commits stored in a repo, or in all of the repos
+created by a principal. Writes that would exceed a quota fail.`,
+	}
+
+	var maxBytes string
+	var maxCommits uint64
+	parseQuota := func() (*pfsclient.Quota, error) {
+		if maxBytes == "" && maxCommits == 0 {
+			return nil, nil
+		}
+		result := &pfsclient.Quota{MaxCommits: maxCommits}
+		if maxBytes != "" {
+			bytes, err := units.FromHumanSize(maxBytes)
+			if err != nil {
+				return nil, err
+			}
+			result.MaxBytes = uint64(bytes)
+		}
+		return result, nil
+	}
+	setRepoQuota := &cobra.Command{
+		Use:   "set-repo <repo-name>",
+		Short: "Set the quota of a repo.",
+		Long: `Set the quota of a repo. Setting no flags removes the repo's quota. Only
+cluster admins may set quotas.
+
+Examples:
+
+` + codestart + `# Limit repo foo to 10GB and 1000 commits.
+$ pachctl quota set-repo foo --max-bytes 10GB --max-commits 1000` + codeend,
+		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
+			client, err := client.NewOnUserMachine(metrics, "user")
+			if err != nil {
+				return err
+			}
+			newQuota, err := parseQuota()
+			if err != nil {
+				return err
+			}
+			return client.SetRepoQuota(args[0], newQuota)
+		}),
+	}
+	setPrincipalQuota := &cobra.Command{
+		Use:   "set-principal <principal>",
+		Short: "Set the quota of a principal.",
+		Long: `Set the quota of a principal, which limits the total data stored in all of
+the repos they created. Setting no flags removes the principal's quota. Only
+cluster admins may set quotas.
+
+Examples:
+
+` + codestart + `# Limit the repos created by alice to 100GB in total.
+$ pachctl quota set-principal github:alice --max-bytes 100GB` + codeend,
+		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
+			client, err := client.NewOnUserMachine(metrics, "user")
+			if err != nil {
+				return err
+			}
+			newQuota, err := parseQuota()
+			if err != nil {
+				return err
+			}
+			return client.SetPrincipalQuota(args[0], newQuota)
+		}),
+	}
+	for _, cmd := range []*cobra.Command{setRepoQuota, setPrincipalQuota} {
+		cmd.Flags().StringVar(&maxBytes, "max-bytes", "", "The maximum size of the data, e.g. 10GB.")
+		cmd.Flags().Uint64Var(&maxCommits, "max-commits", 0, "The maximum number of commits.")
+	}
+
+	quotaUsage := &cobra.Command{
+		Use:   "usage",
+		Short: "Return the storage used by each repo and principal.",
+		Long: `Return the storage used by each repo and principal. Logical bytes count
+each file added in each commit, while deduplicated bytes count each distinct
+file content once. Only cluster admins may view usage.`,
+		Run: cmdutil.RunFixedArgs(0, func(args []string) error {
+			client, err := client.NewOnUserMachine(metrics, "user")
+			if err != nil {
+				return err
+			}
+			usage, err := client.QuotaUsage()
+			if err != nil {
+				return err
+			}
+			if raw {
+				return marshaller.Marshal(os.Stdout, usage)
+			}
+			writer := tabwriter.NewWriter(os.Stdout, 20, 1, 3, ' ', 0)
+			pretty.PrintRepoUsageHeader(writer)
+			for _, repoUsage := range usage.Repos {
+				pretty.PrintRepoUsage(writer, repoUsage)
+			}
+			if err := writer.Flush(); err != nil {
+				return err
+			}
+			if len(usage.Principals) == 0 {
+				return nil
+			}
+			fmt.Println()
+			writer = tabwriter.NewWriter(os.Stdout, 20, 1, 3, ' ', 0)
+			pretty.PrintPrincipalUsageHeader(writer)
+			for _, principalUsage := range usage.Principals {
+				pretty.PrintPrincipalUsage(writer, principalUsage)
+			}
+			return writer.Flush()
+		}),
+	}
+	rawFlag(quotaUsage)
+	quota.AddCommand(setRepoQuota)
+	quota.AddCommand(setPrincipalQuota)
+	quota.AddCommand(quotaUsage)
+
 	file := &cobra.Command{
 		Use:   "file",
 		Short: "Docs for files.",
@@ -731,6 +1064,7 @@ want to consider using commit IDs directly.
 	copyFile.Flags().BoolVarP(&overwrite, "overwrite", "o", false, "Overwrite the existing content of the file, either from previous commits or previous calls to put-file within this commit.")
 
 	var outputPath string
+	var archive string
 	getFile := &cobra.Command{
 		Use:   "get-file repo-name commit-id path/to/file",
 		Short: "Return the contents of a file.",
@@ -745,12 +1079,23 @@ $ pachctl get-file foo master^ XXX
 # get file "XXX" in the grandparent of the current head of branch "master"
 # in repo "foo"
 $ pachctl get-file foo master^2 XXX
+
+# get directory "dir" on branch "master" in repo "foo", as a gzipped tar
+# archive built by pachd
+$ pachctl get-file foo master dir --archive tar.gz -o dir.tar.gz
+
+# get all the .csv files in directory "dir" on branch "master" in repo "foo",
+# as a zip archive
+$ pachctl get-file foo master "dir/*.csv" --archive zip -o csvs.zip
 ` + codeend,
 		Run: cmdutil.RunFixedArgs(3, func(args []string) error {
 			client, err := client.NewOnUserMachine(metrics, "user")
 			if err != nil {
 				return err
 			}
+			if recursive && archive != "" {
+				return fmt.Errorf("only one of --recursive and --archive may be given")
+			}
 			if recursive {
 				if outputPath == "" {
 					return fmt.Errorf("an output path needs to be specified when using the --recursive flag")
@@ -758,6 +1103,12 @@ $ pachctl get-file foo master^2 XXX
 				puller := sync.NewPuller()
 				return puller.Pull(client, outputPath, args[0], args[1], args[2], false, int(parallelism), nil, "")
 			}
+			var format pfsclient.ArchiveFormat
+			if archive != "" {
+				if format, err = pfsclient.ParseArchiveFormat(archive); err != nil {
+					return err
+				}
+			}
 			var w io.Writer
 			// If an output path is given, print the output to stdout
 			if outputPath == "" {
@@ -770,10 +1121,14 @@ $ pachctl get-file foo master^2 XXX
 				defer f.Close()
 				w = f
 			}
+			if archive != "" {
+				return client.GetArchive(args[0], args[1], args[2], format, w)
+			}
 			return client.GetFile(args[0], args[1], args[2], 0, 0, w)
 		}),
 	}
 	getFile.Flags().BoolVarP(&recursive, "recursive", "r", false, "Recursively download a directory.")
+	getFile.Flags().StringVar(&archive, "archive", "", "Download the file, or the directory and everything under it, as an archive built by pachd, in this format: `tar`, `tar.gz` or `zip`. The path may be a glob pattern.")
 	getFile.Flags().StringVarP(&outputPath, "output", "o", "", "The path where data will be downloaded.")
 	getFile.Flags().UintVarP(&parallelism, "parallelism", "p", DefaultParallelism, "The maximum number of files that can be downloaded in parallel")
 
@@ -895,6 +1250,49 @@ $ pachctl glob-file foo master "data/*"
 	}
 	rawFlag(globFile)
 
+	var grepMaxMatches int64
+	var grepMaxBytes string
+	grep := &cobra.Command{
+		Use:   "grep repo-name commit-id pattern regex",
+		Short: "Search for lines matching a regex in the files that match a glob pattern in a commit.",
+		Long: `Search for lines matching a regex in the files that match a glob pattern in a
+commit. The files are searched inside the cluster, so they aren't downloaded.
+Regexes use the [RE2 syntax](https://github.com/google/re2/wiki/Syntax).
+Matching lines are printed as "path:line-number:line".
+
+Examples:
+
+` + codestart + `# Search for the record ID "a0b1c2" in the CSV files under "data" in repo
+# "foo" on branch "master".
+$ pachctl grep foo master "data/*.csv" a0b1c2
+
+# Return at most 10 matches, and stop if more than 1GB would be scanned.
+$ pachctl grep foo master "*" "error|warning" --max-matches 10 --max-bytes 1GB
+` + codeend,
+		Run: cmdutil.RunFixedArgs(4, func(args []string) error {
+			client, err := client.NewOnUserMachine(metrics, "user")
+			if err != nil {
+				return err
+			}
+			var maxBytes int64
+			if grepMaxBytes != "" {
+				if maxBytes, err = units.FromHumanSize(grepMaxBytes); err != nil {
+					return err
+				}
+			}
+			return client.GrepFile(args[0], args[1], args[2], args[3], grepMaxMatches, maxBytes, func(result *pfsclient.GrepFileResult) error {
+				if raw {
+					return marshaller.Marshal(os.Stdout, result)
+				}
+				fmt.Printf("%s:%d:%s\n", result.File.Path, result.LineNumber, result.Line)
+				return nil
+			})
+		}),
+	}
+	grep.Flags().Int64Var(&grepMaxMatches, "max-matches", 0, "The maximum number of matching lines to return (0 means no limit).")
+	grep.Flags().StringVar(&grepMaxBytes, "max-bytes", "", "Fail if more than this many bytes would be scanned, e.g. 1GB (empty means no limit).")
+	rawFlag(grep)
+
 	var shallow bool
 	diffFile := &cobra.Command{
 		Use:   "diff-file new-repo-name new-commit-id new-path [old-repo-name old-commit-id old-path]",
@@ -1080,6 +1478,8 @@ $ pachctl diff-file foo master path1 bar master path2
 	result = append(result, inspectRepo)
 	result = append(result, listRepo)
 	result = append(result, deleteRepo)
+	result = append(result, forkRepo)
+	result = append(result, renameRepo)
 	result = append(result, commit)
 	result = append(result, startCommit)
 	result = append(result, finishCommit)
@@ -1091,6 +1491,12 @@ $ pachctl diff-file foo master path1 bar master path2
 	result = append(result, listBranch)
 	result = append(result, setBranch)
 	result = append(result, deleteBranch)
+	result = append(result, branchLog)
+	result = append(result, setBranchProtection)
+	result = append(result, deleteBranchProtection)
+	result = append(result, setRetentionPolicy)
+	result = append(result, applyRetentionPolicy)
+	result = append(result, quota)
 	result = append(result, file)
 	result = append(result, putFile)
 	result = append(result, copyFile)
@@ -1098,6 +1504,7 @@ $ pachctl diff-file foo master path1 bar master path2
 	result = append(result, inspectFile)
 	result = append(result, listFile)
 	result = append(result, globFile)
+	result = append(result, grep)
 	result = append(result, diffFile)
 	result = append(result, deleteFile)
 	result = append(result, getObject)
@@ -41,6 +41,22 @@ type ErrParentCommitNotFound struct {
 	Commit *pfs.Commit
 }
 
+// ErrBranchProtected represents an attempt to move a branch in a way that its
+// protection rule forbids.
+type ErrBranchProtected struct {
+	Repo   *pfs.Repo
+	Branch string
+	Reason string
+}
+
+// ErrQuotaExceeded represents a write that would exceed the quota of a repo,
+// or of the principal who created it (if Principal is set).
+type ErrQuotaExceeded struct {
+	Repo      *pfs.Repo
+	Principal string
+	Reason    string
+}
+
 func (e ErrFileNotFound) Error() string {
 	return fmt.Sprintf("file %v not found in repo %v at commit %v", e.File.Path, e.File.Commit.Repo.Name, e.File.Commit.ID)
 }
@@ -69,6 +85,18 @@ func (e ErrParentCommitNotFound) Error() string {
 	return fmt.Sprintf("parent commit %v not found in repo %v", e.Commit.ID, e.Commit.Repo.Name)
 }
 
+func (e ErrBranchProtected) Error() string {
+	return fmt.Sprintf("branch %v in repo %v is protected: %v", e.Branch, e.Repo.Name, e.Reason)
+}
+
+func (e ErrQuotaExceeded) Error() string {
+	// The "quota exceeded" prefix is matched by pfs.IsQuotaExceededError
+	if e.Principal != "" {
+		return fmt.Sprintf("quota exceeded for principal %v (writing to repo %v): %v", e.Principal, e.Repo.Name, e.Reason)
+	}
+	return fmt.Sprintf("quota exceeded for repo %v: %v", e.Repo.Name, e.Reason)
+}
+
 // ByteRangeSize returns byteRange.Upper - byteRange.Lower.
 func ByteRangeSize(byteRange *pfs.ByteRange) uint64 {
 	return byteRange.Upper - byteRange.Lower
@@ -5,6 +5,7 @@ import (
 	"html/template"
 	"io"
 	"os"
+	"strings"
 
 	"github.com/docker/go-units"
 	"github.com/pachyderm/pachyderm/src/client/pfs"
@@ -43,7 +44,12 @@ Description: {{.Description}}{{end}}
 Created: {{prettyAgo .Created}}
 Size: {{prettySize .SizeBytes}}{{if .Provenance}}
 Provenance: {{range .Provenance}} {{.Name}} {{end}}{{end}}{{if .AuthInfo}}
-Access level: {{ .AuthInfo.AccessLevel.String }}{{end}}
+Access level: {{ .AuthInfo.AccessLevel.String }}{{end}}{{if .BranchProtections}}
+Protected branches:{{range .BranchProtections}}
+  {{.Branch}}:{{if .AllowedPrincipals}} principals:{{range .AllowedPrincipals}} {{.}}{{end}};{{end}}{{if .FastForwardOnly}} fast-forward only;{{end}}{{if .RequiredPipeline}} requires pipeline {{.RequiredPipeline}};{{end}}{{end}}{{end}}{{with .RetentionPolicy}}
+Retention policy:{{if .KeepLast}} keep last {{.KeepLast}};{{end}}{{if .KeepNewerThan}} keep newer than {{prettyDuration .KeepNewerThan}};{{end}}{{if .KeepDaily}} keep {{.KeepDaily}} daily;{{end}}{{if .KeepWeekly}} keep {{.KeepWeekly}} weekly;{{end}}{{end}}{{if .Quota}}
+Quota: {{prettyQuota .Quota}}{{end}}{{if .CreatedBy}}
+Created by: {{.CreatedBy}}{{end}}
 `)
 	if err != nil {
 		return err
@@ -66,6 +72,80 @@ func PrintBranch(w io.Writer, branch *pfs.BranchInfo) {
 	fmt.Fprintf(w, "%s\t\n", branch.Head.ID)
 }
 
+// PrintBranchMoveHeader prints a branch move header.
+func PrintBranchMoveHeader(w io.Writer) {
+	fmt.Fprint(w, "MOVE\tTIME\tPRINCIPAL\tOPERATION\tOLD HEAD\tNEW HEAD\t\n")
+}
+
+// PrintBranchMove pretty-prints a branch move, which was the 'n'th most recent
+// move of its branch.
+func PrintBranchMove(w io.Writer, n int, move *pfs.BranchMove) {
+	fmt.Fprintf(w, "%d\t", n)
+	fmt.Fprintf(w, "%s\t", pretty.Ago(move.Time))
+	if move.Principal != "" {
+		fmt.Fprintf(w, "%s\t", move.Principal)
+	} else {
+		fmt.Fprint(w, "-\t")
+	}
+	fmt.Fprintf(w, "%s\t", move.Operation)
+	for _, head := range []*pfs.Commit{move.OldHead, move.NewHead} {
+		if head != nil {
+			fmt.Fprintf(w, "%s\t", head.ID)
+		} else {
+			fmt.Fprint(w, "<none>\t")
+		}
+	}
+	fmt.Fprintln(w)
+}
+
+// PrintRepoUsageHeader prints a repo usage header.
+func PrintRepoUsageHeader(w io.Writer) {
+	fmt.Fprint(w, "REPO\tCREATED BY\tLOGICAL\tDEDUPLICATED\tCOMMITS\tQUOTA\t\n")
+}
+
+// PrintRepoUsage pretty-prints a repo's usage.
+func PrintRepoUsage(w io.Writer, repoUsage *pfs.RepoUsage) {
+	fmt.Fprintf(w, "%s\t", repoUsage.Repo.Name)
+	if repoUsage.CreatedBy != "" {
+		fmt.Fprintf(w, "%s\t", repoUsage.CreatedBy)
+	} else {
+		fmt.Fprint(w, "-\t")
+	}
+	printUsage(w, repoUsage.Usage)
+}
+
+// PrintPrincipalUsageHeader prints a principal usage header.
+func PrintPrincipalUsageHeader(w io.Writer) {
+	fmt.Fprint(w, "PRINCIPAL\tLOGICAL\tDEDUPLICATED\tCOMMITS\tQUOTA\t\n")
+}
+
+// PrintPrincipalUsage pretty-prints a principal's usage.
+func PrintPrincipalUsage(w io.Writer, principalUsage *pfs.PrincipalUsage) {
+	fmt.Fprintf(w, "%s\t", principalUsage.Principal)
+	printUsage(w, principalUsage.Usage)
+}
+
+func printUsage(w io.Writer, usage *pfs.Usage) {
+	fmt.Fprintf(w, "%s\t", units.BytesSize(float64(usage.LogicalBytes)))
+	fmt.Fprintf(w, "%s\t", units.BytesSize(float64(usage.DeduplicatedBytes)))
+	fmt.Fprintf(w, "%d\t", usage.Commits)
+	fmt.Fprintf(w, "%s\t\n", quotaString(usage.Quota))
+}
+
+func quotaString(quota *pfs.Quota) string {
+	if quota == nil || (quota.MaxBytes == 0 && quota.MaxCommits == 0) {
+		return "-"
+	}
+	var limits []string
+	if quota.MaxBytes != 0 {
+		limits = append(limits, units.BytesSize(float64(quota.MaxBytes)))
+	}
+	if quota.MaxCommits != 0 {
+		limits = append(limits, fmt.Sprintf("%d commits", quota.MaxCommits))
+	}
+	return strings.Join(limits, ", ")
+}
+
 // PrintCommitInfoHeader prints a commit info header.
 func PrintCommitInfoHeader(w io.Writer) {
 	fmt.Fprint(w, "REPO\tID\tPARENT\tSTARTED\tDURATION\tSIZE\t\n")
@@ -161,7 +241,9 @@ func fileType(fileType pfs.FileType) string {
 }
 
 var funcMap = template.FuncMap{
-	"prettyAgo":  pretty.Ago,
-	"prettySize": pretty.Size,
-	"fileType":   fileType,
+	"prettyAgo":      pretty.Ago,
+	"prettySize":     pretty.Size,
+	"prettyDuration": pretty.Duration,
+	"prettyQuota":    quotaString,
+	"fileType":       fileType,
 }
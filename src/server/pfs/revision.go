@@ -0,0 +1,169 @@
+package pfs
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Revision is a parsed revision expression, which names a commit of a repo.
+// The forms are:
+//
+//	<name>                         a branch, a commit ID, or a unique prefix
+//	                               of a commit ID
+//	<branch>@{<time>}              the head of a branch at a point in time,
+//	                               e.g. master@{2017-10-01T00:00}
+//	<branch>@{-<n>}                the head of a branch n moves ago
+//	provenance-of(<repo>@<rev>)    the commit of this repo that has the
+//	                               commit <rev> of <repo> in its provenance
+//
+// each of which may be followed by any number of ancestry suffixes: ^<n> or
+// ~<n> (n parents back, where n defaults to 1), e.g. master^^ or master~5.
+type Revision struct {
+	// Name is a branch name, a commit ID or a prefix of one. It's empty if
+	// ProvenanceOf is set.
+	Name string
+	// ProvenanceOf is the commit whose descendant in this repo the revision
+	// names.
+	ProvenanceOf *ProvenanceOf
+	// At, if set, is the time at which the branch Name's head is wanted
+	At *time.Time
+	// Back, if positive, is the number of moves of the branch Name back
+	// from its current head
+	Back int
+	// Ancestry is the number of parents back from the commit named by the
+	// rest of the revision
+	Ancestry int
+}
+
+// ProvenanceOf names a commit of another repo, in a Revision.
+type ProvenanceOf struct {
+	Repo     string
+	Revision *Revision
+}
+
+// revisionTimeFormats are the formats accepted in <branch>@{<time>}. Times
+// without a time zone are in UTC.
+var revisionTimeFormats = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05",
+	"2006-01-02T15:04",
+	"2006-01-02",
+}
+
+const provenanceOfPrefix = "provenance-of("
+
+// ParseRevision parses the revision expression 's'.
+func ParseRevision(s string) (*Revision, error) {
+	p := &revisionParser{s: s}
+	rev, err := p.revision()
+	if err != nil {
+		return nil, fmt.Errorf("invalid revision %q: %v", s, err)
+	}
+	if p.pos != len(s) {
+		return nil, fmt.Errorf("invalid revision %q: unexpected %q at position %d", s, s[p.pos:], p.pos)
+	}
+	return rev, nil
+}
+
+// revisionParser is a recursive descent parser of revision expressions
+type revisionParser struct {
+	s   string
+	pos int
+}
+
+func (p *revisionParser) revision() (*Revision, error) {
+	rev := &Revision{}
+	if strings.HasPrefix(p.s[p.pos:], provenanceOfPrefix) {
+		p.pos += len(provenanceOfPrefix)
+		provenanceOf, err := p.provenanceOf()
+		if err != nil {
+			return nil, err
+		}
+		rev.ProvenanceOf = provenanceOf
+	} else {
+		rev.Name = p.name()
+		if rev.Name == "" {
+			return nil, fmt.Errorf("expected a branch or commit at position %d", p.pos)
+		}
+		if strings.HasPrefix(p.s[p.pos:], "@{") {
+			p.pos += len("@{")
+			if err := p.reflog(rev); err != nil {
+				return nil, err
+			}
+		}
+	}
+	for p.pos < len(p.s) && (p.s[p.pos] == '^' || p.s[p.pos] == '~') {
+		p.pos++
+		n := 1
+		if digits := p.digits(); digits != "" {
+			var err error
+			if n, err = strconv.Atoi(digits); err != nil {
+				return nil, err
+			}
+		}
+		rev.Ancestry += n
+	}
+	return rev, nil
+}
+
+// provenanceOf parses "<repo>@<rev>)"
+func (p *revisionParser) provenanceOf() (*ProvenanceOf, error) {
+	repo := p.name()
+	if repo == "" || !strings.HasPrefix(p.s[p.pos:], "@") {
+		return nil, fmt.Errorf("expected <repo>@<revision> at position %d", p.pos)
+	}
+	p.pos++
+	rev, err := p.revision()
+	if err != nil {
+		return nil, err
+	}
+	if !strings.HasPrefix(p.s[p.pos:], ")") {
+		return nil, fmt.Errorf("expected ) at position %d", p.pos)
+	}
+	p.pos++
+	return &ProvenanceOf{Repo: repo, Revision: rev}, nil
+}
+
+// reflog parses "<time>}" or "-<n>}" into 'rev'
+func (p *revisionParser) reflog(rev *Revision) error {
+	end := strings.IndexByte(p.s[p.pos:], '}')
+	if end < 0 {
+		return fmt.Errorf("expected } after position %d", p.pos)
+	}
+	spec := p.s[p.pos : p.pos+end]
+	p.pos += end + 1
+	if strings.HasPrefix(spec, "-") {
+		n, err := strconv.Atoi(spec[1:])
+		if err != nil || n <= 0 {
+			return fmt.Errorf("expected a positive number of moves in @{%s}", spec)
+		}
+		rev.Back = n
+		return nil
+	}
+	for _, format := range revisionTimeFormats {
+		if t, err := time.Parse(format, spec); err == nil {
+			rev.At = &t
+			return nil
+		}
+	}
+	return fmt.Errorf("expected a time (e.g. 2017-10-01T00:00) or -<n> in @{%s}", spec)
+}
+
+// name consumes a branch, commit or repo name
+func (p *revisionParser) name() string {
+	start := p.pos
+	for p.pos < len(p.s) && !strings.ContainsRune("^~@(){}", rune(p.s[p.pos])) {
+		p.pos++
+	}
+	return p.s[start:p.pos]
+}
+
+func (p *revisionParser) digits() string {
+	start := p.pos
+	for p.pos < len(p.s) && p.s[p.pos] >= '0' && p.s[p.pos] <= '9' {
+		p.pos++
+	}
+	return p.s[start:p.pos]
+}
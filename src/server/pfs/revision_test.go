@@ -0,0 +1,66 @@
+package pfs
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pachyderm/pachyderm/src/client/pkg/require"
+)
+
+func TestParseRevisionAncestry(t *testing.T) {
+	for s, expected := range map[string]*Revision{
+		"master":            {Name: "master"},
+		"master^":           {Name: "master", Ancestry: 1},
+		"master^2":          {Name: "master", Ancestry: 2},
+		"master~~~":         {Name: "master", Ancestry: 3},
+		"master~5":          {Name: "master", Ancestry: 5},
+		"master^2~3":        {Name: "master", Ancestry: 5},
+		"0123456789abcdef^": {Name: "0123456789abcdef", Ancestry: 1},
+	} {
+		rev, err := ParseRevision(s)
+		require.NoError(t, err)
+		require.Equal(t, expected, rev)
+	}
+}
+
+func TestParseRevisionReflog(t *testing.T) {
+	rev, err := ParseRevision("master@{-3}")
+	require.NoError(t, err)
+	require.Equal(t, &Revision{Name: "master", Back: 3}, rev)
+
+	rev, err = ParseRevision("master@{2017-10-01T00:00}^")
+	require.NoError(t, err)
+	require.Equal(t, "master", rev.Name)
+	require.Equal(t, 1, rev.Ancestry)
+	require.True(t, rev.At.Equal(time.Date(2017, 10, 1, 0, 0, 0, 0, time.UTC)))
+
+	rev, err = ParseRevision("master@{2017-10-01T12:00:00-07:00}")
+	require.NoError(t, err)
+	require.True(t, rev.At.Equal(time.Date(2017, 10, 1, 19, 0, 0, 0, time.UTC)))
+
+	for _, s := range []string{"master@{-0}", "master@{yesterday}", "master@{-1", "@{-1}"} {
+		_, err := ParseRevision(s)
+		require.YesError(t, err)
+	}
+}
+
+func TestParseRevisionProvenance(t *testing.T) {
+	rev, err := ParseRevision("provenance-of(data@master@{-1}^)~2")
+	require.NoError(t, err)
+	require.Equal(t, &Revision{
+		ProvenanceOf: &ProvenanceOf{
+			Repo:     "data",
+			Revision: &Revision{Name: "master", Back: 1, Ancestry: 1},
+		},
+		Ancestry: 2,
+	}, rev)
+
+	rev, err = ParseRevision("provenance-of(b@provenance-of(a@abc123))")
+	require.NoError(t, err)
+	require.Equal(t, "abc123", rev.ProvenanceOf.Revision.ProvenanceOf.Revision.Name)
+
+	for _, s := range []string{"provenance-of(data)", "provenance-of(data@master", "provenance-of(@master)", "master~whatever", ""} {
+		_, err := ParseRevision(s)
+		require.YesError(t, err)
+	}
+}
@@ -1,6 +1,7 @@
 package server
 
 import (
+	"bufio"
 	"bytes"
 	"fmt"
 	"io"
@@ -18,6 +19,7 @@ import (
 	"github.com/pachyderm/pachyderm/src/client/pkg/grpcutil"
 	"github.com/pachyderm/pachyderm/src/server/pkg/log"
 	"github.com/pachyderm/pachyderm/src/server/pkg/obj"
+	"github.com/pachyderm/pachyderm/src/server/pkg/prom"
 
 	"github.com/sirupsen/logrus"
 	"golang.org/x/net/context"
@@ -27,6 +29,17 @@ import (
 
 var (
 	grpcErrorf = grpc.Errorf // needed to get passed govet
+
+	putFileBytes = prom.NewCounterVec(
+		"pachyderm_pfs_put_file_bytes_total",
+		"Number of bytes written to PFS by PutFile, by repo.",
+		"repo",
+	)
+	getFileBytes = prom.NewCounterVec(
+		"pachyderm_pfs_get_file_bytes_total",
+		"Number of bytes read from PFS by GetFile, by repo.",
+		"repo",
+	)
 )
 
 type apiServer struct {
@@ -45,11 +58,12 @@ func newLocalAPIServer(address string, etcdPrefix string) (*apiServer, error) {
 	}, nil
 }
 
-func newAPIServer(address string, etcdAddresses []string, etcdPrefix string, cacheSize int64) (*apiServer, error) {
-	d, err := newDriver(address, etcdAddresses, etcdPrefix, cacheSize)
+func newAPIServer(address string, etcdAddresses []string, etcdPrefix string, ppsEtcdPrefix string, authEtcdPrefix string, cacheSize int64) (*apiServer, error) {
+	d, err := newDriver(address, etcdAddresses, etcdPrefix, ppsEtcdPrefix, authEtcdPrefix, cacheSize)
 	if err != nil {
 		return nil, err
 	}
+	go d.master()
 	return &apiServer{
 		Logger: log.NewLogger("pfs.API"),
 		driver: d,
@@ -98,13 +112,33 @@ func (a *apiServer) DeleteRepo(ctx context.Context, request *pfs.DeleteRepoReque
 	return &types.Empty{}, nil
 }
 
+func (a *apiServer) ForkRepo(ctx context.Context, request *pfs.ForkRepoRequest) (response *types.Empty, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+
+	if err := a.driver.forkRepo(ctx, request.Repo, request.Fork, request.Description); err != nil {
+		return nil, quotaErrorToGRPC(err)
+	}
+	return &types.Empty{}, nil
+}
+
+func (a *apiServer) RenameRepo(ctx context.Context, request *pfs.RenameRepoRequest) (response *types.Empty, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+
+	if err := a.driver.renameRepo(ctx, request.Repo, request.NewRepo); err != nil {
+		return nil, err
+	}
+	return &types.Empty{}, nil
+}
+
 func (a *apiServer) StartCommit(ctx context.Context, request *pfs.StartCommitRequest) (response *pfs.Commit, retErr error) {
 	func() { a.Log(request, nil, nil, 0) }()
 	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
 
 	commit, err := a.driver.startCommit(ctx, request.Parent, request.Branch, request.Provenance)
 	if err != nil {
-		return nil, err
+		return nil, quotaErrorToGRPC(err)
 	}
 	return commit, nil
 }
@@ -115,7 +149,7 @@ func (a *apiServer) BuildCommit(ctx context.Context, request *pfs.BuildCommitReq
 
 	commit, err := a.driver.buildCommit(ctx, request.Parent, request.Branch, request.Provenance, request.Tree)
 	if err != nil {
-		return nil, err
+		return nil, quotaErrorToGRPC(err)
 	}
 	return commit, nil
 }
@@ -125,7 +159,7 @@ func (a *apiServer) FinishCommit(ctx context.Context, request *pfs.FinishCommitR
 	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
 
 	if err := a.driver.finishCommit(ctx, request.Commit); err != nil {
-		return nil, err
+		return nil, quotaErrorToGRPC(err)
 	}
 	return &types.Empty{}, nil
 }
@@ -181,6 +215,118 @@ func (a *apiServer) DeleteBranch(ctx context.Context, request *pfs.DeleteBranchR
 	return &types.Empty{}, nil
 }
 
+func (a *apiServer) ListBranchHistory(ctx context.Context, request *pfs.ListBranchHistoryRequest) (response *pfs.BranchHistory, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+
+	return a.driver.listBranchHistory(ctx, request.Repo, request.Branch, request.Number)
+}
+
+func (a *apiServer) SetBranchProtection(ctx context.Context, request *pfs.SetBranchProtectionRequest) (response *types.Empty, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+
+	if err := a.driver.setBranchProtection(ctx, request.Repo, request.Protection); err != nil {
+		return nil, err
+	}
+	return &types.Empty{}, nil
+}
+
+func (a *apiServer) DeleteBranchProtection(ctx context.Context, request *pfs.DeleteBranchProtectionRequest) (response *types.Empty, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+
+	if err := a.driver.deleteBranchProtection(ctx, request.Repo, request.Branch); err != nil {
+		return nil, err
+	}
+	return &types.Empty{}, nil
+}
+
+func (a *apiServer) SetRetentionPolicy(ctx context.Context, request *pfs.SetRetentionPolicyRequest) (response *types.Empty, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+
+	if err := a.driver.setRetentionPolicy(ctx, request.Repo, request.Policy); err != nil {
+		return nil, err
+	}
+	return &types.Empty{}, nil
+}
+
+func (a *apiServer) ApplyRetentionPolicy(ctx context.Context, request *pfs.ApplyRetentionPolicyRequest) (response *pfs.ApplyRetentionPolicyResponse, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+
+	scope := auth.Scope_OWNER
+	if request.DryRun {
+		scope = auth.Scope_READER
+	}
+	if err := a.driver.checkIsAuthorized(ctx, request.Repo, scope); err != nil {
+		return nil, err
+	}
+	deleted, err := a.driver.applyRetentionPolicy(ctx, request.Repo, request.DryRun)
+	if err != nil {
+		return nil, err
+	}
+	return &pfs.ApplyRetentionPolicyResponse{Deleted: deleted}, nil
+}
+
+func (a *apiServer) SetQuota(ctx context.Context, request *pfs.SetQuotaRequest) (response *types.Empty, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+
+	if err := a.driver.setQuota(ctx, request.Repo, request.Principal, request.Quota); err != nil {
+		return nil, err
+	}
+	return &types.Empty{}, nil
+}
+
+func (a *apiServer) CheckQuota(ctx context.Context, request *pfs.CheckQuotaRequest) (response *types.Empty, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+
+	if err := a.driver.checkIsAuthorized(ctx, request.Repo, auth.Scope_WRITER); err != nil {
+		return nil, err
+	}
+	if err := a.driver.checkQuota(ctx, request.Repo, request.AdditionalBytes, request.AdditionalCommits); err != nil {
+		return nil, quotaErrorToGRPC(err)
+	}
+	return &types.Empty{}, nil
+}
+
+func (a *apiServer) QuotaUsage(ctx context.Context, request *pfs.QuotaUsageRequest) (response *pfs.QuotaUsageResponse, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+
+	return a.driver.quotaUsage(ctx)
+}
+
+func (a *apiServer) StartTransaction(ctx context.Context, request *pfs.StartTransactionRequest) (response *pfs.Transaction, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+
+	return a.driver.startTransaction(ctx)
+}
+
+func (a *apiServer) FinishTransaction(ctx context.Context, request *pfs.FinishTransactionRequest) (response *types.Empty, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+
+	if err := a.driver.finishTransaction(ctx, request.Transaction); err != nil {
+		return nil, quotaErrorToGRPC(err)
+	}
+	return &types.Empty{}, nil
+}
+
+func (a *apiServer) DeleteTransaction(ctx context.Context, request *pfs.DeleteTransactionRequest) (response *types.Empty, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+
+	if err := a.driver.deleteTransaction(ctx, request.Transaction); err != nil {
+		return nil, err
+	}
+	return &types.Empty{}, nil
+}
+
 func (a *apiServer) DeleteCommit(ctx context.Context, request *pfs.DeleteCommitRequest) (response *types.Empty, retErr error) {
 	func() { a.Log(request, nil, nil, 0) }()
 	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
@@ -250,6 +396,7 @@ func (a *apiServer) SubscribeCommit(request *pfs.SubscribeCommitRequest, stream
 }
 
 func (a *apiServer) PutFile(putFileServer pfs.API_PutFileServer) (retErr error) {
+	defer func() { retErr = quotaErrorToGRPC(retErr) }()
 	ctx := putFileServer.Context()
 	defer drainFileServer(putFileServer)
 	defer func() {
@@ -324,6 +471,7 @@ func (a *apiServer) PutFile(putFileServer pfs.API_PutFileServer) (retErr error)
 		}
 		r = &reader
 	}
+	r = prom.CountingReader(r, putFileBytes.WithLabelValues(request.File.Commit.Repo.Name))
 	return a.driver.putFile(ctx, request.File, request.Delimiter, request.TargetFileDatums, request.TargetFileBytes, request.OverwriteIndex, r)
 }
 
@@ -337,7 +485,8 @@ func (a *apiServer) putFilePfs(ctx context.Context, request *pfs.PutFileRequest,
 		if err != nil {
 			return err
 		}
-		return a.driver.putFile(ctx, client.NewFile(request.File.Commit.Repo.Name, request.File.Commit.ID, outPath), request.Delimiter, request.TargetFileDatums, request.TargetFileBytes, request.OverwriteIndex, r)
+		return a.driver.putFile(ctx, client.NewFile(request.File.Commit.Repo.Name, request.File.Commit.ID, outPath), request.Delimiter, request.TargetFileDatums, request.TargetFileBytes, request.OverwriteIndex,
+			prom.CountingReader(r, putFileBytes.WithLabelValues(request.File.Commit.Repo.Name)))
 	}
 	splitPath := strings.Split(strings.TrimPrefix(url.Path, "/"), "/")
 	if len(splitPath) < 2 {
@@ -391,7 +540,8 @@ func (a *apiServer) putFileObj(ctx context.Context, objClient obj.Client, reques
 			}
 		}()
 		return a.driver.putFile(ctx, client.NewFile(request.File.Commit.Repo.Name, request.File.Commit.ID, filePath),
-			request.Delimiter, request.TargetFileDatums, request.TargetFileBytes, request.OverwriteIndex, r)
+			request.Delimiter, request.TargetFileDatums, request.TargetFileBytes, request.OverwriteIndex,
+			prom.CountingReader(r, putFileBytes.WithLabelValues(request.File.Commit.Repo.Name)))
 	}
 	if request.Recursive {
 		eg, egContext := errgroup.WithContext(ctx)
@@ -440,7 +590,21 @@ func (a *apiServer) GetFile(request *pfs.GetFileRequest, apiGetFileServer pfs.AP
 	if err != nil {
 		return err
 	}
-	return grpcutil.WriteToStreamingBytesServer(file, apiGetFileServer)
+	return grpcutil.WriteToStreamingBytesServer(prom.CountingReader(file, getFileBytes.WithLabelValues(request.File.Commit.Repo.Name)), apiGetFileServer)
+}
+
+func (a *apiServer) GetArchive(request *pfs.GetArchiveRequest, apiGetArchiveServer pfs.API_GetArchiveServer) (retErr error) {
+	ctx := apiGetArchiveServer.Context()
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, nil, retErr, time.Since(start)) }(time.Now())
+
+	// Buffer the archive so that its many small writes (e.g. tar headers) are
+	// sent in a few large messages
+	w := bufio.NewWriterSize(grpcutil.NewStreamingBytesWriter(apiGetArchiveServer), grpcutil.MaxMsgSize/10)
+	if err := a.driver.getArchive(ctx, request.File, request.Format, w); err != nil {
+		return err
+	}
+	return w.Flush()
 }
 
 func (a *apiServer) InspectFile(ctx context.Context, request *pfs.InspectFileRequest) (response *pfs.FileInfo, retErr error) {
@@ -528,6 +692,21 @@ func (a *apiServer) GlobFileStream(request *pfs.GlobFileRequest, respServer pfs.
 	return nil
 }
 
+func (a *apiServer) GrepFile(request *pfs.GrepFileRequest, respServer pfs.API_GrepFileServer) (retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	var sent int
+	defer func(start time.Time) {
+		a.Log(request, fmt.Sprintf("response stream with %d matches", sent), retErr, time.Since(start))
+	}(time.Now())
+	return a.driver.grepFile(auth.In2Out(respServer.Context()), request.Commit, request.Pattern, request.Regex, request.MaxMatches, request.MaxBytes, func(result *pfs.GrepFileResult) error {
+		if err := respServer.Send(result); err != nil {
+			return err
+		}
+		sent++
+		return nil
+	})
+}
+
 func (a *apiServer) DiffFile(ctx context.Context, request *pfs.DiffFileRequest) (response *pfs.DiffFileResponse, retErr error) {
 	func() { a.Log(request, nil, nil, 0) }()
 	defer func(start time.Time) {
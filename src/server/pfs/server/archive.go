@@ -0,0 +1,215 @@
+package server
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+
+	"github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/client/auth"
+	"github.com/pachyderm/pachyderm/src/client/pfs"
+	"github.com/pachyderm/pachyderm/src/client/pkg/grpcutil"
+	"github.com/pachyderm/pachyderm/src/client/pkg/tracing"
+	pfsserver "github.com/pachyderm/pachyderm/src/server/pfs"
+	"github.com/pachyderm/pachyderm/src/server/pkg/hashtree"
+)
+
+// archiveWriter writes the entries of an archive
+type archiveWriter interface {
+	// dir adds the directory 'name'
+	dir(name string) error
+	// file adds the file 'name', whose content is read from 'r'
+	file(name string, size int64, r io.Reader) error
+	// Close finishes the archive
+	Close() error
+}
+
+// getArchive writes an archive, in 'format', of the files and directories of
+// 'file.Commit' that match the path or glob pattern 'file.Path' to 'w'.
+// Matching directories are archived with everything under them. The paths in
+// the archive are relative to the root of the repo, and the files' times are
+// the time the commit was finished.
+func (d *driver) getArchive(ctx context.Context, file *pfs.File, format pfs.ArchiveFormat, w io.Writer) (retErr error) {
+	span, ctx := tracing.StartSpan(ctx, "pfs.getArchive", "repo", file.GetCommit().GetRepo().GetName(), "commit", file.GetCommit().GetID(), "path", file.GetPath())
+	defer span.Finish()
+	if err := d.checkIsAuthorized(ctx, file.Commit.Repo, auth.Scope_READER); err != nil {
+		return err
+	}
+	commitInfo, err := d.inspectCommit(ctx, file.Commit)
+	if err != nil {
+		return err
+	}
+	modTime := commitInfo.Started
+	if commitInfo.Finished != nil {
+		modTime = commitInfo.Finished
+	}
+	t, err := types.TimestampFromProto(modTime)
+	if err != nil {
+		return err
+	}
+	tree, err := d.getTreeForFile(ctx, client.NewFile(commitInfo.Commit.Repo.Name, commitInfo.Commit.ID, ""))
+	if err != nil {
+		return err
+	}
+	nodes, err := archivedNodes(tree, file.Path)
+	if err != nil {
+		return err
+	}
+	if len(nodes) == 0 {
+		return pfsserver.ErrFileNotFound{File: file}
+	}
+	var paths []string
+	for path := range nodes {
+		paths = append(paths, path)
+	}
+	// Each directory is archived before its contents
+	sort.Strings(paths)
+
+	var aw archiveWriter
+	switch format {
+	case pfs.ArchiveFormat_TAR:
+		aw = &tarWriter{tar.NewWriter(w), t}
+	case pfs.ArchiveFormat_TAR_GZ:
+		gw := gzip.NewWriter(w)
+		defer func() {
+			if err := gw.Close(); err != nil && retErr == nil {
+				retErr = err
+			}
+		}()
+		aw = &tarWriter{tar.NewWriter(gw), t}
+	case pfs.ArchiveFormat_ZIP:
+		aw = &zipWriter{zip.NewWriter(w), t}
+	default:
+		return fmt.Errorf("unknown archive format %v", format)
+	}
+	for _, path := range paths {
+		node := nodes[path]
+		name := strings.TrimPrefix(path, "/")
+		if node.FileNode == nil {
+			if err := aw.dir(name); err != nil {
+				return err
+			}
+			continue
+		}
+		r, err := d.readFileNode(ctx, node, 0, 0)
+		if err != nil {
+			return err
+		}
+		if err := aw.file(name, node.SubtreeSize, r); err != nil {
+			return err
+		}
+	}
+	return aw.Close()
+}
+
+// archivedNodes returns the nodes of 'tree' that match 'pattern', and the
+// nodes under the matching directories, by path. The root isn't included.
+func archivedNodes(tree hashtree.HashTree, pattern string) (map[string]*hashtree.NodeProto, error) {
+	matches, err := tree.Glob(pattern)
+	if err != nil {
+		return nil, err
+	}
+	nodes := make(map[string]*hashtree.NodeProto)
+	for _, match := range matches {
+		if err := tree.Walk(match.Name, func(path string, node *hashtree.NodeProto) error {
+			// Walk also visits the siblings of 'match' whose names start with
+			// its name
+			if path != match.Name && !strings.HasPrefix(path, match.Name+"/") {
+				return nil
+			}
+			if path != "/" && path != "" {
+				nodes[path] = node
+			}
+			return nil
+		}); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// readFileNode returns a reader of the content of the file 'node'
+func (d *driver) readFileNode(ctx context.Context, node *hashtree.NodeProto, offset int64, size int64) (io.Reader, error) {
+	getObjectsClient, err := d.pachClient.ObjectAPIClient.GetObjects(
+		ctx,
+		&pfs.GetObjectsRequest{
+			Objects:     node.FileNode.Objects,
+			OffsetBytes: uint64(offset),
+			SizeBytes:   uint64(size),
+			TotalSize:   uint64(node.SubtreeSize),
+		})
+	if err != nil {
+		return nil, err
+	}
+	return grpcutil.NewStreamingBytesReader(getObjectsClient), nil
+}
+
+type tarWriter struct {
+	w       *tar.Writer
+	modTime time.Time
+}
+
+func (t *tarWriter) dir(name string) error {
+	return t.w.WriteHeader(&tar.Header{
+		Name:     name + "/",
+		Typeflag: tar.TypeDir,
+		Mode:     0755,
+		ModTime:  t.modTime,
+	})
+}
+
+func (t *tarWriter) file(name string, size int64, r io.Reader) error {
+	if err := t.w.WriteHeader(&tar.Header{
+		Name:     name,
+		Typeflag: tar.TypeReg,
+		Mode:     0644,
+		Size:     size,
+		ModTime:  t.modTime,
+	}); err != nil {
+		return err
+	}
+	_, err := io.Copy(t.w, r)
+	return err
+}
+
+func (t *tarWriter) Close() error {
+	return t.w.Close()
+}
+
+type zipWriter struct {
+	w       *zip.Writer
+	modTime time.Time
+}
+
+func (z *zipWriter) dir(name string) error {
+	header := &zip.FileHeader{Name: name + "/"}
+	header.SetModTime(z.modTime)
+	header.SetMode(0755 | os.ModeDir)
+	_, err := z.w.CreateHeader(header)
+	return err
+}
+
+func (z *zipWriter) file(name string, size int64, r io.Reader) error {
+	header := &zip.FileHeader{Name: name, Method: zip.Deflate}
+	header.SetModTime(z.modTime)
+	header.SetMode(0644)
+	w, err := z.w.CreateHeader(header)
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(w, r)
+	return err
+}
+
+func (z *zipWriter) Close() error {
+	return z.w.Close()
+}
@@ -0,0 +1,125 @@
+package server
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pachyderm/pachyderm/src/client/auth"
+	"github.com/pachyderm/pachyderm/src/client/pfs"
+	"github.com/pachyderm/pachyderm/src/client/pkg/grpcutil"
+	col "github.com/pachyderm/pachyderm/src/server/pkg/collection"
+)
+
+// maxBranchHistory is the number of moves kept in the history of a branch.
+// Older moves are dropped, so that a branch's history stays well within
+// etcd's limit on the size of a value.
+const maxBranchHistory = 1000
+
+// The operations that move branches, as recorded in BranchMove.Operation
+const (
+	opStartCommit       = "StartCommit"
+	opBuildCommit       = "BuildCommit"
+	opSetBranch         = "SetBranch"
+	opDeleteBranch      = "DeleteBranch"
+	opDeleteCommit      = "DeleteCommit"
+	opFinishTransaction = "FinishTransaction"
+)
+
+// callerPrincipal returns the principal of the caller (in 'ctx'), or "" if
+// auth isn't active. It's recorded in the branch moves that the caller makes.
+func (d *driver) callerPrincipal(ctx context.Context) (string, error) {
+	d.initializePachConn()
+	who, err := d.pachClient.AuthAPIClient.WhoAmI(auth.In2Out(ctx), &auth.WhoAmIRequest{})
+	if err != nil {
+		if auth.IsNotActivatedError(err) {
+			return "", nil
+		}
+		return "", fmt.Errorf("error getting the principal moving the branch: %v", grpcutil.ScrubGRPC(err))
+	}
+	return who.Username, nil
+}
+
+// moveBranch makes 'head' the head of 'branch' in 'repo', or deletes the
+// branch if 'head' is nil, and appends the move to the branch's history.
+// Moves that leave the head where it was aren't recorded.
+func (d *driver) moveBranch(stm col.STM, repo *pfs.Repo, branch string, head *pfs.Commit, principal string, operation string) error {
+	branches := d.branches(repo.Name).ReadWrite(stm)
+	var oldHead *pfs.Commit
+	existing := new(pfs.Commit)
+	if err := branches.Get(branch, existing); err != nil {
+		if !col.IsErrNotFound(err) {
+			return err
+		}
+	} else {
+		oldHead = existing
+	}
+	if head != nil {
+		if err := branches.Put(branch, head); err != nil {
+			return err
+		}
+	} else {
+		if err := branches.Delete(branch); err != nil {
+			return err
+		}
+	}
+	if oldHead.GetID() == head.GetID() {
+		return nil
+	}
+	if oldHead == nil {
+		if err := d.touchRepo(stm, repo); err != nil {
+			return err
+		}
+	}
+
+	histories := d.branchHistories(repo.Name).ReadWrite(stm)
+	history := new(pfs.BranchHistory)
+	if err := histories.Get(branch, history); err != nil && !col.IsErrNotFound(err) {
+		return err
+	}
+	move := &pfs.BranchMove{
+		Branch:    branch,
+		OldHead:   oldHead,
+		NewHead:   head,
+		Time:      now(),
+		Principal: principal,
+		Operation: operation,
+	}
+	history.Moves = append([]*pfs.BranchMove{move}, history.Moves...)
+	if len(history.Moves) > maxBranchHistory {
+		history.Moves = history.Moves[:maxBranchHistory]
+	}
+	return histories.Put(branch, history)
+}
+
+// branchHistory returns the history of 'branch' in 'repo', newest move
+// first. Branches that haven't moved since pachd started recording moves
+// have an empty history.
+func (d *driver) branchHistory(ctx context.Context, repo *pfs.Repo, branch string) (*pfs.BranchHistory, error) {
+	history := new(pfs.BranchHistory)
+	if err := d.branchHistories(repo.Name).ReadOnly(ctx).Get(branch, history); err != nil {
+		if !col.IsErrNotFound(err) {
+			return nil, err
+		}
+		if err := d.branches(repo.Name).ReadOnly(ctx).Get(branch, &pfs.Commit{}); err != nil {
+			if col.IsErrNotFound(err) {
+				return nil, fmt.Errorf("branch %s not found in repo %s", branch, repo.Name)
+			}
+			return nil, err
+		}
+	}
+	return history, nil
+}
+
+func (d *driver) listBranchHistory(ctx context.Context, repo *pfs.Repo, branch string, number uint64) (*pfs.BranchHistory, error) {
+	if err := d.checkIsAuthorized(ctx, repo, auth.Scope_READER); err != nil {
+		return nil, err
+	}
+	history, err := d.branchHistory(ctx, repo, branch)
+	if err != nil {
+		return nil, err
+	}
+	if number != 0 && uint64(len(history.Moves)) > number {
+		history.Moves = history.Moves[:number]
+	}
+	return history, nil
+}
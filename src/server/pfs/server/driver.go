@@ -14,19 +14,24 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"golang.org/x/sync/errgroup"
 
 	"github.com/pachyderm/pachyderm/src/client"
 	"github.com/pachyderm/pachyderm/src/client/auth"
+	"github.com/pachyderm/pachyderm/src/client/limit"
 	"github.com/pachyderm/pachyderm/src/client/pfs"
 	"github.com/pachyderm/pachyderm/src/client/pkg/grpcutil"
+	"github.com/pachyderm/pachyderm/src/client/pkg/tracing"
 	"github.com/pachyderm/pachyderm/src/client/pkg/uuid"
 	pfsserver "github.com/pachyderm/pachyderm/src/server/pfs"
+	"github.com/pachyderm/pachyderm/src/server/pkg/authdb"
 	col "github.com/pachyderm/pachyderm/src/server/pkg/collection"
 	"github.com/pachyderm/pachyderm/src/server/pkg/hashtree"
 	"github.com/pachyderm/pachyderm/src/server/pkg/pfsdb"
+	"github.com/pachyderm/pachyderm/src/server/pkg/ppsdb"
 	"github.com/pachyderm/pachyderm/src/server/pkg/watch"
 
 	etcd "github.com/coreos/etcd/clientv3"
@@ -42,6 +47,9 @@ const (
 
 	// Makes calls to ListRepo and InspectRepo more legible
 	includeAuth = true
+
+	// The number of files that GrepFile scans at once
+	grepParallelism = 10
 )
 
 // ValidateRepoName determines if a repo name is valid
@@ -91,11 +99,20 @@ type driver struct {
 	prefix     string
 
 	// collections
-	repos         col.Collection
-	repoRefCounts col.Collection
-	commits       collectionFactory
-	branches      collectionFactory
-	openCommits   col.Collection
+	repos           col.Collection
+	repoRefCounts   col.Collection
+	commits         collectionFactory
+	branches        collectionFactory
+	branchHistories collectionFactory
+	openCommits     col.Collection
+	quotas          col.Collection
+	transactions    col.Collection
+
+	// The PPS and auth collections that refer to repos by name, which
+	// renameRepo updates. They're nil in drivers that don't rename repos.
+	pipelines        col.Collection
+	pipelineVersions collectionFactory
+	acls             col.Collection
 
 	// a cache for hashtrees
 	treeCache *lru.Cache
@@ -110,7 +127,7 @@ const (
 )
 
 // newDriver is used to create a new Driver instance
-func newDriver(address string, etcdAddresses []string, etcdPrefix string, treeCacheSize int64) (*driver, error) {
+func newDriver(address string, etcdAddresses []string, etcdPrefix string, ppsEtcdPrefix string, authEtcdPrefix string, treeCacheSize int64) (*driver, error) {
 	etcdClient, err := etcd.New(etcd.Config{
 		Endpoints:   etcdAddresses,
 		DialOptions: client.EtcdDialOptions(),
@@ -138,8 +155,22 @@ func newDriver(address string, etcdAddresses []string, etcdPrefix string, treeCa
 		branches: func(repo string) col.Collection {
 			return pfsdb.Branches(etcdClient, etcdPrefix, repo)
 		},
-		openCommits: pfsdb.OpenCommits(etcdClient, etcdPrefix),
-		treeCache:   treeCache,
+		branchHistories: func(repo string) col.Collection {
+			return pfsdb.BranchHistories(etcdClient, etcdPrefix, repo)
+		},
+		openCommits:  pfsdb.OpenCommits(etcdClient, etcdPrefix),
+		quotas:       pfsdb.Quotas(etcdClient, etcdPrefix),
+		transactions: pfsdb.Transactions(etcdClient, etcdPrefix),
+		treeCache:    treeCache,
+	}
+	if ppsEtcdPrefix != "" {
+		d.pipelines = ppsdb.Pipelines(etcdClient, ppsEtcdPrefix)
+		d.pipelineVersions = func(pipeline string) col.Collection {
+			return ppsdb.PipelineVersions(etcdClient, ppsEtcdPrefix, pipeline)
+		}
+	}
+	if authEtcdPrefix != "" {
+		d.acls = authdb.ACLs(etcdClient, authEtcdPrefix)
 	}
 	go func() { d.initializePachConn() }() // Begin dialing connection on startup
 	return d, nil
@@ -148,7 +179,7 @@ func newDriver(address string, etcdAddresses []string, etcdPrefix string, treeCa
 // newLocalDriver creates a driver using an local etcd instance.  This
 // function is intended for testing purposes
 func newLocalDriver(blockAddress string, etcdPrefix string) (*driver, error) {
-	return newDriver(blockAddress, []string{"localhost:32379"}, etcdPrefix, defaultTreeCacheSize)
+	return newDriver(blockAddress, []string{"localhost:32379"}, etcdPrefix, path.Join(etcdPrefix, "pps"), path.Join(etcdPrefix, "auth"), defaultTreeCacheSize)
 }
 
 // initializePachConn initializes the connects that the pfs driver has with the
@@ -203,6 +234,8 @@ func absent(key string) etcd.Cmp {
 }
 
 func (d *driver) createRepo(ctx context.Context, repo *pfs.Repo, provenance []*pfs.Repo, description string, update bool) error {
+	span, ctx := tracing.StartSpan(ctx, "pfs.createRepo", "repo", repo.GetName())
+	defer span.Finish()
 	if err := ValidateRepoName(repo.Name); err != nil {
 		return err
 	}
@@ -280,6 +313,9 @@ func (d *driver) createRepo(ctx context.Context, repo *pfs.Repo, provenance []*p
 			Provenance:  fullProvRepos,
 			Description: description,
 		}
+		if whoAmI != nil {
+			repoInfo.CreatedBy = whoAmI.Username
+		}
 		return repos.Create(repo.Name, repoInfo)
 	})
 	return err
@@ -469,6 +505,8 @@ nextRepo:
 }
 
 func (d *driver) deleteRepo(ctx context.Context, repo *pfs.Repo, force bool) error {
+	span, ctx := tracing.StartSpan(ctx, "pfs.deleteRepo", "repo", repo.GetName())
+	defer span.Finish()
 	_, err := col.NewSTM(ctx, d.etcdClient, func(stm col.STM) error {
 		repos := d.repos.ReadWrite(stm)
 		repoRefCounts := d.repoRefCounts.ReadWriteInt(stm)
@@ -524,6 +562,7 @@ func (d *driver) deleteRepo(ctx context.Context, repo *pfs.Repo, force bool) err
 		}
 		commits.DeleteAll()
 		branches.DeleteAll()
+		d.branchHistories(repo.Name).ReadWrite(stm).DeleteAll()
 		return nil
 	})
 	if err != nil {
@@ -547,12 +586,20 @@ func (d *driver) buildCommit(ctx context.Context, parent *pfs.Commit, branch str
 }
 
 func (d *driver) makeCommit(ctx context.Context, parent *pfs.Commit, branch string, provenance []*pfs.Commit, treeRef *pfs.Object) (*pfs.Commit, error) {
+	span, ctx := tracing.StartSpan(ctx, "pfs.makeCommit", "repo", parent.GetRepo().GetName(), "branch", branch)
+	defer span.Finish()
 	if err := d.checkIsAuthorized(ctx, parent.Repo, auth.Scope_WRITER); err != nil {
 		return nil, err
 	}
 	if parent == nil {
 		return nil, fmt.Errorf("parent cannot be nil")
 	}
+	if txnID := transactionID(ctx); txnID != "" {
+		if treeRef != nil {
+			return nil, fmt.Errorf("BuildCommit cannot be used in a transaction")
+		}
+		return d.startCommitInTransaction(ctx, txnID, parent, branch, provenance)
+	}
 	commit := &pfs.Commit{
 		Repo: parent.Repo,
 		ID:   uuid.NewWithoutDashes(),
@@ -569,6 +616,20 @@ func (d *driver) makeCommit(ctx context.Context, parent *pfs.Commit, branch stri
 		}
 		tree = _tree
 	}
+	if err := d.checkQuota(ctx, parent.Repo, 0, 1); err != nil {
+		return nil, err
+	}
+	var principal string
+	if branch != "" {
+		var err error
+		if principal, err = d.callerPrincipal(ctx); err != nil {
+			return nil, err
+		}
+	}
+	operation := opStartCommit
+	if treeRef != nil {
+		operation = opBuildCommit
+	}
 	if _, err := col.NewSTM(ctx, d.etcdClient, func(stm col.STM) error {
 		repos := d.repos.ReadWrite(stm)
 		commits := d.commits(parent.Repo.Name).ReadWrite(stm)
@@ -608,21 +669,39 @@ func (d *driver) makeCommit(ctx context.Context, parent *pfs.Commit, branch stri
 			commitInfo.Provenance = append(commitInfo.Provenance, c)
 		}
 
+		var protection *pfs.BranchProtection
+		var oldHead *pfs.Commit
 		if branch != "" {
-			// If we don't have an explicit parent we use the previous head of
-			// branch as the parent, if it exists.
-			if parent.ID == "" {
-				head := new(pfs.Commit)
-				if err := branches.Get(branch, head); err != nil {
-					if _, ok := err.(col.ErrNotFound); !ok {
-						return err
+			protection = branchProtection(repoInfo, branch)
+			if protection != nil {
+				if err := d.checkBranchPrincipal(ctx, parent.Repo, protection); err != nil {
+					return err
+				}
+				// A commit that doesn't exist yet can't have been validated
+				if protection.RequiredPipeline != "" {
+					return pfsserver.ErrBranchProtected{
+						Repo:   parent.Repo,
+						Branch: branch,
+						Reason: fmt.Sprintf("commits must be validated by pipeline %s first; start the commit on another branch and move this branch to it with SetBranch",
+							protection.RequiredPipeline),
 					}
-				} else {
+				}
+			}
+			head := new(pfs.Commit)
+			if err := branches.Get(branch, head); err != nil {
+				if _, ok := err.(col.ErrNotFound); !ok {
+					return err
+				}
+			} else {
+				oldHead = head
+				// If we don't have an explicit parent we use the previous head of
+				// branch as the parent, if it exists.
+				if parent.ID == "" {
 					parent.ID = head.ID
 				}
 			}
 			// Make commit the new head of the branch
-			if err := branches.Put(branch, commit); err != nil {
+			if err := d.moveBranch(stm, parent.Repo, branch, commit, principal, operation); err != nil {
 				return err
 			}
 		}
@@ -637,19 +716,34 @@ func (d *driver) makeCommit(ctx context.Context, parent *pfs.Commit, branch stri
 			}
 			commitInfo.ParentCommit = parent
 		}
+		if protection != nil {
+			// The new commit descends from oldHead iff its parent does
+			if err := d.checkFastForward(commits, parent.Repo, branch, protection, oldHead, parent); err != nil {
+				return err
+			}
+		}
 		parentTree, err := d.getTreeForCommit(ctx, parent)
 		if err != nil {
 			return err
 		}
 		if treeRef != nil {
+			// The commit is finished as it's made, so its size counts
+			// against the repo's quota now, as it would in FinishCommit
+			sizeChange := sizeChange(tree, parentTree)
+			if err := d.checkQuota(ctx, parent.Repo, sizeChange, 1); err != nil {
+				return err
+			}
 			commitInfo.Tree = treeRef
 			commitInfo.SizeBytes = uint64(tree.FSSize())
 			commitInfo.Finished = now()
-			repoInfo.SizeBytes += sizeChange(tree, parentTree)
-			repos.Put(parent.Repo.Name, repoInfo)
+			repoInfo.SizeBytes += sizeChange
 		} else {
 			d.openCommits.ReadWrite(stm).Put(commit.ID, commit)
 		}
+		// The repo is written even if it's unchanged, as touchRepo does
+		if err := repos.Put(parent.Repo.Name, repoInfo); err != nil {
+			return err
+		}
 		return commits.Create(commit.ID, commitInfo)
 	}); err != nil {
 		return nil, err
@@ -659,9 +753,16 @@ func (d *driver) makeCommit(ctx context.Context, parent *pfs.Commit, branch stri
 }
 
 func (d *driver) finishCommit(ctx context.Context, commit *pfs.Commit) error {
+	span, ctx := tracing.StartSpan(ctx, "pfs.finishCommit", "repo", commit.GetRepo().GetName(), "commit", commit.GetID())
+	defer span.Finish()
 	if err := d.checkIsAuthorized(ctx, commit.Repo, auth.Scope_WRITER); err != nil {
 		return err
 	}
+	if txnID := transactionID(ctx); txnID != "" {
+		return d.addTransactionRequest(ctx, txnID, &pfs.TransactionRequest{
+			FinishCommit: &pfs.FinishCommitRequest{Commit: commit},
+		})
+	}
 	commitInfo, err := d.inspectCommit(ctx, commit)
 	if err != nil {
 		return err
@@ -669,6 +770,9 @@ func (d *driver) finishCommit(ctx context.Context, commit *pfs.Commit) error {
 	if commitInfo.Finished != nil {
 		return fmt.Errorf("commit %s has already been finished", commit.FullID())
 	}
+	if err := d.checkFinishProtectedCommit(ctx, commit); err != nil {
+		return err
+	}
 
 	prefix, err := d.scratchCommitPrefix(ctx, commit)
 	if err != nil {
@@ -715,6 +819,10 @@ func (d *driver) finishCommit(ctx context.Context, commit *pfs.Commit) error {
 	commitInfo.Finished = now()
 
 	sizeChange := sizeChange(finishedTree, parentTree)
+	// The commit itself was counted when it was started
+	if err := d.checkQuota(ctx, commit.Repo, sizeChange, 0); err != nil {
+		return err
+	}
 	_, err = col.NewSTM(ctx, d.etcdClient, func(stm col.STM) error {
 		commits := d.commits(commit.Repo.Name).ReadWrite(stm)
 		repos := d.repos.ReadWrite(stm)
@@ -763,6 +871,8 @@ func sizeChange(tree hashtree.HashTree, parentTree hashtree.HashTree) uint64 {
 // As a side effect, this function also replaces the ID in the given commit
 // with a real commit ID.
 func (d *driver) inspectCommit(ctx context.Context, commit *pfs.Commit) (*pfs.CommitInfo, error) {
+	span, ctx := tracing.StartSpan(ctx, "pfs.inspectCommit", "repo", commit.GetRepo().GetName(), "commit", commit.GetID())
+	defer span.Finish()
 	if commit == nil {
 		return nil, fmt.Errorf("cannot inspect nil commit")
 	}
@@ -770,86 +880,19 @@ func (d *driver) inspectCommit(ctx context.Context, commit *pfs.Commit) (*pfs.Co
 		return nil, err
 	}
 
-	commitID, ancestryLength := parseCommitID(commit.ID)
-
-	// Check if the commitID is a branch name
-	_, err := col.NewSTM(ctx, d.etcdClient, func(stm col.STM) error {
-		branches := d.branches(commit.Repo.Name).ReadWrite(stm)
-
-		head := new(pfs.Commit)
-		// See if we are given a branch
-		if err := branches.Get(commitID, head); err != nil {
-			if _, ok := err.(col.ErrNotFound); !ok {
-				return err
-			}
-			// If it's not a branch, use it as it is
-			return nil
-		}
-		commitID = head.ID
-		return nil
-	})
+	commitID, err := d.resolveRevision(ctx, commit.Repo, commit.ID)
 	if err != nil {
 		return nil, err
 	}
-
-	var commitInfo *pfs.CommitInfo
-	nextCommit := &pfs.Commit{
-		Repo: commit.Repo,
-		ID:   commitID,
-	}
-	for i := 0; i <= ancestryLength; i++ {
-		if nextCommit == nil {
-			return nil, pfsserver.ErrCommitNotFound{commit}
-		}
-		commits := d.commits(commit.Repo.Name).ReadOnly(ctx)
-		commitInfo = new(pfs.CommitInfo)
-		if err := commits.Get(nextCommit.ID, commitInfo); err != nil {
-			return nil, pfsserver.ErrCommitNotFound{nextCommit}
-		}
-		nextCommit = commitInfo.ParentCommit
+	commitInfo := new(pfs.CommitInfo)
+	if err := d.commits(commit.Repo.Name).ReadOnly(ctx).Get(commitID, commitInfo); err != nil {
+		return nil, pfsserver.ErrCommitNotFound{commit}
 	}
 
 	commit.ID = commitInfo.Commit.ID
 	return commitInfo, nil
 }
 
-// parseCommitID accepts a commit ID that might contain the Git ancestry
-// syntax, such as "master^2", "master~~", "master^^", "master~5", etc.
-// It then returns the ID component such as "master" and the depth of the
-// ancestor.  For instance, for "master^2" it'd return "master" and 2.
-func parseCommitID(commitID string) (string, int) {
-	sepIndex := strings.IndexAny(commitID, "^~")
-	if sepIndex == -1 {
-		return commitID, 0
-	}
-
-	// Find the separator, which is either "^" or "~"
-	sep := commitID[sepIndex]
-	strAfterSep := commitID[sepIndex+1:]
-
-	// Try convert the string after the separator to an int.
-	intAfterSep, err := strconv.Atoi(strAfterSep)
-	// If it works, return
-	if err == nil {
-		return commitID[:sepIndex], intAfterSep
-	}
-
-	// Otherwise, we check if there's a sequence of separators, as in
-	// "master^^^^" or "master~~~~"
-	for i := sepIndex + 1; i < len(commitID); i++ {
-		if commitID[i] != sep {
-			// If we find a character that's not the separator, as in
-			// "master~whatever", then we return.
-			return commitID, 0
-		}
-	}
-
-	// Here we've confirmed that the commit ID ends with a sequence of
-	// (the same) separators and therefore uses the correct ancestry
-	// syntax.
-	return commitID[:sepIndex], len(commitID) - sepIndex
-}
-
 func (d *driver) listCommit(ctx context.Context, repo *pfs.Repo, to *pfs.Commit, from *pfs.Commit, number uint64) ([]*pfs.CommitInfo, error) {
 	if err := d.checkIsAuthorized(ctx, repo, auth.Scope_READER); err != nil {
 		return nil, err
@@ -1264,38 +1307,42 @@ func (d *driver) deleteCommit(ctx context.Context, commit *pfs.Commit) error {
 		return fmt.Errorf("cannot delete finished commit")
 	}
 
-	// Delete the scratch space for this commit
-	prefix, err := d.scratchCommitPrefix(ctx, commit)
-	if err != nil {
-		return err
-	}
-	_, err = d.etcdClient.Delete(ctx, prefix, etcd.WithPrefix())
+	// If this commit is the head of a branch, make the commit's parent
+	// the head instead. This is done before anything is deleted so that a
+	// protected branch that can't be rewound leaves the commit intact.
+	branches, err := d.listBranch(ctx, commit.Repo)
 	if err != nil {
 		return err
 	}
 
-	// If this commit is the head of a branch, make the commit's parent
-	// the head instead.
-	branches, err := d.listBranch(ctx, commit.Repo)
+	principal, err := d.callerPrincipal(ctx)
 	if err != nil {
 		return err
 	}
-
 	for _, branch := range branches {
 		if branch.Head.ID == commitInfo.Commit.ID {
-			if commitInfo.ParentCommit != nil {
-				if err := d.setBranch(ctx, commitInfo.ParentCommit, branch.Name); err != nil {
-					return err
-				}
-			} else {
-				// If this commit doesn't have a parent, delete the branch
-				if err := d.deleteBranch(ctx, commit.Repo, branch.Name); err != nil {
+			// If this commit doesn't have a parent, the branch is deleted.
+			if _, err := col.NewSTM(ctx, d.etcdClient, func(stm col.STM) error {
+				if err := d.checkRewindProtectedBranch(ctx, stm, commitInfo, branch.Name); err != nil {
 					return err
 				}
+				return d.moveBranch(stm, commit.Repo, branch.Name, commitInfo.ParentCommit, principal, opDeleteCommit)
+			}); err != nil {
+				return err
 			}
 		}
 	}
 
+	// Delete the scratch space for this commit
+	prefix, err := d.scratchCommitPrefix(ctx, commit)
+	if err != nil {
+		return err
+	}
+	_, err = d.etcdClient.Delete(ctx, prefix, etcd.WithPrefix())
+	if err != nil {
+		return err
+	}
+
 	// Delete the commit itself and subtract the size of the commit
 	// from repo size.
 	_, err = col.NewSTM(ctx, d.etcdClient, func(stm col.STM) error {
@@ -1347,10 +1394,24 @@ func (d *driver) setBranch(ctx context.Context, commit *pfs.Commit, name string)
 	if err := d.checkIsAuthorized(ctx, commit.Repo, auth.Scope_WRITER); err != nil {
 		return err
 	}
-	if _, err := d.inspectCommit(ctx, commit); err != nil {
+	if txnID := transactionID(ctx); txnID != "" {
+		return d.addTransactionRequest(ctx, txnID, &pfs.TransactionRequest{
+			SetBranch: &pfs.SetBranchRequest{Commit: commit, Branch: name},
+		})
+	}
+	// 'commit' may be a revision expression, e.g. master@{-1}, so the
+	// branch is moved to the commit that it names
+	resolved, err := d.inspectCommit(ctx, commit)
+	if err != nil {
 		return err
 	}
-	_, err := col.NewSTM(ctx, d.etcdClient, func(stm col.STM) error {
+	commit = resolved.Commit
+	principal, err := d.callerPrincipal(ctx)
+	if err != nil {
+		return err
+	}
+	_, err = col.NewSTM(ctx, d.etcdClient, func(stm col.STM) error {
+		repos := d.repos.ReadWrite(stm)
 		commits := d.commits(commit.Repo.Name).ReadWrite(stm)
 		branches := d.branches(commit.Repo.Name).ReadWrite(stm)
 
@@ -1360,7 +1421,268 @@ func (d *driver) setBranch(ctx context.Context, commit *pfs.Commit, name string)
 			return err
 		}
 
-		return branches.Put(name, commit)
+		repoInfo := new(pfs.RepoInfo)
+		if err := repos.Get(commit.Repo.Name, repoInfo); err != nil {
+			return err
+		}
+		if protection := branchProtection(repoInfo, name); protection != nil {
+			var oldHead *pfs.Commit
+			head := new(pfs.Commit)
+			if err := branches.Get(name, head); err != nil {
+				if _, ok := err.(col.ErrNotFound); !ok {
+					return err
+				}
+			} else {
+				oldHead = head
+			}
+			if err := d.checkBranchPrincipal(ctx, commit.Repo, protection); err != nil {
+				return err
+			}
+			if err := d.checkFastForward(commits, commit.Repo, name, protection, oldHead, commit); err != nil {
+				return err
+			}
+			if err := d.checkRequiredPipeline(ctx, commit.Repo, name, protection, &commitInfo); err != nil {
+				return err
+			}
+		}
+
+		return d.moveBranch(stm, commit.Repo, name, commit, principal, opSetBranch)
+	})
+	return err
+}
+
+// branchProtection returns the protection rule for 'branch' in 'repoInfo',
+// or nil if the branch isn't protected.
+func branchProtection(repoInfo *pfs.RepoInfo, branch string) *pfs.BranchProtection {
+	for _, protection := range repoInfo.BranchProtections {
+		if protection.Branch == branch {
+			return protection
+		}
+	}
+	return nil
+}
+
+// checkBranchPrincipal returns an error if the caller (in 'ctx') isn't
+// allowed to move a branch protected by 'protection'. Cluster admins are
+// always allowed.
+func (d *driver) checkBranchPrincipal(ctx context.Context, repo *pfs.Repo, protection *pfs.BranchProtection) error {
+	if len(protection.AllowedPrincipals) == 0 {
+		return nil
+	}
+	d.initializePachConn()
+	who, err := d.pachClient.AuthAPIClient.WhoAmI(auth.In2Out(ctx), &auth.WhoAmIRequest{})
+	if err != nil {
+		if auth.IsNotActivatedError(err) {
+			return pfsserver.ErrBranchProtected{
+				Repo:   repo,
+				Branch: protection.Branch,
+				Reason: "it may only be moved by specific principals, but the auth service is not activated",
+			}
+		}
+		return fmt.Errorf("error checking the principal allowed to move \"%s\": %v",
+			protection.Branch, grpcutil.ScrubGRPC(err))
+	}
+	if who.IsAdmin {
+		return nil
+	}
+	for _, principal := range protection.AllowedPrincipals {
+		if principal == who.Username {
+			return nil
+		}
+	}
+	return pfsserver.ErrBranchProtected{
+		Repo:   repo,
+		Branch: protection.Branch,
+		Reason: fmt.Sprintf("%s is not allowed to move it", who.Username),
+	}
+}
+
+// checkFastForward returns an error if 'protection' requires fast-forward
+// moves and 'newHead' is not a descendant of (or equal to) 'oldHead'.
+// newHead.ID must be a real commit ID rather than a branch name.
+func (d *driver) checkFastForward(commits col.ReadWriteCollection, repo *pfs.Repo, branch string, protection *pfs.BranchProtection, oldHead *pfs.Commit, newHead *pfs.Commit) error {
+	if !protection.FastForwardOnly || oldHead == nil {
+		return nil
+	}
+	for cursor := newHead; cursor != nil && cursor.ID != ""; {
+		if cursor.ID == oldHead.ID {
+			return nil
+		}
+		commitInfo := new(pfs.CommitInfo)
+		if err := commits.Get(cursor.ID, commitInfo); err != nil {
+			return err
+		}
+		cursor = commitInfo.ParentCommit
+	}
+	return pfsserver.ErrBranchProtected{
+		Repo:   repo,
+		Branch: branch,
+		Reason: fmt.Sprintf("it only allows fast-forward moves, and %s is not a descendant of its head %s", newHead.ID, oldHead.ID),
+	}
+}
+
+// checkRequiredPipeline returns an error if 'protection' requires a
+// validation pipeline and that pipeline hasn't produced a finished output
+// commit with 'commitInfo' in its provenance. Since output commits are only
+// created for jobs that succeed, such a commit means validation passed.
+func (d *driver) checkRequiredPipeline(ctx context.Context, repo *pfs.Repo, branch string, protection *pfs.BranchProtection, commitInfo *pfs.CommitInfo) error {
+	if protection.RequiredPipeline == "" {
+		return nil
+	}
+	if commitInfo.Finished == nil {
+		return pfsserver.ErrBranchProtected{
+			Repo:   repo,
+			Branch: branch,
+			Reason: fmt.Sprintf("commit %s must be finished and validated by pipeline %s", commitInfo.Commit.ID, protection.RequiredPipeline),
+		}
+	}
+	// The pipeline's output repo has the same name as the pipeline
+	iter, err := d.commits(protection.RequiredPipeline).ReadOnly(ctx).GetByIndex(pfsdb.ProvenanceIndex, commitInfo.Commit)
+	if err != nil {
+		return err
+	}
+	for {
+		var commitID string
+		outputCommitInfo := new(pfs.CommitInfo)
+		ok, err := iter.Next(&commitID, outputCommitInfo)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			break
+		}
+		if outputCommitInfo.Finished != nil {
+			return nil
+		}
+	}
+	return pfsserver.ErrBranchProtected{
+		Repo:   repo,
+		Branch: branch,
+		Reason: fmt.Sprintf("commit %s has not been validated by pipeline %s", commitInfo.Commit.ID, protection.RequiredPipeline),
+	}
+}
+
+// checkRewindProtectedBranch returns an error if 'branch', whose head is the
+// commit in 'commitInfo', is protected and moving it back to that commit's
+// parent isn't a move that SetBranch would allow.
+func (d *driver) checkRewindProtectedBranch(ctx context.Context, stm col.STM, commitInfo *pfs.CommitInfo, branch string) error {
+	repo := commitInfo.Commit.Repo
+	repoInfo := new(pfs.RepoInfo)
+	if err := d.repos.ReadWrite(stm).Get(repo.Name, repoInfo); err != nil {
+		return err
+	}
+	protection := branchProtection(repoInfo, branch)
+	if protection == nil {
+		return nil
+	}
+	if err := d.checkBranchPrincipal(ctx, repo, protection); err != nil {
+		return err
+	}
+	parent := commitInfo.ParentCommit
+	if parent == nil {
+		return pfsserver.ErrBranchProtected{
+			Repo:   repo,
+			Branch: branch,
+			Reason: fmt.Sprintf("deleting commit %s would delete the branch", commitInfo.Commit.ID),
+		}
+	}
+	commits := d.commits(repo.Name).ReadWrite(stm)
+	if err := d.checkFastForward(commits, repo, branch, protection, commitInfo.Commit, parent); err != nil {
+		return err
+	}
+	parentInfo := new(pfs.CommitInfo)
+	if err := commits.Get(parent.ID, parentInfo); err != nil {
+		return err
+	}
+	return d.checkRequiredPipeline(ctx, repo, branch, protection, parentInfo)
+}
+
+// checkFinishProtectedCommit returns an error if 'commit' is the head of a
+// protected branch that the caller isn't allowed to move, or of a branch that
+// requires validation (which an open commit can't have had).
+func (d *driver) checkFinishProtectedCommit(ctx context.Context, commit *pfs.Commit) error {
+	repoInfo, err := d.inspectRepo(ctx, commit.Repo, !includeAuth)
+	if err != nil {
+		return err
+	}
+	if len(repoInfo.BranchProtections) == 0 {
+		return nil
+	}
+	branches, err := d.listBranch(ctx, commit.Repo)
+	if err != nil {
+		return err
+	}
+	for _, branch := range branches {
+		if branch.Head.ID != commit.ID {
+			continue
+		}
+		protection := branchProtection(repoInfo, branch.Name)
+		if protection == nil {
+			continue
+		}
+		if err := d.checkFinishProtectedBranch(ctx, commit.Repo, protection); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkFinishProtectedBranch returns an error if 'protection' doesn't allow
+// the caller to finish the open commit at the head of the protected branch.
+func (d *driver) checkFinishProtectedBranch(ctx context.Context, repo *pfs.Repo, protection *pfs.BranchProtection) error {
+	if err := d.checkBranchPrincipal(ctx, repo, protection); err != nil {
+		return err
+	}
+	if protection.RequiredPipeline != "" {
+		return pfsserver.ErrBranchProtected{
+			Repo:   repo,
+			Branch: protection.Branch,
+			Reason: fmt.Sprintf("commits must be validated by pipeline %s before the branch is moved to them", protection.RequiredPipeline),
+		}
+	}
+	return nil
+}
+
+func (d *driver) setBranchProtection(ctx context.Context, repo *pfs.Repo, protection *pfs.BranchProtection) error {
+	if protection == nil || protection.Branch == "" {
+		return fmt.Errorf("branch protection must name a branch")
+	}
+	if err := d.checkIsAuthorized(ctx, repo, auth.Scope_OWNER); err != nil {
+		return err
+	}
+	_, err := col.NewSTM(ctx, d.etcdClient, func(stm col.STM) error {
+		repos := d.repos.ReadWrite(stm)
+		repoInfo := new(pfs.RepoInfo)
+		if err := repos.Get(repo.Name, repoInfo); err != nil {
+			return err
+		}
+		if existing := branchProtection(repoInfo, protection.Branch); existing != nil {
+			*existing = *protection
+		} else {
+			repoInfo.BranchProtections = append(repoInfo.BranchProtections, protection)
+		}
+		return repos.Put(repo.Name, repoInfo)
+	})
+	return err
+}
+
+func (d *driver) deleteBranchProtection(ctx context.Context, repo *pfs.Repo, branch string) error {
+	if err := d.checkIsAuthorized(ctx, repo, auth.Scope_OWNER); err != nil {
+		return err
+	}
+	_, err := col.NewSTM(ctx, d.etcdClient, func(stm col.STM) error {
+		repos := d.repos.ReadWrite(stm)
+		repoInfo := new(pfs.RepoInfo)
+		if err := repos.Get(repo.Name, repoInfo); err != nil {
+			return err
+		}
+		for i, protection := range repoInfo.BranchProtections {
+			if protection.Branch == branch {
+				repoInfo.BranchProtections = append(repoInfo.BranchProtections[:i], repoInfo.BranchProtections[i+1:]...)
+				return repos.Put(repo.Name, repoInfo)
+			}
+		}
+		return fmt.Errorf("branch %s in repo %s is not protected", branch, repo.Name)
 	})
 	return err
 }
@@ -1369,9 +1691,12 @@ func (d *driver) deleteBranch(ctx context.Context, repo *pfs.Repo, name string)
 	if err := d.checkIsAuthorized(ctx, repo, auth.Scope_WRITER); err != nil {
 		return err
 	}
-	_, err := col.NewSTM(ctx, d.etcdClient, func(stm col.STM) error {
-		branches := d.branches(repo.Name).ReadWrite(stm)
-		return branches.Delete(name)
+	principal, err := d.callerPrincipal(ctx)
+	if err != nil {
+		return err
+	}
+	_, err = col.NewSTM(ctx, d.etcdClient, func(stm col.STM) error {
+		return d.moveBranch(stm, repo, name, nil, principal, opDeleteBranch)
 	})
 	return err
 }
@@ -1416,15 +1741,20 @@ func checkPath(path string) error {
 
 func (d *driver) putFile(ctx context.Context, file *pfs.File, delimiter pfs.Delimiter,
 	targetFileDatums int64, targetFileBytes int64, overwriteIndex *pfs.OverwriteIndex, reader io.Reader) error {
+	span, ctx := tracing.StartSpan(ctx, "pfs.putFile", "repo", file.GetCommit().GetRepo().GetName(), "commit", file.GetCommit().GetID(), "path", file.GetPath())
+	defer span.Finish()
 	if err := d.checkIsAuthorized(ctx, file.Commit.Repo, auth.Scope_WRITER); err != nil {
 		return err
 	}
+	// In a transaction, branch names are resolved when the transaction is
+	// finished, since the transaction may move the branch
+	txnID := transactionID(ctx)
 	// Check if the commit ID is a branch name.  If so, we have to
 	// get the real commit ID in order to check if the commit does exist
 	// and is open.
 	// Since we use UUIDv4 for commit IDs, the 13th character would be 4 if
 	// this is a commit ID.
-	if len(file.Commit.ID) != uuid.UUIDWithoutDashesLength || file.Commit.ID[12] != '4' {
+	if txnID == "" && (len(file.Commit.ID) != uuid.UUIDWithoutDashesLength || file.Commit.ID[12] != '4') {
 		commitInfo, err := d.inspectCommit(ctx, file.Commit)
 		if err != nil {
 			return err
@@ -1432,6 +1762,33 @@ func (d *driver) putFile(ctx context.Context, file *pfs.File, delimiter pfs.Deli
 		file.Commit = commitInfo.Commit
 	}
 
+	// Fail early if the repo is already full, before uploading anything. The
+	// usage is read once, and this request's own writes are added to it.
+	var openCommit *pfs.Commit
+	if txnID == "" {
+		// In a transaction, the commit may not exist until the transaction
+		// is finished
+		openCommit = file.Commit
+	}
+	checker, err := d.newQuotaChecker(ctx, file.Commit.Repo, openCommit)
+	if err != nil {
+		return err
+	}
+	var quotaMu sync.Mutex
+	var bytesPut uint64
+	checkQuota := func(size uint64) error {
+		quotaMu.Lock()
+		defer quotaMu.Unlock()
+		if err := checker.check(bytesPut+size, 0); err != nil {
+			return err
+		}
+		bytesPut += size
+		return nil
+	}
+	if err := checkQuota(0); err != nil {
+		return err
+	}
+
 	if overwriteIndex != nil && overwriteIndex.Index == 0 {
 		if err := d.deleteFile(ctx, file); err != nil {
 			return err
@@ -1452,6 +1809,18 @@ func (d *driver) putFile(ctx context.Context, file *pfs.File, delimiter pfs.Deli
 	// To check that a key exists in etcd, we assert that its CreateRevision
 	// is greater than zero.
 	putRecords := func() error {
+		var size uint64
+		for _, record := range records.Records {
+			size += uint64(record.SizeBytes)
+		}
+		if err := checkQuota(size); err != nil {
+			return err
+		}
+		if txnID != "" {
+			return d.addTransactionRequest(ctx, txnID, &pfs.TransactionRequest{
+				PutFile: &pfs.TransactionPutFile{File: file, Records: records},
+			})
+		}
 		marshalledRecords, err := records.Marshal()
 		if err != nil {
 			return err
@@ -1572,6 +1941,8 @@ func (d *driver) putFile(ctx context.Context, file *pfs.File, delimiter pfs.Deli
 }
 
 func (d *driver) copyFile(ctx context.Context, src *pfs.File, dst *pfs.File, overwrite bool) error {
+	span, ctx := tracing.StartSpan(ctx, "pfs.copyFile", "repo", dst.GetCommit().GetRepo().GetName(), "commit", dst.GetCommit().GetID(), "path", dst.GetPath())
+	defer span.Finish()
 	if err := d.checkIsAuthorized(ctx, src.Commit.Repo, auth.Scope_READER); err != nil {
 		return err
 	}
@@ -1656,6 +2027,8 @@ func (d *driver) copyFile(ctx context.Context, src *pfs.File, dst *pfs.File, ove
 }
 
 func (d *driver) getTreeForCommit(ctx context.Context, commit *pfs.Commit) (hashtree.HashTree, error) {
+	span, ctx := tracing.StartSpan(ctx, "pfs.getTreeForCommit", "repo", commit.GetRepo().GetName(), "commit", commit.GetID())
+	defer span.Finish()
 	if commit == nil || commit.ID == "" {
 		t, err := hashtree.NewHashTree().Finish()
 		if err != nil {
@@ -1759,6 +2132,8 @@ func (d *driver) getTreeForFile(ctx context.Context, file *pfs.File) (hashtree.H
 }
 
 func (d *driver) getFile(ctx context.Context, file *pfs.File, offset int64, size int64) (io.Reader, error) {
+	span, ctx := tracing.StartSpan(ctx, "pfs.getFile", "repo", file.GetCommit().GetRepo().GetName(), "commit", file.GetCommit().GetID(), "path", file.GetPath())
+	defer span.Finish()
 	if err := d.checkIsAuthorized(ctx, file.Commit.Repo, auth.Scope_READER); err != nil {
 		return nil, err
 	}
@@ -1776,18 +2151,7 @@ func (d *driver) getFile(ctx context.Context, file *pfs.File, offset int64, size
 		return nil, fmt.Errorf("%s is a directory", file.Path)
 	}
 
-	getObjectsClient, err := d.pachClient.ObjectAPIClient.GetObjects(
-		ctx,
-		&pfs.GetObjectsRequest{
-			Objects:     node.FileNode.Objects,
-			OffsetBytes: uint64(offset),
-			SizeBytes:   uint64(size),
-			TotalSize:   uint64(node.SubtreeSize),
-		})
-	if err != nil {
-		return nil, err
-	}
-	return grpcutil.NewStreamingBytesReader(getObjectsClient), nil
+	return d.readFileNode(ctx, node, offset, size)
 }
 
 // If full is false, exclude potentially large fields such as `Objects`
@@ -1816,6 +2180,8 @@ func nodeToFileInfo(commit *pfs.Commit, path string, node *hashtree.NodeProto, f
 }
 
 func (d *driver) inspectFile(ctx context.Context, file *pfs.File) (*pfs.FileInfo, error) {
+	span, ctx := tracing.StartSpan(ctx, "pfs.inspectFile", "repo", file.GetCommit().GetRepo().GetName(), "commit", file.GetCommit().GetID(), "path", file.GetPath())
+	defer span.Finish()
 	if err := d.checkIsAuthorized(ctx, file.Commit.Repo, auth.Scope_READER); err != nil {
 		return nil, err
 	}
@@ -1833,6 +2199,8 @@ func (d *driver) inspectFile(ctx context.Context, file *pfs.File) (*pfs.FileInfo
 }
 
 func (d *driver) listFile(ctx context.Context, file *pfs.File, full bool) ([]*pfs.FileInfo, error) {
+	span, ctx := tracing.StartSpan(ctx, "pfs.listFile", "repo", file.GetCommit().GetRepo().GetName(), "commit", file.GetCommit().GetID(), "path", file.GetPath())
+	defer span.Finish()
 	if err := d.checkIsAuthorized(ctx, file.Commit.Repo, auth.Scope_READER); err != nil {
 		return nil, err
 	}
@@ -1854,6 +2222,8 @@ func (d *driver) listFile(ctx context.Context, file *pfs.File, full bool) ([]*pf
 }
 
 func (d *driver) globFile(ctx context.Context, commit *pfs.Commit, pattern string) ([]*pfs.FileInfo, error) {
+	span, ctx := tracing.StartSpan(ctx, "pfs.globFile", "repo", commit.GetRepo().GetName(), "commit", commit.GetID(), "pattern", pattern)
+	defer span.Finish()
 	if err := d.checkIsAuthorized(ctx, commit.Repo, auth.Scope_READER); err != nil {
 		return nil, err
 	}
@@ -1874,6 +2244,105 @@ func (d *driver) globFile(ctx context.Context, commit *pfs.Commit, pattern strin
 	return fileInfos, nil
 }
 
+// grepFile calls 'f' with each line that matches 'regex' in the files in
+// 'commit' that match the glob 'pattern'. Files are scanned in parallel, and
+// the matches in each file are passed to 'f' together, in order.
+func (d *driver) grepFile(ctx context.Context, commit *pfs.Commit, pattern string, regex string, maxMatches int64, maxBytes int64, f func(*pfs.GrepFileResult) error) error {
+	if err := d.checkIsAuthorized(ctx, commit.Repo, auth.Scope_READER); err != nil {
+		return err
+	}
+	re, err := regexp.Compile(regex)
+	if err != nil {
+		return fmt.Errorf("invalid regex %q: %v", regex, err)
+	}
+	tree, err := d.getTreeForFile(ctx, client.NewFile(commit.Repo.Name, commit.ID, ""))
+	if err != nil {
+		return err
+	}
+	nodes, err := tree.Glob(pattern)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	var (
+		mu           sync.Mutex
+		matches      int64
+		bytesScanned int64
+		eg           errgroup.Group
+	)
+	// errMaxMatches stops the search once enough matches have been found
+	errMaxMatches := fmt.Errorf("max matches reached")
+	limiter := limit.New(grepParallelism)
+	for _, node := range nodes {
+		if node.FileNode == nil {
+			continue
+		}
+		if ctx.Err() != nil {
+			break
+		}
+		node := node
+		limiter.Acquire()
+		eg.Go(func() (retErr error) {
+			defer limiter.Release()
+			defer func() {
+				if retErr != nil {
+					cancel()
+				}
+			}()
+			getObjectsClient, err := d.pachClient.ObjectAPIClient.GetObjects(
+				ctx,
+				&pfs.GetObjectsRequest{
+					Objects:   node.FileNode.Objects,
+					TotalSize: uint64(node.SubtreeSize),
+				})
+			if err != nil {
+				return err
+			}
+			var results []*pfs.GrepFileResult
+			r := bufio.NewReader(grpcutil.NewStreamingBytesReader(getObjectsClient))
+			for lineNumber := int64(1); ; lineNumber++ {
+				line, err := r.ReadBytes('\n')
+				if len(line) > 0 {
+					if maxBytes > 0 && atomic.AddInt64(&bytesScanned, int64(len(line))) > maxBytes {
+						return fmt.Errorf("grep scanned more than the limit of %d bytes; narrow the glob pattern or raise the limit", maxBytes)
+					}
+					line = bytes.TrimSuffix(line, []byte{'\n'})
+					if re.Match(line) {
+						results = append(results, &pfs.GrepFileResult{
+							File:       client.NewFile(commit.Repo.Name, commit.ID, node.Name),
+							LineNumber: lineNumber,
+							Line:       string(line),
+						})
+					}
+				}
+				if err == io.EOF {
+					break
+				} else if err != nil {
+					return err
+				}
+			}
+			mu.Lock()
+			defer mu.Unlock()
+			for _, result := range results {
+				if maxMatches > 0 && matches >= maxMatches {
+					return errMaxMatches
+				}
+				if err := f(result); err != nil {
+					return err
+				}
+				matches++
+			}
+			return nil
+		})
+	}
+	if err := eg.Wait(); err != nil && err != errMaxMatches {
+		return err
+	}
+	return nil
+}
+
 func (d *driver) diffFile(ctx context.Context, newFile *pfs.File, oldFile *pfs.File, shallow bool) ([]*pfs.FileInfo, []*pfs.FileInfo, error) {
 	// Do READER authorization check for both newFile and oldFile
 	if oldFile != nil && oldFile.Commit != nil {
@@ -1928,9 +2397,19 @@ func (d *driver) diffFile(ctx context.Context, newFile *pfs.File, oldFile *pfs.F
 }
 
 func (d *driver) deleteFile(ctx context.Context, file *pfs.File) error {
+	span, ctx := tracing.StartSpan(ctx, "pfs.deleteFile", "repo", file.GetCommit().GetRepo().GetName(), "commit", file.GetCommit().GetID(), "path", file.GetPath())
+	defer span.Finish()
 	if err := d.checkIsAuthorized(ctx, file.Commit.Repo, auth.Scope_WRITER); err != nil {
 		return err
 	}
+	if txnID := transactionID(ctx); txnID != "" {
+		if err := checkPath(file.Path); err != nil {
+			return err
+		}
+		return d.addTransactionRequest(ctx, txnID, &pfs.TransactionRequest{
+			PutFile: &pfs.TransactionPutFile{File: file},
+		})
+	}
 	commitInfo, err := d.inspectCommit(ctx, file.Commit)
 	if err != nil {
 		return err
@@ -1974,66 +2453,84 @@ func (d *driver) applyWrites(resp *etcd.GetResponse, tree hashtree.OpenHashTree)
 		filePath := strings.Join(parts[:len(parts)-1], "/")
 
 		if string(kv.Value) == tombstone {
-			if err := tree.DeleteFile(filePath); err != nil {
-				// Deleting a non-existent file in an open commit should
-				// be a no-op
-				if hashtree.Code(err) != hashtree.PathNotFound {
-					return err
-				}
+			if err := applyDeleteFile(filePath, tree); err != nil {
+				return err
 			}
 		} else {
 			records := &pfs.PutFileRecords{}
 			if err := records.Unmarshal(kv.Value); err != nil {
 				return err
 			}
-			if !records.Split {
-				if len(records.Records) == 0 {
-					return fmt.Errorf("unexpect %d length pfs.PutFileRecord (this is likely a bug)", len(records.Records))
-				}
-				for _, record := range records.Records {
-					sizeMap[record.ObjectHash] = record.SizeBytes
-					if record.OverwriteIndex != nil {
-						// Computing size delta
-						delta := record.SizeBytes
-						fileNode, err := tree.Get(filePath)
-						if err == nil {
-							// If we can't find the file, that's fine.
-							for i := record.OverwriteIndex.Index; int(i) < len(fileNode.FileNode.Objects); i++ {
-								delta -= sizeMap[fileNode.FileNode.Objects[i].Hash]
-							}
-						}
+			if err := applyPutFileRecords(filePath, records, sizeMap, tree); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
 
-						if err := tree.PutFileOverwrite(filePath, []*pfs.Object{{Hash: record.ObjectHash}}, record.OverwriteIndex, delta); err != nil {
-							return err
-						}
-					} else {
-						if err := tree.PutFile(filePath, []*pfs.Object{{Hash: record.ObjectHash}}, record.SizeBytes); err != nil {
-							return err
-						}
+// applyDeleteFile deletes 'filePath' from 'tree', as a tombstone written by
+// DeleteFile does.
+func applyDeleteFile(filePath string, tree hashtree.OpenHashTree) error {
+	if err := tree.DeleteFile(filePath); err != nil {
+		// Deleting a non-existent file in an open commit should
+		// be a no-op
+		if hashtree.Code(err) != hashtree.PathNotFound {
+			return err
+		}
+	}
+	return nil
+}
+
+// applyPutFileRecords adds the objects in 'records' to 'filePath' in 'tree'.
+// 'sizeMap' keeps track of the sizes of the objects written so far.
+func applyPutFileRecords(filePath string, records *pfs.PutFileRecords, sizeMap map[string]int64, tree hashtree.OpenHashTree) error {
+	if !records.Split {
+		if len(records.Records) == 0 {
+			return fmt.Errorf("unexpect %d length pfs.PutFileRecord (this is likely a bug)", len(records.Records))
+		}
+		for _, record := range records.Records {
+			sizeMap[record.ObjectHash] = record.SizeBytes
+			if record.OverwriteIndex != nil {
+				// Computing size delta
+				delta := record.SizeBytes
+				fileNode, err := tree.Get(filePath)
+				if err == nil {
+					// If we can't find the file, that's fine.
+					for i := record.OverwriteIndex.Index; int(i) < len(fileNode.FileNode.Objects); i++ {
+						delta -= sizeMap[fileNode.FileNode.Objects[i].Hash]
 					}
 				}
-			} else {
-				nodes, err := tree.List(filePath)
-				if err != nil && hashtree.Code(err) != hashtree.PathNotFound {
+
+				if err := tree.PutFileOverwrite(filePath, []*pfs.Object{{Hash: record.ObjectHash}}, record.OverwriteIndex, delta); err != nil {
 					return err
 				}
-				var indexOffset int64
-				if len(nodes) > 0 {
-					indexOffset, err = strconv.ParseInt(path.Base(nodes[len(nodes)-1].Name), splitSuffixBase, splitSuffixWidth)
-					if err != nil {
-						return fmt.Errorf("error parsing filename %s as int, this likely means you're "+
-							"using split on a directory which contains other data that wasn't put with split",
-							path.Base(nodes[len(nodes)-1].Name))
-					}
-					indexOffset++ // start writing to the file after the last file
-				}
-				for i, record := range records.Records {
-					if err := tree.PutFile(path.Join(filePath, fmt.Sprintf(splitSuffixFmt, i+int(indexOffset))), []*pfs.Object{{Hash: record.ObjectHash}}, record.SizeBytes); err != nil {
-						return err
-					}
+			} else {
+				if err := tree.PutFile(filePath, []*pfs.Object{{Hash: record.ObjectHash}}, record.SizeBytes); err != nil {
+					return err
 				}
 			}
 		}
+		return nil
+	}
+	nodes, err := tree.List(filePath)
+	if err != nil && hashtree.Code(err) != hashtree.PathNotFound {
+		return err
+	}
+	var indexOffset int64
+	if len(nodes) > 0 {
+		indexOffset, err = strconv.ParseInt(path.Base(nodes[len(nodes)-1].Name), splitSuffixBase, splitSuffixWidth)
+		if err != nil {
+			return fmt.Errorf("error parsing filename %s as int, this likely means you're "+
+				"using split on a directory which contains other data that wasn't put with split",
+				path.Base(nodes[len(nodes)-1].Name))
+		}
+		indexOffset++ // start writing to the file after the last file
+	}
+	for i, record := range records.Records {
+		if err := tree.PutFile(path.Join(filePath, fmt.Sprintf(splitSuffixFmt, i+int(indexOffset))), []*pfs.Object{{Hash: record.ObjectHash}}, record.SizeBytes); err != nil {
+			return err
+		}
 	}
 	return nil
 }
@@ -0,0 +1,473 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"path"
+	"sort"
+
+	"github.com/pachyderm/pachyderm/src/client/auth"
+	"github.com/pachyderm/pachyderm/src/client/pfs"
+	"github.com/pachyderm/pachyderm/src/client/pps"
+	col "github.com/pachyderm/pachyderm/src/server/pkg/collection"
+	"github.com/pachyderm/pachyderm/src/server/pkg/hashtree"
+)
+
+const (
+	opForkRepo = "ForkRepo"
+)
+
+// forkRepo creates the repo 'fork' with a copy of each finished commit of
+// 'repo', built with buildCommit from the original commit's tree, so that no
+// data is copied. The copies get new IDs, and have no provenance. The
+// branches of 'repo' are copied too; a branch whose head is open points to
+// the newest finished ancestor of its head.
+func (d *driver) forkRepo(ctx context.Context, repo *pfs.Repo, fork *pfs.Repo, description string) error {
+	if err := d.checkIsAuthorized(ctx, repo, auth.Scope_READER); err != nil {
+		return err
+	}
+	if _, err := d.inspectRepo(ctx, repo, !includeAuth); err != nil {
+		return err
+	}
+	var commitInfos []*pfs.CommitInfo
+	parents := make(map[string]*pfs.Commit)
+	iter, err := d.commits(repo.Name).ReadOnly(ctx).List()
+	if err != nil {
+		return err
+	}
+	for {
+		var commitID string
+		commitInfo := new(pfs.CommitInfo)
+		ok, err := iter.Next(&commitID, commitInfo)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			break
+		}
+		parents[commitInfo.Commit.ID] = commitInfo.ParentCommit
+		if commitInfo.Finished != nil {
+			commitInfos = append(commitInfos, commitInfo)
+		}
+	}
+	branchInfos, err := d.listBranch(ctx, repo)
+	if err != nil {
+		return err
+	}
+
+	if err := d.createRepo(ctx, fork, nil, description, false); err != nil {
+		return err
+	}
+	// Commits are copied oldest first, so that each commit's parent has been
+	// copied before it
+	sort.Slice(commitInfos, func(i, j int) bool {
+		return commitStartedBefore(commitInfos[i], commitInfos[j])
+	})
+	copies := make(map[string]*pfs.Commit)
+	var emptyTree *pfs.Object
+	for _, commitInfo := range commitInfos {
+		parent := &pfs.Commit{Repo: fork}
+		if commitInfo.ParentCommit != nil {
+			if parentCopy, ok := copies[commitInfo.ParentCommit.ID]; ok {
+				parent.ID = parentCopy.ID
+			}
+		}
+		tree := commitInfo.Tree
+		if tree == nil {
+			// The commit is empty, which its copy can't inherit from its
+			// parent, so it's built from an empty tree
+			if emptyTree == nil {
+				if emptyTree, err = d.putEmptyTree(); err != nil {
+					return err
+				}
+			}
+			tree = emptyTree
+		}
+		commit, err := d.buildCommit(ctx, parent, "", nil, tree)
+		if err != nil {
+			return err
+		}
+		copies[commitInfo.Commit.ID] = commit
+	}
+
+	principal, err := d.callerPrincipal(ctx)
+	if err != nil {
+		return err
+	}
+	_, err = col.NewSTM(ctx, d.etcdClient, func(stm col.STM) error {
+		for _, branchInfo := range branchInfos {
+			head := branchInfo.Head
+			for head != nil && copies[head.ID] == nil {
+				head = parents[head.ID]
+			}
+			if head == nil {
+				continue
+			}
+			if err := d.moveBranch(stm, fork, branchInfo.Name, copies[head.ID], principal, opForkRepo); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	return err
+}
+
+// putEmptyTree puts an empty hashtree into the object store
+func (d *driver) putEmptyTree() (*pfs.Object, error) {
+	tree, err := hashtree.NewHashTree().Finish()
+	if err != nil {
+		return nil, err
+	}
+	data, err := hashtree.Serialize(tree)
+	if err != nil {
+		return nil, err
+	}
+	obj, _, err := d.pachClient.PutObject(bytes.NewReader(data))
+	return obj, err
+}
+
+// renameRepo renames 'repo' to 'newRepo'. The records of the repo, its
+// commits, its branches and their histories, its ACL, the provenance of
+// downstream repos and commits, and the inputs of pipelines are all updated
+// in one STM, so the rename is atomic. The etcd transaction that it makes is
+// as large as the repo and its downstream commits, so it's limited by etcd's
+// maximum transaction size.
+func (d *driver) renameRepo(ctx context.Context, repo *pfs.Repo, newRepo *pfs.Repo) error {
+	if err := ValidateRepoName(newRepo.Name); err != nil {
+		return err
+	}
+	if repo.Name == newRepo.Name {
+		return fmt.Errorf("repo %s already has that name", repo.Name)
+	}
+	if err := d.checkIsAuthorized(ctx, repo, auth.Scope_OWNER); err != nil {
+		return err
+	}
+	// The keys that the STM reads and writes are listed first, as an STM
+	// can't list collections. The STM fails if any of them change meanwhile,
+	// and the repo's revision, which changes whenever a commit or branch is
+	// added to it (see touchRepo), catches keys that are added meanwhile.
+	repoResp, err := d.etcdClient.Get(ctx, d.repos.Path(repo.Name))
+	if err != nil {
+		return err
+	}
+	if len(repoResp.Kvs) == 0 {
+		return fmt.Errorf("repo %s not found", repo.Name)
+	}
+	repoRev := repoResp.Kvs[0].ModRevision
+	commitIDs, err := d.renamedCommits(ctx, repo)
+	if err != nil {
+		return err
+	}
+	branchInfos, err := d.listBranch(ctx, repo)
+	if err != nil {
+		return err
+	}
+	histories, err := d.renamedHistories(ctx, repo)
+	if err != nil {
+		return err
+	}
+	repoInfos, err := d.listRepo(ctx, []*pfs.Repo{repo}, !includeAuth)
+	if err != nil {
+		return err
+	}
+	// The commits of downstream repos whose provenance refers to the repo
+	downstream := make(map[string][]string)
+	for _, repoInfo := range repoInfos.RepoInfo {
+		iter, err := d.commits(repoInfo.Repo.Name).ReadOnly(ctx).List()
+		if err != nil {
+			return err
+		}
+		for {
+			var commitID string
+			commitInfo := new(pfs.CommitInfo)
+			ok, err := iter.Next(&commitID, commitInfo)
+			if err != nil {
+				return err
+			}
+			if !ok {
+				break
+			}
+			for _, prov := range commitInfo.Provenance {
+				if prov.Repo.Name == repo.Name {
+					downstream[repoInfo.Repo.Name] = append(downstream[repoInfo.Repo.Name], commitInfo.Commit.ID)
+					break
+				}
+			}
+		}
+	}
+	pipelines, err := d.renamedPipelines(ctx, repo)
+	if err != nil {
+		return err
+	}
+
+	_, err = col.NewSTM(ctx, d.etcdClient, func(stm col.STM) error {
+		repos := d.repos.ReadWrite(stm)
+		repoInfo := new(pfs.RepoInfo)
+		if err := repos.Get(repo.Name, repoInfo); err != nil {
+			if col.IsErrNotFound(err) {
+				return fmt.Errorf("repo %s not found", repo.Name)
+			}
+			return err
+		}
+		if stm.Rev(d.repos.Path(repo.Name)) != repoRev {
+			return fmt.Errorf("repo %s was modified while it was being renamed; rename it again to retry", repo.Name)
+		}
+		repoInfo.Repo = newRepo
+		if err := repos.Create(newRepo.Name, repoInfo); err != nil {
+			if _, ok := err.(col.ErrExists); ok {
+				return fmt.Errorf("cannot rename %s to %s as it already exists", repo.Name, newRepo.Name)
+			}
+			return err
+		}
+		if err := repos.Delete(repo.Name); err != nil {
+			return err
+		}
+		repoRefCounts := d.repoRefCounts.ReadWriteInt(stm)
+		refCount, err := repoRefCounts.Get(repo.Name)
+		if err != nil {
+			return err
+		}
+		if err := repoRefCounts.Create(newRepo.Name, refCount); err != nil {
+			return err
+		}
+		if err := repoRefCounts.Delete(repo.Name); err != nil {
+			return err
+		}
+
+		rename := func(commit *pfs.Commit) {
+			if commit != nil && commit.Repo.Name == repo.Name {
+				commit.Repo = newRepo
+			}
+		}
+		oldCommits := d.commits(repo.Name).ReadWrite(stm)
+		newCommits := d.commits(newRepo.Name).ReadWrite(stm)
+		for _, commitID := range commitIDs {
+			commitInfo := new(pfs.CommitInfo)
+			if err := oldCommits.Get(commitID, commitInfo); err != nil {
+				return err
+			}
+			if commitInfo.Finished == nil {
+				return fmt.Errorf("cannot rename %s while it has open commits", repo.Name)
+			}
+			rename(commitInfo.Commit)
+			rename(commitInfo.ParentCommit)
+			if err := newCommits.Put(commitID, commitInfo); err != nil {
+				return err
+			}
+			if err := oldCommits.Delete(commitID); err != nil {
+				return err
+			}
+		}
+		oldBranches := d.branches(repo.Name).ReadWrite(stm)
+		newBranches := d.branches(newRepo.Name).ReadWrite(stm)
+		for _, branchInfo := range branchInfos {
+			head := new(pfs.Commit)
+			if err := oldBranches.Get(branchInfo.Name, head); err != nil {
+				return err
+			}
+			rename(head)
+			if err := newBranches.Put(branchInfo.Name, head); err != nil {
+				return err
+			}
+			if err := oldBranches.Delete(branchInfo.Name); err != nil {
+				return err
+			}
+		}
+		oldHistories := d.branchHistories(repo.Name).ReadWrite(stm)
+		newHistories := d.branchHistories(newRepo.Name).ReadWrite(stm)
+		for _, branch := range histories {
+			history := new(pfs.BranchHistory)
+			if err := oldHistories.Get(branch, history); err != nil {
+				return err
+			}
+			for _, move := range history.Moves {
+				rename(move.OldHead)
+				rename(move.NewHead)
+			}
+			if err := newHistories.Put(branch, history); err != nil {
+				return err
+			}
+			if err := oldHistories.Delete(branch); err != nil {
+				return err
+			}
+		}
+
+		for downstreamRepo, commitIDs := range downstream {
+			commits := d.commits(downstreamRepo).ReadWrite(stm)
+			for _, commitID := range commitIDs {
+				commitInfo := new(pfs.CommitInfo)
+				if err := commits.Get(commitID, commitInfo); err != nil {
+					return err
+				}
+				for _, prov := range commitInfo.Provenance {
+					rename(prov)
+				}
+				if err := commits.Put(commitID, commitInfo); err != nil {
+					return err
+				}
+			}
+		}
+		for _, downstreamRepo := range repoInfos.RepoInfo {
+			downstreamInfo := new(pfs.RepoInfo)
+			if err := repos.Get(downstreamRepo.Repo.Name, downstreamInfo); err != nil {
+				return err
+			}
+			for i, prov := range downstreamInfo.Provenance {
+				if prov.Name == repo.Name {
+					downstreamInfo.Provenance[i] = newRepo
+				}
+			}
+			if err := repos.Put(downstreamRepo.Repo.Name, downstreamInfo); err != nil {
+				return err
+			}
+		}
+
+		if d.acls != nil {
+			acls := d.acls.ReadWrite(stm)
+			acl := new(auth.ACL)
+			if err := acls.Get(repo.Name, acl); err != nil {
+				if !col.IsErrNotFound(err) {
+					return err
+				}
+				// Clear any ACL left under the new name
+				if err := acls.Delete(newRepo.Name); err != nil && !col.IsErrNotFound(err) {
+					return err
+				}
+			} else {
+				if err := acls.Put(newRepo.Name, acl); err != nil {
+					return err
+				}
+				if err := acls.Delete(repo.Name); err != nil {
+					return err
+				}
+			}
+		}
+
+		if d.pipelines != nil {
+			pipelineInfos := d.pipelines.ReadWrite(stm)
+			if err := pipelineInfos.Get(repo.Name, &pps.PipelineInfo{}); err == nil {
+				return fmt.Errorf("cannot rename %s as it's the output repo of a pipeline", repo.Name)
+			} else if !col.IsErrNotFound(err) {
+				return err
+			}
+			for _, pipeline := range pipelines {
+				pipelineInfo := new(pps.PipelineInfo)
+				if err := pipelineInfos.Get(pipeline, pipelineInfo); err != nil {
+					return err
+				}
+				pps.VisitInput(pipelineInfo.Input, func(input *pps.Input) {
+					if input.Atom != nil && input.Atom.Repo == repo.Name {
+						input.Atom.Repo = newRepo.Name
+					}
+					if input.Cron != nil && input.Cron.Repo == repo.Name {
+						input.Cron.Repo = newRepo.Name
+					}
+				})
+				// The input changed, so this is a new version of the
+				// pipeline, which makes PPS restart its workers
+				pipelineInfo.Version++
+				versionInfo := *pipelineInfo
+				versionInfo.Capability = ""
+				if err := d.pipelineVersions(pipeline).ReadWrite(stm).Put(fmt.Sprint(pipelineInfo.Version), &versionInfo); err != nil {
+					return err
+				}
+				if err := pipelineInfos.Put(pipeline, pipelineInfo); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+	return err
+}
+
+// renamedCommits returns the IDs of the commits of 'repo', which must all be
+// finished for it to be renamed
+func (d *driver) renamedCommits(ctx context.Context, repo *pfs.Repo) ([]string, error) {
+	var commitIDs []string
+	iter, err := d.commits(repo.Name).ReadOnly(ctx).List()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		var commitID string
+		commitInfo := new(pfs.CommitInfo)
+		ok, err := iter.Next(&commitID, commitInfo)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return commitIDs, nil
+		}
+		if commitInfo.Finished == nil {
+			return nil, fmt.Errorf("cannot rename %s while it has open commits", repo.Name)
+		}
+		commitIDs = append(commitIDs, commitInfo.Commit.ID)
+	}
+}
+
+// renamedHistories returns the names of the branches of 'repo' that have
+// histories, which include deleted branches
+func (d *driver) renamedHistories(ctx context.Context, repo *pfs.Repo) ([]string, error) {
+	var branches []string
+	iter, err := d.branchHistories(repo.Name).ReadOnly(ctx).List()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		var branch string
+		ok, err := iter.Next(&branch, &pfs.BranchHistory{})
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return branches, nil
+		}
+		branches = append(branches, path.Base(branch))
+	}
+}
+
+// renamedPipelines returns the names of the pipelines with 'repo' as an input
+func (d *driver) renamedPipelines(ctx context.Context, repo *pfs.Repo) ([]string, error) {
+	if d.pipelines == nil {
+		return nil, nil
+	}
+	var pipelines []string
+	iter, err := d.pipelines.ReadOnly(ctx).List()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		var pipeline string
+		pipelineInfo := new(pps.PipelineInfo)
+		ok, err := iter.Next(&pipeline, pipelineInfo)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return pipelines, nil
+		}
+		found := false
+		pps.VisitInput(pipelineInfo.Input, func(input *pps.Input) {
+			if (input.Atom != nil && input.Atom.Repo == repo.Name) || (input.Cron != nil && input.Cron.Repo == repo.Name) {
+				found = true
+			}
+		})
+		if found {
+			pipelines = append(pipelines, pipelineInfo.Pipeline.Name)
+		}
+	}
+}
+
+// touchRepo rewrites the record of 'repo' unchanged, so that its etcd
+// revision changes. It's called whenever a commit or branch is added to the
+// repo, which renameRepo relies on to notice keys that it didn't list.
+func (d *driver) touchRepo(stm col.STM, repo *pfs.Repo) error {
+	repos := d.repos.ReadWrite(stm)
+	repoInfo := new(pfs.RepoInfo)
+	if err := repos.Get(repo.Name, repoInfo); err != nil {
+		return err
+	}
+	return repos.Put(repo.Name, repoInfo)
+}
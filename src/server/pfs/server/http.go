@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"path"
 	"strings"
 
 	"github.com/pachyderm/pachyderm/src/client/auth"
@@ -31,8 +32,9 @@ func (fw *flushWriter) Write(p []byte) (n int, err error) {
 	return
 }
 
-// HTTPServer serves GetFile requests over HTTP
+// HTTPServer serves GetFile and GetArchive requests over HTTP
 // e.g. http://localhost:30652/v1/pfs/repos/foo/commits/b7a1923be56744f6a3f1525ec222dc3b/files/ttt.log
+// or http://localhost:30652/v1/pfs/repos/foo/commits/master/archive/logs?format=zip
 type HTTPServer struct {
 	driver *driver
 	*httprouter.Router
@@ -40,7 +42,7 @@ type HTTPServer struct {
 }
 
 func newHTTPServer(address string, etcdAddresses []string, etcdPrefix string, cacheSize int64) (*HTTPServer, error) {
-	d, err := newDriver(address, etcdAddresses, etcdPrefix, cacheSize)
+	d, err := newDriver(address, etcdAddresses, etcdPrefix, "", "", cacheSize)
 	if err != nil {
 		return nil, err
 	}
@@ -52,6 +54,7 @@ func newHTTPServer(address string, etcdAddresses []string, etcdPrefix string, ca
 	}
 
 	router.GET(fmt.Sprintf("/%v/pfs/repos/:repoName/commits/:commitID/files/*filePath", apiVersion), s.getFileHandler)
+	router.GET(fmt.Sprintf("/%v/pfs/repos/:repoName/commits/:commitID/archive/*filePath", apiVersion), s.getArchiveHandler)
 	router.POST(s.loginPath, s.authLoginHandler)
 	router.POST(fmt.Sprintf("/%v/auth/logout", apiVersion), s.authLogoutHandler)
 	// Debug method (to check login cookies):
@@ -109,6 +112,62 @@ func (s *HTTPServer) getFileHandler(w http.ResponseWriter, r *http.Request, ps h
 	io.Copy(&fw, file)
 }
 
+// getArchiveHandler serves an archive of the files that match a path or glob
+// pattern. The archive's format is given by the "format" query parameter
+// ("tar", "tar.gz" or "zip"), and defaults to tar.
+func (s *HTTPServer) getArchiveHandler(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	filePath := ps.ByName("filePath")
+	pfsFile := &pfs.File{
+		Commit: &pfs.Commit{
+			ID: ps.ByName("commitID"),
+			Repo: &pfs.Repo{
+				Name: ps.ByName("repoName"),
+			},
+		},
+		Path: filePath,
+	}
+	format := pfs.ArchiveFormat_TAR
+	if formatValue := r.URL.Query().Get("format"); formatValue != "" {
+		var err error
+		if format, err = pfs.ParseArchiveFormat(formatValue); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+	}
+	// Name the archive after the archived directory, or after the repo if the
+	// path is the root or a glob pattern
+	archiveName := path.Base(filePath)
+	if archiveName == "/" || archiveName == "." || strings.ContainsAny(archiveName, "*?[") {
+		archiveName = pfsFile.Commit.Repo.Name
+	}
+	ctx := context.Background()
+	for _, cookie := range r.Cookies() {
+		if cookie.Name == auth.ContextTokenKey {
+			ctx = metadata.NewIncomingContext(
+				ctx,
+				metadata.Pairs(auth.ContextTokenKey, cookie.Value),
+			)
+		}
+	}
+	switch format {
+	case pfs.ArchiveFormat_TAR_GZ:
+		w.Header().Add("Content-Type", "application/gzip")
+	case pfs.ArchiveFormat_ZIP:
+		w.Header().Add("Content-Type", "application/zip")
+	default:
+		w.Header().Add("Content-Type", "application/x-tar")
+	}
+	w.Header().Add("Content-Disposition", fmt.Sprintf("attachment; filename=\"%v.%v\"", archiveName, format.Extension()))
+	fw := flushWriter{w: w}
+	if f, ok := w.(http.Flusher); ok {
+		fw.f = f
+	}
+	if err := s.driver.getArchive(ctx, pfsFile, format, &fw); err != nil {
+		// If part of the archive has been sent, this just truncates it
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
+
 type loginRequestPayload struct {
 	Token string
 }
@@ -0,0 +1,39 @@
+package server
+
+import (
+	"context"
+	"path"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/pachyderm/pachyderm/src/server/pkg/backoff"
+	"github.com/pachyderm/pachyderm/src/server/pkg/dlock"
+)
+
+const (
+	masterLockPath = "_master_lock"
+)
+
+// The master process runs PFS's background processes, such as applying the
+// repos' retention policies. Only one pachd runs it at a time.
+func (d *driver) master() {
+	masterLock := dlock.NewDLock(d.etcdClient, path.Join(d.prefix, masterLockPath))
+	backoff.RetryNotify(func() error {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		ctx, err := masterLock.Lock(ctx)
+		if err != nil {
+			return err
+		}
+		defer masterLock.Unlock(ctx)
+
+		log.Infof("Launching PFS master process")
+
+		return d.retainCommits(ctx)
+	}, backoff.NewInfiniteBackOff(), func(err error, d time.Duration) error {
+		log.Errorf("PFS master: error running the master process: %v; retrying in %v", err, d)
+		return nil
+	})
+}
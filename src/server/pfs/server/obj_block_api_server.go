@@ -24,10 +24,12 @@ import (
 	"github.com/pachyderm/pachyderm/src/client/limit"
 	pfsclient "github.com/pachyderm/pachyderm/src/client/pfs"
 	"github.com/pachyderm/pachyderm/src/client/pkg/grpcutil"
+	"github.com/pachyderm/pachyderm/src/client/pkg/tracing"
 	"github.com/pachyderm/pachyderm/src/client/pkg/uuid"
 	"github.com/pachyderm/pachyderm/src/server/pkg/backoff"
 	"github.com/pachyderm/pachyderm/src/server/pkg/log"
 	"github.com/pachyderm/pachyderm/src/server/pkg/obj"
+	"github.com/pachyderm/pachyderm/src/server/pkg/prom"
 	"github.com/pachyderm/pachyderm/src/server/pkg/watch"
 )
 
@@ -39,6 +41,44 @@ const (
 	objectInfoCacheShares = 1
 	maxCachedObjectDenom  = 4                // We will only cache objects less than 1/maxCachedObjectDenom of total cache size
 	bufferSize            = 15 * 1024 * 1024 // 15 MB
+
+	// Names of the groupcache groups
+	objectGroup     = "object"
+	tagGroup        = "tag"
+	objectInfoGroup = "objectInfo"
+)
+
+var (
+	cacheGets = prom.NewCounterVec(
+		"pachyderm_block_cache_gets_total",
+		"Number of lookups in the block cache, by cache group.",
+		"group",
+	)
+	cacheHits = prom.NewCounterVec(
+		"pachyderm_block_cache_hits_total",
+		"Number of lookups served from the block cache, by cache group.",
+		"group",
+	)
+	cacheLoads = prom.NewCounterVec(
+		"pachyderm_block_cache_local_loads_total",
+		"Number of cache misses loaded from object storage, by cache group.",
+		"group",
+	)
+	cachePeerLoads = prom.NewCounterVec(
+		"pachyderm_block_cache_peer_loads_total",
+		"Number of cache misses loaded from another pachd, by cache group.",
+		"group",
+	)
+	cacheBytes = prom.NewGaugeVec(
+		"pachyderm_block_cache_bytes",
+		"Number of bytes held in the block cache, by cache group.",
+		"group",
+	)
+	cacheEvictions = prom.NewCounterVec(
+		"pachyderm_block_cache_evictions_total",
+		"Number of items evicted from the block cache, by cache group.",
+		"group",
+	)
 )
 
 type objBlockAPIServer struct {
@@ -78,9 +118,9 @@ func newObjBlockAPIServer(dir string, cacheBytes int64, etcdAddress string, objC
 		objectCacheBytes: oneCacheShare * objectCacheShares,
 	}
 
-	objectGroupName := "object"
-	tagGroupName := "tag"
-	objectInfoGroupName := "objectInfo"
+	objectGroupName := objectGroup
+	tagGroupName := tagGroup
+	objectInfoGroupName := objectInfoGroup
 	if test {
 		uuid := uuid.New()
 		objectGroupName += uuid
@@ -91,8 +131,10 @@ func newObjBlockAPIServer(dir string, cacheBytes int64, etcdAddress string, objC
 	s.objectCache = groupcache.NewGroup(objectGroupName, oneCacheShare*objectCacheShares, groupcache.GetterFunc(s.objectGetter))
 	s.tagCache = groupcache.NewGroup(tagGroupName, oneCacheShare*tagCacheShares, groupcache.GetterFunc(s.tagGetter))
 	s.objectInfoCache = groupcache.NewGroup(objectInfoGroupName, oneCacheShare*objectInfoCacheShares, groupcache.GetterFunc(s.objectInfoGetter))
+	registerCacheMetrics(objectGroup, s.objectCache)
+	registerCacheMetrics(tagGroup, s.tagCache)
+	registerCacheMetrics(objectInfoGroup, s.objectInfoCache)
 	// Periodically print cache stats for debugging purposes
-	// TODO: make the stats accessible via HTTP or gRPC.
 	go func() {
 		ticker := time.NewTicker(time.Minute)
 		for {
@@ -106,6 +148,22 @@ func newObjBlockAPIServer(dir string, cacheBytes int64, etcdAddress string, objC
 	return s, nil
 }
 
+// registerCacheMetrics exports the stats of 'g' under the label 'group'. The
+// stats are read when the metrics are scraped, so that the hit ratio can be
+// computed as cache_hits/cache_gets.
+func registerCacheMetrics(group string, g *groupcache.Group) {
+	cacheGets.Func(func() float64 { return float64(g.Stats.Gets.Get()) }, group)
+	cacheHits.Func(func() float64 { return float64(g.Stats.CacheHits.Get()) }, group)
+	cacheLoads.Func(func() float64 { return float64(g.Stats.LocalLoads.Get()) }, group)
+	cachePeerLoads.Func(func() float64 { return float64(g.Stats.PeerLoads.Get()) }, group)
+	cacheBytes.Func(func() float64 {
+		return float64(g.CacheStats(groupcache.MainCache).Bytes + g.CacheStats(groupcache.HotCache).Bytes)
+	}, group)
+	cacheEvictions.Func(func() float64 {
+		return float64(g.CacheStats(groupcache.MainCache).Evictions + g.CacheStats(groupcache.HotCache).Evictions)
+	}, group)
+}
+
 // watchGC watches for GC runs and invalidate all cache when GC happens.
 func (s *objBlockAPIServer) watchGC(etcdAddress string) {
 	b := backoff.NewInfiniteBackOff()
@@ -253,6 +311,8 @@ func (s *objBlockAPIServer) putObject(ctx context.Context, dataReader io.Reader,
 	var size int64
 	if err := func() (retErr error) {
 		blockPath := s.blockPath(block)
+		span, _ := tracing.StartSpan(ctx, "obj.Write", "path", blockPath)
+		defer func() { span.FinishWithError(retErr) }()
 		w, err := s.objClient.Writer(blockPath)
 		if err != nil {
 			return err
@@ -326,6 +386,8 @@ func (s *objBlockAPIServer) GetObject(request *pfsclient.Object, getObjectServer
 		// The object is a substantial portion of the available cache space so
 		// we bypass the cache and stream it directly out of the underlying store.
 		blockPath := s.blockPath(objectInfo.BlockRef.Block)
+		span, _ := tracing.StartSpan(getObjectServer.Context(), "obj.Read", "path", blockPath)
+		defer func() { span.FinishWithError(retErr) }()
 		r, err := s.objClient.Reader(blockPath, objectInfo.BlockRef.Range.Lower, objectSize)
 		if err != nil {
 			return err
@@ -370,11 +432,15 @@ func (s *objBlockAPIServer) GetObjects(request *pfsclient.GetObjectsRequest, get
 		}
 		if request.TotalSize >= uint64(s.objectCacheBytes/maxCachedObjectDenom) {
 			blockPath := s.blockPath(objectInfo.BlockRef.Block)
+			span, _ := tracing.StartSpan(getObjectsServer.Context(), "obj.Read", "path", blockPath)
 			r, err := s.objClient.Reader(blockPath, objectInfo.BlockRef.Range.Lower+offset, readSize)
 			if err != nil {
+				span.FinishWithError(err)
 				return err
 			}
-			if err := grpcutil.WriteToStreamingBytesServer(r, getObjectsServer); err != nil {
+			err = grpcutil.WriteToStreamingBytesServer(r, getObjectsServer)
+			span.FinishWithError(err)
+			if err != nil {
 				return err
 			}
 			continue
@@ -759,7 +825,7 @@ func (s *objBlockAPIServer) writeProto(path string, pb proto.Marshaler) (retErr
 }
 
 func (s *objBlockAPIServer) blockGetter(ctx groupcache.Context, key string, dest groupcache.Sink) (retErr error) {
-	return s.readObj(s.blockPath(client.NewBlock(key)), 0, 0, dest)
+	return s.readObj(groupcacheContext(ctx), s.blockPath(client.NewBlock(key)), 0, 0, dest)
 }
 
 func (s *objBlockAPIServer) objectGetter(ctx groupcache.Context, key string, dest groupcache.Sink) error {
@@ -768,7 +834,7 @@ func (s *objBlockAPIServer) objectGetter(ctx groupcache.Context, key string, des
 	if err := s.objectInfoCache.Get(ctx, key, sink); err != nil {
 		return err
 	}
-	return s.readBlockRef(objectInfo.BlockRef, dest)
+	return s.readBlockRef(groupcacheContext(ctx), objectInfo.BlockRef, dest)
 }
 
 func (s *objBlockAPIServer) tagGetter(ctx groupcache.Context, key string, dest groupcache.Sink) error {
@@ -872,7 +938,9 @@ func (s *objBlockAPIServer) objectInfoGetter(ctx groupcache.Context, key string,
 	return fmt.Errorf("objectInfoGetter: object %s not found", object.Hash)
 }
 
-func (s *objBlockAPIServer) readObj(path string, offset uint64, size uint64, dest groupcache.Sink) (retErr error) {
+func (s *objBlockAPIServer) readObj(ctx context.Context, path string, offset uint64, size uint64, dest groupcache.Sink) (retErr error) {
+	span, _ := tracing.StartSpan(ctx, "obj.Read", "path", path)
+	defer func() { span.FinishWithError(retErr) }()
 	var reader io.ReadCloser
 	var err error
 	backoff.RetryNotify(func() error {
@@ -903,8 +971,17 @@ func (s *objBlockAPIServer) readObj(path string, offset uint64, size uint64, des
 	return dest.SetBytes(data)
 }
 
-func (s *objBlockAPIServer) readBlockRef(blockRef *pfsclient.BlockRef, dest groupcache.Sink) error {
-	return s.readObj(s.blockPath(blockRef.Block), blockRef.Range.Lower, blockRef.Range.Upper-blockRef.Range.Lower, dest)
+func (s *objBlockAPIServer) readBlockRef(ctx context.Context, blockRef *pfsclient.BlockRef, dest groupcache.Sink) error {
+	return s.readObj(ctx, s.blockPath(blockRef.Block), blockRef.Range.Lower, blockRef.Range.Upper-blockRef.Range.Lower, dest)
+}
+
+// groupcacheContext returns the context passed to the groupcache Get call
+// that caused a getter to run, so that the getter's spans join its trace.
+func groupcacheContext(ctx groupcache.Context) context.Context {
+	if ctx, ok := ctx.(context.Context); ok {
+		return ctx
+	}
+	return context.Background()
 }
 
 func (s *objBlockAPIServer) getObjectIndex(prefix string) (*pfsclient.ObjectIndex, bool) {
@@ -0,0 +1,350 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/pachyderm/pachyderm/src/client/auth"
+	"github.com/pachyderm/pachyderm/src/client/pfs"
+	"github.com/pachyderm/pachyderm/src/client/pkg/grpcutil"
+	pfsserver "github.com/pachyderm/pachyderm/src/server/pfs"
+	col "github.com/pachyderm/pachyderm/src/server/pkg/collection"
+	"github.com/pachyderm/pachyderm/src/server/pkg/hashtree"
+	"github.com/pachyderm/pachyderm/src/server/pkg/pfsdb"
+
+	etcd "github.com/coreos/etcd/clientv3"
+	"google.golang.org/grpc/codes"
+)
+
+// checkIsAdmin returns an error if the caller (in 'ctx') isn't a cluster
+// admin. If auth isn't active, everyone is treated as an admin.
+func (d *driver) checkIsAdmin(ctx context.Context, operation string) error {
+	d.initializePachConn()
+	who, err := d.pachClient.AuthAPIClient.WhoAmI(auth.In2Out(ctx), &auth.WhoAmIRequest{})
+	if err != nil {
+		if auth.IsNotActivatedError(err) {
+			return nil
+		}
+		return fmt.Errorf("error checking whether the caller may %s: %v", operation, grpcutil.ScrubGRPC(err))
+	}
+	if !who.IsAdmin {
+		return fmt.Errorf("only cluster admins may %s", operation)
+	}
+	return nil
+}
+
+func (d *driver) setQuota(ctx context.Context, repo *pfs.Repo, principal string, quota *pfs.Quota) error {
+	if (repo == nil || repo.Name == "") == (principal == "") {
+		return fmt.Errorf("exactly one of a repo and a principal must be given")
+	}
+	if err := d.checkIsAdmin(ctx, "set quotas"); err != nil {
+		return err
+	}
+	_, err := col.NewSTM(ctx, d.etcdClient, func(stm col.STM) error {
+		if principal != "" {
+			quotas := d.quotas.ReadWrite(stm)
+			if quota == nil {
+				if err := quotas.Delete(principal); err != nil && !col.IsErrNotFound(err) {
+					return err
+				}
+				return nil
+			}
+			return quotas.Put(principal, quota)
+		}
+		repos := d.repos.ReadWrite(stm)
+		repoInfo := new(pfs.RepoInfo)
+		if err := repos.Get(repo.Name, repoInfo); err != nil {
+			return err
+		}
+		repoInfo.Quota = quota
+		return repos.Put(repo.Name, repoInfo)
+	})
+	return err
+}
+
+// checkQuota returns an ErrQuotaExceeded if adding 'additionalBytes' and
+// 'additionalCommits' to 'repo' would exceed its quota, or the quota of the
+// principal who created it.
+func (d *driver) checkQuota(ctx context.Context, repo *pfs.Repo, additionalBytes uint64, additionalCommits uint64) error {
+	checker, err := d.newQuotaChecker(ctx, repo, nil)
+	if err != nil {
+		return err
+	}
+	return checker.check(additionalBytes, additionalCommits)
+}
+
+// quotaChecker checks writes against the quotas that apply to a repo. The
+// usage it checks them against is read once, when it's made, so that a
+// request can check each of its writes without reading etcd again.
+type quotaChecker struct {
+	repo           *pfs.Repo
+	repoQuota      *pfs.Quota
+	repoBytes      uint64
+	repoCommits    uint64
+	principal      string
+	principalQuota *pfs.Quota
+	principalBytes uint64
+	// principalCommits includes the commits of 'repo'
+	principalCommits uint64
+}
+
+// newQuotaChecker reads the usage that counts against the quotas of 'repo'
+// and of the principal who created it. If 'openCommit' is set, the bytes
+// already written to it, which aren't part of the repo's size until it's
+// finished, count too.
+func (d *driver) newQuotaChecker(ctx context.Context, repo *pfs.Repo, openCommit *pfs.Commit) (*quotaChecker, error) {
+	repoInfo := new(pfs.RepoInfo)
+	if err := d.repos.ReadOnly(ctx).Get(repo.Name, repoInfo); err != nil {
+		return nil, err
+	}
+	checker := &quotaChecker{
+		repo:      repo,
+		repoQuota: repoInfo.Quota,
+		principal: repoInfo.CreatedBy,
+	}
+	if checker.principal != "" {
+		quota := new(pfs.Quota)
+		if err := d.quotas.ReadOnly(ctx).Get(checker.principal, quota); err != nil {
+			if !col.IsErrNotFound(err) {
+				return nil, err
+			}
+		} else {
+			checker.principalQuota = quota
+		}
+	}
+	if checker.repoQuota == nil && checker.principalQuota == nil {
+		return checker, nil
+	}
+
+	var openBytes uint64
+	if openCommit != nil {
+		var err error
+		if openBytes, err = d.openCommitBytes(ctx, openCommit); err != nil {
+			return nil, err
+		}
+	}
+	if checker.repoQuota != nil {
+		commits, err := d.commits(repo.Name).ReadOnly(ctx).Count()
+		if err != nil {
+			return nil, err
+		}
+		checker.repoBytes = repoInfo.SizeBytes + openBytes
+		checker.repoCommits = uint64(commits)
+	}
+	if checker.principalQuota != nil {
+		// Only the principal's own repos are read, through the index on
+		// their creators
+		iter, err := d.repos.ReadOnly(ctx).GetByIndex(pfsdb.ReposCreatedByIndex, checker.principal)
+		if err != nil {
+			return nil, err
+		}
+		for {
+			var repoName string
+			otherRepoInfo := new(pfs.RepoInfo)
+			ok, err := iter.Next(&repoName, otherRepoInfo)
+			if err != nil {
+				return nil, err
+			}
+			if !ok {
+				break
+			}
+			commits, err := d.commits(otherRepoInfo.Repo.Name).ReadOnly(ctx).Count()
+			if err != nil {
+				return nil, err
+			}
+			checker.principalBytes += otherRepoInfo.SizeBytes
+			checker.principalCommits += uint64(commits)
+		}
+		checker.principalBytes += openBytes
+	}
+	return checker, nil
+}
+
+// check returns an ErrQuotaExceeded if adding 'additionalBytes' and
+// 'additionalCommits' to the usage read by newQuotaChecker would exceed a
+// quota.
+func (q *quotaChecker) check(additionalBytes uint64, additionalCommits uint64) error {
+	if q.repoQuota != nil {
+		if reason := exceedsQuota(q.repoQuota, q.repoBytes+additionalBytes, q.repoCommits+additionalCommits); reason != "" {
+			return pfsserver.ErrQuotaExceeded{Repo: q.repo, Reason: reason}
+		}
+	}
+	if q.principalQuota != nil {
+		if reason := exceedsQuota(q.principalQuota, q.principalBytes+additionalBytes, q.principalCommits+additionalCommits); reason != "" {
+			return pfsserver.ErrQuotaExceeded{Repo: q.repo, Principal: q.principal, Reason: reason}
+		}
+	}
+	return nil
+}
+
+// openCommitBytes returns the number of bytes written to the open commit
+// 'commit' so far. Overwritten and deleted data is still counted, so this is
+// an upper bound on how much finishing the commit will grow its repo.
+func (d *driver) openCommitBytes(ctx context.Context, commit *pfs.Commit) (uint64, error) {
+	prefix := path.Join(d.scratchPrefix(), commit.Repo.Name, commit.ID) + "/"
+	resp, err := d.etcdClient.Get(ctx, prefix, etcd.WithPrefix())
+	if err != nil {
+		return 0, err
+	}
+	var size uint64
+	for _, kv := range resp.Kvs {
+		records := &pfs.PutFileRecords{}
+		if err := records.Unmarshal(kv.Value); err != nil {
+			return 0, err
+		}
+		for _, record := range records.Records {
+			size += uint64(record.SizeBytes)
+		}
+	}
+	return size, nil
+}
+
+// exceedsQuota returns why 'bytes' and 'commits' exceed 'quota', or "" if
+// they don't.
+func exceedsQuota(quota *pfs.Quota, bytes uint64, commits uint64) string {
+	if quota.MaxBytes != 0 && bytes > quota.MaxBytes {
+		return fmt.Sprintf("%d bytes exceeds the limit of %d bytes", bytes, quota.MaxBytes)
+	}
+	if quota.MaxCommits != 0 && commits > quota.MaxCommits {
+		return fmt.Sprintf("%d commits exceeds the limit of %d commits", commits, quota.MaxCommits)
+	}
+	return ""
+}
+
+// quotaErrorToGRPC gives quota errors the RESOURCE_EXHAUSTED code, so that
+// callers can tell them apart from other errors. Other errors are returned
+// unchanged.
+func quotaErrorToGRPC(err error) error {
+	if _, ok := err.(pfsserver.ErrQuotaExceeded); ok {
+		return grpcErrorf(codes.ResourceExhausted, "%s", err.Error())
+	}
+	return err
+}
+
+func (d *driver) quotaUsage(ctx context.Context) (*pfs.QuotaUsageResponse, error) {
+	if err := d.checkIsAdmin(ctx, "view storage usage"); err != nil {
+		return nil, err
+	}
+	repos, err := d.listRepo(ctx, nil, !includeAuth)
+	if err != nil {
+		return nil, err
+	}
+
+	// Contents map the objects making up each distinct file content to its
+	// size
+	principalContents := make(map[string]map[string]uint64)
+	principalUsage := make(map[string]*pfs.Usage)
+	response := new(pfs.QuotaUsageResponse)
+	for _, repoInfo := range repos.RepoInfo {
+		usage := &pfs.Usage{
+			LogicalBytes: repoInfo.SizeBytes,
+			Quota:        repoInfo.Quota,
+		}
+		contents := make(map[string]uint64)
+		iter, err := d.commits(repoInfo.Repo.Name).ReadOnly(ctx).List()
+		if err != nil {
+			return nil, err
+		}
+		for {
+			var commitID string
+			commitInfo := new(pfs.CommitInfo)
+			ok, err := iter.Next(&commitID, commitInfo)
+			if err != nil {
+				return nil, err
+			}
+			if !ok {
+				break
+			}
+			usage.Commits++
+			if commitInfo.Finished == nil {
+				continue
+			}
+			tree, err := d.getTreeForCommit(ctx, commitInfo.Commit)
+			if err != nil {
+				return nil, err
+			}
+			if err := addContents(contents, tree); err != nil {
+				return nil, err
+			}
+		}
+		usage.DeduplicatedBytes = sumContents(contents)
+		response.Repos = append(response.Repos, &pfs.RepoUsage{
+			Repo:      repoInfo.Repo,
+			CreatedBy: repoInfo.CreatedBy,
+			Usage:     usage,
+		})
+
+		if repoInfo.CreatedBy == "" {
+			continue
+		}
+		if principalUsage[repoInfo.CreatedBy] == nil {
+			principalUsage[repoInfo.CreatedBy] = new(pfs.Usage)
+			principalContents[repoInfo.CreatedBy] = make(map[string]uint64)
+		}
+		principalUsage[repoInfo.CreatedBy].LogicalBytes += usage.LogicalBytes
+		principalUsage[repoInfo.CreatedBy].Commits += usage.Commits
+		for key, size := range contents {
+			principalContents[repoInfo.CreatedBy][key] = size
+		}
+	}
+
+	// Principals with quotas are reported even if they haven't created repos
+	iter, err := d.quotas.ReadOnly(ctx).List()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		var principal string
+		quota := new(pfs.Quota)
+		ok, err := iter.Next(&principal, quota)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			break
+		}
+		principal = path.Base(principal)
+		if principalUsage[principal] == nil {
+			principalUsage[principal] = new(pfs.Usage)
+		}
+		principalUsage[principal].Quota = quota
+	}
+	for principal, usage := range principalUsage {
+		usage.DeduplicatedBytes = sumContents(principalContents[principal])
+		response.Principals = append(response.Principals, &pfs.PrincipalUsage{
+			Principal: principal,
+			Usage:     usage,
+		})
+	}
+	sort.Slice(response.Principals, func(i, j int) bool {
+		return response.Principals[i].Principal < response.Principals[j].Principal
+	})
+	return response, nil
+}
+
+// addContents adds each distinct file content in 'tree' to 'contents'. Files
+// made of the same objects have the same content.
+func addContents(contents map[string]uint64, tree hashtree.HashTree) error {
+	return tree.Walk("/", func(_ string, node *hashtree.NodeProto) error {
+		if node.FileNode == nil {
+			return nil
+		}
+		var key []string
+		for _, object := range node.FileNode.Objects {
+			key = append(key, object.Hash)
+		}
+		contents[strings.Join(key, ",")] = uint64(node.SubtreeSize)
+		return nil
+	})
+}
+
+func sumContents(contents map[string]uint64) uint64 {
+	var result uint64
+	for _, size := range contents {
+		result += size
+	}
+	return result
+}
@@ -0,0 +1,346 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/pachyderm/pachyderm/src/client/auth"
+	"github.com/pachyderm/pachyderm/src/client/pfs"
+	col "github.com/pachyderm/pachyderm/src/server/pkg/collection"
+	"github.com/pachyderm/pachyderm/src/server/pkg/pfsdb"
+)
+
+const (
+	// How often the master applies every repo's retention policy
+	retentionPeriod = 5 * time.Minute
+)
+
+// retainCommits periodically deletes the commits that their repos' retention
+// policies don't keep. It runs in the PFS master, until 'ctx' is cancelled.
+func (d *driver) retainCommits(ctx context.Context) error {
+	ticker := time.NewTicker(retentionPeriod)
+	defer ticker.Stop()
+	for {
+		repos, err := d.listRepo(ctx, nil, !includeAuth)
+		if err != nil {
+			return err
+		}
+		for _, repoInfo := range repos.RepoInfo {
+			if repoInfo.RetentionPolicy == nil {
+				continue
+			}
+			deleted, err := d.applyRetentionPolicy(ctx, repoInfo.Repo, false)
+			if err != nil {
+				// Don't let one repo stop the policies of the others from
+				// being applied
+				log.Errorf("error applying the retention policy of repo %s: %v", repoInfo.Repo.Name, err)
+			}
+			for _, commit := range deleted {
+				log.Infof("retention: deleted commit %s", commit.FullID())
+			}
+		}
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+func (d *driver) setRetentionPolicy(ctx context.Context, repo *pfs.Repo, policy *pfs.RetentionPolicy) error {
+	if err := d.checkIsAuthorized(ctx, repo, auth.Scope_OWNER); err != nil {
+		return err
+	}
+	if policy != nil && policy.KeepNewerThan != nil {
+		keepNewerThan, err := types.DurationFromProto(policy.KeepNewerThan)
+		if err != nil {
+			return err
+		}
+		if keepNewerThan <= 0 {
+			return fmt.Errorf("keep_newer_than must be positive")
+		}
+	}
+	_, err := col.NewSTM(ctx, d.etcdClient, func(stm col.STM) error {
+		repos := d.repos.ReadWrite(stm)
+		repoInfo := new(pfs.RepoInfo)
+		if err := repos.Get(repo.Name, repoInfo); err != nil {
+			return err
+		}
+		repoInfo.RetentionPolicy = policy
+		return repos.Put(repo.Name, repoInfo)
+	})
+	return err
+}
+
+// applyRetentionPolicy deletes the commits in 'repo' that its retention
+// policy doesn't keep, and returns them. If 'dryRun' is true, nothing is
+// deleted. It's called by the PFS master, so it doesn't check auth;
+// callers acting on behalf of a user must check it themselves.
+func (d *driver) applyRetentionPolicy(ctx context.Context, repo *pfs.Repo, dryRun bool) ([]*pfs.Commit, error) {
+	repoInfo := new(pfs.RepoInfo)
+	if err := d.repos.ReadOnly(ctx).Get(repo.Name, repoInfo); err != nil {
+		return nil, err
+	}
+	if repoInfo.RetentionPolicy == nil {
+		return nil, nil
+	}
+
+	iter, err := d.commits(repo.Name).ReadOnly(ctx).List()
+	if err != nil {
+		return nil, err
+	}
+	var commitInfos []*pfs.CommitInfo
+	children := make(map[string][]*pfs.Commit)
+	for {
+		var commitID string
+		commitInfo := new(pfs.CommitInfo)
+		ok, err := iter.Next(&commitID, commitInfo)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			break
+		}
+		commitInfos = append(commitInfos, commitInfo)
+		if commitInfo.ParentCommit != nil {
+			children[commitInfo.ParentCommit.ID] = append(children[commitInfo.ParentCommit.ID], commitInfo.Commit)
+		}
+	}
+	kept, err := retainedCommits(repoInfo.RetentionPolicy, commitInfos, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	heads := make(map[string]bool)
+	branchIter, err := d.branches(repo.Name).ReadOnly(ctx).List()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		var branchName string
+		head := new(pfs.Commit)
+		ok, err := branchIter.Next(&branchName, head)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			break
+		}
+		heads[head.ID] = true
+	}
+	// The branches with histories, whose moves may refer to deleted commits
+	var historyBranches []string
+	historyIter, err := d.branchHistories(repo.Name).ReadOnly(ctx).List()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		var branch string
+		ok, err := historyIter.Next(&branch, new(pfs.BranchHistory))
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			break
+		}
+		historyBranches = append(historyBranches, branch)
+	}
+	downstream, err := d.listRepo(ctx, []*pfs.Repo{repo}, !includeAuth)
+	if err != nil {
+		return nil, err
+	}
+
+	// Delete commits oldest first, so that each commit's children are known
+	// (they're all newer) and its parent is already final when it's deleted
+	sort.Slice(commitInfos, func(i, j int) bool {
+		return commitStartedBefore(commitInfos[i], commitInfos[j])
+	})
+	var deleted []*pfs.Commit
+	for _, commitInfo := range commitInfos {
+		if commitInfo.Finished == nil || kept[commitInfo.Commit.ID] || heads[commitInfo.Commit.ID] {
+			continue
+		}
+		inProvenance, err := d.isDownstreamProvenance(ctx, downstream.RepoInfo, commitInfo.Commit)
+		if err != nil {
+			return deleted, err
+		}
+		if inProvenance {
+			continue
+		}
+		if !dryRun {
+			if err := d.squashCommit(ctx, commitInfo.Commit, children[commitInfo.Commit.ID], historyBranches); err != nil {
+				return deleted, err
+			}
+		}
+		deleted = append(deleted, commitInfo.Commit)
+	}
+	return deleted, nil
+}
+
+// isDownstreamProvenance returns true if 'commit' is in the provenance of a
+// commit in one of the 'downstream' repos.
+func (d *driver) isDownstreamProvenance(ctx context.Context, downstream []*pfs.RepoInfo, commit *pfs.Commit) (bool, error) {
+	for _, repoInfo := range downstream {
+		iter, err := d.commits(repoInfo.Repo.Name).ReadOnly(ctx).GetByIndex(pfsdb.ProvenanceIndex, commit)
+		if err != nil {
+			return false, err
+		}
+		var commitID string
+		ok, err := iter.Next(&commitID, new(pfs.CommitInfo))
+		if err != nil {
+			return false, err
+		}
+		if ok {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// squashCommit deletes the finished commit 'commit', making its parent the
+// parent of 'children'. Each commit's tree holds the complete state of the
+// repo, so the children are unchanged. The moves in the histories of
+// 'branches' that refer to the commit are rewritten by squashBranchMoves, so
+// that restoring a branch never targets a deleted commit.
+func (d *driver) squashCommit(ctx context.Context, commit *pfs.Commit, children []*pfs.Commit, branches []string) error {
+	_, err := col.NewSTM(ctx, d.etcdClient, func(stm col.STM) error {
+		commits := d.commits(commit.Repo.Name).ReadWrite(stm)
+		commitInfo := new(pfs.CommitInfo)
+		if err := commits.Get(commit.ID, commitInfo); err != nil {
+			return err
+		}
+		for _, child := range children {
+			childInfo := new(pfs.CommitInfo)
+			if err := commits.Get(child.ID, childInfo); err != nil {
+				return err
+			}
+			childInfo.ParentCommit = commitInfo.ParentCommit
+			if err := commits.Put(child.ID, childInfo); err != nil {
+				return err
+			}
+		}
+		histories := d.branchHistories(commit.Repo.Name).ReadWrite(stm)
+		for _, branch := range branches {
+			history := new(pfs.BranchHistory)
+			if err := histories.Get(branch, history); err != nil {
+				if col.IsErrNotFound(err) {
+					continue
+				}
+				return err
+			}
+			if squashBranchMoves(history, commit, commitInfo.ParentCommit) {
+				if err := histories.Put(branch, history); err != nil {
+					return err
+				}
+			}
+		}
+		return commits.Delete(commit.ID)
+	})
+	return err
+}
+
+// squashBranchMoves makes the moves in 'history' that refer to the squashed
+// commit 'commit' refer to 'parent', its nearest remaining ancestor, instead.
+// Moves that no longer move the branch, and moves that would be left without
+// a commit where they had one, are dropped. It returns true if 'history'
+// changed.
+func squashBranchMoves(history *pfs.BranchHistory, commit *pfs.Commit, parent *pfs.Commit) bool {
+	changed := false
+	moves := history.Moves[:0]
+	for _, move := range history.Moves {
+		squashed := false
+		if move.OldHead.GetID() == commit.ID {
+			move.OldHead = parent
+			squashed = true
+		}
+		if move.NewHead.GetID() == commit.ID {
+			move.NewHead = parent
+			squashed = true
+		}
+		if squashed {
+			changed = true
+			if parent == nil || move.OldHead.GetID() == move.NewHead.GetID() {
+				continue
+			}
+		}
+		moves = append(moves, move)
+	}
+	history.Moves = moves
+	return changed
+}
+
+// retainedCommits returns the IDs of the commits in 'commitInfos' that
+// 'policy' keeps at time 'now'. Open commits are never counted.
+func retainedCommits(policy *pfs.RetentionPolicy, commitInfos []*pfs.CommitInfo, now time.Time) (map[string]bool, error) {
+	var finished []*pfs.CommitInfo
+	for _, commitInfo := range commitInfos {
+		if commitInfo.Finished != nil {
+			finished = append(finished, commitInfo)
+		}
+	}
+	// Newest first
+	sort.Slice(finished, func(i, j int) bool {
+		return commitStartedBefore(finished[j], finished[i])
+	})
+
+	kept := make(map[string]bool)
+	for i := 0; i < len(finished) && uint64(i) < policy.KeepLast; i++ {
+		kept[finished[i].Commit.ID] = true
+	}
+	if policy.KeepNewerThan != nil {
+		keepNewerThan, err := types.DurationFromProto(policy.KeepNewerThan)
+		if err != nil {
+			return nil, err
+		}
+		for _, commitInfo := range finished {
+			started, err := types.TimestampFromProto(commitInfo.Started)
+			if err != nil {
+				return nil, err
+			}
+			if now.Sub(started) < keepNewerThan {
+				kept[commitInfo.Commit.ID] = true
+			}
+		}
+	}
+	day := func(t time.Time) string {
+		return t.UTC().Format("2006-01-02")
+	}
+	week := func(t time.Time) string {
+		year, week := t.UTC().ISOWeek()
+		return fmt.Sprintf("%d-%d", year, week)
+	}
+	for _, thin := range []struct {
+		n      uint64
+		period func(time.Time) string
+	}{{policy.KeepDaily, day}, {policy.KeepWeekly, week}} {
+		seen := make(map[string]bool)
+		for _, commitInfo := range finished {
+			if uint64(len(seen)) >= thin.n {
+				break
+			}
+			started, err := types.TimestampFromProto(commitInfo.Started)
+			if err != nil {
+				return nil, err
+			}
+			// 'finished' is sorted newest first, so the first commit seen in
+			// each period is the most recent one
+			if p := thin.period(started); !seen[p] {
+				seen[p] = true
+				kept[commitInfo.Commit.ID] = true
+			}
+		}
+	}
+	return kept, nil
+}
+
+func commitStartedBefore(a, b *pfs.CommitInfo) bool {
+	if a.Started.Seconds != b.Started.Seconds {
+		return a.Started.Seconds < b.Started.Seconds
+	}
+	return a.Started.Nanos < b.Started.Nanos
+}
@@ -0,0 +1,234 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+
+	"github.com/pachyderm/pachyderm/src/client/auth"
+	"github.com/pachyderm/pachyderm/src/client/pfs"
+	pfsserver "github.com/pachyderm/pachyderm/src/server/pfs"
+	col "github.com/pachyderm/pachyderm/src/server/pkg/collection"
+)
+
+// minCommitPrefix is the length of the shortest prefix of a commit ID that
+// names the commit
+const minCommitPrefix = 4
+
+// resolveRevision returns the ID of the commit of 'repo' that the revision
+// expression 'revision' names (see pfsserver.Revision).
+func (d *driver) resolveRevision(ctx context.Context, repo *pfs.Repo, revision string) (string, error) {
+	rev, err := pfsserver.ParseRevision(revision)
+	if err != nil {
+		// Anything that isn't an expression is looked up as it is, so that
+		// odd branch names keep working
+		rev = &pfsserver.Revision{Name: revision}
+	}
+	return d.resolve(ctx, repo, rev)
+}
+
+func (d *driver) resolve(ctx context.Context, repo *pfs.Repo, rev *pfsserver.Revision) (string, error) {
+	var commitID string
+	var err error
+	switch {
+	case rev.ProvenanceOf != nil:
+		provRepo := &pfs.Repo{Name: rev.ProvenanceOf.Repo}
+		if err := d.checkIsAuthorized(ctx, provRepo, auth.Scope_READER); err != nil {
+			return "", err
+		}
+		provID, err := d.resolve(ctx, provRepo, rev.ProvenanceOf.Revision)
+		if err != nil {
+			return "", err
+		}
+		commitID, err = d.commitWithProvenance(ctx, repo, &pfs.Commit{Repo: provRepo, ID: provID})
+		if err != nil {
+			return "", err
+		}
+	case rev.At != nil:
+		commitID, err = d.branchHeadAt(ctx, repo, rev.Name, *rev.At)
+		if err != nil {
+			return "", err
+		}
+	case rev.Back > 0:
+		commitID, err = d.branchHeadBack(ctx, repo, rev.Name, rev.Back)
+		if err != nil {
+			return "", err
+		}
+	default:
+		commitID, err = d.resolveName(ctx, repo, rev.Name)
+		if err != nil {
+			return "", err
+		}
+	}
+	return d.ancestor(ctx, repo, commitID, rev.Ancestry)
+}
+
+// resolveName returns the ID of the commit named by 'name': the head of the
+// branch 'name', the commit 'name', or the only commit whose ID starts with
+// 'name'.
+func (d *driver) resolveName(ctx context.Context, repo *pfs.Repo, name string) (string, error) {
+	head := new(pfs.Commit)
+	if err := d.branches(repo.Name).ReadOnly(ctx).Get(name, head); err == nil {
+		return head.ID, nil
+	} else if !col.IsErrNotFound(err) {
+		return "", err
+	}
+	commits := d.commits(repo.Name).ReadOnly(ctx)
+	if err := commits.Get(name, &pfs.CommitInfo{}); err == nil || !col.IsErrNotFound(err) {
+		return name, err
+	}
+	notFound := pfsserver.ErrCommitNotFound{Commit: &pfs.Commit{Repo: repo, ID: name}}
+	if len(name) < minCommitPrefix || strings.Trim(name, "0123456789abcdef") != "" {
+		return "", notFound
+	}
+	var matches []string
+	iter, err := commits.List()
+	if err != nil {
+		return "", err
+	}
+	for {
+		var commitID string
+		ok, err := iter.Next(&commitID, &pfs.CommitInfo{})
+		if err != nil {
+			return "", err
+		}
+		if !ok {
+			break
+		}
+		if strings.HasPrefix(commitID, name) {
+			matches = append(matches, commitID)
+		}
+	}
+	switch len(matches) {
+	case 0:
+		return "", notFound
+	case 1:
+		return matches[0], nil
+	default:
+		return "", fmt.Errorf("commit ID prefix %q is ambiguous: it matches %d commits in repo %s", name, len(matches), repo.Name)
+	}
+}
+
+// ancestor returns the ID of the commit 'n' parents back from 'commitID'
+func (d *driver) ancestor(ctx context.Context, repo *pfs.Repo, commitID string, n int) (string, error) {
+	commits := d.commits(repo.Name).ReadOnly(ctx)
+	commit := &pfs.Commit{Repo: repo, ID: commitID}
+	for i := 0; i < n; i++ {
+		commitInfo := new(pfs.CommitInfo)
+		if err := commits.Get(commit.ID, commitInfo); err != nil {
+			return "", pfsserver.ErrCommitNotFound{Commit: commit}
+		}
+		if commitInfo.ParentCommit == nil {
+			return "", pfsserver.ErrCommitNotFound{Commit: &pfs.Commit{Repo: repo, ID: fmt.Sprintf("%s~%d", commitID, n)}}
+		}
+		commit = commitInfo.ParentCommit
+	}
+	return commit.ID, nil
+}
+
+// branchHeadAt returns the ID of the commit that was the head of 'branch' at
+// time 't', according to the branch's history. Before the oldest recorded
+// move (or if the branch has no recorded moves), the head is taken to be the
+// newest commit on the branch started at or before 't'.
+func (d *driver) branchHeadAt(ctx context.Context, repo *pfs.Repo, branch string, t time.Time) (string, error) {
+	history, err := d.branchHistory(ctx, repo, branch)
+	if err != nil {
+		return "", err
+	}
+	var head *pfs.Commit
+	if len(history.Moves) == 0 {
+		head = new(pfs.Commit)
+		if err := d.branches(repo.Name).ReadOnly(ctx).Get(branch, head); err != nil {
+			return "", err
+		}
+	}
+	for _, move := range history.Moves {
+		moved, err := types.TimestampFromProto(move.Time)
+		if err != nil {
+			return "", err
+		}
+		if !moved.After(t) {
+			if move.NewHead == nil {
+				return "", fmt.Errorf("branch %s of repo %s had been deleted as of %s", branch, repo.Name, t.Format(time.RFC3339))
+			}
+			return move.NewHead.ID, nil
+		}
+		head = move.OldHead
+	}
+	commits := d.commits(repo.Name).ReadOnly(ctx)
+	for commit := head; commit != nil; {
+		commitInfo := new(pfs.CommitInfo)
+		if err := commits.Get(commit.ID, commitInfo); err != nil {
+			return "", pfsserver.ErrCommitNotFound{Commit: commit}
+		}
+		started, err := types.TimestampFromProto(commitInfo.Started)
+		if err != nil {
+			return "", err
+		}
+		if !started.After(t) {
+			return commit.ID, nil
+		}
+		commit = commitInfo.ParentCommit
+	}
+	return "", fmt.Errorf("branch %s of repo %s has no commits from before %s", branch, repo.Name, t.Format(time.RFC3339))
+}
+
+// branchHeadBack returns the ID of the commit that was the head of 'branch'
+// 'n' moves ago, according to the branch's history. If the branch has no
+// recorded moves, its commits' parents are taken to be its moves.
+func (d *driver) branchHeadBack(ctx context.Context, repo *pfs.Repo, branch string, n int) (string, error) {
+	history, err := d.branchHistory(ctx, repo, branch)
+	if err != nil {
+		return "", err
+	}
+	if len(history.Moves) == 0 {
+		head := new(pfs.Commit)
+		if err := d.branches(repo.Name).ReadOnly(ctx).Get(branch, head); err != nil {
+			return "", err
+		}
+		return d.ancestor(ctx, repo, head.ID, n)
+	}
+	if n > len(history.Moves) {
+		return "", fmt.Errorf("branch %s of repo %s has only %d recorded moves", branch, repo.Name, len(history.Moves))
+	}
+	if oldHead := history.Moves[n-1].OldHead; oldHead != nil {
+		return oldHead.ID, nil
+	}
+	return "", fmt.Errorf("branch %s of repo %s didn't exist %d moves ago", branch, repo.Name, n)
+}
+
+// commitWithProvenance returns the ID of the newest commit of 'repo' that
+// has 'prov' in its provenance
+func (d *driver) commitWithProvenance(ctx context.Context, repo *pfs.Repo, prov *pfs.Commit) (string, error) {
+	iter, err := d.commits(repo.Name).ReadOnly(ctx).List()
+	if err != nil {
+		return "", err
+	}
+	var result *pfs.CommitInfo
+	for {
+		var commitID string
+		commitInfo := new(pfs.CommitInfo)
+		ok, err := iter.Next(&commitID, commitInfo)
+		if err != nil {
+			return "", err
+		}
+		if !ok {
+			break
+		}
+		for _, c := range commitInfo.Provenance {
+			if c.Repo.Name == prov.Repo.Name && c.ID == prov.ID {
+				if result == nil || commitInfo.Started.Compare(result.Started) > 0 {
+					result = commitInfo
+				}
+				break
+			}
+		}
+	}
+	if result == nil {
+		return "", fmt.Errorf("no commit of repo %s has %s@%s in its provenance", repo.Name, prov.Repo.Name, prov.ID)
+	}
+	return result.Commit.ID, nil
+}
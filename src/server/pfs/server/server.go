@@ -25,8 +25,10 @@ type BlockAPIServer interface {
 
 // NewAPIServer creates an APIServer.
 // cacheSize is the number of commit trees which will be cached in the server.
-func NewAPIServer(address string, etcdAddresses []string, etcdPrefix string, cacheSize int64) (APIServer, error) {
-	return newAPIServer(address, etcdAddresses, etcdPrefix, cacheSize)
+// ppsEtcdPrefix and authEtcdPrefix are the etcd prefixes of PPS and auth,
+// whose records of a repo are updated when it's renamed.
+func NewAPIServer(address string, etcdAddresses []string, etcdPrefix string, ppsEtcdPrefix string, authEtcdPrefix string, cacheSize int64) (APIServer, error) {
+	return newAPIServer(address, etcdAddresses, etcdPrefix, ppsEtcdPrefix, authEtcdPrefix, cacheSize)
 }
 
 // NewHTTPServer creates an APIServer.
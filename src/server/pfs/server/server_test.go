@@ -1,7 +1,10 @@
 package server
 
 import (
+	"archive/tar"
+	"archive/zip"
 	"bytes"
+	"compress/gzip"
 	"fmt"
 	"io"
 	"io/ioutil"
@@ -818,6 +821,308 @@ func TestAncestrySyntax(t *testing.T) {
 	}
 }
 
+func TestRevisionSyntax(t *testing.T) {
+	t.Parallel()
+	client := getClient(t)
+
+	require.NoError(t, client.CreateRepo("in"))
+	_, err := client.PfsAPIClient.CreateRepo(context.Background(), &pfs.CreateRepoRequest{
+		Repo:       pclient.NewRepo("out"),
+		Provenance: []*pfs.Repo{pclient.NewRepo("in")},
+	})
+	require.NoError(t, err)
+
+	var commits []*pfs.Commit
+	var starts []time.Time
+	for i := 0; i < 3; i++ {
+		starts = append(starts, time.Now())
+		commit, err := client.StartCommit("in", "master")
+		require.NoError(t, err)
+		require.NoError(t, client.FinishCommit("in", commit.ID))
+		commits = append(commits, commit)
+		time.Sleep(time.Second)
+	}
+
+	// Reflog-style history of the branch
+	commitInfo, err := client.InspectCommit("in", "master@{-1}")
+	require.NoError(t, err)
+	require.Equal(t, commits[1], commitInfo.Commit)
+	commitInfo, err = client.InspectCommit("in", "master@{-1}^")
+	require.NoError(t, err)
+	require.Equal(t, commits[0], commitInfo.Commit)
+	_, err = client.InspectCommit("in", "master@{-3}")
+	require.YesError(t, err)
+
+	// The branch at a point in time
+	at := starts[1].Add(500 * time.Millisecond).UTC().Format(time.RFC3339Nano)
+	commitInfo, err = client.InspectCommit("in", fmt.Sprintf("master@{%s}", at))
+	require.NoError(t, err)
+	require.Equal(t, commits[1], commitInfo.Commit)
+	_, err = client.InspectCommit("in", "master@{2000-01-01}")
+	require.YesError(t, err)
+
+	// Unique prefixes of commit IDs
+	commitInfo, err = client.InspectCommit("in", commits[2].ID[:12])
+	require.NoError(t, err)
+	require.Equal(t, commits[2], commitInfo.Commit)
+	_, err = client.InspectCommit("in", "zzzz")
+	require.YesError(t, err)
+
+	// The commit of a repo downstream of another commit
+	outCommit, err := client.PfsAPIClient.StartCommit(
+		context.Background(),
+		&pfs.StartCommitRequest{
+			Parent:     pclient.NewCommit("out", "master"),
+			Provenance: []*pfs.Commit{commits[1]},
+		},
+	)
+	require.NoError(t, err)
+	require.NoError(t, client.FinishCommit("out", outCommit.ID))
+	commitInfo, err = client.InspectCommit("out", "provenance-of(in@master^)")
+	require.NoError(t, err)
+	require.Equal(t, outCommit.ID, commitInfo.Commit.ID)
+	_, err = client.InspectCommit("out", "provenance-of(in@master)")
+	require.YesError(t, err)
+
+	// Revisions work wherever commits do
+	fileInfos, err := client.ListFile("in", "master@{-2}", "/")
+	require.NoError(t, err)
+	require.Equal(t, 0, len(fileInfos))
+	commitInfos, err := client.ListCommit("in", "master@{-1}", "", 0)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(commitInfos))
+}
+
+func TestBranchHistory(t *testing.T) {
+	t.Parallel()
+	client := getClient(t)
+
+	repo := "test"
+	require.NoError(t, client.CreateRepo(repo))
+	var commits []*pfs.Commit
+	for i := 0; i < 3; i++ {
+		commit, err := client.StartCommit(repo, "master")
+		require.NoError(t, err)
+		require.NoError(t, client.FinishCommit(repo, commit.ID))
+		commits = append(commits, commit)
+	}
+
+	// Mistakenly move master back to its first commit, then delete it
+	require.NoError(t, client.SetBranch(repo, commits[0].ID, "master"))
+	require.NoError(t, client.DeleteBranch(repo, "master"))
+
+	moves, err := client.ListBranchHistory(repo, "master", 0)
+	require.NoError(t, err)
+	require.Equal(t, 5, len(moves))
+	require.Equal(t, "DeleteBranch", moves[0].Operation)
+	require.Equal(t, commits[0], moves[0].OldHead)
+	require.Nil(t, moves[0].NewHead)
+	require.Equal(t, "SetBranch", moves[1].Operation)
+	require.Equal(t, commits[2], moves[1].OldHead)
+	require.Equal(t, commits[0], moves[1].NewHead)
+	require.Equal(t, "StartCommit", moves[4].Operation)
+	require.Nil(t, moves[4].OldHead)
+	require.Equal(t, commits[0], moves[4].NewHead)
+	for _, move := range moves {
+		require.NotNil(t, move.Time)
+	}
+
+	moves, err = client.ListBranchHistory(repo, "master", 2)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(moves))
+
+	// Undo the delete, then the set-branch
+	require.NoError(t, client.RestoreBranch(repo, "master", 1))
+	commitInfo, err := client.InspectCommit(repo, "master")
+	require.NoError(t, err)
+	require.Equal(t, commits[0], commitInfo.Commit)
+	require.NoError(t, client.RestoreBranch(repo, "master", 3))
+	commitInfo, err = client.InspectCommit(repo, "master")
+	require.NoError(t, err)
+	require.Equal(t, commits[2], commitInfo.Commit)
+
+	moves, err = client.ListBranchHistory(repo, "master", 0)
+	require.NoError(t, err)
+	require.Equal(t, 7, len(moves))
+	require.Equal(t, "SetBranch", moves[0].Operation)
+
+	_, err = client.ListBranchHistory(repo, "nonexistent", 0)
+	require.YesError(t, err)
+}
+
+func TestForkRepo(t *testing.T) {
+	t.Parallel()
+	client := getClient(t)
+
+	require.NoError(t, client.CreateRepo("source"))
+	_, err := client.PutFile("source", "master", "foo", strings.NewReader("foo\n"))
+	require.NoError(t, err)
+	_, err = client.PutFile("source", "master", "bar", strings.NewReader("bar\n"))
+	require.NoError(t, err)
+	require.NoError(t, client.SetBranch("source", "master", "stable"))
+	// Open commits aren't copied, so the fork's master is the finished head
+	_, err = client.StartCommit("source", "master")
+	require.NoError(t, err)
+
+	require.NoError(t, client.ForkRepo("source", "fork", ""))
+	commitInfos, err := client.ListCommit("fork", "", "", 0)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(commitInfos))
+	sourceInfo, err := client.InspectCommit("source", "stable")
+	require.NoError(t, err)
+	forkInfo, err := client.InspectCommit("fork", "master")
+	require.NoError(t, err)
+	require.NotEqual(t, sourceInfo.Commit.ID, forkInfo.Commit.ID)
+	require.Equal(t, sourceInfo.Tree, forkInfo.Tree)
+	require.NotNil(t, forkInfo.Finished)
+	stableInfo, err := client.InspectCommit("fork", "stable")
+	require.NoError(t, err)
+	require.Equal(t, forkInfo.Commit, stableInfo.Commit)
+
+	var buf bytes.Buffer
+	require.NoError(t, client.GetFile("fork", "master", "foo", 0, 0, &buf))
+	require.Equal(t, "foo\n", buf.String())
+	require.YesError(t, client.GetFile("fork", "master^", "bar", 0, 0, &buf))
+
+	// The fork is independent of the source
+	_, err = client.PutFile("fork", "master", "baz", strings.NewReader("baz\n"))
+	require.NoError(t, err)
+	_, err = client.InspectFile("source", "stable", "baz")
+	require.YesError(t, err)
+
+	require.YesError(t, client.ForkRepo("source", "fork", ""))
+}
+
+func TestRenameRepo(t *testing.T) {
+	t.Parallel()
+	client := getClient(t)
+
+	require.NoError(t, client.CreateRepo("in"))
+	_, err := client.PfsAPIClient.CreateRepo(context.Background(), &pfs.CreateRepoRequest{
+		Repo:       pclient.NewRepo("out"),
+		Provenance: []*pfs.Repo{pclient.NewRepo("in")},
+	})
+	require.NoError(t, err)
+	_, err = client.PutFile("in", "master", "foo", strings.NewReader("foo\n"))
+	require.NoError(t, err)
+	inInfo, err := client.InspectCommit("in", "master")
+	require.NoError(t, err)
+	outCommit, err := client.PfsAPIClient.StartCommit(
+		context.Background(),
+		&pfs.StartCommitRequest{
+			Parent:     pclient.NewCommit("out", "master"),
+			Provenance: []*pfs.Commit{inInfo.Commit},
+		},
+	)
+	require.NoError(t, err)
+	require.NoError(t, client.FinishCommit("out", outCommit.ID))
+
+	require.NoError(t, client.RenameRepo("in", "data"))
+	_, err = client.InspectRepo("in")
+	require.YesError(t, err)
+	commitInfo, err := client.InspectCommit("data", "master")
+	require.NoError(t, err)
+	require.Equal(t, inInfo.Commit.ID, commitInfo.Commit.ID)
+	require.Equal(t, "data", commitInfo.Commit.Repo.Name)
+	var buf bytes.Buffer
+	require.NoError(t, client.GetFile("data", "master", "foo", 0, 0, &buf))
+	require.Equal(t, "foo\n", buf.String())
+	moves, err := client.ListBranchHistory("data", "master", 0)
+	require.NoError(t, err)
+	require.Equal(t, "data", moves[0].NewHead.Repo.Name)
+
+	// Downstream repos and commits refer to the new name
+	repoInfo, err := client.InspectRepo("out")
+	require.NoError(t, err)
+	require.Equal(t, "data", repoInfo.Provenance[0].Name)
+	commitInfo, err = client.InspectCommit("out", "master")
+	require.NoError(t, err)
+	require.Equal(t, "data", commitInfo.Provenance[0].Repo.Name)
+	repoInfos, err := client.ListRepo([]string{"data"})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(repoInfos))
+
+	// Repos with open commits can't be renamed, nor can a repo be renamed
+	// to an existing repo
+	_, err = client.StartCommit("data", "master")
+	require.NoError(t, err)
+	require.YesError(t, client.RenameRepo("data", "in"))
+	require.YesError(t, client.RenameRepo("out", "data"))
+}
+
+func TestGetArchive(t *testing.T) {
+	t.Parallel()
+	client := getClient(t)
+
+	repo := "TestGetArchive"
+	require.NoError(t, client.CreateRepo(repo))
+	_, err := client.PutFile(repo, "master", "dir/a", strings.NewReader("a\n"))
+	require.NoError(t, err)
+	_, err = client.PutFile(repo, "master", "dir/sub/b", strings.NewReader("b\n"))
+	require.NoError(t, err)
+	_, err = client.PutFile(repo, "master", "dirfoo", strings.NewReader("dirfoo\n"))
+	require.NoError(t, err)
+	_, err = client.PutFile(repo, "master", "c.csv", strings.NewReader("c\n"))
+	require.NoError(t, err)
+
+	// readTar returns the content of the entries of a tar archive by name
+	readTar := func(r io.Reader) map[string]string {
+		entries := make(map[string]string)
+		tr := tar.NewReader(r)
+		for {
+			header, err := tr.Next()
+			if err == io.EOF {
+				return entries
+			}
+			require.NoError(t, err)
+			content, err := ioutil.ReadAll(tr)
+			require.NoError(t, err)
+			entries[header.Name] = string(content)
+		}
+	}
+	dirEntries := map[string]string{
+		"dir/":      "",
+		"dir/a":     "a\n",
+		"dir/sub/":  "",
+		"dir/sub/b": "b\n",
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, client.GetArchive(repo, "master", "dir", pfs.ArchiveFormat_TAR, &buf))
+	require.Equal(t, dirEntries, readTar(&buf))
+
+	buf.Reset()
+	require.NoError(t, client.GetArchive(repo, "master", "dir", pfs.ArchiveFormat_TAR_GZ, &buf))
+	gr, err := gzip.NewReader(&buf)
+	require.NoError(t, err)
+	require.Equal(t, dirEntries, readTar(gr))
+
+	buf.Reset()
+	require.NoError(t, client.GetArchive(repo, "master", "dir", pfs.ArchiveFormat_ZIP, &buf))
+	zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	require.NoError(t, err)
+	zipEntries := make(map[string]string)
+	for _, f := range zr.File {
+		r, err := f.Open()
+		require.NoError(t, err)
+		content, err := ioutil.ReadAll(r)
+		require.NoError(t, err)
+		require.NoError(t, r.Close())
+		zipEntries[f.Name] = string(content)
+	}
+	require.Equal(t, dirEntries, zipEntries)
+
+	// Glob patterns and the root
+	buf.Reset()
+	require.NoError(t, client.GetArchive(repo, "master", "*.csv", pfs.ArchiveFormat_TAR, &buf))
+	require.Equal(t, map[string]string{"c.csv": "c\n"}, readTar(&buf))
+	buf.Reset()
+	require.NoError(t, client.GetArchive(repo, "master", "/", pfs.ArchiveFormat_TAR, &buf))
+	require.Equal(t, 6, len(readTar(&buf)))
+
+	require.YesError(t, client.GetArchive(repo, "master", "nonexistent", pfs.ArchiveFormat_TAR, &buf))
+}
+
 func TestProvenance2(t *testing.T) {
 	t.Parallel()
 	client := getClient(t)
@@ -3144,6 +3449,572 @@ func TestBuildCommit(t *testing.T) {
 	require.Equal(t, uint64(fooSize+barSize), commitInfo.SizeBytes)
 }
 
+func TestBranchProtectionFastForwardOnly(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+	t.Parallel()
+
+	c := getClient(t)
+	repo := uniqueString("TestBranchProtectionFastForwardOnly")
+	require.NoError(t, c.CreateRepo(repo))
+
+	commit1, err := c.StartCommit(repo, "master")
+	require.NoError(t, err)
+	require.NoError(t, c.FinishCommit(repo, commit1.ID))
+	require.NoError(t, c.SetBranchProtection(repo, &pfs.BranchProtection{
+		Branch:          "master",
+		FastForwardOnly: true,
+	}))
+	repoInfo, err := c.InspectRepo(repo)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(repoInfo.BranchProtections))
+
+	// Commits on top of the head are fast-forwards
+	commit2, err := c.StartCommit(repo, "master")
+	require.NoError(t, err)
+	require.NoError(t, c.FinishCommit(repo, commit2.ID))
+
+	// Moving the branch backwards is not
+	require.YesError(t, c.SetBranch(repo, commit1.ID, "master"))
+	// Neither is moving it to an unrelated commit
+	commit3, err := c.StartCommit(repo, "")
+	require.NoError(t, err)
+	require.NoError(t, c.FinishCommit(repo, commit3.ID))
+	require.YesError(t, c.SetBranch(repo, commit3.ID, "master"))
+	commitInfo, err := c.InspectCommit(repo, "master")
+	require.NoError(t, err)
+	require.Equal(t, commit2.ID, commitInfo.Commit.ID)
+
+	// Other branches aren't affected
+	require.NoError(t, c.SetBranch(repo, commit1.ID, "other"))
+	require.NoError(t, c.SetBranch(repo, commit3.ID, "other"))
+
+	require.NoError(t, c.DeleteBranchProtection(repo, "master"))
+	require.NoError(t, c.SetBranch(repo, commit1.ID, "master"))
+	require.YesError(t, c.DeleteBranchProtection(repo, "master"))
+}
+
+func TestBranchProtectionDeleteCommit(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+	t.Parallel()
+
+	c := getClient(t)
+	repo := uniqueString("TestBranchProtectionDeleteCommit")
+	require.NoError(t, c.CreateRepo(repo))
+
+	commit1, err := c.StartCommit(repo, "master")
+	require.NoError(t, err)
+	require.NoError(t, c.FinishCommit(repo, commit1.ID))
+	require.NoError(t, c.SetBranchProtection(repo, &pfs.BranchProtection{
+		Branch:          "master",
+		FastForwardOnly: true,
+	}))
+
+	// Deleting the open head would rewind master to commit1
+	commit2, err := c.StartCommit(repo, "master")
+	require.NoError(t, err)
+	require.YesError(t, c.DeleteCommit(repo, commit2.ID))
+	commitInfo, err := c.InspectCommit(repo, "master")
+	require.NoError(t, err)
+	require.Equal(t, commit2.ID, commitInfo.Commit.ID)
+
+	require.NoError(t, c.DeleteBranchProtection(repo, "master"))
+	require.NoError(t, c.DeleteCommit(repo, commit2.ID))
+	commitInfo, err = c.InspectCommit(repo, "master")
+	require.NoError(t, err)
+	require.Equal(t, commit1.ID, commitInfo.Commit.ID)
+}
+
+func TestBranchProtectionPrincipals(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+	t.Parallel()
+
+	c := getClient(t)
+	repo := uniqueString("TestBranchProtectionPrincipals")
+	require.NoError(t, c.CreateRepo(repo))
+
+	commit1, err := c.StartCommit(repo, "master")
+	require.NoError(t, err)
+	require.NoError(t, c.FinishCommit(repo, commit1.ID))
+	require.NoError(t, c.SetBranchProtection(repo, &pfs.BranchProtection{
+		Branch:            "master",
+		AllowedPrincipals: []string{"alice"},
+	}))
+
+	// Auth isn't active in this test, so nobody can be identified as alice
+	_, err = c.StartCommit(repo, "master")
+	require.YesError(t, err)
+	commit2, err := c.StartCommit(repo, "")
+	require.NoError(t, err)
+	require.NoError(t, c.FinishCommit(repo, commit2.ID))
+	require.YesError(t, c.SetBranch(repo, commit2.ID, "master"))
+}
+
+func TestBranchProtectionRequiredPipeline(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+	t.Parallel()
+
+	c := getClient(t)
+	repo := uniqueString("TestBranchProtectionRequiredPipeline")
+	require.NoError(t, c.CreateRepo(repo))
+	// Stands in for the output repo of a validation pipeline
+	pipeline := uniqueString("validate")
+	require.NoError(t, c.CreateRepo(pipeline))
+	require.NoError(t, c.SetBranchProtection(repo, &pfs.BranchProtection{
+		Branch:           "master",
+		RequiredPipeline: pipeline,
+	}))
+
+	_, err := c.StartCommit(repo, "master")
+	require.YesError(t, err)
+
+	commit, err := c.StartCommit(repo, "staging")
+	require.NoError(t, err)
+	require.NoError(t, c.FinishCommit(repo, commit.ID))
+	require.YesError(t, c.SetBranch(repo, commit.ID, "master"))
+
+	outputCommit, err := c.PfsAPIClient.StartCommit(context.Background(), &pfs.StartCommitRequest{
+		Parent:     pclient.NewCommit(pipeline, ""),
+		Provenance: []*pfs.Commit{commit},
+	})
+	require.NoError(t, err)
+	// The output commit must be finished before it counts
+	require.YesError(t, c.SetBranch(repo, commit.ID, "master"))
+	require.NoError(t, c.FinishCommit(pipeline, outputCommit.ID))
+	require.NoError(t, c.SetBranch(repo, commit.ID, "master"))
+}
+
+func TestRetentionPolicy(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+	t.Parallel()
+
+	c := getClient(t)
+	repo := uniqueString("TestRetentionPolicy")
+	require.NoError(t, c.CreateRepo(repo))
+
+	var commits []*pfs.Commit
+	for i := 0; i < 5; i++ {
+		commit, err := c.StartCommit(repo, "master")
+		require.NoError(t, err)
+		_, err = c.PutFile(repo, commit.ID, fmt.Sprintf("file%d", i), strings.NewReader("foo\n"))
+		require.NoError(t, err)
+		require.NoError(t, c.FinishCommit(repo, commit.ID))
+		commits = append(commits, commit)
+	}
+	// The first commit is kept because it's the head of a branch
+	require.NoError(t, c.SetBranch(repo, commits[0].ID, "pinned"))
+
+	// Without a policy nothing is deleted
+	deleted, err := c.ApplyRetentionPolicy(repo, false)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(deleted))
+
+	require.NoError(t, c.SetRetentionPolicy(repo, &pfs.RetentionPolicy{KeepLast: 2}))
+	repoInfo, err := c.InspectRepo(repo)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), repoInfo.RetentionPolicy.KeepLast)
+
+	deleted, err = c.ApplyRetentionPolicy(repo, true)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(deleted))
+	commitInfos, err := c.ListCommit(repo, "", "", 0)
+	require.NoError(t, err)
+	require.Equal(t, 5, len(commitInfos))
+
+	deleted, err = c.ApplyRetentionPolicy(repo, false)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(deleted))
+	require.Equal(t, commits[1].ID, deleted[0].ID)
+	require.Equal(t, commits[2].ID, deleted[1].ID)
+
+	// The remaining commits are linked together and still hold all the files
+	commitInfos, err = c.ListCommit(repo, "master", "", 0)
+	require.NoError(t, err)
+	require.Equal(t, 3, len(commitInfos))
+	require.Equal(t, commits[0].ID, commitInfos[2].Commit.ID)
+	fileInfos, err := c.ListFile(repo, "master", "")
+	require.NoError(t, err)
+	require.Equal(t, 5, len(fileInfos))
+
+	// The branch's history skips the deleted commits, so restoring it never
+	// targets one
+	moves, err := c.ListBranchHistory(repo, "master", 0)
+	require.NoError(t, err)
+	require.Equal(t, 3, len(moves))
+	require.Equal(t, commits[3].ID, moves[0].OldHead.ID)
+	require.Equal(t, commits[0].ID, moves[1].OldHead.ID)
+	require.Equal(t, commits[3].ID, moves[1].NewHead.ID)
+	require.NoError(t, c.RestoreBranch(repo, "master", 2))
+	commitInfo, err := c.InspectCommit(repo, "master")
+	require.NoError(t, err)
+	require.Equal(t, commits[0].ID, commitInfo.Commit.ID)
+
+	require.NoError(t, c.SetRetentionPolicy(repo, nil))
+	repoInfo, err = c.InspectRepo(repo)
+	require.NoError(t, err)
+	require.Nil(t, repoInfo.RetentionPolicy)
+}
+
+func TestRetainedCommits(t *testing.T) {
+	now := time.Date(2017, 10, 20, 12, 0, 0, 0, time.UTC)
+	var commitInfos []*pfs.CommitInfo
+	// One commit every 6 hours for 30 days, the last of which is still open
+	for i := 0; i < 120; i++ {
+		started, err := types.TimestampProto(now.Add(-time.Duration(i) * 6 * time.Hour))
+		require.NoError(t, err)
+		commitInfo := &pfs.CommitInfo{
+			Commit:   pclient.NewCommit("repo", fmt.Sprintf("%d", i)),
+			Started:  started,
+			Finished: started,
+		}
+		if i == 0 {
+			commitInfo.Finished = nil
+		}
+		commitInfos = append(commitInfos, commitInfo)
+	}
+
+	kept, err := retainedCommits(&pfs.RetentionPolicy{KeepLast: 3}, commitInfos, now)
+	require.NoError(t, err)
+	require.Equal(t, 3, len(kept))
+	require.True(t, kept["1"] && kept["2"] && kept["3"])
+
+	kept, err = retainedCommits(&pfs.RetentionPolicy{KeepNewerThan: types.DurationProto(25 * time.Hour)}, commitInfos, now)
+	require.NoError(t, err)
+	require.Equal(t, 4, len(kept))
+
+	kept, err = retainedCommits(&pfs.RetentionPolicy{KeepDaily: 2}, commitInfos, now)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(kept))
+	// The newest finished commit of today and of yesterday
+	require.True(t, kept["1"] && kept["3"])
+
+	kept, err = retainedCommits(&pfs.RetentionPolicy{KeepWeekly: 10}, commitInfos, now)
+	require.NoError(t, err)
+	require.Equal(t, 5, len(kept))
+
+	kept, err = retainedCommits(&pfs.RetentionPolicy{KeepLast: 1, KeepDaily: 2}, commitInfos, now)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(kept))
+}
+
+func TestSquashBranchMoves(t *testing.T) {
+	commit := func(id string) *pfs.Commit {
+		if id == "" {
+			return nil
+		}
+		return pclient.NewCommit("repo", id)
+	}
+	move := func(oldHead, newHead string) *pfs.BranchMove {
+		return &pfs.BranchMove{OldHead: commit(oldHead), NewHead: commit(newHead)}
+	}
+	history := &pfs.BranchHistory{Moves: []*pfs.BranchMove{
+		move("c", "d"), move("b", "c"), move("a", "b"), move("", "a"),
+	}}
+	require.False(t, squashBranchMoves(history, commit("x"), commit("w")))
+	require.Equal(t, 4, len(history.Moves))
+
+	// The move onto the squashed commit no longer moves the branch
+	require.True(t, squashBranchMoves(history, commit("b"), commit("a")))
+	require.Equal(t, []*pfs.BranchMove{move("c", "d"), move("a", "c"), move("", "a")}, history.Moves)
+
+	// A squashed root commit has no ancestor to stand in for it
+	require.True(t, squashBranchMoves(history, commit("a"), nil))
+	require.Equal(t, []*pfs.BranchMove{move("c", "d")}, history.Moves)
+}
+
+func TestRepoQuota(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+	t.Parallel()
+
+	c := getClient(t)
+	repo := uniqueString("TestRepoQuota")
+	require.NoError(t, c.CreateRepo(repo))
+	require.NoError(t, c.SetRepoQuota(repo, &pfs.Quota{MaxBytes: 10, MaxCommits: 3}))
+	repoInfo, err := c.InspectRepo(repo)
+	require.NoError(t, err)
+	require.Equal(t, uint64(10), repoInfo.Quota.MaxBytes)
+
+	commit1, err := c.StartCommit(repo, "master")
+	require.NoError(t, err)
+	_, err = c.PutFile(repo, commit1.ID, "foo", strings.NewReader("foo\n"))
+	require.NoError(t, err)
+	require.NoError(t, c.FinishCommit(repo, commit1.ID))
+
+	// 4 bytes are stored, so another 8 would exceed the quota
+	commit2, err := c.StartCommit(repo, "master")
+	require.NoError(t, err)
+	_, err = c.PutFile(repo, commit2.ID, "bar", strings.NewReader("barbarb\n"))
+	require.YesError(t, err)
+	require.True(t, pfs.IsQuotaExceededError(err))
+	_, err = c.PutFile(repo, commit2.ID, "bar", strings.NewReader("bar\n"))
+	require.NoError(t, err)
+	require.NoError(t, c.FinishCommit(repo, commit2.ID))
+
+	commit3, err := c.StartCommit(repo, "master")
+	require.NoError(t, err)
+	require.NoError(t, c.FinishCommit(repo, commit3.ID))
+	_, err = c.StartCommit(repo, "master")
+	require.YesError(t, err)
+	require.True(t, pfs.IsQuotaExceededError(err))
+
+	// Removing the quota allows writes again
+	require.NoError(t, c.SetRepoQuota(repo, nil))
+	_, err = c.StartCommit(repo, "master")
+	require.NoError(t, err)
+}
+
+func TestRepoQuotaOpenCommit(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+	t.Parallel()
+
+	c := getClient(t)
+	repo := uniqueString("TestRepoQuotaOpenCommit")
+	require.NoError(t, c.CreateRepo(repo))
+	require.NoError(t, c.SetRepoQuota(repo, &pfs.Quota{MaxBytes: 10}))
+
+	// Bytes written to an open commit count before it's finished
+	commit, err := c.StartCommit(repo, "master")
+	require.NoError(t, err)
+	_, err = c.PutFile(repo, commit.ID, "foo", strings.NewReader("foo\n"))
+	require.NoError(t, err)
+	_, err = c.PutFile(repo, commit.ID, "bar", strings.NewReader("bar\n"))
+	require.NoError(t, err)
+	_, err = c.PutFile(repo, commit.ID, "buzz", strings.NewReader("buz\n"))
+	require.YesError(t, err)
+	require.True(t, pfs.IsQuotaExceededError(err))
+	require.NoError(t, c.FinishCommit(repo, commit.ID))
+}
+
+func TestRepoQuotaBuildCommit(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+	t.Parallel()
+
+	c := getClient(t)
+	repo := uniqueString("TestRepoQuotaBuildCommit")
+	require.NoError(t, c.CreateRepo(repo))
+	require.NoError(t, c.SetRepoQuota(repo, &pfs.Quota{MaxBytes: 10}))
+
+	buildCommit := func(tree hashtree.OpenHashTree) error {
+		finished, err := tree.Finish()
+		require.NoError(t, err)
+		serialized, err := hashtree.Serialize(finished)
+		require.NoError(t, err)
+		treeObj, _, err := c.PutObject(bytes.NewReader(serialized))
+		require.NoError(t, err)
+		_, err = c.BuildCommit(repo, "master", "", treeObj.Hash)
+		return err
+	}
+	tree := hashtree.NewHashTree()
+	fooObj, fooSize, err := c.PutObject(strings.NewReader("foo\n"))
+	require.NoError(t, err)
+	require.NoError(t, tree.PutFile("foo", []*pfs.Object{fooObj}, fooSize))
+	require.NoError(t, buildCommit(tree))
+
+	// Commits built from trees, like pipelines' output commits, count
+	// against the quota too
+	bigObj, bigSize, err := c.PutObject(strings.NewReader("barbarb\n"))
+	require.NoError(t, err)
+	require.NoError(t, tree.PutFile("bar", []*pfs.Object{bigObj}, bigSize))
+	err = buildCommit(tree)
+	require.YesError(t, err)
+	require.True(t, pfs.IsQuotaExceededError(err))
+	repoInfo, err := c.InspectRepo(repo)
+	require.NoError(t, err)
+	require.Equal(t, uint64(fooSize), repoInfo.SizeBytes)
+}
+
+func TestQuotaUsage(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+	t.Parallel()
+
+	c := getClient(t)
+	repo := uniqueString("TestQuotaUsage")
+	require.NoError(t, c.CreateRepo(repo))
+
+	// Two files with the same content in two commits
+	commit1, err := c.StartCommit(repo, "master")
+	require.NoError(t, err)
+	_, err = c.PutFile(repo, commit1.ID, "foo", strings.NewReader("foo\n"))
+	require.NoError(t, err)
+	require.NoError(t, c.FinishCommit(repo, commit1.ID))
+	commit2, err := c.StartCommit(repo, "master")
+	require.NoError(t, err)
+	_, err = c.PutFile(repo, commit2.ID, "bar", strings.NewReader("foo\n"))
+	require.NoError(t, err)
+	require.NoError(t, c.FinishCommit(repo, commit2.ID))
+
+	usage, err := c.QuotaUsage()
+	require.NoError(t, err)
+	var repoUsage *pfs.RepoUsage
+	for _, u := range usage.Repos {
+		if u.Repo.Name == repo {
+			repoUsage = u
+		}
+	}
+	require.NotNil(t, repoUsage)
+	require.Equal(t, uint64(8), repoUsage.Usage.LogicalBytes)
+	require.Equal(t, uint64(4), repoUsage.Usage.DeduplicatedBytes)
+	require.Equal(t, uint64(2), repoUsage.Usage.Commits)
+}
+
+func TestTransaction(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+	t.Parallel()
+
+	c := getClient(t)
+	repo1 := uniqueString("TestTransaction1")
+	repo2 := uniqueString("TestTransaction2")
+	require.NoError(t, c.CreateRepo(repo1))
+	require.NoError(t, c.CreateRepo(repo2))
+
+	txn, err := c.StartTransaction()
+	require.NoError(t, err)
+	tc := c.WithTransaction(txn)
+	commit1, err := tc.StartCommit(repo1, "master")
+	require.NoError(t, err)
+	_, err = tc.PutFile(repo1, commit1.ID, "foo", strings.NewReader("foo\n"))
+	require.NoError(t, err)
+	require.NoError(t, tc.FinishCommit(repo1, commit1.ID))
+	commit2, err := tc.StartCommit(repo2, "")
+	require.NoError(t, err)
+	_, err = tc.PutFile(repo2, commit2.ID, "bar", strings.NewReader("bar\n"))
+	require.NoError(t, err)
+	require.NoError(t, tc.FinishCommit(repo2, commit2.ID))
+	require.NoError(t, tc.SetBranch(repo2, commit2.ID, "master"))
+
+	// Nothing is visible until the transaction is finished
+	commitInfos, err := c.ListCommit(repo1, "", "", 0)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(commitInfos))
+	_, err = c.InspectCommit(repo2, "master")
+	require.YesError(t, err)
+
+	require.NoError(t, c.FinishTransaction(txn))
+	var buffer bytes.Buffer
+	require.NoError(t, c.GetFile(repo1, "master", "foo", 0, 0, &buffer))
+	require.Equal(t, "foo\n", buffer.String())
+	buffer.Reset()
+	require.NoError(t, c.GetFile(repo2, "master", "bar", 0, 0, &buffer))
+	require.Equal(t, "bar\n", buffer.String())
+
+	// A finished transaction can't be finished again
+	require.YesError(t, c.FinishTransaction(txn))
+}
+
+func TestTransactionBranchProtection(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+	t.Parallel()
+
+	c := getClient(t)
+	repo := uniqueString("TestTransactionBranchProtection")
+	require.NoError(t, c.CreateRepo(repo))
+	commit, err := c.StartCommit(repo, "master")
+	require.NoError(t, err)
+	require.NoError(t, c.SetBranchProtection(repo, &pfs.BranchProtection{
+		Branch:            "master",
+		AllowedPrincipals: []string{"alice"},
+	}))
+
+	// Auth isn't active in this test, so nobody can finish the head of
+	// master, whether or not it's done in a transaction
+	require.YesError(t, c.FinishCommit(repo, commit.ID))
+	txn, err := c.StartTransaction()
+	require.NoError(t, err)
+	require.NoError(t, c.WithTransaction(txn).FinishCommit(repo, commit.ID))
+	require.YesError(t, c.FinishTransaction(txn))
+	commitInfo, err := c.InspectCommit(repo, commit.ID)
+	require.NoError(t, err)
+	require.Nil(t, commitInfo.Finished)
+}
+
+func TestDeleteTransaction(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+	t.Parallel()
+
+	c := getClient(t)
+	repo := uniqueString("TestDeleteTransaction")
+	require.NoError(t, c.CreateRepo(repo))
+
+	txn, err := c.StartTransaction()
+	require.NoError(t, err)
+	tc := c.WithTransaction(txn)
+	commit, err := tc.StartCommit(repo, "master")
+	require.NoError(t, err)
+	require.NoError(t, tc.FinishCommit(repo, commit.ID))
+	require.NoError(t, c.DeleteTransaction(txn))
+
+	require.YesError(t, c.FinishTransaction(txn))
+	commitInfos, err := c.ListCommit(repo, "", "", 0)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(commitInfos))
+}
+
+func TestGrepFile(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+	t.Parallel()
+
+	c := getClient(t)
+	repo := uniqueString("TestGrepFile")
+	require.NoError(t, c.CreateRepo(repo))
+	_, err := c.StartCommit(repo, "master")
+	require.NoError(t, err)
+	_, err = c.PutFile(repo, "master", "data/a", strings.NewReader("foo\nbar\nbaz\n"))
+	require.NoError(t, err)
+	_, err = c.PutFile(repo, "master", "data/b", strings.NewReader("bar\n"))
+	require.NoError(t, err)
+	_, err = c.PutFile(repo, "master", "other", strings.NewReader("bar\n"))
+	require.NoError(t, err)
+	require.NoError(t, c.FinishCommit(repo, "master"))
+
+	var results []*pfs.GrepFileResult
+	grep := func(pattern string, regex string, maxMatches int64, maxBytes int64) error {
+		results = nil
+		return c.GrepFile(repo, "master", pattern, regex, maxMatches, maxBytes, func(result *pfs.GrepFileResult) error {
+			results = append(results, result)
+			return nil
+		})
+	}
+	require.NoError(t, grep("data/*", "^ba", 0, 0))
+	require.Equal(t, 3, len(results))
+	lines := make(map[string]bool)
+	for _, result := range results {
+		lines[fmt.Sprintf("%s:%d:%s", result.File.Path, result.LineNumber, result.Line)] = true
+	}
+	require.True(t, lines["/data/a:2:bar"])
+	require.True(t, lines["/data/a:3:baz"])
+	require.True(t, lines["/data/b:1:bar"])
+
+	require.NoError(t, grep("data/*", "^ba", 1, 0))
+	require.Equal(t, 1, len(results))
+	require.YesError(t, grep("data/*", "^ba", 0, 5))
+	require.YesError(t, grep("data/*", "(", 0, 0))
+}
+
 func uniqueString(prefix string) string {
 	return prefix + "-" + uuid.NewWithoutDashes()[0:12]
 }
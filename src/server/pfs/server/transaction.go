@@ -0,0 +1,618 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/pachyderm/pachyderm/src/client/pfs"
+	"github.com/pachyderm/pachyderm/src/client/pkg/uuid"
+	pfsserver "github.com/pachyderm/pachyderm/src/server/pfs"
+	col "github.com/pachyderm/pachyderm/src/server/pkg/collection"
+	"github.com/pachyderm/pachyderm/src/server/pkg/hashtree"
+
+	etcd "github.com/coreos/etcd/clientv3"
+	"google.golang.org/grpc/metadata"
+)
+
+// transactionID returns the ID of the transaction that the request in 'ctx'
+// was made in, or "" if it wasn't made in a transaction.
+func transactionID(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	if ids := md[pfs.TransactionKey]; len(ids) > 0 {
+		return ids[0]
+	}
+	return ""
+}
+
+func (d *driver) startTransaction(ctx context.Context) (*pfs.Transaction, error) {
+	txn := &pfs.Transaction{ID: uuid.NewWithoutDashes()}
+	if _, err := col.NewSTM(ctx, d.etcdClient, func(stm col.STM) error {
+		return d.transactions.ReadWrite(stm).Create(txn.ID, &pfs.TransactionInfo{
+			Transaction: txn,
+			Started:     now(),
+		})
+	}); err != nil {
+		return nil, err
+	}
+	return txn, nil
+}
+
+func (d *driver) deleteTransaction(ctx context.Context, txn *pfs.Transaction) error {
+	_, err := col.NewSTM(ctx, d.etcdClient, func(stm col.STM) error {
+		return d.transactions.ReadWrite(stm).Delete(txn.ID)
+	})
+	return err
+}
+
+// addTransactionRequest records 'request' in the transaction 'txnID'.
+func (d *driver) addTransactionRequest(ctx context.Context, txnID string, request *pfs.TransactionRequest) error {
+	_, err := col.NewSTM(ctx, d.etcdClient, func(stm col.STM) error {
+		transactions := d.transactions.ReadWrite(stm)
+		txnInfo := new(pfs.TransactionInfo)
+		if err := transactions.Get(txnID, txnInfo); err != nil {
+			if col.IsErrNotFound(err) {
+				return fmt.Errorf("transaction %s not found", txnID)
+			}
+			return err
+		}
+		txnInfo.Requests = append(txnInfo.Requests, request)
+		return transactions.Put(txnID, txnInfo)
+	})
+	return err
+}
+
+// startCommitInTransaction records a StartCommit request in the transaction
+// 'txnID' and returns the commit that it will create. The commit's parent is
+// chosen when the transaction is finished.
+func (d *driver) startCommitInTransaction(ctx context.Context, txnID string, parent *pfs.Commit, branch string, provenance []*pfs.Commit) (*pfs.Commit, error) {
+	commit := &pfs.Commit{
+		Repo: parent.Repo,
+		ID:   uuid.NewWithoutDashes(),
+	}
+	if err := d.addTransactionRequest(ctx, txnID, &pfs.TransactionRequest{
+		StartCommit: &pfs.StartCommitRequest{
+			Parent:     parent,
+			Branch:     branch,
+			Provenance: provenance,
+		},
+		Commit: commit,
+	}); err != nil {
+		return nil, err
+	}
+	return commit, nil
+}
+
+// txnCommit is a commit that's started or written to by a transaction
+type txnCommit struct {
+	info *pfs.CommitInfo
+	// started is true if the commit is started by the transaction, and false
+	// if it was already open
+	started bool
+	// writes are the transaction's PutFile and DeleteFile requests for the
+	// commit
+	writes []*pfs.TransactionPutFile
+	// tree and sizeChange are set if the transaction finishes the commit
+	tree       hashtree.HashTree
+	sizeChange uint64
+	// scratchKeys are the keys of the writes made to an already-open commit
+	// outside of the transaction that its tree includes
+	scratchKeys []string
+}
+
+// txnBranchMove is a branch moved by a transaction
+type txnBranchMove struct {
+	commit *pfs.Commit
+	branch string
+	// started is true if the branch is moved by a StartCommit request
+	started bool
+}
+
+// txnState is the state of PFS as seen by a transaction's requests, which
+// is computed before the transaction is applied. Commits are finished (i.e.
+// their trees are computed and uploaded) while the state is computed, so
+// that applying the transaction only writes to etcd.
+type txnState struct {
+	d   *driver
+	ctx context.Context
+
+	commits map[string]*txnCommit
+	// commitOrder is the order in which commits are first used
+	commitOrder []*txnCommit
+	moves       []*txnBranchMove
+	// heads are the branch heads as moved by the transaction, and origHeads
+	// are the IDs of the heads that the transaction saw before moving them
+	// ("" for branches that didn't exist). Both are keyed by branchKey.
+	heads      map[string]*pfs.Commit
+	origHeads  map[string]string
+	newCommits map[string]uint64
+}
+
+func branchKey(repo string, branch string) string {
+	return path.Join(repo, branch)
+}
+
+// finishTransaction applies all of the requests in 'txn' in one etcd
+// transaction, so that other clients see all of them or none of them.
+func (d *driver) finishTransaction(ctx context.Context, txn *pfs.Transaction) error {
+	txnInfo := new(pfs.TransactionInfo)
+	if err := d.transactions.ReadOnly(ctx).Get(txn.ID, txnInfo); err != nil {
+		if col.IsErrNotFound(err) {
+			return fmt.Errorf("transaction %s not found", txn.ID)
+		}
+		return err
+	}
+	s := &txnState{
+		d:          d,
+		ctx:        ctx,
+		commits:    make(map[string]*txnCommit),
+		heads:      make(map[string]*pfs.Commit),
+		origHeads:  make(map[string]string),
+		newCommits: make(map[string]uint64),
+	}
+	// Commits started by the transaction have no scratch space yet, so the
+	// writes to those that the transaction leaves open are written to their
+	// scratch space now, where they're invisible until the commit is created.
+	var scratchWrites []*pfs.TransactionPutFile
+	for _, request := range txnInfo.Requests {
+		if err := s.apply(request); err != nil {
+			return err
+		}
+	}
+	for _, tc := range s.commitOrder {
+		if tc.started && tc.tree == nil {
+			scratchWrites = append(scratchWrites, tc.writes...)
+		} else if !tc.started && tc.tree == nil && len(tc.writes) > 0 {
+			return fmt.Errorf("commit %s was written to in the transaction, so it must be started or finished in it", tc.info.Commit.FullID())
+		}
+	}
+	for _, write := range scratchWrites {
+		prefix, err := d.scratchFilePrefix(ctx, write.File)
+		if err != nil {
+			return err
+		}
+		value := tombstone
+		if write.Records != nil {
+			marshalledRecords, err := write.Records.Marshal()
+			if err != nil {
+				return err
+			}
+			value = string(marshalledRecords)
+		}
+		if _, err := d.etcdClient.Put(ctx, path.Join(prefix, uuid.NewWithoutDashes()), value); err != nil {
+			return err
+		}
+	}
+
+	principal, err := d.callerPrincipal(ctx)
+	if err != nil {
+		return err
+	}
+	if _, err := col.NewSTM(ctx, d.etcdClient, func(stm col.STM) error {
+		// Fail if a branch that the transaction read has been moved since,
+		// as the commits' parents and trees would be out of date
+		for key, origID := range s.origHeads {
+			repo, branch := path.Dir(key), path.Base(key)
+			head := new(pfs.Commit)
+			if err := d.branches(repo).ReadWrite(stm).Get(branch, head); err != nil {
+				if !col.IsErrNotFound(err) {
+					return err
+				}
+				head.ID = ""
+			}
+			if head.ID != origID {
+				return fmt.Errorf("branch %s in repo %s was moved while the transaction was being finished; finish it again to retry", branch, repo)
+			}
+		}
+
+		repos := d.repos.ReadWrite(stm)
+		for _, tc := range s.commitOrder {
+			commit := tc.info.Commit
+			commits := d.commits(commit.Repo.Name).ReadWrite(stm)
+			if tc.started {
+				if err := commits.Create(commit.ID, tc.info); err != nil {
+					return err
+				}
+				if err := d.touchRepo(stm, commit.Repo); err != nil {
+					return err
+				}
+				if tc.tree == nil {
+					if err := d.openCommits.ReadWrite(stm).Put(commit.ID, commit); err != nil {
+						return err
+					}
+				}
+			} else if tc.tree != nil {
+				commitInfo := new(pfs.CommitInfo)
+				if err := commits.Get(commit.ID, commitInfo); err != nil {
+					return err
+				}
+				if commitInfo.Finished != nil {
+					return pfsserver.ErrCommitFinished{Commit: commit}
+				}
+				// The commit may be the head of a protected branch, which
+				// FinishCommit would have checked
+				if err := d.checkFinishProtectedCommitSTM(ctx, stm, commit); err != nil {
+					return err
+				}
+				if err := commits.Put(commit.ID, tc.info); err != nil {
+					return err
+				}
+				if err := d.openCommits.ReadWrite(stm).Delete(commit.ID); err != nil {
+					return fmt.Errorf("could not confirm that commit %s is open; this is likely a bug. err: %v", commit.ID, err)
+				}
+				// Only the writes that are in the tree are deleted; one
+				// that raced with the transaction is left in place rather
+				// than dropped
+				for _, key := range tc.scratchKeys {
+					stm.Del(key)
+				}
+			}
+			if tc.tree != nil {
+				repoInfo := new(pfs.RepoInfo)
+				if err := repos.Get(commit.Repo.Name, repoInfo); err != nil {
+					return err
+				}
+				repoInfo.SizeBytes += tc.sizeChange
+				if err := repos.Put(commit.Repo.Name, repoInfo); err != nil {
+					return err
+				}
+			}
+		}
+
+		for _, move := range s.moves {
+			repo := move.commit.Repo
+			commits := d.commits(repo.Name).ReadWrite(stm)
+			branches := d.branches(repo.Name).ReadWrite(stm)
+			repoInfo := new(pfs.RepoInfo)
+			if err := repos.Get(repo.Name, repoInfo); err != nil {
+				return err
+			}
+			if protection := branchProtection(repoInfo, move.branch); protection != nil {
+				var oldHead *pfs.Commit
+				head := new(pfs.Commit)
+				if err := branches.Get(move.branch, head); err != nil {
+					if !col.IsErrNotFound(err) {
+						return err
+					}
+				} else {
+					oldHead = head
+				}
+				if err := d.checkBranchPrincipal(ctx, repo, protection); err != nil {
+					return err
+				}
+				if err := d.checkFastForward(commits, repo, move.branch, protection, oldHead, move.commit); err != nil {
+					return err
+				}
+				if move.started && protection.RequiredPipeline != "" {
+					return pfsserver.ErrBranchProtected{
+						Repo:   repo,
+						Branch: move.branch,
+						Reason: fmt.Sprintf("commits must be validated by pipeline %s first; start the commit on another branch and move this branch to it with SetBranch",
+							protection.RequiredPipeline),
+					}
+				}
+				if !move.started {
+					commitInfo := new(pfs.CommitInfo)
+					if err := commits.Get(move.commit.ID, commitInfo); err != nil {
+						return err
+					}
+					if err := d.checkRequiredPipeline(ctx, repo, move.branch, protection, commitInfo); err != nil {
+						return err
+					}
+				}
+			}
+			if err := d.moveBranch(stm, repo, move.branch, move.commit, principal, opFinishTransaction); err != nil {
+				return err
+			}
+		}
+
+		return d.transactions.ReadWrite(stm).Delete(txn.ID)
+	}); err != nil {
+		// The commits started by the transaction weren't created, so nothing
+		// else will clean up their scratch space
+		for _, tc := range s.commitOrder {
+			if tc.started && tc.tree == nil {
+				d.etcdClient.Delete(ctx, path.Join(d.scratchPrefix(), tc.info.Commit.Repo.Name, tc.info.Commit.ID), etcd.WithPrefix())
+			}
+		}
+		return err
+	}
+	return nil
+}
+
+// checkFinishProtectedCommitSTM is like checkFinishProtectedCommit, but
+// reads the repo and its branch heads in 'stm', so that the check is
+// consistent with the transaction that finishes 'commit'.
+func (d *driver) checkFinishProtectedCommitSTM(ctx context.Context, stm col.STM, commit *pfs.Commit) error {
+	repoInfo := new(pfs.RepoInfo)
+	if err := d.repos.ReadWrite(stm).Get(commit.Repo.Name, repoInfo); err != nil {
+		return err
+	}
+	branches := d.branches(commit.Repo.Name).ReadWrite(stm)
+	for _, protection := range repoInfo.BranchProtections {
+		head := new(pfs.Commit)
+		if err := branches.Get(protection.Branch, head); err != nil {
+			if col.IsErrNotFound(err) {
+				continue
+			}
+			return err
+		}
+		if head.ID != commit.ID {
+			continue
+		}
+		if err := d.checkFinishProtectedBranch(ctx, commit.Repo, protection); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// apply updates 's' with the effect of 'request'.
+func (s *txnState) apply(request *pfs.TransactionRequest) error {
+	switch {
+	case request.StartCommit != nil:
+		return s.startCommit(request.StartCommit, request.Commit)
+	case request.PutFile != nil:
+		commit, err := s.resolve(request.PutFile.File.Commit)
+		if err != nil {
+			return err
+		}
+		tc, err := s.openCommit(commit)
+		if err != nil {
+			return err
+		}
+		request.PutFile.File.Commit = commit
+		tc.writes = append(tc.writes, request.PutFile)
+		return nil
+	case request.FinishCommit != nil:
+		commit, err := s.resolve(request.FinishCommit.Commit)
+		if err != nil {
+			return err
+		}
+		tc, err := s.openCommit(commit)
+		if err != nil {
+			return err
+		}
+		return s.finishCommit(tc)
+	case request.SetBranch != nil:
+		commit, err := s.resolve(request.SetBranch.Commit)
+		if err != nil {
+			return err
+		}
+		return s.moveBranch(commit, request.SetBranch.Branch, false)
+	}
+	return fmt.Errorf("empty transaction request (this is likely a bug)")
+}
+
+func (s *txnState) startCommit(request *pfs.StartCommitRequest, commit *pfs.Commit) error {
+	repo := request.Parent.Repo
+	if _, err := s.d.inspectRepo(s.ctx, repo, !includeAuth); err != nil {
+		return err
+	}
+	s.newCommits[repo.Name]++
+	if err := s.d.checkQuota(s.ctx, repo, 0, s.newCommits[repo.Name]); err != nil {
+		return err
+	}
+	commitInfo := &pfs.CommitInfo{
+		Commit:  commit,
+		Started: now(),
+	}
+
+	// Build the full provenance; my provenance's provenance is my provenance
+	provenanceMap := make(map[string]*pfs.Commit)
+	for _, prov := range request.Provenance {
+		prov, err := s.resolve(prov)
+		if err != nil {
+			return err
+		}
+		provCommitInfo, err := s.commitInfo(prov)
+		if err != nil {
+			return err
+		}
+		for _, c := range provCommitInfo.Provenance {
+			provenanceMap[c.ID] = c
+		}
+		provenanceMap[prov.ID] = prov
+	}
+	for _, c := range provenanceMap {
+		commitInfo.Provenance = append(commitInfo.Provenance, c)
+	}
+
+	parent := request.Parent
+	if parent.ID != "" {
+		var err error
+		if parent, err = s.resolve(parent); err != nil {
+			return err
+		}
+	} else if request.Branch != "" {
+		head, err := s.head(repo.Name, request.Branch)
+		if err != nil {
+			return err
+		}
+		parent = head
+	}
+	if parent != nil && parent.ID != "" {
+		parentCommitInfo, err := s.commitInfo(parent)
+		if err != nil {
+			return err
+		}
+		if parentCommitInfo.Finished == nil {
+			return fmt.Errorf("parent commit %s has not been finished", parent.ID)
+		}
+		commitInfo.ParentCommit = parent
+	}
+
+	tc := &txnCommit{info: commitInfo, started: true}
+	s.commits[commit.ID] = tc
+	s.commitOrder = append(s.commitOrder, tc)
+	if request.Branch != "" {
+		return s.moveBranch(commit, request.Branch, true)
+	}
+	return nil
+}
+
+func (s *txnState) finishCommit(tc *txnCommit) error {
+	parentTree, err := s.tree(tc.info.ParentCommit)
+	if err != nil {
+		return err
+	}
+	tree := parentTree.Open()
+	if !tc.started {
+		// Apply the writes made to the commit outside of the transaction
+		prefix, err := s.d.scratchCommitPrefix(s.ctx, tc.info.Commit)
+		if err != nil {
+			return err
+		}
+		resp, err := s.d.etcdClient.Get(s.ctx, prefix, etcd.WithPrefix(), etcd.WithSort(etcd.SortByModRevision, etcd.SortAscend))
+		if err != nil {
+			return err
+		}
+		if err := s.d.applyWrites(resp, tree); err != nil {
+			return err
+		}
+		for _, kv := range resp.Kvs {
+			tc.scratchKeys = append(tc.scratchKeys, string(kv.Key))
+		}
+	}
+	sizeMap := make(map[string]int64)
+	for _, write := range tc.writes {
+		if write.Records == nil {
+			if err := applyDeleteFile(write.File.Path, tree); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := applyPutFileRecords(write.File.Path, write.Records, sizeMap, tree); err != nil {
+			return err
+		}
+	}
+	finishedTree, err := tree.Finish()
+	if err != nil {
+		return err
+	}
+	data, err := hashtree.Serialize(finishedTree)
+	if err != nil {
+		return err
+	}
+	if len(data) > 0 {
+		obj, _, err := s.d.pachClient.PutObject(bytes.NewReader(data))
+		if err != nil {
+			return err
+		}
+		tc.info.Tree = obj
+	}
+	tc.info.SizeBytes = uint64(finishedTree.FSSize())
+	tc.info.Finished = now()
+	tc.sizeChange = sizeChange(finishedTree, parentTree)
+	if err := s.d.checkQuota(s.ctx, tc.info.Commit.Repo, tc.sizeChange, 0); err != nil {
+		return err
+	}
+	tc.tree = finishedTree
+	return nil
+}
+
+func (s *txnState) moveBranch(commit *pfs.Commit, branch string, started bool) error {
+	key := branchKey(commit.Repo.Name, branch)
+	// Record the head the transaction saw before moving it
+	if _, err := s.head(commit.Repo.Name, branch); err != nil {
+		return err
+	}
+	s.heads[key] = commit
+	s.moves = append(s.moves, &txnBranchMove{
+		commit:  commit,
+		branch:  branch,
+		started: started,
+	})
+	return nil
+}
+
+// head returns the head of 'branch' as seen by the transaction, or nil if
+// the branch doesn't exist.
+func (s *txnState) head(repo string, branch string) (*pfs.Commit, error) {
+	key := branchKey(repo, branch)
+	if head, ok := s.heads[key]; ok {
+		return head, nil
+	}
+	head := new(pfs.Commit)
+	if err := s.d.branches(repo).ReadOnly(s.ctx).Get(branch, head); err != nil {
+		if !col.IsErrNotFound(err) {
+			return nil, err
+		}
+		head = nil
+	}
+	s.heads[key] = head
+	s.origHeads[key] = ""
+	if head != nil {
+		s.origHeads[key] = head.ID
+	}
+	return head, nil
+}
+
+// resolve returns the commit that 'commit' (which may be a commit started by
+// the transaction, or a branch moved by it) refers to.
+func (s *txnState) resolve(commit *pfs.Commit) (*pfs.Commit, error) {
+	if _, ok := s.commits[commit.ID]; ok {
+		return commit, nil
+	}
+	// Branches are read now, so that the transaction fails if they move
+	// before it's applied
+	if !strings.ContainsAny(commit.ID, "^~") {
+		if head, err := s.head(commit.Repo.Name, commit.ID); err != nil {
+			return nil, err
+		} else if head != nil {
+			return head, nil
+		}
+	}
+	commitInfo, err := s.d.inspectCommit(s.ctx, &pfs.Commit{Repo: commit.Repo, ID: commit.ID})
+	if err != nil {
+		return nil, err
+	}
+	return commitInfo.Commit, nil
+}
+
+// commitInfo returns the CommitInfo of the resolved commit 'commit'.
+func (s *txnState) commitInfo(commit *pfs.Commit) (*pfs.CommitInfo, error) {
+	if tc, ok := s.commits[commit.ID]; ok {
+		return tc.info, nil
+	}
+	return s.d.inspectCommit(s.ctx, &pfs.Commit{Repo: commit.Repo, ID: commit.ID})
+}
+
+// openCommit returns the txnCommit for the resolved commit 'commit', which
+// must be open.
+func (s *txnState) openCommit(commit *pfs.Commit) (*txnCommit, error) {
+	tc, ok := s.commits[commit.ID]
+	if !ok {
+		commitInfo, err := s.commitInfo(commit)
+		if err != nil {
+			return nil, err
+		}
+		tc = &txnCommit{info: commitInfo}
+		if commitInfo.Finished == nil {
+			s.commits[commit.ID] = tc
+			s.commitOrder = append(s.commitOrder, tc)
+		}
+	}
+	if tc.info.Finished != nil {
+		return nil, pfsserver.ErrCommitFinished{Commit: commit}
+	}
+	return tc, nil
+}
+
+// tree returns the tree of the resolved commit 'commit'.
+func (s *txnState) tree(commit *pfs.Commit) (hashtree.HashTree, error) {
+	if commit != nil {
+		if tc, ok := s.commits[commit.ID]; ok {
+			if tc.tree == nil {
+				return nil, fmt.Errorf("parent commit %s has not been finished", commit.ID)
+			}
+			return tc.tree, nil
+		}
+	}
+	return s.d.getTreeForCommit(s.ctx, commit)
+}
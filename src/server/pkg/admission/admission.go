@@ -0,0 +1,252 @@
+// Package admission decides which queued jobs may start, given an
+// AdmissionPolicy and the jobs that are already running.
+package admission
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/pachyderm/pachyderm/src/client/pps"
+)
+
+// Job is a running or queued job, as far as admission is concerned.
+type Job struct {
+	ID string
+	// PriorityClass is the priority class of the job's pipeline, or "" for
+	// the policy's default class
+	PriorityClass string
+	Team          string
+	// Workers is the number of workers of the job's pipeline
+	Workers int64
+	// Queued is when the job was created. Jobs of the same priority and
+	// share are admitted oldest first.
+	Queued time.Time
+}
+
+// Decision is the result of Admit.
+type Decision struct {
+	// Admitted are the IDs of the jobs that may start, in the order they
+	// were admitted
+	Admitted []string
+	// Reasons explain why each of the jobs that are still queued wasn't
+	// admitted, by job ID
+	Reasons map[string]string
+}
+
+// Empty returns true if 'policy' doesn't limit anything, in which case
+// jobs needn't be queued.
+func Empty(policy *pps.AdmissionPolicy) bool {
+	return policy == nil ||
+		(len(policy.PriorityClasses) == 0 && len(policy.Teams) == 0 && policy.MaxRunningJobs == 0)
+}
+
+// Validate checks that 'policy' is consistent.
+func Validate(policy *pps.AdmissionPolicy) error {
+	classes := make(map[string]bool)
+	for _, class := range policy.PriorityClasses {
+		if class.Name == "" {
+			return fmt.Errorf("priority classes must have a name")
+		}
+		if classes[class.Name] {
+			return fmt.Errorf("priority class %q is defined more than once", class.Name)
+		}
+		classes[class.Name] = true
+		if class.MaxRunningJobs < 0 || class.MaxWorkers < 0 {
+			return fmt.Errorf("limits of priority class %q must be >= 0", class.Name)
+		}
+	}
+	if policy.DefaultPriorityClass != "" && !classes[policy.DefaultPriorityClass] {
+		return fmt.Errorf("default priority class %q isn't defined", policy.DefaultPriorityClass)
+	}
+	teams := make(map[string]bool)
+	for _, team := range policy.Teams {
+		if team.Team == "" {
+			return fmt.Errorf("team quotas must have a team")
+		}
+		if teams[team.Team] {
+			return fmt.Errorf("team %q has more than one quota", team.Team)
+		}
+		teams[team.Team] = true
+		if team.MaxRunningJobs < 0 || team.MaxWorkers < 0 {
+			return fmt.Errorf("limits of team %q must be >= 0", team.Team)
+		}
+	}
+	if policy.MaxRunningJobs < 0 {
+		return fmt.Errorf("max_running_jobs must be >= 0")
+	}
+	return nil
+}
+
+// HasPriorityClass returns true if 'policy' defines the priority class
+// 'name'. The empty name always exists, and means the default class.
+func HasPriorityClass(policy *pps.AdmissionPolicy, name string) bool {
+	if name == "" {
+		return true
+	}
+	for _, class := range policy.PriorityClasses {
+		if class.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// usage is the number of jobs running against a limit, and their workers
+type usage struct {
+	jobs    int64
+	workers int64
+}
+
+func (u *usage) add(job *Job) {
+	u.jobs++
+	u.workers += job.Workers
+}
+
+// admitter holds the state of a call to Admit
+type admitter struct {
+	policy  *pps.AdmissionPolicy
+	classes map[string]*pps.PriorityClass
+	teams   map[string]*pps.TeamQuota
+	total   usage
+	byClass map[string]*usage
+	byTeam  map[string]*usage
+}
+
+// class returns the priority class of 'job', which is nil if the policy
+// doesn't define it
+func (a *admitter) class(job *Job) *pps.PriorityClass {
+	name := job.PriorityClass
+	if name == "" {
+		name = a.policy.DefaultPriorityClass
+	}
+	return a.classes[name]
+}
+
+func (a *admitter) classUsage(class *pps.PriorityClass) *usage {
+	if a.byClass[class.Name] == nil {
+		a.byClass[class.Name] = &usage{}
+	}
+	return a.byClass[class.Name]
+}
+
+func (a *admitter) teamUsage(team string) *usage {
+	if a.byTeam[team] == nil {
+		a.byTeam[team] = &usage{}
+	}
+	return a.byTeam[team]
+}
+
+func (a *admitter) add(job *Job) {
+	a.total.add(job)
+	if class := a.class(job); class != nil {
+		a.classUsage(class).add(job)
+	}
+	a.teamUsage(job.Team).add(job)
+}
+
+func (a *admitter) priority(job *Job) int64 {
+	if class := a.class(job); class != nil {
+		return class.Priority
+	}
+	return 0
+}
+
+// exceeds returns a reason if admitting 'job' would exceed one of the
+// limits of the policy, and "" otherwise. A worker limit never keeps a job
+// from running on its own, so a job with more workers than its limit
+// still runs once nothing else is using it.
+func (a *admitter) exceeds(job *Job) string {
+	if a.policy.MaxRunningJobs > 0 && a.total.jobs >= a.policy.MaxRunningJobs {
+		return fmt.Sprintf("waiting for one of the %d jobs running in the cluster to finish", a.total.jobs)
+	}
+	if class := a.class(job); class != nil {
+		u := a.classUsage(class)
+		if class.MaxRunningJobs > 0 && u.jobs >= class.MaxRunningJobs {
+			return fmt.Sprintf("waiting for one of the %d jobs running in priority class %q to finish", u.jobs, class.Name)
+		}
+		if class.MaxWorkers > 0 && u.jobs > 0 && u.workers+job.Workers > class.MaxWorkers {
+			return fmt.Sprintf("waiting for workers in priority class %q (%d of %d in use, %d needed)", class.Name, u.workers, class.MaxWorkers, job.Workers)
+		}
+	}
+	if quota, ok := a.teams[job.Team]; ok {
+		u := a.teamUsage(job.Team)
+		if quota.MaxRunningJobs > 0 && u.jobs >= quota.MaxRunningJobs {
+			return fmt.Sprintf("waiting for one of the %d jobs running for team %q to finish", u.jobs, job.Team)
+		}
+		if quota.MaxWorkers > 0 && u.jobs > 0 && u.workers+job.Workers > quota.MaxWorkers {
+			return fmt.Sprintf("waiting for workers of team %q (%d of %d in use, %d needed)", job.Team, u.workers, quota.MaxWorkers, job.Workers)
+		}
+	}
+	return ""
+}
+
+// before returns true if 'x' should be admitted before 'y': jobs of higher
+// priority first, then jobs of the team with the fewest running jobs, so
+// that teams share a priority class fairly, then older jobs.
+func (a *admitter) before(x *Job, y *Job) bool {
+	if px, py := a.priority(x), a.priority(y); px != py {
+		return px > py
+	}
+	if x.Team != y.Team {
+		if ux, uy := a.teamUsage(x.Team).jobs, a.teamUsage(y.Team).jobs; ux != uy {
+			return ux < uy
+		}
+	}
+	if !x.Queued.Equal(y.Queued) {
+		return x.Queued.Before(y.Queued)
+	}
+	return x.ID < y.ID
+}
+
+// Admit decides which of the 'queued' jobs may start under 'policy', given
+// the 'running' jobs. Jobs are admitted one at a time, so that each
+// admission counts against the limits of the next.
+func Admit(policy *pps.AdmissionPolicy, running []*Job, queued []*Job) *Decision {
+	decision := &Decision{Reasons: make(map[string]string)}
+	if Empty(policy) {
+		for _, job := range queued {
+			decision.Admitted = append(decision.Admitted, job.ID)
+		}
+		return decision
+	}
+	a := &admitter{
+		policy:  policy,
+		classes: make(map[string]*pps.PriorityClass),
+		teams:   make(map[string]*pps.TeamQuota),
+		byClass: make(map[string]*usage),
+		byTeam:  make(map[string]*usage),
+	}
+	for _, class := range policy.PriorityClasses {
+		a.classes[class.Name] = class
+	}
+	for _, team := range policy.Teams {
+		a.teams[team.Team] = team
+	}
+	for _, job := range running {
+		a.add(job)
+	}
+	remaining := append([]*Job(nil), queued...)
+	for len(remaining) > 0 {
+		// Find the first job that can be admitted
+		var next *Job
+		nextIndex := -1
+		for i, job := range remaining {
+			if a.exceeds(job) != "" {
+				continue
+			}
+			if next == nil || a.before(job, next) {
+				next, nextIndex = job, i
+			}
+		}
+		if next == nil {
+			break
+		}
+		decision.Admitted = append(decision.Admitted, next.ID)
+		a.add(next)
+		remaining = append(remaining[:nextIndex], remaining[nextIndex+1:]...)
+	}
+	for _, job := range remaining {
+		decision.Reasons[job.ID] = a.exceeds(job)
+	}
+	return decision
+}
@@ -0,0 +1,120 @@
+package admission
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pachyderm/pachyderm/src/client/pkg/require"
+	"github.com/pachyderm/pachyderm/src/client/pps"
+)
+
+var start = time.Date(2018, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func job(id string, class string, team string, workers int64, age int) *Job {
+	return &Job{
+		ID:            id,
+		PriorityClass: class,
+		Team:          team,
+		Workers:       workers,
+		Queued:        start.Add(-time.Duration(age) * time.Minute),
+	}
+}
+
+func TestEmptyPolicy(t *testing.T) {
+	queued := []*Job{job("a", "", "", 1, 0), job("b", "", "", 1, 0)}
+	decision := Admit(nil, nil, queued)
+	require.Equal(t, []string{"a", "b"}, decision.Admitted)
+	decision = Admit(&pps.AdmissionPolicy{}, nil, queued)
+	require.Equal(t, []string{"a", "b"}, decision.Admitted)
+}
+
+func TestPriority(t *testing.T) {
+	policy := &pps.AdmissionPolicy{
+		PriorityClasses: []*pps.PriorityClass{
+			{Name: "high", Priority: 10},
+			{Name: "low", Priority: 1},
+		},
+		DefaultPriorityClass: "low",
+		MaxRunningJobs:       2,
+	}
+	running := []*Job{job("r", "low", "", 1, 10)}
+	queued := []*Job{
+		job("old-default", "", "", 1, 5),
+		job("high", "high", "", 1, 0),
+		job("low", "low", "", 1, 1),
+	}
+	decision := Admit(policy, running, queued)
+	require.Equal(t, []string{"high"}, decision.Admitted)
+	require.Equal(t, 2, len(decision.Reasons))
+	require.True(t, decision.Reasons["low"] != "")
+
+	// Within a priority class, older jobs go first
+	decision = Admit(policy, nil, queued)
+	require.Equal(t, []string{"high", "old-default"}, decision.Admitted)
+}
+
+func TestClassLimits(t *testing.T) {
+	policy := &pps.AdmissionPolicy{
+		PriorityClasses: []*pps.PriorityClass{
+			{Name: "backfill", Priority: 1, MaxRunningJobs: 1},
+			{Name: "batch", Priority: 1, MaxWorkers: 10},
+		},
+	}
+	running := []*Job{job("r1", "backfill", "", 5, 10), job("r2", "batch", "", 8, 10)}
+	queued := []*Job{
+		job("backfill", "backfill", "", 1, 5),
+		job("small", "batch", "", 2, 4),
+		job("big", "batch", "", 4, 3),
+		job("other", "", "", 100, 0),
+	}
+	decision := Admit(policy, running, queued)
+	require.Equal(t, []string{"small", "other"}, decision.Admitted)
+	require.Equal(t, 2, len(decision.Reasons))
+
+	// A job with more workers than the limit runs once the class is idle
+	decision = Admit(policy, nil, []*Job{job("huge", "batch", "", 20, 0), job("next", "batch", "", 1, 0)})
+	require.Equal(t, []string{"huge"}, decision.Admitted)
+}
+
+func TestFairShare(t *testing.T) {
+	policy := &pps.AdmissionPolicy{MaxRunningJobs: 4}
+	running := []*Job{job("r1", "", "a", 1, 10), job("r2", "", "a", 1, 10)}
+	queued := []*Job{
+		job("a1", "", "a", 1, 9),
+		job("a2", "", "a", 1, 8),
+		job("b1", "", "b", 1, 1),
+		job("b2", "", "b", 1, 0),
+	}
+	// Team b has nothing running, so its jobs go first despite being newer
+	decision := Admit(policy, running, queued)
+	require.Equal(t, []string{"b1", "b2"}, decision.Admitted)
+
+	policy.MaxRunningJobs = 5
+	decision = Admit(policy, running, queued)
+	require.Equal(t, []string{"b1", "b2", "a1"}, decision.Admitted)
+}
+
+func TestTeamQuota(t *testing.T) {
+	policy := &pps.AdmissionPolicy{
+		Teams: []*pps.TeamQuota{{Team: "a", MaxRunningJobs: 1}},
+	}
+	queued := []*Job{job("a1", "", "a", 1, 2), job("a2", "", "a", 1, 1), job("b1", "", "b", 1, 0)}
+	decision := Admit(policy, nil, queued)
+	require.Equal(t, []string{"a1", "b1"}, decision.Admitted)
+	require.True(t, decision.Reasons["a2"] != "")
+}
+
+func TestValidate(t *testing.T) {
+	require.NoError(t, Validate(&pps.AdmissionPolicy{
+		PriorityClasses:      []*pps.PriorityClass{{Name: "high", Priority: 1}},
+		DefaultPriorityClass: "high",
+	}))
+	require.YesError(t, Validate(&pps.AdmissionPolicy{DefaultPriorityClass: "missing"}))
+	require.YesError(t, Validate(&pps.AdmissionPolicy{
+		PriorityClasses: []*pps.PriorityClass{{Name: "x"}, {Name: "x"}},
+	}))
+	require.YesError(t, Validate(&pps.AdmissionPolicy{
+		Teams: []*pps.TeamQuota{{Team: "a", MaxWorkers: -1}},
+	}))
+	require.YesError(t, Validate(&pps.AdmissionPolicy{MaxRunningJobs: -1}))
+}
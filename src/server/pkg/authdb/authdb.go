@@ -0,0 +1,27 @@
+// Package authdb contains the database schema that the auth service uses, for
+// the parts of it that other services update.
+package authdb
+
+import (
+	"path"
+
+	etcd "github.com/coreos/etcd/clientv3"
+
+	authclient "github.com/pachyderm/pachyderm/src/client/auth"
+	col "github.com/pachyderm/pachyderm/src/server/pkg/collection"
+)
+
+const (
+	aclsPrefix = "/acls"
+)
+
+// ACLs returns a collection of the ACLs of repos, keyed by repo name
+func ACLs(etcdClient *etcd.Client, etcdPrefix string) col.Collection {
+	return col.NewCollection(
+		etcdClient,
+		path.Join(etcdPrefix, aclsPrefix),
+		nil,
+		&authclient.ACL{},
+		nil,
+	)
+}
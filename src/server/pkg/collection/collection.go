@@ -427,6 +427,10 @@ func (c *readonlyCollection) WatchWithPrev() (watch.Watcher, error) {
 	return watch.NewWatcherWithPrev(c.ctx, c.etcdClient, c.prefix)
 }
 
+func (c *readonlyCollection) WatchWithPrevFromRevision(rev int64) (watch.Watcher, error) {
+	return watch.NewWatcherWithPrevFromRevision(c.ctx, c.etcdClient, c.prefix, rev)
+}
+
 // WatchByIndex watches items in a collection that match a particular index
 func (c *readonlyCollection) WatchByIndex(index Index, val interface{}) (watch.Watcher, error) {
 	eventCh := make(chan *watch.Event)
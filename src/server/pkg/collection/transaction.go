@@ -19,9 +19,22 @@ package collection
 
 import (
 	v3 "github.com/coreos/etcd/clientv3"
+	"github.com/pachyderm/pachyderm/src/client/pkg/tracing"
+	"github.com/pachyderm/pachyderm/src/server/pkg/prom"
 	"golang.org/x/net/context"
 )
 
+var (
+	stmTransactions = prom.NewCounter(
+		"pachyderm_etcd_transactions_total",
+		"Number of etcd transactions run.",
+	)
+	stmRetries = prom.NewCounter(
+		"pachyderm_etcd_transaction_retries_total",
+		"Number of times an etcd transaction was retried because a key it read was modified before it committed.",
+	)
+)
+
 // STM is an interface for software transactional memory.
 type STM interface {
 	// Get returns the value for a key and inserts the key in the txn's read set.
@@ -50,7 +63,10 @@ type stmError struct{ err error }
 
 // NewSTM intiates a new STM operation. It uses a serializable model.
 func NewSTM(ctx context.Context, c *v3.Client, apply func(STM) error) (*v3.TxnResponse, error) {
-	return newSTMSerializable(ctx, c, apply)
+	span, ctx := tracing.StartSpan(ctx, "etcd.STM")
+	resp, err := newSTMSerializable(ctx, c, apply)
+	span.FinishWithError(err)
+	return resp, err
 }
 
 // newSTMRepeatable initiates new repeatable read transaction; reads within
@@ -95,7 +111,11 @@ func runSTM(s STM, apply func(STM) error) (*v3.TxnResponse, error) {
 			}
 		}()
 		var out stmResponse
-		for {
+		stmTransactions.Inc()
+		for attempt := 0; ; attempt++ {
+			if attempt > 0 {
+				stmRetries.Inc()
+			}
 			s.reset()
 			if out.err = apply(s); out.err != nil {
 				break
@@ -92,6 +92,9 @@ type ReadonlyCollection interface {
 	// WatchWithPrev is like Watch, but the events will include the previous
 	// versions of the key/value.
 	WatchWithPrev() (watch.Watcher, error)
+	// WatchWithPrevFromRevision is like WatchWithPrev, but it returns the
+	// events at or after revision 'rev' instead of the current items.
+	WatchWithPrevFromRevision(rev int64) (watch.Watcher, error)
 	WatchOne(key string) (watch.Watcher, error)
 	WatchByIndex(index Index, val interface{}) (watch.Watcher, error)
 }
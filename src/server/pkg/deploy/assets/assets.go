@@ -300,6 +300,9 @@ func PachdDeployment(opts *AssetOpts, objectStoreBackend backend, hostPath strin
 					Labels: labels(pachdName),
 					Annotations: map[string]string{
 						"iam.amazonaws.com/role": opts.IAMRole,
+						// Let Prometheus discover pachd's /metrics endpoint
+						"prometheus.io/scrape": "true",
+						"prometheus.io/port":   "651",
 					},
 				},
 				Spec: api.PodSpec{
@@ -0,0 +1,252 @@
+// Package egress pushes the files in a pipeline's output commits to external
+// systems (sinks). Each push only sends the difference between the commit
+// and the last commit that was pushed to the same sink.
+package egress
+
+import (
+	"fmt"
+	"io"
+	"net/url"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+	"golang.org/x/net/context"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/client/pfs"
+	"github.com/pachyderm/pachyderm/src/client/pps"
+	col "github.com/pachyderm/pachyderm/src/server/pkg/collection"
+	"github.com/pachyderm/pachyderm/src/server/pkg/ppsdb"
+)
+
+// DefaultRetries is the number of times egress is retried if the pipeline
+// doesn't say
+const DefaultRetries = 3
+
+// concurrency is the number of files pushed at once
+const concurrency = 100
+
+// Sink is a destination for egressed files. Put and Delete may be called
+// concurrently. Exactly one of Finish or Abort is called at the end of a push.
+type Sink interface {
+	// Put writes the contents of 'r' to 'path', replacing what was there.
+	Put(path string, r io.Reader) error
+	// Delete removes 'path', if it exists.
+	Delete(path string) error
+	// Finish completes a push in which every Put and Delete succeeded.
+	Finish() error
+	// Abort abandons a push that failed.
+	Abort() error
+}
+
+// NewSink returns the sink for 'rawURL', chosen by its scheme.
+func NewSink(ctx context.Context, rawURL string) (Sink, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing egress URL %v: %v", rawURL, err)
+	}
+	switch u.Scheme {
+	case "s3", "gcs", "gs", "as", "wasb":
+		return newObjSink(ctx, rawURL)
+	case "pfs":
+		return newPFSSink(ctx, u)
+	case "http", "https":
+		return newHTTPSink(ctx, u), nil
+	case "postgres", "postgresql", "mysql":
+		return newSQLSink(u)
+	}
+	return nil, fmt.Errorf("unrecognized egress URL scheme: %q", u.Scheme)
+}
+
+// Stats describes what a push did.
+type Stats struct {
+	FilesPushed  int64
+	FilesDeleted int64
+	BytesPushed  uint64
+}
+
+// Push sends the files in 'commit' to 'sink'. If 'base' is set, it's the
+// commit that was last pushed to 'sink', and only the files that differ
+// between it and 'commit' are pushed, with files that are no longer in
+// 'commit' deleted.
+func Push(pachClient *client.APIClient, commit *pfs.Commit, base *pfs.Commit, sink Sink) (_ *Stats, retErr error) {
+	defer func() {
+		if retErr != nil {
+			sink.Abort()
+		}
+	}()
+	var puts, deletes []*pfs.FileInfo
+	if base == nil {
+		if err := pachClient.Walk(commit.Repo.Name, commit.ID, "", func(fileInfo *pfs.FileInfo) error {
+			if fileInfo.FileType == pfs.FileType_FILE {
+				puts = append(puts, fileInfo)
+			}
+			return nil
+		}); err != nil {
+			return nil, err
+		}
+	} else {
+		newFiles, oldFiles, err := pachClient.DiffFile(commit.Repo.Name, commit.ID, "", base.Repo.Name, base.ID, "", false)
+		if err != nil {
+			return nil, err
+		}
+		puts = newFiles
+		deletes = deletedFiles(newFiles, oldFiles)
+	}
+
+	stats := &Stats{}
+	var statsMu sync.Mutex
+	limiter := make(chan struct{}, concurrency)
+	var eg errgroup.Group
+	for _, fileInfo := range puts {
+		fileInfo := fileInfo
+		eg.Go(func() error {
+			limiter <- struct{}{}
+			defer func() { <-limiter }()
+			r, w := io.Pipe()
+			go func() {
+				w.CloseWithError(pachClient.GetFile(commit.Repo.Name, commit.ID, fileInfo.File.Path, 0, 0, w))
+			}()
+			counter := &countingReader{r: r}
+			err := sink.Put(fileInfo.File.Path, counter)
+			// Unblock GetFile if the sink stopped reading early
+			r.CloseWithError(io.ErrClosedPipe)
+			if err != nil {
+				return fmt.Errorf("error pushing %s: %v", fileInfo.File.Path, err)
+			}
+			statsMu.Lock()
+			defer statsMu.Unlock()
+			stats.FilesPushed++
+			stats.BytesPushed += uint64(atomic.LoadInt64(&counter.n))
+			return nil
+		})
+	}
+	for _, fileInfo := range deletes {
+		fileInfo := fileInfo
+		eg.Go(func() error {
+			limiter <- struct{}{}
+			defer func() { <-limiter }()
+			if err := sink.Delete(fileInfo.File.Path); err != nil {
+				return fmt.Errorf("error deleting %s: %v", fileInfo.File.Path, err)
+			}
+			statsMu.Lock()
+			defer statsMu.Unlock()
+			stats.FilesDeleted++
+			return nil
+		})
+	}
+	if err := eg.Wait(); err != nil {
+		return nil, err
+	}
+	if err := sink.Finish(); err != nil {
+		return nil, err
+	}
+	return stats, nil
+}
+
+// Egress pushes 'commit', the output of a job of 'pipeline', to the egress
+// described by 'spec'. Only the difference between 'commit' and the last
+// commit of 'pipeline' that was pushed to the same URL (according to the
+// jobs in 'jobs') is pushed. The result records whether the push failed.
+func Egress(ctx context.Context, pachClient *client.APIClient, jobs col.ReadonlyCollection, pipeline *pps.Pipeline, commit *pfs.Commit, spec *pps.Egress) *pps.EgressInfo {
+	egressInfo := &pps.EgressInfo{
+		URL:     spec.URL,
+		Started: now(),
+	}
+	err := func() error {
+		last, err := LastEgressed(jobs, pipeline, spec.URL)
+		if err != nil {
+			return err
+		}
+		if last != nil {
+			egressInfo.BaseCommit = last.OutputCommit
+		}
+		sink, err := NewSink(ctx, spec.URL)
+		if err != nil {
+			return err
+		}
+		stats, err := Push(pachClient.WithCtx(ctx), commit, egressInfo.BaseCommit, sink)
+		if err != nil {
+			return err
+		}
+		egressInfo.FilesPushed = stats.FilesPushed
+		egressInfo.FilesDeleted = stats.FilesDeleted
+		egressInfo.BytesPushed = stats.BytesPushed
+		return nil
+	}()
+	egressInfo.Finished = now()
+	if err != nil {
+		egressInfo.State = pps.EgressState_EGRESS_FAILURE
+		egressInfo.Reason = err.Error()
+	} else {
+		egressInfo.State = pps.EgressState_EGRESS_SUCCESS
+	}
+	return egressInfo
+}
+
+// deletedFiles returns the files in 'oldFiles' that aren't in 'newFiles'.
+// (Files in both have changed, and are overwritten rather than deleted.)
+func deletedFiles(newFiles []*pfs.FileInfo, oldFiles []*pfs.FileInfo) []*pfs.FileInfo {
+	present := make(map[string]bool)
+	for _, fileInfo := range newFiles {
+		present[fileInfo.File.Path] = true
+	}
+	var result []*pfs.FileInfo
+	for _, fileInfo := range oldFiles {
+		if !present[fileInfo.File.Path] {
+			result = append(result, fileInfo)
+		}
+	}
+	return result
+}
+
+// LastEgressed returns the most recently egressed job of 'pipeline' whose
+// output was successfully pushed to 'url', or nil if there isn't one.
+func LastEgressed(jobs col.ReadonlyCollection, pipeline *pps.Pipeline, url string) (*pps.JobInfo, error) {
+	iter, err := jobs.GetByIndex(ppsdb.JobsPipelineIndex, pipeline)
+	if err != nil {
+		return nil, err
+	}
+	var result *pps.JobInfo
+	for {
+		var jobID string
+		jobInfo := &pps.JobInfo{}
+		ok, err := iter.Next(&jobID, jobInfo)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			break
+		}
+		egressInfo := jobInfo.EgressInfo
+		if egressInfo == nil || egressInfo.State != pps.EgressState_EGRESS_SUCCESS || egressInfo.URL != url {
+			continue
+		}
+		if result == nil || egressInfo.Finished.Compare(result.EgressInfo.Finished) > 0 {
+			result = jobInfo
+		}
+	}
+	return result, nil
+}
+
+func now() *types.Timestamp {
+	t, err := types.TimestampProto(time.Now())
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+type countingReader struct {
+	r io.Reader
+	n int64
+}
+
+func (c *countingReader) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	atomic.AddInt64(&c.n, int64(n))
+	return n, err
+}
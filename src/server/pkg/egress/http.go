@@ -0,0 +1,71 @@
+package egress
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"path"
+
+	"golang.org/x/net/context"
+)
+
+// httpSink pushes files to an HTTP endpoint: each new or changed file is
+// sent in a PUT to the URL with the file's path appended, and each deleted
+// file is sent a DELETE.
+type httpSink struct {
+	ctx    context.Context
+	client *http.Client
+	url    url.URL
+}
+
+func newHTTPSink(ctx context.Context, u *url.URL) Sink {
+	return &httpSink{
+		ctx:    ctx,
+		client: http.DefaultClient,
+		url:    *u,
+	}
+}
+
+func (s *httpSink) fileURL(p string) string {
+	u := s.url
+	u.Path = path.Join("/", u.Path, p)
+	return u.String()
+}
+
+func (s *httpSink) do(method string, p string, body io.Reader) error {
+	req, err := http.NewRequest(method, s.fileURL(p), body)
+	if err != nil {
+		return err
+	}
+	resp, err := s.client.Do(req.WithContext(s.ctx))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusNotFound && method == http.MethodDelete {
+		return nil
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		msg, _ := ioutil.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("%s %s: %s: %s", method, s.fileURL(p), resp.Status, msg)
+	}
+	return nil
+}
+
+func (s *httpSink) Put(p string, r io.Reader) error {
+	return s.do(http.MethodPut, p, r)
+}
+
+func (s *httpSink) Delete(p string) error {
+	return s.do(http.MethodDelete, p, nil)
+}
+
+func (s *httpSink) Finish() error {
+	return nil
+}
+
+func (s *httpSink) Abort() error {
+	return nil
+}
@@ -0,0 +1,58 @@
+package egress
+
+import (
+	"io"
+	"path"
+
+	"golang.org/x/net/context"
+
+	"github.com/pachyderm/pachyderm/src/server/pkg/obj"
+)
+
+// objSink pushes files to an object store, as objects named by their paths
+// under the URL's prefix
+type objSink struct {
+	client obj.Client
+	root   string
+}
+
+func newObjSink(ctx context.Context, rawURL string) (Sink, error) {
+	u, err := obj.ParseURL(rawURL)
+	if err != nil {
+		return nil, err
+	}
+	client, err := obj.NewClientFromURLAndSecret(ctx, u)
+	if err != nil {
+		return nil, err
+	}
+	return &objSink{client: client, root: u.Object}, nil
+}
+
+func (s *objSink) Put(p string, r io.Reader) (retErr error) {
+	w, err := s.client.Writer(path.Join(s.root, p))
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := w.Close(); err != nil && retErr == nil {
+			retErr = err
+		}
+	}()
+	_, err = io.Copy(w, r)
+	return err
+}
+
+func (s *objSink) Delete(p string) error {
+	if err := s.client.Delete(path.Join(s.root, p)); err != nil && !s.client.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+func (s *objSink) Finish() error {
+	return nil
+}
+
+func (s *objSink) Abort() error {
+	return nil
+}
@@ -0,0 +1,67 @@
+package egress
+
+import (
+	"fmt"
+	"io"
+	"net/url"
+	"path"
+	"strings"
+
+	"golang.org/x/net/context"
+
+	"github.com/pachyderm/pachyderm/src/client"
+)
+
+// pfsSink pushes files to a branch of a repo in another Pachyderm cluster,
+// given as pfs://host:port/repo/branch[/dir]. Each push is one commit.
+type pfsSink struct {
+	client   *client.APIClient
+	repo     string
+	commitID string
+	root     string
+}
+
+func newPFSSink(ctx context.Context, u *url.URL) (Sink, error) {
+	parts := strings.SplitN(strings.Trim(u.Path, "/"), "/", 3)
+	if u.Host == "" || len(parts) < 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("pfs egress URL must be of the form pfs://host:port/repo/branch, got %v", u)
+	}
+	c, err := client.NewFromAddress(u.Host)
+	if err != nil {
+		return nil, err
+	}
+	s := &pfsSink{
+		client: c.WithCtx(ctx),
+		repo:   parts[0],
+		root:   "/",
+	}
+	if len(parts) == 3 {
+		s.root = "/" + parts[2]
+	}
+	commit, err := s.client.StartCommit(s.repo, parts[1])
+	if err != nil {
+		c.Close()
+		return nil, err
+	}
+	s.commitID = commit.ID
+	return s, nil
+}
+
+func (s *pfsSink) Put(p string, r io.Reader) error {
+	_, err := s.client.PutFileOverwrite(s.repo, s.commitID, path.Join(s.root, p), r, 0)
+	return err
+}
+
+func (s *pfsSink) Delete(p string) error {
+	return s.client.DeleteFile(s.repo, s.commitID, path.Join(s.root, p))
+}
+
+func (s *pfsSink) Finish() error {
+	defer s.client.Close()
+	return s.client.FinishCommit(s.repo, s.commitID)
+}
+
+func (s *pfsSink) Abort() error {
+	defer s.client.Close()
+	return s.client.DeleteCommit(s.repo, s.commitID)
+}
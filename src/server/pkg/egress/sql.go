@@ -0,0 +1,174 @@
+package egress
+
+import (
+	"bufio"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+// identifierRegex matches the table names that sqlSink accepts, which are
+// interpolated into its statements
+var identifierRegex = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*(\.[A-Za-z_][A-Za-z0-9_]*)?$`)
+
+// sqlSink loads files into an SQL table with the columns (path, line, data),
+// in which each row is a line of a file or, if the format is JSON, a JSON
+// value in it. A push is one transaction.
+//
+// The database driver (lib/pq for postgres://, go-sql-driver/mysql for
+// mysql://) must be linked into the binary doing the egress; pachd and the
+// worker link both.
+type sqlSink struct {
+	mu          sync.Mutex
+	db          *sql.DB
+	tx          *sql.Tx
+	table       string
+	json        bool
+	placeholder func(n int) string
+}
+
+func newSQLSink(u *url.URL) (Sink, error) {
+	query := u.Query()
+	table := query.Get("table")
+	if !identifierRegex.MatchString(table) {
+		return nil, fmt.Errorf("SQL egress URL must have a \"table\" parameter that's a valid table name, got %q", table)
+	}
+	s := &sqlSink{table: table}
+	switch query.Get("format") {
+	case "", "lines":
+	case "json":
+		s.json = true
+	default:
+		return nil, fmt.Errorf("SQL egress format must be \"lines\" or \"json\", got %q", query.Get("format"))
+	}
+	query.Del("table")
+	query.Del("format")
+	driver, dsn := sqlDSN(u, query)
+	if driver == "postgres" {
+		s.placeholder = func(n int) string { return fmt.Sprintf("$%d", n) }
+	} else {
+		s.placeholder = func(n int) string { return "?" }
+	}
+	if !driverRegistered(driver) {
+		return nil, fmt.Errorf("SQL egress to %s:// is unavailable, as the %q database driver isn't linked into this binary", u.Scheme, driver)
+	}
+	db, err := sql.Open(driver, dsn)
+	if err != nil {
+		return nil, err
+	}
+	if s.tx, err = db.Begin(); err != nil {
+		db.Close()
+		return nil, err
+	}
+	s.db = db
+	return s, nil
+}
+
+// sqlDSN returns the database/sql driver name and data source name for the
+// SQL egress URL 'u', with the query parameters 'query'
+func sqlDSN(u *url.URL, query url.Values) (string, string) {
+	if u.Scheme == "mysql" {
+		// go-sql-driver/mysql uses user:password@tcp(host:port)/db?params
+		var dsn string
+		if u.User != nil {
+			dsn = u.User.String() + "@"
+		}
+		dsn += fmt.Sprintf("tcp(%s)/%s", u.Host, strings.TrimPrefix(u.Path, "/"))
+		if len(query) > 0 {
+			dsn += "?" + query.Encode()
+		}
+		return "mysql", dsn
+	}
+	// lib/pq accepts URLs
+	dsnURL := *u
+	dsnURL.RawQuery = query.Encode()
+	return "postgres", dsnURL.String()
+}
+
+func driverRegistered(driver string) bool {
+	for _, d := range sql.Drivers() {
+		if d == driver {
+			return true
+		}
+	}
+	return false
+}
+
+// rows splits the contents of 'r' into the values of the data column
+func (s *sqlSink) rows(r io.Reader) ([]string, error) {
+	var result []string
+	if s.json {
+		decoder := json.NewDecoder(r)
+		for {
+			var value json.RawMessage
+			if err := decoder.Decode(&value); err == io.EOF {
+				return result, nil
+			} else if err != nil {
+				return nil, err
+			}
+			result = append(result, string(value))
+		}
+	}
+	reader := bufio.NewReader(r)
+	for {
+		line, err := reader.ReadString('\n')
+		if line != "" {
+			result = append(result, strings.TrimSuffix(line, "\n"))
+		}
+		if err == io.EOF {
+			return result, nil
+		} else if err != nil {
+			return nil, err
+		}
+	}
+}
+
+func (s *sqlSink) Put(path string, r io.Reader) error {
+	rows, err := s.rows(r)
+	if err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.deleteLocked(path); err != nil {
+		return err
+	}
+	stmt, err := s.tx.Prepare(fmt.Sprintf("INSERT INTO %s (path, line, data) VALUES (%s, %s, %s)",
+		s.table, s.placeholder(1), s.placeholder(2), s.placeholder(3)))
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
+	for i, row := range rows {
+		if _, err := stmt.Exec(path, i, row); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *sqlSink) Delete(path string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.deleteLocked(path)
+}
+
+func (s *sqlSink) deleteLocked(path string) error {
+	_, err := s.tx.Exec(fmt.Sprintf("DELETE FROM %s WHERE path = %s", s.table, s.placeholder(1)), path)
+	return err
+}
+
+func (s *sqlSink) Finish() error {
+	defer s.db.Close()
+	return s.tx.Commit()
+}
+
+func (s *sqlSink) Abort() error {
+	defer s.db.Close()
+	return s.tx.Rollback()
+}
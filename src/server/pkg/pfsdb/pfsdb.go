@@ -16,12 +16,19 @@ const (
 	repoRefCountsPrefix = "/repoRefCounts"
 	commitsPrefix       = "/commits"
 	branchesPrefix      = "/branches"
+	branchHistoryPrefix = "/branchHistory"
 	openCommitsPrefix   = "/openCommits"
+	quotasPrefix        = "/quotas"
+	transactionsPrefix  = "/transactions"
 )
 
 var (
 	// ProvenanceIndex is a secondary index on provenance
 	ProvenanceIndex = col.Index{"Provenance", true}
+
+	// ReposCreatedByIndex is a secondary index on the principals who created
+	// repos
+	ReposCreatedByIndex = col.Index{"CreatedBy", false}
 )
 
 // Repos returns a collection of repos
@@ -29,7 +36,7 @@ func Repos(etcdClient *etcd.Client, etcdPrefix string) col.Collection {
 	return col.NewCollection(
 		etcdClient,
 		path.Join(etcdPrefix, reposPrefix),
-		[]col.Index{ProvenanceIndex},
+		[]col.Index{ProvenanceIndex, ReposCreatedByIndex},
 		&pfs.RepoInfo{},
 		nil,
 	)
@@ -73,6 +80,18 @@ func Branches(etcdClient *etcd.Client, etcdPrefix string, repo string) col.Colle
 	)
 }
 
+// BranchHistories returns a collection of the histories of branches, keyed by
+// branch name
+func BranchHistories(etcdClient *etcd.Client, etcdPrefix string, repo string) col.Collection {
+	return col.NewCollection(
+		etcdClient,
+		path.Join(etcdPrefix, branchHistoryPrefix, repo),
+		nil,
+		&pfs.BranchHistory{},
+		nil,
+	)
+}
+
 // OpenCommits returns a collection of open commits
 func OpenCommits(etcdClient *etcd.Client, etcdPrefix string) col.Collection {
 	return col.NewCollection(
@@ -83,3 +102,25 @@ func OpenCommits(etcdClient *etcd.Client, etcdPrefix string) col.Collection {
 		nil,
 	)
 }
+
+// Quotas returns a collection of the quotas of principals, keyed by principal
+func Quotas(etcdClient *etcd.Client, etcdPrefix string) col.Collection {
+	return col.NewCollection(
+		etcdClient,
+		path.Join(etcdPrefix, quotasPrefix),
+		nil,
+		&pfs.Quota{},
+		nil,
+	)
+}
+
+// Transactions returns a collection of open transactions
+func Transactions(etcdClient *etcd.Client, etcdPrefix string) col.Collection {
+	return col.NewCollection(
+		etcdClient,
+		path.Join(etcdPrefix, transactionsPrefix),
+		nil,
+		&pfs.TransactionInfo{},
+		nil,
+	)
+}
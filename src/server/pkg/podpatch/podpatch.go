@@ -0,0 +1,423 @@
+// Package podpatch applies user-supplied patches to the JSON form of
+// Kubernetes objects, such as the pod spec of a pipeline's workers. A patch
+// is either an RFC 6902 JSON patch (a JSON array of operations) or a
+// strategic merge patch (a JSON object), as accepted by kubectl.
+package podpatch
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// mergeKeys gives, for each list field that's merged rather than replaced
+// by a strategic merge patch, the field that identifies its elements. These
+// follow the patchMergeKey tags of the Kubernetes API types.
+var mergeKeys = map[string]string{
+	"containers":       "name",
+	"initContainers":   "name",
+	"volumes":          "name",
+	"env":              "name",
+	"imagePullSecrets": "name",
+	"volumeMounts":     "mountPath",
+	"ports":            "containerPort",
+}
+
+// directive is the key that strategic merge patches use for instructions,
+// such as {"name": "foo", "$patch": "delete"} to delete the element "foo"
+// from a merged list
+const directive = "$patch"
+
+// Apply returns the result of applying 'patch' to the JSON document
+// 'original'. If 'patch' is a JSON array, it's applied as a JSON patch;
+// if it's a JSON object, it's applied as a strategic merge patch.
+func Apply(original []byte, patch string) ([]byte, error) {
+	var doc interface{}
+	if err := json.Unmarshal(original, &doc); err != nil {
+		return nil, err
+	}
+	var p interface{}
+	if err := json.Unmarshal([]byte(patch), &p); err != nil {
+		return nil, fmt.Errorf("patch is not valid JSON: %v", err)
+	}
+	var err error
+	switch p := p.(type) {
+	case []interface{}:
+		doc, err = applyJSONPatch(doc, p)
+	case map[string]interface{}:
+		doc, err = strategicMerge(doc, p)
+	default:
+		return nil, fmt.Errorf("patch must be a JSON array (a JSON patch) or a JSON object (a strategic merge patch)")
+	}
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(doc)
+}
+
+// Validate returns an error if 'patch' isn't a well-formed patch. It doesn't
+// check that the patch applies to any particular document.
+func Validate(patch string) error {
+	var p interface{}
+	if err := json.Unmarshal([]byte(patch), &p); err != nil {
+		return fmt.Errorf("patch is not valid JSON: %v", err)
+	}
+	switch p := p.(type) {
+	case []interface{}:
+		for i, op := range p {
+			if _, err := parseOperation(op); err != nil {
+				return fmt.Errorf("operation %d: %v", i, err)
+			}
+		}
+		return nil
+	case map[string]interface{}:
+		return nil
+	}
+	return fmt.Errorf("patch must be a JSON array (a JSON patch) or a JSON object (a strategic merge patch)")
+}
+
+// strategicMerge merges 'patch' into 'doc'. Fields in 'patch' replace those
+// in 'doc', except that objects are merged recursively, lists in mergeKeys
+// are merged element by element, and null fields are deleted.
+func strategicMerge(doc interface{}, patch map[string]interface{}) (interface{}, error) {
+	docMap, ok := doc.(map[string]interface{})
+	if !ok || docMap == nil {
+		docMap = make(map[string]interface{})
+	}
+	for key, patchValue := range patch {
+		if key == directive {
+			continue
+		}
+		if patchValue == nil {
+			delete(docMap, key)
+			continue
+		}
+		switch patchValue := patchValue.(type) {
+		case map[string]interface{}:
+			merged, err := strategicMerge(docMap[key], patchValue)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %v", key, err)
+			}
+			docMap[key] = merged
+		case []interface{}:
+			mergeKey, ok := mergeKeys[key]
+			if !ok {
+				docMap[key] = patchValue
+				continue
+			}
+			docList, _ := docMap[key].([]interface{})
+			merged, err := mergeList(docList, patchValue, mergeKey)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %v", key, err)
+			}
+			docMap[key] = merged
+		default:
+			docMap[key] = patchValue
+		}
+	}
+	return docMap, nil
+}
+
+// mergeList merges the elements of 'patch' into 'doc', matching them by the
+// field 'mergeKey'. Matching elements are merged, new elements are appended,
+// and elements with the directive "delete" are removed.
+func mergeList(doc []interface{}, patch []interface{}, mergeKey string) ([]interface{}, error) {
+	result := append([]interface{}{}, doc...)
+	for _, patchElement := range patch {
+		patchMap, ok := patchElement.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("expected objects with the field %q, got %v", mergeKey, patchElement)
+		}
+		key, ok := patchMap[mergeKey]
+		if !ok {
+			return nil, fmt.Errorf("element %v is missing the field %q", patchElement, mergeKey)
+		}
+		i := indexOf(result, mergeKey, key)
+		switch patchMap[directive] {
+		case nil:
+		case "delete":
+			if i >= 0 {
+				result = append(result[:i], result[i+1:]...)
+			}
+			continue
+		default:
+			return nil, fmt.Errorf("unsupported %s directive %v", directive, patchMap[directive])
+		}
+		if i < 0 {
+			merged, err := strategicMerge(nil, patchMap)
+			if err != nil {
+				return nil, err
+			}
+			result = append(result, merged)
+			continue
+		}
+		merged, err := strategicMerge(result[i], patchMap)
+		if err != nil {
+			return nil, err
+		}
+		result[i] = merged
+	}
+	return result, nil
+}
+
+func indexOf(list []interface{}, mergeKey string, key interface{}) int {
+	for i, element := range list {
+		if m, ok := element.(map[string]interface{}); ok && reflect.DeepEqual(m[mergeKey], key) {
+			return i
+		}
+	}
+	return -1
+}
+
+// operation is one operation of a JSON patch
+type operation struct {
+	op    string
+	path  []string
+	from  []string
+	value interface{}
+}
+
+func parseOperation(raw interface{}) (*operation, error) {
+	m, ok := raw.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("expected an object, got %v", raw)
+	}
+	o := &operation{}
+	if o.op, ok = m["op"].(string); !ok {
+		return nil, fmt.Errorf("missing \"op\"")
+	}
+	p, ok := m["path"].(string)
+	if !ok {
+		return nil, fmt.Errorf("missing \"path\"")
+	}
+	var err error
+	if o.path, err = parsePointer(p); err != nil {
+		return nil, err
+	}
+	switch o.op {
+	case "add", "replace", "test":
+		if o.value, ok = m["value"]; !ok {
+			return nil, fmt.Errorf("%q is missing \"value\"", o.op)
+		}
+	case "remove":
+	case "move", "copy":
+		from, ok := m["from"].(string)
+		if !ok {
+			return nil, fmt.Errorf("%q is missing \"from\"", o.op)
+		}
+		if o.from, err = parsePointer(from); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("unknown op %q", o.op)
+	}
+	return o, nil
+}
+
+// parsePointer splits an RFC 6901 JSON pointer into its unescaped tokens
+func parsePointer(pointer string) ([]string, error) {
+	if pointer == "" {
+		return nil, nil
+	}
+	if !strings.HasPrefix(pointer, "/") {
+		return nil, fmt.Errorf("invalid JSON pointer %q", pointer)
+	}
+	tokens := strings.Split(pointer[1:], "/")
+	for i, token := range tokens {
+		tokens[i] = strings.Replace(strings.Replace(token, "~1", "/", -1), "~0", "~", -1)
+	}
+	return tokens, nil
+}
+
+func applyJSONPatch(doc interface{}, patch []interface{}) (interface{}, error) {
+	for i, raw := range patch {
+		o, err := parseOperation(raw)
+		if err != nil {
+			return nil, fmt.Errorf("operation %d: %v", i, err)
+		}
+		if doc, err = applyOperation(doc, o); err != nil {
+			return nil, fmt.Errorf("operation %d (%s /%s): %v", i, o.op, strings.Join(o.path, "/"), err)
+		}
+	}
+	return doc, nil
+}
+
+func applyOperation(doc interface{}, o *operation) (interface{}, error) {
+	switch o.op {
+	case "add":
+		return add(doc, o.path, o.value)
+	case "remove":
+		doc, _, err := remove(doc, o.path)
+		return doc, err
+	case "replace":
+		doc, _, err := remove(doc, o.path)
+		if err != nil {
+			return nil, err
+		}
+		return add(doc, o.path, o.value)
+	case "move":
+		doc, value, err := remove(doc, o.from)
+		if err != nil {
+			return nil, err
+		}
+		return add(doc, o.path, value)
+	case "copy":
+		value, err := get(doc, o.from)
+		if err != nil {
+			return nil, err
+		}
+		return add(doc, o.path, deepCopy(value))
+	case "test":
+		value, err := get(doc, o.path)
+		if err != nil {
+			return nil, err
+		}
+		if !reflect.DeepEqual(value, o.value) {
+			return nil, fmt.Errorf("test failed: value is %v, not %v", value, o.value)
+		}
+		return doc, nil
+	}
+	return nil, fmt.Errorf("unknown op %q", o.op)
+}
+
+func get(doc interface{}, path []string) (interface{}, error) {
+	for _, token := range path {
+		switch d := doc.(type) {
+		case map[string]interface{}:
+			value, ok := d[token]
+			if !ok {
+				return nil, fmt.Errorf("no field %q", token)
+			}
+			doc = value
+		case []interface{}:
+			i, err := index(token, len(d), false)
+			if err != nil {
+				return nil, err
+			}
+			doc = d[i]
+		default:
+			return nil, fmt.Errorf("can't look up %q in %v", token, doc)
+		}
+	}
+	return doc, nil
+}
+
+// add sets 'path' in 'doc' to 'value', inserting it if 'path' is in a list,
+// and returns the new document
+func add(doc interface{}, path []string, value interface{}) (interface{}, error) {
+	if len(path) == 0 {
+		return value, nil
+	}
+	parent, err := get(doc, path[:len(path)-1])
+	if err != nil {
+		return nil, err
+	}
+	token := path[len(path)-1]
+	switch p := parent.(type) {
+	case map[string]interface{}:
+		p[token] = value
+		return doc, nil
+	case []interface{}:
+		i, err := index(token, len(p), true)
+		if err != nil {
+			return nil, err
+		}
+		list := append(p[:i:i], append([]interface{}{value}, p[i:]...)...)
+		return set(doc, path[:len(path)-1], list)
+	}
+	return nil, fmt.Errorf("can't add %q to %v", token, parent)
+}
+
+// set replaces the existing value at 'path' in 'doc' with 'value', and
+// returns the new document
+func set(doc interface{}, path []string, value interface{}) (interface{}, error) {
+	if len(path) == 0 {
+		return value, nil
+	}
+	parent, err := get(doc, path[:len(path)-1])
+	if err != nil {
+		return nil, err
+	}
+	token := path[len(path)-1]
+	switch p := parent.(type) {
+	case map[string]interface{}:
+		p[token] = value
+		return doc, nil
+	case []interface{}:
+		i, err := index(token, len(p), false)
+		if err != nil {
+			return nil, err
+		}
+		p[i] = value
+		return doc, nil
+	}
+	return nil, fmt.Errorf("can't set %q in %v", token, parent)
+}
+
+// remove deletes 'path' from 'doc', returning the new document and the
+// removed value
+func remove(doc interface{}, path []string) (interface{}, interface{}, error) {
+	if len(path) == 0 {
+		return nil, doc, nil
+	}
+	parent, err := get(doc, path[:len(path)-1])
+	if err != nil {
+		return nil, nil, err
+	}
+	token := path[len(path)-1]
+	switch p := parent.(type) {
+	case map[string]interface{}:
+		value, ok := p[token]
+		if !ok {
+			return nil, nil, fmt.Errorf("no field %q", token)
+		}
+		delete(p, token)
+		return doc, value, nil
+	case []interface{}:
+		i, err := index(token, len(p), false)
+		if err != nil {
+			return nil, nil, err
+		}
+		value := p[i]
+		list := append(p[:i:i], p[i+1:]...)
+		doc, err := set(doc, path[:len(path)-1], list)
+		if err != nil {
+			return nil, nil, err
+		}
+		return doc, value, nil
+	}
+	return nil, nil, fmt.Errorf("can't remove %q from %v", token, parent)
+}
+
+// index parses the list index 'token' for a list of length 'n'. If
+// 'insert' is true, the index may be n, or "-", which both mean the end.
+func index(token string, n int, insert bool) (int, error) {
+	if token == "-" && insert {
+		return n, nil
+	}
+	i, err := strconv.Atoi(token)
+	if err != nil || i < 0 || i > n || (i == n && !insert) {
+		return 0, fmt.Errorf("invalid index %q for a list of length %d", token, n)
+	}
+	return i, nil
+}
+
+func deepCopy(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		result := make(map[string]interface{}, len(v))
+		for key, value := range v {
+			result[key] = deepCopy(value)
+		}
+		return result
+	case []interface{}:
+		result := make([]interface{}, len(v))
+		for i, value := range v {
+			result[i] = deepCopy(value)
+		}
+		return result
+	}
+	return value
+}
@@ -0,0 +1,113 @@
+package podpatch
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/pachyderm/pachyderm/src/client/pkg/require"
+)
+
+const pod = `{
+	"containers": [
+		{"name": "user", "image": "ubuntu", "env": [{"name": "A", "value": "1"}]},
+		{"name": "storage", "image": "pachd"}
+	],
+	"volumes": [{"name": "pach-bin", "emptyDir": {}}],
+	"nodeSelector": {"zone": "a"}
+}`
+
+// requireJSONEqual checks that 'expected' and 'actual' are the same JSON
+// value, ignoring formatting and field order
+func requireJSONEqual(t *testing.T, expected string, actual []byte) {
+	var e, a interface{}
+	require.NoError(t, json.Unmarshal([]byte(expected), &e))
+	require.NoError(t, json.Unmarshal(actual, &a))
+	require.Equal(t, e, a)
+}
+
+func TestStrategicMerge(t *testing.T) {
+	result, err := Apply([]byte(pod), `{
+		"containers": [
+			{"name": "user", "env": [{"name": "B", "value": "2"}], "resources": {"limits": {"memory": "1G"}}},
+			{"name": "proxy", "image": "envoy"}
+		],
+		"volumes": [{"name": "shm", "emptyDir": {"medium": "Memory"}}],
+		"nodeSelector": {"zone": null, "disk": "ssd"},
+		"dnsPolicy": "Default"
+	}`)
+	require.NoError(t, err)
+	requireJSONEqual(t, `{
+		"containers": [
+			{"name": "user", "image": "ubuntu", "env": [{"name": "A", "value": "1"}, {"name": "B", "value": "2"}], "resources": {"limits": {"memory": "1G"}}},
+			{"name": "storage", "image": "pachd"},
+			{"name": "proxy", "image": "envoy"}
+		],
+		"volumes": [{"name": "pach-bin", "emptyDir": {}}, {"name": "shm", "emptyDir": {"medium": "Memory"}}],
+		"nodeSelector": {"disk": "ssd"},
+		"dnsPolicy": "Default"
+	}`, result)
+}
+
+func TestStrategicMergeDelete(t *testing.T) {
+	result, err := Apply([]byte(pod), `{"volumes": [{"name": "pach-bin", "$patch": "delete"}]}`)
+	require.NoError(t, err)
+	requireJSONEqual(t, `{
+		"containers": [
+			{"name": "user", "image": "ubuntu", "env": [{"name": "A", "value": "1"}]},
+			{"name": "storage", "image": "pachd"}
+		],
+		"volumes": [],
+		"nodeSelector": {"zone": "a"}
+	}`, result)
+
+	_, err = Apply([]byte(pod), `{"volumes": [{"emptyDir": {}}]}`)
+	require.YesError(t, err)
+	_, err = Apply([]byte(pod), `{"volumes": [{"name": "x", "$patch": "replace"}]}`)
+	require.YesError(t, err)
+}
+
+func TestJSONPatch(t *testing.T) {
+	result, err := Apply([]byte(pod), `[
+		{"op": "test", "path": "/containers/0/name", "value": "user"},
+		{"op": "add", "path": "/containers/0/env/-", "value": {"name": "B", "value": "2"}},
+		{"op": "add", "path": "/containers/1", "value": {"name": "proxy"}},
+		{"op": "replace", "path": "/containers/2/image", "value": "pachd:1"},
+		{"op": "copy", "from": "/nodeSelector", "path": "/labels"},
+		{"op": "move", "from": "/labels/zone", "path": "/labels/region"},
+		{"op": "remove", "path": "/volumes/0"}
+	]`)
+	require.NoError(t, err)
+	requireJSONEqual(t, `{
+		"containers": [
+			{"name": "user", "image": "ubuntu", "env": [{"name": "A", "value": "1"}, {"name": "B", "value": "2"}]},
+			{"name": "proxy"},
+			{"name": "storage", "image": "pachd:1"}
+		],
+		"volumes": [],
+		"nodeSelector": {"zone": "a"},
+		"labels": {"region": "a"}
+	}`, result)
+}
+
+func TestJSONPatchErrors(t *testing.T) {
+	for _, patch := range []string{
+		`[{"op": "test", "path": "/containers/0/name", "value": "storage"}]`,
+		`[{"op": "remove", "path": "/missing"}]`,
+		`[{"op": "replace", "path": "/containers/5", "value": {}}]`,
+		`[{"op": "add", "path": "/containers/x", "value": {}}]`,
+	} {
+		_, err := Apply([]byte(pod), patch)
+		require.YesError(t, err, patch)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	require.NoError(t, Validate(`{"nodeSelector": {"disk": "ssd"}}`))
+	require.NoError(t, Validate(`[{"op": "remove", "path": "/a~1b"}]`))
+	require.YesError(t, Validate(`{`))
+	require.YesError(t, Validate(`"string"`))
+	require.YesError(t, Validate(`[{"op": "frobnicate", "path": "/"}]`))
+	require.YesError(t, Validate(`[{"op": "add", "path": "/a"}]`))
+	require.YesError(t, Validate(`[{"op": "move", "path": "/a"}]`))
+	require.YesError(t, Validate(`[{"op": "remove", "path": "a"}]`))
+}
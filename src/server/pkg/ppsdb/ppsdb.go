@@ -11,8 +11,16 @@ import (
 )
 
 const (
-	pipelinesPrefix = "/pipelines"
-	jobsPrefix      = "/jobs"
+	pipelinesPrefix        = "/pipelines"
+	pipelineVersionsPrefix = "/pipeline_versions"
+	jobsPrefix             = "/jobs"
+	webhooksPrefix         = "/webhooks"
+	webhookDeliveryPrefix  = "/webhook_deliveries"
+	admissionPolicyPrefix  = "/admission_policy"
+
+	// AdmissionPolicyKey is the key of the cluster's admission policy in
+	// the AdmissionPolicy collection
+	AdmissionPolicyKey = "policy"
 )
 
 var (
@@ -43,6 +51,18 @@ func Pipelines(etcdClient *etcd.Client, etcdPrefix string) col.Collection {
 	)
 }
 
+// PipelineVersions returns a Collection of the past and present versions of
+// a pipeline's spec, keyed by version number
+func PipelineVersions(etcdClient *etcd.Client, etcdPrefix string, pipeline string) col.Collection {
+	return col.NewCollection(
+		etcdClient,
+		path.Join(etcdPrefix, pipelineVersionsPrefix, pipeline),
+		nil,
+		&pps.PipelineInfo{},
+		nil,
+	)
+}
+
 // Jobs returns a Collection of jobs
 func Jobs(etcdClient *etcd.Client, etcdPrefix string) col.Collection {
 	return col.NewCollection(
@@ -53,3 +73,37 @@ func Jobs(etcdClient *etcd.Client, etcdPrefix string) col.Collection {
 		nil,
 	)
 }
+
+// Webhooks returns a Collection of webhooks
+func Webhooks(etcdClient *etcd.Client, etcdPrefix string) col.Collection {
+	return col.NewCollection(
+		etcdClient,
+		path.Join(etcdPrefix, webhooksPrefix),
+		nil,
+		&pps.WebhookInfo{},
+		nil,
+	)
+}
+
+// WebhookDeliveries returns a Collection of pending webhook deliveries
+func WebhookDeliveries(etcdClient *etcd.Client, etcdPrefix string) col.Collection {
+	return col.NewCollection(
+		etcdClient,
+		path.Join(etcdPrefix, webhookDeliveryPrefix),
+		nil,
+		&pps.WebhookDelivery{},
+		nil,
+	)
+}
+
+// AdmissionPolicy returns a Collection that holds the cluster's admission
+// policy, under AdmissionPolicyKey
+func AdmissionPolicy(etcdClient *etcd.Client, etcdPrefix string) col.Collection {
+	return col.NewCollection(
+		etcdClient,
+		path.Join(etcdPrefix, admissionPolicyPrefix),
+		nil,
+		&pps.AdmissionPolicy{},
+		nil,
+	)
+}
@@ -0,0 +1,45 @@
+package prom
+
+import (
+	"time"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+)
+
+var (
+	rpcRequests = NewCounterVec(
+		"pachyderm_grpc_requests_total",
+		"Number of gRPC requests handled, by method and status code.",
+		"method", "code",
+	)
+	rpcDuration = NewHistogramVec(
+		"pachyderm_grpc_request_duration_seconds",
+		"Time taken to handle gRPC requests, by method. For streaming methods this is the lifetime of the stream.",
+		DefBuckets,
+		"method",
+	)
+)
+
+// UnaryServerInterceptor records the latency and status code of every unary
+// RPC served by a grpc.Server that it's installed on.
+func UnaryServerInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	start := time.Now()
+	resp, err := handler(ctx, req)
+	observeRPC(info.FullMethod, start, err)
+	return resp, err
+}
+
+// StreamServerInterceptor records the latency and status code of every
+// streaming RPC served by a grpc.Server that it's installed on.
+func StreamServerInterceptor(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	start := time.Now()
+	err := handler(srv, stream)
+	observeRPC(info.FullMethod, start, err)
+	return err
+}
+
+func observeRPC(method string, start time.Time, err error) {
+	rpcRequests.WithLabelValues(method, grpc.Code(err).String()).Inc()
+	rpcDuration.WithLabelValues(method).Observe(time.Since(start).Seconds())
+}
@@ -0,0 +1,22 @@
+package prom
+
+import (
+	"io"
+)
+
+// CountingReader returns a reader that reads from 'r' and adds the number of
+// bytes read to 'c'.
+func CountingReader(r io.Reader, c *Counter) io.Reader {
+	return &countingReader{r: r, c: c}
+}
+
+type countingReader struct {
+	r io.Reader
+	c *Counter
+}
+
+func (r *countingReader) Read(p []byte) (int, error) {
+	n, err := r.r.Read(p)
+	r.c.Add(float64(n))
+	return n, err
+}
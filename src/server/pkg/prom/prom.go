@@ -0,0 +1,443 @@
+// Package prom exposes a process's metrics over HTTP in the Prometheus text
+// exposition format, so that pachd and workers can be scraped by Prometheus
+// (or anything else that speaks the format). It supports the three metric
+// types we need: counters, gauges and histograms, each with a fixed set of
+// labels.
+//
+// Unlike src/server/pkg/metrics, which reports usage data to Segment, nothing
+// in this package leaves the cluster unless it is scraped.
+package prom
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
+const (
+	typeCounter   = "counter"
+	typeGauge     = "gauge"
+	typeHistogram = "histogram"
+
+	// labelSep separates label values in a series key. It can't appear in
+	// valid UTF-8, so keys never collide.
+	labelSep = "\xff"
+)
+
+var (
+	// DefBuckets are the default histogram buckets. They're tailored to
+	// latencies measured in seconds, from 5ms up to 10s.
+	DefBuckets = []float64{.005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10}
+
+	// DefaultRegistry is the registry served by Handler, and the one the
+	// package-level constructors register metrics with.
+	DefaultRegistry = NewRegistry()
+
+	helpReplacer       = strings.NewReplacer(`\`, `\\`, "\n", `\n`)
+	labelValueReplacer = strings.NewReplacer(`\`, `\\`, "\n", `\n`, `"`, `\"`)
+)
+
+// ExponentialBuckets returns 'count' histogram buckets, the first of which is
+// 'start' and each subsequent one 'factor' times larger than the last.
+func ExponentialBuckets(start, factor float64, count int) []float64 {
+	buckets := make([]float64, count)
+	for i := range buckets {
+		buckets[i] = start
+		start *= factor
+	}
+	return buckets
+}
+
+// Registry holds a set of metric families, and writes them out in the
+// Prometheus text format.
+type Registry struct {
+	mu       sync.Mutex
+	families map[string]*family
+}
+
+// NewRegistry creates an empty Registry.
+func NewRegistry() *Registry {
+	return &Registry{families: make(map[string]*family)}
+}
+
+// Handler returns an http.Handler that serves the metrics in
+// DefaultRegistry.
+func Handler() http.Handler {
+	return DefaultRegistry.Handler()
+}
+
+// Handler returns an http.Handler that serves the metrics in 'r'.
+func (r *Registry) Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("Content-Type", "text/plain; version=0.0.4")
+		if err := r.Write(w); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+	})
+}
+
+// Write writes every metric in 'r' to 'w' in the Prometheus text format.
+// Families are written in name order, and series within a family in label
+// order, so the output is stable.
+func (r *Registry) Write(w io.Writer) error {
+	r.mu.Lock()
+	var families []*family
+	for _, f := range r.families {
+		families = append(families, f)
+	}
+	r.mu.Unlock()
+	sort.Slice(families, func(i, j int) bool { return families[i].name < families[j].name })
+	bw := bufio.NewWriter(w)
+	for _, f := range families {
+		f.write(bw)
+	}
+	return bw.Flush()
+}
+
+// family returns the family called 'name', creating it if it doesn't exist.
+// Registering the same family twice returns the original, so that packages
+// which are instantiated more than once in a process (e.g. in tests) can
+// declare their metrics unconditionally. It panics if the two registrations
+// disagree, as that's a programming error.
+func (r *Registry) family(name string, help string, typ string, buckets []float64, labelNames []string) *family {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if f, ok := r.families[name]; ok {
+		if f.typ != typ || strings.Join(f.labelNames, ",") != strings.Join(labelNames, ",") {
+			panic(fmt.Sprintf("metric %s registered twice with different types or labels", name))
+		}
+		return f
+	}
+	f := &family{
+		name:       name,
+		help:       help,
+		typ:        typ,
+		buckets:    buckets,
+		labelNames: labelNames,
+		series:     make(map[string]series),
+		labels:     make(map[string]string),
+	}
+	r.families[name] = f
+	return f
+}
+
+// series is a single labelled time series (or, for histograms, a group of
+// them) within a family.
+type series interface {
+	write(w *bufio.Writer, name string, labels string)
+}
+
+type family struct {
+	name       string
+	help       string
+	typ        string
+	buckets    []float64
+	labelNames []string
+
+	mu     sync.Mutex
+	series map[string]series
+	labels map[string]string // series key -> formatted labels
+}
+
+// get returns the series for 'labelValues', using 'newSeries' to create it if
+// it doesn't exist yet.
+func (f *family) get(labelValues []string, newSeries func() series) series {
+	if len(labelValues) != len(f.labelNames) {
+		panic(fmt.Sprintf("metric %s has %d labels but got %d values", f.name, len(f.labelNames), len(labelValues)))
+	}
+	key := strings.Join(labelValues, labelSep)
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if s, ok := f.series[key]; ok {
+		return s
+	}
+	s := newSeries()
+	f.series[key] = s
+	f.labels[key] = formatLabels(f.labelNames, labelValues)
+	return s
+}
+
+// setFunc makes the series for 'labelValues' report the result of 'fn',
+// replacing any series already there.
+func (f *family) setFunc(labelValues []string, fn func() float64) {
+	if len(labelValues) != len(f.labelNames) {
+		panic(fmt.Sprintf("metric %s has %d labels but got %d values", f.name, len(f.labelNames), len(labelValues)))
+	}
+	key := strings.Join(labelValues, labelSep)
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.series[key] = valueFunc(fn)
+	f.labels[key] = formatLabels(f.labelNames, labelValues)
+}
+
+func (f *family) write(w *bufio.Writer) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	fmt.Fprintf(w, "# HELP %s %s\n", f.name, escapeHelp(f.help))
+	fmt.Fprintf(w, "# TYPE %s %s\n", f.name, f.typ)
+	var keys []string
+	for key := range f.series {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		f.series[key].write(w, f.name, f.labels[key])
+	}
+}
+
+// CounterVec is a family of counters, partitioned by label values.
+type CounterVec struct {
+	f *family
+}
+
+// NewCounterVec registers a counter family with DefaultRegistry.
+func NewCounterVec(name string, help string, labelNames ...string) *CounterVec {
+	return DefaultRegistry.NewCounterVec(name, help, labelNames...)
+}
+
+// NewCounterVec registers a counter family with 'r'.
+func (r *Registry) NewCounterVec(name string, help string, labelNames ...string) *CounterVec {
+	return &CounterVec{r.family(name, help, typeCounter, nil, labelNames)}
+}
+
+// NewCounter registers an unlabelled counter with DefaultRegistry.
+func NewCounter(name string, help string) *Counter {
+	return NewCounterVec(name, help).WithLabelValues()
+}
+
+// WithLabelValues returns the counter for 'labelValues', which must be given
+// in the same order as the family's label names.
+func (v *CounterVec) WithLabelValues(labelValues ...string) *Counter {
+	return v.f.get(labelValues, func() series { return &Counter{} }).(*Counter)
+}
+
+// Func makes the series for 'labelValues' report the result of 'fn' whenever
+// it's scraped. It's for values that are already counted elsewhere (e.g. in
+// a library's own stats) and so can't be incremented directly. A later call
+// with the same label values replaces the earlier function.
+func (v *CounterVec) Func(fn func() float64, labelValues ...string) {
+	v.f.setFunc(labelValues, fn)
+}
+
+// Counter is a value that only goes up.
+type Counter struct {
+	v atomicFloat
+}
+
+// Inc adds one to the counter.
+func (c *Counter) Inc() {
+	c.v.add(1)
+}
+
+// Add adds 'delta', which must not be negative, to the counter.
+func (c *Counter) Add(delta float64) {
+	if delta < 0 {
+		panic("counter cannot decrease in value")
+	}
+	c.v.add(delta)
+}
+
+func (c *Counter) write(w *bufio.Writer, name string, labels string) {
+	writeSample(w, name, labels, c.v.load())
+}
+
+// GaugeVec is a family of gauges, partitioned by label values.
+type GaugeVec struct {
+	f *family
+}
+
+// NewGaugeVec registers a gauge family with DefaultRegistry.
+func NewGaugeVec(name string, help string, labelNames ...string) *GaugeVec {
+	return DefaultRegistry.NewGaugeVec(name, help, labelNames...)
+}
+
+// NewGaugeVec registers a gauge family with 'r'.
+func (r *Registry) NewGaugeVec(name string, help string, labelNames ...string) *GaugeVec {
+	return &GaugeVec{r.family(name, help, typeGauge, nil, labelNames)}
+}
+
+// WithLabelValues returns the gauge for 'labelValues', which must be given in
+// the same order as the family's label names.
+func (v *GaugeVec) WithLabelValues(labelValues ...string) *Gauge {
+	return v.f.get(labelValues, func() series { return &Gauge{} }).(*Gauge)
+}
+
+// Func makes the series for 'labelValues' report the result of 'fn' whenever
+// it's scraped.
+func (v *GaugeVec) Func(fn func() float64, labelValues ...string) {
+	v.f.setFunc(labelValues, fn)
+}
+
+// Gauge is a value that can go up and down.
+type Gauge struct {
+	v atomicFloat
+}
+
+// Set sets the gauge to 'value'.
+func (g *Gauge) Set(value float64) {
+	g.v.store(value)
+}
+
+// Add adds 'delta' to the gauge.
+func (g *Gauge) Add(delta float64) {
+	g.v.add(delta)
+}
+
+// Inc adds one to the gauge.
+func (g *Gauge) Inc() {
+	g.v.add(1)
+}
+
+// Dec subtracts one from the gauge.
+func (g *Gauge) Dec() {
+	g.v.add(-1)
+}
+
+func (g *Gauge) write(w *bufio.Writer, name string, labels string) {
+	writeSample(w, name, labels, g.v.load())
+}
+
+// HistogramVec is a family of histograms, partitioned by label values.
+type HistogramVec struct {
+	f *family
+}
+
+// NewHistogramVec registers a histogram family with DefaultRegistry.
+// 'buckets' are the upper bounds of the histogram's buckets, in increasing
+// order; a +Inf bucket is always added.
+func NewHistogramVec(name string, help string, buckets []float64, labelNames ...string) *HistogramVec {
+	return DefaultRegistry.NewHistogramVec(name, help, buckets, labelNames...)
+}
+
+// NewHistogramVec registers a histogram family with 'r'.
+func (r *Registry) NewHistogramVec(name string, help string, buckets []float64, labelNames ...string) *HistogramVec {
+	if !sort.Float64sAreSorted(buckets) {
+		panic(fmt.Sprintf("buckets for metric %s are not sorted", name))
+	}
+	return &HistogramVec{r.family(name, help, typeHistogram, buckets, labelNames)}
+}
+
+// WithLabelValues returns the histogram for 'labelValues', which must be
+// given in the same order as the family's label names.
+func (v *HistogramVec) WithLabelValues(labelValues ...string) *Histogram {
+	return v.f.get(labelValues, func() series {
+		return &Histogram{
+			buckets: v.f.buckets,
+			counts:  make([]uint64, len(v.f.buckets)),
+		}
+	}).(*Histogram)
+}
+
+// Histogram counts observations in buckets, and tracks their sum.
+type Histogram struct {
+	sum     atomicFloat
+	count   uint64
+	buckets []float64
+	// counts[i] is the number of observations in (buckets[i-1], buckets[i]];
+	// observations above the last bucket are only reflected in count.
+	counts []uint64
+}
+
+// Observe adds 'value' to the histogram.
+func (h *Histogram) Observe(value float64) {
+	if i := sort.SearchFloat64s(h.buckets, value); i < len(h.buckets) {
+		atomic.AddUint64(&h.counts[i], 1)
+	}
+	h.sum.add(value)
+	atomic.AddUint64(&h.count, 1)
+}
+
+func (h *Histogram) write(w *bufio.Writer, name string, labels string) {
+	var cumulative uint64
+	for i, upperBound := range h.buckets {
+		cumulative += atomic.LoadUint64(&h.counts[i])
+		writeSample(w, name+"_bucket", withLabel(labels, "le", formatFloat(upperBound)), float64(cumulative))
+	}
+	count := atomic.LoadUint64(&h.count)
+	writeSample(w, name+"_bucket", withLabel(labels, "le", "+Inf"), float64(count))
+	writeSample(w, name+"_sum", labels, h.sum.load())
+	writeSample(w, name+"_count", labels, float64(count))
+}
+
+// valueFunc is a series whose value is computed at scrape time.
+type valueFunc func() float64
+
+func (fn valueFunc) write(w *bufio.Writer, name string, labels string) {
+	writeSample(w, name, labels, fn())
+}
+
+// atomicFloat is a float64 that can be updated concurrently.
+type atomicFloat struct {
+	bits uint64
+}
+
+func (f *atomicFloat) load() float64 {
+	return math.Float64frombits(atomic.LoadUint64(&f.bits))
+}
+
+func (f *atomicFloat) store(value float64) {
+	atomic.StoreUint64(&f.bits, math.Float64bits(value))
+}
+
+func (f *atomicFloat) add(delta float64) {
+	for {
+		old := atomic.LoadUint64(&f.bits)
+		new := math.Float64bits(math.Float64frombits(old) + delta)
+		if atomic.CompareAndSwapUint64(&f.bits, old, new) {
+			return
+		}
+	}
+}
+
+func writeSample(w *bufio.Writer, name string, labels string, value float64) {
+	w.WriteString(name)
+	if labels != "" {
+		w.WriteString("{")
+		w.WriteString(labels)
+		w.WriteString("}")
+	}
+	w.WriteString(" ")
+	w.WriteString(formatFloat(value))
+	w.WriteString("\n")
+}
+
+// formatLabels formats label pairs as they appear between a sample's braces,
+// e.g. `method="Get",code="OK"`.
+func formatLabels(names []string, values []string) string {
+	var pairs []string
+	for i, name := range names {
+		pairs = append(pairs, fmt.Sprintf(`%s="%s"`, name, labelValueReplacer.Replace(values[i])))
+	}
+	return strings.Join(pairs, ",")
+}
+
+func withLabel(labels string, name string, value string) string {
+	pair := fmt.Sprintf(`%s="%s"`, name, labelValueReplacer.Replace(value))
+	if labels == "" {
+		return pair
+	}
+	return labels + "," + pair
+}
+
+func formatFloat(value float64) string {
+	switch {
+	case math.IsInf(value, 1):
+		return "+Inf"
+	case math.IsInf(value, -1):
+		return "-Inf"
+	case math.IsNaN(value):
+		return "NaN"
+	}
+	return strconv.FormatFloat(value, 'g', -1, 64)
+}
+
+func escapeHelp(help string) string {
+	return helpReplacer.Replace(help)
+}
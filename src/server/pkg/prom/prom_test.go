@@ -0,0 +1,100 @@
+package prom
+
+import (
+	"bytes"
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/pachyderm/pachyderm/src/client/pkg/require"
+)
+
+func write(t *testing.T, r *Registry) string {
+	var buf bytes.Buffer
+	require.NoError(t, r.Write(&buf))
+	return buf.String()
+}
+
+func TestCounter(t *testing.T) {
+	r := NewRegistry()
+	c := r.NewCounterVec("requests_total", "Number of requests.", "method", "code")
+	c.WithLabelValues("Get", "OK").Inc()
+	c.WithLabelValues("Get", "OK").Add(2)
+	c.WithLabelValues("Put", "Unknown").Inc()
+	require.Equal(t, `# HELP requests_total Number of requests.
+# TYPE requests_total counter
+requests_total{method="Get",code="OK"} 3
+requests_total{method="Put",code="Unknown"} 1
+`, write(t, r))
+}
+
+func TestGauge(t *testing.T) {
+	r := NewRegistry()
+	g := r.NewGaugeVec("queue_size", "Size of the queue.")
+	g.WithLabelValues().Set(5)
+	g.WithLabelValues().Dec()
+	g.WithLabelValues().Add(0.5)
+	require.Equal(t, `# HELP queue_size Size of the queue.
+# TYPE queue_size gauge
+queue_size 4.5
+`, write(t, r))
+}
+
+func TestFunc(t *testing.T) {
+	r := NewRegistry()
+	g := r.NewGaugeVec("cache_bytes", "Bytes cached.", "group")
+	value := 1.0
+	g.Func(func() float64 { return value }, "object")
+	value = 2
+	require.True(t, strings.Contains(write(t, r), `cache_bytes{group="object"} 2`))
+	// A second function for the same labels replaces the first
+	g.Func(func() float64 { return 3 }, "object")
+	require.True(t, strings.Contains(write(t, r), `cache_bytes{group="object"} 3`))
+}
+
+func TestHistogram(t *testing.T) {
+	r := NewRegistry()
+	h := r.NewHistogramVec("duration_seconds", "Duration.", []float64{1, 2, 4}, "pipeline")
+	for _, v := range []float64{0.5, 1, 3, 10} {
+		h.WithLabelValues("edges").Observe(v)
+	}
+	require.Equal(t, `# HELP duration_seconds Duration.
+# TYPE duration_seconds histogram
+duration_seconds_bucket{pipeline="edges",le="1"} 2
+duration_seconds_bucket{pipeline="edges",le="2"} 2
+duration_seconds_bucket{pipeline="edges",le="4"} 3
+duration_seconds_bucket{pipeline="edges",le="+Inf"} 4
+duration_seconds_sum{pipeline="edges"} 14.5
+duration_seconds_count{pipeline="edges"} 4
+`, write(t, r))
+}
+
+func TestEscaping(t *testing.T) {
+	r := NewRegistry()
+	c := r.NewCounterVec("files_total", "Files\nwith a \\ in the help.", "path")
+	c.WithLabelValues("a\"b\\c\nd").Inc()
+	require.Equal(t, `# HELP files_total Files\nwith a \\ in the help.
+# TYPE files_total counter
+files_total{path="a\"b\\c\nd"} 1
+`, write(t, r))
+}
+
+func TestRegisterTwice(t *testing.T) {
+	r := NewRegistry()
+	r.NewCounterVec("requests_total", "Number of requests.", "method").WithLabelValues("Get").Inc()
+	r.NewCounterVec("requests_total", "Number of requests.", "method").WithLabelValues("Get").Inc()
+	require.True(t, strings.Contains(write(t, r), `requests_total{method="Get"} 2`))
+	defer func() {
+		require.NotNil(t, recover())
+	}()
+	r.NewGaugeVec("requests_total", "Number of requests.", "method")
+}
+
+func TestCountingReader(t *testing.T) {
+	r := NewRegistry()
+	c := r.NewCounterVec("bytes_total", "Bytes read.").WithLabelValues()
+	data, err := ioutil.ReadAll(CountingReader(strings.NewReader("hello world"), c))
+	require.NoError(t, err)
+	require.Equal(t, "hello world", string(data))
+	require.True(t, strings.Contains(write(t, r), "bytes_total 11"))
+}
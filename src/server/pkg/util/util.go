@@ -53,6 +53,40 @@ func GetResourceListFromPipeline(pipelineInfo *pps.PipelineInfo) (*api.ResourceL
 	return &result, nil
 }
 
+// GetLimitsResourceListFromPipeline returns a list of resources that the
+// pipeline's user container may use at most, or nil if the pipeline has no
+// limits. Only the resources that are set in the pipeline's limits are
+// included.
+func GetLimitsResourceListFromPipeline(pipelineInfo *pps.PipelineInfo) (*api.ResourceList, error) {
+	limits := pipelineInfo.ResourceLimits
+	if limits == nil {
+		return nil, nil
+	}
+	var result api.ResourceList = make(map[api.ResourceName]resource.Quantity)
+	if limits.Cpu != 0 {
+		cpuQuantity, err := resource.ParseQuantity(fmt.Sprintf("%f", limits.Cpu))
+		if err != nil {
+			return nil, fmt.Errorf("could not parse cpu limit %f: %v", limits.Cpu, err)
+		}
+		result[api.ResourceCPU] = cpuQuantity
+	}
+	if limits.Memory != "" {
+		memQuantity, err := resource.ParseQuantity(limits.Memory)
+		if err != nil {
+			return nil, fmt.Errorf("could not parse memory limit %q: %v", limits.Memory, err)
+		}
+		result[api.ResourceMemory] = memQuantity
+	}
+	if limits.Gpu != 0 {
+		gpuQuantity, err := resource.ParseQuantity(fmt.Sprintf("%d", limits.Gpu))
+		if err != nil {
+			return nil, fmt.Errorf("could not parse gpu limit %d: %v", limits.Gpu, err)
+		}
+		result[api.ResourceNvidiaGPU] = gpuQuantity
+	}
+	return &result, nil
+}
+
 // LookupUser is a reimplementation of user.Lookup that doesn't require cgo.
 func LookupUser(name string) (_ *user.User, retErr error) {
 	passwd, err := os.Open("/etc/passwd")
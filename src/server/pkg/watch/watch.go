@@ -84,37 +84,55 @@ func (s byModRev) Less(i, j int) bool {
 
 // NewWatcher watches a given etcd prefix for events.
 func NewWatcher(ctx context.Context, client *etcd.Client, prefix string) (Watcher, error) {
-	return newWatcher(ctx, client, prefix, false)
+	return newWatcher(ctx, client, prefix, false, 0)
 }
 
 // NewWatcherWithPrev is like NewWatcher, except that the returned events
 // include the previous version of the values.
 func NewWatcherWithPrev(ctx context.Context, client *etcd.Client, prefix string) (Watcher, error) {
-	return newWatcher(ctx, client, prefix, true)
+	return newWatcher(ctx, client, prefix, true, 0)
 }
 
-func newWatcher(ctx context.Context, client *etcd.Client, prefix string, withPrev bool) (Watcher, error) {
+// NewWatcherWithPrevFromRevision is like NewWatcherWithPrev, except that it
+// returns the events at or after revision 'rev' instead of the current
+// items. If 'rev' has been compacted, the watcher returns an error event.
+func NewWatcherWithPrevFromRevision(ctx context.Context, client *etcd.Client, prefix string, rev int64) (Watcher, error) {
+	return newWatcher(ctx, client, prefix, true, rev)
+}
+
+// newWatcher lists the current items under 'prefix' and then watches it for
+// changes, unless 'fromRev' is set, in which case it only watches for the
+// changes at or after 'fromRev'.
+func newWatcher(ctx context.Context, client *etcd.Client, prefix string, withPrev bool, fromRev int64) (Watcher, error) {
 	eventCh := make(chan *Event)
 	done := make(chan struct{})
-	// Firstly we list the collection to get the current items
-	// Sort them by ascending order because that's how the items would have
-	// been returned if we watched them from the beginning.
-	resp, err := client.Get(ctx, prefix, etcd.WithPrefix(), etcd.WithSort(etcd.SortByModRevision, etcd.SortAscend))
-	if err != nil {
-		return nil, err
+	nextRevision := fromRev
+	var resp *etcd.GetResponse
+	if fromRev == 0 {
+		// Firstly we list the collection to get the current items
+		// Sort them by ascending order because that's how the items would have
+		// been returned if we watched them from the beginning.
+		var err error
+		resp, err = client.Get(ctx, prefix, etcd.WithPrefix(), etcd.WithSort(etcd.SortByModRevision, etcd.SortAscend))
+		if err != nil {
+			return nil, err
+		}
+		nextRevision = resp.Header.Revision + 1
 	}
 
-	nextRevision := resp.Header.Revision + 1
 	etcdWatcher := etcd.NewWatcher(client)
 	// Now we issue a watch that uses the revision timestamp returned by the
 	// Get request earlier.  That way even if some items are added between
 	// when we list the collection and when we start watching the collection,
 	// we won't miss any items.
-	options := []etcd.OpOption{etcd.WithPrefix(), etcd.WithRev(nextRevision)}
-	if withPrev {
-		options = append(options, etcd.WithPrevKV())
+	options := func() []etcd.OpOption {
+		options := []etcd.OpOption{etcd.WithPrefix(), etcd.WithRev(nextRevision)}
+		if withPrev {
+			options = append(options, etcd.WithPrevKV())
+		}
+		return options
 	}
-	rch := etcdWatcher.Watch(ctx, prefix, options...)
+	rch := etcdWatcher.Watch(ctx, prefix, options()...)
 
 	go func() (retErr error) {
 		defer func() {
@@ -130,12 +148,14 @@ func newWatcher(ctx context.Context, client *etcd.Client, prefix string, withPre
 			close(eventCh)
 			etcdWatcher.Close()
 		}()
-		for _, etcdKv := range resp.Kvs {
-			eventCh <- &Event{
-				Key:   etcdKv.Key,
-				Value: etcdKv.Value,
-				Type:  EventPut,
-				Rev:   etcdKv.ModRevision,
+		if resp != nil {
+			for _, etcdKv := range resp.Kvs {
+				eventCh <- &Event{
+					Key:   etcdKv.Key,
+					Value: etcdKv.Value,
+					Type:  EventPut,
+					Rev:   etcdKv.ModRevision,
+				}
 			}
 		}
 		for {
@@ -151,7 +171,7 @@ func newWatcher(ctx context.Context, client *etcd.Client, prefix string, withPre
 					return err
 				}
 				etcdWatcher = etcd.NewWatcher(client)
-				rch = etcdWatcher.Watch(ctx, prefix, etcd.WithPrefix(), etcd.WithRev(nextRevision))
+				rch = etcdWatcher.Watch(ctx, prefix, options()...)
 				continue
 			}
 			if err := resp.Err(); err != nil {
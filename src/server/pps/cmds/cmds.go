@@ -11,7 +11,9 @@ import (
 	"net/url"
 	"os"
 	"os/user"
+	"path/filepath"
 	"sort"
+	"strconv"
 	"strings"
 	"text/tabwriter"
 
@@ -31,6 +33,10 @@ import (
 const (
 	codestart = "```sh"
 	codeend   = "```"
+
+	// dryRunSampleSize is the number of datums that create-pipeline
+	// --dry-run shows
+	dryRunSampleSize = 10
 )
 
 // Cmds returns a slice containing pps commands.
@@ -193,6 +199,33 @@ $ pachctl list-job -p foo bar/YYY
 		}),
 	}
 
+	egressJob := &cobra.Command{
+		Use:   "egress-job job-id",
+		Short: "Push the output of a job to its egress.",
+		Long: `Push the output of a job to its pipeline's egress URL.
+
+Only the files that differ from the last output pushed to the same URL are
+sent. This can be used to retry egress for a job that failed because its
+egress did; if it succeeds, the job's state is updated. A job's output can't
+be pushed over the output of a job that started after it.`,
+		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
+			client, err := pachdclient.NewOnUserMachine(metrics, "user")
+			if err != nil {
+				return err
+			}
+			egressInfo, err := client.EgressJob(args[0])
+			if err != nil {
+				cmdutil.ErrorAndExit("error from EgressJob: %s", err.Error())
+			}
+			if raw {
+				return marshaller.Marshal(os.Stdout, egressInfo)
+			}
+			pretty.PrintEgressInfo(os.Stdout, egressInfo)
+			return nil
+		}),
+	}
+	rawFlag(egressJob)
+
 	restartDatum := &cobra.Command{
 		Use:   "restart-datum job-id datum-path1,datum-path2",
 		Short: "Restart a datum.",
@@ -285,6 +318,88 @@ $ pachctl list-job -p foo bar/YYY
 	}
 	rawFlag(inspectDatum)
 
+	var traceDepth int64
+	traceFile := &cobra.Command{
+		Use:   "trace-file repo-name commit-id path/to/file",
+		Short: "Return the datums and input files that produced a file.",
+		Long: `Return the datums and input files that produced a file in a pipeline's output.
+
+The inputs of each datum are traced in turn, back through upstream pipelines
+to the input repos that the data was originally put in.
+
+Examples:
+
+	# trace a file in the output of the "edges" pipeline
+	$ pachctl trace-file edges master /image.png
+
+	# only trace back through one pipeline
+	$ pachctl trace-file edges master /image.png --depth 1
+`,
+		Run: cmdutil.RunFixedArgs(3, func(args []string) error {
+			client, err := pachdclient.NewOnUserMachine(metrics, "user")
+			if err != nil {
+				return err
+			}
+			fileTrace, err := client.TraceFile(args[0], args[1], args[2], traceDepth)
+			if err != nil {
+				return err
+			}
+			if raw {
+				return marshaller.Marshal(os.Stdout, fileTrace)
+			}
+			pretty.PrintFileTrace(os.Stdout, fileTrace)
+			return nil
+		}),
+	}
+	traceFile.Flags().Int64Var(&traceDepth, "depth", 0, "The number of pipelines to trace back through, 0 means no limit.")
+	rawFlag(traceFile)
+
+	impactAnalysis := &cobra.Command{
+		Use:   "impact-analysis repo-name branch path/to/file [path/to/file...]",
+		Short: "Show how much reprocessing a change to some files would cause.",
+		Long: `Show how much reprocessing a change to some files would cause.
+
+For each pipeline downstream of the branch, impact-analysis reports how many
+of its datums would be reprocessed and how many would be reused from the datum
+cache, along with an estimate of the time (summed across workers) that the
+reprocessing would take, based on the pipeline's previous jobs. Nothing is
+changed.
+
+Examples:
+
+	# show the effect of changing two files in the master branch of "images"
+	$ pachctl impact-analysis images master /cat.png /dog.png
+
+	# show the effect of changing everything in a directory
+	$ pachctl impact-analysis images master /2017
+`,
+		Run: cmdutil.RunMinimumArgs(3, func(args []string) error {
+			client, err := pachdclient.NewOnUserMachine(metrics, "user")
+			if err != nil {
+				return err
+			}
+			impacts, err := client.ImpactAnalysis(args[0], args[1], args[2:])
+			if err != nil {
+				return err
+			}
+			if raw {
+				for _, impact := range impacts {
+					if err := marshaller.Marshal(os.Stdout, impact); err != nil {
+						return err
+					}
+				}
+				return nil
+			}
+			writer := tabwriter.NewWriter(os.Stdout, 20, 1, 3, ' ', 0)
+			pretty.PrintPipelineImpactHeader(writer)
+			for _, impact := range impacts {
+				pretty.PrintPipelineImpact(writer, impact)
+			}
+			return writer.Flush()
+		}),
+	}
+	rawFlag(impactAnalysis)
+
 	var (
 		jobID       string
 		datumID     string
@@ -380,6 +495,7 @@ All jobs created by a pipeline will create commits in the pipeline's repo.
 	var username string
 	var password string
 	var pipelinePath string
+	var dryRun bool
 	createPipeline := &cobra.Command{
 		Use:   "create-pipeline -f pipeline.json",
 		Short: "Create a new pipeline.",
@@ -400,6 +516,12 @@ All jobs created by a pipeline will create commits in the pipeline's repo.
 				} else if err != nil {
 					return err
 				}
+				if dryRun {
+					if err := printDryRun(client, request); err != nil {
+						return err
+					}
+					continue
+				}
 				if pushImages {
 					pushedImage, err := pushImage(registry, username, password, request.Transform.Image)
 					if err != nil {
@@ -422,6 +544,7 @@ All jobs created by a pipeline will create commits in the pipeline's repo.
 	createPipeline.Flags().StringVarP(&registry, "registry", "r", "docker.io", "The registry to push images to.")
 	createPipeline.Flags().StringVarP(&username, "username", "u", "", "The username to push images as, defaults to your OS username.")
 	createPipeline.Flags().StringVarP(&password, "password", "", "", "Your password for the registry being pushed to.")
+	createPipeline.Flags().BoolVar(&dryRun, "dry-run", false, "Don't create the pipeline, instead list the datums it would process given its current inputs.")
 
 	var reprocess bool
 	updatePipeline := &cobra.Command{
@@ -470,6 +593,70 @@ All jobs created by a pipeline will create commits in the pipeline's repo.
 	updatePipeline.Flags().StringVarP(&password, "password", "", "", "Your password for the registry being pushed to.")
 	updatePipeline.Flags().BoolVar(&reprocess, "reprocess", false, "If true, reprocess datums that were already processed by previous version of the pipeline.")
 
+	var prune bool
+	apply := &cobra.Command{
+		Use:   "apply -f path",
+		Short: "Create and update many repos and pipelines at once.",
+		Long: `Create and update many repos and pipelines at once, from the repo and
+pipeline specs in a file or in the .json files of a directory.
+
+Repo specs are objects with a "repo" field, e.g. {"repo": {"name": "data"}},
+and pipeline specs are objects with a "pipeline" field. Repos that don't exist
+are created, pipelines that don't exist are created, and pipelines whose spec
+differs from their current one are updated. Pipelines are applied after the
+pipelines whose output they take as input. The changes are printed before
+they're applied.
+
+Examples:
+
+` + codestart + `# show what applying the specs in dag/ would change
+$ pachctl apply -f dag/ --dry-run
+
+# apply the specs in dag/, deleting pipelines that aren't in them
+$ pachctl apply -f dag/ --prune
+` + codeend,
+		Run: cmdutil.RunFixedArgs(0, func(args []string) error {
+			repos, pipelines, err := readApplySpecs(pipelinePath)
+			if err != nil {
+				return err
+			}
+			client, err := pachdclient.NewOnUserMachine(metrics, "user")
+			if err != nil {
+				return fmt.Errorf("error connecting to pachd: %v", err)
+			}
+			actions, err := client.ApplyPipelines(repos, pipelines, prune, reprocess, true)
+			if err != nil {
+				return err
+			}
+			if len(actions) == 0 {
+				fmt.Println("Nothing to apply.")
+				return nil
+			}
+			writer := tabwriter.NewWriter(os.Stdout, 20, 1, 3, ' ', 0)
+			pretty.PrintApplyActionHeader(writer)
+			for _, action := range actions {
+				pretty.PrintApplyAction(writer, action)
+			}
+			if err := writer.Flush(); err != nil {
+				return err
+			}
+			if dryRun {
+				return nil
+			}
+			if _, err := client.ApplyPipelines(repos, pipelines, prune, reprocess, false); err != nil {
+				return err
+			}
+			fmt.Printf("Applied %d changes.\n", len(actions))
+			return nil
+		}),
+	}
+	apply.Flags().StringVarP(&pipelinePath, "file", "f", "-", "The file or directory containing the specs, it can be a url or local path. - reads from stdin.")
+	apply.Flags().BoolVar(&prune, "prune", false, "If true, delete pipelines that aren't in the specs.")
+	apply.Flags().BoolVar(&reprocess, "reprocess", false, "If true, updated pipelines reprocess datums that were already processed by their previous version.")
+	apply.Flags().BoolVar(&dryRun, "dry-run", false, "If true, only print the changes that would be applied.")
+
+	var pipelineVersion uint64
+	var workerSpec bool
 	inspectPipeline := &cobra.Command{
 		Use:   "inspect-pipeline pipeline-name",
 		Short: "Return info about a pipeline.",
@@ -479,7 +666,12 @@ All jobs created by a pipeline will create commits in the pipeline's repo.
 			if err != nil {
 				return err
 			}
-			pipelineInfo, err := client.InspectPipeline(args[0])
+			var pipelineInfo *ppsclient.PipelineInfo
+			if workerSpec {
+				pipelineInfo, err = client.InspectPipelineWorkerSpec(args[0], pipelineVersion)
+			} else {
+				pipelineInfo, err = client.InspectPipelineVersion(args[0], pipelineVersion)
+			}
 			if err != nil {
 				return grpcutil.ScrubGRPC(err)
 			}
@@ -492,6 +684,8 @@ All jobs created by a pipeline will create commits in the pipeline's repo.
 			return pretty.PrintDetailedPipelineInfo(pipelineInfo)
 		}),
 	}
+	inspectPipeline.Flags().Uint64Var(&pipelineVersion, "version", 0, "Return info about this past version of the pipeline rather than the current one.")
+	inspectPipeline.Flags().BoolVar(&workerSpec, "worker-spec", false, "Include the Kubernetes pod template of the pipeline's workers, with its scheduling_spec, resource_limits and pod_patch applied.")
 	rawFlag(inspectPipeline)
 
 	listPipeline := &cobra.Command{
@@ -525,6 +719,68 @@ All jobs created by a pipeline will create commits in the pipeline's repo.
 	}
 	rawFlag(listPipeline)
 
+	listPipelineVersions := &cobra.Command{
+		Use:   "list-pipeline-versions pipeline-name",
+		Short: "Return info about every version of a pipeline.",
+		Long:  "Return info about every version of a pipeline, oldest first. Each update of the pipeline creates a new version.",
+		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
+			client, err := pachdclient.NewOnUserMachine(metrics, "user")
+			if err != nil {
+				return fmt.Errorf("error connecting to pachd: %v", err)
+			}
+			pipelineInfos, err := client.ListPipelineVersions(args[0])
+			if err != nil {
+				return grpcutil.ScrubGRPC(err)
+			}
+			if raw {
+				for _, pipelineInfo := range pipelineInfos {
+					if err := marshaller.Marshal(os.Stdout, pipelineInfo); err != nil {
+						return err
+					}
+				}
+				return nil
+			}
+			writer := tabwriter.NewWriter(os.Stdout, 20, 1, 3, ' ', 0)
+			pretty.PrintPipelineVersionHeader(writer)
+			for _, pipelineInfo := range pipelineInfos {
+				pretty.PrintPipelineVersion(writer, pipelineInfo)
+			}
+			return writer.Flush()
+		}),
+	}
+	rawFlag(listPipelineVersions)
+
+	rollbackPipeline := &cobra.Command{
+		Use:   "rollback-pipeline pipeline-name version",
+		Short: "Roll a pipeline back to one of its past versions.",
+		Long: `Roll a pipeline back to one of its past versions. The spec of the past
+version becomes the pipeline's next version, and the pipeline reuses the
+output of datums that the past version already processed.
+
+Examples:
+
+` + codestart + `# list the versions of pipeline foo
+$ pachctl list-pipeline-versions foo
+
+# reinstate version 2 of pipeline foo
+$ pachctl rollback-pipeline foo 2
+` + codeend,
+		Run: cmdutil.RunFixedArgs(2, func(args []string) error {
+			version, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid version %q: %v", args[1], err)
+			}
+			client, err := pachdclient.NewOnUserMachine(metrics, "user")
+			if err != nil {
+				return err
+			}
+			if err := client.RollbackPipeline(args[0], version); err != nil {
+				cmdutil.ErrorAndExit("error from RollbackPipeline: %s", err.Error())
+			}
+			return nil
+		}),
+	}
+
 	var all bool
 	var deleteJobs bool
 	var deleteRepo bool
@@ -602,6 +858,220 @@ All jobs created by a pipeline will create commits in the pipeline's repo.
 		}),
 	}
 
+	var webhookPipeline string
+	var webhookEvents []string
+	var webhookSecret string
+	var updateWebhook bool
+	createWebhook := &cobra.Command{
+		Use:   "create-webhook name url",
+		Short: "Create a webhook that receives job and pipeline events.",
+		Long: `Create a webhook that receives job and pipeline events. Each event is
+posted to the url as JSON. Deliveries that fail are retried with backoff.
+
+Event types are: job_started, job_succeeded, job_failed, job_killed,
+job_partial and pipeline_failed. If --secret is set, each delivery carries
+the hex HMAC-SHA256 of its body, keyed by the secret, in the
+X-Pachyderm-Signature header as "sha256=<hmac>".
+
+Examples:
+
+` + codestart + `# post every failed job of pipeline foo to a url
+$ pachctl create-webhook foo-failures https://example.com/hook --pipeline foo --event job_failed
+
+# post every event of every pipeline, signed with a secret
+$ pachctl create-webhook all https://example.com/hook --secret s3cret
+` + codeend,
+		Run: cmdutil.RunFixedArgs(2, func(args []string) error {
+			var events []ppsclient.EventType
+			for _, event := range webhookEvents {
+				eventType, ok := ppsclient.EventType_value["EVENT_"+strings.ToUpper(event)]
+				if !ok {
+					return fmt.Errorf("unknown event type %q", event)
+				}
+				events = append(events, ppsclient.EventType(eventType))
+			}
+			client, err := pachdclient.NewOnUserMachine(metrics, "user")
+			if err != nil {
+				return err
+			}
+			return client.CreateWebhook(args[0], args[1], webhookPipeline, events, webhookSecret, updateWebhook)
+		}),
+	}
+	createWebhook.Flags().StringVar(&webhookPipeline, "pipeline", "", "Only receive the events of this pipeline.")
+	createWebhook.Flags().StringSliceVar(&webhookEvents, "event", nil, "Only receive events of this type; may be given multiple times.")
+	createWebhook.Flags().StringVar(&webhookSecret, "secret", "", "The key used to sign deliveries.")
+	createWebhook.Flags().BoolVar(&updateWebhook, "update", false, "Replace an existing webhook of the same name.")
+
+	listWebhook := &cobra.Command{
+		Use:   "list-webhook",
+		Short: "Return info about webhooks.",
+		Long:  "Return info about webhooks.",
+		Run: cmdutil.RunFixedArgs(0, func(args []string) error {
+			client, err := pachdclient.NewOnUserMachine(metrics, "user")
+			if err != nil {
+				return err
+			}
+			webhookInfos, err := client.ListWebhook(webhookPipeline)
+			if err != nil {
+				return err
+			}
+			if raw {
+				for _, webhookInfo := range webhookInfos {
+					if err := marshaller.Marshal(os.Stdout, webhookInfo); err != nil {
+						return err
+					}
+				}
+				return nil
+			}
+			writer := tabwriter.NewWriter(os.Stdout, 20, 1, 3, ' ', 0)
+			pretty.PrintWebhookHeader(writer)
+			for _, webhookInfo := range webhookInfos {
+				pretty.PrintWebhookInfo(writer, webhookInfo)
+			}
+			return writer.Flush()
+		}),
+	}
+	listWebhook.Flags().StringVar(&webhookPipeline, "pipeline", "", "Only list the webhooks of this pipeline.")
+	rawFlag(listWebhook)
+
+	deleteWebhook := &cobra.Command{
+		Use:   "delete-webhook name",
+		Short: "Delete a webhook.",
+		Long:  "Delete a webhook. Its pending deliveries are dropped.",
+		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
+			client, err := pachdclient.NewOnUserMachine(metrics, "user")
+			if err != nil {
+				return err
+			}
+			return client.DeleteWebhook(args[0])
+		}),
+	}
+
+	var policyPath string
+	setAdmissionPolicy := &cobra.Command{
+		Use:   "set-admission-policy -f policy.json",
+		Short: "Set the policy that limits the jobs running at once.",
+		Long: `Set the policy that limits the jobs running at once across the cluster.
+Jobs that would exceed a limit are queued, and admitted in priority order,
+sharing capacity fairly between the teams of a priority class. Pipelines
+choose their priority class and team with the priority_class and team fields
+of their spec. An empty policy ({}) lets every job run as soon as it's
+created.
+
+Examples:
+
+` + codestart + `# run at most 10 jobs at once, and at most 2 backfill jobs, which wait
+# for prod jobs
+$ echo '{
+  "max_running_jobs": 10,
+  "priority_classes": [
+    {"name": "prod", "priority": 10},
+    {"name": "backfill", "priority": 1, "max_running_jobs": 2}
+  ],
+  "default_priority_class": "prod"
+}' | pachctl set-admission-policy -f -
+` + codeend,
+		Run: cmdutil.RunFixedArgs(0, func(args []string) error {
+			policyReader, err := newPipelineManifestReader(policyPath)
+			if err != nil {
+				return err
+			}
+			policy := &ppsclient.AdmissionPolicy{}
+			if err := jsonpb.UnmarshalNext(policyReader.decoder, policy); err != nil {
+				return fmt.Errorf("malformed admission policy: %s", err)
+			}
+			client, err := pachdclient.NewOnUserMachine(metrics, "user")
+			if err != nil {
+				return err
+			}
+			return client.SetAdmissionPolicy(policy)
+		}),
+	}
+	setAdmissionPolicy.Flags().StringVarP(&policyPath, "file", "f", "-", "The file containing the admission policy, it can be a url or local file. - reads from stdin.")
+
+	getAdmissionPolicy := &cobra.Command{
+		Use:   "get-admission-policy",
+		Short: "Return the policy that limits the jobs running at once.",
+		Long:  "Return the policy that limits the jobs running at once.",
+		Run: cmdutil.RunFixedArgs(0, func(args []string) error {
+			client, err := pachdclient.NewOnUserMachine(metrics, "user")
+			if err != nil {
+				return err
+			}
+			policy, err := client.GetAdmissionPolicy()
+			if err != nil {
+				return err
+			}
+			if raw {
+				return marshaller.Marshal(os.Stdout, policy)
+			}
+			pretty.PrintAdmissionPolicy(os.Stdout, policy)
+			return nil
+		}),
+	}
+	rawFlag(getAdmissionPolicy)
+
+	var includeCommits []string
+	var excludeCommits []string
+	rerunPipeline := &cobra.Command{
+		Use:   "rerun-pipeline pipeline-name",
+		Short: "Rerun a pipeline over the input commits of its previous jobs.",
+		Long: `Rerun a pipeline over the input commits of its previous jobs, reprocessing
+every datum, e.g. after fixing a bug in its transform. Unlike updating the
+pipeline with --reprocess, only the selected input commits are reprocessed.
+
+A job's input commits are rerun if one of them is an ancestor of (or is) an
+--include commit, and none of them is an ancestor of an --exclude commit.
+Without --include, every job's input commits are rerun. The output of a rerun
+is written to a new output commit, which is only put on the output branch if
+its input commits are still the latest ones.
+
+Examples:
+
+` + codestart + `# rerun pipeline foo over all of its previous input commits
+$ pachctl rerun-pipeline foo
+
+# rerun pipeline foo over the input commits up to data/XXX, except those up
+# to data/YYY
+$ pachctl rerun-pipeline foo --include data/XXX --exclude data/YYY
+` + codeend,
+		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
+			client, err := pachdclient.NewOnUserMachine(metrics, "user")
+			if err != nil {
+				return err
+			}
+			include, err := cmdutil.ParseCommits(includeCommits)
+			if err != nil {
+				return err
+			}
+			exclude, err := cmdutil.ParseCommits(excludeCommits)
+			if err != nil {
+				return err
+			}
+			jobInfos, err := client.RerunPipeline(args[0], include, exclude)
+			if err != nil {
+				cmdutil.ErrorAndExit("error from RerunPipeline: %s", err.Error())
+			}
+			if raw {
+				for _, jobInfo := range jobInfos {
+					if err := marshaller.Marshal(os.Stdout, jobInfo); err != nil {
+						return err
+					}
+				}
+				return nil
+			}
+			writer := tabwriter.NewWriter(os.Stdout, 0, 1, 1, ' ', 0)
+			pretty.PrintJobHeader(writer)
+			for _, jobInfo := range jobInfos {
+				pretty.PrintJobInfo(writer, jobInfo)
+			}
+			return writer.Flush()
+		}),
+	}
+	rerunPipeline.Flags().StringSliceVar(&includeCommits, "include", nil, "Rerun the input commits that are ancestors of this commit (repo/commit-id); may be given multiple times.")
+	rerunPipeline.Flags().StringSliceVar(&excludeCommits, "exclude", nil, "Don't rerun the input commits that are ancestors of this commit (repo/commit-id); may be given multiple times.")
+	rawFlag(rerunPipeline)
+
 	var specPath string
 	runPipeline := &cobra.Command{
 		Use:   "run-pipeline pipeline-name [-f job.json]",
@@ -662,19 +1132,31 @@ All jobs created by a pipeline will create commits in the pipeline's repo.
 	result = append(result, listJob)
 	result = append(result, deleteJob)
 	result = append(result, stopJob)
+	result = append(result, egressJob)
 	result = append(result, restartDatum)
 	result = append(result, listDatum)
 	result = append(result, inspectDatum)
+	result = append(result, traceFile)
+	result = append(result, impactAnalysis)
 	result = append(result, getLogs)
 	result = append(result, pipeline)
 	result = append(result, createPipeline)
 	result = append(result, updatePipeline)
+	result = append(result, apply)
 	result = append(result, inspectPipeline)
 	result = append(result, listPipeline)
+	result = append(result, listPipelineVersions)
+	result = append(result, rollbackPipeline)
 	result = append(result, deletePipeline)
 	result = append(result, startPipeline)
 	result = append(result, stopPipeline)
 	result = append(result, runPipeline)
+	result = append(result, rerunPipeline)
+	result = append(result, createWebhook)
+	result = append(result, listWebhook)
+	result = append(result, deleteWebhook)
+	result = append(result, setAdmissionPolicy)
+	result = append(result, getAdmissionPolicy)
 	return result, nil
 }
 
@@ -751,6 +1233,79 @@ func (r *pipelineManifestReader) nextCreatePipelineRequest() (*ppsclient.CreateP
 	return &result, nil
 }
 
+// nextApplySpec returns the next repo or pipeline spec read by r. Exactly
+// one of the returned specs is set.
+func (r *pipelineManifestReader) nextApplySpec() (*pfs.CreateRepoRequest, *ppsclient.CreatePipelineRequest, error) {
+	var raw json.RawMessage
+	if err := r.decoder.Decode(&raw); err != nil {
+		if err == io.EOF {
+			return nil, nil, err
+		}
+		return nil, nil, describeSyntaxError(err, r.buf)
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &fields); err != nil {
+		return nil, nil, fmt.Errorf("malformed spec: %s", err)
+	}
+	switch {
+	case fields["pipeline"] != nil:
+		var result ppsclient.CreatePipelineRequest
+		if err := jsonpb.UnmarshalString(string(raw), &result); err != nil {
+			return nil, nil, fmt.Errorf("malformed pipeline spec: %s", err)
+		}
+		return nil, &result, nil
+	case fields["repo"] != nil:
+		var result pfs.CreateRepoRequest
+		if err := jsonpb.UnmarshalString(string(raw), &result); err != nil {
+			return nil, nil, fmt.Errorf("malformed repo spec: %s", err)
+		}
+		return &result, nil, nil
+	}
+	return nil, nil, fmt.Errorf("spec has neither a \"pipeline\" nor a \"repo\" field")
+}
+
+// readApplySpecs reads the repo and pipeline specs in path, which is a file,
+// url or '-' for stdin, or a directory whose .json files are read.
+func readApplySpecs(path string) ([]*pfs.CreateRepoRequest, []*ppsclient.CreatePipelineRequest, error) {
+	paths := []string{path}
+	if info, err := os.Stat(path); err == nil && info.IsDir() {
+		paths = nil
+		if err := filepath.Walk(path, func(filePath string, info os.FileInfo, err error) error {
+			if err != nil {
+				return err
+			}
+			if !info.IsDir() && filepath.Ext(filePath) == ".json" {
+				paths = append(paths, filePath)
+			}
+			return nil
+		}); err != nil {
+			return nil, nil, err
+		}
+	}
+	var repos []*pfs.CreateRepoRequest
+	var pipelines []*ppsclient.CreatePipelineRequest
+	for _, path := range paths {
+		specReader, err := newPipelineManifestReader(path)
+		if err != nil {
+			return nil, nil, err
+		}
+		for {
+			repo, pipeline, err := specReader.nextApplySpec()
+			if err == io.EOF {
+				break
+			} else if err != nil {
+				return nil, nil, fmt.Errorf("error reading %s: %v", path, err)
+			}
+			if repo != nil {
+				repos = append(repos, repo)
+			} else {
+				pipelines = append(pipelines, pipeline)
+			}
+		}
+	}
+	return repos, pipelines, nil
+}
+
 func describeSyntaxError(originalErr error, parsedBuffer bytes.Buffer) error {
 
 	sErr, ok := originalErr.(*json.SyntaxError)
@@ -781,6 +1336,23 @@ func describeSyntaxError(originalErr error, parsedBuffer bytes.Buffer) error {
 
 // pushImage pushes an image as registry/user/image. Registry and user can be
 // left empty.
+// printDryRun prints the datums that the pipeline in 'request' would process
+// if it were created now.
+func printDryRun(client *pachdclient.APIClient, request *ppsclient.CreatePipelineRequest) error {
+	response, err := client.ListDatumForInput(request.Pipeline.GetName(), request.Input, dryRunSampleSize, 0)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Pipeline %s:\n", request.Pipeline.GetName())
+	writer := tabwriter.NewWriter(os.Stdout, 20, 1, 3, ' ', 0)
+	pretty.PrintDatumsForInput(writer, response)
+	if err := writer.Flush(); err != nil {
+		return err
+	}
+	fmt.Println()
+	return nil
+}
+
 func pushImage(registry string, username string, password string, image string) (string, error) {
 	client, err := docker.NewClientFromEnv()
 	if err != nil {
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"sort"
 	"strings"
 	"text/tabwriter"
 	"text/template"
@@ -14,6 +15,7 @@ import (
 	"github.com/fatih/color"
 	"github.com/gogo/protobuf/types"
 	"github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/client/pfs"
 	ppsclient "github.com/pachyderm/pachyderm/src/client/pps"
 	"github.com/pachyderm/pachyderm/src/server/pkg/pretty"
 )
@@ -45,6 +47,11 @@ func PrintJobInfo(w io.Writer, jobInfo *ppsclient.JobInfo) {
 	fmt.Fprintf(w, "%d + %d / %d\t", jobInfo.DataProcessed, jobInfo.DataSkipped, jobInfo.DataTotal)
 	fmt.Fprintf(w, "%s\t", pretty.Size(jobInfo.Stats.DownloadBytes))
 	fmt.Fprintf(w, "%s\t", pretty.Size(jobInfo.Stats.UploadBytes))
+	if jobInfo.State == ppsclient.JobState_JOB_QUEUED && jobInfo.Reason != "" {
+		// Queued jobs say what they're waiting for
+		fmt.Fprintf(w, "%s: %s\t\n", jobState(jobInfo.State), jobInfo.Reason)
+		return
+	}
 	fmt.Fprintf(w, "%s\t\n", jobState(jobInfo.State))
 }
 
@@ -64,6 +71,85 @@ func PrintPipelineInfo(w io.Writer, pipelineInfo *ppsclient.PipelineInfo) {
 	fmt.Fprintf(w, "%s\t\n", pipelineState(pipelineInfo.State))
 }
 
+// PrintPipelineVersionHeader prints a pipeline version header.
+func PrintPipelineVersionHeader(w io.Writer) {
+	fmt.Fprint(w, "VERSION\tIMAGE\tINPUT\tCREATED\t\n")
+}
+
+// PrintPipelineVersion pretty-prints one version of a pipeline.
+func PrintPipelineVersion(w io.Writer, pipelineInfo *ppsclient.PipelineInfo) {
+	fmt.Fprintf(w, "%d\t", pipelineInfo.Version)
+	fmt.Fprintf(w, "%s\t", pipelineInfo.Transform.Image)
+	fmt.Fprintf(w, "%s\t", shorthandInput(pipelineInfo.Input))
+	fmt.Fprintf(w, "%s\t\n", pretty.Ago(pipelineInfo.CreatedAt))
+}
+
+// PrintApplyActionHeader prints an apply action header.
+func PrintApplyActionHeader(w io.Writer) {
+	fmt.Fprint(w, "ACTION\tNAME\tCHANGES\tDOWNSTREAM\t\n")
+}
+
+// PrintApplyAction pretty-prints an action of an apply.
+func PrintApplyAction(w io.Writer, action *ppsclient.ApplyAction) {
+	fmt.Fprintf(w, "%s\t", applyActionType(action))
+	fmt.Fprintf(w, "%s\t", action.Name)
+	if len(action.ChangedFields) > 0 {
+		fmt.Fprintf(w, "%s\t", strings.Join(action.ChangedFields, ", "))
+	} else {
+		fmt.Fprint(w, "-\t")
+	}
+	if len(action.Downstream) > 0 {
+		fmt.Fprintf(w, "%s\t\n", strings.Join(action.Downstream, ", "))
+	} else {
+		fmt.Fprint(w, "-\t\n")
+	}
+}
+
+// PrintPipelineImpactHeader prints a pipeline impact header.
+func PrintPipelineImpactHeader(w io.Writer) {
+	fmt.Fprint(w, "PIPELINE\tDATUMS\tINVALIDATED\tREUSED\tESTIMATED TIME\t\n")
+}
+
+// PrintPipelineImpact pretty-prints the impact of a change on a pipeline.
+func PrintPipelineImpact(w io.Writer, impact *ppsclient.PipelineImpact) {
+	fmt.Fprintf(w, "%s\t", impact.Pipeline.Name)
+	fmt.Fprintf(w, "%d\t", impact.DatumsTotal)
+	fmt.Fprintf(w, "%d\t", impact.DatumsInvalidated)
+	fmt.Fprintf(w, "%d\t", impact.DatumsReused)
+	estimatedTime, err := types.DurationFromProto(impact.EstimatedProcessTime)
+	if impact.EstimatedProcessTime == nil || err != nil {
+		fmt.Fprint(w, "-\t\n")
+	} else {
+		fmt.Fprintf(w, "%s\t\n", units.HumanDuration(estimatedTime))
+	}
+}
+
+// PrintWebhookHeader prints a webhook header.
+func PrintWebhookHeader(w io.Writer) {
+	fmt.Fprint(w, "NAME\tURL\tPIPELINE\tEVENTS\tRECENT ERROR\t\n")
+}
+
+// PrintWebhookInfo pretty-prints webhook info.
+func PrintWebhookInfo(w io.Writer, webhookInfo *ppsclient.WebhookInfo) {
+	fmt.Fprintf(w, "%s\t", webhookInfo.Name)
+	fmt.Fprintf(w, "%s\t", webhookInfo.URL)
+	if webhookInfo.Pipeline != nil {
+		fmt.Fprintf(w, "%s\t", webhookInfo.Pipeline.Name)
+	} else {
+		fmt.Fprint(w, "*\t")
+	}
+	if len(webhookInfo.Events) > 0 {
+		var events []string
+		for _, event := range webhookInfo.Events {
+			events = append(events, eventType(event))
+		}
+		fmt.Fprintf(w, "%s\t", strings.Join(events, ", "))
+	} else {
+		fmt.Fprint(w, "*\t")
+	}
+	fmt.Fprintf(w, "%s\t\n", webhookInfo.RecentError)
+}
+
 // PrintJobInputHeader pretty prints a job input header.
 func PrintJobInputHeader(w io.Writer) {
 	fmt.Fprint(w, "NAME\tREPO\tCOMMIT\tGLOB\tLAZY\t\n")
@@ -129,6 +215,7 @@ State: {{jobState .State}}
 Reason: {{.Reason}}
 Processed: {{.DataProcessed}}
 Skipped: {{.DataSkipped}}
+Failed: {{.DataFailed}}
 Total: {{.DataTotal}}
 Data Downloaded: {{prettySize .Stats.DownloadBytes}}
 Data Uploaded: {{prettySize .Stats.UploadBytes}}
@@ -142,14 +229,17 @@ ParallelismSpec: {{.ParallelismSpec}}
 	CPU: {{ .ResourceSpec.Cpu }}
 	Memory: {{ .ResourceSpec.Memory }} {{end}}
 {{ if .Service }}Service:
-	{{ if .Service.InternalPort }}InternalPort: {{ .Service.InternalPort }} {{end}}
-	{{ if .Service.ExternalPort }}ExternalPort: {{ .Service.ExternalPort }} {{end}} {{end}}Input:
+{{prettyService .Service nil}}
+{{end}}Input:
 {{jobInput .}}
 Transform:
 {{prettyTransform .Transform}} {{if .OutputCommit}}
 Output Commit: {{.OutputCommit.ID}} {{end}} {{ if .StatsCommit }}
 Stats Commit: {{.StatsCommit.ID}} {{end}} {{ if .Egress }}
-Egress: {{.Egress.URL}} {{end}}
+Egress: {{.Egress.URL}} {{end}} {{ if .EgressInfo }}
+Egress Status: {{prettyEgressInfo .EgressInfo}} {{end}} {{ if .FailedDatums }}
+Failed Datums:
+{{failedDatums .FailedDatums}} {{end}}
 `)
 	if err != nil {
 		return err
@@ -166,22 +256,45 @@ func PrintDetailedPipelineInfo(pipelineInfo *ppsclient.PipelineInfo) error {
 	template, err := template.New("PipelineInfo").Funcs(funcMap).Parse(
 		`Name: {{.Pipeline.Name}}{{if .Description}}
 Description: {{.Description}}{{end}}
+Version: {{.Version}}
 Created: {{prettyAgo .CreatedAt}}
 State: {{pipelineState .State}}
 Reason: {{.Reason}}
 Parallelism Spec: {{.ParallelismSpec}}
-{{ if .ResourceSpec }}ResourceSpec:
+{{ if .PriorityClass }}Priority Class: {{.PriorityClass}}
+{{end}}{{ if .Team }}Team: {{.Team}}
+{{end}}{{ if .ResourceSpec }}ResourceSpec:
 	CPU: {{ .ResourceSpec.Cpu }}
 	Memory: {{ .ResourceSpec.Memory }} {{end}}
-Input:
+{{ if .ResourceLimits }}ResourceLimits:
+	CPU: {{ .ResourceLimits.Cpu }}
+	Memory: {{ .ResourceLimits.Memory }}
+	GPU: {{ .ResourceLimits.Gpu }}
+{{end}}{{ if .SchedulingSpec }}SchedulingSpec:
+{{prettySchedulingSpec .SchedulingSpec}}
+{{end}}{{ if .PodPatch }}Pod Patch: {{.PodPatch}}
+{{end}}{{ if .AutoscalingSpec }}Autoscaling:
+{{prettyAutoscaling .}}
+{{end}}{{ if .Spout }}Spout:
+{{prettySpout .Spout}}
+{{end}}{{ if .Service }}Service:
+{{prettyService .Service .ServiceStatus}}
+{{end}}Input:
 {{pipelineInput .}}
-Output Branch: {{.OutputBranch}}
+Output Branch: {{.OutputBranch}} {{ if .DatumTries }}
+Datum Tries: {{.DatumTries}} {{end}} {{ if .DatumTimeout }}
+Datum Timeout: {{prettyDuration .DatumTimeout}} {{end}} {{ if .JobTimeout }}
+Job Timeout: {{prettyDuration .JobTimeout}} {{end}} {{ if .SkipFailedDatums }}
+Skip Failed Datums: {{.SkipFailedDatums}} {{end}}
 Transform:
 {{prettyTransform .Transform}}
 {{ if .Egress }}Egress: {{.Egress.URL}} {{end}}
 {{if .RecentError}} Recent Error: {{.RecentError}} {{end}}
 Job Counts:
-{{jobCounts .JobCounts}}
+{{jobCounts .JobCounts}} {{ if .WorkerSpec }}
+Worker Spec:
+{{.WorkerSpec}}
+{{end}}
 `)
 	if err != nil {
 		return err
@@ -271,6 +384,8 @@ func datumState(datumState ppsclient.DatumState) string {
 
 func jobState(jobState ppsclient.JobState) string {
 	switch jobState {
+	case ppsclient.JobState_JOB_QUEUED:
+		return color.New(color.FgCyan).SprintFunc()("queued")
 	case ppsclient.JobState_JOB_STARTING:
 		return color.New(color.FgYellow).SprintFunc()("starting")
 	case ppsclient.JobState_JOB_RUNNING:
@@ -281,10 +396,35 @@ func jobState(jobState ppsclient.JobState) string {
 		return color.New(color.FgGreen).SprintFunc()("success")
 	case ppsclient.JobState_JOB_KILLED:
 		return color.New(color.FgYellow).SprintFunc()("killed")
+	case ppsclient.JobState_JOB_PARTIAL:
+		return color.New(color.FgYellow).SprintFunc()("partial")
 	}
 	return "-"
 }
 
+func applyActionType(action *ppsclient.ApplyAction) string {
+	switch action.Type {
+	case ppsclient.ApplyActionType_APPLY_CREATE_REPO:
+		return "create repo"
+	case ppsclient.ApplyActionType_APPLY_CREATE_PIPELINE:
+		return "create pipeline"
+	case ppsclient.ApplyActionType_APPLY_UPDATE_PIPELINE:
+		if action.Reprocess {
+			return "update pipeline (reprocess)"
+		}
+		return "update pipeline"
+	case ppsclient.ApplyActionType_APPLY_DELETE_PIPELINE:
+		return "delete pipeline"
+	}
+	return "-"
+}
+
+// eventType returns the name of an event type as it's given to pachctl,
+// e.g. "job_failed"
+func eventType(eventType ppsclient.EventType) string {
+	return strings.ToLower(strings.TrimPrefix(eventType.String(), "EVENT_"))
+}
+
 func pipelineState(pipelineState ppsclient.PipelineState) string {
 	switch pipelineState {
 	case ppsclient.PipelineState_PIPELINE_STARTING:
@@ -337,12 +477,26 @@ func pipelineInput(pipelineInfo *ppsclient.PipelineInfo) string {
 
 func jobCounts(counts map[int32]int32) string {
 	var buffer bytes.Buffer
+	if queued := counts[int32(ppsclient.JobState_JOB_QUEUED)]; queued != 0 {
+		fmt.Fprintf(&buffer, "%s: %d\t", jobState(ppsclient.JobState_JOB_QUEUED), queued)
+	}
 	for i := int32(ppsclient.JobState_JOB_STARTING); i <= int32(ppsclient.JobState_JOB_SUCCESS); i++ {
 		fmt.Fprintf(&buffer, "%s: %d\t", jobState(ppsclient.JobState(i)), counts[i])
 	}
 	return buffer.String()
 }
 
+func failedDatums(failedDatums []*ppsclient.FailedDatum) string {
+	var buffer bytes.Buffer
+	writer := tabwriter.NewWriter(&buffer, 20, 1, 3, ' ', 0)
+	fmt.Fprintf(writer, "ID\tEXIT CODE\tREASON\t\n")
+	for _, failedDatum := range failedDatums {
+		fmt.Fprintf(writer, "%s\t%d\t%s\t\n", failedDatum.DatumID, failedDatum.ExitCode, failedDatum.Reason)
+	}
+	writer.Flush()
+	return buffer.String()
+}
+
 func prettyTransform(transform *ppsclient.Transform) (string, error) {
 	result, err := json.MarshalIndent(transform, "", "  ")
 	if err != nil {
@@ -353,6 +507,8 @@ func prettyTransform(transform *ppsclient.Transform) (string, error) {
 
 func shorthandInput(input *ppsclient.Input) string {
 	switch {
+	case input == nil:
+		return "none"
 	case input.Atom != nil:
 		return fmt.Sprintf("%s:%s", input.Atom.Repo, input.Atom.Glob)
 	case input.Cross != nil:
@@ -385,5 +541,238 @@ var funcMap = template.FuncMap{
 	"prettyDuration":       pretty.Duration,
 	"prettySize":           pretty.Size,
 	"jobCounts":            jobCounts,
+	"failedDatums":         failedDatums,
 	"prettyTransform":      prettyTransform,
+	"prettyEgressInfo":     prettyEgressInfo,
+	"prettySchedulingSpec": prettySchedulingSpec,
+	"prettyAutoscaling":    prettyAutoscaling,
+	"prettySpout":          prettySpout,
+	"prettyService":        prettyService,
+}
+
+func prettySchedulingSpec(schedulingSpec *ppsclient.SchedulingSpec) string {
+	var buffer bytes.Buffer
+	if len(schedulingSpec.NodeSelector) > 0 {
+		var selectors []string
+		for key, value := range schedulingSpec.NodeSelector {
+			selectors = append(selectors, fmt.Sprintf("%s=%s", key, value))
+		}
+		sort.Strings(selectors)
+		fmt.Fprintf(&buffer, "\tNode Selector: %s\n", strings.Join(selectors, ", "))
+	}
+	for _, toleration := range schedulingSpec.Tolerations {
+		operator := toleration.Operator
+		if operator == "" {
+			operator = "Equal"
+		}
+		fmt.Fprintf(&buffer, "\tToleration: %s %s %s", toleration.Key, operator, toleration.Value)
+		if toleration.Effect != "" {
+			fmt.Fprintf(&buffer, " (%s)", toleration.Effect)
+		}
+		fmt.Fprintln(&buffer)
+	}
+	if schedulingSpec.Affinity != "" {
+		fmt.Fprintf(&buffer, "\tAffinity: %s\n", schedulingSpec.Affinity)
+	}
+	return strings.TrimSuffix(buffer.String(), "\n")
+}
+
+func prettyAutoscaling(pipelineInfo *ppsclient.PipelineInfo) string {
+	var buffer bytes.Buffer
+	spec := pipelineInfo.AutoscalingSpec
+	fmt.Fprintf(&buffer, "\tWorkers: %d - %d\n", spec.MinWorkers, spec.MaxWorkers)
+	if spec.TargetQueueSize != 0 {
+		fmt.Fprintf(&buffer, "\tTarget Queue Size: %d\n", spec.TargetQueueSize)
+	}
+	if spec.TargetDrainTime != nil {
+		fmt.Fprintf(&buffer, "\tTarget Drain Time: %s\n", pretty.Duration(spec.TargetDrainTime))
+	}
+	status := pipelineInfo.AutoscalingStatus
+	if status == nil {
+		return strings.TrimSuffix(buffer.String(), "\n")
+	}
+	fmt.Fprintf(&buffer, "\tCurrent Workers: %d\n", status.CurrentWorkers)
+	fmt.Fprintf(&buffer, "\tDesired Workers: %d\n", status.DesiredWorkers)
+	fmt.Fprintf(&buffer, "\tPending Datums: %d\n", status.PendingDatums)
+	fmt.Fprintf(&buffer, "\tQueued Datums: %d\n", status.QueuedDatums)
+	if status.DatumLatency != nil {
+		fmt.Fprintf(&buffer, "\tDatum Latency: %s\n", pretty.Duration(status.DatumLatency))
+	}
+	fmt.Fprintf(&buffer, "\tReason: %s\n", status.Reason)
+	if status.LastScaled != nil {
+		fmt.Fprintf(&buffer, "\tLast Scaled: %s\n", pretty.Ago(status.LastScaled))
+	}
+	fmt.Fprintf(&buffer, "\tUpdated: %s", pretty.Ago(status.Updated))
+	return buffer.String()
+}
+
+func prettySpout(spout *ppsclient.Spout) string {
+	var buffer bytes.Buffer
+	fmt.Fprintf(&buffer, "\tOverwrite: %t\n", spout.Overwrite)
+	if spout.CommitBytes != 0 {
+		fmt.Fprintf(&buffer, "\tCommit Bytes: %s\n", pretty.Size(uint64(spout.CommitBytes)))
+	}
+	if spout.CommitInterval != nil {
+		fmt.Fprintf(&buffer, "\tCommit Interval: %s\n", pretty.Duration(spout.CommitInterval))
+	}
+	if spout.Marker != "" {
+		fmt.Fprintf(&buffer, "\tMarker: %s\n", spout.Marker)
+	}
+	return strings.TrimSuffix(buffer.String(), "\n")
+}
+
+func prettyService(service *ppsclient.Service, status *ppsclient.ServiceStatus) string {
+	var buffer bytes.Buffer
+	serviceType := service.Type
+	if serviceType == "" {
+		serviceType = "NodePort"
+	}
+	fmt.Fprintf(&buffer, "\tType: %s\n", serviceType)
+	for _, port := range ppsclient.ServicePorts(service) {
+		fmt.Fprintf(&buffer, "\tPort %s: %d -> %d\n", port.Name, port.InternalPort, port.ExternalPort)
+	}
+	if probe := service.ReadinessProbe; probe != nil {
+		switch {
+		case len(probe.Exec) > 0:
+			fmt.Fprintf(&buffer, "\tReadiness Probe: exec %s\n", strings.Join(probe.Exec, " "))
+		case probe.HttpPath != "":
+			fmt.Fprintf(&buffer, "\tReadiness Probe: http %s\n", probe.HttpPath)
+		default:
+			fmt.Fprintf(&buffer, "\tReadiness Probe: tcp\n")
+		}
+	}
+	if status != nil {
+		fmt.Fprintf(&buffer, "\tServing: %s\n", prettyServiceData(status.Job, status.Commits))
+		if status.PendingJob != "" {
+			fmt.Fprintf(&buffer, "\tRolling Out: %s\n", prettyServiceData(status.PendingJob, status.PendingCommits))
+		}
+		fmt.Fprintf(&buffer, "\tUpdated: %s\n", pretty.Ago(status.Updated))
+	}
+	return strings.TrimSuffix(buffer.String(), "\n")
+}
+
+func prettyServiceData(jobID string, commits []*pfs.Commit) string {
+	if jobID == "" {
+		return "-"
+	}
+	var commitStrs []string
+	for _, commit := range commits {
+		commitStrs = append(commitStrs, fmt.Sprintf("%s@%s", commit.Repo.Name, commit.ID))
+	}
+	return fmt.Sprintf("%s (job %s)", strings.Join(commitStrs, ", "), jobID)
+}
+
+// PrintEgressInfo pretty-prints the result of pushing a job's output to its
+// egress.
+func PrintEgressInfo(w io.Writer, egressInfo *ppsclient.EgressInfo) {
+	fmt.Fprintf(w, "%s\n", prettyEgressInfo(egressInfo))
+}
+
+func prettyEgressInfo(egressInfo *ppsclient.EgressInfo) string {
+	var buffer bytes.Buffer
+	switch egressInfo.State {
+	case ppsclient.EgressState_EGRESS_RUNNING:
+		fmt.Fprintf(&buffer, "%s to %s", color.New(color.FgYellow).SprintFunc()("running"), egressInfo.URL)
+		return buffer.String()
+	case ppsclient.EgressState_EGRESS_SUCCESS:
+		fmt.Fprintf(&buffer, "%s to %s", color.New(color.FgGreen).SprintFunc()("success"), egressInfo.URL)
+	case ppsclient.EgressState_EGRESS_FAILURE:
+		fmt.Fprintf(&buffer, "%s to %s: %s", color.New(color.FgRed).SprintFunc()("failure"), egressInfo.URL, egressInfo.Reason)
+		return buffer.String()
+	}
+	fmt.Fprintf(&buffer, " (%d files pushed, %d files deleted, %s", egressInfo.FilesPushed, egressInfo.FilesDeleted, pretty.Size(egressInfo.BytesPushed))
+	if egressInfo.BaseCommit != nil {
+		fmt.Fprintf(&buffer, ", changes since %s", egressInfo.BaseCommit.ID)
+	}
+	fmt.Fprintf(&buffer, ")")
+	return buffer.String()
+}
+
+// PrintFileTrace pretty-prints the lineage of a file as a tree: each file is
+// followed by the datums that wrote it, and each datum by its input files.
+func PrintFileTrace(w io.Writer, trace *ppsclient.FileTrace) {
+	printFileTrace(w, trace, "")
+}
+
+func printFileTrace(w io.Writer, trace *ppsclient.FileTrace, indent string) {
+	file := trace.File
+	fmt.Fprintf(w, "%s%s@%s:%s", indent, file.Commit.Repo.Name, file.Commit.ID, file.Path)
+	if trace.Job != nil {
+		fmt.Fprintf(w, " (job %s)", trace.Job.ID)
+		if len(trace.Datums) == 0 {
+			fmt.Fprintf(w, " [no datums found]")
+		}
+	}
+	fmt.Fprintln(w)
+	for _, datum := range trace.Datums {
+		fmt.Fprintf(w, "%s  datum %s\n", indent, datum.DatumID)
+		for _, input := range datum.Inputs {
+			printFileTrace(w, input, indent+"    ")
+		}
+	}
+}
+
+// PrintDatumsForInput pretty-prints the datums that a pipeline would
+// process: a summary of their sizes, a histogram of them, and a sample.
+func PrintDatumsForInput(w io.Writer, response *ppsclient.ListDatumForInputResponse) {
+	fmt.Fprintf(w, "Datums:\t%d\n", response.DatumCount)
+	fmt.Fprintf(w, "Total Size:\t%s\n", pretty.Size(uint64(response.TotalBytes)))
+	if response.DatumCount == 0 {
+		return
+	}
+	fmt.Fprintf(w, "Smallest Datum:\t%s\n", pretty.Size(uint64(response.MinDatumBytes)))
+	fmt.Fprintf(w, "Largest Datum:\t%s\n", pretty.Size(uint64(response.MaxDatumBytes)))
+	fmt.Fprintf(w, "\nSIZE\tDATUMS\t\n")
+	for _, bucket := range response.SizeHistogram {
+		fmt.Fprintf(w, "%s - %s\t%d\t\n", pretty.Size(uint64(bucket.LowerBoundBytes)), pretty.Size(uint64(bucket.UpperBoundBytes)), bucket.Count)
+	}
+	fmt.Fprintf(w, "\nID\tSIZE\tFILES\t\n")
+	for _, datumInfo := range response.DatumInfos {
+		var size uint64
+		var files []string
+		for _, fileInfo := range datumInfo.Data {
+			size += fileInfo.SizeBytes
+			files = append(files, fmt.Sprintf("%s@%s:%s", fileInfo.File.Commit.Repo.Name, fileInfo.File.Commit.ID, fileInfo.File.Path))
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t\n", datumInfo.Datum.ID, pretty.Size(size), strings.Join(files, ", "))
+	}
+	if int64(len(response.DatumInfos)) < response.DatumCount {
+		fmt.Fprintf(w, "(%d more)\t\t\t\n", response.DatumCount-int64(len(response.DatumInfos)))
+	}
+}
+
+// PrintAdmissionPolicy pretty-prints an admission policy.
+func PrintAdmissionPolicy(w io.Writer, policy *ppsclient.AdmissionPolicy) {
+	if len(policy.PriorityClasses) == 0 && len(policy.Teams) == 0 && policy.MaxRunningJobs == 0 {
+		fmt.Fprintln(w, "No admission policy; jobs start as soon as they're created.")
+		return
+	}
+	if policy.MaxRunningJobs != 0 {
+		fmt.Fprintf(w, "Max Running Jobs: %d\n", policy.MaxRunningJobs)
+	}
+	if policy.DefaultPriorityClass != "" {
+		fmt.Fprintf(w, "Default Priority Class: %s\n", policy.DefaultPriorityClass)
+	}
+	writer := tabwriter.NewWriter(w, 20, 1, 3, ' ', 0)
+	if len(policy.PriorityClasses) > 0 {
+		fmt.Fprint(writer, "PRIORITY CLASS\tPRIORITY\tMAX RUNNING JOBS\tMAX WORKERS\t\n")
+		for _, class := range policy.PriorityClasses {
+			fmt.Fprintf(writer, "%s\t%d\t%s\t%s\t\n", class.Name, class.Priority, limit(class.MaxRunningJobs), limit(class.MaxWorkers))
+		}
+	}
+	if len(policy.Teams) > 0 {
+		fmt.Fprint(writer, "TEAM\t\tMAX RUNNING JOBS\tMAX WORKERS\t\n")
+		for _, team := range policy.Teams {
+			fmt.Fprintf(writer, "%s\t\t%s\t%s\t\n", team.Team, limit(team.MaxRunningJobs), limit(team.MaxWorkers))
+		}
+	}
+	writer.Flush()
+}
+
+// limit formats a limit of an admission policy, where 0 means no limit
+func limit(n int64) string {
+	if n == 0 {
+		return "-"
+	}
+	return fmt.Sprintf("%d", n)
 }
@@ -0,0 +1,271 @@
+package server
+
+import (
+	"fmt"
+	"path"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+
+	"github.com/pachyderm/pachyderm/src/client/auth"
+	"github.com/pachyderm/pachyderm/src/client/pps"
+	"github.com/pachyderm/pachyderm/src/server/pkg/admission"
+	"github.com/pachyderm/pachyderm/src/server/pkg/backoff"
+	col "github.com/pachyderm/pachyderm/src/server/pkg/collection"
+	"github.com/pachyderm/pachyderm/src/server/pkg/dlock"
+	"github.com/pachyderm/pachyderm/src/server/pkg/ppsdb"
+	ppsserver "github.com/pachyderm/pachyderm/src/server/pps"
+)
+
+const (
+	admitterLockPath = "_admitter_lock"
+
+	// admissionInterval is how often queued jobs are reconsidered, if jobs
+	// have changed since they last were
+	admissionInterval = time.Second
+)
+
+func (a *apiServer) SetAdmissionPolicy(ctx context.Context, request *pps.AdmissionPolicy) (response *types.Empty, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+
+	pachClient, err := a.getPachClient()
+	if err != nil {
+		return nil, err
+	}
+	if me, err := pachClient.WhoAmI(auth.In2Out(ctx), &auth.WhoAmIRequest{}); err == nil {
+		if !me.IsAdmin {
+			return nil, fmt.Errorf("not authorized to set the admission policy, must " +
+				"be a cluster admin")
+		}
+	} else if !auth.IsNotActivatedError(err) {
+		return nil, fmt.Errorf("could not verify that caller is admin: %v", err)
+	}
+	if err := admission.Validate(request); err != nil {
+		return nil, fmt.Errorf("invalid admission policy: %v", err)
+	}
+	if _, err := col.NewSTM(ctx, a.etcdClient, func(stm col.STM) error {
+		policies := a.admissionPolicy.ReadWrite(stm)
+		if admission.Empty(request) {
+			policies.DeleteAll()
+			return nil
+		}
+		return policies.Put(ppsdb.AdmissionPolicyKey, request)
+	}); err != nil {
+		return nil, err
+	}
+	return &types.Empty{}, nil
+}
+
+func (a *apiServer) GetAdmissionPolicy(ctx context.Context, request *types.Empty) (response *pps.AdmissionPolicy, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+
+	return a.getAdmissionPolicy(ctx)
+}
+
+// getAdmissionPolicy returns the cluster's admission policy, which is empty
+// if none has been set
+func (a *apiServer) getAdmissionPolicy(ctx context.Context) (*pps.AdmissionPolicy, error) {
+	policy := &pps.AdmissionPolicy{}
+	if err := a.admissionPolicy.ReadOnly(ctx).Get(ppsdb.AdmissionPolicyKey, policy); err != nil && !col.IsErrNotFound(err) {
+		return nil, err
+	}
+	return policy, nil
+}
+
+// The admitter process starts queued jobs, as the admission policy allows.
+// Jobs are queued by CreateJob when there's an admission policy, and wait in
+// the JOB_QUEUED state until the admitter moves them to JOB_STARTING, which
+// the masters of their pipelines wait for.
+func (a *apiServer) admitter() {
+	admitterLock := dlock.NewDLock(a.etcdClient, path.Join(a.etcdPrefix, admitterLockPath))
+	backoff.RetryNotify(func() error {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		ctx, err := admitterLock.Lock(ctx)
+		if err != nil {
+			return err
+		}
+		defer admitterLock.Unlock(ctx)
+
+		log.Infof("Launching PPS admitter process")
+		return a.admitJobs(ctx)
+	}, backoff.NewInfiniteBackOff(), func(err error, d time.Duration) error {
+		log.Errorf("admitter: error running the admitter process: %v; retrying in %v", err, d)
+		return nil
+	})
+}
+
+// admitJobs reconsiders the queued jobs whenever jobs or the admission
+// policy change. Changes are batched, since a burst of job updates needs
+// only one pass.
+func (a *apiServer) admitJobs(ctx context.Context) error {
+	jobWatcher, err := a.jobs.ReadOnly(ctx).Watch()
+	if err != nil {
+		return fmt.Errorf("error creating watch: %v", err)
+	}
+	defer jobWatcher.Close()
+	policyWatcher, err := a.admissionPolicy.ReadOnly(ctx).Watch()
+	if err != nil {
+		return fmt.Errorf("error creating watch: %v", err)
+	}
+	defer policyWatcher.Close()
+
+	ticker := time.NewTicker(admissionInterval)
+	defer ticker.Stop()
+	changed := true
+	for {
+		select {
+		case e := <-jobWatcher.Watch():
+			if e.Err != nil {
+				return fmt.Errorf("event err: %v", e.Err)
+			}
+			changed = true
+		case e := <-policyWatcher.Watch():
+			if e.Err != nil {
+				return fmt.Errorf("event err: %v", e.Err)
+			}
+			changed = true
+		case <-ticker.C:
+			if !changed {
+				continue
+			}
+			changed = false
+			if err := a.admit(ctx); err != nil {
+				return err
+			}
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// admit starts the queued jobs that the admission policy allows, and
+// records why the others are still queued
+func (a *apiServer) admit(ctx context.Context) error {
+	policy, err := a.getAdmissionPolicy(ctx)
+	if err != nil {
+		return err
+	}
+	var running, queued []*admission.Job
+	reasons := make(map[string]string)
+	// The workers of each pipeline, and its class and team, which are looked
+	// up once per pipeline
+	pipelineJobs := make(map[string]*admission.Job)
+	iter, err := a.jobs.ReadOnly(ctx).List()
+	if err != nil {
+		return err
+	}
+	for {
+		var jobID string
+		jobInfo := new(pps.JobInfo)
+		ok, err := iter.Next(&jobID, jobInfo)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			break
+		}
+		if jobInfo.Service != nil || jobStateToStopped(jobInfo.State) {
+			continue
+		}
+		job := &admission.Job{ID: jobInfo.Job.ID, Workers: 1}
+		if jobInfo.Pipeline != nil {
+			pipelineJob, ok := pipelineJobs[jobInfo.Pipeline.Name]
+			if !ok {
+				pipelineJob = a.pipelineAdmissionJob(ctx, jobInfo)
+				pipelineJobs[jobInfo.Pipeline.Name] = pipelineJob
+			}
+			job.PriorityClass = pipelineJob.PriorityClass
+			job.Team = pipelineJob.Team
+			job.Workers = pipelineJob.Workers
+		}
+		if jobInfo.State == pps.JobState_JOB_QUEUED {
+			if started, err := types.TimestampFromProto(jobInfo.Started); err == nil {
+				job.Queued = started
+			}
+			queued = append(queued, job)
+			reasons[job.ID] = jobInfo.Reason
+		} else {
+			running = append(running, job)
+		}
+	}
+	if len(queued) == 0 {
+		return nil
+	}
+
+	decision := admission.Admit(policy, running, queued)
+	for _, jobID := range decision.Admitted {
+		if err := a.setQueuedJob(ctx, jobID, pps.JobState_JOB_STARTING, ""); err != nil {
+			return err
+		}
+		log.Infof("admitter: admitted job %s", jobID)
+	}
+	for jobID, reason := range decision.Reasons {
+		if reason == reasons[jobID] {
+			continue
+		}
+		if err := a.setQueuedJob(ctx, jobID, pps.JobState_JOB_QUEUED, reason); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// pipelineAdmissionJob returns the priority class, team and number of
+// workers of the pipeline of 'jobInfo', as an admission.Job
+func (a *apiServer) pipelineAdmissionJob(ctx context.Context, jobInfo *pps.JobInfo) *admission.Job {
+	result := &admission.Job{
+		PriorityClass: jobInfo.PriorityClass,
+		Team:          jobInfo.Team,
+		Workers:       1,
+	}
+	pipelineInfo := new(pps.PipelineInfo)
+	if err := a.pipelines.ReadOnly(ctx).Get(jobInfo.Pipeline.Name, pipelineInfo); err != nil {
+		// The pipeline may have been deleted, in which case its jobs are
+		// about to be too
+		return result
+	}
+	// The pipeline's current class and team apply to its queued jobs
+	result.PriorityClass = pipelineInfo.PriorityClass
+	result.Team = pipelineInfo.Team
+	if pipelineInfo.AutoscalingSpec != nil {
+		result.Workers = pipelineInfo.AutoscalingSpec.MaxWorkers
+	} else if a.kubeClient != nil {
+		workers, err := ppsserver.GetExpectedNumWorkers(a.kubeClient, pipelineInfo.ParallelismSpec)
+		if err != nil {
+			log.Errorf("admitter: error getting number of workers of %s, assuming 1: %v", pipelineInfo.Pipeline.Name, err)
+		} else {
+			result.Workers = int64(workers)
+		}
+	}
+	return result
+}
+
+// setQueuedJob sets the state and reason of the queued job 'jobID', unless
+// it has left the queue (e.g. because it's been stopped)
+func (a *apiServer) setQueuedJob(ctx context.Context, jobID string, state pps.JobState, reason string) error {
+	_, err := col.NewSTM(ctx, a.etcdClient, func(stm col.STM) error {
+		jobInfo := new(pps.JobInfo)
+		if err := a.jobs.ReadWrite(stm).Get(jobID, jobInfo); err != nil {
+			if col.IsErrNotFound(err) {
+				return nil
+			}
+			return err
+		}
+		if jobInfo.State != pps.JobState_JOB_QUEUED {
+			return nil
+		}
+		jobInfo.Reason = reason
+		if state == jobInfo.State {
+			a.jobs.ReadWrite(stm).Put(jobID, jobInfo)
+			return nil
+		}
+		return a.updateJobState(stm, jobInfo, state)
+	})
+	return err
+}
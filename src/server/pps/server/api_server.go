@@ -3,9 +3,11 @@ package server
 import (
 	"bufio"
 	"bytes"
+	"encoding/json"
 	"fmt"
 	"io"
 	"math"
+	"path"
 	"path/filepath"
 	"sort"
 	"strconv"
@@ -20,12 +22,17 @@ import (
 	"github.com/pachyderm/pachyderm/src/client/pkg/grpcutil"
 	"github.com/pachyderm/pachyderm/src/client/pkg/uuid"
 	"github.com/pachyderm/pachyderm/src/client/pps"
+	"github.com/pachyderm/pachyderm/src/server/pkg/admission"
 	"github.com/pachyderm/pachyderm/src/server/pkg/backoff"
 	col "github.com/pachyderm/pachyderm/src/server/pkg/collection"
+	"github.com/pachyderm/pachyderm/src/server/pkg/egress"
 	"github.com/pachyderm/pachyderm/src/server/pkg/hashtree"
 	"github.com/pachyderm/pachyderm/src/server/pkg/log"
 	"github.com/pachyderm/pachyderm/src/server/pkg/metrics"
+	"github.com/pachyderm/pachyderm/src/server/pkg/podpatch"
 	"github.com/pachyderm/pachyderm/src/server/pkg/ppsdb"
+	"github.com/pachyderm/pachyderm/src/server/pkg/prom"
+	"github.com/pachyderm/pachyderm/src/server/pkg/util"
 	"github.com/pachyderm/pachyderm/src/server/pkg/watch"
 	ppsserver "github.com/pachyderm/pachyderm/src/server/pps"
 	workerpkg "github.com/pachyderm/pachyderm/src/server/worker"
@@ -33,6 +40,7 @@ import (
 
 	etcd "github.com/coreos/etcd/clientv3"
 	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/proto"
 	"github.com/gogo/protobuf/types"
 	logrus "github.com/sirupsen/logrus"
 	"golang.org/x/net/context"
@@ -60,6 +68,16 @@ var (
 	trueVal = true
 	zeroVal = int64(0)
 	suite   = "pachyderm"
+
+	// jobStateChanges counts the jobs entering each state. Jobs are counted
+	// as starting by the pachd that creates them, and their subsequent
+	// changes by the pachd that runs the webhook notifier, which watches all
+	// jobs, so each change is counted exactly once across the cluster.
+	jobStateChanges = prom.NewCounterVec(
+		"pachyderm_job_state_changes_total",
+		"Number of jobs that entered each state, by pipeline.",
+		"pipeline", "state",
+	)
 )
 
 func newErrJobNotFound(job string) error {
@@ -113,8 +131,11 @@ type apiServer struct {
 	imagePullSecret       string
 	reporter              *metrics.Reporter
 	// collections
-	pipelines col.Collection
-	jobs      col.Collection
+	pipelines         col.Collection
+	jobs              col.Collection
+	webhooks          col.Collection
+	webhookDeliveries col.Collection
+	admissionPolicy   col.Collection
 }
 
 func merge(from, to map[string]bool) {
@@ -158,7 +179,7 @@ func validateNames(names map[string]bool, input *pps.Input) error {
 	return nil
 }
 
-func (a *apiServer) validateInput(ctx context.Context, pipelineName string, input *pps.Input, job bool) error {
+func (a *apiServer) validateInput(ctx context.Context, pipelineName string, input *pps.Input, job bool, pendingRepos map[string]bool) error {
 	pachClient, err := a.getPachClient()
 	if err != nil {
 		return err
@@ -194,16 +215,26 @@ func (a *apiServer) validateInput(ctx context.Context, pipelineName string, inpu
 				}
 				repoBranch[input.Atom.Repo] = input.Atom.Branch
 				if job {
-					// for jobs we check that the input commit exists
-					if _, err := pachClient.InspectCommit(input.Atom.Repo, input.Atom.Commit); err != nil {
+					// for jobs we check that the input commit exists, and pin
+					// revisions like "master^" to the commit they name now
+					commitInfo, err := pachClient.InspectCommit(input.Atom.Repo, input.Atom.Commit)
+					if err != nil {
 						return err
 					}
-				} else {
+					input.Atom.Commit = commitInfo.Commit.ID
+				} else if !pendingRepos[input.Atom.Repo] {
 					// for pipelines we only check that the repo exists
 					if _, err = pachClient.InspectRepo(input.Atom.Repo); err != nil {
 						return err
 					}
 				}
+				if !job && input.Atom.FromCommit != "" && !pendingRepos[input.Atom.Repo] {
+					commitInfo, err := pachClient.InspectCommit(input.Atom.Repo, input.Atom.FromCommit)
+					if err != nil {
+						return err
+					}
+					input.Atom.FromCommit = commitInfo.Commit.ID
+				}
 			}
 			if input.Cross != nil {
 				if set {
@@ -225,6 +256,9 @@ func (a *apiServer) validateInput(ctx context.Context, pipelineName string, inpu
 				if _, err := cron.Parse(input.Cron.Spec); err != nil {
 					return err
 				}
+				if pendingRepos[input.Cron.Repo] {
+					return nil
+				}
 				if _, err := pachClient.InspectRepo(input.Cron.Repo); err != nil {
 					return err
 				}
@@ -247,11 +281,149 @@ func validateTransform(transform *pps.Transform) error {
 	return nil
 }
 
+// validateAutoscalingSpec checks that 'pipelineInfo's AutoscalingSpec has
+// sensible bounds and durations, and that it can be autoscaled at all
+func validateAutoscalingSpec(pipelineInfo *pps.PipelineInfo) error {
+	spec := pipelineInfo.AutoscalingSpec
+	if spec.MinWorkers < 0 {
+		return fmt.Errorf("min_workers must be >= 0")
+	}
+	if spec.MaxWorkers < 1 {
+		return fmt.Errorf("max_workers must be >= 1")
+	}
+	if spec.MinWorkers > spec.MaxWorkers {
+		return fmt.Errorf("min_workers (%d) is greater than max_workers (%d)", spec.MinWorkers, spec.MaxWorkers)
+	}
+	if spec.TargetQueueSize < 0 {
+		return fmt.Errorf("target_queue_size must be >= 0")
+	}
+	for name, d := range map[string]*types.Duration{
+		"target_drain_time":   spec.TargetDrainTime,
+		"scale_up_cooldown":   spec.ScaleUpCooldown,
+		"scale_down_cooldown": spec.ScaleDownCooldown,
+	} {
+		if d == nil {
+			continue
+		}
+		duration, err := types.DurationFromProto(d)
+		if err != nil {
+			return fmt.Errorf("invalid %s: %v", name, err)
+		}
+		if duration <= 0 {
+			return fmt.Errorf("%s must be positive", name)
+		}
+	}
+	if pipelineInfo.Service != nil {
+		return fmt.Errorf("services can't be autoscaled")
+	}
+	if pipelineInfo.ScaleDownThreshold != nil {
+		return fmt.Errorf("autoscaled pipelines can't also set scale_down_threshold")
+	}
+	return nil
+}
+
+// validateService checks that the ports and readiness probe of 'service'
+// are consistent
+func validateService(service *pps.Service) error {
+	if len(service.Ports) > 0 && (service.InternalPort != 0 || service.ExternalPort != 0) {
+		return fmt.Errorf("must set at most one of ports and internal_port/external_port")
+	}
+	if len(service.Ports) == 0 && service.ExternalPort != 0 && service.InternalPort == 0 {
+		return fmt.Errorf("external_port is set, but internal_port isn't")
+	}
+	switch service.Type {
+	case "", string(api.ServiceTypeNodePort), string(api.ServiceTypeClusterIP):
+	default:
+		return fmt.Errorf("unknown type %q, must be NodePort or ClusterIP", service.Type)
+	}
+	ports := pps.ServicePorts(service)
+	names := make(map[string]bool)
+	for _, port := range ports {
+		if port.InternalPort <= 0 || port.InternalPort > 65535 || port.ExternalPort < 0 || port.ExternalPort > 65535 {
+			return fmt.Errorf("port %q is out of range", port.Name)
+		}
+		if len(ports) > 1 && port.Name == "" {
+			return fmt.Errorf("ports must be named if there's more than one")
+		}
+		if names[port.Name] {
+			return fmt.Errorf("port %q is defined more than once", port.Name)
+		}
+		names[port.Name] = true
+	}
+	if probe := service.ReadinessProbe; probe != nil {
+		if probe.HttpPath != "" && len(probe.Exec) > 0 {
+			return fmt.Errorf("readiness_probe must set at most one of http_path and exec")
+		}
+		if len(probe.Exec) == 0 && probe.Port == 0 && len(ports) == 0 {
+			return fmt.Errorf("readiness_probe needs a port, as the service has none")
+		}
+		if probe.Port < 0 || probe.Port > 65535 || probe.FailureThreshold < 0 {
+			return fmt.Errorf("readiness_probe's port and failure_threshold must be in range")
+		}
+		for name, d := range map[string]*types.Duration{
+			"initial_delay": probe.InitialDelay,
+			"period":        probe.Period,
+			"timeout":       probe.Timeout,
+		} {
+			if d == nil {
+				continue
+			}
+			duration, err := types.DurationFromProto(d)
+			if err != nil {
+				return fmt.Errorf("invalid readiness_probe %s: %v", name, err)
+			}
+			if duration < 0 || (duration < time.Second && name != "initial_delay") {
+				return fmt.Errorf("readiness_probe %s must be at least 1s", name)
+			}
+		}
+	}
+	return nil
+}
+
+// validateSpout checks that 'pipelineInfo's Spout is valid, and that the
+// pipeline doesn't use anything that only pipelines with inputs can
+func validateSpout(pipelineInfo *pps.PipelineInfo) error {
+	spout := pipelineInfo.Spout
+	if pipelineInfo.Input != nil {
+		return fmt.Errorf("spouts can't have an input")
+	}
+	if pipelineInfo.Service != nil {
+		return fmt.Errorf("spouts can't be services")
+	}
+	if pipelineInfo.Egress != nil {
+		return fmt.Errorf("spouts have no jobs, so can't have an egress")
+	}
+	if pipelineInfo.AutoscalingSpec != nil || pipelineInfo.ScaleDownThreshold != nil {
+		return fmt.Errorf("spouts can't be scaled")
+	}
+	if pipelineInfo.ParallelismSpec != nil && pipelineInfo.ParallelismSpec.Constant != 1 {
+		return fmt.Errorf("spouts can only be run with a constant parallelism of 1")
+	}
+	if spout.CommitBytes < 0 {
+		return fmt.Errorf("commit_bytes must be >= 0")
+	}
+	if spout.CommitInterval != nil {
+		interval, err := types.DurationFromProto(spout.CommitInterval)
+		if err != nil {
+			return fmt.Errorf("invalid commit_interval: %v", err)
+		}
+		if interval <= 0 {
+			return fmt.Errorf("commit_interval must be positive")
+		}
+	}
+	if spout.Marker != "" {
+		if spout.Marker == "out" || strings.ContainsAny(spout.Marker, "/") || spout.Marker == "." || spout.Marker == ".." {
+			return fmt.Errorf("marker must be a file name other than \"out\", not %q", spout.Marker)
+		}
+	}
+	return nil
+}
+
 func (a *apiServer) validateJob(ctx context.Context, jobInfo *pps.JobInfo) error {
 	if err := validateTransform(jobInfo.Transform); err != nil {
 		return err
 	}
-	return a.validateInput(ctx, jobInfo.Pipeline.Name, jobInfo.Input, true)
+	return a.validateInput(ctx, jobInfo.Pipeline.Name, jobInfo.Input, true, nil)
 }
 
 func (a *apiServer) validateKube() {
@@ -337,6 +509,7 @@ func (a *apiServer) CreateJob(ctx context.Context, request *pps.CreateJobRequest
 
 	job := &pps.Job{uuid.NewWithoutUnderscores()}
 	pps.SortInput(request.Input)
+	var state pps.JobState
 	_, err := col.NewSTM(ctx, a.etcdClient, func(stm col.STM) error {
 		jobInfo := &pps.JobInfo{
 			Job:             job,
@@ -359,6 +532,7 @@ func (a *apiServer) CreateJob(ctx context.Context, request *pps.CreateJobRequest
 			Salt:            request.Salt,
 			PipelineVersion: request.PipelineVersion,
 			Batch:           request.Batch,
+			Rerun:           request.Rerun,
 		}
 		if request.Pipeline != nil {
 			pipelineInfo := new(pps.PipelineInfo)
@@ -376,6 +550,8 @@ func (a *apiServer) CreateJob(ctx context.Context, request *pps.CreateJobRequest
 			jobInfo.ResourceSpec = pipelineInfo.ResourceSpec
 			jobInfo.Incremental = pipelineInfo.Incremental
 			jobInfo.EnableStats = pipelineInfo.EnableStats
+			jobInfo.PriorityClass = pipelineInfo.PriorityClass
+			jobInfo.Team = pipelineInfo.Team
 		} else {
 			if jobInfo.OutputRepo == nil {
 				jobInfo.OutputRepo = &pfs.Repo{job.ID}
@@ -384,11 +560,26 @@ func (a *apiServer) CreateJob(ctx context.Context, request *pps.CreateJobRequest
 		if err := a.validateJob(ctx, jobInfo); err != nil {
 			return err
 		}
-		return a.updateJobState(stm, jobInfo, pps.JobState_JOB_STARTING)
+		// If there's an admission policy, the job waits for the admitter to
+		// let it start. Services run until they're replaced, so they're
+		// never queued.
+		state = pps.JobState_JOB_STARTING
+		if jobInfo.Service == nil {
+			policy := new(pps.AdmissionPolicy)
+			if err := a.admissionPolicy.ReadWrite(stm).Get(ppsdb.AdmissionPolicyKey, policy); err != nil && !col.IsErrNotFound(err) {
+				return err
+			}
+			if !admission.Empty(policy) {
+				state = pps.JobState_JOB_QUEUED
+				jobInfo.Reason = "waiting for admission"
+			}
+		}
+		return a.updateJobState(stm, jobInfo, state)
 	})
 	if err != nil {
 		return nil, err
 	}
+	jobStateChanges.WithLabelValues(request.Pipeline.GetName(), state.String()).Inc()
 	return job, nil
 }
 
@@ -527,6 +718,66 @@ func (a *apiServer) StopJob(ctx context.Context, request *pps.StopJobRequest) (r
 	return &types.Empty{}, nil
 }
 
+func (a *apiServer) EgressJob(ctx context.Context, request *pps.EgressJobRequest) (response *pps.EgressInfo, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+
+	jobInfo, err := a.InspectJob(ctx, &pps.InspectJobRequest{
+		Job: request.Job,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if jobInfo.Egress == nil {
+		return nil, fmt.Errorf("job %s has no egress", jobInfo.Job.ID)
+	}
+	if jobInfo.OutputCommit == nil {
+		return nil, fmt.Errorf("job %s has no output commit to egress", jobInfo.Job.ID)
+	}
+	// Refuse to push older output over newer output
+	last, err := egress.LastEgressed(a.jobs.ReadOnly(ctx), jobInfo.Pipeline, jobInfo.Egress.URL)
+	if err != nil {
+		return nil, err
+	}
+	if last != nil && last.Job.ID != jobInfo.Job.ID && last.Started.Compare(jobInfo.Started) > 0 {
+		return nil, fmt.Errorf("job %s, which started after job %s, has already been egressed to %s", last.Job.ID, jobInfo.Job.ID, jobInfo.Egress.URL)
+	}
+	pachClient, err := a.getPachClient()
+	if err != nil {
+		return nil, err
+	}
+	egressInfo := egress.Egress(ctx, pachClient, a.jobs.ReadOnly(ctx), jobInfo.Pipeline, jobInfo.OutputCommit, jobInfo.Egress)
+	if _, err := col.NewSTM(ctx, a.etcdClient, func(stm col.STM) error {
+		jobs := a.jobs.ReadWrite(stm)
+		jobInfo := new(pps.JobInfo)
+		if err := jobs.Get(request.Job.ID, jobInfo); err != nil {
+			return err
+		}
+		failedEgress := jobInfo.EgressInfo != nil && jobInfo.EgressInfo.State == pps.EgressState_EGRESS_FAILURE
+		jobInfo.EgressInfo = egressInfo
+		if jobInfo.State != pps.JobState_JOB_FAILURE || !failedEgress {
+			jobs.Put(jobInfo.Job.ID, jobInfo)
+			return nil
+		}
+		// The job only failed because its egress did, so it's fixed now
+		// that egress has succeeded
+		if egressInfo.State == pps.EgressState_EGRESS_FAILURE {
+			jobInfo.Reason = fmt.Sprintf("egress error: %v", egressInfo.Reason)
+			jobs.Put(jobInfo.Job.ID, jobInfo)
+			return nil
+		}
+		if len(jobInfo.FailedDatums) > 0 {
+			jobInfo.Reason = fmt.Sprintf("%d datums failed", len(jobInfo.FailedDatums))
+			return a.updateJobState(stm, jobInfo, pps.JobState_JOB_PARTIAL)
+		}
+		jobInfo.Reason = ""
+		return a.updateJobState(stm, jobInfo, pps.JobState_JOB_SUCCESS)
+	}); err != nil {
+		return nil, err
+	}
+	return egressInfo, nil
+}
+
 func (a *apiServer) RestartDatum(ctx context.Context, request *pps.RestartDatumRequest) (response *types.Empty, retErr error) {
 	func() { a.Log(request, nil, nil, 0) }()
 	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
@@ -544,6 +795,123 @@ func (a *apiServer) RestartDatum(ctx context.Context, request *pps.RestartDatumR
 	return &types.Empty{}, nil
 }
 
+func (a *apiServer) TraceFile(ctx context.Context, request *pps.TraceFileRequest) (response *pps.FileTrace, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+
+	if request.File == nil || request.File.Commit == nil || request.File.Commit.Repo == nil {
+		return nil, fmt.Errorf("file must be specified")
+	}
+	pachClient, err := a.getPachClient()
+	if err != nil {
+		return nil, err
+	}
+	return a.traceFile(ctx, pachClient.WithCtx(ctx), request.File, request.Depth)
+}
+
+// traceFile finds the datums of the job that wrote 'file' (if any), and
+// traces their inputs in turn, stopping after 'depth' jobs (or never, if
+// 'depth' is 0)
+func (a *apiServer) traceFile(ctx context.Context, pachClient *client.APIClient, file *pfs.File, depth int64) (*pps.FileTrace, error) {
+	// Resolve branch names, so that the commit can be matched against the
+	// output commits of jobs
+	commitInfo, err := pachClient.InspectCommit(file.Commit.Repo.Name, file.Commit.ID)
+	if err != nil {
+		return nil, err
+	}
+	trace := &pps.FileTrace{
+		File: &pfs.File{
+			Commit: commitInfo.Commit,
+			Path:   file.Path,
+		},
+	}
+	jobInfo, err := a.jobForOutputCommit(ctx, commitInfo.Commit)
+	if err != nil {
+		return nil, err
+	}
+	if jobInfo == nil {
+		// 'file' wasn't written by a pipeline
+		return trace, nil
+	}
+	trace.Job = jobInfo.Job
+	lineage, err := readLineage(pachClient, jobInfo)
+	if err != nil {
+		return nil, err
+	}
+	if lineage == nil {
+		// The job predates lineage tracking
+		return trace, nil
+	}
+	for _, datum := range lineage.Datums {
+		if !datumWrote(datum, file.Path) {
+			continue
+		}
+		datumTrace := &pps.DatumTrace{DatumID: datum.DatumID}
+		for _, input := range datum.Inputs {
+			if depth == 1 {
+				datumTrace.Inputs = append(datumTrace.Inputs, &pps.FileTrace{File: input})
+				continue
+			}
+			inputTrace, err := a.traceFile(ctx, pachClient, input, depth-1)
+			if err != nil {
+				return nil, err
+			}
+			datumTrace.Inputs = append(datumTrace.Inputs, inputTrace)
+		}
+		trace.Datums = append(trace.Datums, datumTrace)
+	}
+	return trace, nil
+}
+
+// jobForOutputCommit returns the job whose output commit is 'commit', or nil
+// if there isn't one (e.g. because 'commit' is in an input repo)
+func (a *apiServer) jobForOutputCommit(ctx context.Context, commit *pfs.Commit) (*pps.JobInfo, error) {
+	iter, err := a.jobs.ReadOnly(ctx).GetByIndex(ppsdb.JobsOutputIndex, commit)
+	if err != nil {
+		return nil, err
+	}
+	var jobID string
+	jobInfo := &pps.JobInfo{}
+	ok, err := iter.Next(&jobID, jobInfo)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, nil
+	}
+	return jobInfo, nil
+}
+
+// readLineage reads the lineage of the job in 'jobInfo'. It returns nil if
+// the job doesn't have any, which is the case for jobs that didn't succeed
+// and jobs that predate lineage tracking.
+func readLineage(pachClient *client.APIClient, jobInfo *pps.JobInfo) (*pps.JobLineage, error) {
+	if jobInfo.Lineage == nil {
+		return nil, nil
+	}
+	var buf bytes.Buffer
+	if err := pachClient.GetObject(jobInfo.Lineage.Hash, &buf); err != nil {
+		return nil, err
+	}
+	lineage := &pps.JobLineage{}
+	if err := lineage.Unmarshal(buf.Bytes()); err != nil {
+		return nil, err
+	}
+	return lineage, nil
+}
+
+// datumWrote returns true if 'datum' wrote the file at 'p', or, if 'p' is a
+// directory, any file under it
+func datumWrote(datum *pps.DatumLineage, p string) bool {
+	p = path.Clean("/" + p)
+	for _, output := range datum.Outputs {
+		if output == p || p == "/" || strings.HasPrefix(output, p+"/") {
+			return true
+		}
+	}
+	return false
+}
+
 func (a *apiServer) ListDatum(ctx context.Context, request *pps.ListDatumRequest) (response *pps.ListDatumResponse, retErr error) {
 	func() { a.Log(request, nil, nil, 0) }()
 	defer func(start time.Time) {
@@ -610,6 +978,10 @@ func (a *apiServer) ListDatum(ctx context.Context, request *pps.ListDatumRequest
 			response.Page = request.Page
 			response.TotalPages = getTotalPages(df.Len())
 		}
+		failed := make(map[string]bool)
+		for _, failedDatum := range jobInfo.FailedDatums {
+			failed[failedDatum.DatumID] = true
+		}
 		var datumInfos []*pps.DatumInfo
 		for i := start; i < end; i++ {
 			datum := df.Datum(i)
@@ -621,6 +993,9 @@ func (a *apiServer) ListDatum(ctx context.Context, request *pps.ListDatumRequest
 				},
 				State: pps.DatumState_STARTING,
 			}
+			if failed[workerpkg.DatumID(datum)] {
+				datumInfo.State = pps.DatumState_FAILED
+			}
 			for _, input := range datum {
 				datumInfo.Data = append(datumInfo.Data, input.FileInfo)
 			}
@@ -710,6 +1085,149 @@ func (a *apiServer) ListDatum(ctx context.Context, request *pps.ListDatumRequest
 	return response, nil
 }
 
+func (a *apiServer) ListDatumForInput(ctx context.Context, request *pps.ListDatumForInputRequest) (response *pps.ListDatumForInputResponse, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) {
+		if response != nil && len(response.DatumInfos) > client.MaxListItemsLog {
+			logrus.Infof("Response contains %d objects; logging the first %d", len(response.DatumInfos), client.MaxListItemsLog)
+			logResponse := proto.Clone(response).(*pps.ListDatumForInputResponse)
+			logResponse.DatumInfos = logResponse.DatumInfos[:client.MaxListItemsLog]
+			a.Log(request, logResponse, retErr, time.Since(start))
+		} else {
+			a.Log(request, response, retErr, time.Since(start))
+		}
+	}(time.Now())
+
+	if request.Input == nil {
+		return nil, fmt.Errorf("input must be specified")
+	}
+	if request.PageSize < 0 || request.Page < 0 {
+		return nil, fmt.Errorf("page and page_size must be >= 0")
+	}
+	var pipelineName string
+	if request.Pipeline != nil {
+		pipelineName = request.Pipeline.Name
+	}
+	input := proto.Clone(request.Input).(*pps.Input)
+	setInputDefaults(pipelineName, input)
+	if err := a.validateInput(ctx, pipelineName, input, false, nil); err != nil {
+		return nil, err
+	}
+	pachClient, err := a.getPachClient()
+	if err != nil {
+		return nil, err
+	}
+	pachClient = pachClient.WithCtx(ctx)
+	response = &pps.ListDatumForInputResponse{}
+	input, ok, err := headInput(pachClient, input)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		// Some of the input branches have no commits, so a pipeline
+		// wouldn't have anything to process yet
+		return response, nil
+	}
+	df, err := workerpkg.NewDatumFactory(ctx, pachClient.PfsAPIClient, input)
+	if err != nil {
+		return nil, err
+	}
+
+	start, end := 0, df.Len()
+	if request.PageSize > 0 {
+		response.Page = request.Page
+		response.TotalPages = int64(math.Ceil(float64(df.Len()) / float64(request.PageSize)))
+		start = int(request.Page * request.PageSize)
+		if start > df.Len() {
+			start = df.Len()
+		}
+		end = start + int(request.PageSize)
+		if end > df.Len() {
+			end = df.Len()
+		}
+	}
+	response.DatumCount = int64(df.Len())
+	sizes := make([]int64, df.Len())
+	for i := 0; i < df.Len(); i++ {
+		datum := df.Datum(i)
+		for _, input := range datum {
+			sizes[i] += int64(input.FileInfo.SizeBytes)
+		}
+		response.TotalBytes += sizes[i]
+		if i == 0 || sizes[i] < response.MinDatumBytes {
+			response.MinDatumBytes = sizes[i]
+		}
+		if sizes[i] > response.MaxDatumBytes {
+			response.MaxDatumBytes = sizes[i]
+		}
+		if i < start || i >= end {
+			continue
+		}
+		datumInfo := &pps.DatumInfo{
+			Datum: &pps.Datum{ID: workerpkg.DatumID(datum)},
+			State: pps.DatumState_STARTING,
+		}
+		for _, input := range datum {
+			datumInfo.Data = append(datumInfo.Data, input.FileInfo)
+		}
+		response.DatumInfos = append(response.DatumInfos, datumInfo)
+	}
+	response.SizeHistogram = datumSizeHistogram(sizes)
+	return response, nil
+}
+
+// datumSizeHistogram counts 'sizes' in buckets whose bounds grow by a factor
+// of 4, starting from [0, 1KiB). Buckets smaller than the smallest size or
+// larger than the largest size are left out.
+func datumSizeHistogram(sizes []int64) []*pps.DatumSizeBucket {
+	var buckets []*pps.DatumSizeBucket
+	first := -1
+	for _, size := range sizes {
+		lower, upper := int64(0), int64(1024)
+		i := 0
+		for size >= upper {
+			lower = upper
+			if upper > math.MaxInt64/4 {
+				upper = math.MaxInt64
+				break
+			}
+			upper *= 4
+			i++
+		}
+		for len(buckets) <= i {
+			buckets = append(buckets, nil)
+		}
+		if buckets[i] == nil {
+			buckets[i] = &pps.DatumSizeBucket{
+				LowerBoundBytes: lower,
+				UpperBoundBytes: upper,
+			}
+		}
+		buckets[i].Count++
+		if first == -1 || i < first {
+			first = i
+		}
+	}
+	if first == -1 {
+		return nil
+	}
+	// Fill in the empty buckets between the smallest and largest sizes, so
+	// that the shape of the distribution is clear
+	for i := first; i < len(buckets); i++ {
+		if buckets[i] == nil {
+			lower := int64(0)
+			if i > 0 {
+				lower = 1024 << (2 * uint(i-1))
+			}
+			buckets[i] = &pps.DatumSizeBucket{
+				LowerBoundBytes: lower,
+				UpperBoundBytes: 1024 << (2 * uint(i)),
+			}
+		}
+	}
+	return buckets[first:]
+}
+
 type byDatumState []*pfs.FileInfo
 
 func datumFileToState(f *pfs.FileInfo) pps.DatumState {
@@ -1097,8 +1615,14 @@ func (a *apiServer) getLogsFromStats(ctx context.Context, request *pps.GetLogsRe
 	return eg.Wait()
 }
 
-func (a *apiServer) validatePipeline(ctx context.Context, pipelineInfo *pps.PipelineInfo) error {
-	if err := a.validateInput(ctx, pipelineInfo.Pipeline.Name, pipelineInfo.Input, false); err != nil {
+// validatePipeline checks that a pipeline is valid. Repos in 'pendingRepos'
+// are assumed to exist, as they're about to be created.
+func (a *apiServer) validatePipeline(ctx context.Context, pipelineInfo *pps.PipelineInfo, pendingRepos map[string]bool) error {
+	if pipelineInfo.Spout != nil {
+		if err := validateSpout(pipelineInfo); err != nil {
+			return fmt.Errorf("invalid spout: %v", err)
+		}
+	} else if err := a.validateInput(ctx, pipelineInfo.Pipeline.Name, pipelineInfo.Input, false, pendingRepos); err != nil {
 		return err
 	}
 	if err := validateTransform(pipelineInfo.Transform); err != nil {
@@ -1123,9 +1647,76 @@ func (a *apiServer) validatePipeline(ctx context.Context, pipelineInfo *pps.Pipe
 	if pipelineInfo.OutputBranch == "" {
 		return fmt.Errorf("pipeline needs to specify an output branch")
 	}
+	if pipelineInfo.DatumTries < 0 {
+		return fmt.Errorf("datum_tries must be >= 0")
+	}
+	for name, d := range map[string]*types.Duration{
+		"datum_retry_backoff": pipelineInfo.DatumRetryBackoff,
+		"datum_timeout":       pipelineInfo.DatumTimeout,
+		"job_timeout":         pipelineInfo.JobTimeout,
+	} {
+		if d == nil {
+			continue
+		}
+		duration, err := types.DurationFromProto(d)
+		if err != nil {
+			return fmt.Errorf("invalid %s: %v", name, err)
+		}
+		if duration <= 0 {
+			return fmt.Errorf("%s must be positive", name)
+		}
+	}
 	if _, err := resource.ParseQuantity(pipelineInfo.CacheSize); err != nil {
 		return fmt.Errorf("could not parse cacheSize '%s': %v", pipelineInfo.CacheSize, err)
 	}
+	limits, err := util.GetLimitsResourceListFromPipeline(pipelineInfo)
+	if err != nil {
+		return fmt.Errorf("invalid resource_limits: %v", err)
+	}
+	if limits != nil && pipelineInfo.ResourceSpec != nil {
+		requests, err := util.GetResourceListFromPipeline(pipelineInfo)
+		if err != nil {
+			return err
+		}
+		for name, limit := range *limits {
+			if request, ok := (*requests)[name]; ok && request.Cmp(limit) > 0 {
+				return fmt.Errorf("the %s limit (%s) is less than the %s requested (%s)", name, limit.String(), name, request.String())
+			}
+		}
+	}
+	if pipelineInfo.SchedulingSpec != nil {
+		if err := validateSchedulingSpec(pipelineInfo.SchedulingSpec); err != nil {
+			return fmt.Errorf("invalid scheduling_spec: %v", err)
+		}
+	}
+	if pipelineInfo.Service != nil {
+		if err := validateService(pipelineInfo.Service); err != nil {
+			return fmt.Errorf("invalid service: %v", err)
+		}
+	}
+	if pipelineInfo.AutoscalingSpec != nil {
+		if err := validateAutoscalingSpec(pipelineInfo); err != nil {
+			return fmt.Errorf("invalid autoscaling_spec: %v", err)
+		}
+	}
+	if pipelineInfo.PriorityClass != "" {
+		policy, err := a.getAdmissionPolicy(ctx)
+		if err != nil {
+			return err
+		}
+		if !admission.HasPriorityClass(policy, pipelineInfo.PriorityClass) {
+			return fmt.Errorf("priority class %q isn't defined by the admission policy", pipelineInfo.PriorityClass)
+		}
+	}
+	if pipelineInfo.PodPatch != "" {
+		if err := podpatch.Validate(pipelineInfo.PodPatch); err != nil {
+			return fmt.Errorf("invalid pod_patch: %v", err)
+		}
+		// Make sure the patch applies to the workers' pod spec
+		if _, err := a.pipelineWorkerSpec(pipelineInfo); err != nil {
+			return err
+		}
+	}
 	if pipelineInfo.Incremental {
 		pachClient, err := a.getPachClient()
 		if err != nil {
@@ -1143,6 +1734,9 @@ func (a *apiServer) validatePipeline(ctx context.Context, pipelineInfo *pps.Pipe
 				return fmt.Errorf("can't create an incremental pipeline with inputs that share provenance")
 			}
 			provMap[provRepo.Name] = true
+			if pendingRepos[provRepo.Name] {
+				continue
+			}
 			resp, err := pfsClient.InspectRepo(ctx, &pfs.InspectRepoRequest{Repo: provRepo})
 			if err != nil {
 				return err
@@ -1173,6 +1767,8 @@ const (
 	pipelineOpUpdate
 	// pipelineOpUpdate is required for DeletePipeline
 	pipelineOpDelete
+	// pipelineOpRerun is required for RerunPipeline
+	pipelineOpRerun
 )
 
 // authorizePipelineOp checks if the user indicated by 'ctx' is authorized
@@ -1246,7 +1842,7 @@ func (a *apiServer) authorizePipelineOp(ctx context.Context, operation pipelineO
 		}
 	case pipelineOpGetLogs:
 		required = auth.Scope_READER
-	case pipelineOpUpdate:
+	case pipelineOpUpdate, pipelineOpRerun:
 		required = auth.Scope_WRITER
 	case pipelineOpDelete:
 		required = auth.Scope_OWNER
@@ -1276,33 +1872,20 @@ func (a *apiServer) CreatePipeline(ctx context.Context, request *pps.CreatePipel
 	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
 	metricsFn := metrics.ReportUserAction(ctx, a.reporter, "CreatePipeline")
 	defer func(start time.Time) { metricsFn(start, retErr) }(time.Now())
+	return a.createPipeline(ctx, request, "")
+}
 
+// createPipeline creates or updates a pipeline. If salt is set, an updated
+// pipeline takes it instead of keeping its current salt, which is how a
+// rollback reuses the datums processed by an old version.
+func (a *apiServer) createPipeline(ctx context.Context, request *pps.CreatePipelineRequest, salt string) (*types.Empty, error) {
 	pachClient, err := a.getPachClient()
 	if err != nil {
 		return nil, err
 	}
 	pfsClient := pachClient.PfsAPIClient
 
-	pipelineInfo := &pps.PipelineInfo{
-		Pipeline:           request.Pipeline,
-		Version:            1,
-		Transform:          request.Transform,
-		ParallelismSpec:    request.ParallelismSpec,
-		Input:              request.Input,
-		OutputBranch:       request.OutputBranch,
-		Egress:             request.Egress,
-		CreatedAt:          now(),
-		ScaleDownThreshold: request.ScaleDownThreshold,
-		ResourceSpec:       request.ResourceSpec,
-		Description:        request.Description,
-		Incremental:        request.Incremental,
-		CacheSize:          request.CacheSize,
-		EnableStats:        request.EnableStats,
-		Salt:               uuid.NewWithoutDashes(),
-		Batch:              request.Batch,
-		MaxQueueSize:       request.MaxQueueSize,
-		Service:            request.Service,
-	}
+	pipelineInfo := newPipelineInfo(request)
 	setPipelineDefaults(pipelineInfo)
 	var visitErr error
 	pps.VisitInput(pipelineInfo.Input, func(input *pps.Input) {
@@ -1315,7 +1898,7 @@ func (a *apiServer) CreatePipeline(ctx context.Context, request *pps.CreatePipel
 	if visitErr != nil {
 		return nil, visitErr
 	}
-	if err := a.validatePipeline(ctx, pipelineInfo); err != nil {
+	if err := a.validatePipeline(ctx, pipelineInfo, nil); err != nil {
 		return nil, err
 	}
 	operation := pipelineOpCreate
@@ -1350,11 +1933,24 @@ func (a *apiServer) CreatePipeline(ctx context.Context, request *pps.CreatePipel
 				return err
 			}
 			pipelineInfo.Version = oldPipelineInfo.Version + 1
-			if !request.Reprocess {
+			if salt != "" {
+				pipelineInfo.Salt = salt
+			} else if !request.Reprocess {
 				pipelineInfo.Salt = oldPipelineInfo.Salt
 			}
 			pipelines.Put(pipelineName, pipelineInfo)
-			return nil
+			// Pipelines created before versions were recorded have no
+			// history, so record the version being replaced too
+			versions := a.pipelineVersions(pipelineName).ReadWrite(stm)
+			if err := versions.Get(fmt.Sprint(oldPipelineInfo.Version), &pps.PipelineInfo{}); err != nil {
+				if !col.IsErrNotFound(err) {
+					return err
+				}
+				if err := putPipelineVersion(versions, &oldPipelineInfo); err != nil {
+					return err
+				}
+			}
+			return putPipelineVersion(versions, pipelineInfo)
 		})
 		if err != nil {
 			return nil, err
@@ -1451,7 +2047,13 @@ func (a *apiServer) CreatePipeline(ctx context.Context, request *pps.CreatePipel
 			if isAlreadyExistsErr(err) {
 				return newErrPipelineExists(pipelineName)
 			}
-			return err
+			if err != nil {
+				return err
+			}
+			// Clear any history left by a deleted pipeline of the same name
+			versions := a.pipelineVersions(pipelineName).ReadWrite(stm)
+			versions.DeleteAll()
+			return putPipelineVersion(versions, pipelineInfo)
 		})
 		if err != nil {
 			return nil, err
@@ -1474,13 +2076,110 @@ func (a *apiServer) CreatePipeline(ctx context.Context, request *pps.CreatePipel
 	return &types.Empty{}, nil
 }
 
+// newPipelineInfo returns the PipelineInfo for the first version of the
+// pipeline described by 'request'
+func newPipelineInfo(request *pps.CreatePipelineRequest) *pps.PipelineInfo {
+	return &pps.PipelineInfo{
+		Pipeline:           request.Pipeline,
+		Version:            1,
+		Transform:          request.Transform,
+		ParallelismSpec:    request.ParallelismSpec,
+		Input:              request.Input,
+		OutputBranch:       request.OutputBranch,
+		Egress:             request.Egress,
+		CreatedAt:          now(),
+		ScaleDownThreshold: request.ScaleDownThreshold,
+		ResourceSpec:       request.ResourceSpec,
+		Description:        request.Description,
+		Incremental:        request.Incremental,
+		CacheSize:          request.CacheSize,
+		EnableStats:        request.EnableStats,
+		Salt:               uuid.NewWithoutDashes(),
+		Batch:              request.Batch,
+		MaxQueueSize:       request.MaxQueueSize,
+		Service:            request.Service,
+		DatumTries:         request.DatumTries,
+		DatumRetryBackoff:  request.DatumRetryBackoff,
+		DatumTimeout:       request.DatumTimeout,
+		JobTimeout:         request.JobTimeout,
+		SkipFailedDatums:   request.SkipFailedDatums,
+		ResourceLimits:     request.ResourceLimits,
+		SchedulingSpec:     request.SchedulingSpec,
+		PodPatch:           request.PodPatch,
+		AutoscalingSpec:    request.AutoscalingSpec,
+		PriorityClass:      request.PriorityClass,
+		Team:               request.Team,
+		Spout:              request.Spout,
+	}
+}
+
+// pipelineRequestFromInfo returns a request that recreates the spec of the
+// pipeline described by 'pipelineInfo'
+func pipelineRequestFromInfo(pipelineInfo *pps.PipelineInfo) *pps.CreatePipelineRequest {
+	return &pps.CreatePipelineRequest{
+		Pipeline:           pipelineInfo.Pipeline,
+		Transform:          pipelineInfo.Transform,
+		ParallelismSpec:    pipelineInfo.ParallelismSpec,
+		Egress:             pipelineInfo.Egress,
+		OutputBranch:       pipelineInfo.OutputBranch,
+		ScaleDownThreshold: pipelineInfo.ScaleDownThreshold,
+		ResourceSpec:       pipelineInfo.ResourceSpec,
+		Input:              pipelineInfo.Input,
+		Description:        pipelineInfo.Description,
+		Incremental:        pipelineInfo.Incremental,
+		CacheSize:          pipelineInfo.CacheSize,
+		EnableStats:        pipelineInfo.EnableStats,
+		Batch:              pipelineInfo.Batch,
+		MaxQueueSize:       pipelineInfo.MaxQueueSize,
+		Service:            pipelineInfo.Service,
+		DatumTries:         pipelineInfo.DatumTries,
+		DatumRetryBackoff:  pipelineInfo.DatumRetryBackoff,
+		DatumTimeout:       pipelineInfo.DatumTimeout,
+		JobTimeout:         pipelineInfo.JobTimeout,
+		SkipFailedDatums:   pipelineInfo.SkipFailedDatums,
+		ResourceLimits:     pipelineInfo.ResourceLimits,
+		SchedulingSpec:     pipelineInfo.SchedulingSpec,
+		PodPatch:           pipelineInfo.PodPatch,
+		AutoscalingSpec:    pipelineInfo.AutoscalingSpec,
+		PriorityClass:      pipelineInfo.PriorityClass,
+		Team:               pipelineInfo.Team,
+		Spout:              pipelineInfo.Spout,
+	}
+}
+
 // setPipelineDefaults sets the default values for a pipeline info
 func setPipelineDefaults(pipelineInfo *pps.PipelineInfo) {
-	now := time.Now()
 	if pipelineInfo.Transform.Image == "" {
 		pipelineInfo.Transform.Image = DefaultUserImage
 	}
-	pps.VisitInput(pipelineInfo.Input, func(input *pps.Input) {
+	setInputDefaults(pipelineInfo.Pipeline.Name, pipelineInfo.Input)
+	if pipelineInfo.OutputBranch == "" {
+		// Output branches default to master
+		pipelineInfo.OutputBranch = "master"
+	}
+	if pipelineInfo.CacheSize == "" {
+		pipelineInfo.CacheSize = "64M"
+	}
+	if pipelineInfo.ResourceSpec == nil && pipelineInfo.CacheSize != "" {
+		pipelineInfo.ResourceSpec = &pps.ResourceSpec{
+			Memory: pipelineInfo.CacheSize,
+		}
+	}
+	if pipelineInfo.MaxQueueSize == 0 {
+		pipelineInfo.MaxQueueSize = 10
+	}
+	if pipelineInfo.Spout != nil && pipelineInfo.ParallelismSpec == nil {
+		// A spout's user code runs in its master, so it only needs one
+		// worker
+		pipelineInfo.ParallelismSpec = &pps.ParallelismSpec{Constant: 1}
+	}
+}
+
+// setInputDefaults fills in the unset fields of the inputs of the pipeline
+// 'pipelineName'
+func setInputDefaults(pipelineName string, pipelineInput *pps.Input) {
+	now := time.Now()
+	pps.VisitInput(pipelineInput, func(input *pps.Input) {
 		if input.Atom != nil {
 			if input.Atom.Branch == "" {
 				input.Atom.Branch = "master"
@@ -1495,28 +2194,45 @@ func setPipelineDefaults(pipelineInfo *pps.PipelineInfo) {
 				input.Cron.Start = start
 			}
 			if input.Cron.Repo == "" {
-				input.Cron.Repo = fmt.Sprintf("%s_%s", pipelineInfo.Pipeline.Name, input.Cron.Name)
+				input.Cron.Repo = fmt.Sprintf("%s_%s", pipelineName, input.Cron.Name)
 			}
 		}
 	})
-	if pipelineInfo.OutputBranch == "" {
-		// Output branches default to master
-		pipelineInfo.OutputBranch = "master"
-	}
-	if pipelineInfo.CacheSize == "" {
-		pipelineInfo.CacheSize = "64M"
+}
+
+func (a *apiServer) InspectPipeline(ctx context.Context, request *pps.InspectPipelineRequest) (response *pps.PipelineInfo, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+
+	pipelineInfo := new(pps.PipelineInfo)
+	if err := a.pipelines.ReadOnly(ctx).Get(request.Pipeline.Name, pipelineInfo); err != nil {
+		return nil, err
 	}
-	if pipelineInfo.ResourceSpec == nil && pipelineInfo.CacheSize != "" {
-		pipelineInfo.ResourceSpec = &pps.ResourceSpec{
-			Memory: pipelineInfo.CacheSize,
+	if request.Version != 0 && request.Version != pipelineInfo.Version {
+		versionInfo := new(pps.PipelineInfo)
+		if err := a.pipelineVersions(request.Pipeline.Name).ReadOnly(ctx).Get(fmt.Sprint(request.Version), versionInfo); err != nil {
+			if col.IsErrNotFound(err) {
+				return nil, fmt.Errorf("pipeline %s has no version %d", request.Pipeline.Name, request.Version)
+			}
+			return nil, err
 		}
+		pipelineInfo = versionInfo
 	}
-	if pipelineInfo.MaxQueueSize == 0 {
-		pipelineInfo.MaxQueueSize = 10
+	if request.WorkerSpec {
+		workerSpec, err := a.pipelineWorkerSpec(pipelineInfo)
+		if err != nil {
+			return nil, err
+		}
+		workerSpecJSON, err := json.MarshalIndent(workerSpec, "", "  ")
+		if err != nil {
+			return nil, err
+		}
+		pipelineInfo.WorkerSpec = string(workerSpecJSON)
 	}
+	return pipelineInfo, nil
 }
 
-func (a *apiServer) InspectPipeline(ctx context.Context, request *pps.InspectPipelineRequest) (response *pps.PipelineInfo, retErr error) {
+func (a *apiServer) ListPipelineVersions(ctx context.Context, request *pps.ListPipelineVersionsRequest) (response *pps.PipelineInfos, retErr error) {
 	func() { a.Log(request, nil, nil, 0) }()
 	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
 
@@ -1524,7 +2240,77 @@ func (a *apiServer) InspectPipeline(ctx context.Context, request *pps.InspectPip
 	if err := a.pipelines.ReadOnly(ctx).Get(request.Pipeline.Name, pipelineInfo); err != nil {
 		return nil, err
 	}
-	return pipelineInfo, nil
+	versionIter, err := a.pipelineVersions(request.Pipeline.Name).ReadOnly(ctx).List()
+	if err != nil {
+		return nil, err
+	}
+	var versionInfos []*pps.PipelineInfo
+	foundCurrent := false
+	for {
+		var key string
+		versionInfo := new(pps.PipelineInfo)
+		ok, err := versionIter.Next(&key, versionInfo)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			break
+		}
+		if versionInfo.Version == pipelineInfo.Version {
+			// Report the current version as it is now, e.g. its state
+			versionInfo = pipelineInfo
+			foundCurrent = true
+		}
+		versionInfos = append(versionInfos, versionInfo)
+	}
+	if !foundCurrent {
+		versionInfos = append(versionInfos, pipelineInfo)
+	}
+	sort.Slice(versionInfos, func(i, j int) bool {
+		return versionInfos[i].Version < versionInfos[j].Version
+	})
+	return &pps.PipelineInfos{PipelineInfo: versionInfos}, nil
+}
+
+func (a *apiServer) RollbackPipeline(ctx context.Context, request *pps.RollbackPipelineRequest) (response *types.Empty, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+	metricsFn := metrics.ReportUserAction(ctx, a.reporter, "RollbackPipeline")
+	defer func(start time.Time) { metricsFn(start, retErr) }(time.Now())
+
+	pipelineInfo, err := a.InspectPipeline(ctx, &pps.InspectPipelineRequest{
+		Pipeline: request.Pipeline,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if request.Version == 0 || request.Version >= pipelineInfo.Version {
+		return nil, fmt.Errorf("pipeline %s can only be rolled back to a version before its current version (%d)", request.Pipeline.Name, pipelineInfo.Version)
+	}
+	versionInfo, err := a.InspectPipeline(ctx, &pps.InspectPipelineRequest{
+		Pipeline: request.Pipeline,
+		Version:  request.Version,
+	})
+	if err != nil {
+		return nil, err
+	}
+	// The old spec becomes the pipeline's next version
+	rollbackRequest := pipelineRequestFromInfo(versionInfo)
+	rollbackRequest.Update = true
+	return a.createPipeline(ctx, rollbackRequest, versionInfo.Salt)
+}
+
+func (a *apiServer) pipelineVersions(pipeline string) col.Collection {
+	return ppsdb.PipelineVersions(a.etcdClient, a.etcdPrefix, pipeline)
+}
+
+// putPipelineVersion records a version of a pipeline's spec in its history.
+// The pipeline's capability is left out, since it's revoked when the
+// pipeline is updated.
+func putPipelineVersion(versions col.ReadWriteCollection, pipelineInfo *pps.PipelineInfo) error {
+	versionInfo := *pipelineInfo
+	versionInfo.Capability = ""
+	return versions.Put(fmt.Sprint(pipelineInfo.Version), &versionInfo)
 }
 
 func (a *apiServer) ListPipeline(ctx context.Context, request *pps.ListPipelineRequest) (response *pps.PipelineInfos, retErr error) {
@@ -1587,7 +2373,7 @@ func (a *apiServer) deletePipeline(ctx context.Context, request *pps.DeletePipel
 	if err != nil {
 		return nil, err
 	}
-	pipelineInfo, err := a.InspectPipeline(ctx, &pps.InspectPipelineRequest{request.Pipeline})
+	pipelineInfo, err := a.InspectPipeline(ctx, &pps.InspectPipelineRequest{Pipeline: request.Pipeline})
 	if err != nil {
 		return nil, fmt.Errorf("pipeline %v was not found: %v", request.Pipeline.Name, err)
 	}
@@ -1645,7 +2431,21 @@ func (a *apiServer) deletePipeline(ctx context.Context, request *pps.DeletePipel
 		}
 	}
 
+	webhookInfos, err := a.listWebhooks(ctx)
+	if err != nil {
+		return nil, err
+	}
 	if _, err := col.NewSTM(ctx, a.etcdClient, func(stm col.STM) error {
+		// The pipeline's webhooks go with it
+		webhooks := a.webhooks.ReadWrite(stm)
+		for _, webhookInfo := range webhookInfos {
+			if webhookInfo.Pipeline.GetName() == request.Pipeline.Name {
+				if err := webhooks.Delete(webhookInfo.Name); err != nil && !col.IsErrNotFound(err) {
+					return err
+				}
+			}
+		}
+		a.pipelineVersions(request.Pipeline.Name).ReadWrite(stm).DeleteAll()
 		return a.pipelines.ReadWrite(stm).Delete(request.Pipeline.Name)
 	}); err != nil {
 		return nil, err
@@ -1691,11 +2491,155 @@ func (a *apiServer) StopPipeline(ctx context.Context, request *pps.StopPipelineR
 	return &types.Empty{}, nil
 }
 
-func (a *apiServer) RerunPipeline(ctx context.Context, request *pps.RerunPipelineRequest) (response *types.Empty, retErr error) {
+func (a *apiServer) RerunPipeline(ctx context.Context, request *pps.RerunPipelineRequest) (response *pps.JobInfos, retErr error) {
 	func() { a.Log(request, nil, nil, 0) }()
 	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
 
-	return nil, fmt.Errorf("TODO")
+	pipelineInfo := new(pps.PipelineInfo)
+	if err := a.pipelines.ReadOnly(ctx).Get(request.Pipeline.Name, pipelineInfo); err != nil {
+		return nil, err
+	}
+	if pipelineInfo.Service != nil {
+		return nil, fmt.Errorf("service pipelines can't be rerun")
+	}
+	if pipelineInfo.Spout != nil {
+		return nil, fmt.Errorf("spouts have no input, so can't be rerun")
+	}
+	if err := a.authorizePipelineOp(ctx, pipelineOpRerun, pipelineInfo.Input, pipelineInfo.Pipeline.Name); err != nil {
+		return nil, err
+	}
+	pachClient, err := a.getPachClient()
+	if err != nil {
+		return nil, err
+	}
+	include, err := commitAncestors(ctx, pachClient, request.Include)
+	if err != nil {
+		return nil, err
+	}
+	exclude, err := commitAncestors(ctx, pachClient, request.Exclude)
+	if err != nil {
+		return nil, err
+	}
+
+	iter, err := a.jobs.ReadOnly(ctx).GetByIndex(ppsdb.JobsPipelineIndex, request.Pipeline)
+	if err != nil {
+		return nil, err
+	}
+	var jobInfos []*pps.JobInfo
+	for {
+		var jobID string
+		jobInfo := new(pps.JobInfo)
+		ok, err := iter.Next(&jobID, jobInfo)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			break
+		}
+		jobInfos = append(jobInfos, jobInfo)
+	}
+	// Rerun the input commit sets in the order they were first processed, so
+	// that the output branch ends up at the output of the newest one
+	sort.Slice(jobInfos, func(i, j int) bool {
+		if jobInfos[i].Started.Seconds != jobInfos[j].Started.Seconds {
+			return jobInfos[i].Started.Seconds < jobInfos[j].Started.Seconds
+		}
+		return jobInfos[i].Started.Nanos < jobInfos[j].Started.Nanos
+	})
+
+	seen := make(map[string]bool)
+	response = &pps.JobInfos{}
+	for _, jobInfo := range jobInfos {
+		if jobInfo.Input == nil {
+			continue // jobs from 1.4.5 and earlier can't be rerun
+		}
+		var key []string
+		selected := len(request.Include) == 0
+		for _, commit := range pps.InputCommits(jobInfo.Input) {
+			key = append(key, commit.ID)
+			if include[commit.ID] {
+				selected = true
+			}
+			if exclude[commit.ID] {
+				selected = false
+				break
+			}
+		}
+		sort.Strings(key)
+		if !selected || seen[strings.Join(key, ",")] {
+			continue
+		}
+		seen[strings.Join(key, ",")] = true
+		input, ok := rerunInput(pipelineInfo.Input, jobInfo.Input)
+		if !ok {
+			continue // the pipeline's inputs have changed since the job ran
+		}
+		job, err := a.CreateJob(ctx, &pps.CreateJobRequest{
+			Pipeline:        pipelineInfo.Pipeline,
+			Input:           input,
+			Salt:            pipelineInfo.Salt,
+			PipelineVersion: pipelineInfo.Version,
+			EnableStats:     pipelineInfo.EnableStats,
+			Batch:           pipelineInfo.Batch,
+			Rerun:           true,
+		})
+		if err != nil {
+			return nil, err
+		}
+		newJobInfo, err := a.InspectJob(ctx, &pps.InspectJobRequest{Job: job})
+		if err != nil {
+			return nil, err
+		}
+		response.JobInfo = append(response.JobInfo, newJobInfo)
+	}
+	return response, nil
+}
+
+// commitAncestors returns the IDs of 'commits' and all of their ancestors.
+func commitAncestors(ctx context.Context, pachClient *client.APIClient, commits []*pfs.Commit) (map[string]bool, error) {
+	result := make(map[string]bool)
+	for _, commit := range commits {
+		commitInfos, err := pachClient.PfsAPIClient.ListCommit(auth.In2Out(ctx), &pfs.ListCommitRequest{
+			Repo: commit.Repo,
+			To:   commit,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, commitInfo := range commitInfos.CommitInfo {
+			result[commitInfo.Commit.ID] = true
+		}
+	}
+	return result, nil
+}
+
+// rerunInput returns a copy of 'pipelineInput' with each input pinned to the
+// commit that it used in 'jobInput'. It returns false if 'jobInput' is
+// missing one of the pipeline's inputs.
+func rerunInput(pipelineInput *pps.Input, jobInput *pps.Input) (*pps.Input, bool) {
+	commits := make(map[string]string)
+	pps.VisitInput(jobInput, func(input *pps.Input) {
+		if input.Atom != nil {
+			commits[input.Atom.Name] = input.Atom.Commit
+		}
+		if input.Cron != nil {
+			commits[input.Cron.Name] = input.Cron.Commit
+		}
+	})
+	result := proto.Clone(pipelineInput).(*pps.Input)
+	ok := true
+	pps.VisitInput(result, func(input *pps.Input) {
+		if input.Atom != nil {
+			input.Atom.Commit = commits[input.Atom.Name]
+			input.Atom.FromCommit = ""
+			ok = ok && input.Atom.Commit != ""
+		}
+		if input.Cron != nil {
+			input.Cron.Commit = commits[input.Cron.Name]
+			ok = ok && input.Cron.Commit != ""
+		}
+	})
+	return result, ok
 }
 
 func (a *apiServer) DeleteAll(ctx context.Context, request *types.Empty) (response *types.Empty, retErr error) {
@@ -1739,6 +2683,15 @@ func (a *apiServer) DeleteAll(ctx context.Context, request *types.Empty) (respon
 		}
 	}
 
+	if _, err := col.NewSTM(ctx, a.etcdClient, func(stm col.STM) error {
+		a.webhooks.ReadWrite(stm).DeleteAll()
+		a.webhookDeliveries.ReadWrite(stm).DeleteAll()
+		a.admissionPolicy.ReadWrite(stm).DeleteAll()
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
 	return &types.Empty{}, err
 }
 
@@ -1860,6 +2813,15 @@ func (a *apiServer) GarbageCollect(ctx context.Context, request *pps.GarbageColl
 		return nil, err
 	}
 
+	// Keep the lineage of every job, which TraceFile reads
+	jobInfos, err := a.ListJob(ctx, &pps.ListJobRequest{})
+	if err != nil {
+		return nil, err
+	}
+	for _, jobInfo := range jobInfos.JobInfo {
+		addActiveObjects(jobInfo.Lineage)
+	}
+
 	// Iterate through all objects.  If they are not active, delete them.
 	objects, err := objClient.ListObjects(ctx, &pfs.ListObjectsRequest{})
 	if err != nil {
@@ -2030,6 +2992,8 @@ func (a *apiServer) updateJobState(stm col.STM, jobInfo *pps.JobInfo, state pps.
 
 func jobStateToStopped(state pps.JobState) bool {
 	switch state {
+	case pps.JobState_JOB_QUEUED:
+		return false
 	case pps.JobState_JOB_STARTING:
 		return false
 	case pps.JobState_JOB_RUNNING:
@@ -2040,6 +3004,8 @@ func jobStateToStopped(state pps.JobState) bool {
 		return true
 	case pps.JobState_JOB_KILLED:
 		return true
+	case pps.JobState_JOB_PARTIAL:
+		return true
 	default:
 		panic(fmt.Sprintf("unrecognized job state: %s", state))
 	}
@@ -0,0 +1,341 @@
+package server
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/pachyderm/pachyderm/src/client/auth"
+	"github.com/pachyderm/pachyderm/src/client/pfs"
+	"github.com/pachyderm/pachyderm/src/client/pps"
+	"github.com/pachyderm/pachyderm/src/server/pkg/dag"
+	"github.com/pachyderm/pachyderm/src/server/pkg/metrics"
+	"golang.org/x/net/context"
+)
+
+func (a *apiServer) ApplyPipelines(ctx context.Context, request *pps.ApplyPipelinesRequest) (response *pps.ApplyPipelinesResponse, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+	metricsFn := metrics.ReportUserAction(ctx, a.reporter, "ApplyPipelines")
+	defer func(start time.Time) { metricsFn(start, retErr) }(time.Now())
+
+	actions, err := a.planApply(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	response = &pps.ApplyPipelinesResponse{Actions: actions}
+	if request.DryRun {
+		return response, nil
+	}
+	pachClient, err := a.getPachClient()
+	if err != nil {
+		return nil, err
+	}
+	repos := make(map[string]*pfs.CreateRepoRequest)
+	for _, repoRequest := range request.Repos {
+		repos[repoRequest.Repo.Name] = repoRequest
+	}
+	pipelines := make(map[string]*pps.CreatePipelineRequest)
+	for _, pipelineRequest := range request.Pipelines {
+		pipelines[pipelineRequest.Pipeline.Name] = pipelineRequest
+	}
+	for _, action := range actions {
+		switch action.Type {
+		case pps.ApplyActionType_APPLY_CREATE_REPO:
+			if _, err := pachClient.PfsAPIClient.CreateRepo(auth.In2Out(ctx), repos[action.Name]); err != nil && !isAlreadyExistsErr(err) {
+				return nil, fmt.Errorf("error creating repo %s: %v", action.Name, err)
+			}
+		case pps.ApplyActionType_APPLY_CREATE_PIPELINE, pps.ApplyActionType_APPLY_UPDATE_PIPELINE:
+			pipelineRequest := pipelines[action.Name]
+			pipelineRequest.Update = action.Type == pps.ApplyActionType_APPLY_UPDATE_PIPELINE
+			pipelineRequest.Reprocess = action.Reprocess
+			if _, err := a.createPipeline(ctx, pipelineRequest, ""); err != nil {
+				return nil, fmt.Errorf("error applying pipeline %s: %v", action.Name, err)
+			}
+		case pps.ApplyActionType_APPLY_DELETE_PIPELINE:
+			if _, err := a.DeletePipeline(ctx, &pps.DeletePipelineRequest{
+				Pipeline: &pps.Pipeline{Name: action.Name},
+			}); err != nil {
+				return nil, fmt.Errorf("error deleting pipeline %s: %v", action.Name, err)
+			}
+		}
+	}
+	return response, nil
+}
+
+// planApply validates the specs in 'request' and returns the actions needed
+// to apply them: repo creations first, then pipeline creations and updates
+// with upstream pipelines before downstream ones, then pipeline deletions
+// with downstream pipelines before upstream ones.
+func (a *apiServer) planApply(ctx context.Context, request *pps.ApplyPipelinesRequest) ([]*pps.ApplyAction, error) {
+	pachClient, err := a.getPachClient()
+	if err != nil {
+		return nil, err
+	}
+	pipelineInfos, err := a.ListPipeline(ctx, &pps.ListPipelineRequest{})
+	if err != nil {
+		return nil, err
+	}
+	existing := make(map[string]*pps.PipelineInfo)
+	for _, pipelineInfo := range pipelineInfos.PipelineInfo {
+		existing[pipelineInfo.Pipeline.Name] = pipelineInfo
+	}
+
+	var actions []*pps.ApplyAction
+	// pendingRepos are the repos that applying creates, which the pipelines
+	// being applied may take as input
+	pendingRepos := make(map[string]bool)
+	var repoNames []string
+	for _, repoRequest := range request.Repos {
+		if repoRequest.Repo == nil || repoRequest.Repo.Name == "" {
+			return nil, fmt.Errorf("repo spec must specify a repo name")
+		}
+		if pendingRepos[repoRequest.Repo.Name] {
+			return nil, fmt.Errorf("repo %s is specified more than once", repoRequest.Repo.Name)
+		}
+		if _, err := pachClient.WithCtx(ctx).InspectRepo(repoRequest.Repo.Name); err == nil {
+			continue
+		} else if !isNotFoundErr(err) {
+			return nil, err
+		}
+		pendingRepos[repoRequest.Repo.Name] = true
+		repoNames = append(repoNames, repoRequest.Repo.Name)
+	}
+	sort.Strings(repoNames)
+	for _, repoName := range repoNames {
+		actions = append(actions, &pps.ApplyAction{
+			Type: pps.ApplyActionType_APPLY_CREATE_REPO,
+			Name: repoName,
+		})
+	}
+
+	// Normalize the new specs so that they're comparable to the specs of
+	// existing pipelines
+	requested := make(map[string]*pps.PipelineInfo)
+	for _, pipelineRequest := range request.Pipelines {
+		if pipelineRequest.Pipeline == nil || pipelineRequest.Pipeline.Name == "" {
+			return nil, fmt.Errorf("pipeline spec must specify a pipeline name")
+		}
+		if pipelineRequest.Transform == nil {
+			return nil, fmt.Errorf("pipeline %s must specify a transform", pipelineRequest.Pipeline.Name)
+		}
+		// Spouts have no input; validatePipeline checks the rest
+		if pipelineRequest.Input == nil && pipelineRequest.Spout == nil {
+			return nil, fmt.Errorf("pipeline %s must specify an input or a spout", pipelineRequest.Pipeline.Name)
+		}
+		pipelineName := pipelineRequest.Pipeline.Name
+		if requested[pipelineName] != nil {
+			return nil, fmt.Errorf("pipeline %s is specified more than once", pipelineName)
+		}
+		if existing[pipelineName] != nil {
+			inheritCronStarts(pipelineRequest.Input, existing[pipelineName].Input)
+		}
+		pipelineInfo := newPipelineInfo(pipelineRequest)
+		setPipelineDefaults(pipelineInfo)
+		pps.SortInput(pipelineInfo.Input)
+		requested[pipelineName] = pipelineInfo
+		if existing[pipelineName] == nil {
+			pendingRepos[pipelineName] = true
+		}
+		pps.VisitInput(pipelineInfo.Input, func(input *pps.Input) {
+			if input.Cron != nil {
+				pendingRepos[input.Cron.Repo] = true
+			}
+		})
+	}
+	for pipelineName, pipelineInfo := range requested {
+		if err := a.validatePipeline(ctx, pipelineInfo, pendingRepos); err != nil {
+			return nil, fmt.Errorf("invalid pipeline %s: %v", pipelineName, err)
+		}
+	}
+
+	// The pipeline DAG after applying, which orders creations and updates
+	after := make(map[string]*pps.PipelineInfo)
+	for pipelineName, pipelineInfo := range existing {
+		if !request.Prune || requested[pipelineName] != nil {
+			after[pipelineName] = pipelineInfo
+		}
+	}
+	for pipelineName, pipelineInfo := range requested {
+		after[pipelineName] = pipelineInfo
+	}
+	afterDAG := pipelineDAG(after)
+	order, err := pipelineOrder(afterDAG, after)
+	if err != nil {
+		return nil, err
+	}
+	for _, pipelineName := range order {
+		pipelineInfo, ok := requested[pipelineName]
+		if !ok {
+			continue
+		}
+		oldPipelineInfo, ok := existing[pipelineName]
+		if !ok {
+			actions = append(actions, &pps.ApplyAction{
+				Type: pps.ApplyActionType_APPLY_CREATE_PIPELINE,
+				Name: pipelineName,
+			})
+			continue
+		}
+		changedFields := changedPipelineFields(
+			pipelineRequestFromInfo(oldPipelineInfo),
+			pipelineRequestFromInfo(pipelineInfo),
+		)
+		if len(changedFields) == 0 && !request.Reprocess {
+			continue
+		}
+		actions = append(actions, &pps.ApplyAction{
+			Type:          pps.ApplyActionType_APPLY_UPDATE_PIPELINE,
+			Name:          pipelineName,
+			ChangedFields: changedFields,
+			Reprocess:     request.Reprocess,
+			Downstream:    downstreamPipelines(afterDAG, after, pipelineName),
+		})
+	}
+
+	if request.Prune {
+		beforeDAG := pipelineDAG(existing)
+		order, err := pipelineOrder(beforeDAG, existing)
+		if err != nil {
+			return nil, err
+		}
+		for i := len(order) - 1; i >= 0; i-- {
+			pipelineName := order[i]
+			if requested[pipelineName] != nil {
+				continue
+			}
+			actions = append(actions, &pps.ApplyAction{
+				Type:       pps.ApplyActionType_APPLY_DELETE_PIPELINE,
+				Name:       pipelineName,
+				Downstream: downstreamPipelines(afterDAG, after, pipelineName),
+			})
+		}
+	}
+	return actions, nil
+}
+
+// pipelineDAG returns the DAG of 'pipelineInfos', in which each pipeline's
+// parents are its input repos.
+func pipelineDAG(pipelineInfos map[string]*pps.PipelineInfo) *dag.DAG {
+	nodes := make(map[string][]string)
+	for pipelineName, pipelineInfo := range pipelineInfos {
+		var parents []string
+		pps.VisitInput(pipelineInfo.Input, func(input *pps.Input) {
+			if input.Atom != nil {
+				parents = append(parents, input.Atom.Repo)
+			}
+		})
+		nodes[pipelineName] = parents
+	}
+	return dag.NewDAG(nodes)
+}
+
+// pipelineOrder returns the names of 'pipelineInfos' sorted so that each
+// pipeline comes after the pipelines whose output it takes as input. Pipelines
+// that don't depend on each other are sorted by name.
+func pipelineOrder(d *dag.DAG, pipelineInfos map[string]*pps.PipelineInfo) ([]string, error) {
+	var names []string
+	for pipelineName := range pipelineInfos {
+		names = append(names, pipelineName)
+	}
+	sort.Strings(names)
+	seen := make(map[string]bool)
+	var result []string
+	for _, pipelineName := range names {
+		for _, id := range d.Ancestors(pipelineName, nil) {
+			if _, ok := pipelineInfos[id]; ok && !seen[id] {
+				seen[id] = true
+				result = append(result, id)
+			}
+		}
+	}
+	// Check that the order respects every edge, which it doesn't if the
+	// pipelines form a cycle
+	position := make(map[string]int)
+	for i, pipelineName := range result {
+		position[pipelineName] = i
+	}
+	for _, pipelineName := range result {
+		var cycleErr error
+		pps.VisitInput(pipelineInfos[pipelineName].Input, func(input *pps.Input) {
+			if input.Atom == nil {
+				return
+			}
+			if i, ok := position[input.Atom.Repo]; ok && i >= position[pipelineName] {
+				cycleErr = fmt.Errorf("pipelines %s and %s depend on each other", pipelineName, input.Atom.Repo)
+			}
+		})
+		if cycleErr != nil {
+			return nil, cycleErr
+		}
+	}
+	return result, nil
+}
+
+// downstreamPipelines returns the sorted names of the pipelines downstream of
+// 'pipelineName'
+func downstreamPipelines(d *dag.DAG, pipelineInfos map[string]*pps.PipelineInfo, pipelineName string) []string {
+	var result []string
+	for _, id := range d.Descendants(pipelineName, nil) {
+		if _, ok := pipelineInfos[id]; ok && id != pipelineName {
+			result = append(result, id)
+		}
+	}
+	sort.Strings(result)
+	return result
+}
+
+// changedPipelineFields returns the names of the spec fields that differ
+// between two pipeline requests.
+func changedPipelineFields(oldRequest, newRequest *pps.CreatePipelineRequest) []string {
+	var result []string
+	oldValue := reflect.ValueOf(oldRequest).Elem()
+	newValue := reflect.ValueOf(newRequest).Elem()
+	requestType := oldValue.Type()
+	for i := 0; i < requestType.NumField(); i++ {
+		field := requestType.Field(i)
+		switch field.Name {
+		case "Pipeline", "Update", "Reprocess":
+			continue
+		}
+		tag := field.Tag.Get("protobuf")
+		if tag == "" {
+			continue
+		}
+		if !reflect.DeepEqual(oldValue.Field(i).Interface(), newValue.Field(i).Interface()) {
+			result = append(result, protobufFieldName(tag, field.Name))
+		}
+	}
+	return result
+}
+
+// protobufFieldName returns the proto name of a field given its protobuf
+// struct tag, e.g. "bytes,2,opt,name=transform" -> "transform"
+func protobufFieldName(tag string, defaultName string) string {
+	for _, part := range strings.Split(tag, ",") {
+		if strings.HasPrefix(part, "name=") {
+			return strings.TrimPrefix(part, "name=")
+		}
+	}
+	return defaultName
+}
+
+// inheritCronStarts copies the start times of the cron inputs in 'oldInput'
+// into the cron inputs of the same name in 'input' that don't set one, as
+// an unset start time would otherwise default to now and look like a change.
+func inheritCronStarts(input *pps.Input, oldInput *pps.Input) {
+	starts := make(map[string]*types.Timestamp)
+	pps.VisitInput(oldInput, func(input *pps.Input) {
+		if input.Cron != nil {
+			starts[input.Cron.Name] = input.Cron.Start
+		}
+	})
+	pps.VisitInput(input, func(input *pps.Input) {
+		if input.Cron != nil && input.Cron.Start == nil {
+			input.Cron.Start = starts[input.Cron.Name]
+		}
+	})
+}
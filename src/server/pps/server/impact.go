@@ -0,0 +1,322 @@
+package server
+
+import (
+	"fmt"
+	"path"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/gogo/protobuf/types"
+	"golang.org/x/net/context"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/client/auth"
+	"github.com/pachyderm/pachyderm/src/client/limit"
+	"github.com/pachyderm/pachyderm/src/client/pfs"
+	"github.com/pachyderm/pachyderm/src/client/pps"
+	"github.com/pachyderm/pachyderm/src/server/pkg/ppsdb"
+	workerpkg "github.com/pachyderm/pachyderm/src/server/worker"
+)
+
+// repoBranch identifies a branch of a repo
+type repoBranch struct {
+	repo   string
+	branch string
+}
+
+func (a *apiServer) ImpactAnalysis(ctx context.Context, request *pps.ImpactAnalysisRequest) (response *pps.ImpactAnalysisResponse, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+
+	if request.Repo == nil {
+		return nil, fmt.Errorf("repo must be specified")
+	}
+	branch := request.Branch
+	if branch == "" {
+		branch = "master"
+	}
+	pachClient, err := a.getPachClient()
+	if err != nil {
+		return nil, err
+	}
+	pachClient = pachClient.WithCtx(ctx)
+	pipelineInfos, err := a.ListPipeline(ctx, &pps.ListPipelineRequest{})
+	if err != nil {
+		return nil, err
+	}
+	pipelines := make(map[string]*pps.PipelineInfo)
+	for _, pipelineInfo := range pipelineInfos.PipelineInfo {
+		if pipelineInfo.Input != nil {
+			pipelines[pipelineInfo.Pipeline.Name] = pipelineInfo
+		}
+	}
+	order, err := pipelineOrder(pipelineDAG(pipelines), pipelines)
+	if err != nil {
+		return nil, err
+	}
+
+	// changed maps each branch that would change to the paths in it that
+	// would change. Pipelines are visited upstream first, so by the time a
+	// pipeline is visited, the changes to all of its inputs are known.
+	changed := map[repoBranch][]string{
+		{request.Repo.Name, branch}: request.Paths,
+	}
+	response = &pps.ImpactAnalysisResponse{}
+	for _, pipelineName := range order {
+		pipelineInfo := pipelines[pipelineName]
+		if !readsChanges(pipelineInfo.Input, changed) {
+			continue
+		}
+		impact, outputPaths, err := a.pipelineImpact(ctx, pachClient, pipelineInfo, changed)
+		if err != nil {
+			return nil, err
+		}
+		response.Pipelines = append(response.Pipelines, impact)
+		if len(outputPaths) > 0 {
+			changed[repoBranch{pipelineName, pipelineInfo.OutputBranch}] = outputPaths
+		}
+	}
+	return response, nil
+}
+
+// pipelineImpact works out the effect of 'changed' on 'pipelineInfo', by
+// building the datums of the pipeline's current input as a job would. It
+// also returns the paths in the pipeline's output that would change, which
+// are the outputs of the changed datums according to the lineage of the
+// pipeline's last job (or all of them, if that's unknown).
+func (a *apiServer) pipelineImpact(ctx context.Context, pachClient *client.APIClient, pipelineInfo *pps.PipelineInfo, changed map[repoBranch][]string) (*pps.PipelineImpact, []string, error) {
+	impact := &pps.PipelineImpact{Pipeline: pipelineInfo.Pipeline}
+	input, ok, err := headInput(pachClient, pipelineInfo.Input)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !ok {
+		// Some of the pipeline's inputs have no commits yet, so it has no
+		// datums to invalidate
+		return impact, nil, nil
+	}
+	df, err := workerpkg.NewDatumFactory(ctx, pachClient.PfsAPIClient, input)
+	if err != nil {
+		return nil, nil, err
+	}
+	impact.DatumsTotal = int64(df.Len())
+
+	var changedDatums []string
+	var mu sync.Mutex
+	limiter := limit.New(100)
+	var eg errgroup.Group
+	for i := 0; i < df.Len(); i++ {
+		datum := df.Datum(i)
+		if datumReadsChanges(datum, changed) {
+			mu.Lock()
+			impact.DatumsInvalidated++
+			mu.Unlock()
+			changedDatums = append(changedDatums, workerpkg.DatumID(datum))
+			continue
+		}
+		limiter.Acquire()
+		eg.Go(func() error {
+			defer limiter.Release()
+			tag := &pfs.Tag{Name: workerpkg.HashDatum(pipelineInfo.Pipeline.Name, pipelineInfo.Salt, datum)}
+			_, err := pachClient.ObjectAPIClient.InspectTag(auth.In2Out(ctx), tag)
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				impact.DatumsInvalidated++
+			} else {
+				impact.DatumsReused++
+			}
+			return nil
+		})
+	}
+	if err := eg.Wait(); err != nil {
+		return nil, nil, err
+	}
+
+	datumTime, ok, err := a.averageDatumTime(ctx, pipelineInfo.Pipeline)
+	if err != nil {
+		return nil, nil, err
+	}
+	if ok {
+		impact.EstimatedProcessTime = types.DurationProto(datumTime * time.Duration(impact.DatumsInvalidated))
+	}
+
+	if len(changedDatums) == 0 {
+		return impact, nil, nil
+	}
+	outputPaths, err := a.changedOutputs(ctx, pachClient, pipelineInfo, changedDatums)
+	if err != nil {
+		return nil, nil, err
+	}
+	return impact, outputPaths, nil
+}
+
+// changedOutputs returns the paths written by the datums 'datumIDs' in the
+// job that produced the head of 'pipelineInfo's output branch. If there's no
+// such job, or it has no lineage, it returns "/", as any output could change.
+func (a *apiServer) changedOutputs(ctx context.Context, pachClient *client.APIClient, pipelineInfo *pps.PipelineInfo, datumIDs []string) ([]string, error) {
+	all := []string{"/"}
+	commitInfo, err := pachClient.InspectCommit(pipelineInfo.Pipeline.Name, pipelineInfo.OutputBranch)
+	if err != nil {
+		return all, nil
+	}
+	jobInfo, err := a.jobForOutputCommit(ctx, commitInfo.Commit)
+	if err != nil {
+		return nil, err
+	}
+	if jobInfo == nil {
+		return all, nil
+	}
+	lineage, err := readLineage(pachClient, jobInfo)
+	if err != nil {
+		return nil, err
+	}
+	if lineage == nil {
+		return all, nil
+	}
+	outputs := make(map[string][]string)
+	for _, datum := range lineage.Datums {
+		outputs[datum.DatumID] = datum.Outputs
+	}
+	var result []string
+	for _, datumID := range datumIDs {
+		datumOutputs, ok := outputs[datumID]
+		if !ok {
+			// The datum wasn't in the last job, so we don't know what it
+			// writes
+			return all, nil
+		}
+		result = append(result, datumOutputs...)
+	}
+	return result, nil
+}
+
+// averageDatumTime returns the mean time a datum of 'pipeline' has taken to
+// download, process and upload, across all of its jobs. It returns false if
+// none of its jobs have processed any datums.
+func (a *apiServer) averageDatumTime(ctx context.Context, pipeline *pps.Pipeline) (time.Duration, bool, error) {
+	iter, err := a.jobs.ReadOnly(ctx).GetByIndex(ppsdb.JobsPipelineIndex, pipeline)
+	if err != nil {
+		return 0, false, err
+	}
+	var total time.Duration
+	var datums int64
+	for {
+		var jobID string
+		jobInfo := &pps.JobInfo{}
+		ok, err := iter.Next(&jobID, jobInfo)
+		if err != nil {
+			return 0, false, err
+		}
+		if !ok {
+			break
+		}
+		if jobInfo.Stats == nil || jobInfo.DataProcessed == 0 {
+			continue
+		}
+		for _, d := range []*types.Duration{jobInfo.Stats.DownloadTime, jobInfo.Stats.ProcessTime, jobInfo.Stats.UploadTime} {
+			if d == nil {
+				continue
+			}
+			duration, err := types.DurationFromProto(d)
+			if err != nil {
+				return 0, false, err
+			}
+			total += duration
+		}
+		datums += jobInfo.DataProcessed
+	}
+	if datums == 0 {
+		return 0, false, nil
+	}
+	return total / time.Duration(datums), true, nil
+}
+
+// headInput returns a copy of 'pipelineInput' with each input set to the
+// head of its branch, which is what the pipeline's next job would read. It
+// returns false if one of the branches has no commits.
+func headInput(pachClient *client.APIClient, pipelineInput *pps.Input) (*pps.Input, bool, error) {
+	heads := make(map[repoBranch]string)
+	var visitErr error
+	pps.VisitInput(pipelineInput, func(input *pps.Input) {
+		var rb repoBranch
+		switch {
+		case input.Atom != nil:
+			rb = repoBranch{input.Atom.Repo, input.Atom.Branch}
+		case input.Cron != nil:
+			rb = repoBranch{input.Cron.Repo, pps.CronBranch}
+		default:
+			return
+		}
+		if _, ok := heads[rb]; ok {
+			return
+		}
+		branchInfos, err := pachClient.ListBranch(rb.repo)
+		if err != nil {
+			visitErr = err
+			return
+		}
+		heads[rb] = ""
+		for _, branchInfo := range branchInfos {
+			if branchInfo.Name == rb.branch && branchInfo.Head != nil {
+				heads[rb] = branchInfo.Head.ID
+			}
+		}
+	})
+	if visitErr != nil {
+		return nil, false, visitErr
+	}
+	result := proto.Clone(pipelineInput).(*pps.Input)
+	ok := true
+	pps.VisitInput(result, func(input *pps.Input) {
+		if input.Atom != nil {
+			input.Atom.Commit = heads[repoBranch{input.Atom.Repo, input.Atom.Branch}]
+			input.Atom.FromCommit = ""
+			ok = ok && input.Atom.Commit != ""
+		}
+		if input.Cron != nil {
+			input.Cron.Commit = heads[repoBranch{input.Cron.Repo, pps.CronBranch}]
+			ok = ok && input.Cron.Commit != ""
+		}
+	})
+	return result, ok, nil
+}
+
+// readsChanges returns true if 'input' reads one of the branches in
+// 'changed'
+func readsChanges(input *pps.Input, changed map[repoBranch][]string) bool {
+	result := false
+	pps.VisitInput(input, func(input *pps.Input) {
+		if input.Atom != nil {
+			if _, ok := changed[repoBranch{input.Atom.Repo, input.Atom.Branch}]; ok {
+				result = true
+			}
+		}
+	})
+	return result
+}
+
+// datumReadsChanges returns true if one of the files in 'datum' overlaps one
+// of the changed paths in its branch
+func datumReadsChanges(datum []*workerpkg.Input, changed map[repoBranch][]string) bool {
+	for _, input := range datum {
+		file := input.FileInfo.File
+		for _, p := range changed[repoBranch{file.Commit.Repo.Name, input.Branch}] {
+			if pathsOverlap(file.Path, p) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// pathsOverlap returns true if 'p' and 'q' are the same path, or if one is a
+// directory containing the other
+func pathsOverlap(p, q string) bool {
+	p = strings.TrimSuffix(path.Clean("/"+p), "/")
+	q = strings.TrimSuffix(path.Clean("/"+q), "/")
+	return p == q || strings.HasPrefix(p, q+"/") || strings.HasPrefix(q, p+"/")
+}
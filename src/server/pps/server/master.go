@@ -224,6 +224,14 @@ func (a *apiServer) upsertWorkersForPipeline(pipelineInfo *pps.PipelineInfo) err
 			log.Errorf("error getting number of workers, default to 1 worker: %v", err)
 			parallelism = 1
 		}
+		if autoscaling := pipelineInfo.AutoscalingSpec; autoscaling != nil {
+			// The pipeline's master scales its workers between min_workers
+			// and max_workers, starting from min_workers
+			parallelism = int(autoscaling.MinWorkers)
+			if parallelism < 1 {
+				parallelism = 1
+			}
+		}
 		var resources *api.ResourceList
 		if pipelineInfo.ResourceSpec != nil {
 			resources, err = util.GetResourceListFromPipeline(pipelineInfo)
@@ -232,6 +240,11 @@ func (a *apiServer) upsertWorkersForPipeline(pipelineInfo *pps.PipelineInfo) err
 			}
 		}
 
+		limits, err := util.GetLimitsResourceListFromPipeline(pipelineInfo)
+		if err != nil {
+			return err
+		}
+
 		// Retrieve the current state of the RC.  If the RC is scaled down,
 		// we want to ensure that it remains scaled down.
 		rc := a.kubeClient.ReplicationControllers(a.namespace)
@@ -240,23 +253,18 @@ func (a *apiServer) upsertWorkersForPipeline(pipelineInfo *pps.PipelineInfo) err
 			if (workerRc.Spec.Template.Spec.Containers[0].Resources.Requests == nil) && workerRc.Spec.Replicas == 1 {
 				parallelism = 1
 				resources = nil
+				// Kubernetes defaults requests to limits, so limits
+				// must go too
+				limits = nil
+			} else if pipelineInfo.AutoscalingSpec != nil &&
+				int64(workerRc.Spec.Replicas) <= pipelineInfo.AutoscalingSpec.MaxWorkers &&
+				int(workerRc.Spec.Replicas) > parallelism {
+				// Keep the number of workers the autoscaler chose
+				parallelism = int(workerRc.Spec.Replicas)
 			}
 		}
 
-		options := a.getWorkerOptions(
-			pipelineInfo.Pipeline.Name,
-			ppsserver.PipelineRcName(pipelineInfo.Pipeline.Name, pipelineInfo.Version),
-			int32(parallelism),
-			resources,
-			pipelineInfo.Transform,
-			pipelineInfo.CacheSize,
-			pipelineInfo.Service)
-		// Set the pipeline name env
-		options.workerEnv = append(options.workerEnv, api.EnvVar{
-			Name:  client.PPSPipelineNameEnv,
-			Value: pipelineInfo.Pipeline.Name,
-		})
-		return a.createWorkerRc(options)
+		return a.createWorkerRc(a.pipelineWorkerOptions(pipelineInfo, parallelism, resources, limits))
 	}, backoff.NewInfiniteBackOff(), func(err error, d time.Duration) error {
 		errCount++
 		if errCount >= 3 {
@@ -267,6 +275,46 @@ func (a *apiServer) upsertWorkersForPipeline(pipelineInfo *pps.PipelineInfo) err
 	})
 }
 
+// pipelineWorkerOptions returns the options for the workers of
+// 'pipelineInfo', with the given parallelism and resources
+func (a *apiServer) pipelineWorkerOptions(pipelineInfo *pps.PipelineInfo, parallelism int, resources *api.ResourceList, limits *api.ResourceList) *workerOptions {
+	options := a.getWorkerOptions(
+		pipelineInfo.Pipeline.Name,
+		ppsserver.PipelineRcName(pipelineInfo.Pipeline.Name, pipelineInfo.Version),
+		int32(parallelism),
+		resources,
+		limits,
+		pipelineInfo.Transform,
+		pipelineInfo.CacheSize,
+		pipelineInfo.Service,
+		pipelineInfo.SchedulingSpec,
+		pipelineInfo.PodPatch)
+	// Set the pipeline name env
+	options.workerEnv = append(options.workerEnv, api.EnvVar{
+		Name:  client.PPSPipelineNameEnv,
+		Value: pipelineInfo.Pipeline.Name,
+	})
+	return options
+}
+
+// pipelineWorkerSpec returns the pod template of the workers of
+// 'pipelineInfo', as they'd be created with no scaling down
+func (a *apiServer) pipelineWorkerSpec(pipelineInfo *pps.PipelineInfo) (*api.PodTemplateSpec, error) {
+	var resources *api.ResourceList
+	if pipelineInfo.ResourceSpec != nil {
+		var err error
+		resources, err = util.GetResourceListFromPipeline(pipelineInfo)
+		if err != nil {
+			return nil, err
+		}
+	}
+	limits, err := util.GetLimitsResourceListFromPipeline(pipelineInfo)
+	if err != nil {
+		return nil, err
+	}
+	return a.workerPodTemplate(a.pipelineWorkerOptions(pipelineInfo, 1, resources, limits))
+}
+
 func (a *apiServer) deleteWorkersForPipeline(pipelineInfo *pps.PipelineInfo) error {
 	rcName := ppsserver.PipelineRcName(pipelineInfo.Pipeline.Name, pipelineInfo.Version)
 	if err := a.kubeClient.Services(a.namespace).Delete(rcName); err != nil {
@@ -290,5 +338,21 @@ func (a *apiServer) deleteWorkersForPipeline(pipelineInfo *pps.PipelineInfo) err
 			return err
 		}
 	}
+	if pipelineInfo.Service != nil {
+		// Pods that were being replaced by a rollout aren't in the RC any more
+		retired := labels(rcName)
+		retired["component"] = ppsserver.RetiredComponent
+		podList, err := a.kubeClient.Pods(a.namespace).List(api.ListOptions{
+			LabelSelector: labelspkg.SelectorFromSet(retired),
+		})
+		if err != nil {
+			return err
+		}
+		for _, pod := range podList.Items {
+			if err := a.kubeClient.Pods(a.namespace).Delete(pod.Name, nil); err != nil && !isNotFoundErr(err) {
+				return err
+			}
+		}
+	}
 	return nil
 }
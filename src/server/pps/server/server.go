@@ -54,9 +54,14 @@ func NewAPIServer(
 		reporter:              reporter,
 		pipelines:             ppsdb.Pipelines(etcdClient, etcdPrefix),
 		jobs:                  ppsdb.Jobs(etcdClient, etcdPrefix),
+		webhooks:              ppsdb.Webhooks(etcdClient, etcdPrefix),
+		webhookDeliveries:     ppsdb.WebhookDeliveries(etcdClient, etcdPrefix),
+		admissionPolicy:       ppsdb.AdmissionPolicy(etcdClient, etcdPrefix),
 	}
 	apiServer.validateKube()
 	go apiServer.master()
+	go apiServer.notifier()
+	go apiServer.admitter()
 	return apiServer, nil
 }
 
@@ -79,14 +84,17 @@ func NewSidecarAPIServer(
 	}
 
 	apiServer := &apiServer{
-		Logger:     log.NewLogger("pps.API"),
-		address:    address,
-		etcdPrefix: etcdPrefix,
-		etcdClient: etcdClient,
-		iamRole:    iamRole,
-		reporter:   reporter,
-		pipelines:  ppsdb.Pipelines(etcdClient, etcdPrefix),
-		jobs:       ppsdb.Jobs(etcdClient, etcdPrefix),
+		Logger:            log.NewLogger("pps.API"),
+		address:           address,
+		etcdPrefix:        etcdPrefix,
+		etcdClient:        etcdClient,
+		iamRole:           iamRole,
+		reporter:          reporter,
+		pipelines:         ppsdb.Pipelines(etcdClient, etcdPrefix),
+		jobs:              ppsdb.Jobs(etcdClient, etcdPrefix),
+		webhooks:          ppsdb.Webhooks(etcdClient, etcdPrefix),
+		webhookDeliveries: ppsdb.WebhookDeliveries(etcdClient, etcdPrefix),
+		admissionPolicy:   ppsdb.AdmissionPolicy(etcdClient, etcdPrefix),
 	}
 	return apiServer, nil
 }
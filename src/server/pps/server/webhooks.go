@@ -0,0 +1,591 @@
+package server
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	etcd "github.com/coreos/etcd/clientv3"
+	"github.com/coreos/etcd/etcdserver/api/v3rpc/rpctypes"
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/types"
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/pachyderm/pachyderm/src/client/auth"
+	"github.com/pachyderm/pachyderm/src/client/limit"
+	"github.com/pachyderm/pachyderm/src/client/pps"
+	"github.com/pachyderm/pachyderm/src/server/pkg/backoff"
+	col "github.com/pachyderm/pachyderm/src/server/pkg/collection"
+	"github.com/pachyderm/pachyderm/src/server/pkg/dlock"
+	"github.com/pachyderm/pachyderm/src/server/pkg/watch"
+)
+
+const (
+	notifierLockPath = "_notifier_lock"
+	// notifierRevisionsPath is where the notifier stores the revision of the
+	// last change it processed in each collection that it watches
+	notifierRevisionsPath = "_notifier_revisions"
+
+	// webhookTimeout is how long a webhook has to respond to a delivery
+	webhookTimeout = 10 * time.Second
+	// webhookMaxAttempts is the number of times a delivery is attempted
+	// before it's given up
+	webhookMaxAttempts = 10
+	// webhookMaxBackoff caps the wait between the attempts of a delivery,
+	// which doubles after each failed attempt
+	webhookMaxBackoff = 10 * time.Minute
+	// webhookPollInterval is how often pending deliveries are checked
+	webhookPollInterval = time.Second
+	// webhookParallelism is the number of deliveries attempted at once
+	webhookParallelism = 10
+	// webhookRevisionInterval is how often the notifier stores its progress
+	// when it sees no events
+	webhookRevisionInterval = 10 * time.Second
+
+	// webhookSignatureHeader carries the HMAC-SHA256 of a delivery's body,
+	// keyed by the webhook's secret
+	webhookSignatureHeader = "X-Pachyderm-Signature"
+	webhookEventHeader     = "X-Pachyderm-Event"
+	webhookDeliveryHeader  = "X-Pachyderm-Delivery"
+)
+
+func (a *apiServer) CreateWebhook(ctx context.Context, request *pps.CreateWebhookRequest) (response *types.Empty, retErr error) {
+	// Don't log the webhook's secret
+	loggedRequest := *request
+	if request.Webhook != nil {
+		loggedWebhook := *request.Webhook
+		loggedWebhook.Secret = ""
+		loggedRequest.Webhook = &loggedWebhook
+	}
+	func() { a.Log(&loggedRequest, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(&loggedRequest, response, retErr, time.Since(start)) }(time.Now())
+
+	webhook := request.Webhook
+	if webhook == nil {
+		return nil, fmt.Errorf("webhook must be set")
+	}
+	if webhook.Name == "" || strings.Contains(webhook.Name, "/") {
+		return nil, fmt.Errorf("invalid webhook name %q", webhook.Name)
+	}
+	u, err := url.Parse(webhook.URL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid webhook url: %v", err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return nil, fmt.Errorf("webhook url must be http or https, not %q", webhook.URL)
+	}
+	if err := a.authorizeWebhook(ctx, webhook.Pipeline); err != nil {
+		return nil, err
+	}
+	if request.Update {
+		var oldWebhook pps.WebhookInfo
+		if err := a.webhooks.ReadOnly(ctx).Get(webhook.Name, &oldWebhook); err != nil {
+			return nil, err
+		}
+		// A webhook moved between pipelines needs both authorizations
+		if oldWebhook.Pipeline.GetName() != webhook.Pipeline.GetName() {
+			if err := a.authorizeWebhook(ctx, oldWebhook.Pipeline); err != nil {
+				return nil, err
+			}
+		}
+	}
+	webhook.RecentError = ""
+	if _, err := col.NewSTM(ctx, a.etcdClient, func(stm col.STM) error {
+		webhooks := a.webhooks.ReadWrite(stm)
+		if request.Update {
+			return webhooks.Put(webhook.Name, webhook)
+		}
+		return webhooks.Create(webhook.Name, webhook)
+	}); err != nil {
+		return nil, err
+	}
+	return &types.Empty{}, nil
+}
+
+func (a *apiServer) ListWebhook(ctx context.Context, request *pps.ListWebhookRequest) (response *pps.WebhookInfos, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+
+	webhookInfos, err := a.listWebhooks(ctx)
+	if err != nil {
+		return nil, err
+	}
+	response = &pps.WebhookInfos{}
+	for _, webhookInfo := range webhookInfos {
+		if request.Pipeline != nil && webhookInfo.Pipeline.GetName() != request.Pipeline.Name {
+			continue
+		}
+		webhookInfo.Secret = ""
+		response.WebhookInfo = append(response.WebhookInfo, webhookInfo)
+	}
+	return response, nil
+}
+
+func (a *apiServer) DeleteWebhook(ctx context.Context, request *pps.DeleteWebhookRequest) (response *types.Empty, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+
+	var webhookInfo pps.WebhookInfo
+	if err := a.webhooks.ReadOnly(ctx).Get(request.Name, &webhookInfo); err != nil {
+		return nil, err
+	}
+	if err := a.authorizeWebhook(ctx, webhookInfo.Pipeline); err != nil {
+		return nil, err
+	}
+	if _, err := col.NewSTM(ctx, a.etcdClient, func(stm col.STM) error {
+		return a.webhooks.ReadWrite(stm).Delete(request.Name)
+	}); err != nil {
+		return nil, err
+	}
+	return &types.Empty{}, nil
+}
+
+// authorizeWebhook checks that the caller may manage the webhooks of
+// 'pipeline', which requires the same access as updating it, or the
+// cluster-wide webhooks if 'pipeline' is nil, which requires being an admin.
+func (a *apiServer) authorizeWebhook(ctx context.Context, pipeline *pps.Pipeline) error {
+	if pipeline != nil {
+		pipelineInfo, err := a.InspectPipeline(ctx, &pps.InspectPipelineRequest{Pipeline: pipeline})
+		if err != nil {
+			return err
+		}
+		return a.authorizePipelineOp(ctx, pipelineOpUpdate, pipelineInfo.Input, pipeline.Name)
+	}
+	pachClient, err := a.getPachClient()
+	if err != nil {
+		return err
+	}
+	if me, err := pachClient.WhoAmI(auth.In2Out(ctx), &auth.WhoAmIRequest{}); err == nil {
+		if !me.IsAdmin {
+			return fmt.Errorf("not authorized to manage cluster-wide webhooks, must " +
+				"be a cluster admin")
+		}
+	} else if !auth.IsNotActivatedError(err) {
+		return fmt.Errorf("could not verify that caller is admin: %v", err)
+	}
+	return nil
+}
+
+func (a *apiServer) listWebhooks(ctx context.Context) ([]*pps.WebhookInfo, error) {
+	iter, err := a.webhooks.ReadOnly(ctx).List()
+	if err != nil {
+		return nil, err
+	}
+	var result []*pps.WebhookInfo
+	for {
+		var name string
+		webhookInfo := new(pps.WebhookInfo)
+		ok, err := iter.Next(&name, webhookInfo)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return result, nil
+		}
+		result = append(result, webhookInfo)
+	}
+}
+
+// The notifier process turns job and pipeline state changes into events,
+// records a delivery of each event for every webhook that receives it, and
+// delivers them. Deliveries are stored in etcd until they succeed or are
+// given up, so they survive pachd restarts.
+func (a *apiServer) notifier() {
+	notifierLock := dlock.NewDLock(a.etcdClient, path.Join(a.etcdPrefix, notifierLockPath))
+	backoff.RetryNotify(func() error {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		ctx, err := notifierLock.Lock(ctx)
+		if err != nil {
+			return err
+		}
+		defer notifierLock.Unlock(ctx)
+
+		log.Infof("Launching PPS notifier process")
+
+		var eg errgroup.Group
+		eg.Go(func() error {
+			defer cancel()
+			return a.watchEvents(ctx)
+		})
+		eg.Go(func() error {
+			defer cancel()
+			return a.deliverWebhooks(ctx)
+		})
+		return eg.Wait()
+	}, backoff.NewInfiniteBackOff(), func(err error, d time.Duration) error {
+		log.Errorf("notifier: error running the notifier process: %v; retrying in %v", err, d)
+		return nil
+	})
+}
+
+// watchEvents watches the jobs and pipelines collections for state changes
+// and records deliveries of the resulting events. The revision of the last
+// change processed in each collection is stored in etcd, and the watches
+// resume after it, so that changes made while no notifier is running (e.g.
+// during a pachd restart) still produce events.
+func (a *apiServer) watchEvents(ctx context.Context) error {
+	jobRev, err := a.notifierRevision(ctx, "jobs")
+	if err != nil {
+		return err
+	}
+	jobWatcher, err := a.watchSince(ctx, a.jobs, jobRev)
+	if err != nil {
+		return fmt.Errorf("error creating watch: %v", err)
+	}
+	defer jobWatcher.Close()
+	pipelineRev, err := a.notifierRevision(ctx, "pipelines")
+	if err != nil {
+		return err
+	}
+	pipelineWatcher, err := a.watchSince(ctx, a.pipelines, pipelineRev)
+	if err != nil {
+		return fmt.Errorf("error creating watch: %v", err)
+	}
+	defer pipelineWatcher.Close()
+
+	// Revisions are stored after every event, and at most every
+	// webhookRevisionInterval otherwise, as replaying changes that aren't
+	// events is harmless
+	lastStored := make(map[string]time.Time)
+	processed := func(collection string, rev *int64, newRev int64, event *pps.Event) error {
+		if event != nil {
+			event.Time = now()
+			if err := a.enqueueEvent(ctx, event); err != nil {
+				return err
+			}
+		}
+		*rev = newRev
+		if event == nil && time.Since(lastStored[collection]) < webhookRevisionInterval {
+			return nil
+		}
+		lastStored[collection] = time.Now()
+		return a.putNotifierRevision(ctx, collection, newRev)
+	}
+	for {
+		select {
+		case e := <-jobWatcher.Watch():
+			if e.Err != nil {
+				return fmt.Errorf("event err: %v", e.Err)
+			}
+			if e.Type != watch.EventPut || e.Rev <= jobRev {
+				continue
+			}
+			// Jobs created since the last revision, and jobs whose changes
+			// were compacted, have no previous value, so they're compared
+			// against a new job
+			var jobID string
+			var jobInfo, prevJobInfo pps.JobInfo
+			if err := e.Unmarshal(&jobID, &jobInfo); err != nil {
+				return err
+			}
+			if e.PrevKey != nil {
+				if err := e.UnmarshalPrev(&jobID, &prevJobInfo); err != nil {
+					return err
+				}
+			}
+			if prevJobInfo.State != jobInfo.State {
+				jobStateChanges.WithLabelValues(jobInfo.Pipeline.GetName(), jobInfo.State.String()).Inc()
+			}
+			event := jobEvent(&prevJobInfo, &jobInfo)
+			if event != nil {
+				event.ID = fmt.Sprintf("%s-%s", event.Job.ID, strings.ToLower(event.JobState.String()))
+			}
+			if err := processed("jobs", &jobRev, e.Rev, event); err != nil {
+				return err
+			}
+		case e := <-pipelineWatcher.Watch():
+			if e.Err != nil {
+				return fmt.Errorf("event err: %v", e.Err)
+			}
+			if e.Type != watch.EventPut || e.Rev <= pipelineRev {
+				continue
+			}
+			var pipelineName string
+			var pipelineInfo, prevPipelineInfo pps.PipelineInfo
+			if err := e.Unmarshal(&pipelineName, &pipelineInfo); err != nil {
+				return err
+			}
+			if e.PrevKey != nil {
+				if err := e.UnmarshalPrev(&pipelineName, &prevPipelineInfo); err != nil {
+					return err
+				}
+			}
+			var event *pps.Event
+			if pipelineInfo.State == pps.PipelineState_PIPELINE_FAILURE &&
+				prevPipelineInfo.State != pps.PipelineState_PIPELINE_FAILURE {
+				event = &pps.Event{
+					ID:            fmt.Sprintf("%d-%s", e.Rev, pipelineName),
+					Type:          pps.EventType_EVENT_PIPELINE_FAILED,
+					Pipeline:      pipelineInfo.Pipeline,
+					PipelineState: pipelineInfo.State,
+					Reason:        pipelineInfo.Reason,
+				}
+			}
+			if err := processed("pipelines", &pipelineRev, e.Rev, event); err != nil {
+				return err
+			}
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// watchSince watches 'c' for the changes after revision 'rev'. If those
+// changes have been compacted, the current items are returned instead, with
+// no previous values, so that the caller can reconcile them.
+func (a *apiServer) watchSince(ctx context.Context, c col.Collection, rev int64) (watch.Watcher, error) {
+	if _, err := a.etcdClient.Get(ctx, c.Path(""), etcd.WithRev(rev), etcd.WithCountOnly()); err != nil {
+		if err != rpctypes.ErrCompacted {
+			return nil, err
+		}
+		log.Warnf("notifier: the changes since revision %d have been compacted; reconciling the current states instead", rev)
+		return c.ReadOnly(ctx).WatchWithPrev()
+	}
+	return c.ReadOnly(ctx).WatchWithPrevFromRevision(rev + 1)
+}
+
+func (a *apiServer) notifierRevisionKey(collection string) string {
+	return path.Join(a.etcdPrefix, notifierRevisionsPath, collection)
+}
+
+// notifierRevision returns the revision of the last change to 'collection'
+// that the notifier processed. The first time the notifier runs, the current
+// revision is stored and returned, so that old changes aren't events.
+func (a *apiServer) notifierRevision(ctx context.Context, collection string) (int64, error) {
+	resp, err := a.etcdClient.Get(ctx, a.notifierRevisionKey(collection))
+	if err != nil {
+		return 0, err
+	}
+	if len(resp.Kvs) == 0 {
+		return resp.Header.Revision, a.putNotifierRevision(ctx, collection, resp.Header.Revision)
+	}
+	return strconv.ParseInt(string(resp.Kvs[0].Value), 10, 64)
+}
+
+func (a *apiServer) putNotifierRevision(ctx context.Context, collection string, rev int64) error {
+	_, err := a.etcdClient.Put(ctx, a.notifierRevisionKey(collection), strconv.FormatInt(rev, 10))
+	return err
+}
+
+// jobEvent returns the event for a job's state change from 'prev' to
+// 'jobInfo', or nil if the change isn't an event.
+func jobEvent(prev *pps.JobInfo, jobInfo *pps.JobInfo) *pps.Event {
+	if prev.State == jobInfo.State {
+		return nil
+	}
+	var eventType pps.EventType
+	switch jobInfo.State {
+	case pps.JobState_JOB_RUNNING:
+		if prev.State != pps.JobState_JOB_STARTING {
+			return nil
+		}
+		eventType = pps.EventType_EVENT_JOB_STARTED
+	case pps.JobState_JOB_SUCCESS:
+		eventType = pps.EventType_EVENT_JOB_SUCCEEDED
+	case pps.JobState_JOB_FAILURE:
+		eventType = pps.EventType_EVENT_JOB_FAILED
+	case pps.JobState_JOB_KILLED:
+		eventType = pps.EventType_EVENT_JOB_KILLED
+	case pps.JobState_JOB_PARTIAL:
+		eventType = pps.EventType_EVENT_JOB_PARTIAL
+	default:
+		return nil
+	}
+	return &pps.Event{
+		Type:     eventType,
+		Pipeline: jobInfo.Pipeline,
+		Job:      jobInfo.Job,
+		JobState: jobInfo.State,
+		Reason:   jobInfo.Reason,
+	}
+}
+
+// enqueueEvent records a delivery of 'event' for every webhook that receives
+// it.
+func (a *apiServer) enqueueEvent(ctx context.Context, event *pps.Event) error {
+	webhookInfos, err := a.listWebhooks(ctx)
+	if err != nil {
+		return err
+	}
+	_, err = col.NewSTM(ctx, a.etcdClient, func(stm col.STM) error {
+		deliveries := a.webhookDeliveries.ReadWrite(stm)
+		for _, webhookInfo := range webhookInfos {
+			if !webhookReceives(webhookInfo, event) {
+				continue
+			}
+			if err := deliveries.Create(deliveryKey(webhookInfo.Name, event), &pps.WebhookDelivery{
+				Webhook:     webhookInfo.Name,
+				Event:       event,
+				NextAttempt: event.Time,
+			}); err != nil && !col.IsErrExists(err) {
+				return err
+			}
+		}
+		return nil
+	})
+	return err
+}
+
+func webhookReceives(webhookInfo *pps.WebhookInfo, event *pps.Event) bool {
+	if webhookInfo.Pipeline != nil && webhookInfo.Pipeline.Name != event.Pipeline.GetName() {
+		return false
+	}
+	if len(webhookInfo.Events) == 0 {
+		return true
+	}
+	for _, eventType := range webhookInfo.Events {
+		if eventType == event.Type {
+			return true
+		}
+	}
+	return false
+}
+
+func deliveryKey(webhook string, event *pps.Event) string {
+	return fmt.Sprintf("%s_%s", event.ID, webhook)
+}
+
+// deliverWebhooks periodically attempts the pending deliveries that are due.
+func (a *apiServer) deliverWebhooks(ctx context.Context) error {
+	httpClient := &http.Client{Timeout: webhookTimeout}
+	ticker := time.NewTicker(webhookPollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+		iter, err := a.webhookDeliveries.ReadOnly(ctx).List()
+		if err != nil {
+			return err
+		}
+		limiter := limit.New(webhookParallelism)
+		var eg errgroup.Group
+		for {
+			var key string
+			delivery := new(pps.WebhookDelivery)
+			ok, err := iter.Next(&key, delivery)
+			if err != nil {
+				return err
+			}
+			if !ok {
+				break
+			}
+			nextAttempt, err := types.TimestampFromProto(delivery.NextAttempt)
+			if err != nil {
+				return err
+			}
+			if time.Now().Before(nextAttempt) {
+				continue
+			}
+			limiter.Acquire()
+			eg.Go(func() error {
+				defer limiter.Release()
+				return a.attemptDelivery(ctx, httpClient, key, delivery)
+			})
+		}
+		if err := eg.Wait(); err != nil {
+			return err
+		}
+	}
+}
+
+// attemptDelivery sends a delivery to its webhook, and then deletes it if it
+// succeeded, or schedules its next attempt (or gives it up) if it failed.
+func (a *apiServer) attemptDelivery(ctx context.Context, httpClient *http.Client, key string, delivery *pps.WebhookDelivery) error {
+	var webhookInfo pps.WebhookInfo
+	if err := a.webhooks.ReadOnly(ctx).Get(delivery.Webhook, &webhookInfo); err != nil {
+		if !col.IsErrNotFound(err) {
+			return err
+		}
+		// The webhook has been deleted
+		_, err := col.NewSTM(ctx, a.etcdClient, func(stm col.STM) error {
+			return a.webhookDeliveries.ReadWrite(stm).Delete(key)
+		})
+		return err
+	}
+	deliveryErr := postEvent(ctx, httpClient, &webhookInfo, delivery.Event)
+	_, err := col.NewSTM(ctx, a.etcdClient, func(stm col.STM) error {
+		deliveries := a.webhookDeliveries.ReadWrite(stm)
+		if deliveryErr == nil {
+			return deliveries.Delete(key)
+		}
+		delivery.Attempts++
+		delivery.LastError = deliveryErr.Error()
+		if delivery.Attempts < webhookMaxAttempts {
+			wait := time.Second << uint(delivery.Attempts)
+			if wait > webhookMaxBackoff {
+				wait = webhookMaxBackoff
+			}
+			nextAttempt, err := types.TimestampProto(time.Now().Add(wait))
+			if err != nil {
+				return err
+			}
+			delivery.NextAttempt = nextAttempt
+			return deliveries.Put(key, delivery)
+		}
+		log.Errorf("notifier: giving up delivering event %s to webhook %s after %d attempts: %v", delivery.Event.ID, delivery.Webhook, delivery.Attempts, deliveryErr)
+		webhooks := a.webhooks.ReadWrite(stm)
+		var webhookInfo pps.WebhookInfo
+		if err := webhooks.Get(delivery.Webhook, &webhookInfo); err == nil {
+			webhookInfo.RecentError = fmt.Sprintf("gave up delivering event %s: %v", delivery.Event.ID, deliveryErr)
+			if err := webhooks.Put(delivery.Webhook, &webhookInfo); err != nil {
+				return err
+			}
+		} else if !col.IsErrNotFound(err) {
+			return err
+		}
+		return deliveries.Delete(key)
+	})
+	return err
+}
+
+// postEvent posts 'event' as JSON to a webhook, signing it with the
+// webhook's secret if it has one.
+func postEvent(ctx context.Context, httpClient *http.Client, webhookInfo *pps.WebhookInfo, event *pps.Event) error {
+	body, err := (&jsonpb.Marshaler{}).MarshalToString(event)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequest("POST", webhookInfo.URL, bytes.NewReader([]byte(body)))
+	if err != nil {
+		return err
+	}
+	req = req.WithContext(ctx)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(webhookEventHeader, strings.ToLower(strings.TrimPrefix(event.Type.String(), "EVENT_")))
+	req.Header.Set(webhookDeliveryHeader, event.ID)
+	if webhookInfo.Secret != "" {
+		req.Header.Set(webhookSignatureHeader, "sha256="+signWebhookBody(webhookInfo.Secret, []byte(body)))
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook responded with %s", resp.Status)
+	}
+	return nil
+}
+
+// signWebhookBody returns the hex-encoded HMAC-SHA256 of 'body' keyed by
+// 'secret'.
+func signWebhookBody(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,204 @@
+package server
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	etcd "github.com/coreos/etcd/clientv3"
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/types"
+	"golang.org/x/net/context"
+
+	"github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/client/pkg/require"
+	"github.com/pachyderm/pachyderm/src/client/pkg/uuid"
+	"github.com/pachyderm/pachyderm/src/client/pps"
+	col "github.com/pachyderm/pachyderm/src/server/pkg/collection"
+	"github.com/pachyderm/pachyderm/src/server/pkg/ppsdb"
+)
+
+func TestJobEvent(t *testing.T) {
+	jobInfo := func(state pps.JobState) *pps.JobInfo {
+		return &pps.JobInfo{
+			Job:      client.NewJob("job"),
+			Pipeline: client.NewPipeline("pipeline"),
+			State:    state,
+			Reason:   "reason",
+		}
+	}
+	for _, test := range []struct {
+		prev, next pps.JobState
+		eventType  pps.EventType
+	}{
+		{pps.JobState_JOB_STARTING, pps.JobState_JOB_RUNNING, pps.EventType_EVENT_JOB_STARTED},
+		{pps.JobState_JOB_RUNNING, pps.JobState_JOB_SUCCESS, pps.EventType_EVENT_JOB_SUCCEEDED},
+		{pps.JobState_JOB_RUNNING, pps.JobState_JOB_FAILURE, pps.EventType_EVENT_JOB_FAILED},
+		{pps.JobState_JOB_RUNNING, pps.JobState_JOB_KILLED, pps.EventType_EVENT_JOB_KILLED},
+		{pps.JobState_JOB_RUNNING, pps.JobState_JOB_PARTIAL, pps.EventType_EVENT_JOB_PARTIAL},
+	} {
+		event := jobEvent(jobInfo(test.prev), jobInfo(test.next))
+		require.NotNil(t, event)
+		require.Equal(t, test.eventType, event.Type)
+		require.Equal(t, "job", event.Job.ID)
+		require.Equal(t, "pipeline", event.Pipeline.Name)
+		require.Equal(t, test.next, event.JobState)
+		require.Equal(t, "reason", event.Reason)
+	}
+	// Changes that aren't state changes, and jobs that resume running, aren't
+	// events
+	require.Nil(t, jobEvent(jobInfo(pps.JobState_JOB_RUNNING), jobInfo(pps.JobState_JOB_RUNNING)))
+	require.Nil(t, jobEvent(jobInfo(pps.JobState_JOB_FAILURE), jobInfo(pps.JobState_JOB_RUNNING)))
+	require.Nil(t, jobEvent(jobInfo(pps.JobState_JOB_RUNNING), jobInfo(pps.JobState_JOB_STARTING)))
+}
+
+func TestSignWebhookBody(t *testing.T) {
+	require.Equal(t, "3f3ab3986b656abb17af3eb1443ed6c08ef8fff9fea83915909d1b421aec89be",
+		signWebhookBody("secret", []byte(`{"foo":"bar"}`)))
+}
+
+func TestPostEvent(t *testing.T) {
+	event := &pps.Event{
+		ID:       "job-job_success",
+		Type:     pps.EventType_EVENT_JOB_SUCCEEDED,
+		Pipeline: client.NewPipeline("pipeline"),
+		Job:      client.NewJob("job"),
+		JobState: pps.JobState_JOB_SUCCESS,
+	}
+	var mu sync.Mutex
+	var requests []*http.Request
+	var bodies [][]byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		body, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		requests = append(requests, r)
+		bodies = append(bodies, body)
+	}))
+	defer server.Close()
+
+	httpClient := &http.Client{Timeout: webhookTimeout}
+	require.NoError(t, postEvent(context.Background(), httpClient, &pps.WebhookInfo{URL: server.URL, Secret: "secret"}, event))
+	require.NoError(t, postEvent(context.Background(), httpClient, &pps.WebhookInfo{URL: server.URL}, event))
+	require.Equal(t, 2, len(requests))
+
+	r := requests[0]
+	require.Equal(t, "POST", r.Method)
+	require.Equal(t, "application/json", r.Header.Get("Content-Type"))
+	require.Equal(t, "job_succeeded", r.Header.Get(webhookEventHeader))
+	require.Equal(t, "job-job_success", r.Header.Get(webhookDeliveryHeader))
+	require.Equal(t, "sha256="+signWebhookBody("secret", bodies[0]), r.Header.Get(webhookSignatureHeader))
+	var posted pps.Event
+	require.NoError(t, jsonpb.UnmarshalString(string(bodies[0]), &posted))
+	require.Equal(t, event, &posted)
+
+	// Deliveries to webhooks without secrets aren't signed
+	require.Equal(t, "", requests[1].Header.Get(webhookSignatureHeader))
+	require.Equal(t, bodies[0], bodies[1])
+}
+
+func TestPostEventError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	httpClient := &http.Client{Timeout: webhookTimeout}
+	event := &pps.Event{ID: "event", Type: pps.EventType_EVENT_PIPELINE_FAILED}
+	require.YesError(t, postEvent(context.Background(), httpClient, &pps.WebhookInfo{URL: server.URL}, event))
+}
+
+func TestAttemptDelivery(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+	etcdClient, err := etcd.New(etcd.Config{
+		Endpoints:   []string{"localhost:32379"},
+		DialOptions: client.EtcdDialOptions(),
+	})
+	require.NoError(t, err)
+	etcdPrefix := uuid.NewWithoutDashes()
+	a := &apiServer{
+		etcdClient:        etcdClient,
+		etcdPrefix:        etcdPrefix,
+		webhooks:          ppsdb.Webhooks(etcdClient, etcdPrefix),
+		webhookDeliveries: ppsdb.WebhookDeliveries(etcdClient, etcdPrefix),
+	}
+	ctx := context.Background()
+
+	var mu sync.Mutex
+	status := http.StatusInternalServerError
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		w.WriteHeader(status)
+	}))
+	defer server.Close()
+	setStatus := func(s int) {
+		mu.Lock()
+		defer mu.Unlock()
+		status = s
+	}
+
+	webhookInfo := &pps.WebhookInfo{Name: "webhook", URL: server.URL}
+	put := func(key string, delivery *pps.WebhookDelivery) {
+		_, err := col.NewSTM(ctx, etcdClient, func(stm col.STM) error {
+			if err := a.webhooks.ReadWrite(stm).Put(webhookInfo.Name, webhookInfo); err != nil {
+				return err
+			}
+			return a.webhookDeliveries.ReadWrite(stm).Put(key, delivery)
+		})
+		require.NoError(t, err)
+	}
+	httpClient := &http.Client{Timeout: webhookTimeout}
+	event := &pps.Event{ID: "event", Type: pps.EventType_EVENT_PIPELINE_FAILED}
+
+	// A failed delivery is retried later, with a growing backoff
+	delivery := &pps.WebhookDelivery{Webhook: webhookInfo.Name, Event: event}
+	put("delivery", delivery)
+	require.NoError(t, a.attemptDelivery(ctx, httpClient, "delivery", delivery))
+	require.NoError(t, a.webhookDeliveries.ReadOnly(ctx).Get("delivery", delivery))
+	require.Equal(t, int64(1), delivery.Attempts)
+	require.True(t, delivery.LastError != "")
+	nextAttempt, err := types.TimestampFromProto(delivery.NextAttempt)
+	require.NoError(t, err)
+	require.True(t, nextAttempt.After(time.Now()))
+	require.NoError(t, a.attemptDelivery(ctx, httpClient, "delivery", delivery))
+	require.NoError(t, a.webhookDeliveries.ReadOnly(ctx).Get("delivery", delivery))
+	require.Equal(t, int64(2), delivery.Attempts)
+	laterAttempt, err := types.TimestampFromProto(delivery.NextAttempt)
+	require.NoError(t, err)
+	require.True(t, laterAttempt.After(nextAttempt))
+
+	// A successful delivery is deleted
+	setStatus(http.StatusOK)
+	require.NoError(t, a.attemptDelivery(ctx, httpClient, "delivery", delivery))
+	err = a.webhookDeliveries.ReadOnly(ctx).Get("delivery", delivery)
+	require.True(t, col.IsErrNotFound(err))
+
+	// A delivery is given up after its last attempt fails, and the webhook
+	// records why
+	setStatus(http.StatusInternalServerError)
+	delivery = &pps.WebhookDelivery{Webhook: webhookInfo.Name, Event: event, Attempts: webhookMaxAttempts - 1}
+	put("last", delivery)
+	require.NoError(t, a.attemptDelivery(ctx, httpClient, "last", delivery))
+	err = a.webhookDeliveries.ReadOnly(ctx).Get("last", delivery)
+	require.True(t, col.IsErrNotFound(err))
+	var storedWebhookInfo pps.WebhookInfo
+	require.NoError(t, a.webhooks.ReadOnly(ctx).Get(webhookInfo.Name, &storedWebhookInfo))
+	require.True(t, storedWebhookInfo.RecentError != "")
+
+	// Deliveries to deleted webhooks are dropped
+	delivery = &pps.WebhookDelivery{Webhook: "deleted", Event: event}
+	put("deleted", delivery)
+	require.NoError(t, a.attemptDelivery(ctx, httpClient, "deleted", delivery))
+	err = a.webhookDeliveries.ReadOnly(ctx).Get("deleted", delivery)
+	require.True(t, col.IsErrNotFound(err))
+}
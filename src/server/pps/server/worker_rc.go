@@ -2,11 +2,19 @@ package server
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/gogo/protobuf/types"
 
 	client "github.com/pachyderm/pachyderm/src/client"
 	"github.com/pachyderm/pachyderm/src/client/enterprise"
+	"github.com/pachyderm/pachyderm/src/client/pkg/tracing"
 	"github.com/pachyderm/pachyderm/src/client/pps"
 	"github.com/pachyderm/pachyderm/src/server/pkg/deploy/assets"
+	"github.com/pachyderm/pachyderm/src/server/pkg/podpatch"
+	ppsserver "github.com/pachyderm/pachyderm/src/server/pps"
 
 	"k8s.io/kubernetes/pkg/api"
 	"k8s.io/kubernetes/pkg/api/resource"
@@ -25,6 +33,7 @@ type workerOptions struct {
 	parallelism  int32             // Number of replicas the RC maintains
 	cacheSize    string            // Size of cache that sidecar uses
 	resources    *api.ResourceList // Resources requested by pipeline/job pods
+	limits       *api.ResourceList // Resources that pipeline/job pods may use
 	workerEnv    []api.EnvVar      // Environment vars set in the user container
 	volumes      []api.Volume      // Volumes that we expose to the user container
 	volumeMounts []api.VolumeMount // Paths where we mount each volume in 'volumes'
@@ -33,6 +42,9 @@ type workerOptions struct {
 	// s3)
 	imagePullSecrets []api.LocalObjectReference
 	service          *pps.Service
+
+	schedulingSpec *pps.SchedulingSpec // Constraints on where workers run
+	podPatch       string              // Patch applied to the worker pod spec
 }
 
 func (a *apiServer) workerPodSpec(options *workerOptions) (api.PodSpec, error) {
@@ -53,6 +65,13 @@ func (a *apiServer) workerPodSpec(options *workerOptions) (api.PodSpec, error) {
 		Name:  "STORAGE_BACKEND",
 		Value: a.storageBackend,
 	}}
+	// Trace the sidecar if pachd is being traced
+	if exporter := os.Getenv(tracing.ExporterEnvVar); exporter != "" {
+		sidecarEnv = append(sidecarEnv, api.EnvVar{
+			Name:  tracing.ExporterEnvVar,
+			Value: exporter,
+		})
+	}
 	// This only happens in local deployment.  We want the workers to be
 	// able to read from/write to the hostpath volume as well.
 	storageVolumeName := "pach-disk"
@@ -167,12 +186,149 @@ func (a *apiServer) workerPodSpec(options *workerOptions) (api.PodSpec, error) {
 			Requests: *options.resources,
 		}
 	}
+	if options.limits != nil {
+		podSpec.Containers[0].Resources.Limits = *options.limits
+	}
+	if options.schedulingSpec != nil {
+		podSpec.NodeSelector = options.schedulingSpec.NodeSelector
+	}
+	if options.service != nil {
+		probe, err := readinessProbe(options.service)
+		if err != nil {
+			return api.PodSpec{}, err
+		}
+		podSpec.Containers[0].ReadinessProbe = probe
+	}
+	if options.podPatch != "" {
+		return patchPodSpec(podSpec, options.podPatch)
+	}
 	return podSpec, nil
 }
 
+// patchPodSpec applies the pod patch of a pipeline to its workers' pod
+// spec, and checks that the result is still a pod that can run the
+// pipeline's workers.
+func patchPodSpec(podSpec api.PodSpec, podPatch string) (api.PodSpec, error) {
+	podSpecJSON, err := json.Marshal(podSpec)
+	if err != nil {
+		return api.PodSpec{}, err
+	}
+	patchedJSON, err := podpatch.Apply(podSpecJSON, podPatch)
+	if err != nil {
+		return api.PodSpec{}, fmt.Errorf("could not apply pod patch: %v", err)
+	}
+	var patched api.PodSpec
+	if err := json.Unmarshal(patchedJSON, &patched); err != nil {
+		return api.PodSpec{}, fmt.Errorf("pod patch results in an invalid pod spec: %v", err)
+	}
+	// Init containers aren't serialized in this version of the pod spec, so
+	// they can't be patched
+	patched.InitContainers = podSpec.InitContainers
+	// Pachyderm finds the user container and the sidecar by name, and
+	// assumes the user container is first
+	if len(patched.Containers) < 2 || patched.Containers[0].Name != client.PPSWorkerUserContainerName {
+		return api.PodSpec{}, fmt.Errorf("pod patch must keep the %q container first in the pod spec", client.PPSWorkerUserContainerName)
+	}
+	hasSidecar := false
+	for _, container := range patched.Containers {
+		if container.Name == client.PPSWorkerSidecarContainerName {
+			hasSidecar = true
+		}
+		if container.Name == "" || container.Image == "" {
+			return api.PodSpec{}, fmt.Errorf("pod patch results in a container with no name or image")
+		}
+	}
+	if !hasSidecar {
+		return api.PodSpec{}, fmt.Errorf("pod patch must not remove the %q container", client.PPSWorkerSidecarContainerName)
+	}
+	return patched, nil
+}
+
+// workerPodTemplate returns the template of the pods of the workers
+// described by 'options'
+func (a *apiServer) workerPodTemplate(options *workerOptions) (*api.PodTemplateSpec, error) {
+	podSpec, err := a.workerPodSpec(options)
+	if err != nil {
+		return nil, err
+	}
+	annotations, err := schedulingAnnotations(options.annotations, options.schedulingSpec)
+	if err != nil {
+		return nil, err
+	}
+	return &api.PodTemplateSpec{
+		ObjectMeta: api.ObjectMeta{
+			Name:        options.rcName,
+			Labels:      options.labels,
+			Annotations: annotations,
+		},
+		Spec: podSpec,
+	}, nil
+}
+
+// schedulingAnnotations returns 'annotations' plus the annotations that
+// carry the tolerations and affinity in 'schedulingSpec', which this version
+// of Kubernetes reads from pod annotations rather than the pod spec
+func schedulingAnnotations(annotations map[string]string, schedulingSpec *pps.SchedulingSpec) (map[string]string, error) {
+	if schedulingSpec == nil || (len(schedulingSpec.Tolerations) == 0 && schedulingSpec.Affinity == "") {
+		return annotations, nil
+	}
+	result := make(map[string]string)
+	for key, value := range annotations {
+		result[key] = value
+	}
+	if len(schedulingSpec.Tolerations) > 0 {
+		var tolerations []api.Toleration
+		for _, toleration := range schedulingSpec.Tolerations {
+			tolerations = append(tolerations, api.Toleration{
+				Key:      toleration.Key,
+				Operator: api.TolerationOperator(toleration.Operator),
+				Value:    toleration.Value,
+				Effect:   api.TaintEffect(toleration.Effect),
+			})
+		}
+		tolerationsJSON, err := json.Marshal(tolerations)
+		if err != nil {
+			return nil, err
+		}
+		result[api.TolerationsAnnotationKey] = string(tolerationsJSON)
+	}
+	if schedulingSpec.Affinity != "" {
+		var affinity api.Affinity
+		if err := json.Unmarshal([]byte(schedulingSpec.Affinity), &affinity); err != nil {
+			return nil, fmt.Errorf("could not parse affinity: %v", err)
+		}
+		result[api.AffinityAnnotationKey] = schedulingSpec.Affinity
+	}
+	return result, nil
+}
+
+// validateSchedulingSpec checks the fields of 'schedulingSpec' that
+// Kubernetes would otherwise reject when the workers are created
+func validateSchedulingSpec(schedulingSpec *pps.SchedulingSpec) error {
+	for _, toleration := range schedulingSpec.Tolerations {
+		switch api.TolerationOperator(toleration.Operator) {
+		case "", api.TolerationOpEqual:
+		case api.TolerationOpExists:
+			if toleration.Value != "" {
+				return fmt.Errorf("toleration of %q has operator Exists, so must not have a value", toleration.Key)
+			}
+		default:
+			return fmt.Errorf("toleration of %q has unknown operator %q", toleration.Key, toleration.Operator)
+		}
+		switch api.TaintEffect(toleration.Effect) {
+		case "", api.TaintEffectNoSchedule, api.TaintEffectPreferNoSchedule:
+		default:
+			return fmt.Errorf("toleration of %q has unknown effect %q", toleration.Key, toleration.Effect)
+		}
+	}
+	_, err := schedulingAnnotations(nil, schedulingSpec)
+	return err
+}
+
 func (a *apiServer) getWorkerOptions(pipelineName string, rcName string,
-	parallelism int32, resources *api.ResourceList, transform *pps.Transform,
-	cacheSize string, service *pps.Service) *workerOptions {
+	parallelism int32, resources *api.ResourceList, limits *api.ResourceList,
+	transform *pps.Transform, cacheSize string, service *pps.Service,
+	schedulingSpec *pps.SchedulingSpec, podPatch string) *workerOptions {
 	labels := labels(rcName)
 	userImage := transform.Image
 	if userImage == "" {
@@ -220,6 +376,13 @@ func (a *apiServer) getWorkerOptions(pipelineName string, rcName string,
 		Name:  client.PPSNamespaceEnv,
 		Value: a.namespace,
 	})
+	// Trace the worker if pachd is being traced
+	if exporter := os.Getenv(tracing.ExporterEnvVar); exporter != "" {
+		workerEnv = append(workerEnv, api.EnvVar{
+			Name:  tracing.ExporterEnvVar,
+			Value: exporter,
+		})
+	}
 
 	var volumes []api.Volume
 	var volumeMounts []api.VolumeMount
@@ -296,7 +459,12 @@ func (a *apiServer) getWorkerOptions(pipelineName string, rcName string,
 		imagePullSecrets = append(imagePullSecrets, api.LocalObjectReference{Name: a.imagePullSecret})
 	}
 
-	annotations := map[string]string{"pipelineName": pipelineName}
+	annotations := map[string]string{
+		"pipelineName": pipelineName,
+		// Let Prometheus discover the worker's /metrics endpoint
+		"prometheus.io/scrape": "true",
+		"prometheus.io/port":   "652",
+	}
 	if a.iamRole != "" {
 		annotations["iam.amazonaws.com/role"] = a.iamRole
 	}
@@ -307,6 +475,7 @@ func (a *apiServer) getWorkerOptions(pipelineName string, rcName string,
 		annotations:      annotations,
 		parallelism:      int32(parallelism),
 		resources:        resources,
+		limits:           limits,
 		userImage:        userImage,
 		workerEnv:        workerEnv,
 		volumes:          volumes,
@@ -314,11 +483,13 @@ func (a *apiServer) getWorkerOptions(pipelineName string, rcName string,
 		imagePullSecrets: imagePullSecrets,
 		cacheSize:        cacheSize,
 		service:          service,
+		schedulingSpec:   schedulingSpec,
+		podPatch:         podPatch,
 	}
 }
 
 func (a *apiServer) createWorkerRc(options *workerOptions) error {
-	podSpec, err := a.workerPodSpec(options)
+	podTemplate, err := a.workerPodTemplate(options)
 	if err != nil {
 		return err
 	}
@@ -335,14 +506,7 @@ func (a *apiServer) createWorkerRc(options *workerOptions) error {
 		Spec: api.ReplicationControllerSpec{
 			Selector: options.labels,
 			Replicas: options.parallelism,
-			Template: &api.PodTemplateSpec{
-				ObjectMeta: api.ObjectMeta{
-					Name:        options.rcName,
-					Labels:      options.labels,
-					Annotations: options.annotations,
-				},
-				Spec: podSpec,
-			},
+			Template: podTemplate,
 		},
 	}
 	if _, err := a.kubeClient.ReplicationControllers(a.namespace).Create(rc); err != nil {
@@ -377,6 +541,26 @@ func (a *apiServer) createWorkerRc(options *workerOptions) error {
 	}
 
 	if options.service != nil {
+		var ports []api.ServicePort
+		for _, port := range pps.ServicePorts(options.service) {
+			servicePort := api.ServicePort{
+				Name:       port.Name,
+				Port:       port.ExternalPort,
+				TargetPort: intstr.FromInt(int(port.InternalPort)),
+			}
+			if serviceType(options.service) == api.ServiceTypeNodePort {
+				servicePort.NodePort = port.ExternalPort
+			}
+			if servicePort.Port == 0 {
+				servicePort.Port = port.InternalPort
+			}
+			ports = append(ports, servicePort)
+		}
+		// Only the pods that have been rolled out to receive traffic
+		selector := map[string]string{
+			"app":                  options.rcName,
+			ppsserver.ServingLabel: "true",
+		}
 		service := &api.Service{
 			TypeMeta: unversioned.TypeMeta{
 				Kind:       "Service",
@@ -387,16 +571,9 @@ func (a *apiServer) createWorkerRc(options *workerOptions) error {
 				Labels: options.labels,
 			},
 			Spec: api.ServiceSpec{
-				Selector: options.labels,
-				Type:     api.ServiceTypeNodePort,
-				Ports: []api.ServicePort{
-					{
-						Port:       options.service.ExternalPort,
-						TargetPort: intstr.FromInt(int(options.service.InternalPort)),
-						Name:       "user-port",
-						NodePort:   options.service.ExternalPort,
-					},
-				},
+				Selector: selector,
+				Type:     serviceType(options.service),
+				Ports:    ports,
 			},
 		}
 		if _, err := a.kubeClient.Services(a.namespace).Create(service); err != nil {
@@ -408,3 +585,57 @@ func (a *apiServer) createWorkerRc(options *workerOptions) error {
 
 	return nil
 }
+
+// serviceType returns the k8s service type that exposes 'service'
+func serviceType(service *pps.Service) api.ServiceType {
+	if service.Type == "" {
+		return api.ServiceTypeNodePort
+	}
+	return api.ServiceType(service.Type)
+}
+
+// readinessProbe returns the readiness probe of the user container of a
+// service's pods, which decides when they may receive traffic. It's nil if
+// the service has nothing to check.
+func readinessProbe(service *pps.Service) (*api.Probe, error) {
+	spec := service.ReadinessProbe
+	if spec == nil {
+		spec = &pps.ReadinessProbe{}
+	}
+	port := spec.Port
+	if ports := pps.ServicePorts(service); port == 0 && len(ports) > 0 {
+		port = ports[0].InternalPort
+	}
+	probe := &api.Probe{FailureThreshold: spec.FailureThreshold}
+	switch {
+	case len(spec.Exec) > 0:
+		probe.Exec = &api.ExecAction{Command: spec.Exec}
+	case spec.HttpPath != "":
+		probe.HTTPGet = &api.HTTPGetAction{
+			Path: spec.HttpPath,
+			Port: intstr.FromInt(int(port)),
+		}
+	case port != 0:
+		probe.TCPSocket = &api.TCPSocketAction{Port: intstr.FromInt(int(port))}
+	default:
+		return nil, nil
+	}
+	for _, field := range []struct {
+		d       *types.Duration
+		seconds *int32
+	}{
+		{spec.InitialDelay, &probe.InitialDelaySeconds},
+		{spec.Period, &probe.PeriodSeconds},
+		{spec.Timeout, &probe.TimeoutSeconds},
+	} {
+		if field.d == nil {
+			continue
+		}
+		d, err := types.DurationFromProto(field.d)
+		if err != nil {
+			return nil, err
+		}
+		*field.seconds = int32(d.Seconds())
+	}
+	return probe, nil
+}
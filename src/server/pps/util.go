@@ -32,6 +32,20 @@ func PipelineRcName(name string, version uint64) string {
 	return fmt.Sprintf("pipeline-%s-v%d", strings.ToLower(name), version)
 }
 
+// Labels of the pods of service pipelines, which the rollout of new data
+// uses to track which pods are serving what
+const (
+	// ServiceJobLabel is set on a service's pods once their user code is
+	// running, to the ID of the job whose data they serve
+	ServiceJobLabel = "serviceJob"
+	// ServingLabel is set to "true" on the service's pods that receive
+	// traffic. The service's k8s service selects on it.
+	ServingLabel = "serving"
+	// RetiredComponent replaces the "component" label of a pod that's being
+	// replaced, which takes the pod out of its RC
+	RetiredComponent = "retired-worker"
+)
+
 // getNumNodes attempts to retrieve the number of nodes in the current k8s
 // cluster
 func getNumNodes(kubeClient *kube.Client) (int, error) {
@@ -34,11 +34,13 @@ import (
 	"github.com/pachyderm/pachyderm/src/client/limit"
 	"github.com/pachyderm/pachyderm/src/client/pfs"
 	"github.com/pachyderm/pachyderm/src/client/pkg/grpcutil"
+	"github.com/pachyderm/pachyderm/src/client/pkg/tracing"
 	"github.com/pachyderm/pachyderm/src/client/pkg/uuid"
 	"github.com/pachyderm/pachyderm/src/client/pps"
 	col "github.com/pachyderm/pachyderm/src/server/pkg/collection"
 	"github.com/pachyderm/pachyderm/src/server/pkg/hashtree"
 	"github.com/pachyderm/pachyderm/src/server/pkg/ppsdb"
+	"github.com/pachyderm/pachyderm/src/server/pkg/prom"
 	filesync "github.com/pachyderm/pachyderm/src/server/pkg/sync"
 	"github.com/pachyderm/pachyderm/src/server/pkg/util"
 	ppsserver "github.com/pachyderm/pachyderm/src/server/pps"
@@ -52,7 +54,40 @@ const (
 
 var (
 	errSpecialFile = errors.New("cannot upload special file")
+	errJobFinished = errors.New("job has already finished")
 	statsTagSuffix = "_stats"
+
+	datumsProcessed = prom.NewCounterVec(
+		"pachyderm_worker_datums_total",
+		"Number of datums handled by this worker, by pipeline and result (processed, skipped, failed or errored).",
+		"pipeline", "result",
+	)
+	datumDownloadSeconds = prom.NewHistogramVec(
+		"pachyderm_worker_datum_download_seconds",
+		"Time taken to download a datum's input data, by pipeline.",
+		datumBuckets,
+		"pipeline",
+	)
+	datumProcessSeconds = prom.NewHistogramVec(
+		"pachyderm_worker_datum_process_seconds",
+		"Time taken to run the user code on a datum, by pipeline.",
+		datumBuckets,
+		"pipeline",
+	)
+	datumUploadSeconds = prom.NewHistogramVec(
+		"pachyderm_worker_datum_upload_seconds",
+		"Time taken to upload a datum's output data, by pipeline.",
+		datumBuckets,
+		"pipeline",
+	)
+	datumQueueSize = prom.NewGaugeVec(
+		"pachyderm_worker_queue_size",
+		"Number of datums waiting to be processed by this worker, by pipeline.",
+		"pipeline",
+	)
+	// datumBuckets range from 10ms to ~45m, as datums can take anywhere
+	// from milliseconds to hours.
+	datumBuckets = prom.ExponentialBuckets(0.01, 4, 12)
 )
 
 // APIServer implements the worker API
@@ -88,6 +123,8 @@ type APIServer struct {
 
 	// The total number of workers for this pipeline
 	numWorkers int
+	// The autoscaler, if the pipeline has an AutoscalingSpec
+	autoscaler *autoscaler
 	// The namespace in which pachyderm is deployed
 	namespace string
 	// The jobs collection
@@ -125,9 +162,14 @@ type taggedLogger struct {
 	eg           errgroup.Group
 }
 
+// DatumID computes the id for a datum (see DatumID).
+func (a *APIServer) DatumID(data []*Input) string {
+	return DatumID(data)
+}
+
 // DatumID computes the id for a datum, this value is used in ListDatum and
 // InspectDatum.
-func (a *APIServer) DatumID(data []*Input) string {
+func DatumID(data []*Input) string {
 	hash := sha256.New()
 	for _, d := range data {
 		hash.Write([]byte(d.FileInfo.File.Path))
@@ -294,7 +336,16 @@ func NewAPIServer(pachClient *client.APIClient, etcdClient *etcd.Client, etcdPre
 		logger.Logf("error getting number of workers, default to 1 worker: %v", err)
 		numWorkers = 1
 	}
+	if pipelineInfo.AutoscalingSpec != nil {
+		// The pipeline may be scaled up to max_workers while a job runs, so
+		// size the job's queue for that many
+		numWorkers = int(pipelineInfo.AutoscalingSpec.MaxWorkers)
+	}
 	server.numWorkers = numWorkers
+	server.autoscaler = newAutoscaler(server)
+	datumQueueSize.Func(func() float64 {
+		return float64(atomic.LoadInt64(&server.queueSize))
+	}, pipelineInfo.Pipeline.Name)
 	if pipelineInfo.Transform.Image != "" {
 		docker, err := docker.NewClientFromEnv()
 		if err != nil {
@@ -330,10 +381,14 @@ func NewAPIServer(pachClient *client.APIClient, etcdClient *etcd.Client, etcdPre
 			server.pipelineInfo.Transform.Cmd = image.Config.Entrypoint
 		}
 	}
-	if pipelineInfo.Service == nil {
-		go server.master()
-	} else {
+	switch {
+	case pipelineInfo.Spout != nil:
+		go server.spoutMaster()
+	case pipelineInfo.Service != nil:
 		go server.serviceMaster()
+		go server.serveService()
+	default:
+		go server.master()
 	}
 	return server, nil
 }
@@ -421,6 +476,17 @@ func (a *APIServer) runUserCode(ctx context.Context, logger *taggedLogger, envir
 	return err
 }
 
+// exitCode returns the exit code of the user code that returned 'err', or -1
+// if it didn't exit normally.
+func exitCode(err error) int32 {
+	if exiterr, ok := err.(*exec.ExitError); ok {
+		if status, ok := exiterr.Sys().(syscall.WaitStatus); ok {
+			return int32(status.ExitStatus())
+		}
+	}
+	return -1
+}
+
 func (a *APIServer) uploadOutput(ctx context.Context, dir string, tag string, logger *taggedLogger, inputs []*Input, stats *pps.ProcessStats, statsTree hashtree.OpenHashTree, statsRoot string) error {
 	defer func(start time.Time) {
 		stats.UploadTime = types.DurationProto(time.Since(start))
@@ -589,6 +655,15 @@ func (a *APIServer) uploadOutput(ctx context.Context, dir string, tag string, lo
 		return err
 	}
 
+	// Fail the datum now, rather than when the job's output commit is
+	// built, if its output would exceed the output repo's quota
+	if _, err := a.pachClient.PfsAPIClient.CheckQuota(auth.In2Out(ctx), &pfs.CheckQuotaRequest{
+		Repo:            client.NewRepo(a.pipelineInfo.Pipeline.Name),
+		AdditionalBytes: atomic.LoadUint64(&stats.UploadBytes),
+	}); err != nil {
+		return err
+	}
+
 	finTree, err := tree.Finish()
 	if err != nil {
 		return err
@@ -667,6 +742,11 @@ func (a *APIServer) Process(ctx context.Context, req *ProcessRequest) (resp *Pro
 		logger.Logf("process call finished - request: %v, response: %v, err %v, duration: %v", req, resp, retErr, time.Since(start))
 	}(time.Now())
 	atomic.AddInt64(&a.queueSize, 1)
+	// Make sure the datum leaves the queue even if we return before running
+	// the user code, e.g. because the datum is skipped.
+	var dequeue sync.Once
+	leaveQueue := func() { atomic.AddInt64(&a.queueSize, -1) }
+	defer dequeue.Do(leaveQueue)
 	ctx, cancel := context.WithCancel(ctx)
 	// Hash inputs
 	tag := HashDatum(a.pipelineInfo.Pipeline.Name, a.pipelineInfo.Salt, req.Data)
@@ -678,19 +758,23 @@ func (a *APIServer) Process(ctx context.Context, req *ProcessRequest) (resp *Pro
 	foundTag15 := false
 	var object *pfs.Object
 	var eg errgroup.Group
-	eg.Go(func() error {
-		if _, err := a.pachClient.InspectTag(auth.In2Out(ctx), &pfs.Tag{tag}); err == nil {
-			foundTag = true
-		}
-		return nil
-	})
-	eg.Go(func() error {
-		if objectInfo, err := a.pachClient.InspectTag(auth.In2Out(ctx), &pfs.Tag{tag15}); err == nil {
-			foundTag15 = true
-			object = objectInfo.Object
-		}
-		return nil
-	})
+	// If the cache is skipped, the datum's output is recomputed and its tag
+	// overwritten
+	if !req.SkipCache {
+		eg.Go(func() error {
+			if _, err := a.pachClient.InspectTag(auth.In2Out(ctx), &pfs.Tag{tag}); err == nil {
+				foundTag = true
+			}
+			return nil
+		})
+		eg.Go(func() error {
+			if objectInfo, err := a.pachClient.InspectTag(auth.In2Out(ctx), &pfs.Tag{tag15}); err == nil {
+				foundTag15 = true
+				object = objectInfo.Object
+			}
+			return nil
+		})
+	}
 	if err := eg.Wait(); err != nil {
 		return nil, err
 	}
@@ -716,11 +800,13 @@ func (a *APIServer) Process(ctx context.Context, req *ProcessRequest) (resp *Pro
 	if foundTag15 || foundTag {
 		// We've already computed the output for these inputs. Return immediately
 		logger.Logf("skipping input, as it's already been processed")
+		datumsProcessed.WithLabelValues(a.pipelineInfo.Pipeline.Name, "skipped").Inc()
 		return &ProcessResponse{
 			Skipped: true,
 		}, nil
 	}
 	stats := &pps.ProcessStats{}
+	defer func() { a.observeDatum(stats, resp, retErr) }()
 	statsPath := path.Join("/", logger.template.DatumID)
 	var statsTree hashtree.OpenHashTree
 	if req.EnableStats {
@@ -779,7 +865,9 @@ func (a *APIServer) Process(ctx context.Context, req *ProcessRequest) (resp *Pro
 	env := a.userCodeEnv(req.JobID, req.Data)
 	// Download input data
 	puller := filesync.NewPuller()
+	downloadSpan, _ := tracing.StartSpan(ctx, "worker.Download", "datum", logger.template.DatumID)
 	dir, err := a.downloadData(logger, req.Data, puller, req.ParentOutput, stats, statsTree, path.Join(statsPath, "pfs"))
+	downloadSpan.FinishWithError(err)
 	// We run these cleanup functions no matter what, so that if
 	// downloadData partially succeeded, we still clean up the resources.
 	defer func() {
@@ -806,7 +894,7 @@ func (a *APIServer) Process(ctx context.Context, req *ProcessRequest) (resp *Pro
 	if response, err := func() (_ *ProcessResponse, retErr error) {
 		a.runMu.Lock()
 		defer a.runMu.Unlock()
-		atomic.AddInt64(&a.queueSize, -1)
+		dequeue.Do(leaveQueue)
 		func() {
 			a.statusMu.Lock()
 			defer a.statusMu.Unlock()
@@ -827,8 +915,24 @@ func (a *APIServer) Process(ctx context.Context, req *ProcessRequest) (resp *Pro
 				retErr = err
 			}
 		}()
-		err = a.runUserCode(ctx, logger, env, stats)
+		userCtx := ctx
+		var timeout time.Duration
+		if a.pipelineInfo.DatumTimeout != nil {
+			if timeout, err = types.DurationFromProto(a.pipelineInfo.DatumTimeout); err != nil {
+				return nil, err
+			}
+			var cancelTimeout func()
+			userCtx, cancelTimeout = context.WithTimeout(ctx, timeout)
+			defer cancelTimeout()
+		}
+		userCodeSpan, _ := tracing.StartSpan(userCtx, "worker.RunUserCode", "datum", logger.template.DatumID)
+		err = a.runUserCode(userCtx, logger, env, stats)
+		userCodeSpan.FinishWithError(err)
 		if err != nil {
+			code := exitCode(err)
+			if userCtx.Err() == context.DeadlineExceeded {
+				err = fmt.Errorf("datum timed out after %v", timeout)
+			}
 			logger.Logf("failed to process datum with error: %+v", err)
 			if statsTree != nil {
 				object, size, err := a.pachClient.PutObject(strings.NewReader(err.Error()))
@@ -841,7 +945,9 @@ func (a *APIServer) Process(ctx context.Context, req *ProcessRequest) (resp *Pro
 				}
 			}
 			return &ProcessResponse{
-				Failed: true,
+				Failed:   true,
+				ExitCode: code,
+				Reason:   err.Error(),
 			}, nil
 		}
 		return nil, nil
@@ -862,14 +968,19 @@ func (a *APIServer) Process(ctx context.Context, req *ProcessRequest) (resp *Pro
 		return nil, err
 	}
 	atomic.AddUint64(&stats.DownloadBytes, uint64(downSize))
-	if err := a.uploadOutput(ctx, dir, tag, logger, req.Data, stats, statsTree, path.Join(statsPath, "pfs", "out")); err != nil {
+	uploadSpan, uploadCtx := tracing.StartSpan(ctx, "worker.Upload", "datum", logger.template.DatumID)
+	err = a.uploadOutput(uploadCtx, dir, tag, logger, req.Data, stats, statsTree, path.Join(statsPath, "pfs", "out"))
+	uploadSpan.FinishWithError(err)
+	if err != nil {
 		// If uploading failed because the user program outputed a special
 		// file, then there's no point in retrying.  Thus we signal that
 		// there's some problem with the user code so the job doesn't
 		// infinitely retry to process this datum.
 		if err == errSpecialFile {
 			return &ProcessResponse{
-				Failed: true,
+				Failed:   true,
+				ExitCode: -1,
+				Reason:   err.Error(),
 			}, nil
 		}
 		return nil, err
@@ -877,6 +988,35 @@ func (a *APIServer) Process(ctx context.Context, req *ProcessRequest) (resp *Pro
 	return &ProcessResponse{Stats: stats}, nil
 }
 
+// observeDatum records the result of processing a datum, and how long each
+// stage of processing it took.
+func (a *APIServer) observeDatum(stats *pps.ProcessStats, resp *ProcessResponse, err error) {
+	pipelineName := a.pipelineInfo.Pipeline.Name
+	result := "processed"
+	switch {
+	case err != nil:
+		result = "errored"
+	case resp != nil && resp.Failed:
+		result = "failed"
+	}
+	datumsProcessed.WithLabelValues(pipelineName, result).Inc()
+	for _, stage := range []struct {
+		duration  *types.Duration
+		histogram *prom.HistogramVec
+	}{
+		{stats.DownloadTime, datumDownloadSeconds},
+		{stats.ProcessTime, datumProcessSeconds},
+		{stats.UploadTime, datumUploadSeconds},
+	} {
+		if stage.duration == nil {
+			continue
+		}
+		if duration, err := types.DurationFromProto(stage.duration); err == nil {
+			stage.histogram.WithLabelValues(pipelineName).Observe(duration.Seconds())
+		}
+	}
+}
+
 // Status returns the status of the current worker.
 func (a *APIServer) Status(ctx context.Context, _ *types.Empty) (*pps.WorkerStatus, error) {
 	a.statusMu.Lock()
@@ -934,7 +1074,13 @@ func (a *APIServer) userCodeEnv(jobID string, data []*Input) []string {
 	return result
 }
 
+// updateJobState moves 'jobInfo' to 'state', keeping its pipeline's job
+// counts in step. Jobs in a terminal state are never moved out of it;
+// errJobFinished is returned for them instead.
 func (a *APIServer) updateJobState(stm col.STM, jobInfo *pps.JobInfo, state pps.JobState, reason string) error {
+	if jobStateTerminal(jobInfo.State) {
+		return errJobFinished
+	}
 	// Update job counts
 	if jobInfo.Pipeline != nil {
 		pipelines := a.pipelines.ReadWrite(stm)
@@ -957,3 +1103,12 @@ func (a *APIServer) updateJobState(stm col.STM, jobInfo *pps.JobInfo, state pps.
 	jobs.Put(jobInfo.Job.ID, jobInfo)
 	return nil
 }
+
+// jobStateTerminal returns true if 'state' is one that jobs finish in.
+func jobStateTerminal(state pps.JobState) bool {
+	switch state {
+	case pps.JobState_JOB_SUCCESS, pps.JobState_JOB_FAILURE, pps.JobState_JOB_KILLED, pps.JobState_JOB_PARTIAL:
+		return true
+	}
+	return false
+}
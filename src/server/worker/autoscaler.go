@@ -0,0 +1,322 @@
+package worker
+
+import (
+	"fmt"
+	"path"
+	"sync"
+	"time"
+
+	etcd "github.com/coreos/etcd/clientv3"
+	"github.com/gogo/protobuf/proto"
+	"github.com/gogo/protobuf/types"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+
+	"github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/client/pps"
+	col "github.com/pachyderm/pachyderm/src/server/pkg/collection"
+	ppsserver "github.com/pachyderm/pachyderm/src/server/pps"
+)
+
+const (
+	// autoscaleInterval is how often the autoscaler reconsiders the number
+	// of workers
+	autoscaleInterval = 10 * time.Second
+	// statusTimeout bounds the time the autoscaler waits for the workers to
+	// report their status
+	statusTimeout = 5 * time.Second
+	// defaultScaleUpCooldown and defaultScaleDownCooldown are used if the
+	// pipeline's AutoscalingSpec doesn't set them
+	defaultScaleUpCooldown   = 30 * time.Second
+	defaultScaleDownCooldown = 5 * time.Minute
+	// latencyWeight is the weight of each new datum in the moving average
+	// of datum latency
+	latencyWeight = 0.2
+)
+
+// autoscaler scales the workers of a pipeline with an AutoscalingSpec. It
+// runs in the master, where runJob tells it how many datums of the current
+// job are pending and how long datums are taking. Its methods are no-ops on
+// a nil autoscaler, so callers needn't check whether the pipeline is
+// autoscaled.
+type autoscaler struct {
+	a    *APIServer
+	spec *pps.AutoscalingSpec
+
+	mu sync.Mutex
+	// pending is the number of datums of the running job that haven't
+	// finished
+	pending int64
+	// latency is a moving average of the time datums have taken
+	latency time.Duration
+	// lastScaled is when the autoscaler last changed the number of workers
+	lastScaled time.Time
+	// status is the last status written to the pipeline
+	status *pps.AutoscalingStatus
+}
+
+func newAutoscaler(a *APIServer) *autoscaler {
+	if a.pipelineInfo.AutoscalingSpec == nil {
+		return nil
+	}
+	return &autoscaler{
+		a:    a,
+		spec: a.pipelineInfo.AutoscalingSpec,
+	}
+}
+
+// minWorkers returns the fewest workers the pipeline may have
+func minWorkers(spec *pps.AutoscalingSpec) int64 {
+	if spec.MinWorkers > 0 {
+		return spec.MinWorkers
+	}
+	return 1
+}
+
+// startJob records that a job with 'datums' datums has started
+func (s *autoscaler) startJob(datums int64) {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.pending = datums
+}
+
+// finishJob records that the running job has finished
+func (s *autoscaler) finishJob() {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.pending = 0
+}
+
+// datumFinished records that one of the running job's datums has finished
+// (or failed)
+func (s *autoscaler) datumFinished() {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.pending > 0 {
+		s.pending--
+	}
+}
+
+// observe adds the time a processed datum took to the moving average of
+// datum latency
+func (s *autoscaler) observe(stats *pps.ProcessStats) {
+	if s == nil || stats == nil {
+		return
+	}
+	var total time.Duration
+	for _, d := range []*types.Duration{stats.DownloadTime, stats.ProcessTime, stats.UploadTime} {
+		if duration, err := types.DurationFromProto(d); err == nil {
+			total += duration
+		}
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.latency == 0 {
+		s.latency = total
+		return
+	}
+	s.latency = time.Duration(latencyWeight*float64(total) + (1-latencyWeight)*float64(s.latency))
+}
+
+// run reconsiders the number of workers every autoscaleInterval until 'ctx'
+// is cancelled
+func (s *autoscaler) run(ctx context.Context, logger *taggedLogger) {
+	if s == nil {
+		return
+	}
+	ticker := time.NewTicker(autoscaleInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		if err := s.autoscale(ctx, logger); err != nil {
+			logger.Logf("autoscaler: %v", err)
+		}
+	}
+}
+
+// autoscale works out how many workers the pipeline should have and, unless
+// it's cooling down from the last change, scales it to that many
+func (s *autoscaler) autoscale(ctx context.Context, logger *taggedLogger) error {
+	rcs := s.a.kubeClient.ReplicationControllers(s.a.namespace)
+	rcName := ppsserver.PipelineRcName(s.a.pipelineInfo.Pipeline.Name, s.a.pipelineInfo.Version)
+	workerRc, err := rcs.Get(rcName)
+	if err != nil {
+		return fmt.Errorf("error getting workers: %v", err)
+	}
+	current := int64(workerRc.Spec.Replicas)
+	statuses, err := s.workerStatuses(ctx, rcName)
+	if err != nil {
+		// The workers' queues only keep busy workers from being removed, so
+		// carry on without them
+		logger.Logf("autoscaler: error getting worker status: %v", err)
+	}
+	var queued, busy int64
+	for _, status := range statuses {
+		queued += status.QueueSize
+		if status.QueueSize > 0 || len(status.Data) > 0 {
+			busy++
+		}
+	}
+
+	s.mu.Lock()
+	pending, latency, lastScaled := s.pending, s.latency, s.lastScaled
+	s.mu.Unlock()
+	desired, reason := desiredWorkers(s.spec, s.a.pipelineInfo.MaxQueueSize, pending, busy, latency)
+
+	now := time.Now()
+	switch {
+	case desired > current && now.Sub(lastScaled) < durationOr(s.spec.ScaleUpCooldown, defaultScaleUpCooldown):
+		reason += "; cooling down before scaling up"
+	case desired < current && now.Sub(lastScaled) < durationOr(s.spec.ScaleDownCooldown, defaultScaleDownCooldown):
+		reason += "; cooling down before scaling down"
+	case desired != current:
+		workerRc.Spec.Replicas = int32(desired)
+		if _, err := rcs.Update(workerRc); err != nil {
+			return fmt.Errorf("error scaling workers from %d to %d: %v", current, desired, err)
+		}
+		logger.Logf("autoscaler: scaled workers from %d to %d: %s", current, desired, reason)
+		s.mu.Lock()
+		s.lastScaled = now
+		s.mu.Unlock()
+		lastScaled = now
+		current = desired
+	}
+
+	status := &pps.AutoscalingStatus{
+		CurrentWorkers: current,
+		DesiredWorkers: desired,
+		PendingDatums:  pending,
+		QueuedDatums:   queued,
+		DatumLatency:   types.DurationProto(latency),
+		Reason:         reason,
+	}
+	if !lastScaled.IsZero() {
+		status.LastScaled, _ = types.TimestampProto(lastScaled)
+	}
+	return s.putStatus(ctx, logger, status)
+}
+
+// desiredWorkers returns the number of workers that 'spec' calls for, given
+// the number of datums that are pending, the number of workers that are
+// busy, and the recent datum latency, along with the reason for it
+func desiredWorkers(spec *pps.AutoscalingSpec, maxQueueSize int64, pending int64, busy int64, latency time.Duration) (int64, string) {
+	target := spec.TargetQueueSize
+	if target <= 0 {
+		target = maxQueueSize
+	}
+	if target <= 0 {
+		target = 1
+	}
+	desired := (pending + target - 1) / target
+	reason := fmt.Sprintf("%d pending datums at %d per worker", pending, target)
+	if drainTime := durationOr(spec.TargetDrainTime, 0); drainTime > 0 && latency > 0 && pending > 0 {
+		work := time.Duration(pending) * latency
+		byLatency := int64((work + drainTime - 1) / drainTime)
+		if byLatency > desired {
+			desired = byLatency
+			reason = fmt.Sprintf("%d pending datums taking %v each, to be done in %v", pending, latency, drainTime)
+		}
+	}
+	if busy > desired {
+		desired = busy
+		reason = fmt.Sprintf("%d workers are still processing datums", busy)
+	}
+	if min := minWorkers(spec); desired < min {
+		desired = min
+		reason += fmt.Sprintf(" (raised to min_workers %d)", min)
+	}
+	if spec.MaxWorkers > 0 && desired > spec.MaxWorkers {
+		desired = spec.MaxWorkers
+		reason += fmt.Sprintf(" (capped at max_workers %d)", spec.MaxWorkers)
+	}
+	return desired, reason
+}
+
+// workerStatuses returns the status of each of the workers in 'rcName'
+func (s *autoscaler) workerStatuses(ctx context.Context, rcName string) ([]*pps.WorkerStatus, error) {
+	ctx, cancel := context.WithTimeout(ctx, statusTimeout)
+	defer cancel()
+	resp, err := s.a.etcdClient.Get(ctx, path.Join(s.a.etcdPrefix, "workers", rcName), etcd.WithPrefix())
+	if err != nil {
+		return nil, err
+	}
+	var result []*pps.WorkerStatus
+	for _, kv := range resp.Kvs {
+		status, err := func() (*pps.WorkerStatus, error) {
+			conn, err := grpc.DialContext(ctx, fmt.Sprintf("%s:%d", path.Base(string(kv.Key)), client.PPSWorkerPort),
+				client.PachDialOptions()...)
+			if err != nil {
+				return nil, err
+			}
+			defer conn.Close()
+			return NewWorkerClient(conn).Status(ctx, &types.Empty{})
+		}()
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, status)
+	}
+	return result, nil
+}
+
+// putStatus records 'status' in the pipeline, unless it's the same as the
+// last status recorded
+func (s *autoscaler) putStatus(ctx context.Context, logger *taggedLogger, status *pps.AutoscalingStatus) error {
+	s.mu.Lock()
+	unchanged := s.status != nil && proto.Equal(s.status, status)
+	s.mu.Unlock()
+	if unchanged {
+		return nil
+	}
+	if status.DesiredWorkers != status.CurrentWorkers {
+		logger.Logf("autoscaler: want %d workers, have %d: %s", status.DesiredWorkers, status.CurrentWorkers, status.Reason)
+	}
+	recorded := *status
+	recorded.Updated, _ = types.TimestampProto(time.Now())
+	if _, err := col.NewSTM(ctx, s.a.etcdClient, func(stm col.STM) error {
+		pipelines := s.a.pipelines.ReadWrite(stm)
+		pipelineInfo := new(pps.PipelineInfo)
+		if err := pipelines.Get(s.a.pipelineInfo.Pipeline.Name, pipelineInfo); err != nil {
+			return err
+		}
+		if pipelineInfo.Salt != s.a.pipelineInfo.Salt {
+			// The pipeline has been updated, and its new workers
+			// report their own status
+			return nil
+		}
+		pipelineInfo.AutoscalingStatus = &recorded
+		pipelines.Put(pipelineInfo.Pipeline.Name, pipelineInfo)
+		return nil
+	}); err != nil {
+		return fmt.Errorf("error recording status: %v", err)
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.status = status
+	return nil
+}
+
+// durationOr returns 'd' as a time.Duration, or 'def' if it's unset
+func durationOr(d *types.Duration, def time.Duration) time.Duration {
+	if d == nil {
+		return def
+	}
+	duration, err := types.DurationFromProto(d)
+	if err != nil {
+		return def
+	}
+	return duration
+}
@@ -102,7 +102,7 @@ func (a *APIServer) newBranchSetFactory(_ctx context.Context) (_ branchSetFactor
 			}
 			tstamp := &types.Timestamp{}
 			var buffer bytes.Buffer
-			if err := pachClient.GetFile(input.Cron.Repo, "master", "time", 0, 0, &buffer); err != nil && !isNotFoundErr(err) {
+			if err := pachClient.GetFile(input.Cron.Repo, pps.CronBranch, "time", 0, 0, &buffer); err != nil && !isNotFoundErr(err) {
 				return nil, err
 			} else if err != nil {
 				// File not found, this happens the first time the pipeline is run
@@ -122,7 +122,7 @@ func (a *APIServer) newBranchSetFactory(_ctx context.Context) (_ branchSetFactor
 						nextT := schedule.Next(t)
 						t = nextT
 						time.Sleep(time.Until(nextT))
-						commit, err := pachClient.StartCommit(input.Cron.Repo, "master")
+						commit, err := pachClient.StartCommit(input.Cron.Repo, pps.CronBranch)
 						if err != nil {
 							return err
 						}
@@ -134,16 +134,16 @@ func (a *APIServer) newBranchSetFactory(_ctx context.Context) (_ branchSetFactor
 						if err != nil {
 							return err
 						}
-						if err := pachClient.DeleteFile(input.Cron.Repo, "master", "time"); err != nil {
+						if err := pachClient.DeleteFile(input.Cron.Repo, pps.CronBranch, "time"); err != nil {
 							return err
 						}
-						if _, err := pachClient.PutFile(input.Cron.Repo, "master", "time", strings.NewReader(timeString)); err != nil {
+						if _, err := pachClient.PutFile(input.Cron.Repo, pps.CronBranch, "time", strings.NewReader(timeString)); err != nil {
 							return err
 						}
-						if err := pachClient.FinishCommit(input.Cron.Repo, "master"); err != nil {
+						if err := pachClient.FinishCommit(input.Cron.Repo, pps.CronBranch); err != nil {
 							return err
 						}
-						commitInfo, err := pachClient.InspectCommit(commit.Repo.Name, "master")
+						commitInfo, err := pachClient.InspectCommit(commit.Repo.Name, pps.CronBranch)
 						if err != nil {
 							return err
 						}
@@ -203,8 +203,11 @@ func (f *branchSetFactoryImpl) sendBranchSet(ctx context.Context, i int, commitI
 		}
 		for i, commitInfo := range set {
 			branch := "master"
-			if f.directInputs[i].Atom != nil {
+			switch {
+			case f.directInputs[i].Atom != nil:
 				branch = f.directInputs[i].Atom.Branch
+			case f.directInputs[i].Cron != nil:
+				branch = pps.CronBranch
 			}
 			bs.Branches = append(bs.Branches, &pfs.BranchInfo{
 				Name: branch,
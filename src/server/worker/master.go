@@ -3,13 +3,14 @@ package worker
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"os"
 	"path"
+	"sort"
 	"strings"
 	"sync"
-	"syscall"
 	"time"
 
 	"github.com/gogo/protobuf/jsonpb"
@@ -22,25 +23,25 @@ import (
 	"github.com/pachyderm/pachyderm/src/client"
 	"github.com/pachyderm/pachyderm/src/client/limit"
 	"github.com/pachyderm/pachyderm/src/client/pfs"
+	"github.com/pachyderm/pachyderm/src/client/pkg/tracing"
 	"github.com/pachyderm/pachyderm/src/client/pps"
 	"github.com/pachyderm/pachyderm/src/server/pkg/backoff"
 	col "github.com/pachyderm/pachyderm/src/server/pkg/collection"
 	"github.com/pachyderm/pachyderm/src/server/pkg/dlock"
+	"github.com/pachyderm/pachyderm/src/server/pkg/egress"
 	"github.com/pachyderm/pachyderm/src/server/pkg/hashtree"
-	"github.com/pachyderm/pachyderm/src/server/pkg/obj"
 	"github.com/pachyderm/pachyderm/src/server/pkg/pool"
 	"github.com/pachyderm/pachyderm/src/server/pkg/ppsdb"
-	filesync "github.com/pachyderm/pachyderm/src/server/pkg/sync"
-	pfs_sync "github.com/pachyderm/pachyderm/src/server/pkg/sync"
 	"github.com/pachyderm/pachyderm/src/server/pkg/util"
+	"github.com/pachyderm/pachyderm/src/server/pkg/watch"
 	ppsserver "github.com/pachyderm/pachyderm/src/server/pps"
 	"google.golang.org/grpc"
 )
 
 const (
-	// maximumRetriesPerDatum is the maximum number of times each datum
-	// can failed to be processed before we declare that the job has failed.
-	maximumRetriesPerDatum = 3
+	// defaultDatumTries is the number of times each datum is tried before
+	// we declare that it has failed, unless the pipeline sets datum_tries.
+	defaultDatumTries = 4
 
 	masterLockPath = "_master_worker_lock"
 
@@ -104,6 +105,7 @@ func (a *APIServer) master() {
 		}); err != nil {
 			return err
 		}
+		go a.autoscaler.run(ctx, logger)
 		return a.jobSpawner(ctx, logger)
 	}, b, func(err error, d time.Duration) error {
 		logger.Logf("master: error running the master process: %v; retrying in %v", err, d)
@@ -140,7 +142,8 @@ func (a *APIServer) serviceMaster() {
 		}); err != nil {
 			return err
 		}
-		return a.serviceSpawner(ctx)
+		go a.keepServing(ctx, logger)
+		return a.serviceSpawner(ctx, logger)
 	}, b, func(err error, d time.Duration) error {
 		logger.Logf("master: error running the master process: %v; retrying in %v", err, d)
 		return nil
@@ -197,6 +200,16 @@ func (a *APIServer) jobSpawner(ctx context.Context, logger *taggedLogger) error
 		return fmt.Errorf("error constructing branch set factory: %v", err)
 	}
 	defer bsf.Close()
+	// Jobs created by RerunPipeline aren't triggered by new input commits, so
+	// they're found by watching the pipeline's jobs
+	rerunWatcher, err := a.jobs.ReadOnly(ctx).WatchByIndex(ppsdb.JobsPipelineIndex, a.pipelineInfo.Pipeline)
+	if err != nil {
+		return fmt.Errorf("error watching jobs: %v", err)
+	}
+	defer rerunWatcher.Close()
+	// rerunJobs holds the rerun jobs that this master has run, so that the
+	// writes running them makes don't run them again
+	rerunJobs := make(map[string]bool)
 nextInput:
 	for {
 		// scaleDownCh is closed after we have not received a job for
@@ -220,6 +233,44 @@ nextInput:
 			if bs.Err != nil {
 				return fmt.Errorf("error from branch set factory: %v", bs.Err)
 			}
+		case event, ok := <-rerunWatcher.Watch():
+			if !ok {
+				return fmt.Errorf("job watch closed unexpectedly")
+			}
+			if event.Type == watch.EventError {
+				return fmt.Errorf("job watch error: %v", event.Err)
+			}
+			if event.Type != watch.EventPut {
+				continue nextInput
+			}
+			var jobID string
+			jobInfo := new(pps.JobInfo)
+			if err := event.Unmarshal(&jobID, jobInfo); err != nil {
+				return err
+			}
+			jobID = jobInfo.Job.ID
+			if !jobInfo.Rerun || jobInfo.Salt != a.pipelineInfo.Salt || rerunJobs[jobID] {
+				continue nextInput
+			}
+			// The event may be stale, so the job's current state decides
+			// whether it still needs to run
+			jobInfo, err := a.unfinishedJob(ctx, jobID)
+			if err != nil {
+				return err
+			}
+			if jobInfo == nil {
+				continue nextInput
+			}
+			rerunJobs[jobID] = true
+			if a.pipelineInfo.ScaleDownThreshold != nil {
+				if err := a.scaleUpWorkers(logger); err != nil {
+					logger.Logf("error scaling up workers: %v", err)
+				}
+			}
+			if err := a.runJob(ctx, jobInfo, pool, logger); err != nil {
+				return err
+			}
+			continue nextInput
 		case <-scaleDownCh:
 			if err := a.scaleDownWorkers(); err != nil {
 				logger.Logf("error scaling down workers: %v", err)
@@ -271,14 +322,26 @@ nextInput:
 					break
 				}
 			}
-			if jobInfo.Pipeline.Name == a.pipelineInfo.Pipeline.Name &&
+			// Rerun jobs are run as they're created, and don't mean that the
+			// input has been processed
+			if jobInfo.Pipeline.Name == a.pipelineInfo.Pipeline.Name && !jobInfo.Rerun &&
 				(jobInfo.Salt == a.pipelineInfo.Salt || (jobInfo.Salt == "" && jobInfo.PipelineVersion == a.pipelineInfo.Version)) {
 				switch jobInfo.State {
+				case pps.JobState_JOB_QUEUED:
+					admittedJobInfo, err := a.waitForAdmission(ctx, &jobInfo, logger)
+					if err != nil {
+						return err
+					}
+					if admittedJobInfo != nil {
+						if err := a.runJob(ctx, admittedJobInfo, pool, logger); err != nil {
+							return err
+						}
+					}
 				case pps.JobState_JOB_STARTING, pps.JobState_JOB_RUNNING:
 					if err := a.runJob(ctx, &jobInfo, pool, logger); err != nil {
 						return err
 					}
-				case pps.JobState_JOB_SUCCESS:
+				case pps.JobState_JOB_SUCCESS, pps.JobState_JOB_PARTIAL:
 					continue nextInput
 				}
 			}
@@ -318,7 +381,7 @@ nextInput:
 				if !ok {
 					break
 				}
-				if jobInfo.Pipeline.Name == a.pipelineInfo.Pipeline.Name &&
+				if jobInfo.Pipeline.Name == a.pipelineInfo.Pipeline.Name && !jobInfo.Rerun &&
 					(jobInfo.Salt == a.pipelineInfo.Salt || (jobInfo.Salt == "" && jobInfo.PipelineVersion == a.pipelineInfo.Version)) {
 					parentJob = jobInfo.Job
 				}
@@ -346,6 +409,15 @@ nextInput:
 		if err != nil {
 			return err
 		}
+		if jobInfo.State == pps.JobState_JOB_QUEUED {
+			jobInfo, err = a.waitForAdmission(ctx, jobInfo, logger)
+			if err != nil {
+				return err
+			}
+			if jobInfo == nil {
+				continue nextInput
+			}
+		}
 
 		if err := a.runJob(ctx, jobInfo, pool, logger); err != nil {
 			return err
@@ -353,15 +425,15 @@ nextInput:
 	}
 }
 
-func (a *APIServer) serviceSpawner(ctx context.Context) error {
+// serviceSpawner creates a job for each new set of input commits of a
+// service, and rolls its data out to the service's pods
+func (a *APIServer) serviceSpawner(ctx context.Context, logger *taggedLogger) error {
 	bsf, err := a.newBranchSetFactory(ctx)
 	if err != nil {
 		return fmt.Errorf("error constructing branch set factory: %v", err)
 	}
 	defer bsf.Close()
 
-	var serviceCtx context.Context
-	var serviceCancel func()
 nextInput:
 	for {
 		var bs *branchSet
@@ -380,33 +452,35 @@ nextInput:
 		}
 		jobsRO := a.jobs.ReadOnly(ctx)
 		// Check if the job has already been created.
-		jobCreated := false
+		var jobID string
 		jobIter, err := jobsRO.GetByIndex(ppsdb.JobsInputIndex, jobInput)
 		if err != nil {
 			return err
 		}
 		for {
-			var jobID string
 			var jobInfo pps.JobInfo
 			ok, err := jobIter.Next(&jobID, &jobInfo)
 			if err != nil {
 				return err
 			}
 			if !ok {
+				jobID = ""
 				break
 			}
 			if jobInfo.Pipeline.Name == a.pipelineInfo.Pipeline.Name &&
 				(jobInfo.Salt == a.pipelineInfo.Salt || (jobInfo.Salt == "" && jobInfo.PipelineVersion == a.pipelineInfo.Version)) {
 				switch jobInfo.State {
 				case pps.JobState_JOB_STARTING, pps.JobState_JOB_RUNNING:
-					jobCreated = true
-				case pps.JobState_JOB_SUCCESS:
+					jobID = jobInfo.Job.ID
+				case pps.JobState_JOB_SUCCESS, pps.JobState_JOB_PARTIAL:
 					continue nextInput
+				default:
+					continue
 				}
+				break
 			}
 		}
-		var jobID string
-		if !jobCreated {
+		if jobID == "" {
 			newBranch := bs.Branches[bs.NewBranch]
 			job, err := a.pachClient.PpsAPIClient.CreateJob(ctx, &pps.CreateJobRequest{
 				Pipeline:        a.pipelineInfo.Pipeline,
@@ -423,69 +497,50 @@ nextInput:
 			}
 			jobID = job.ID
 		}
-		df, err := NewDatumFactory(ctx, a.pachClient.PfsAPIClient, jobInput)
-		if err != nil {
-			return err
-		}
-		if df.Len() != 1 {
-			return fmt.Errorf("services must have a single datum")
-		}
-		data := df.Datum(0)
-		logger, err := a.getTaggedLogger(ctx, jobID, data, false)
-		puller := filesync.NewPuller()
-		dir, err := a.downloadData(logger, data, puller, nil, &pps.ProcessStats{}, nil, "")
-		if err != nil {
+		if err := a.rollOut(ctx, jobID, pps.InputCommits(jobInput), logger); err != nil {
 			return err
 		}
-		if err := os.MkdirAll(client.PPSInputPrefix, 0666); err != nil {
-			return err
+	}
+}
+
+// waitForAdmission waits for the queued job 'jobInfo' to be admitted by the
+// admission policy, and returns it as it is once it's been admitted. It
+// returns nil if the job leaves the queue without being admitted (i.e. if
+// it's stopped or deleted).
+func (a *APIServer) waitForAdmission(ctx context.Context, jobInfo *pps.JobInfo, logger *taggedLogger) (*pps.JobInfo, error) {
+	logger.Logf("job %s is queued: %s", jobInfo.Job.ID, jobInfo.Reason)
+	watcher, err := a.jobs.ReadOnly(ctx).WatchOne(jobInfo.Job.ID)
+	if err != nil {
+		return nil, err
+	}
+	defer watcher.Close()
+	for {
+		var event *watch.Event
+		select {
+		case <-ctx.Done():
+			return nil, context.Canceled
+		case event = <-watcher.Watch():
 		}
-		if err := syscall.Unmount(client.PPSInputPrefix, syscall.MNT_DETACH); err != nil {
-			logger.Logf("error unmounting %+v", err)
+		switch event.Type {
+		case watch.EventError:
+			return nil, event.Err
+		case watch.EventDelete:
+			return nil, nil
 		}
-		if err := syscall.Mount(dir, client.PPSInputPrefix, "", syscall.MS_BIND, ""); err != nil {
-			return err
+		var jobID string
+		jobInfo := new(pps.JobInfo)
+		if err := event.Unmarshal(&jobID, jobInfo); err != nil {
+			return nil, err
 		}
-		if serviceCancel != nil {
-			serviceCancel()
+		switch jobInfo.State {
+		case pps.JobState_JOB_QUEUED:
+			continue
+		case pps.JobState_JOB_STARTING, pps.JobState_JOB_RUNNING:
+			logger.Logf("job %s has been admitted", jobInfo.Job.ID)
+			return jobInfo, nil
+		default:
+			return nil, nil
 		}
-		serviceCtx, serviceCancel = context.WithCancel(ctx)
-		defer serviceCancel()
-		go func() {
-			serviceCtx := serviceCtx
-			if _, err := col.NewSTM(ctx, a.etcdClient, func(stm col.STM) error {
-				jobs := a.jobs.ReadWrite(stm)
-				jobInfo := new(pps.JobInfo)
-				if err := jobs.Get(jobID, jobInfo); err != nil {
-					return err
-				}
-				jobInfo.State = pps.JobState_JOB_RUNNING
-				jobs.Put(jobInfo.Job.ID, jobInfo)
-				return nil
-			}); err != nil {
-				logger.Logf("error updating job state: %+v", err)
-			}
-			err := a.runService(serviceCtx, logger)
-			if err != nil {
-				logger.Logf("error from runService: %+v", err)
-			}
-			select {
-			case <-serviceCtx.Done():
-				if _, err := col.NewSTM(ctx, a.etcdClient, func(stm col.STM) error {
-					jobs := a.jobs.ReadWrite(stm)
-					jobInfo := new(pps.JobInfo)
-					if err := jobs.Get(jobID, jobInfo); err != nil {
-						return err
-					}
-					jobInfo.State = pps.JobState_JOB_SUCCESS
-					jobs.Put(jobInfo.Job.ID, jobInfo)
-					return nil
-				}); err != nil {
-					logger.Logf("error updating job progress: %+v", err)
-				}
-			default:
-			}
-		}()
 	}
 }
 
@@ -514,6 +569,8 @@ func (a *APIServer) runJob(ctx context.Context, jobInfo *pps.JobInfo, pool *pool
 	ppsClient := a.pachClient.PpsAPIClient
 
 	jobID := jobInfo.Job.ID
+	span, ctx := tracing.StartSpan(ctx, "worker.Job", "pipeline", a.pipelineInfo.Pipeline.Name, "job", jobID)
+	defer span.Finish()
 	var jobStopped bool
 	var jobStoppedMutex sync.Mutex
 	backoff.RetryNotify(func() (retErr error) {
@@ -535,6 +592,29 @@ func (a *APIServer) runJob(ctx context.Context, jobInfo *pps.JobInfo, pool *pool
 			}
 		}
 
+		// If the pipeline has a job timeout, bound the rest of this job by
+		// it; the clock starts when the job was created, not on each retry.
+		var jobTimeout time.Duration
+		// jobCtx outlives the timeout so that we can still record the failure
+		jobCtx := ctx
+		if a.pipelineInfo.JobTimeout != nil {
+			var err error
+			if jobTimeout, err = types.DurationFromProto(a.pipelineInfo.JobTimeout); err != nil {
+				return err
+			}
+			started, err := types.TimestampFromProto(jobInfo.Started)
+			if err != nil {
+				return err
+			}
+			deadline := started.Add(jobTimeout)
+			if !time.Now().Before(deadline) {
+				return a.failJobTimeout(ctx, jobID, jobTimeout)
+			}
+			var cancelTimeout context.CancelFunc
+			ctx, cancelTimeout = context.WithDeadline(ctx, deadline)
+			defer cancelTimeout()
+		}
+
 		// Cancel the context and move on to the next job if this job
 		// has been manually stopped.
 		go func() {
@@ -547,7 +627,7 @@ func (a *APIServer) runJob(ctx context.Context, jobInfo *pps.JobInfo, pool *pool
 				return
 			}
 			switch currentJobInfo.State {
-			case pps.JobState_JOB_KILLED, pps.JobState_JOB_SUCCESS, pps.JobState_JOB_FAILURE:
+			case pps.JobState_JOB_KILLED, pps.JobState_JOB_SUCCESS, pps.JobState_JOB_FAILURE, pps.JobState_JOB_PARTIAL:
 				jobStoppedMutex.Lock()
 				defer jobStoppedMutex.Unlock()
 				jobStopped = true
@@ -575,12 +655,30 @@ func (a *APIServer) runJob(ctx context.Context, jobInfo *pps.JobInfo, pool *pool
 			}
 			return a.updateJobState(stm, jobInfo, pps.JobState_JOB_RUNNING, "")
 		})
+		if err == errJobFinished {
+			// The job finished (e.g. was stopped) before it got going
+			return nil
+		}
 		if err != nil {
 			return err
 		}
 
 		failed := false
 		var failedDatumID string
+		// failedDatums collects the datums that exhausted their tries when
+		// the pipeline skips failed datums rather than failing the job.
+		var failedDatums []*pps.FailedDatum
+		var failedDatumsMu sync.Mutex
+		datumTries := defaultDatumTries
+		if a.pipelineInfo.DatumTries > 0 {
+			datumTries = int(a.pipelineInfo.DatumTries)
+		}
+		var datumRetryBackoff time.Duration
+		if a.pipelineInfo.DatumRetryBackoff != nil {
+			if datumRetryBackoff, err = types.DurationFromProto(a.pipelineInfo.DatumRetryBackoff); err != nil {
+				return err
+			}
+		}
 		limiter := limit.New(a.numWorkers * int(a.pipelineInfo.MaxQueueSize))
 		// process all datums
 		df, err := NewDatumFactory(ctx, pfsClient, jobInfo.Input)
@@ -605,6 +703,9 @@ func (a *APIServer) runJob(ctx context.Context, jobInfo *pps.JobInfo, pool *pool
 			statsTree = hashtree.NewHashTree()
 		}
 		var processStats []*pps.ProcessStats
+		// lineage records which output files each datum wrote, so that
+		// TraceFile can find the inputs that produced a file
+		lineage := &pps.JobLineage{}
 		var treeMu sync.Mutex
 
 		processedData := int64(0)
@@ -661,6 +762,8 @@ func (a *APIServer) runJob(ctx context.Context, jobInfo *pps.JobInfo, pool *pool
 		}
 		// set the initial values
 		updateProgress(0, 0, nil)
+		a.autoscaler.startJob(totalData)
+		defer a.autoscaler.finishJob()
 
 		for i := 0; i < df.Len(); i++ {
 			i := i
@@ -699,8 +802,15 @@ func (a *APIServer) runJob(ctx context.Context, jobInfo *pps.JobInfo, pool *pool
 			go func() {
 				userCodeFailures := 0
 				defer limiter.Release()
+				defer a.autoscaler.datumFinished()
 				b := backoff.NewInfiniteBackOff()
 				b.Multiplier = 1
+				if datumRetryBackoff > 0 {
+					b.InitialInterval = datumRetryBackoff
+					b.Multiplier = 2
+				}
+				var exitCode int32
+				var reason string
 				var stats *pps.ProcessStats
 				// If usedCache is set to true, we know that we thought a
 				// datum has been processed, but it's not found in the
@@ -713,11 +823,13 @@ func (a *APIServer) runJob(ctx context.Context, jobInfo *pps.JobInfo, pool *pool
 					Data:         files,
 					ParentOutput: parentOutputTag,
 					EnableStats:  jobInfo.EnableStats,
+					SkipCache:    jobInfo.Rerun,
 				}
 				datumID := a.DatumID(files)
 				if err := backoff.RetryNotify(func() error {
 					var failed bool
-					processed := a.getCachedDatum(datumHash)
+					// Rerun jobs reprocess every datum
+					processed := !jobInfo.Rerun && a.getCachedDatum(datumHash)
 					if usedCache || !processed {
 						if err := pool.Do(ctx, func(conn *grpc.ClientConn) error {
 							workerClient := NewWorkerClient(conn)
@@ -728,6 +840,8 @@ func (a *APIServer) runJob(ctx context.Context, jobInfo *pps.JobInfo, pool *pool
 							skipped = resp.Skipped
 							failed = resp.Failed
 							stats = resp.Stats
+							exitCode = resp.ExitCode
+							reason = resp.Reason
 							return nil
 						}); err != nil {
 							return fmt.Errorf("Process() call failed: %v", err)
@@ -738,10 +852,9 @@ func (a *APIServer) runJob(ctx context.Context, jobInfo *pps.JobInfo, pool *pool
 					}
 					if failed {
 						userCodeFailures++
-						failedDatumID = datumID
 						// If this is our last failure we merge in the stats
 						// tree for the failed run.
-						if userCodeFailures > maximumRetriesPerDatum && jobInfo.EnableStats {
+						if userCodeFailures >= datumTries && jobInfo.EnableStats {
 							if err := func() error {
 								statsSubtree, err := a.getTreeFromTag(ctx, statsTag)
 								if err != nil {
@@ -818,6 +931,11 @@ func (a *APIServer) runJob(ctx context.Context, jobInfo *pps.JobInfo, pool *pool
 					if stats != nil {
 						processStats = append(processStats, stats)
 					}
+					datumLineage, err := newDatumLineage(datumID, files, subTree)
+					if err != nil {
+						return err
+					}
+					lineage.Datums = append(lineage.Datums, datumLineage)
 					return tree.Merge(subTree)
 				}, b, func(err error, d time.Duration) error {
 					select {
@@ -825,9 +943,23 @@ func (a *APIServer) runJob(ctx context.Context, jobInfo *pps.JobInfo, pool *pool
 						return err
 					default:
 					}
-					if userCodeFailures > maximumRetriesPerDatum {
+					if userCodeFailures >= datumTries {
+						if a.pipelineInfo.SkipFailedDatums {
+							logger.Logf("job %s failed to process datum %+v %d times, skipping it", jobID, files, userCodeFailures)
+							failedDatumsMu.Lock()
+							defer failedDatumsMu.Unlock()
+							failedDatums = append(failedDatums, &pps.FailedDatum{
+								DatumID:  datumID,
+								ExitCode: exitCode,
+								Reason:   reason,
+							})
+							return err
+						}
 						logger.Logf("job %s failed to process datum %+v %d times failing", jobID, files, userCodeFailures)
+						failedDatumsMu.Lock()
+						defer failedDatumsMu.Unlock()
 						failed = true
+						failedDatumID = datumID
 						return err
 					}
 					logger.Logf("job %s failed to process datum %+v with: %+v, retrying in: %+v", jobID, files, err, d)
@@ -836,12 +968,16 @@ func (a *APIServer) runJob(ctx context.Context, jobInfo *pps.JobInfo, pool *pool
 					if skipped {
 						go updateProgress(0, 1, stats)
 					} else {
+						a.autoscaler.observe(stats)
 						go updateProgress(1, 0, stats)
 					}
 				}
 			}()
 		}
 		limiter.Wait()
+		if jobTimeout > 0 && ctx.Err() == context.DeadlineExceeded {
+			return a.failJobTimeout(jobCtx, jobID, jobTimeout)
+		}
 
 		var statsCommit *pfs.Commit
 		if jobInfo.EnableStats {
@@ -898,17 +1034,33 @@ func (a *APIServer) runJob(ctx context.Context, jobInfo *pps.JobInfo, pool *pool
 		if err != nil {
 			return err
 		}
+		lineageObject, err := a.putLineage(ctx, lineage)
+		if err != nil {
+			return err
+		}
 
 		var provenance []*pfs.Commit
 		for _, commit := range pps.InputCommits(jobInfo.Input) {
 			provenance = append(provenance, commit)
 		}
 
+		outputBranch := jobInfo.OutputBranch
+		if jobInfo.Rerun {
+			// Reruns of old inputs don't move the output branch, as it holds
+			// the output of newer inputs
+			latest, err := a.inputsAreHeads(ctx, jobInfo.Input)
+			if err != nil {
+				return err
+			}
+			if !latest {
+				outputBranch = ""
+			}
+		}
 		outputCommit, err := pfsClient.BuildCommit(ctx, &pfs.BuildCommitRequest{
 			Parent: &pfs.Commit{
 				Repo: jobInfo.OutputRepo,
 			},
-			Branch:     jobInfo.OutputBranch,
+			Branch:     outputBranch,
 			Provenance: provenance,
 			Tree:       object,
 		})
@@ -918,54 +1070,35 @@ func (a *APIServer) runJob(ctx context.Context, jobInfo *pps.JobInfo, pool *pool
 
 		// Put egress into its own retry loop, because 1) we don't want to
 		// endlessly retry egress, and 2) we don't want to create the output
-		// commit over and over again.
-		var egressFailureCount int
-		egressErr := backoff.RetryNotify(func() (retErr error) {
-			if jobInfo.Egress != nil {
+		// commit over and over again. Reruns that don't move the output
+		// branch aren't egressed, as their output is older than what's
+		// already been pushed.
+		var egressInfo *pps.EgressInfo
+		if jobInfo.Egress != nil && outputBranch != "" {
+			retries := egress.DefaultRetries
+			if jobInfo.Egress.Retries > 0 {
+				retries = int(jobInfo.Egress.Retries)
+			}
+			var egressFailureCount int
+			backoff.RetryNotify(func() error {
 				logger.Logf("Starting egress upload for job (%v)", jobInfo)
-				start := time.Now()
-				url, err := obj.ParseURL(jobInfo.Egress.URL)
-				if err != nil {
-					return err
-				}
-				objClient, err := obj.NewClientFromURLAndSecret(ctx, url)
-				if err != nil {
-					return err
+				egressInfo = egress.Egress(ctx, a.pachClient, a.jobs.ReadOnly(ctx), jobInfo.Pipeline, outputCommit, jobInfo.Egress)
+				if egressInfo.State == pps.EgressState_EGRESS_FAILURE {
+					return errors.New(egressInfo.Reason)
 				}
-				client := client.APIClient{
-					PfsAPIClient: pfsClient,
-				}
-				client.SetMaxConcurrentStreams(100)
-				if err := pfs_sync.PushObj(client, outputCommit, objClient, url.Object); err != nil {
-					return err
-				}
-				logger.Logf("Completed egress upload for job (%v), duration (%v)", jobInfo, time.Since(start))
-			}
-			return nil
-		}, backoff.NewInfiniteBackOff(), func(err error, d time.Duration) error {
-			egressFailureCount++
-			if egressFailureCount > 3 {
-				return err
-			}
-			logger.Logf("egress failed: %v; retrying in %v", err, d)
-			return nil
-		})
-		if egressErr != nil {
-			_, err = col.NewSTM(ctx, a.etcdClient, func(stm col.STM) error {
-				jobs := a.jobs.ReadWrite(stm)
-				jobInfo := new(pps.JobInfo)
-				if err := jobs.Get(jobID, jobInfo); err != nil {
+				logger.Logf("Completed egress upload for job (%v): pushed %d files, deleted %d files", jobInfo, egressInfo.FilesPushed, egressInfo.FilesDeleted)
+				return nil
+			}, backoff.NewInfiniteBackOff(), func(err error, d time.Duration) error {
+				egressFailureCount++
+				if egressFailureCount > retries {
 					return err
 				}
-				jobInfo.Finished = now()
-				jobInfo.StatsCommit = statsCommit
-				return a.updateJobState(stm, jobInfo, pps.JobState_JOB_FAILURE, fmt.Sprintf("egress error: %v", egressErr))
+				logger.Logf("egress failed: %v; retrying in %v", err, d)
+				return nil
 			})
-			// returning nil so we don't retry
-			return nil
 		}
 		// Record the job's output commit and 'Finished' timestamp, and mark the job
-		// as a SUCCESS
+		// as a SUCCESS (or a FAILURE, if its egress failed)
 		_, err = col.NewSTM(ctx, a.etcdClient, func(stm col.STM) error {
 			jobs := a.jobs.ReadWrite(stm)
 			jobInfo := new(pps.JobInfo)
@@ -981,6 +1114,24 @@ func (a *APIServer) runJob(ctx context.Context, jobInfo *pps.JobInfo, pool *pool
 			// likely already set but just in case it failed
 			jobInfo.DataTotal = totalData
 			jobInfo.StatsCommit = statsCommit
+			jobInfo.Lineage = lineageObject
+			jobInfo.EgressInfo = egressInfo
+			if statsCommit != nil {
+				for _, failedDatum := range failedDatums {
+					failedDatum.Logs = &pfs.File{
+						Commit: statsCommit,
+						Path:   fmt.Sprintf("/%v/logs", failedDatum.DatumID),
+					}
+				}
+			}
+			jobInfo.FailedDatums = failedDatums
+			jobInfo.DataFailed = int64(len(failedDatums))
+			if egressInfo != nil && egressInfo.State == pps.EgressState_EGRESS_FAILURE {
+				return a.updateJobState(stm, jobInfo, pps.JobState_JOB_FAILURE, fmt.Sprintf("egress error: %v", egressInfo.Reason))
+			}
+			if len(failedDatums) > 0 {
+				return a.updateJobState(stm, jobInfo, pps.JobState_JOB_PARTIAL, fmt.Sprintf("%d datums failed", len(failedDatums)))
+			}
 			return a.updateJobState(stm, jobInfo, pps.JobState_JOB_SUCCESS, "")
 		})
 		return err
@@ -1021,6 +1172,69 @@ func (a *APIServer) runJob(ctx context.Context, jobInfo *pps.JobInfo, pool *pool
 	return nil
 }
 
+// unfinishedJob reads the job 'jobID' and returns it, or nil if it has
+// already reached a terminal state.
+func (a *APIServer) unfinishedJob(ctx context.Context, jobID string) (*pps.JobInfo, error) {
+	var result *pps.JobInfo
+	_, err := col.NewSTM(ctx, a.etcdClient, func(stm col.STM) error {
+		result = nil
+		jobInfo := new(pps.JobInfo)
+		if err := a.jobs.ReadWrite(stm).Get(jobID, jobInfo); err != nil {
+			return err
+		}
+		if !jobStateTerminal(jobInfo.State) {
+			result = jobInfo
+		}
+		return nil
+	})
+	return result, err
+}
+
+// failJobTimeout marks a job as failed because it ran past its pipeline's
+// job timeout. It returns nil when the state was recorded so that the
+// caller's retry loop exits rather than restarting the job.
+func (a *APIServer) failJobTimeout(ctx context.Context, jobID string, timeout time.Duration) error {
+	_, err := col.NewSTM(ctx, a.etcdClient, func(stm col.STM) error {
+		jobs := a.jobs.ReadWrite(stm)
+		jobInfo := new(pps.JobInfo)
+		if err := jobs.Get(jobID, jobInfo); err != nil {
+			return err
+		}
+		jobInfo.Finished = now()
+		return a.updateJobState(stm, jobInfo, pps.JobState_JOB_FAILURE, fmt.Sprintf("job exceeded its timeout of %v", timeout))
+	})
+	return err
+}
+
+// inputsAreHeads returns true if every input commit of 'input' is the head of
+// its branch.
+func (a *APIServer) inputsAreHeads(ctx context.Context, input *pps.Input) (bool, error) {
+	var inputBranches []*pfs.Commit
+	var inputCommits []string
+	pps.VisitInput(input, func(input *pps.Input) {
+		if input.Atom != nil {
+			inputBranches = append(inputBranches, client.NewCommit(input.Atom.Repo, input.Atom.Branch))
+			inputCommits = append(inputCommits, input.Atom.Commit)
+		}
+		if input.Cron != nil {
+			inputBranches = append(inputBranches, client.NewCommit(input.Cron.Repo, pps.CronBranch))
+			inputCommits = append(inputCommits, input.Cron.Commit)
+		}
+	})
+	for i, branch := range inputBranches {
+		commitInfo, err := a.pachClient.PfsAPIClient.InspectCommit(ctx, &pfs.InspectCommitRequest{
+			Commit: branch,
+		})
+		if err != nil {
+			return false, err
+		}
+		if commitInfo.Commit.ID != inputCommits[i] {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
 func (a *APIServer) runService(ctx context.Context, logger *taggedLogger) error {
 	return backoff.RetryNotify(func() error {
 		return a.runUserCode(ctx, logger, nil, &pps.ProcessStats{})
@@ -1139,6 +1353,39 @@ func (a *APIServer) putTree(ctx context.Context, tree hashtree.OpenHashTree) (*p
 	return object, err
 }
 
+// putLineage stores 'lineage' in object storage, sorted so that identical
+// jobs produce identical objects
+func (a *APIServer) putLineage(ctx context.Context, lineage *pps.JobLineage) (*pfs.Object, error) {
+	sort.Slice(lineage.Datums, func(i, j int) bool {
+		return lineage.Datums[i].DatumID < lineage.Datums[j].DatumID
+	})
+	data, err := lineage.Marshal()
+	if err != nil {
+		return nil, err
+	}
+	object, _, err := a.pachClient.WithCtx(ctx).PutObject(bytes.NewReader(data))
+	return object, err
+}
+
+// newDatumLineage records that the datum made up of 'files' wrote the files
+// in 'subTree'
+func newDatumLineage(datumID string, files []*Input, subTree hashtree.HashTree) (*pps.DatumLineage, error) {
+	lineage := &pps.DatumLineage{DatumID: datumID}
+	for _, file := range files {
+		lineage.Inputs = append(lineage.Inputs, file.FileInfo.File)
+	}
+	if err := subTree.Walk("/", func(path string, node *hashtree.NodeProto) error {
+		if node.FileNode != nil {
+			lineage.Outputs = append(lineage.Outputs, path)
+		}
+		return nil
+	}); err != nil && hashtree.Code(err) != hashtree.PathNotFound {
+		return nil, err
+	}
+	sort.Strings(lineage.Outputs)
+	return lineage, nil
+}
+
 func (a *APIServer) scaleDownWorkers() error {
 	rc := a.kubeClient.ReplicationControllers(a.namespace)
 	workerRc, err := rc.Get(ppsserver.PipelineRcName(a.pipelineInfo.Pipeline.Name, a.pipelineInfo.Version))
@@ -1150,7 +1397,7 @@ func (a *APIServer) scaleDownWorkers() error {
 	// requirements so that the remaining master pod does not take up
 	// the resource it doesn't need, since by definition when a pipeline
 	// is in scale-down mode, it doesn't process any work.
-	if a.pipelineInfo.ResourceSpec != nil {
+	if a.pipelineInfo.ResourceSpec != nil || a.pipelineInfo.ResourceLimits != nil {
 		workerRc.Spec.Template.Spec.Containers[0].Resources = api.ResourceRequirements{}
 	}
 	_, err = rc.Update(workerRc)
@@ -1174,14 +1421,23 @@ func (a *APIServer) scaleUpWorkers(logger *taggedLogger) error {
 	// Reset the resource requirements for the RC since the pipeline
 	// is in scale-down mode and probably has removed its resource
 	// requirements.
-	if a.pipelineInfo.ResourceSpec != nil {
-		resourceList, err := util.GetResourceListFromPipeline(a.pipelineInfo)
+	if a.pipelineInfo.ResourceSpec != nil || a.pipelineInfo.ResourceLimits != nil {
+		var resources api.ResourceRequirements
+		if a.pipelineInfo.ResourceSpec != nil {
+			resourceList, err := util.GetResourceListFromPipeline(a.pipelineInfo)
+			if err != nil {
+				return fmt.Errorf("error parsing resource spec; this is likely a bug: %v", err)
+			}
+			resources.Requests = *resourceList
+		}
+		limitsList, err := util.GetLimitsResourceListFromPipeline(a.pipelineInfo)
 		if err != nil {
-			return fmt.Errorf("error parsing resource spec; this is likely a bug: %v", err)
+			return fmt.Errorf("error parsing resource limits; this is likely a bug: %v", err)
 		}
-		workerRc.Spec.Template.Spec.Containers[0].Resources = api.ResourceRequirements{
-			Requests: *resourceList,
+		if limitsList != nil {
+			resources.Limits = *limitsList
 		}
+		workerRc.Spec.Template.Spec.Containers[0].Resources = resources
 	}
 	_, err = rc.Update(workerRc)
 	return err
@@ -0,0 +1,391 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"syscall"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+	"k8s.io/kubernetes/pkg/api"
+	kube_labels "k8s.io/kubernetes/pkg/labels"
+
+	"github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/client/pfs"
+	"github.com/pachyderm/pachyderm/src/client/pps"
+	"github.com/pachyderm/pachyderm/src/server/pkg/backoff"
+	col "github.com/pachyderm/pachyderm/src/server/pkg/collection"
+	filesync "github.com/pachyderm/pachyderm/src/server/pkg/sync"
+	"github.com/pachyderm/pachyderm/src/server/pkg/watch"
+	ppsserver "github.com/pachyderm/pachyderm/src/server/pps"
+)
+
+const (
+	// rolloutInterval is how often the service master checks on the pods
+	// that a rollout is waiting for
+	rolloutInterval = time.Second
+	// servingInterval is how often the service master checks for ready pods
+	// of the served job that don't receive traffic
+	servingInterval = 5 * time.Second
+)
+
+// A service's pods each serve the data of one job for as long as they run:
+// a pod serves the job that's being rolled out when it starts, or the job
+// that's being served if there's no rollout. To roll out a new job, the
+// service master takes the old pods out of the pipeline's RC, which starts
+// new pods in their place. Once the new pods pass their readiness probe,
+// traffic moves to them and the old pods are deleted. Until then, the old
+// pods keep serving. Pods that the RC starts outside of a rollout (e.g. to
+// replace a pod that crashed or was evicted) serve the served job, and the
+// service master moves traffic to them once they're ready.
+
+// serveService runs the user code of a service on the data of the job that
+// this pod serves, until the pod is deleted.
+func (a *APIServer) serveService() {
+	logger := a.getMasterLogger()
+	backoff.RetryNotify(func() error {
+		ctx, cancel := context.WithCancel(a.pachClient.AddMetadata(context.Background()))
+		defer cancel()
+
+		jobInfo, err := a.serviceJob(ctx)
+		if err != nil {
+			return err
+		}
+		df, err := NewDatumFactory(ctx, a.pachClient.PfsAPIClient, jobInfo.Input)
+		if err != nil {
+			return err
+		}
+		if df.Len() != 1 {
+			return fmt.Errorf("services must have a single datum")
+		}
+		data := df.Datum(0)
+		logger, err := a.getTaggedLogger(ctx, jobInfo.Job.ID, data, false)
+		if err != nil {
+			return err
+		}
+		puller := filesync.NewPuller()
+		dir, err := a.downloadData(logger, data, puller, nil, &pps.ProcessStats{}, nil, "")
+		if err != nil {
+			return err
+		}
+		if err := os.MkdirAll(client.PPSInputPrefix, 0666); err != nil {
+			return err
+		}
+		if err := syscall.Unmount(client.PPSInputPrefix, syscall.MNT_DETACH); err != nil {
+			logger.Logf("error unmounting %+v", err)
+		}
+		if err := syscall.Mount(dir, client.PPSInputPrefix, "", syscall.MS_BIND, ""); err != nil {
+			return err
+		}
+		// Tell the service master which data this pod serves
+		if err := a.labelPod(os.Getenv(client.PPSPodNameEnv), func(pod *api.Pod) {
+			pod.Labels[ppsserver.ServiceJobLabel] = jobInfo.Job.ID
+		}); err != nil {
+			return fmt.Errorf("error labelling pod: %v", err)
+		}
+		logger.Logf("serving the data of job %s", jobInfo.Job.ID)
+		return a.runService(ctx, logger)
+	}, backoff.NewInfiniteBackOff(), func(err error, d time.Duration) error {
+		logger.Logf("error serving service: %v; retrying in %v", err, d)
+		return nil
+	})
+}
+
+// serviceJob waits for there to be a job for this pod to serve, and returns
+// it: the job being rolled out, if there is one, or else the job being
+// served.
+func (a *APIServer) serviceJob(ctx context.Context) (*pps.JobInfo, error) {
+	watcher, err := a.pipelines.ReadOnly(ctx).WatchOne(a.pipelineInfo.Pipeline.Name)
+	if err != nil {
+		return nil, err
+	}
+	defer watcher.Close()
+	for {
+		var event *watch.Event
+		select {
+		case <-ctx.Done():
+			return nil, context.Canceled
+		case event = <-watcher.Watch():
+		}
+		switch event.Type {
+		case watch.EventError:
+			return nil, event.Err
+		case watch.EventDelete:
+			return nil, fmt.Errorf("pipeline %s was deleted", a.pipelineInfo.Pipeline.Name)
+		}
+		var pipelineName string
+		pipelineInfo := new(pps.PipelineInfo)
+		if err := event.Unmarshal(&pipelineName, pipelineInfo); err != nil {
+			return nil, err
+		}
+		status := pipelineInfo.ServiceStatus
+		if status == nil || pipelineInfo.Version != a.pipelineInfo.Version {
+			continue
+		}
+		jobID := status.PendingJob
+		if jobID == "" {
+			jobID = status.Job
+		}
+		if jobID == "" {
+			continue
+		}
+		jobInfo := new(pps.JobInfo)
+		if err := a.jobs.ReadOnly(ctx).Get(jobID, jobInfo); err != nil {
+			return nil, err
+		}
+		return jobInfo, nil
+	}
+}
+
+// rollOut rolls the data of the job 'jobID', whose input commits are
+// 'commits', out to the service's pods, and returns once they're serving it
+func (a *APIServer) rollOut(ctx context.Context, jobID string, commits []*pfs.Commit, logger *taggedLogger) error {
+	var previousJob string
+	if err := a.updateServiceStatus(ctx, func(status *pps.ServiceStatus) {
+		previousJob = status.Job
+		if status.Job != jobID {
+			status.PendingJob = jobID
+			status.PendingCommits = commits
+		}
+	}); err != nil {
+		return err
+	}
+	if previousJob == jobID {
+		// The job was rolled out before this master started, so its pods
+		// are labelled by keepServing
+		return nil
+	}
+	logger.Logf("rolling out job %s", jobID)
+
+	rcName := ppsserver.PipelineRcName(a.pipelineInfo.Pipeline.Name, a.pipelineInfo.Version)
+	ticker := time.NewTicker(rolloutInterval)
+	defer ticker.Stop()
+	for {
+		podList, err := a.kubeClient.Pods(a.namespace).List(api.ListOptions{
+			LabelSelector: kube_labels.SelectorFromSet(map[string]string{"app": rcName}),
+		})
+		if err != nil {
+			return err
+		}
+		var ready, old []*api.Pod
+		starting := false
+		for i := range podList.Items {
+			pod := &podList.Items[i]
+			switch pod.Labels[ppsserver.ServiceJobLabel] {
+			case jobID:
+				if podReady(pod) {
+					ready = append(ready, pod)
+				} else {
+					starting = true
+				}
+			case "":
+				// The pod hasn't started serving yet
+				starting = true
+			default:
+				old = append(old, pod)
+			}
+		}
+		// Take the old pods out of the RC, so that it starts new pods, which
+		// serve the new job, in their place
+		for _, pod := range old {
+			if pod.Labels["component"] == ppsserver.RetiredComponent {
+				continue
+			}
+			if err := a.labelPod(pod.Name, func(pod *api.Pod) {
+				pod.Labels["component"] = ppsserver.RetiredComponent
+			}); err != nil {
+				return err
+			}
+		}
+		if len(ready) > 0 && !starting {
+			return a.finishRollOut(ctx, jobID, commits, previousJob, ready, old, logger)
+		}
+		select {
+		case <-ctx.Done():
+			return context.Canceled
+		case <-ticker.C:
+		}
+	}
+}
+
+// finishRollOut moves the traffic of the service from the 'old' pods to the
+// 'ready' pods, which serve 'jobID', and deletes the old pods
+func (a *APIServer) finishRollOut(ctx context.Context, jobID string, commits []*pfs.Commit, previousJob string, ready []*api.Pod, old []*api.Pod, logger *taggedLogger) error {
+	for _, pod := range ready {
+		if err := a.labelPod(pod.Name, func(pod *api.Pod) {
+			pod.Labels[ppsserver.ServingLabel] = "true"
+		}); err != nil {
+			return err
+		}
+	}
+	for _, pod := range old {
+		if err := a.labelPod(pod.Name, func(pod *api.Pod) {
+			delete(pod.Labels, ppsserver.ServingLabel)
+		}); err != nil && !isNotFoundErr(err) {
+			return err
+		}
+	}
+	if err := a.updateServiceStatus(ctx, func(status *pps.ServiceStatus) {
+		status.Job = jobID
+		status.Commits = commits
+		status.PendingJob = ""
+		status.PendingCommits = nil
+	}); err != nil {
+		return err
+	}
+	if _, err := col.NewSTM(ctx, a.etcdClient, func(stm col.STM) error {
+		jobs := a.jobs.ReadWrite(stm)
+		for id, state := range map[string]pps.JobState{
+			jobID:       pps.JobState_JOB_RUNNING,
+			previousJob: pps.JobState_JOB_SUCCESS,
+		} {
+			if id == "" {
+				continue
+			}
+			jobInfo := new(pps.JobInfo)
+			if err := jobs.Get(id, jobInfo); err != nil {
+				if col.IsErrNotFound(err) {
+					continue
+				}
+				return err
+			}
+			if jobInfo.State == state || jobStateTerminal(jobInfo.State) {
+				continue
+			}
+			if err := a.updateJobState(stm, jobInfo, state, ""); err != nil {
+				return err
+			}
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+	logger.Logf("rolled out job %s", jobID)
+	// This pod may be one of the old ones, so it's deleted last
+	self := os.Getenv(client.PPSPodNameEnv)
+	for _, pod := range old {
+		if pod.Name == self {
+			continue
+		}
+		if err := a.kubeClient.Pods(a.namespace).Delete(pod.Name, nil); err != nil && !isNotFoundErr(err) {
+			return err
+		}
+	}
+	for _, pod := range old {
+		if pod.Name == self {
+			return a.kubeClient.Pods(a.namespace).Delete(pod.Name, nil)
+		}
+	}
+	return nil
+}
+
+// keepServing labels the ready pods that serve the service's current job as
+// serving, so that pods started outside of a rollout receive traffic. It
+// runs until 'ctx' is cancelled.
+func (a *APIServer) keepServing(ctx context.Context, logger *taggedLogger) {
+	ticker := time.NewTicker(servingInterval)
+	defer ticker.Stop()
+	for {
+		if err := a.labelServingPods(ctx); err != nil && ctx.Err() == nil {
+			logger.Logf("error labelling serving pods: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// labelServingPods labels the ready pods that serve the service's current
+// job as serving. Retired pods are skipped, as a rollout is moving traffic
+// away from them.
+func (a *APIServer) labelServingPods(ctx context.Context) error {
+	pipelineInfo := new(pps.PipelineInfo)
+	if err := a.pipelines.ReadOnly(ctx).Get(a.pipelineInfo.Pipeline.Name, pipelineInfo); err != nil {
+		return err
+	}
+	status := pipelineInfo.ServiceStatus
+	if pipelineInfo.Version != a.pipelineInfo.Version || status == nil || status.Job == "" {
+		return nil
+	}
+	rcName := ppsserver.PipelineRcName(a.pipelineInfo.Pipeline.Name, a.pipelineInfo.Version)
+	podList, err := a.kubeClient.Pods(a.namespace).List(api.ListOptions{
+		LabelSelector: kube_labels.SelectorFromSet(map[string]string{
+			"app":                     rcName,
+			ppsserver.ServiceJobLabel: status.Job,
+		}),
+	})
+	if err != nil {
+		return err
+	}
+	for i := range podList.Items {
+		pod := &podList.Items[i]
+		if pod.Labels["component"] == ppsserver.RetiredComponent ||
+			pod.Labels[ppsserver.ServingLabel] == "true" || !podReady(pod) {
+			continue
+		}
+		if err := a.labelPod(pod.Name, func(pod *api.Pod) {
+			pod.Labels[ppsserver.ServingLabel] = "true"
+		}); err != nil && !isNotFoundErr(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// updateServiceStatus applies 'f' to the ServiceStatus of the pipeline,
+// unless the pipeline has been updated since this worker started
+func (a *APIServer) updateServiceStatus(ctx context.Context, f func(status *pps.ServiceStatus)) error {
+	_, err := col.NewSTM(ctx, a.etcdClient, func(stm col.STM) error {
+		pipelines := a.pipelines.ReadWrite(stm)
+		pipelineInfo := new(pps.PipelineInfo)
+		if err := pipelines.Get(a.pipelineInfo.Pipeline.Name, pipelineInfo); err != nil {
+			return err
+		}
+		if pipelineInfo.Version != a.pipelineInfo.Version {
+			return fmt.Errorf("pipeline has been updated to version %d", pipelineInfo.Version)
+		}
+		if pipelineInfo.ServiceStatus == nil {
+			pipelineInfo.ServiceStatus = &pps.ServiceStatus{}
+		}
+		f(pipelineInfo.ServiceStatus)
+		updated, err := types.TimestampProto(time.Now())
+		if err != nil {
+			return err
+		}
+		pipelineInfo.ServiceStatus.Updated = updated
+		return pipelines.Put(a.pipelineInfo.Pipeline.Name, pipelineInfo)
+	})
+	return err
+}
+
+// labelPod applies 'f' to the labels of the pod 'name'
+func (a *APIServer) labelPod(name string, f func(pod *api.Pod)) error {
+	pods := a.kubeClient.Pods(a.namespace)
+	pod, err := pods.Get(name)
+	if err != nil {
+		return err
+	}
+	if pod.Labels == nil {
+		pod.Labels = make(map[string]string)
+	}
+	f(pod)
+	_, err = pods.Update(pod)
+	return err
+}
+
+// podReady returns true if 'pod' has passed its readiness probe, and isn't
+// being deleted
+func podReady(pod *api.Pod) bool {
+	if pod.DeletionTimestamp != nil {
+		return false
+	}
+	for _, condition := range pod.Status.Conditions {
+		if condition.Type == api.PodReady {
+			return condition.Status == api.ConditionTrue
+		}
+	}
+	return false
+}
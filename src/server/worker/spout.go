@@ -0,0 +1,414 @@
+package worker
+
+import (
+	"archive/tar"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/client/pfs"
+	"github.com/pachyderm/pachyderm/src/client/pkg/uuid"
+	"github.com/pachyderm/pachyderm/src/client/pps"
+	"github.com/pachyderm/pachyderm/src/server/pkg/backoff"
+	col "github.com/pachyderm/pachyderm/src/server/pkg/collection"
+	"github.com/pachyderm/pachyderm/src/server/pkg/dlock"
+)
+
+const (
+	// spoutMarkerBranch is the branch of a spout's output repo that its
+	// marker is committed to
+	spoutMarkerBranch = "marker"
+	// spoutPollInterval is how often a spout checks whether its open commit
+	// has reached its commit_interval
+	spoutPollInterval = time.Second
+)
+
+// spoutMaster runs the user code of a spout pipeline, and commits what it
+// writes to /pfs/out
+func (a *APIServer) spoutMaster() {
+	masterLock := dlock.NewDLock(a.etcdClient, path.Join(a.etcdPrefix, masterLockPath, a.pipelineInfo.Pipeline.Name, a.pipelineInfo.Salt))
+	logger := a.getMasterLogger()
+	b := backoff.NewInfiniteBackOff()
+	backoff.RetryNotify(func() error {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel() // make sure that everything this loop might spawn gets cleaned up
+		ctx, err := masterLock.Lock(a.pachClient.AddMetadata(ctx))
+		if err != nil {
+			return err
+		}
+		defer masterLock.Unlock(ctx)
+
+		logger.Logf("Launching spout master process")
+
+		// Set pipeline state to running
+		if _, err := col.NewSTM(ctx, a.etcdClient, func(stm col.STM) error {
+			pipelineName := a.pipelineInfo.Pipeline.Name
+			pipelines := a.pipelines.ReadWrite(stm)
+			pipelineInfo := new(pps.PipelineInfo)
+			if err := pipelines.Get(pipelineName, pipelineInfo); err != nil {
+				return err
+			}
+			pipelineInfo.State = pps.PipelineState_PIPELINE_RUNNING
+			pipelines.Put(pipelineName, pipelineInfo)
+			return nil
+		}); err != nil {
+			return err
+		}
+		return a.spoutSpawner(ctx, logger)
+	}, b, func(err error, d time.Duration) error {
+		logger.Logf("master: error running the spout master process: %v; retrying in %v", err, d)
+		return nil
+	})
+}
+
+// spoutSpawner sets up /pfs for the spout's user code, with its marker and
+// the named pipe /pfs/out, and runs the user code until 'ctx' is cancelled
+func (a *APIServer) spoutSpawner(ctx context.Context, logger *taggedLogger) (retErr error) {
+	pachClient := a.pachClient.WithCtx(ctx)
+	s := &spout{
+		spec:       a.pipelineInfo.Spout,
+		pachClient: pachClient,
+		repo:       a.pipelineInfo.Pipeline.Name,
+		branch:     a.pipelineInfo.OutputBranch,
+		logger:     logger,
+	}
+	// Writes that were in flight when the spout last stopped are
+	// incomplete, and so is their marker, so they're dropped
+	if err := s.dropOpenCommit(s.branch); err != nil {
+		return err
+	}
+	if s.spec.Marker != "" {
+		if err := s.dropOpenCommit(spoutMarkerBranch); err != nil {
+			return err
+		}
+	}
+
+	dir := filepath.Join(client.PPSScratchSpace, uuid.NewWithoutDashes())
+	if err := os.MkdirAll(dir, 0777); err != nil {
+		return err
+	}
+	defer func() {
+		if err := os.RemoveAll(dir); err != nil && retErr == nil {
+			retErr = err
+		}
+	}()
+	if s.spec.Marker != "" {
+		if err := s.downloadMarker(dir); err != nil {
+			return fmt.Errorf("error downloading marker: %v", err)
+		}
+	}
+	out := filepath.Join(dir, "out")
+	if err := syscall.Mkfifo(out, 0666); err != nil {
+		return fmt.Errorf("error creating %s: %v", out, err)
+	}
+	// The user code may not run as root, and Mkfifo is subject to umask
+	if err := os.Chmod(out, 0666); err != nil {
+		return err
+	}
+	if err := os.MkdirAll(client.PPSInputPrefix, 0666); err != nil {
+		return err
+	}
+	if err := syscall.Unmount(client.PPSInputPrefix, syscall.MNT_DETACH); err != nil {
+		logger.Logf("error unmounting %+v", err)
+	}
+	if err := syscall.Mount(dir, client.PPSInputPrefix, "", syscall.MS_BIND, ""); err != nil {
+		return err
+	}
+
+	eg, ctx := errgroup.WithContext(ctx)
+	eg.Go(func() error {
+		return a.runService(ctx, logger)
+	})
+	eg.Go(func() error {
+		return s.read(ctx, out)
+	})
+	eg.Go(func() error {
+		return s.poll(ctx)
+	})
+	return eg.Wait()
+}
+
+// spout commits the tar streams that a spout's user code writes
+type spout struct {
+	spec       *pps.Spout
+	pachClient *client.APIClient
+	repo       string
+	branch     string
+	logger     *taggedLogger
+
+	// mu guards the fields below. It's held for the whole of each write, so
+	// that a write is never split between commits.
+	mu sync.Mutex
+	// commit is the open commit on the output branch, if there is one
+	commit *pfs.Commit
+	// markerCommit is the open commit on the marker branch, if the writes
+	// in 'commit' have written the marker
+	markerCommit *pfs.Commit
+	// size is the number of bytes written to 'commit'
+	size int64
+	// firstWrite is when the first write to 'commit' finished
+	firstWrite time.Time
+}
+
+// dropOpenCommit deletes the head of 'branch', if it's unfinished
+func (s *spout) dropOpenCommit(branch string) error {
+	commitInfo, err := s.pachClient.InspectCommit(s.repo, branch)
+	if err != nil {
+		if isNotFoundErr(err) {
+			return nil
+		}
+		return err
+	}
+	if commitInfo.Finished != nil {
+		return nil
+	}
+	s.logger.Logf("deleting unfinished commit %s on branch %s", commitInfo.Commit.ID, branch)
+	return s.pachClient.DeleteCommit(s.repo, commitInfo.Commit.ID)
+}
+
+// downloadMarker copies the marker from the head of the marker branch into
+// 'dir', where the user code can read it
+func (s *spout) downloadMarker(dir string) error {
+	err := s.pachClient.Walk(s.repo, spoutMarkerBranch, s.spec.Marker, func(fileInfo *pfs.FileInfo) error {
+		target := filepath.Join(dir, fileInfo.File.Path)
+		if fileInfo.FileType == pfs.FileType_DIR {
+			return os.MkdirAll(target, 0777)
+		}
+		if err := os.MkdirAll(filepath.Dir(target), 0777); err != nil {
+			return err
+		}
+		f, err := os.OpenFile(target, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0666)
+		if err != nil {
+			return err
+		}
+		if err := s.pachClient.GetFile(s.repo, spoutMarkerBranch, fileInfo.File.Path, 0, 0, f); err != nil {
+			f.Close()
+			return err
+		}
+		return f.Close()
+	})
+	if isNotFoundErr(err) {
+		// The spout hasn't written a marker yet
+		return nil
+	}
+	return err
+}
+
+// read reads the tar streams written to the named pipe 'out' until 'ctx' is
+// cancelled
+func (s *spout) read(ctx context.Context, out string) error {
+	go func() {
+		// Opening the pipe for reading blocks until a writer opens it, so
+		// open it for writing to wake the reader up when we're done. This
+		// doesn't block if the reader isn't waiting.
+		<-ctx.Done()
+		if f, err := os.OpenFile(out, os.O_WRONLY|syscall.O_NONBLOCK, 0); err == nil {
+			f.Close()
+		}
+	}()
+	for {
+		f, err := os.OpenFile(out, os.O_RDONLY, 0)
+		if err != nil {
+			return err
+		}
+		err = s.write(ctx, f)
+		f.Close()
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// write commits one write: the tar stream read from 'r', which ends when
+// the user code closes the pipe. An empty write is ignored.
+func (s *spout) write(ctx context.Context, r io.Reader) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	tr := tar.NewReader(r)
+	var wrote bool
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("error reading tar stream from /pfs/out: %v", err)
+		}
+		if hdr.Typeflag != tar.TypeReg && hdr.Typeflag != tar.TypeRegA {
+			continue
+		}
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if err := s.putFile(path.Clean("/"+hdr.Name), tr); err != nil {
+			return err
+		}
+		s.size += hdr.Size
+		wrote = true
+	}
+	// Drain the pipe, so the writer doesn't block on padding
+	if _, err := io.Copy(ioutil.Discard, r); err != nil {
+		return err
+	}
+	if !wrote {
+		return nil
+	}
+	if s.firstWrite.IsZero() {
+		s.firstWrite = time.Now()
+	}
+	if s.full() {
+		return s.finish()
+	}
+	return nil
+}
+
+// putFile writes the file at 'p' in a write to the open commit (starting it
+// if need be), or to the marker branch if it's part of the marker
+func (s *spout) putFile(p string, r io.Reader) error {
+	marker := s.spec.Marker != "" && (p == "/"+s.spec.Marker || strings.HasPrefix(p, "/"+s.spec.Marker+"/"))
+	commit, err := s.startCommit()
+	if err != nil {
+		return err
+	}
+	repoCommit := commit.ID
+	if marker {
+		markerCommit, err := s.startMarkerCommit()
+		if err != nil {
+			return err
+		}
+		repoCommit = markerCommit.ID
+	}
+	if marker || s.spec.Overwrite {
+		_, err = s.pachClient.PutFileOverwrite(s.repo, repoCommit, p, r, 0)
+	} else {
+		_, err = s.pachClient.PutFile(s.repo, repoCommit, p, r)
+	}
+	return err
+}
+
+// startCommit returns the open commit on the output branch, starting it if
+// there isn't one. If the spout overwrites its output, the new commit starts
+// empty.
+func (s *spout) startCommit() (*pfs.Commit, error) {
+	if s.commit != nil {
+		return s.commit, nil
+	}
+	var previous []*pfs.FileInfo
+	if s.spec.Overwrite {
+		fileInfos, err := s.pachClient.ListFile(s.repo, s.branch, "/")
+		if err != nil && !isNotFoundErr(err) {
+			return nil, err
+		}
+		previous = fileInfos
+	}
+	commit, err := s.pachClient.StartCommit(s.repo, s.branch)
+	if err != nil {
+		return nil, err
+	}
+	for _, fileInfo := range previous {
+		if err := s.pachClient.DeleteFile(s.repo, commit.ID, fileInfo.File.Path); err != nil {
+			return nil, err
+		}
+	}
+	s.commit = commit
+	return commit, nil
+}
+
+// startMarkerCommit returns the open commit on the marker branch, starting
+// it if there isn't one. The marker replaces the previous one.
+func (s *spout) startMarkerCommit() (*pfs.Commit, error) {
+	if s.markerCommit != nil {
+		return s.markerCommit, nil
+	}
+	commit, err := s.pachClient.StartCommit(s.repo, spoutMarkerBranch)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.pachClient.DeleteFile(s.repo, commit.ID, "/"+s.spec.Marker); err != nil && !isNotFoundErr(err) {
+		return nil, err
+	}
+	s.markerCommit = commit
+	return commit, nil
+}
+
+// full returns true if the open commit should be finished, given the
+// spout's batching
+func (s *spout) full() bool {
+	if s.commit == nil {
+		return false
+	}
+	batched := false
+	if s.spec.CommitBytes > 0 {
+		batched = true
+		if s.size >= s.spec.CommitBytes {
+			return true
+		}
+	}
+	if s.spec.CommitInterval != nil {
+		batched = true
+		if interval, err := types.DurationFromProto(s.spec.CommitInterval); err == nil && time.Since(s.firstWrite) >= interval {
+			return true
+		}
+	}
+	return !batched
+}
+
+// finish finishes the open commit, and the marker commit after it, so that
+// the marker is never ahead of the output
+func (s *spout) finish() error {
+	if err := s.pachClient.FinishCommit(s.repo, s.commit.ID); err != nil {
+		return err
+	}
+	s.logger.Logf("finished commit %s (%d bytes)", s.commit.ID, s.size)
+	if s.markerCommit != nil {
+		if err := s.pachClient.FinishCommit(s.repo, s.markerCommit.ID); err != nil {
+			return err
+		}
+	}
+	s.commit, s.markerCommit = nil, nil
+	s.size = 0
+	s.firstWrite = time.Time{}
+	return nil
+}
+
+// poll finishes the open commit once it reaches the spout's commit_interval,
+// even if the user code doesn't write again
+func (s *spout) poll(ctx context.Context) error {
+	if s.spec.CommitInterval == nil {
+		return nil
+	}
+	ticker := time.NewTicker(spoutPollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+		if err := func() error {
+			s.mu.Lock()
+			defer s.mu.Unlock()
+			if !s.firstWrite.IsZero() && s.full() {
+				return s.finish()
+			}
+			return nil
+		}(); err != nil {
+			return err
+		}
+	}
+}
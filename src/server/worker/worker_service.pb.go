@@ -22,7 +22,7 @@ import math "math"
 import pfs "github.com/pachyderm/pachyderm/src/client/pfs"
 import pps "github.com/pachyderm/pachyderm/src/client/pps"
 import _ "github.com/gogo/protobuf/gogoproto"
-import google_protobuf "github.com/gogo/protobuf/types"
+import google_protobuf1 "github.com/gogo/protobuf/types"
 
 import (
 	context "golang.org/x/net/context"
@@ -100,6 +100,8 @@ type ProcessRequest struct {
 	// incremental jobs, may be nil.
 	ParentOutput *pfs.Tag `protobuf:"bytes,3,opt,name=parent_output,json=parentOutput" json:"parent_output,omitempty"`
 	EnableStats  bool     `protobuf:"varint,4,opt,name=enable_stats,json=enableStats,proto3" json:"enable_stats,omitempty"`
+	// If true, the datum is processed even if its output is already stored
+	SkipCache bool `protobuf:"varint,5,opt,name=skip_cache,json=skipCache,proto3" json:"skip_cache,omitempty"`
 }
 
 func (m *ProcessRequest) Reset()                    { *m = ProcessRequest{} }
@@ -135,12 +137,23 @@ func (m *ProcessRequest) GetEnableStats() bool {
 	return false
 }
 
+func (m *ProcessRequest) GetSkipCache() bool {
+	if m != nil {
+		return m.SkipCache
+	}
+	return false
+}
+
 // ProcessResponse contains a tag, only if the processing was successful.
 type ProcessResponse struct {
 	Stats *pps.ProcessStats `protobuf:"bytes,4,opt,name=stats" json:"stats,omitempty"`
 	// If true, the user program has errored
 	Failed  bool `protobuf:"varint,2,opt,name=failed,proto3" json:"failed,omitempty"`
 	Skipped bool `protobuf:"varint,5,opt,name=skipped,proto3" json:"skipped,omitempty"`
+	// If the user program has errored, its exit code (-1 if it didn't exit) and
+	// why it failed
+	ExitCode int32  `protobuf:"varint,6,opt,name=exit_code,json=exitCode,proto3" json:"exit_code,omitempty"`
+	Reason   string `protobuf:"bytes,7,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
 func (m *ProcessResponse) Reset()                    { *m = ProcessResponse{} }
@@ -169,6 +182,20 @@ func (m *ProcessResponse) GetSkipped() bool {
 	return false
 }
 
+func (m *ProcessResponse) GetExitCode() int32 {
+	if m != nil {
+		return m.ExitCode
+	}
+	return 0
+}
+
+func (m *ProcessResponse) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
 type CancelRequest struct {
 	JobID       string   `protobuf:"bytes,2,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
 	DataFilters []string `protobuf:"bytes,1,rep,name=data_filters,json=dataFilters" json:"data_filters,omitempty"`
@@ -229,7 +256,7 @@ const _ = grpc.SupportPackageIsVersion4
 
 type WorkerClient interface {
 	Process(ctx context.Context, in *ProcessRequest, opts ...grpc.CallOption) (*ProcessResponse, error)
-	Status(ctx context.Context, in *google_protobuf.Empty, opts ...grpc.CallOption) (*pps.WorkerStatus, error)
+	Status(ctx context.Context, in *google_protobuf1.Empty, opts ...grpc.CallOption) (*pps.WorkerStatus, error)
 	Cancel(ctx context.Context, in *CancelRequest, opts ...grpc.CallOption) (*CancelResponse, error)
 }
 
@@ -250,7 +277,7 @@ func (c *workerClient) Process(ctx context.Context, in *ProcessRequest, opts ...
 	return out, nil
 }
 
-func (c *workerClient) Status(ctx context.Context, in *google_protobuf.Empty, opts ...grpc.CallOption) (*pps.WorkerStatus, error) {
+func (c *workerClient) Status(ctx context.Context, in *google_protobuf1.Empty, opts ...grpc.CallOption) (*pps.WorkerStatus, error) {
 	out := new(pps.WorkerStatus)
 	err := grpc.Invoke(ctx, "/worker.Worker/Status", in, out, c.cc, opts...)
 	if err != nil {
@@ -272,7 +299,7 @@ func (c *workerClient) Cancel(ctx context.Context, in *CancelRequest, opts ...gr
 
 type WorkerServer interface {
 	Process(context.Context, *ProcessRequest) (*ProcessResponse, error)
-	Status(context.Context, *google_protobuf.Empty) (*pps.WorkerStatus, error)
+	Status(context.Context, *google_protobuf1.Empty) (*pps.WorkerStatus, error)
 	Cancel(context.Context, *CancelRequest) (*CancelResponse, error)
 }
 
@@ -299,7 +326,7 @@ func _Worker_Process_Handler(srv interface{}, ctx context.Context, dec func(inte
 }
 
 func _Worker_Status_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(google_protobuf.Empty)
+	in := new(google_protobuf1.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -311,7 +338,7 @@ func _Worker_Status_Handler(srv interface{}, ctx context.Context, dec func(inter
 		FullMethod: "/worker.Worker/Status",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WorkerServer).Status(ctx, req.(*google_protobuf.Empty))
+		return srv.(WorkerServer).Status(ctx, req.(*google_protobuf1.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -468,6 +495,16 @@ func (m *ProcessRequest) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i++
 	}
+	if m.SkipCache {
+		dAtA[i] = 0x28
+		i++
+		if m.SkipCache {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
 	return i, nil
 }
 
@@ -516,6 +553,17 @@ func (m *ProcessResponse) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i++
 	}
+	if m.ExitCode != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintWorkerService(dAtA, i, uint64(m.ExitCode))
+	}
+	if len(m.Reason) > 0 {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintWorkerService(dAtA, i, uint64(len(m.Reason)))
+		i += copy(dAtA[i:], m.Reason)
+	}
 	return i, nil
 }
 
@@ -658,6 +706,9 @@ func (m *ProcessRequest) Size() (n int) {
 	if m.EnableStats {
 		n += 2
 	}
+	if m.SkipCache {
+		n += 2
+	}
 	return n
 }
 
@@ -674,6 +725,13 @@ func (m *ProcessResponse) Size() (n int) {
 	if m.Skipped {
 		n += 2
 	}
+	if m.ExitCode != 0 {
+		n += 1 + sovWorkerService(uint64(m.ExitCode))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovWorkerService(uint64(l))
+	}
 	return n
 }
 
@@ -1051,6 +1109,26 @@ func (m *ProcessRequest) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.EnableStats = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SkipCache", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkerService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SkipCache = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipWorkerService(dAtA[iNdEx:])
@@ -1174,6 +1252,54 @@ func (m *ProcessResponse) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Skipped = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExitCode", wireType)
+			}
+			m.ExitCode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkerService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExitCode |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkerService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkerService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipWorkerService(dAtA[iNdEx:])
@@ -1481,39 +1607,42 @@ var (
 func init() { proto.RegisterFile("server/worker/worker_service.proto", fileDescriptorWorkerService) }
 
 var fileDescriptorWorkerService = []byte{
-	// 538 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x52, 0xc1, 0x8e, 0xd3, 0x30,
-	0x10, 0xad, 0xd9, 0x36, 0x9b, 0x3a, 0xed, 0x02, 0x16, 0x94, 0xa8, 0x48, 0xa5, 0x9b, 0x0b, 0xd5,
-	0x4a, 0xa4, 0xa8, 0x88, 0x03, 0x12, 0xa7, 0x5d, 0x58, 0xa9, 0x5c, 0x40, 0x66, 0x25, 0x8e, 0x91,
-	0x93, 0x3a, 0x21, 0xbb, 0x69, 0x6c, 0x62, 0x07, 0xb4, 0x7c, 0x09, 0x3f, 0x80, 0xf8, 0x05, 0x3e,
-	0x81, 0x23, 0x5f, 0x80, 0x50, 0xf9, 0x11, 0xe4, 0x71, 0xd2, 0x6a, 0xe1, 0xb0, 0x87, 0x28, 0x33,
-	0x6f, 0xec, 0x79, 0x6f, 0x9e, 0x07, 0x07, 0x8a, 0x57, 0x1f, 0x79, 0x35, 0xff, 0x24, 0xaa, 0x8b,
-	0xed, 0x2f, 0x32, 0x60, 0x9e, 0xf0, 0x50, 0x56, 0x42, 0x0b, 0xe2, 0x58, 0x74, 0x7c, 0x27, 0x29,
-	0x72, 0x5e, 0xea, 0xb9, 0x4c, 0x95, 0xf9, 0x6c, 0x75, 0x87, 0x4a, 0x65, 0xbe, 0x16, 0xcd, 0x44,
-	0x26, 0x20, 0x9c, 0x9b, 0xa8, 0x41, 0xef, 0x67, 0x42, 0x64, 0x05, 0x9f, 0x43, 0x16, 0xd7, 0xe9,
-	0x9c, 0xaf, 0xa5, 0xbe, 0xb4, 0xc5, 0xe0, 0x2b, 0xc2, 0xbd, 0x65, 0x29, 0x6b, 0x4d, 0x8e, 0x70,
-	0x3f, 0xcd, 0x0b, 0x1e, 0xe5, 0x65, 0x2a, 0x7c, 0x34, 0x45, 0x33, 0x6f, 0x31, 0x0c, 0x0d, 0xe3,
-	0x69, 0x5e, 0xf0, 0x65, 0x99, 0x0a, 0xea, 0xa6, 0x4d, 0x44, 0x08, 0xee, 0x96, 0x6c, 0xcd, 0xfd,
-	0x1b, 0x53, 0x34, 0xeb, 0x53, 0x88, 0x0d, 0x56, 0xb0, 0xcf, 0x97, 0xfe, 0xde, 0x14, 0xcd, 0x5c,
-	0x0a, 0x31, 0x19, 0x61, 0x27, 0xae, 0x58, 0x99, 0xbc, 0xf7, 0xbb, 0x70, 0xb2, 0xc9, 0xc8, 0x63,
-	0x3c, 0x94, 0xac, 0xe2, 0xa5, 0x8e, 0x12, 0xb1, 0x5e, 0xe7, 0xda, 0xef, 0x01, 0x9f, 0x07, 0x7c,
-	0x27, 0x00, 0xd1, 0x81, 0x3d, 0x61, 0xb3, 0xe0, 0x1b, 0xc2, 0x07, 0x6f, 0x2a, 0x91, 0x70, 0xa5,
-	0x28, 0xff, 0x50, 0x73, 0xa5, 0xc9, 0x21, 0xee, 0xae, 0x98, 0x66, 0x3e, 0x9a, 0xee, 0x81, 0x56,
-	0x6b, 0x58, 0x08, 0xd3, 0x50, 0x28, 0x91, 0x29, 0x76, 0xce, 0x45, 0x1c, 0xe5, 0x2b, 0xab, 0xf4,
-	0xb8, 0xbf, 0xf9, 0xf5, 0xa0, 0xf7, 0x4a, 0xc4, 0xcb, 0x17, 0xb4, 0x77, 0x2e, 0xe2, 0xe5, 0x8a,
-	0x3c, 0xda, 0x2a, 0x11, 0xb5, 0x96, 0xb5, 0x06, 0xf9, 0xde, 0xc2, 0x05, 0x25, 0x67, 0x2c, 0x6b,
-	0x65, 0xbc, 0x86, 0x2a, 0x39, 0xc4, 0x03, 0x5e, 0xb2, 0xb8, 0xe0, 0x91, 0xd2, 0x4c, 0x2b, 0x18,
-	0xcb, 0xa5, 0x9e, 0xc5, 0xde, 0x1a, 0x28, 0x28, 0xf0, 0xcd, 0xad, 0x50, 0x25, 0x45, 0xa9, 0xb8,
-	0xb1, 0x21, 0x65, 0x79, 0xc1, 0xad, 0x0c, 0x97, 0x36, 0x19, 0x79, 0x88, 0x7b, 0xbb, 0x36, 0xde,
-	0xe2, 0x76, 0x68, 0x9e, 0xb2, 0xb9, 0x0c, 0xcd, 0xa8, 0xad, 0x13, 0x1f, 0xef, 0xab, 0x8b, 0x5c,
-	0x4a, 0xbe, 0x02, 0xa7, 0x5c, 0xda, 0xa6, 0xc1, 0x19, 0x1e, 0x9e, 0xb0, 0x32, 0xe1, 0xc5, 0xce,
-	0x95, 0x81, 0x19, 0x3d, 0x4a, 0xf3, 0x42, 0xf3, 0x4a, 0x81, 0x3b, 0x7d, 0xea, 0x19, 0xec, 0xd4,
-	0x42, 0xd7, 0xbb, 0x12, 0x1c, 0xe1, 0x83, 0xb6, 0x6b, 0x33, 0x82, 0x51, 0x50, 0x27, 0x46, 0x18,
-	0xec, 0x86, 0x51, 0x60, 0xd3, 0xc5, 0x77, 0x84, 0x9d, 0x77, 0x60, 0x3d, 0x79, 0x8e, 0xf7, 0x1b,
-	0xf5, 0x64, 0xd4, 0x3e, 0xc7, 0xd5, 0x47, 0x1b, 0xdf, 0xfb, 0x0f, 0xb7, 0x04, 0x41, 0x87, 0x3c,
-	0xc5, 0x8e, 0x19, 0xba, 0x36, 0x97, 0xed, 0xca, 0x86, 0xed, 0xca, 0x86, 0x2f, 0xcd, 0xca, 0x8e,
-	0xad, 0x41, 0x96, 0xcc, 0x1e, 0x0d, 0x3a, 0xe4, 0x19, 0x76, 0xac, 0x56, 0x72, 0xb7, 0xed, 0x7d,
-	0xc5, 0x91, 0xf1, 0xe8, 0x5f, 0xb8, 0x65, 0x3c, 0xbe, 0xf5, 0x63, 0x33, 0x41, 0x3f, 0x37, 0x13,
-	0xf4, 0x7b, 0x33, 0x41, 0x5f, 0xfe, 0x4c, 0x3a, 0xb1, 0x03, 0x8c, 0x4f, 0xfe, 0x06, 0x00, 0x00,
-	0xff, 0xff, 0x30, 0x21, 0xdf, 0xec, 0xa2, 0x03, 0x00, 0x00,
+	// 581 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x53, 0xc1, 0x6e, 0xd4, 0x30,
+	0x10, 0xad, 0x69, 0x93, 0x26, 0x4e, 0x5b, 0xc0, 0x82, 0x12, 0x6d, 0xc5, 0x92, 0xe6, 0xc2, 0xaa,
+	0x12, 0x59, 0xb4, 0x88, 0x03, 0x12, 0xa7, 0x2e, 0x54, 0x5a, 0x2e, 0x20, 0x53, 0x89, 0x63, 0xe4,
+	0x24, 0x93, 0x6d, 0xda, 0x6c, 0x1c, 0x62, 0x07, 0x28, 0x5f, 0xc2, 0x95, 0x03, 0xff, 0xc0, 0x17,
+	0x20, 0x8e, 0x7c, 0x01, 0x42, 0xcb, 0x8f, 0x20, 0xdb, 0xc9, 0xae, 0x0a, 0x07, 0x0e, 0x56, 0x66,
+	0xde, 0xd8, 0x7e, 0x6f, 0xde, 0xc4, 0x38, 0x14, 0xd0, 0xbc, 0x83, 0x66, 0xfc, 0x9e, 0x37, 0x17,
+	0xab, 0x4f, 0xac, 0xc0, 0x22, 0x85, 0xa8, 0x6e, 0xb8, 0xe4, 0xc4, 0x36, 0xe8, 0xe0, 0x56, 0x5a,
+	0x16, 0x50, 0xc9, 0x71, 0x9d, 0x0b, 0xb5, 0x4c, 0x75, 0x8d, 0xd6, 0x42, 0xad, 0x1e, 0x9d, 0xf3,
+	0x39, 0xd7, 0xe1, 0x58, 0x45, 0x1d, 0x7a, 0x30, 0xe7, 0x7c, 0x5e, 0xc2, 0x58, 0x67, 0x49, 0x9b,
+	0x8f, 0x61, 0x51, 0xcb, 0x4b, 0x53, 0x0c, 0xbf, 0x20, 0x6c, 0xcd, 0xaa, 0xba, 0x95, 0xe4, 0x08,
+	0xbb, 0x79, 0x51, 0x42, 0x5c, 0x54, 0x39, 0xf7, 0x51, 0x80, 0x46, 0xde, 0x64, 0x37, 0x52, 0x8c,
+	0x27, 0x45, 0x09, 0xb3, 0x2a, 0xe7, 0xd4, 0xc9, 0xbb, 0x88, 0x10, 0xbc, 0x55, 0xb1, 0x05, 0xf8,
+	0xd7, 0x02, 0x34, 0x72, 0xa9, 0x8e, 0x15, 0x56, 0xb2, 0x8f, 0x97, 0xfe, 0x66, 0x80, 0x46, 0x0e,
+	0xd5, 0x31, 0xd9, 0xc7, 0x76, 0xd2, 0xb0, 0x2a, 0x3d, 0xf3, 0xb7, 0xf4, 0xce, 0x2e, 0x23, 0x0f,
+	0xf1, 0x6e, 0xcd, 0x1a, 0xa8, 0x64, 0x9c, 0xf2, 0xc5, 0xa2, 0x90, 0xbe, 0xa5, 0xf9, 0x3c, 0xcd,
+	0x37, 0xd5, 0x10, 0xdd, 0x31, 0x3b, 0x4c, 0x16, 0x7e, 0x43, 0x78, 0xef, 0x55, 0xc3, 0x53, 0x10,
+	0x82, 0xc2, 0xdb, 0x16, 0x84, 0x24, 0x87, 0x78, 0x2b, 0x63, 0x92, 0xf9, 0x28, 0xd8, 0xd4, 0x5a,
+	0x8d, 0x61, 0x91, 0xee, 0x86, 0xea, 0x12, 0x09, 0xb0, 0x7d, 0xce, 0x93, 0xb8, 0xc8, 0x8c, 0xd2,
+	0x63, 0x77, 0xf9, 0xf3, 0x9e, 0xf5, 0x82, 0x27, 0xb3, 0x67, 0xd4, 0x3a, 0xe7, 0xc9, 0x2c, 0x23,
+	0x0f, 0x56, 0x4a, 0x78, 0x2b, 0xeb, 0x56, 0x6a, 0xf9, 0xde, 0xc4, 0xd1, 0x4a, 0x4e, 0xd9, 0xbc,
+	0x97, 0xf1, 0x52, 0x57, 0xc9, 0x21, 0xde, 0x81, 0x8a, 0x25, 0x25, 0xc4, 0x42, 0x32, 0x29, 0x74,
+	0x5b, 0x0e, 0xf5, 0x0c, 0xf6, 0x5a, 0x41, 0xe4, 0x2e, 0xc6, 0xe2, 0xa2, 0xa8, 0xe3, 0x94, 0xa5,
+	0x67, 0xa0, 0x1b, 0x73, 0xa8, 0xab, 0x90, 0xa9, 0x02, 0xc2, 0xcf, 0x08, 0x5f, 0x5f, 0x35, 0x22,
+	0x6a, 0x5e, 0x09, 0x50, 0x36, 0xe5, 0xac, 0x28, 0xc1, 0xc8, 0x74, 0x68, 0x97, 0x91, 0xfb, 0xd8,
+	0x5a, 0xd3, 0x78, 0x93, 0x9b, 0x91, 0x1a, 0x75, 0x77, 0x58, 0x93, 0x51, 0x53, 0x27, 0x3e, 0xde,
+	0x56, 0x0c, 0x35, 0x64, 0x1d, 0x61, 0x9f, 0x92, 0x03, 0xec, 0xc2, 0x87, 0x42, 0xf9, 0x9c, 0x81,
+	0x6f, 0x07, 0x68, 0x64, 0x51, 0x47, 0x01, 0x53, 0x9e, 0x69, 0xde, 0x06, 0x98, 0xe0, 0x95, 0xbf,
+	0x6d, 0xc6, 0x63, 0xb2, 0xf0, 0x14, 0xef, 0x4e, 0x59, 0x95, 0x42, 0xb9, 0xb6, 0x7a, 0x47, 0xf9,
+	0x19, 0xe7, 0x45, 0x29, 0xa1, 0x11, 0xda, 0x72, 0x97, 0x7a, 0x0a, 0x3b, 0x31, 0xd0, 0xff, 0xad,
+	0x0e, 0x8f, 0xf0, 0x5e, 0x7f, 0x6b, 0xd7, 0xb7, 0x92, 0xdd, 0xa6, 0xaa, 0x1b, 0x1f, 0x75, 0xb2,
+	0x4d, 0x3a, 0xf9, 0x8a, 0xb0, 0xfd, 0x46, 0xcf, 0x93, 0x3c, 0xc5, 0xdb, 0x5d, 0xcb, 0x64, 0xbf,
+	0x9f, 0xf1, 0xd5, 0x3f, 0x61, 0x70, 0xe7, 0x1f, 0xdc, 0x10, 0x84, 0x1b, 0xe4, 0x31, 0xb6, 0x95,
+	0x53, 0xad, 0x3a, 0x6c, 0xde, 0x41, 0xd4, 0xbf, 0x83, 0xe8, 0xb9, 0x7a, 0x07, 0x03, 0xe3, 0xaa,
+	0x21, 0x33, 0x5b, 0xc3, 0x0d, 0xf2, 0x04, 0xdb, 0x46, 0x2b, 0xb9, 0xdd, 0xdf, 0x7d, 0xc5, 0x91,
+	0xc1, 0xfe, 0xdf, 0x70, 0xcf, 0x78, 0x7c, 0xe3, 0xfb, 0x72, 0x88, 0x7e, 0x2c, 0x87, 0xe8, 0xd7,
+	0x72, 0x88, 0x3e, 0xfd, 0x1e, 0x6e, 0x24, 0xb6, 0x66, 0x7c, 0xf4, 0x67, 0x00, 0x4d, 0x12, 0x84,
+	0x0a, 0xf7, 0x03, 0x00, 0x00,
 }